@@ -24,6 +24,12 @@ run build/builder.sh env TZ=America/New_York make test TESTFLAGS='-v' 2>&1 \
 	| go-test-teamcity
 tc_end_block "Run Go tests"
 
+tc_start_block "Run Go tests in FIPS mode"
+run build/builder.sh make testfips TESTFLAGS='-v' 2>&1 \
+	| tee artifacts/testfips.log \
+	| go-test-teamcity
+tc_end_block "Run Go tests in FIPS mode"
+
 tc_start_block "Run C++ tests"
 run build/builder.sh make check-libroach
 tc_end_block "Run C++ tests"
@@ -525,7 +525,7 @@ func makeSQLClient(appName string) (*sqlConn, error) {
 			// If there's no password in the URL yet and we don't have a client
 			// certificate, ask for it and populate it in the URL.
 			if _, pwdSet := baseURL.User.Password(); !pwdSet {
-				pwd, err := security.PromptForPassword()
+				pwd, err := security.PromptForExistingPassword()
 				if err != nil {
 					return nil, err
 				}
@@ -129,7 +129,7 @@ func runSetUser(cmd *cobra.Command, args []string) error {
 	pwdString := ""
 	if password {
 		var err error
-		pwdString, err = security.PromptForPasswordTwice()
+		pwdString, err = security.PromptForPasswordTwiceWithRetries(3)
 		if err != nil {
 			return err
 		}
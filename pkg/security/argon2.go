@@ -0,0 +1,127 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"math"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2id hashes are encoded in the PHC string format used by the
+// reference implementation:
+//
+//	$argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<hash>
+const argon2idID = "argon2id"
+
+// Argon2Memory (in KiB), Argon2Time and Argon2Threads are the parameters to
+// use when hashing passwords with HashArgon2id. Like BcryptCost, they are
+// exposed for testing. Verification always uses the parameters recorded in
+// the stored hash.
+//
+// The defaults follow the recommendation in the x/crypto/argon2 package
+// documentation.
+var (
+	Argon2Memory  uint32 = 64 * 1024
+	Argon2Time    uint32 = 1
+	Argon2Threads uint8  = 4
+)
+
+// Argon2MaxMemory (in KiB), Argon2MaxTime and Argon2MaxThreads are the
+// highest Argon2id parameters accepted. Like ScryptMaxMemory, they are
+// enforced both when hashing and when parsing a stored hash, so that a
+// crafted hash, e.g. set with CREATE USER ... WITH PASSWORD, cannot exhaust
+// the memory of the server or pin its CPUs on every login attempt.
+var (
+	Argon2MaxMemory  uint32 = 1 << 20
+	Argon2MaxTime    uint32 = 16
+	Argon2MaxThreads uint8  = 16
+)
+
+const (
+	argon2SaltLen = 16
+	argon2KeyLen  = 32
+)
+
+// b64Raw is the base64 variant used by the PHC string format.
+var b64Raw = base64.RawStdEncoding
+
+func hashArgon2id(password []byte) ([]byte, error) {
+	salt, err := generateSalt(argon2SaltLen)
+	if err != nil {
+		return nil, err
+	}
+	memory, time, threads := Argon2Memory, Argon2Time, Argon2Threads
+	if err := checkArgon2idParams(uint64(memory), uint64(time), uint64(threads)); err != nil {
+		return nil, err
+	}
+	h := PasswordHash{
+		method:  HashArgon2id,
+		id:      argon2idID,
+		version: argon2.Version,
+		params:  []phcParam{{"m", int(memory)}, {"t", int(time)}, {"p", int(threads)}},
+		salt:    salt,
+		hash:    argon2.IDKey(password, salt, time, memory, threads, argon2KeyLen),
+	}
+	return h.Encode(), nil
+}
+
+// argon2idParams validates and returns the parameters of an Argon2id hash.
+func argon2idParams(h PasswordHash) (memory, time uint32, threads uint8, err error) {
+	if h.version != argon2.Version {
+		return 0, 0, 0, errors.Errorf("unsupported argon2id version %d", h.version)
+	}
+	values, err := requireParams(h, "m", "t", "p")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if uint64(values[0]) > math.MaxUint32 || values[1] == 0 ||
+		uint64(values[1]) > math.MaxUint32 || values[2] == 0 || values[2] > math.MaxUint8 {
+		return 0, 0, 0, errors.New("malformed argon2id hash: invalid parameters")
+	}
+	if err := checkArgon2idParams(
+		uint64(values[0]), uint64(values[1]), uint64(values[2]),
+	); err != nil {
+		return 0, 0, 0, errors.Wrap(err, "malformed argon2id hash")
+	}
+	return uint32(values[0]), uint32(values[1]), uint8(values[2]), nil
+}
+
+// checkArgon2idParams verifies that the given parameters stay within
+// Argon2MaxMemory, Argon2MaxTime and Argon2MaxThreads.
+func checkArgon2idParams(memory, time, threads uint64) error {
+	if memory > uint64(Argon2MaxMemory) || time > uint64(Argon2MaxTime) ||
+		threads > uint64(Argon2MaxThreads) {
+		return errors.Errorf("argon2id parameters m=%d, t=%d, p=%d exceed the maximum of "+
+			"m=%d, t=%d, p=%d", memory, time, threads, Argon2MaxMemory, Argon2MaxTime,
+			Argon2MaxThreads)
+	}
+	return nil
+}
+
+func verifyArgon2id(h PasswordHash, password []byte) error {
+	memory, time, threads, err := argon2idParams(h)
+	if err != nil {
+		return err
+	}
+	got := argon2.IDKey(password, h.salt, time, memory, threads, uint32(len(h.hash)))
+	if subtle.ConstantTimeCompare(h.hash, got) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
@@ -105,7 +105,8 @@ func UserAuthPasswordHook(insecureMode bool, password string, hashedPassword []b
 		}
 
 		// If the requested user has an empty password, disallow authentication.
-		if len(password) == 0 || CompareHashAndPassword(hashedPassword, password) != nil {
+		if len(password) == 0 ||
+			CompareHashAndPasswordWithUser(hashedPassword, password, requestedUser) != nil {
 			return errors.Errorf(ErrPasswordUserAuthFailed, requestedUser)
 		}
 
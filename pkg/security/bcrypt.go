@@ -0,0 +1,428 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/blowfish"
+)
+
+// bcryptV2Prefix marks hashes produced with the corrected SHA-256 pre-hash.
+// It is immediately followed by the bcrypt hash itself, e.g.
+// "crdb-bcrypt2$2a$10$...".
+//
+// Hashes created before the prefix was introduced use the legacy
+// pre-hash, which erroneously appends the SHA-256 of the empty string to the
+// unhashed password instead of actually hashing the password. This is not a
+// security issue because the round of SHA-256 was only intended to achieve a
+// fixed-length input to bcrypt; it is bcrypt that provides the cryptographic
+// security, and bcrypt is correctly applied. Legacy hashes keep verifying,
+// and NeedsRehash reports them so that they can be upgraded.
+const bcryptV2Prefix = "crdb-bcrypt2"
+
+// bcryptV1Prefix explicitly marks a hash using the legacy pre-hash. Such
+// hashes are never produced by HashPassword, but can be supplied by clients
+// that compute hashes themselves (see CheckPasswordHashValidity).
+const bcryptV1Prefix = "crdb-bcrypt"
+
+// The versions of bcrypt hashes, as recorded in PasswordHash.version.
+const (
+	// bcryptLegacy hashes have no prefix and use the legacy pre-hash.
+	bcryptLegacy = iota
+	// bcryptLegacyTagged hashes have bcryptV1Prefix.
+	bcryptLegacyTagged
+	// bcryptV2 hashes have bcryptV2Prefix.
+	bcryptV2
+	// bcryptPeppered hashes have pepperPrefix.
+	bcryptPeppered
+)
+
+// bcryptVersionPrefixes maps the prefixes of bcrypt hashes to their version.
+var bcryptVersionPrefixes = []struct {
+	prefix  string
+	version int
+}{
+	{bcryptV2Prefix, bcryptV2},
+	{bcryptV1Prefix, bcryptLegacyTagged},
+}
+
+// splitBcryptVersion strips the version prefix, if any, from a bcrypt hash.
+// For peppered hashes, it also returns the pepper key ID.
+func splitBcryptVersion(
+	hashedPassword []byte,
+) (version int, keyID string, hash []byte, err error) {
+	if bytes.HasPrefix(hashedPassword, []byte(pepperPrefix+"$")) {
+		rest := hashedPassword[len(pepperPrefix)+1:]
+		end := bytes.IndexByte(rest, '$')
+		if end <= 0 {
+			return 0, "", nil, errors.New("malformed bcrypt hash: missing pepper key ID")
+		}
+		return bcryptPeppered, string(rest[:end]), rest[end:], nil
+	}
+	for _, v := range bcryptVersionPrefixes {
+		if bytes.HasPrefix(hashedPassword, []byte(v.prefix+"$")) {
+			return v.version, "", hashedPassword[len(v.prefix):], nil
+		}
+	}
+	return bcryptLegacy, "", hashedPassword, nil
+}
+
+// bcryptMaxInputLen is the number of bytes of its input bcrypt considers;
+// the rest is silently ignored, both when hashing and when verifying.
+const bcryptMaxInputLen = 72
+
+// bcryptPreHash computes the input to bcrypt for the given password: the
+// base64 encoding of its SHA-256. The encoding keeps the input free of NUL
+// bytes, which some bcrypt implementations treat as a terminator, and at 44
+// bytes it is well below the 72 bytes bcrypt considers, so that every byte
+// of the password, however long, affects the hash.
+func bcryptPreHash(password []byte) []byte {
+	return appendBcryptPreHash(make([]byte, 0, bcryptPreHashLen), password)
+}
+
+// bcryptPreHashLen is the length of the output of bcryptPreHash.
+const bcryptPreHashLen = 44
+
+// appendBcryptPreHash appends the output of bcryptPreHash to dst, so that
+// verifications can compute it in a buffer on the stack.
+func appendBcryptPreHash(dst, password []byte) []byte {
+	sum := sha256.Sum256(password)
+	dst = growBytes(dst, bcryptPreHashLen)
+	base64.StdEncoding.Encode(dst[len(dst)-bcryptPreHashLen:], sum[:])
+	ZeroBytes(sum[:])
+	return dst
+}
+
+// emptySHA256 is the SHA-256 of the empty string, which the legacy pre-hash
+// appends to the password (see bcryptV2Prefix).
+var emptySHA256 = sha256.Sum256(nil)
+
+// bcryptLegacyPreHash computes the input to bcrypt for hashes without
+// bcryptV2Prefix. As the password is not actually hashed, bcrypt ignores the
+// bytes of passwords longer than 72 bytes past the 72nd: legacy hashes of
+// such passwords verify any password with the same first 72 bytes. Legacy
+// hashes are never produced, and are upgraded by UpgradeHashIfNeeded.
+func bcryptLegacyPreHash(password []byte) []byte {
+	return appendBcryptLegacyPreHash(make([]byte, 0, len(password)+sha256.Size), password)
+}
+
+// appendBcryptLegacyPreHash appends the output of bcryptLegacyPreHash to dst.
+func appendBcryptLegacyPreHash(dst, password []byte) []byte {
+	return append(append(dst, password...), emptySHA256[:]...)
+}
+
+// growBytes extends b by n bytes, reallocating it if its capacity is too
+// small.
+func growBytes(b []byte, n int) []byte {
+	if cap(b)-len(b) < n {
+		grown := make([]byte, len(b), 2*len(b)+n)
+		copy(grown, b)
+		b = grown
+	}
+	return b[:len(b)+n]
+}
+
+// hashBcrypt hashes a password with bcrypt, using the pepper if one is
+// configured (see SetPepper).
+func hashBcrypt(password []byte) ([]byte, error) {
+	prefix := bcryptV2Prefix
+	var input []byte
+	if id, peppered, ok := activePepperedPreHash(password); ok {
+		prefix = pepperPrefix + "$" + id
+		input = peppered
+	} else {
+		input = bcryptPreHash(password)
+	}
+	defer ZeroBytes(input)
+	if len(input) > bcryptMaxInputLen {
+		// The pre-hashes have a fixed length which fits; refuse to produce
+		// a hash with bcrypt's silent truncation should that change.
+		return nil, &PasswordTooLongError{Max: bcryptMaxInputLen, Actual: len(input)}
+	}
+	var hash []byte
+	var err error
+	if cost := GetBcryptCost(); testingSaltSourceSet() {
+		hash, err = bcryptWithSalt(input, cost)
+	} else {
+		hash, err = bcrypt.GenerateFromPassword(input, cost)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(prefix), hash...), nil
+}
+
+// bcryptMagic is the plaintext that bcrypt encrypts.
+const bcryptMagic = "OrpheanBeholderScryDoubt"
+
+// bcryptWithSalt is like bcrypt.GenerateFromPassword, but reads the salt
+// with generateSalt, which the bcrypt package does not allow. It is only
+// used in tests that override the salt source (see TestingSetSaltSource).
+func bcryptWithSalt(password []byte, cost int) ([]byte, error) {
+	if cost < bcrypt.MinCost {
+		// Like bcrypt.GenerateFromPassword.
+		cost = bcrypt.DefaultCost
+	}
+	if cost > bcrypt.MaxCost {
+		return nil, bcrypt.InvalidCostError(cost)
+	}
+	salt, err := generateSalt(16)
+	if err != nil {
+		return nil, err
+	}
+	// Like the C implementations, use the trailing NUL of the key.
+	key := append(append(make([]byte, 0, len(password)+1), password...), 0)
+	defer ZeroBytes(key)
+	c, err := blowfish.NewSaltedCipher(key, salt)
+	if err != nil {
+		return nil, err
+	}
+	for i := uint64(0); i < 1<<uint(cost); i++ {
+		blowfish.ExpandKey(key, c)
+		blowfish.ExpandKey(salt, c)
+	}
+	data := []byte(bcryptMagic)
+	for i := 0; i < len(data); i += blowfish.BlockSize {
+		for j := 0; j < 64; j++ {
+			c.Encrypt(data[i:i+blowfish.BlockSize], data[i:i+blowfish.BlockSize])
+		}
+	}
+	return encodeBcryptHash(PasswordHash{
+		method: HashBCrypt,
+		id:     "2a",
+		params: []phcParam{{"cost", cost}},
+		salt:   salt,
+		// Like the C implementations, only use 23 of the 24 bytes.
+		hash: data[:len(data)-1],
+	}, false /* withPrefix */), nil
+}
+
+// verifyBcrypt verifies a password against a hash produced by HashBCrypt,
+// using the pre-hash indicated by the hash's version. Peppered hashes are
+// only ever verified with their pepper, and other hashes without one.
+func verifyBcrypt(h PasswordHash, password []byte) error {
+	if err := checkBcryptVariant(h); err != nil {
+		return err
+	}
+	// The $2b$ and $2y$ variants, as produced by OpenBSD and PHP, compute
+	// the same hashes as Go's implementation of $2a$: they only exist to
+	// distinguish hashes produced after fixes for bugs that Go's
+	// implementation never had.
+	h.id = "2a"
+	// The pre-hash and the encoded hash are computed in buffers on the stack,
+	// unless the password is too long for the legacy pre-hash to fit.
+	var inputBuf [bcryptVerifyBufLen]byte
+	var input []byte
+	switch h.version {
+	case bcryptPeppered:
+		var err error
+		if input, err = pepperedPreHashWithKey(h.keyID, password); err != nil {
+			return err
+		}
+	case bcryptV2:
+		input = appendBcryptPreHash(inputBuf[:0], password)
+	default:
+		input = appendBcryptLegacyPreHash(inputBuf[:0], password)
+	}
+	defer ZeroBytes(input)
+	var hashBuf [bcryptHashLen]byte
+	return bcrypt.CompareHashAndPassword(
+		appendBcryptHash(hashBuf[:0], h, false /* withPrefix */), input)
+}
+
+// bcryptVerifyBufLen is the size of the buffer verifyBcrypt computes the
+// pre-hash in. bcrypt only considers 72 bytes of its input.
+const bcryptVerifyBufLen = 128
+
+// ErrUnsupportedBcryptVariant is the cause of UnsupportedBcryptVariantError.
+var ErrUnsupportedBcryptVariant = errors.New("unsupported bcrypt variant")
+
+// UnsupportedBcryptVariantError is returned when verifying a bcrypt hash
+// of the $2x$ variant. Its cause is ErrUnsupportedBcryptVariant.
+type UnsupportedBcryptVariantError struct {
+	Variant string
+}
+
+func (e *UnsupportedBcryptVariantError) Error() string {
+	return fmt.Sprintf("bcrypt variant $%s$ is not supported, since it was produced by a "+
+		"broken implementation; the password must be reset", e.Variant)
+}
+
+// Cause implements the causer interface.
+func (e *UnsupportedBcryptVariantError) Cause() error {
+	return ErrUnsupportedBcryptVariant
+}
+
+// checkBcryptVariant rejects bcrypt hashes of the $2x$ variant, which PHP
+// used to mark hashes produced by its implementation before it was fixed to
+// handle non-ASCII passwords correctly. Such hashes cannot be verified
+// reliably.
+func checkBcryptVariant(h PasswordHash) error {
+	if h.id == "2x" {
+		return &UnsupportedBcryptVariantError{Variant: h.id}
+	}
+	return nil
+}
+
+// encodeBcryptHash encodes a bcrypt hash, optionally preceded by the prefix
+// for its version.
+func encodeBcryptHash(h PasswordHash, withPrefix bool) []byte {
+	return appendBcryptHash(nil, h, withPrefix)
+}
+
+// appendBcryptHash appends the encoding of a bcrypt hash to dst.
+func appendBcryptHash(dst []byte, h PasswordHash, withPrefix bool) []byte {
+	if withPrefix {
+		switch h.version {
+		case bcryptPeppered:
+			dst = append(append(append(dst, pepperPrefix...), '$'), h.keyID...)
+		case bcryptV2:
+			dst = append(dst, bcryptV2Prefix...)
+		case bcryptLegacyTagged:
+			dst = append(dst, bcryptV1Prefix...)
+		}
+	}
+	dst = append(append(append(dst, '$'), h.id...), '$')
+	// The cost is always encoded with two digits.
+	if cost, _ := h.Param("cost"); cost < 10 {
+		dst = append(strconv.AppendInt(append(dst, '0'), int64(cost), 10), '$')
+	} else {
+		dst = append(strconv.AppendInt(dst, int64(cost), 10), '$')
+	}
+	for _, b := range [][]byte{h.salt, h.hash} {
+		n := bcryptB64.EncodedLen(len(b))
+		dst = growBytes(dst, n)
+		bcryptB64.Encode(dst[len(dst)-n:], b)
+	}
+	return dst
+}
+
+// bcryptB64 is the base64 variant used by bcrypt.
+var bcryptB64 = base64.NewEncoding(
+	"./ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+).WithPadding(base64.NoPadding).Strict()
+
+const (
+	bcryptEncodedSaltLen = 22
+	bcryptEncodedHashLen = 31
+	// bcryptHashLen is the length of a complete bcrypt hash, e.g.
+	// "$2a$10$" followed by the encoded salt and hash.
+	bcryptHashLen = 7 + bcryptEncodedSaltLen + bcryptEncodedHashLen
+)
+
+// parseBcryptHash decodes a bcrypt hash, with or without a version prefix.
+func parseBcryptHash(hashedPassword []byte) (PasswordHash, error) {
+	version, keyID, hashedPassword, err := splitBcryptVersion(hashedPassword)
+	if err != nil {
+		return PasswordHash{}, err
+	}
+	if n := len(hashedPassword); n != bcryptHashLen {
+		field := "unexpected trailing characters"
+		switch {
+		case n < 7:
+			field = "truncated version or cost"
+		case n < 7+bcryptEncodedSaltLen:
+			field = "truncated salt"
+		case n < bcryptHashLen:
+			field = "truncated hash"
+		}
+		return PasswordHash{}, errors.Errorf("malformed bcrypt hash: %s: expected %d bytes, got %d",
+			field, bcryptHashLen, n)
+	}
+	s := string(hashedPassword)
+	if s[0] != '$' || s[3] != '$' || s[6] != '$' {
+		return PasswordHash{}, errors.New("malformed bcrypt hash: invalid separators")
+	}
+	id := s[1:3]
+	switch id {
+	case "2a", "2b", "2x", "2y":
+	default:
+		return PasswordHash{}, errors.Errorf("malformed bcrypt hash: invalid version %q", id)
+	}
+	cost, err := strconv.Atoi(s[4:6])
+	if err != nil || s[4] < '0' || s[4] > '9' {
+		return PasswordHash{}, errors.Errorf("malformed bcrypt hash: invalid cost %q", s[4:6])
+	}
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return PasswordHash{}, errors.Errorf("malformed bcrypt hash: cost %d outside of [%d, %d]",
+			cost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	salt, err := bcryptB64.DecodeString(s[7 : 7+bcryptEncodedSaltLen])
+	if err != nil {
+		return PasswordHash{}, errors.New("malformed bcrypt hash: invalid salt encoding")
+	}
+	hash, err := bcryptB64.DecodeString(s[7+bcryptEncodedSaltLen:])
+	if err != nil {
+		return PasswordHash{}, errors.New("malformed bcrypt hash: invalid hash encoding")
+	}
+	return PasswordHash{
+		method:  HashBCrypt,
+		id:      id,
+		version: version,
+		keyID:   keyID,
+		params:  []phcParam{{"cost", cost}},
+		salt:    salt,
+		hash:    hash,
+	}, nil
+}
+
+// CheckPasswordHashValidity determines whether the input supplied as a
+// password, e.g. in CREATE USER ... WITH PASSWORD, is a pre-computed hash
+// rather than a plaintext password. This lets clients such as provisioning
+// tools avoid sending plaintext passwords to the server.
+//
+// Inputs starting with "crdb-bcrypt" are considered to be hashes, using the
+// legacy ("crdb-bcrypt$2a$...") or current ("crdb-bcrypt2$2a$...") pre-hash
+// (see bcryptV2Prefix). An error is returned if such an input is not a
+// well-formed hash, so that it is not mistaken for a plaintext password, or
+// if its cost is below MinAcceptedBcryptCost or more than 2 above the
+// configured cost (see GetBcryptCost), so that a client cannot make the
+// logins of the user cheap to brute-force or hours long. The latter is a
+// *HashCostOutOfRangeError.
+func CheckPasswordHashValidity(input []byte) (isHashed bool, err error) {
+	if !bytes.HasPrefix(input, []byte(bcryptV1Prefix)) {
+		return false, nil
+	}
+	h, err := parseBcryptHash(input)
+	if err != nil {
+		err = malformedHash(err)
+	} else if err = checkBcryptVariant(h); err == nil {
+		err = checkImportedHashParams(h)
+	}
+	if err != nil {
+		return true, errors.Wrap(err, "invalid pre-hashed password")
+	}
+	return true, nil
+}
+
+// LoadPasswordHash validates a pre-computed hash accepted by
+// CheckPasswordHashValidity and returns it in the form to store.
+func LoadPasswordHash(input []byte) ([]byte, error) {
+	isHashed, err := CheckPasswordHashValidity(input)
+	if err != nil {
+		return nil, err
+	}
+	if !isHashed {
+		return nil, errors.New("password is not a pre-hashed password")
+	}
+	return append([]byte(nil), input...), nil
+}
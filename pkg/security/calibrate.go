@@ -0,0 +1,398 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/cockroachdb/cockroach/pkg/util/envutil"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+// HashParams are the parameters of a hash method, as recommended by
+// CalibrateHashParams.
+type HashParams struct {
+	Method HashMethod
+	// Cost is the bcrypt cost, the Argon2id memory in KiB, the base-2
+	// logarithm of the scrypt N parameter, or the PBKDF2 iteration count,
+	// depending on Method. The other parameters of the method are left as
+	// configured.
+	Cost int
+	// Latency is the estimated time taken to hash a password with these
+	// parameters.
+	Latency time.Duration
+}
+
+func (p HashParams) String() string {
+	name := "cost"
+	switch p.Method {
+	case HashArgon2id:
+		name = "m"
+	case HashScrypt:
+		name = "ln"
+	case HashPBKDF2:
+		name = "i"
+	}
+	return fmt.Sprintf("%s %s=%d (~%s)", p.Method, name, p.Cost, p.Latency)
+}
+
+// Apply makes the parameters the ones used to hash passwords, and their
+// method the default. Like the variables it sets, it is not safe for
+// concurrent use with hashing, and is meant to be called on startup.
+func (p HashParams) Apply() error {
+	switch p.Method {
+	case HashBCrypt:
+		if p.Cost < bcrypt.MinCost || p.Cost > bcrypt.MaxCost {
+			return errors.Errorf("bcrypt cost %d outside of [%d, %d]",
+				p.Cost, bcrypt.MinCost, bcrypt.MaxCost)
+		}
+		initBcryptCost()
+		atomic.StoreInt32(&bcryptCost, int32(p.Cost))
+	case HashArgon2id:
+		if p.Cost <= 0 {
+			return errors.Errorf("invalid argon2id memory %d", p.Cost)
+		}
+		if err := checkArgon2idParams(
+			uint64(p.Cost), uint64(Argon2Time), uint64(Argon2Threads),
+		); err != nil {
+			return err
+		}
+		Argon2Memory = uint32(p.Cost)
+	case HashScrypt:
+		if p.Cost <= 0 || p.Cost >= 63 {
+			return errors.Errorf("invalid scrypt ln %d", p.Cost)
+		}
+		n := 1 << uint(p.Cost)
+		if err := checkScryptParams(n, ScryptR, ScryptP); err != nil {
+			return err
+		}
+		ScryptN = n
+	case HashPBKDF2:
+		if p.Cost <= 0 || p.Cost > PBKDF2MaxIterations {
+			return errors.Errorf("PBKDF2 iteration count %d outside of [1, %d]",
+				p.Cost, PBKDF2MaxIterations)
+		}
+		PBKDF2Iterations = p.Cost
+	default:
+		return &HashMethodUnsupportedError{Method: p.Method}
+	}
+	return SetDefaultHashMethod(p.Method)
+}
+
+// calibrationBudget bounds the wall time spent by CalibrateHashParams.
+const calibrationBudget = 2 * time.Second
+
+const (
+	// calibrationRuns is the number of times CalibrateHashParams times the
+	// hash method, if the budget allows. The fastest run is used, as the
+	// others were slowed down by unrelated activity.
+	calibrationRuns = 5
+	// pbkdf2CalibrationStep is the step PBKDF2 iteration counts are rounded
+	// down to.
+	pbkdf2CalibrationStep = 10000
+)
+
+// CalibrateHashParams times hashing with the given method on the local
+// machine, and returns the most expensive parameters for which hashing a
+// password is estimated to take at most the target latency. It takes a
+// couple of seconds at most.
+//
+// The parameters are rounded down to steps (powers of two for the bcrypt
+// cost, the Argon2id memory and the scrypt N parameter, multiples of 10000
+// for PBKDF2 iteration counts), so that repeated calibrations on the same
+// hardware return the same result. The result can be passed to Apply.
+func CalibrateHashParams(method HashMethod, target time.Duration) (HashParams, error) {
+	password := []byte("calibration password")
+	salt := make([]byte, 16)
+	// Each method is timed with cheap parameters, and the latency is assumed
+	// to be proportional to the cost parameter.
+	var base, min, max int
+	var hash func(cost int)
+	var estimate func(cost int, baseLatency time.Duration) time.Duration
+	linear := func(cost int, d time.Duration) time.Duration {
+		return time.Duration(float64(d) * float64(cost) / float64(base))
+	}
+	exponential := func(cost int, d time.Duration) time.Duration {
+		if cost < base {
+			return d >> uint(base-cost)
+		}
+		return d << uint(cost-base)
+	}
+	switch method {
+	case HashBCrypt:
+		base, min, max = 6, bcrypt.MinCost, bcrypt.MaxCost
+		hash = func(cost int) { _, _ = bcrypt.GenerateFromPassword(password, cost) }
+		estimate = exponential
+	case HashArgon2id:
+		base, min, max = 8<<10, 1<<10, int(Argon2MaxMemory)
+		iterations, threads := Argon2Time, Argon2Threads
+		hash = func(cost int) {
+			_ = argon2.IDKey(password, salt, iterations, uint32(cost), threads, argon2KeyLen)
+		}
+		estimate = linear
+	case HashScrypt:
+		base, min, max = 12, 1, 1
+		for checkScryptParams(1<<uint(max+1), ScryptR, ScryptP) == nil && max < 62 {
+			max++
+		}
+		if base > max {
+			base = max
+		}
+		r, p := ScryptR, ScryptP
+		hash = func(cost int) { _, _ = scrypt.Key(password, salt, 1<<uint(cost), r, p, scryptKeyLen) }
+		estimate = exponential
+	case HashPBKDF2:
+		base, min, max = pbkdf2CalibrationStep, pbkdf2CalibrationStep, PBKDF2MaxIterations
+		hash = func(cost int) { _ = pbkdf2.Key(password, salt, cost, pbkdf2KeyLen, sha256.New) }
+		estimate = linear
+	default:
+		return HashParams{}, errors.Errorf("cannot calibrate the parameters of %s", method)
+	}
+
+	start := timeutil.Now()
+	var fastest time.Duration
+	for i := 0; i < calibrationRuns && (i == 0 || timeutil.Since(start) < calibrationBudget/2); i++ {
+		runStart := timeutil.Now()
+		hash(base)
+		if d := timeutil.Since(runStart); i == 0 || d < fastest {
+			fastest = d
+		}
+	}
+
+	if estimate(min, fastest) > target {
+		return HashParams{}, errors.Errorf("%s takes at least %s with the cheapest parameters, "+
+			"more than the target of %s", method, estimate(min, fastest), target)
+	}
+	cost := min
+	if method == HashPBKDF2 {
+		cost = int(float64(target) / float64(fastest) * float64(base))
+		cost -= cost % pbkdf2CalibrationStep
+		if cost > max {
+			cost = max
+		}
+		if cost < min {
+			cost = min
+		}
+	} else {
+		for {
+			next := cost + 1
+			if method == HashArgon2id {
+				next = cost * 2
+			}
+			if next > max || estimate(next, fastest) > target {
+				break
+			}
+			cost = next
+		}
+	}
+	return HashParams{Method: method, Cost: cost, Latency: estimate(cost, fastest)}, nil
+}
+
+// recommendedBcryptCosts caches the results of RecommendedBcryptCost, by
+// target latency.
+var recommendedBcryptCosts struct {
+	syncutil.Mutex
+	byTarget map[time.Duration]int
+}
+
+// RecommendedBcryptCost returns the highest bcrypt cost for which hashing a
+// password takes at most the target latency on the local machine, clamped
+// to [bcrypt.MinCost+4, bcrypt.MaxCost]. It times bcrypt once at each cost,
+// up to the first cost over the target, and once more if that cost is only
+// slightly over it. As each cost takes twice as long as the previous one,
+// it takes between two and four times the target. The result is cached for
+// the lifetime of the process.
+//
+// Unlike CalibrateHashParams, which extrapolates from a single cheap cost,
+// it times each cost up to the recommended one.
+func RecommendedBcryptCost(target time.Duration) (int, error) {
+	if target <= 0 {
+		return 0, errors.Errorf("invalid target latency %s", target)
+	}
+	if err := checkFIPSApproved(HashBCrypt); err != nil {
+		return 0, err
+	}
+	recommendedBcryptCosts.Lock()
+	defer recommendedBcryptCosts.Unlock()
+	if cost, ok := recommendedBcryptCosts.byTarget[target]; ok {
+		return cost, nil
+	}
+
+	password := []byte("calibration password")
+	timeCost := func(cost int) (time.Duration, error) {
+		start := timeutil.Now()
+		_, err := bcrypt.GenerateFromPassword(password, cost)
+		return timeutil.Since(start), err
+	}
+	cost := bcrypt.MinCost
+	for ; cost < bcrypt.MaxCost; cost++ {
+		d, err := timeCost(cost + 1)
+		if err != nil {
+			return 0, err
+		}
+		// Unrelated activity can only slow a run down, so a run within the
+		// target is kept, and a run slightly over it is timed again.
+		if d > target && d < target*5/4 {
+			retry, err := timeCost(cost + 1)
+			if err != nil {
+				return 0, err
+			}
+			if retry < d {
+				d = retry
+			}
+		}
+		if d > target {
+			break
+		}
+	}
+	if cost < bcrypt.MinCost+4 {
+		cost = bcrypt.MinCost + 4
+	}
+
+	if recommendedBcryptCosts.byTarget == nil {
+		recommendedBcryptCosts.byTarget = make(map[time.Duration]int)
+	}
+	recommendedBcryptCosts.byTarget[target] = cost
+	return cost, nil
+}
+
+// bcryptTargetEnvVar is the environment variable read by
+// InitBcryptCostFromEnv.
+const bcryptTargetEnvVar = "COCKROACH_BCRYPT_TARGET_LATENCY"
+
+// InitBcryptCostFromEnv sets the bcrypt cost to the RecommendedBcryptCost for
+// the target latency in the COCKROACH_BCRYPT_TARGET_LATENCY environment
+// variable, e.g. "100ms", if it is set. It is meant to be called on startup.
+func InitBcryptCostFromEnv() error {
+	target := envutil.EnvOrDefaultDuration(bcryptTargetEnvVar, 0)
+	if target == 0 {
+		return nil
+	}
+	cost, err := RecommendedBcryptCost(target)
+	if err != nil {
+		return errors.Wrapf(err, "invalid %s", bcryptTargetEnvVar)
+	}
+	initBcryptCost()
+	atomic.StoreInt32(&bcryptCost, int32(cost))
+	return nil
+}
+
+// The bcrypt pre-hash variants measured by BenchmarkHashCosts.
+const (
+	// PreHashLegacy is the pre-hash of bcrypt hashes without bcryptV2Prefix.
+	PreHashLegacy = "legacy"
+	// PreHashV2 is the corrected pre-hash used by HashPassword.
+	PreHashV2 = "v2"
+)
+
+// CostSample is the latency of hashing a password with bcrypt at a given
+// cost and pre-hash variant, as measured by BenchmarkHashCosts. Latencies
+// are encoded in JSON as nanoseconds.
+type CostSample struct {
+	Cost    int           `json:"cost"`
+	PreHash string        `json:"prehash"`
+	Samples int           `json:"samples"`
+	Median  time.Duration `json:"median"`
+	P95     time.Duration `json:"p95"`
+}
+
+// hashCostBenchmarkBudget bounds the wall time spent by
+// BenchmarkHashCosts, in nanoseconds.
+var hashCostBenchmarkBudget = int64(30 * time.Second)
+
+// TestingSetHashCostBenchmarkBudget changes the time BenchmarkHashCosts is
+// allowed to spend. The returned function restores the previous budget.
+func TestingSetHashCostBenchmarkBudget(d time.Duration) func() {
+	if flag.Lookup("test.v") == nil {
+		panic("TestingSetHashCostBenchmarkBudget can only be used in tests")
+	}
+	prev := atomic.SwapInt64(&hashCostBenchmarkBudget, int64(d))
+	return func() {
+		atomic.StoreInt64(&hashCostBenchmarkBudget, prev)
+	}
+}
+
+// BenchmarkHashCosts measures the latency of hashing a password with bcrypt
+// on the local machine, for each cost between minCost and maxCost and for
+// both the legacy and the corrected pre-hash, taking the given number of
+// samples of each. It returns the samples by increasing cost, e.g. for the
+// CLI to render as a table or JSON.
+//
+// The benchmark stops after about 30 seconds: the cost at which the first
+// sample ends past that deadline, and the more expensive ones, are omitted,
+// and the last costs measured may have fewer samples than requested.
+func BenchmarkHashCosts(minCost, maxCost, samples int) ([]CostSample, error) {
+	if minCost < bcrypt.MinCost || maxCost > bcrypt.MaxCost || minCost > maxCost {
+		return nil, errors.Errorf("invalid bcrypt cost range [%d, %d], must be within [%d, %d]",
+			minCost, maxCost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	if samples <= 0 {
+		return nil, errors.Errorf("invalid number of samples %d", samples)
+	}
+	if err := checkFIPSApproved(HashBCrypt); err != nil {
+		return nil, err
+	}
+
+	password := []byte("benchmark password")
+	variants := []struct {
+		name    string
+		preHash func([]byte) []byte
+	}{
+		{PreHashLegacy, bcryptLegacyPreHash},
+		{PreHashV2, bcryptPreHash},
+	}
+	deadline := timeutil.Now().Add(time.Duration(atomic.LoadInt64(&hashCostBenchmarkBudget)))
+	var results []CostSample
+	for cost := minCost; cost <= maxCost; cost++ {
+		measured := len(results)
+		for _, v := range variants {
+			latencies := make([]time.Duration, 0, samples)
+			for i := 0; i < samples && (i == 0 || timeutil.Now().Before(deadline)); i++ {
+				start := timeutil.Now()
+				input := v.preHash(password)
+				if _, err := bcrypt.GenerateFromPassword(input, cost); err != nil {
+					return nil, err
+				}
+				ZeroBytes(input)
+				end := timeutil.Now()
+				if i == 0 && end.After(deadline) {
+					return results[:measured], nil
+				}
+				latencies = append(latencies, end.Sub(start))
+			}
+			sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+			results = append(results, CostSample{
+				Cost:    cost,
+				PreHash: v.name,
+				Samples: len(latencies),
+				Median:  latencies[(len(latencies)-1)/2],
+				P95:     latencies[(len(latencies)*95+99)/100-1],
+			})
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util"
+	"github.com/cockroachdb/cockroach/pkg/util/envutil"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+func TestCalibrateHashParams(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer security.TestingSetBcryptCost(security.GetBcryptCost())()
+	defer func(m uint32, n, i int, method security.HashMethod) {
+		security.Argon2Memory, security.ScryptN, security.PBKDF2Iterations = m, n, i
+		if err := security.SetDefaultHashMethod(method); err != nil {
+			t.Fatal(err)
+		}
+	}(security.Argon2Memory, security.ScryptN, security.PBKDF2Iterations,
+		security.GetDefaultHashMethod())
+
+	const target = 50 * time.Millisecond
+	for _, method := range []security.HashMethod{
+		security.HashBCrypt, security.HashArgon2id, security.HashScrypt, security.HashPBKDF2,
+	} {
+		params, err := security.CalibrateHashParams(method, target)
+		if err != nil {
+			// The test machine may be too slow for even the cheapest
+			// parameters to meet the target.
+			t.Logf("%s: %v", method, err)
+			continue
+		}
+		if params.Method != method || params.Latency > target {
+			t.Errorf("%s: unexpected parameters %s", method, params)
+		}
+		if err := params.Apply(); err != nil {
+			t.Fatalf("%s: %v", method, err)
+		}
+		if m := security.GetDefaultHashMethod(); m != method {
+			t.Errorf("expected default method %s, got %s", method, m)
+		}
+		hash, err := security.HashPassword("hunter2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if security.NeedsRehash(hash) {
+			t.Errorf("%s: expected hash %s to match the calibrated parameters", method, hash)
+		}
+	}
+
+	if _, err := security.CalibrateHashParams(
+		security.HashBCrypt, time.Nanosecond,
+	); !testutils.IsError(err, "more than the target of 1ns") {
+		t.Errorf("expected error, got %v", err)
+	}
+	if _, err := security.CalibrateHashParams(
+		security.HashSCRAMSHA256, time.Second,
+	); !testutils.IsError(err, "cannot calibrate") {
+		t.Errorf("expected error, got %v", err)
+	}
+	invalid := security.HashParams{Method: security.HashBCrypt, Cost: 3}
+	if err := invalid.Apply(); !testutils.IsError(err, "outside of") {
+		t.Errorf("expected error, got %v", err)
+	}
+}
+
+func TestRecommendedBcryptCost(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	const target = 20 * time.Millisecond
+	cost, err := security.RecommendedBcryptCost(target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cost < bcrypt.MinCost+4 || cost > bcrypt.MaxCost {
+		t.Fatalf("cost %d outside of [%d, %d]", cost, bcrypt.MinCost+4, bcrypt.MaxCost)
+	}
+	// The result is cached.
+	start := timeutil.Now()
+	if cached, err := security.RecommendedBcryptCost(target); err != nil || cached != cost {
+		t.Errorf("expected cached cost %d, got %d (%v)", cost, cached, err)
+	}
+	if d := timeutil.Since(start); d > target {
+		t.Errorf("expected the cached cost to be returned immediately, took %s", d)
+	}
+
+	// Each cost is timed about once, so that the calibration takes a few
+	// times the target.
+	if !util.RaceEnabled {
+		const target = 50 * time.Millisecond
+		start := timeutil.Now()
+		if _, err := security.RecommendedBcryptCost(target); err != nil {
+			t.Fatal(err)
+		}
+		if d := timeutil.Since(start); d > 8*target {
+			t.Errorf("expected the calibration to take a few times the target of %s, took %s",
+				target, d)
+		}
+	}
+
+	if _, err := security.RecommendedBcryptCost(0); !testutils.IsError(err, "invalid target") {
+		t.Errorf("expected error, got %v", err)
+	}
+
+	defer security.TestingSetBcryptCost(4)()
+	defer envutil.ClearEnvCache()
+	defer func() {
+		if err := os.Unsetenv("COCKROACH_BCRYPT_TARGET_LATENCY"); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if err := os.Setenv("COCKROACH_BCRYPT_TARGET_LATENCY", target.String()); err != nil {
+		t.Fatal(err)
+	}
+	envutil.ClearEnvCache()
+	if err := security.InitBcryptCostFromEnv(); err != nil {
+		t.Fatal(err)
+	}
+	if c := security.GetBcryptCost(); c != cost {
+		t.Errorf("expected bcrypt cost %d, got %d", cost, c)
+	}
+}
+
+func TestBenchmarkHashCosts(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	for _, tc := range []struct {
+		minCost, maxCost, samples int
+		expected                  string
+	}{
+		{3, 5, 1, "invalid bcrypt cost range"},
+		{5, 4, 1, "invalid bcrypt cost range"},
+		{4, 32, 1, "invalid bcrypt cost range"},
+		{4, 5, 0, "invalid number of samples"},
+	} {
+		if _, err := security.BenchmarkHashCosts(
+			tc.minCost, tc.maxCost, tc.samples,
+		); !testutils.IsError(err, tc.expected) {
+			t.Errorf("%+v: expected %q, got %v", tc, tc.expected, err)
+		}
+	}
+
+	results, err := security.BenchmarkHashCosts(4, 6, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 6 {
+		t.Fatalf("expected 6 samples, got %+v", results)
+	}
+	for i, r := range results {
+		preHash := security.PreHashLegacy
+		if i%2 == 1 {
+			preHash = security.PreHashV2
+		}
+		if r.Cost != 4+i/2 || r.PreHash != preHash || r.Samples != 3 ||
+			r.Median <= 0 || r.Median > r.P95 {
+			t.Errorf("unexpected sample %+v", r)
+		}
+	}
+	encoded, err := json.Marshal(results[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := fmt.Sprintf(`{"cost":4,"prehash":"legacy","samples":3,"median":%d,"p95":%d}`,
+		results[0].Median, results[0].P95)
+	if string(encoded) != expected {
+		t.Errorf("expected %s, got %s", expected, encoded)
+	}
+
+	// The expensive costs are skipped once the budget is exhausted.
+	defer security.TestingSetHashCostBenchmarkBudget(100 * time.Millisecond)()
+	start := timeutil.Now()
+	results, err = security.BenchmarkHashCosts(4, 31, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := timeutil.Since(start); d > 10*time.Second {
+		t.Errorf("expected the budget to bound the benchmark, took %s", d)
+	}
+	if len(results)%2 != 0 || len(results) == 0 || results[len(results)-1].Cost == 31 {
+		t.Errorf("expected the most expensive costs to be skipped, got %+v", results)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+//go:generate go run gen_common_passwords.go
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+// ErrPasswordTooCommon is the cause of the errors returned for passwords
+// found in the list of common passwords when the password policy rejects
+// them.
+var ErrPasswordTooCommon = errors.New("password is too common")
+
+// ErrCommonPassword is the former name of ErrPasswordTooCommon.
+var ErrCommonPassword = ErrPasswordTooCommon
+
+// CommonPasswordError is returned for passwords found in the list of common
+// passwords when the password policy rejects them. Its cause is
+// ErrPasswordTooCommon.
+type CommonPasswordError struct {
+	// Rank is the rank of the password in the list, from 1 for the most
+	// common one.
+	Rank int
+}
+
+func (e *CommonPasswordError) Error() string {
+	return ErrPasswordTooCommon.Error()
+}
+
+// Cause implements the causer interface.
+func (e *CommonPasswordError) Cause() error {
+	return ErrPasswordTooCommon
+}
+
+// commonPasswords maps the normalized common passwords to their rank, from 1
+// for the most common one. It is loaded from commonPasswordsGzip the first
+// time it is used, and extended by LoadCommonPasswordsFile, whose passwords
+// rank after the embedded ones.
+var commonPasswords struct {
+	once sync.Once
+	syncutil.RWMutex
+	ranks map[string]int
+}
+
+// normalizeCommonPassword returns the key of a password in the set of common
+// passwords: its NFKC normalization, lowercased, so that e.g. "PASSWORD" and
+// "ｐａｓｓｗｏｒｄ" match "password".
+func normalizeCommonPassword(password []byte) []byte {
+	return bytes.ToLower(norm.NFKC.Bytes(password))
+}
+
+// readCommonPasswords calls add with each password of a newline-separated
+// list, skipping empty lines and lines starting with "#".
+func readCommonPasswords(r io.Reader, add func(password []byte)) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		add(normalizeCommonPassword(line))
+	}
+	return scanner.Err()
+}
+
+// loadedCommonPasswords returns the ranks of the common passwords, loading
+// the embedded list if needed. The caller must hold the lock of
+// commonPasswords.
+func loadedCommonPasswords() map[string]int {
+	c := &commonPasswords
+	c.once.Do(func() {
+		ranks := make(map[string]int, commonPasswordCount)
+		gz, err := gzip.NewReader(strings.NewReader(commonPasswordsGzip))
+		if err == nil {
+			err = readCommonPasswords(gz, func(password []byte) {
+				addCommonPassword(ranks, string(password))
+			})
+		}
+		if err != nil {
+			// The list is generated and covered by tests.
+			panic(errors.Wrap(err, "loading the common passwords"))
+		}
+		c.ranks = ranks
+	})
+	return c.ranks
+}
+
+// addCommonPassword adds a normalized password to the ranks of the common
+// passwords, unless it is already in them.
+func addCommonPassword(ranks map[string]int, password string) {
+	if _, ok := ranks[password]; !ok {
+		ranks[password] = len(ranks) + 1
+	}
+}
+
+// commonPasswordRank returns the rank of a normalized password in the list
+// of common passwords, or 0 if it is not in it.
+func commonPasswordRank(key string) int {
+	c := &commonPasswords
+	c.RLock()
+	defer c.RUnlock()
+	return loadedCommonPasswords()[key]
+}
+
+// IsCommonPassword returns whether the password is in the list of common
+// passwords, e.g. "123456" or "qwerty", compared case-insensitively and
+// after Unicode NFKC normalization. The embedded list, generated from
+// common_passwords.txt, can be extended with LoadCommonPasswordsFile.
+//
+// The embedded list holds the 1058 most common passwords, rather than the
+// top 10,000 of breach corpora, so that it stays small enough to be
+// reviewed entry by entry and to keep in memory. The long tail is
+// covered by the strength estimate of PasswordPolicy, which ranks passwords
+// of dictionary words and keyboard patterns low, and by the Pwned Passwords
+// corpus (see PasswordPolicy.PwnedPasswords). Deployments wanting a longer
+// list, e.g. the top 10,000 or 100,000 passwords of a corpus they trust,
+// can load it with LoadCommonPasswordsFile.
+//
+// Lookups are a map access, which is negligible next to hashing the
+// password.
+func IsCommonPassword(password string) bool {
+	return isCommonPassword([]byte(password))
+}
+
+func isCommonPassword(password []byte) bool {
+	return commonPasswordRankOf(password) > 0
+}
+
+// commonPasswordRankOf returns the rank of a password in the list of common
+// passwords, or 0 if it is not in it.
+func commonPasswordRankOf(password []byte) int {
+	key := normalizeCommonPassword(password)
+	rank := commonPasswordRank(string(key))
+	for i := range key {
+		key[i] = 0
+	}
+	return rank
+}
+
+// LoadCommonPasswordsFile adds the passwords listed in a file, one per line,
+// to the list of common passwords. Empty lines and lines starting with "#"
+// are ignored. Nothing is added if the file cannot be read.
+func LoadCommonPasswordsFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "loading common passwords")
+	}
+	defer f.Close()
+	var passwords []string
+	if err := readCommonPasswords(f, func(password []byte) {
+		passwords = append(passwords, string(password))
+	}); err != nil {
+		return errors.Wrapf(err, "loading common passwords from %s", path)
+	}
+	c := &commonPasswords
+	c.Lock()
+	defer c.Unlock()
+	ranks := loadedCommonPasswords()
+	for _, password := range passwords {
+		addCommonPassword(ranks, password)
+	}
+	return nil
+}
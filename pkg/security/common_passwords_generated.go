@@ -0,0 +1,220 @@
+// Code generated by gen_common_passwords.go; DO NOT EDIT.
+// GENERATED FILE DO NOT EDIT
+
+package security
+
+// commonPasswordCount is the number of entries of commonPasswordsGzip.
+const commonPasswordCount = 1058
+
+// commonPasswordsGzip is the gzipped, newline-separated list of common
+// passwords from common_passwords.txt.
+const commonPasswordsGzip = "" +
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x79\xcd\x72\xc3\xbc\xae\xe4\x1e\xcf" +
+	"\x71\x1e\x20\xf2\x4f\x1c\xef\x66\xaa\x66\x3f\xa7\xe6\xd4\xd4\x5d\x43\x24\x24\x31" +
+	"\x22\x09\x05\xa4\x6c\x2b\x4f\x7f\xab\x29\x39\xdf\x95\x2a\x89\x1d\xdb\x32\x05\x34" +
+	"\xba\x1b\x60\x77\x3a\x5f\xae\x9f\xb4\x70\x29\x4f\x35\x4f\xfb\xf3\xdb\x17\xfd\x3c" +
+	"\xc5\xea\xf6\xf7\xfc\xbe\x3f\x6a\xbf\xa9\x6b\xc7\xfb\x35\xf2\xc6\xa3\x66\x3c\xed" +
+	"\x4e\x67\xea\xb9\x48\xcf\x31\x12\xf7\x0e\xcf\x07\xd5\xda\x9e\x27\xcd\xb3\x6c\x14" +
+	"\xa5\x26\x09\x99\x3e\xef\x38\xa9\x4c\xec\xf5\x49\x89\x4b\x15\xa3\xcf\x76\x1c\x5f" +
+	"\xbe\x06\x5d\x70\xd5\xf3\xa9\xa3\xb4\x96\xca\x79\xfc\x67\x3d\x1f\x94\x82\x9b\x58" +
+	"\x22\x7d\x5e\x2f\x78\xc7\xb2\x96\xb2\x51\x59\x17\xb1\xc4\x99\xba\x1f\xfe\x3d\x3d" +
+	"\xcb\x8b\x6e\xfb\x41\xc3\xea\xe6\x4d\x57\xea\x4e\x38\xe9\xa3\x1d\xf4\xc3\xbf\x78" +
+	"\x53\x77\x3a\xff\x3c\x85\xe6\x10\xa3\x18\x55\x5b\x4b\xcd\xda\xd1\xb7\x9a\xe7\x4c" +
+	"\xdf\x92\x73\x18\xc4\xe8\xf7\xe5\x1e\x7d\x4e\xc4\xc5\x0f\xe3\x44\xd3\x9a\xb1\xe8" +
+	"\x7e\x6d\x6b\x2f\xea\x9c\x18\x4d\x6c\x51\x36\xea\xb9\x62\x19\x9c\xbd\xc9\x93\x6a" +
+	"\x18\x47\xbc\x65\xcd\x65\x0a\x59\x28\x44\x7d\x08\x56\x83\x55\x25\xa1\x13\xd6\xe2" +
+	"\xf0\xd1\x20\x64\xda\x8b\x55\xaa\x93\x26\x2e\x34\xa9\x43\xd8\x8c\x33\xae\xe0\x39" +
+	"\x07\x89\x54\x2a\xdb\x93\xad\xd0\x1c\xf7\xc0\x75\xdd\xe9\x74\x3e\xd3\x28\x6a\xa3" +
+	"\x10\x97\x32\x69\x14\x72\x9a\x96\x15\x2f\x23\x56\x12\xa3\xd0\xb7\x94\x12\x1c\xd3" +
+	"\x22\xcb\xd2\x3e\xd6\x75\xc7\x5d\xd1\xb5\x1d\x47\x72\xbb\x8e\xba\x33\x4e\x1a\x4c" +
+	"\xc4\x6b\x3a\xe2\xd8\x90\xd2\x62\x49\x89\xc7\x31\x08\x75\xd7\xfb\xed\x7a\x26\x6e" +
+	"\x07\x8d\xa1\x2d\x73\xb1\x90\x9d\x94\x42\xdf\x5a\xa6\x95\xc9\x4d\x22\x45\x88\x13" +
+	"\x67\xcf\x54\xd6\x94\xc4\x08\x31\x20\x2e\x13\xc2\xd5\xb0\x90\x83\x6b\xab\x9e\x24" +
+	"\x16\x61\xea\x43\x95\x24\x94\xb8\xd6\x49\x9e\xc4\xae\x5d\x71\xe3\x3c\x8b\x14\xba" +
+	"\x7f\xdd\x8e\xcc\x7b\x8e\x91\x0b\xf1\x5a\x6a\xc8\x54\xa7\x35\x7b\x24\x91\xb7\xa8" +
+	"\x86\x4f\x5b\x78\xd1\x33\xc4\x18\x38\x91\x53\x7b\x48\xad\x42\x93\xc4\xa8\x94\xd8" +
+	"\xf0\x99\x49\xb8\x4e\xc8\x8f\x38\x93\xda\x6e\x0f\x41\x13\x8b\x21\x93\x0f\x9c\x34" +
+	"\xef\xa5\x01\x60\xd2\x38\x4c\xdf\x73\xa2\x89\xdb\x5d\x94\x10\x1f\x62\x74\x6a\x07" +
+	"\x7d\x1d\x07\x71\xae\x93\xe6\x8d\xbe\x8f\x55\x49\xa9\xd4\x73\xc0\xbd\xfe\x74\xcf" +
+	"\x93\x9c\xed\x52\xaf\xb4\x60\x75\x6e\xa6\x00\x20\x65\xa9\x54\x9c\x2a\x12\xa6\x2d" +
+	"\xdf\x7b\x32\x68\xd4\x08\xf4\x39\xd5\x19\xf0\x00\xee\xcd\x53\x41\x34\xeb\x84\x38" +
+	"\x8d\x5e\x47\x1a\xd7\x50\xd9\xe8\x9b\xdd\x5c\x34\xd3\x73\xe2\x2a\x58\x59\x0a\x0d" +
+	"\x40\x6e\xc2\xdf\x4c\x65\x61\x9b\x37\x2a\x59\x75\xd9\x28\xf1\x43\xda\x0a\x96\x49" +
+	"\x25\x87\x17\x39\x4e\x6c\x4a\x45\x5e\x1b\x2d\xc2\x79\xad\x94\xd4\x46\xce\xf4\x94" +
+	"\xe8\x34\x09\x0d\x1c\x9d\x66\x72\xfa\xec\x75\xa3\x41\xcc\xd8\x02\x56\x53\xd6\x3c" +
+	"\xee\x80\x67\x2a\x49\xf1\xa5\xa5\x8a\x44\xb1\x86\x03\x59\x26\x84\xd4\x89\x97\x42" +
+	"\x9e\x67\xad\x4c\x6c\x45\x32\x47\x12\x1e\xa3\x14\x4a\x12\x43\x29\x4c\xbd\x2a\x42" +
+	"\xdb\xab\xf6\xaa\x54\x96\x80\x84\x66\x2e\x8e\x8d\x92\xe6\x86\xf8\x1a\x46\x5c\x78" +
+	"\x43\x1e\x9f\xf4\x6a\xc7\xc1\x41\xa0\x9d\x91\xab\x3c\x19\x37\x68\x21\x33\x92\xd8" +
+	"\x47\xa5\x7e\x8d\x11\xb1\x42\x1e\x91\xcf\x56\x21\xfc\x43\xcb\x6a\x4b\x14\xd4\xae" +
+	"\x77\x6a\x42\x3d\x67\xce\x4c\xdf\x6b\x0e\x8a\x92\xce\x99\x27\x5c\xfc\xf3\x7a\xa1" +
+	"\x45\xad\xb8\x49\x28\xf2\x8c\x05\x04\x27\x28\xf4\xa4\x19\x41\x6e\x41\x79\x83\x93" +
+	"\xa2\x66\xaf\x99\x2a\xf8\xa3\xd0\xbd\x1d\x94\x9d\xeb\x6e\x1f\x1d\x39\x1d\x06\x91" +
+	"\x96\xf1\x7e\x6b\x7c\x44\x69\xe7\x9f\x5e\x4b\xd5\xfc\x87\x93\x86\x47\x1d\x06\xea" +
+	"\x8d\xdb\xf5\x36\x4e\x3c\xb5\xa2\x6a\x91\x48\xda\xc0\x3b\xa8\xb5\x7c\x7f\xeb\x94" +
+	"\xf3\x46\xe2\x9f\x6c\x9e\xce\xed\x20\x8d\x01\xaf\x99\xf8\xa2\x2f\x5a\x22\x6f\x88" +
+	"\x68\x98\x43\x65\x9a\x73\x18\xa7\x4a\x83\xb4\xba\xe9\xd9\x70\x27\x29\xf8\xfd\xdf" +
+	"\x4b\x14\x2e\xb2\x7f\xf7\x8e\x22\x1e\x95\x7a\xf6\xc8\x7e\x78\x72\xae\x2b\x95\xfd" +
+	"\x7a\x0d\xb3\x56\x08\xf8\x44\x3a\xf1\x34\xd2\x10\x15\x55\x03\x94\xb2\xf7\x60\xb2" +
+	"\xbe\x0f\x95\x9e\xe1\x17\xeb\xc3\xbf\x83\x9b\xe9\x9b\x0b\x08\x49\x50\x08\x64\x0c" +
+	"\xba\x22\x37\x59\x28\x54\x00\xe3\x4c\xcf\x90\xb3\x18\xb1\x0f\x9e\x0b\x3d\x82\xab" +
+	"\x6a\x81\x29\x73\xe5\x32\x31\x75\x3f\xa7\xe7\x59\x2e\xc0\x7f\x49\xa0\xd8\x67\xab" +
+	"\xa9\x83\x89\x68\xe1\x5c\x03\x10\xc6\x86\x17\xc7\xa9\xf7\x35\xd3\x10\x40\xc7\x23" +
+	"\x39\x75\xec\x34\x32\xb9\x7d\x15\xdf\x9c\xa4\xd0\xe9\x8c\x93\x8c\x01\xbf\x72\x94" +
+	"\x36\xae\x10\x7b\x35\xa5\x11\x5c\x16\x87\x26\x06\xf8\x21\x67\x5b\xa9\x1c\xe9\x8f" +
+	"\x98\x80\xb0\xf6\xa0\xc8\xab\xc8\x0b\x75\xec\x25\x53\x1f\xf5\x99\x04\x65\x5b\x43" +
+	"\x2d\xf4\xf5\x79\xbb\x9e\x3f\xee\x6d\x89\xc8\x63\xe4\xd5\x04\xca\x31\x4a\x44\x71" +
+	"\x57\x37\xa1\x68\xf3\xbc\x51\x9d\x5e\x5d\x77\xfe\xda\x5f\xc3\xcd\xf8\xd0\x4a\x3d" +
+	"\xe4\x06\x98\x02\xa0\x6a\xa6\x14\x66\xa1\xaa\x1b\x2a\x0c\x5f\xf6\xad\xfd\x21\x65" +
+	"\xa7\x33\x39\xce\x0c\x0e\xd6\x65\x0a\x42\xff\x7e\x0b\x3f\x2f\x0b\x52\xd6\x72\xd1" +
+	"\x4a\x8b\x8c\x7f\x7f\x81\x79\xee\x1d\x2d\x3a\x4b\x02\x24\xf9\xf7\x55\x9e\xbb\x54" +
+	"\xd0\xcf\x93\xcb\xef\x8b\xd2\x3a\x0c\x21\x37\xd8\x61\x31\x69\xb5\x65\x42\x25\x68" +
+	"\x8b\xa4\x66\xae\x13\x67\x6a\xf8\x5b\x54\xc9\xf3\x23\xf8\x43\xc7\x62\x93\x8f\xf3" +
+	"\xf5\xd6\x58\x0b\x62\x72\xbf\x7f\x1c\x1a\xcf\x74\xfb\xba\xc3\x9b\xd4\xd5\x2a\x6a" +
+	"\x53\x2d\x6f\x30\x15\x1e\xaf\xa3\x6c\x6c\x09\xfb\x92\x9e\xe5\x25\xde\x51\xf7\x81" +
+	"\x93\xfa\xb5\x22\xf1\x8e\x2d\x6a\xf9\x73\x36\x1d\xf9\xbd\x0a\x4b\x0c\xcb\x9c\xb5" +
+	"\x1e\x26\x03\x5c\xd1\xab\xe2\x86\x5b\x12\xbb\xfb\xbd\xa3\x3e\xb2\x9b\x9b\xc8\x56" +
+	"\x93\xb9\x2d\x08\x3f\x20\x47\x31\xcd\x94\xe5\xb9\xa9\xcd\x80\x46\xee\xf5\x49\xc7" +
+	"\x3d\x22\x04\xb8\x85\x13\x19\x44\xbb\xd2\x23\xcc\x80\x17\xaa\x6e\x0e\xb9\xb4\xa5" +
+	"\x4d\xc2\xfe\x30\x10\xdf\x73\x6c\x76\x84\x4a\x00\x8b\x82\x6f\xdd\x24\x85\x46\x49" +
+	"\x21\x07\xf2\x0a\x8c\x43\xba\x10\xd7\x02\x66\x65\xe8\xd6\x92\xe4\xcf\x21\x1d\x85" +
+	"\x80\x22\xb3\xe0\x99\xbc\xc6\x65\x0a\x99\x96\x5d\x2b\x1c\x2f\x95\x21\x8a\xbb\xa2" +
+	"\xf5\x71\x95\xbf\x4c\x44\xaa\x53\xa3\xae\x9e\xb3\x0f\xf5\xfd\xd1\x06\x2b\x70\xe4" +
+	"\xc2\xf8\x4c\xa1\x6f\x1e\x57\xde\xd5\xda\x0a\xe5\xe0\x26\x85\xdc\xae\x39\x54\xf1" +
+	"\x54\xc3\x30\x70\x06\xcf\xbe\x9e\x12\x23\xfd\xb6\x83\x72\xb0\x47\x63\x50\x31\x49" +
+	"\xb0\x65\x6e\x0e\x95\x4a\x5d\x97\xe0\x69\x51\x6b\x84\x09\x23\x22\x51\x96\x89\x73" +
+	"\xa5\x31\x70\xae\xf8\x36\x37\xc3\x62\x4c\x5a\xb1\x08\xd3\x22\xfd\xea\x71\x81\xa6" +
+	"\xfe\x5e\xfa\x3e\x08\x25\x5d\x73\xbb\xb1\x4b\x3b\x0e\xfa\x7f\xbd\xe8\xc9\x66\xa0" +
+	"\xec\x37\x1d\x5c\xeb\x41\xa3\x07\xaa\x7e\x88\x63\x73\x57\x49\x2a\xc7\x18\x1c\x45" +
+	"\xf8\x42\xe2\xdf\x66\x77\xe1\x71\xa8\x4c\xe1\xc8\x52\x94\x17\xf5\x9a\xfd\xc7\xc7" +
+	"\xad\x3d\x09\xe5\x6d\x8f\x9a\xde\x69\xa6\x6b\x77\xfd\x40\x86\x62\x90\x37\x24\xf1" +
+	"\x89\x1c\x84\x46\xae\x6a\x85\x7a\xc9\xdf\x9c\x42\xa6\x87\xaa\x07\xfe\x0d\xf1\x27" +
+	"\x2f\x2f\xa0\xf4\xd4\x75\x27\x50\x16\x04\x95\xe3\x23\x64\xf8\x2d\xb0\x64\x73\xa9" +
+	"\x1d\x39\x13\xae\xe1\x21\xfb\xf2\x99\xca\xe6\xc1\xce\xb6\x96\xa9\x7d\xb4\xbb\x7f" +
+	"\xdd\xff\xd0\x44\x26\x28\x0e\xea\xd7\xbe\x67\xba\x7c\x75\xd7\xee\xf3\x74\xbe\x9c" +
+	"\xf6\x22\xf8\x30\x4f\xd5\x74\xed\xa3\xd0\xb8\x36\x32\x9d\x78\x59\xb6\x26\x2f\x80" +
+	"\xe9\xa8\x06\x75\x89\x32\x4a\xf6\xbb\x53\x14\xb0\x6f\x8c\xbc\xe3\x8d\x80\x4b\x49" +
+	"\xc4\x56\xa7\xd5\x76\xca\xa0\x0c\xc5\xce\x4d\x59\x11\x38\xea\x85\x8d\x50\x27\xc8" +
+	"\x2e\x5c\xf8\xab\x3c\x4f\x94\xb5\x36\xa6\x85\xf9\x10\xa3\x96\x34\x93\x5e\x9c\x6b" +
+	"\xd7\x86\xf9\x1e\xd9\x86\x20\xd1\x13\xca\xf8\xf4\xd1\x75\xb8\xd4\x23\x94\xa3\x53" +
+	"\xf8\xbc\x37\x6c\xe0\x66\xb9\x78\xf2\xe2\x24\xf5\x87\xf1\xa2\xee\xe3\xf4\x71\xfe" +
+	"\xa0\xd3\x15\xe7\x61\x86\x2f\x17\x58\xd4\xe0\x88\x17\x85\xd7\x2b\x73\xc0\xdd\x9e" +
+	"\xbb\xeb\xe5\x76\xa5\x31\x58\x2c\x34\x87\x5a\x25\x83\x98\x07\x72\xda\x2c\x71\x6f" +
+	"\xfc\x90\x42\x65\x92\xd8\x6f\x34\xaa\xff\x0d\x08\x00\xd6\x02\x91\x35\x60\x5e\x93" +
+	"\xe3\x4a\xbc\x8e\x2b\x5c\xdd\x8a\x7c\x71\xb0\x5e\x2d\x37\x0a\x3b\x53\x77\xff\xfa" +
+	"\xa2\x18\x06\x99\xb0\xe4\x9f\x76\x50\x6f\xaa\x73\xdc\x40\xed\x21\x71\x84\x0a\xd7" +
+	"\x90\xd7\x44\xad\x00\x34\x91\xe6\x08\x71\x7a\xf1\x23\x00\x21\x6c\x73\x06\xe6\xfb" +
+	"\x18\xf2\xdc\x7d\x9d\x68\x69\x42\x0a\xd1\xa2\xd1\x44\xf2\xc1\x90\x48\xf9\x43\x37" +
+	"\x06\x87\x2d\x1a\x83\x13\xaa\xd6\x22\xd7\x9d\x0e\x8e\x9e\xb0\xfa\x0c\xd3\xf7\x6c" +
+	"\xbd\x58\x2b\xe6\x46\xa6\x32\x34\xeb\xf1\x41\x0b\xcf\x01\x3d\x16\x7d\x7c\xdc\x80" +
+	"\xf7\x27\x47\x00\x14\x56\x01\x4a\xdf\x47\x94\x08\x39\x98\x45\xf8\xd4\x1c\x10\xac" +
+	"\xa9\xb9\xd5\x42\x7e\xef\xed\x50\x08\xfa\x6c\x54\x91\x84\x0a\xd7\xd5\x60\xc8\x05" +
+	"\x74\x08\x70\x3e\x43\x1e\x0b\x84\x0f\x57\x5c\xd6\xb4\xcc\xe0\x26\x0b\x39\xd4\xed" +
+	"\x6d\xd1\x0b\x35\x55\xcc\x90\xef\xec\x95\x7c\x18\x03\x54\xd5\x0b\x8c\xf4\x46\x55" +
+	"\x97\x71\xcd\x64\x3b\x82\x13\x1b\x8a\x66\x5c\xe1\x0e\x4a\xb3\x1e\xd9\x49\xab\x00" +
+	"\xc8\x59\x6d\x1f\x1a\x69\x08\x26\x94\xb1\x2c\x40\x06\x28\x76\xc6\x43\x05\x98\x06" +
+	"\x54\x16\x45\x2e\x0f\x19\xb9\x50\x11\x1b\xd1\xbe\x99\x66\xa7\x85\x1c\x5b\xeb\xe3" +
+	"\x16\x0b\x0f\xae\x42\x4e\x62\x0d\x8e\xfa\x60\x3e\x80\x4a\x2b\xa4\xc9\x71\x2b\x95" +
+	"\x9e\xfb\x0d\xb0\x42\x34\x38\x7a\xc0\xb7\x62\x11\xad\x8d\x82\xb0\x36\x52\x19\x38" +
+	"\x85\xb8\x1d\x6d\x68\x77\xa2\xe2\x26\xd5\x48\x51\xd7\x50\x50\x04\xbd\xa1\xc1\x13" +
+	"\x17\xc3\x52\x84\x86\xb8\x0e\xc3\x46\xdd\xe5\x76\xba\x7e\x9d\x3f\xef\x14\x35\x22" +
+	"\xdf\xf2\x5a\xa2\x1a\xe0\x2a\x30\x72\xd2\x24\x62\x88\x1b\x08\xbb\x2c\x92\xd1\x89" +
+	"\x16\xa7\xb5\xb6\x64\xc4\x8d\xc6\xd0\xe3\x2d\x5e\x5b\xd3\xe6\x26\x31\xdb\x76\xb3" +
+	"\x8e\x5e\x00\x49\x05\x57\xad\xc3\xc0\x51\x9b\x15\x11\xe3\x3f\x92\xc4\xa3\x56\x6c" +
+	"\x08\x87\xd8\x5b\x7d\x68\x11\x40\x04\xcc\xc8\xd9\x37\xae\x78\xc8\x11\x3a\x5a\xd8" +
+	"\xd8\xb7\x5b\x52\xf4\xb3\xcd\x33\x80\x35\x57\x89\xe0\xfe\x0a\x75\x48\xf2\x0a\x0e" +
+	"\x94\x28\x9c\x4a\x6b\xe8\xc3\xc8\x68\x2d\xdc\x8c\xc8\x6b\x84\x75\xdf\x1b\x3e\x1a" +
+	"\x2c\x48\xf6\x50\xa7\x11\x35\x53\x56\x43\x4f\xb4\xa8\xc2\x54\x24\x7e\x85\xb4\x42" +
+	"\x39\x73\x58\x0b\xcc\x47\xa4\x07\xa7\x05\x69\x8f\xec\x4c\x0b\xba\xcf\xd2\xd8\xb1" +
+	"\xf5\xa9\xcd\x5a\xa2\x31\x9b\x03\x6e\x2c\x6e\x54\x16\x11\xbf\x61\x1e\x01\x85\x77" +
+	"\x6c\x49\x32\xd8\x1e\xf4\x32\xef\x6f\x66\xac\x3f\xc1\xc1\x3a\x70\x99\xae\x5f\xc8" +
+	"\x1f\xf7\xad\xc3\x98\xd4\x8a\x14\xfa\xf8\xa7\x2f\x3d\x0c\x06\x2d\x21\x2d\x21\x37" +
+	"\x78\xc0\x53\x44\x28\xb1\xe4\x30\x26\x46\x5e\xbb\xcb\x0d\xff\x6d\xb7\x32\x35\xf2" +
+	"\x44\xdb\x03\xaf\xba\x27\x9d\x4a\x48\xe0\xd9\x1e\x24\xe6\x66\x14\xf5\xf9\x72\xfd" +
+	"\x81\x0f\x75\x6b\xa1\xde\x02\xa6\x1d\xf7\xaf\x1b\xb2\xc2\xc5\xff\xbe\x1c\x79\xd3" +
+	"\x67\x29\xbc\xd0\xc4\x38\xdf\xb1\x04\x44\xad\x67\x63\x98\x30\xa1\x47\x2b\x61\x6f" +
+	"\x7b\x37\xce\xae\xc2\x4e\x09\xbc\x24\xe6\x33\xcd\x78\x36\x33\x92\xa5\x84\x02\xe3" +
+	"\x11\xb5\x83\xc8\xd5\xba\x1d\xd9\x68\x3d\x47\xa9\x60\xec\xd3\xc7\x99\x26\xad\xa6" +
+	"\x9e\x46\xd5\x31\x0a\x3d\x18\x15\xc9\x68\xdf\x6a\xab\xbf\x9e\x3d\x28\x2a\xb1\x6d" +
+	"\xdf\x9c\x05\xd7\xf0\xbc\x11\x47\x2e\x33\xe3\xb6\x70\xfe\xb4\x9a\x85\x73\x3c\xfe" +
+	"\xf0\x8c\x89\x00\x72\xa9\x58\x54\x44\xed\x5c\x6e\x5f\xd7\xdd\x64\xd1\xc4\x4f\x0e" +
+	"\x01\xfd\x08\x5c\x83\x9b\xe4\xb1\xd1\xe5\xf4\x71\x39\x7d\xd0\x73\x0f\x75\xa9\xb2" +
+	"\x4c\x92\xf7\x1e\xb3\x6b\x97\x40\xbc\x3e\x09\xf6\x0a\xc8\x1c\x4d\x46\xb5\x8d\xca" +
+	"\x43\x6a\x04\x2c\x17\x4e\xb0\xde\xdd\xfd\xeb\x82\xc1\x53\x70\x54\x26\x85\x3d\x78" +
+	"\x4a\xa9\x25\x34\xa4\x73\x46\x2b\xef\x83\x14\x34\x2a\xba\x5a\x85\x2e\x9f\x2e\x38" +
+	"\x69\x16\x90\x12\x6c\x8e\xd2\x14\x1a\x81\x20\xa9\x00\xaf\xcd\x47\x02\x01\x0e\x70" +
+	"\x22\x5c\xce\x60\x9c\x67\xe4\x0f\xf8\x5c\x74\x71\x30\x48\xfb\x6c\x20\xf0\x31\x5a" +
+	"\x61\x2c\xe8\xf3\x4e\x55\x4c\x0a\x53\xd2\x5f\xb6\x5d\x7c\x26\x46\x21\x8b\xa1\xbe" +
+	"\x17\x5e\x23\x61\xf5\x39\xc8\xc1\xef\x23\xc6\x1a\xab\x81\x62\x9b\xd7\xe9\x28\xee" +
+	"\xad\x97\x97\x8c\xfa\x6c\xfd\x21\xff\x8d\xf7\x6e\x5f\x77\xb2\xb5\x44\xce\xb8\x4b" +
+	"\x43\x1f\xdd\x20\x58\x34\x17\x42\x07\x3e\xb0\x93\x3d\x98\x87\xa7\xba\x7d\xdd\x19" +
+	"\x63\x97\x04\x38\xa1\xd1\x6c\x6d\x99\xad\x55\xf7\x37\xec\xd5\x30\x4a\x16\xe3\x88" +
+	"\xae\xd5\x74\x16\x21\x3e\x66\x8f\x0f\x34\x68\xb9\xd2\xff\x87\x49\x84\x3a\xfd\x76" +
+	"\x54\xe0\x5f\xb7\x03\xd1\xe4\xd6\x54\x26\xad\x10\x5f\xa0\x86\x33\xac\xad\xd7\x75" +
+	"\x84\x0f\xf5\x98\xdd\x20\xa1\x1f\xf4\x1e\xaa\x41\xd5\x37\x9a\x05\x58\x79\xc0\xb0" +
+	"\x83\xc1\xca\xfa\xbb\xce\x81\x8a\x60\x99\x43\xa0\x45\x32\x54\xa3\x8d\x24\x56\xdb" +
+	"\x28\x06\xf8\x42\xf0\x40\x30\x58\x55\xa7\x00\x83\x87\xb7\x67\xb8\x3b\xf4\x7d\xb2" +
+	"\x1d\x96\xbe\x50\xd9\x4a\x15\xd0\x10\x98\x93\x9a\xcd\x87\x9f\x44\x0e\x12\x5b\x99" +
+	"\xa0\xb2\xdd\xfd\x76\x3f\x08\xe1\x4d\x06\x27\x9a\x8e\x72\xf3\xef\xc2\xbd\xd2\x63" +
+	"\x98\xfa\x6d\xa0\xc2\xd9\x03\x2a\xc0\x35\xe6\xa4\x05\x5f\x0b\x67\x90\x83\xfe\x8d" +
+	"\xbb\xa8\x05\xb3\xe8\xb0\x0f\x55\xe1\xec\x50\xb6\x29\xf7\x0f\xf7\xfa\xc5\xf4\xb5" +
+	"\x22\xb7\xf8\xff\xa2\x56\x5b\xdb\x04\xc3\x88\xe1\x49\xa4\x49\xdb\x50\xa0\xc5\x10" +
+	"\xb3\xad\x3e\x8c\x30\x34\x5c\x0a\xbe\xf8\x4d\xe4\xd0\x75\xac\xd1\x04\xe4\x40\xdf" +
+	"\xeb\x12\x50\x8a\x2e\xf2\xea\x03\x1f\x83\xc2\xeb\x95\xba\x0b\x4e\xfa\xe5\x9f\xee" +
+	"\x84\x29\x6a\xf3\x7f\x0b\xef\xbc\xe1\xd6\x5c\x8f\xde\x99\x42\x1e\x76\x8d\x6f\x82" +
+	"\x43\x07\x20\x18\x0a\x46\x4e\x23\x1c\x27\x30\x57\x02\xd3\xcc\x4f\x2e\x3c\x07\xea" +
+	"\x43\x99\x74\x79\x8f\x6f\x6f\xf4\x88\xec\x43\x0a\x6d\x00\x24\x5b\xd7\xc0\xb0\x16" +
+	"\x31\xf8\x06\x0f\x3b\x86\x9b\x72\x01\x2d\x6c\x41\x29\xf6\xab\x8f\x6d\x80\xd1\x5b" +
+	"\xa8\x15\x2d\x4a\x77\xbf\x5f\x8e\xb9\xef\xc7\x07\x95\xa7\x48\x25\x9d\x0b\x2a\x7e" +
+	"\x52\xcc\x25\xbd\xa6\x90\xff\x86\x44\xe0\xd6\xb5\xae\x85\x5a\xff\x38\x84\x42\x59" +
+	"\xed\x18\xf5\x80\xb7\xbe\x43\x4a\x1b\x05\xd3\x8c\x7f\x0e\x68\xa2\x07\xce\x95\xcb" +
+	"\xd6\x26\x3d\xf4\x96\x81\x7f\xff\xef\xff\xfc\xe7\xbf\xfe\xef\xff\xfb\x3f\x34\x09" +
+	"\x7a\x16\xf2\x6b\x76\x4d\xe4\xd6\x91\x8d\xba\xfb\xed\x46\xdf\x32\x0c\x10\xe2\x49" +
+	"\xd7\x22\xe8\x8d\x21\xdf\xcd\x2e\x0a\xd5\x80\xf9\xce\x31\xd9\x3e\xe6\x17\x65\x1f" +
+	"\x2b\x3a\x81\x3b\x02\xa7\x3a\xcd\x59\x6d\x1f\x27\x6a\x2d\x34\xe3\x6d\x4c\xa7\x0f" +
+	"\x9c\x94\x14\x95\x80\xe1\x10\xa6\x04\x35\x64\x40\x96\x63\x23\x17\x83\x64\x3c\xc0" +
+	"\x3a\xba\xa0\x01\xb5\xf8\xcd\x89\xa0\x76\xf3\x46\x99\xd7\x71\xaa\x1b\x72\xa4\xc6" +
+	"\x5e\x8f\xf9\x1a\xd3\x93\x01\x08\x30\x35\x18\xeb\x98\x68\x79\x4a\x5a\xd5\x30\x47" +
+	"\x09\x06\x0a\x3a\xfa\x5c\x2a\x11\x53\x44\xae\x40\xe3\xda\x26\x15\xe8\xb8\x83\xd0" +
+	"\xaf\x26\x74\x73\xec\x1c\xa2\xf7\x08\x0d\xca\xbd\xb1\x07\xb7\x9a\x34\xab\x32\x8b" +
+	"\xa5\x80\xec\xea\x38\x6e\xe4\x57\xc7\x35\x10\x3f\x38\x6a\x6e\xdb\x05\x74\x3f\xdf" +
+	"\xee\xe8\xb9\x0b\x1b\x4f\x54\x38\xa0\x8d\x8c\x3a\x86\x2a\x6e\x22\x37\xed\xb6\xfe" +
+	"\xeb\x7a\x02\xd9\x1c\x4c\x86\x1e\x57\x33\xfa\xd3\xf5\x21\x19\x49\x76\x91\xa1\x51" +
+	"\xdd\xf5\x7e\xbf\x76\xf4\xcb\x6e\x62\xdb\x68\x1b\xf2\x10\x06\x7a\x2a\x66\xbc\x21" +
+	"\xa3\xbd\xdc\x8d\xe3\xae\x86\xcd\x88\x55\xc3\x94\x7a\x01\x99\xd2\x22\x32\x73\xaf" +
+	"\xda\x0c\x27\x60\x21\x2b\x78\x8f\x7a\x13\x00\x6c\x9f\x20\x1e\x0c\x5b\xd0\x2e\x1f" +
+	"\x43\x1f\xc8\xac\xda\x18\x30\x38\x0d\x75\xa2\x32\x6f\xad\x15\xf8\x96\xb2\x16\x12" +
+	"\x10\x76\x0f\xd7\xdd\x26\xa1\x09\xef\x52\xad\x13\x2d\xc1\x40\xcf\xb2\x1b\x1d\xe0" +
+	"\x56\x2a\x61\x4c\x45\x8f\x60\x63\xc8\x81\xe9\xc1\x51\x32\x8c\xce\x52\x36\x37\x29" +
+	"\x2d\x26\x1e\x8d\x29\xb1\x85\x5f\xcd\x4c\xdd\xf9\xf2\x79\xbb\x53\x6a\x72\x8f\x6d" +
+	"\x9b\xb8\x41\xb5\x1f\x32\x4a\x65\x78\x71\xce\xc1\x1d\x7e\x89\x46\x6d\x23\x84\x61" +
+	"\x8b\x53\xfd\x41\x58\x06\x4a\xed\xa0\x39\x18\x84\x35\x64\x0f\x5a\x9b\xc2\x82\xe2" +
+	"\xed\xb9\x35\xb9\xfc\x94\x82\xc9\xf1\x22\x0a\x27\xe3\x31\xbe\x32\x02\x50\x32\x30" +
+	"\xd3\x26\x15\xb7\x4b\xf7\x75\x3d\xdd\x3f\xcf\x7f\x1b\x10\x5d\xb7\x1b\x43\x94\x02" +
+	"\xa7\x9e\xd1\x77\x66\x8d\x1e\x9c\xd9\xed\xdd\x1c\xd4\x5d\x4c\x46\xc6\xc5\x62\x85" +
+	"\x32\x4b\x44\xe3\x83\x36\x60\x44\x5a\x4a\xc0\xd8\x6a\x9f\x06\x34\x7d\xc0\x0f\x0d" +
+	"\x73\x35\x87\x9b\xc0\x50\x08\x9d\x50\x55\xd4\x32\xfa\x82\xf6\x26\x43\x7f\x32\x69" +
+	"\xdf\x4b\xd9\x7b\xe4\x8e\x78\xe7\xf6\x2c\xa9\xb9\x21\x18\x8d\xb6\xa1\xe2\xd8\x7c" +
+	"\xc0\x08\xbb\x5f\x0d\xc8\x7e\xc2\xad\x1a\x2d\x01\x2f\x50\x77\xc5\x49\x15\x5c\xb3" +
+	"\xbd\x77\xac\xba\xc3\xc0\xc0\xce\xb7\x82\x22\xcc\x2e\x30\xe0\xf4\xfa\x2c\x54\x57" +
+	"\x43\x2f\xf5\xde\x76\xbb\xdf\x2e\xf4\x18\xcc\xf5\x0f\x2a\x6b\x1f\x43\x42\x8b\x79" +
+	"\xbb\xd2\xc8\xcd\x1d\xf7\xda\xf7\x1b\x06\x52\x8d\xed\x13\x06\xf6\x98\xae\xb5\x96" +
+	"\x74\x6f\xc2\xff\x78\x1d\x64\xf3\xf9\x79\x4c\x8c\x36\x32\xd5\xe6\xb0\x7e\x7e\x7e" +
+	"\xb0\x9b\x97\xd8\x27\x7e\x91\x44\x71\xf0\x1d\xe8\xd2\x9a\x0f\xe7\xae\x3f\xb9\x73" +
+	"\xcb\x35\x26\x42\x54\x30\x51\x1d\x69\x99\x96\xbe\xa7\xc8\x38\x9b\x0a\x26\xf9\x83" +
+	"\x67\x26\x7c\x71\x6b\x60\x9b\x5b\x72\x11\x2a\xe5\xc8\x78\x01\xf2\xfe\x5c\xc3\x0f" +
+	"\x28\xd1\x63\x7b\xa6\xbb\x7f\x9d\xe8\xa9\xa9\x47\x5b\xfd\x60\xd8\x61\x8e\xcb\xc4" +
+	"\xd8\x9b\x02\xc3\x38\xe3\xdf\xad\x8d\xe7\x49\xf2\xd8\x70\xd3\x1b\xff\x86\xa6\xb1" +
+	"\x5f\xf0\x9f\x5d\x73\x00\x87\x16\x14\x5a\xe0\x77\xb9\x89\x04\xa4\xf0\x18\xaa\x76" +
+	"\xe4\xd8\x7a\xcd\xfb\xe8\xa2\xdb\xb7\xe1\x56\x2a\x1c\x1f\xec\xb5\xe9\x94\xc0\xb3" +
+	"\xed\x1b\x54\xdd\x31\x5b\xc4\x02\x96\xf0\xfb\x8b\x61\x6c\xc1\x88\x1e\x34\xb3\xe6" +
+	"\x63\xae\xfd\xde\xea\xea\xfe\x76\x3a\xbb\xbf\x5d\xc8\xee\xbd\xa7\xd7\x1d\xfb\x9c" +
+	"\x1d\xc4\x63\x86\x7c\xed\xbb\x85\xdd\xdf\x76\x69\x77\x6c\xa3\x76\xc7\x56\xe8\x7b" +
+	"\xf3\xb1\x7b\xef\xbb\x74\x8d\xd3\x32\xb1\x4f\xef\xdf\xa1\x54\x6b\xb5\x6c\xaa\x95" +
+	"\xaa\xaa\xd1\xb8\x62\xa3\xc9\xcb\xc0\x6b\xac\x47\x19\x84\xfa\xde\x74\x3d\xee\x78" +
+	"\xd3\xf5\xaf\x3b\x41\xf3\xfb\x1e\x0f\x75\xb4\xfc\xaf\x63\x50\xd4\x1e\x80\x94\x17" +
+	"\xfe\xd7\xbf\xa0\x7f\xc7\xde\x58\x77\x6c\xeb\xee\x5b\xc1\x87\x3f\x83\x01\xc0\xcf" +
+	"\xdf\xa6\xeb\x19\xe3\xd8\xf7\x88\x52\x17\x04\x90\xdf\x76\xef\xf8\x7b\xfb\x67\x32" +
+	"\x7b\x3a\xff\x8f\xc7\x7f\x0f\x4f\x6f\x9c\xfc\x33\xdf\x6a\x9d\xef\xfb\x56\x4e\xe7" +
+	"\xbf\xc8\xe0\xf2\x88\x0a\x5e\x46\x28\xf0\xf7\xd0\xa6\xcb\xb1\x0c\x08\xf1\xa2\xa5" +
+	"\x8e\x86\x21\x37\x57\xee\xb9\x08\xfd\xf7\x00\x45\xd5\xb1\x89\xfe\x1e\x00\x00"
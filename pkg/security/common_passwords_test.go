@@ -0,0 +1,142 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestIsCommonPassword(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		password string
+		common   bool
+	}{
+		{"123456", true},
+		{"password", true},
+		{"PassWord", true},
+		{"PASSWORD", true},
+		// Fullwidth characters are folded by NFKC normalization.
+		{"ｑｗｅｒｔｙ", true},
+		{"qwerty", true},
+		{"correct horse battery staple", false},
+		{"password ", false},
+		{"", false},
+	} {
+		if common := security.IsCommonPassword(tc.password); common != tc.common {
+			t.Errorf("%q: expected %t, got %t", tc.password, tc.common, common)
+		}
+	}
+
+	// The embedded list is generated from common_passwords.txt; make sure it
+	// was regenerated after the last change.
+	f, err := os.Open("common_passwords.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	entries := map[string]bool{}
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries[line] = true
+		if !security.IsCommonPassword(line) {
+			t.Errorf("%q is missing from the embedded list; run go generate", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	// The size of the list is documented on IsCommonPassword.
+	const expected = 1058
+	if len(entries) != expected {
+		t.Errorf("expected %d common passwords, got %d", expected, len(entries))
+	}
+}
+
+func TestLoadCommonPasswordsFile(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "common_passwords_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	if err := security.LoadCommonPasswordsFile(
+		filepath.Join(dir, "missing.txt"),
+	); !testutils.IsError(err, "loading common passwords") {
+		t.Errorf("expected error, got %v", err)
+	}
+
+	path := filepath.Join(dir, "blocklist.txt")
+	const blocklist = "# Site-specific passwords.\n\nCorrectHorse\n  battery staple  \n"
+	if err := ioutil.WriteFile(path, []byte(blocklist), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if security.IsCommonPassword("correcthorse") {
+		t.Fatal("unexpected common password")
+	}
+	if err := security.LoadCommonPasswordsFile(path); err != nil {
+		t.Fatal(err)
+	}
+	for _, password := range []string{"correcthorse", "CORRECTHORSE", "battery staple", "123456"} {
+		if !security.IsCommonPassword(password) {
+			t.Errorf("expected %q to be common", password)
+		}
+	}
+	if security.IsCommonPassword("# Site-specific passwords.") {
+		t.Error("comments should be ignored")
+	}
+
+	// The policy rejects common passwords only when configured to.
+	if err := (&security.PasswordPolicy{}).Validate(ctx, "CorrectHorse", nil); err != nil {
+		t.Error(err)
+	}
+	p := &security.PasswordPolicy{RejectCommon: true}
+	if err := p.Validate(ctx, "CorrectHorse", nil); errors.Cause(err) != security.ErrCommonPassword {
+		t.Errorf("expected ErrCommonPassword, got %v", err)
+	}
+	if err := p.Validate(ctx, "CorrectHorse2", nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func BenchmarkIsCommonPassword(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		security.IsCommonPassword("Hunter2!")
+	}
+}
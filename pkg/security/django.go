@@ -0,0 +1,134 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"hash"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// The legacy salted hashes of Django (and of the homegrown systems modeled
+// on it) name the digest, followed by the salt and the hex encoding of the
+// digest of the salt and password:
+//
+//	sha1$<salt>$<hex(sha1(salt || password))>
+//	md5$<salt>$<hex(md5(salt || password))>
+//
+// The salt may be empty, as in the hashes of Django's unsalted SHA-1 hasher.
+const (
+	djangoSHA1ID = "sha1"
+	djangoMD5ID  = "md5"
+)
+
+// legacyHashVerification is 1 if AllowLegacyHashVerification was called
+// with true.
+var legacyHashVerification int32
+
+// ErrLegacyHashVerificationDisabled indicates that a password was compared
+// against a legacy salted SHA-1 or MD5 hash, but AllowLegacyHashVerification
+// was not called.
+var ErrLegacyHashVerificationDisabled = errors.New(
+	"verification of legacy salted sha1 and md5 password hashes is disabled")
+
+// AllowLegacyHashVerification enables or disables the verification of the
+// legacy salted SHA-1 and MD5 hashes of HashDjangoSHA1 and HashDjangoMD5.
+// These digests are cheap to brute-force, so verification is disabled by
+// default, and should only be enabled while importing users from systems
+// using them. NeedsRehash always reports these hashes as needing a rehash.
+func AllowLegacyHashVerification(allow bool) {
+	var v int32
+	if allow {
+		v = 1
+	}
+	atomic.StoreInt32(&legacyHashVerification, v)
+	clearVerifyCache()
+}
+
+// legacyScheme returns the name of the digest of a hash in the format of the
+// legacy Django hashes, e.g. "crypt" for "crypt$...", and whether the hash
+// is in that format.
+func legacyScheme(hashedPassword []byte) (string, bool) {
+	end := bytes.IndexByte(hashedPassword, '$')
+	if end <= 0 {
+		return "", false
+	}
+	for _, c := range hashedPassword[:end] {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_') {
+			return "", false
+		}
+	}
+	return string(hashedPassword[:end]), true
+}
+
+// djangoDigest returns the digest used by the given legacy Django method.
+func djangoDigest(method HashMethod) func() hash.Hash {
+	if method == HashDjangoMD5 {
+		return md5.New
+	}
+	return sha1.New
+}
+
+// parseDjangoHash decodes a legacy salted SHA-1 or MD5 hash.
+func parseDjangoHash(method HashMethod, hashedPassword []byte) (PasswordHash, error) {
+	id := djangoSHA1ID
+	if method == HashDjangoMD5 {
+		id = djangoMD5ID
+	}
+	fields := strings.Split(string(hashedPassword), "$")
+	if len(fields) != 3 {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: expected 3 fields, got %d",
+			id, len(fields))
+	}
+	digest, err := hex.DecodeString(fields[2])
+	if err != nil {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: invalid encoding", id)
+	}
+	if size := djangoDigest(method)().Size(); len(digest) != size {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: expected %d bytes, got %d",
+			id, size, len(digest))
+	}
+	return PasswordHash{
+		method: method,
+		id:     id,
+		salt:   []byte(fields[1]),
+		hash:   digest,
+	}, nil
+}
+
+// encodeDjangoHash is the inverse of parseDjangoHash.
+func encodeDjangoHash(h PasswordHash) []byte {
+	return []byte(h.id + "$" + string(h.salt) + "$" + hex.EncodeToString(h.hash))
+}
+
+func verifyDjango(h PasswordHash, password []byte) error {
+	if atomic.LoadInt32(&legacyHashVerification) == 0 {
+		return ErrLegacyHashVerificationDisabled
+	}
+	d := djangoDigest(h.method)()
+	_, _ = d.Write(h.salt)
+	_, _ = d.Write(password)
+	if subtle.ConstantTimeCompare(h.hash, d.Sum(nil)) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
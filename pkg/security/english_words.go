@@ -0,0 +1,57 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+//go:generate go run gen_english_words.go
+
+import (
+	"bufio"
+	"compress/gzip"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// englishWords maps the English words matched by the strength estimator to
+// their rank, from 1 for the most frequent one. It is loaded from
+// englishWordsGzip the first time it is used.
+var englishWords struct {
+	once  sync.Once
+	ranks map[string]int
+}
+
+// englishWordRank returns the rank of a lowercase word in the list of
+// English words, or 0 if it is not in it.
+func englishWordRank(word string) int {
+	w := &englishWords
+	w.once.Do(func() {
+		ranks := make(map[string]int, englishWordCount)
+		gz, err := gzip.NewReader(strings.NewReader(englishWordsGzip))
+		if err == nil {
+			scanner := bufio.NewScanner(gz)
+			for scanner.Scan() {
+				ranks[scanner.Text()] = len(ranks) + 1
+			}
+			err = scanner.Err()
+		}
+		if err != nil {
+			// The list is generated and covered by tests.
+			panic(errors.Wrap(err, "loading the English words"))
+		}
+		w.ranks = ranks
+	})
+	return w.ranks[word]
+}
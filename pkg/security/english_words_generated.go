@@ -0,0 +1,4991 @@
+// Code generated by gen_english_words.go; DO NOT EDIT.
+// GENERATED FILE DO NOT EDIT
+
+package security
+
+// englishWordCount is the number of entries of englishWordsGzip.
+const englishWordCount = 30244
+
+// englishWordsGzip is the gzipped, newline-separated list of English words
+// from english_words.txt.
+const englishWordsGzip = "" +
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x9d\xe9\xb6\xab\x2c\xb3\x85\xff\x73" +
+	"\x97\xa8\x24\xe1\x5d\x2a\x7e\xa0\x2b\x3b\xfb\xea\xcf\x78\x66\x15\x98\x7d\xc6\x48" +
+	"\xa8\x49\xdf\x48\x5b\x14\x70\xbe\x52\x28\x8f\x10\xf7\x25\x9c\x25\xe4\x3d\xe4\x16" +
+	"\x3e\xe5\x0a\xe7\x2b\x9e\x21\x9f\xe1\x95\xc2\x3b\xb6\xf0\x28\x35\x94\x3d\xc4\x9a" +
+	"\x42\x6c\xe1\x9d\xcf\x57\x78\xe5\x16\xce\x57\xfa\x84\x78\x86\x29\x85\x13\xfd\x2b" +
+	"\xfe\xa6\xf0\xa8\x65\x0b\x72\x9f\xc2\x2b\x2e\x61\xfa\x84\x77\xa9\x4b\x98\xae\x33" +
+	"\xec\xe5\x0c\x6f\x82\x8e\xeb\x1a\xde\xa9\xa6\xf0\x4e\xe1\xfd\x4a\x3b\x91\xd6\x30" +
+	"\xc7\x3d\xb4\x98\x17\x02\xae\x29\x5c\x2d\x85\xb8\x87\x14\xe7\x57\x78\xbf\xf2\xfc" +
+	"\x0a\xed\x95\xc2\x52\xc2\xab\xbc\x71\x92\x6b\xc8\x8f\xf0\xce\xeb\x1a\xae\x23\x14" +
+	"\x3c\x85\x38\x95\xeb\x0c\xfc\xb7\xb8\x7f\x70\xb5\xa3\x6c\x28\x2d\x85\x56\x42\x2b" +
+	"\x5b\x0a\x38\x7d\x97\x6b\x5d\xc2\x16\x7f\x52\x58\xf3\x4f\x0a\xaf\xbc\x85\xbc\x9f" +
+	"\x25\x9c\x19\x17\xb1\x85\xb5\x94\x9f\x70\xbe\x4b\xd8\x0a\x49\xad\xf9\x4c\xe1\x59" +
+	"\x42\x4b\x29\xec\xd7\x36\xa5\x1a\xf6\x12\xde\xf1\x13\x66\x05\x75\xa4\x72\xac\x29" +
+	"\x6c\x44\x1b\xf7\xf0\xc8\xb5\x9d\xe1\x1d\xcf\x54\xc3\x94\xd2\x1e\x66\xe5\xfa\x55" +
+	"\x42\xc9\x6b\xc8\x67\x0b\x7b\x79\x87\x47\xde\x97\xb0\x96\xfd\x19\x96\xf2\xde\xc3" +
+	"\x12\x3f\x61\xc9\x4b\x78\xa6\x33\xcc\xa4\x74\x8b\x0b\xca\x27\x1c\xb1\x9e\xa1\xfc" +
+	"\x12\x69\x7a\x87\x56\x2e\xbe\x19\x89\x2f\xfb\xfa\x09\x6b\x3e\xcf\x35\x51\xd2\x3f" +
+	"\xe1\x87\x70\x8f\x35\xce\x29\x7c\x52\xac\x61\xcd\xbf\x29\x6c\x29\x4c\x71\xfe\x09" +
+	"\x4f\x69\x4a\x3b\xc3\x6f\xaa\x9f\x10\x1f\x24\xef\x7c\xe5\xfd\x19\xf8\x04\xff\x5d" +
+	"\xed\x0c\x7b\xdc\xc8\x68\x59\x42\x4b\xfb\x99\xf6\x99\x14\x50\x8e\x79\xff\x09\x2d" +
+	"\x7e\xc2\xb3\xa6\xc8\x97\xe4\x0b\xbe\xd2\x7a\x84\xf3\x55\xcb\xf5\x7c\x85\xed\x9a" +
+	"\x5f\x61\x4a\x0f\xca\x6b\xcd\x7b\x0a\x35\x3f\x5f\x67\x38\x4b\x09\x5b\x8a\x7b\x28" +
+	"\xeb\x12\xf8\x2e\x8d\x18\xce\xb4\xae\x61\x2a\x9f\xf0\x28\xeb\x5a\xde\x61\xc6\xf0" +
+	"\x1d\xf7\x33\x34\xbe\x70\x5c\x5b\x09\xb1\x2a\xa3\x8f\x52\xf9\x84\x35\xa5\xd0\x36" +
+	"\x8a\xb1\xa5\x33\x1c\xd7\x19\xd2\xbe\x84\xa5\xa4\x16\xe2\x6e\x15\xe0\x4d\xa0\x6b" +
+	"\xac\xcf\x14\xb6\xab\x9d\x61\xca\xcf\x90\x7e\xd3\x1e\x9a\x25\x6d\x8e\x54\xab\xf3" +
+	"\xaa\x3b\x95\x80\xda\xf7\x09\xb1\xfd\x84\x77\xda\xcf\xb0\xa5\x3d\xd4\x14\x97\xb0" +
+	"\xa7\xb4\x84\x95\x66\xb1\xe4\xc7\x23\x55\x2c\x5f\x7c\x8e\xab\x85\xad\xfc\xa6\x70" +
+	"\xd6\x4f\xf8\xe1\xd3\xbd\x70\x74\xe4\xf9\xbc\x68\x1c\xcf\x98\xf7\x30\xbf\xe2\xfe" +
+	"\xa4\x5d\x3d\xc2\xb1\xc6\x4f\x68\x07\x69\x8a\xb9\x86\x48\x65\x89\x7b\xde\xe2\x1a" +
+	"\x5e\x85\x84\x1c\x25\xef\x67\x38\xe2\x33\x85\x35\x9d\x7c\x8b\xcd\x2b\xf2\xde\xde" +
+	"\xa9\x86\x87\xb2\xdf\xce\x6b\xf9\x84\x76\x52\xd5\xd7\x14\xeb\x4e\x09\x51\xe5\xe2" +
+	"\x96\x6a\x9e\x23\xdf\x7d\x5d\xc2\x2b\x3f\x5f\x64\xb6\x7e\xc2\xce\x87\x8f\xcb\x12" +
+	"\x1e\xa5\x2c\x61\x4a\xe7\x9b\x1a\x48\x0d\x9b\x92\xca\xba\x5c\x3b\x79\x38\x56\xca" +
+	"\x7b\x8d\xed\x0c\x6d\x7e\x95\xb2\x86\x47\x54\xfc\x3f\x29\x1d\xe1\xa4\xc0\x77\x42" +
+	"\x0c\xed\xa4\xfe\xcd\xf9\xfc\x84\x14\xeb\xf9\x0a\xe9\xc3\x27\xd6\xd7\x7d\xf1\xed" +
+	"\xe9\x2c\xe2\x12\xae\x7d\x91\xeb\x52\xf9\xca\xef\xb0\xa6\xc7\x19\x1e\xe9\x4d\x0b" +
+	"\x5e\x53\x88\xaa\xea\x9b\x6a\xc5\xbc\x16\x35\xc9\x2d\x51\xb3\x9e\xb4\xab\x2d\xec" +
+	"\xe9\xcf\x19\x5e\xb1\x2e\xa1\x1c\x69\x0f\xe9\x4f\xdc\x68\x54\x53\x7a\xe6\x3d\xac" +
+	"\xf9\x41\x10\xef\xf8\xa1\xf3\xc1\xf7\x54\xce\x57\x38\xe2\x41\x25\x2e\xcf\xa4\xa4" +
+	"\x3f\xcb\x19\x9e\xb5\xd0\x27\x3c\x4e\xbe\xe8\xb5\x87\xbc\x1d\xa5\x9e\xe4\xf5\xda" +
+	"\xcf\xbc\x86\xf9\x95\xd7\xa5\x52\x2d\xf2\x92\xc2\x23\xd1\xdc\x62\x0d\x5b\x5e\x53" +
+	"\xd8\x95\xba\x77\x5c\x7f\x48\xf4\x99\x42\x4b\x31\x4c\xe9\x19\x77\x82\x7d\x53\x9b" +
+	"\x7f\x42\xcd\x94\xca\x83\x36\x33\xc7\x4a\x66\xcf\x78\xd2\x18\x67\x12\x55\x7e\x28" +
+	"\x0d\x95\xc3\xa1\xfe\xb2\x5c\x67\x68\x69\x2e\x34\x76\xf5\x08\x5b\x6e\x2d\xe4\x25" +
+	"\xc5\x90\x76\x8a\x2f\xd0\xa2\x1e\xb4\xd9\x77\x3c\xe7\x57\x78\xc4\x1a\xf2\xbe\xe4" +
+	"\xa8\x1a\xb9\xae\x9f\x10\x57\x35\xdb\x35\x9d\xf4\x72\xbf\x29\x3c\x73\x5d\xad\xf8" +
+	"\xf2\x96\xa8\x97\xd7\x7e\xaa\xfe\x5d\x27\xfd\xe9\xfe\x0c\x27\x79\x68\xa5\x50\x72" +
+	"\x7c\x61\xca\x7e\x4a\x94\xf5\x9a\xd4\x57\xc7\x2d\xaf\x9f\x30\x95\xe5\x43\x6b\xc9" +
+	"\x73\x98\xcb\x5a\x48\x36\xb5\xba\x5d\x7b\xf8\xdf\x95\xda\x99\x0b\xbd\x59\x7b\x31" +
+	"\x00\xc4\xb0\xc5\xfa\x13\x96\xf2\x0c\xaf\x52\xf9\x02\xb9\x2e\x2d\x1c\xb5\x4c\x6b" +
+	"\xda\xe8\xb3\x8e\x35\x9d\x29\xd4\x52\xb6\xf0\xa3\xae\x2a\xd3\x81\xa8\x12\x1d\x39" +
+	"\xcd\x29\x9c\xf4\x02\x57\xbb\x94\xab\x47\xcd\x69\x5f\x5a\x48\xb1\x7d\x54\x66\x4b" +
+	"\x28\x95\x2a\x54\x13\x2d\x9b\xc4\xd0\xb2\xa6\xa4\xde\xf0\x2c\x47\x68\xaf\x7c\x84" +
+	"\x38\xd7\xd2\x5a\x38\x8b\x3a\xcc\xab\x92\xa7\xf6\x2a\x95\xc1\x48\xcd\x68\x4a\x8d" +
+	"\x26\xfb\x56\xbc\xd4\xf8\x57\xb9\x6a\x0b\xd3\x4a\x27\x78\xd4\xb2\x5c\xf3\xc9\x88" +
+	"\x75\x1c\x69\x4f\x4b\x78\xbf\x0a\x5d\x77\x8a\x8a\xad\xa6\x2d\xa9\x7f\x4f\xb1\xae" +
+	"\x9f\xf0\x8e\xbf\xa9\xf1\x19\xe6\x57\x5a\x54\x94\x69\x0f\x6f\x5a\x7f\x2d\xf3\x4f" +
+	"\x68\x07\x9f\x6d\xa6\x6f\x4e\x4b\x78\xa8\x2d\x11\xad\x1a\xb9\x9a\xe5\xd6\xd2\xfa" +
+	"\x08\x67\x79\x53\xaf\x1f\xf4\xc0\xed\x4c\x07\xc3\xca\x4e\xc2\x8f\xd8\x5a\x5a\xc2" +
+	"\x6f\x79\xa7\x35\x9c\xf5\x4a\xe1\x75\xed\x0b\x05\xa0\x0e\xa5\xd1\x41\x90\xa9\x9d" +
+	"\x61\x47\xe1\x9e\x71\xa2\xa2\x16\x1a\x62\x5b\xcb\x7b\xfd\x84\xad\xec\xe9\x13\xb6" +
+	"\x78\x50\x75\xb6\x70\x5c\xeb\x4a\x09\xd6\xf8\x0e\xbf\x25\xcf\xd4\x62\xc6\x20\xca" +
+	"\x7e\xae\x39\x2d\xf4\x4d\x3b\x23\xb2\xec\xca\x45\x48\x24\xe6\x1d\x2b\xd5\x9c\x6e" +
+	"\xe7\x41\x4f\xfb\x83\xe1\x49\xcf\x71\xed\xf9\x0c\x8f\xfc\xa4\x84\xe6\x54\x55\xd7" +
+	"\x1e\x39\xad\x4b\x38\x6b\xfc\x4d\x6b\x78\xd3\xd7\x3c\x32\x43\xf7\x51\xf6\xb0\x94" +
+	"\x3d\x85\xb4\x3f\xd7\xdc\x5e\xa1\x96\x48\x67\x49\x31\xa4\x3d\x3c\xd6\x4f\x78\x52" +
+	"\x03\xa7\xf2\x87\x21\x50\x55\xe1\x1d\x33\x83\x5e\xad\x69\x3e\x43\x79\x85\xff\x5d" +
+	"\x79\xfe\x59\x3f\xe1\x48\xb5\x15\x3a\x2e\x0d\x10\x8c\x0d\x7b\xd8\xf2\x7e\x9d\xa9" +
+	"\x85\x76\x56\x2a\xf5\x6f\xaa\x13\xb5\xb6\x36\xe6\x1f\x3b\x3d\x4e\xa2\x2b\x9b\xcf" +
+	"\x90\xf7\xf9\x65\x0e\x69\xe4\x4b\x9a\xf3\x92\x96\x30\x17\x6f\x2b\xd4\x8a\x44\x1d" +
+	"\x53\xdb\xb3\x8a\x91\xc2\x74\xe5\x75\x21\xe3\x65\x66\xd8\x9a\xd7\xd8\x18\xae\x4f" +
+	"\xca\xdc\x3a\xab\x4a\xfd\x9a\x63\x4d\x8f\x8b\xb4\xb7\x39\xa7\xfd\xcc\xed\x6c\x21" +
+	"\xef\xea\x53\xde\xaf\x94\x56\xe2\xb5\xf1\x32\xed\x1a\x97\x99\x61\x69\x5c\x79\xa7" +
+	"\xf4\x13\xd6\xd4\x5a\xd8\xe2\xfc\x62\xa8\x9c\x22\xf3\x1d\xe6\x17\x27\xe5\xc8\xe7" +
+	"\x49\xa1\x7d\xda\x99\xb6\x30\xa5\x97\xaa\x1b\xbd\x81\x46\x84\xa9\xc4\x33\x3c\x29" +
+	"\x8f\x47\xa9\x74\x39\x1a\x7f\xe9\xdd\x96\x54\xad\xf9\xbe\xa9\x06\x73\xd9\x36\xca" +
+	"\x4e\x0d\x24\xfd\x39\x56\x32\xbd\xd4\x8f\x77\xda\x8c\x71\xcf\x8b\xd1\xa7\xbd\xe2" +
+	"\x91\xc2\xa2\x5e\x9f\xa1\x29\xd2\x28\x3f\xa9\x85\x99\x01\x27\xa4\xff\x5d\x51\xfd" +
+	"\xc0\x27\x9d\xe1\x49\x55\xdf\x37\x06\xc5\x47\x56\x35\x7b\xa9\xf7\xba\x56\x6a\xfc" +
+	"\x19\xe6\x57\x9a\x7f\x42\x99\xfe\xe3\x43\xc6\x2d\xd4\x8b\x9e\x7f\x2b\xfb\x33\xec" +
+	"\xe5\xda\xc3\x51\x18\xda\xe6\xb8\x33\x1f\x54\x4d\x6e\xf9\x4f\x68\xf9\x6f\x0a\x0b" +
+	"\x9d\xcb\x44\xbd\xdb\xe8\x26\x73\x5c\x19\x3e\x67\xe8\x2b\xc5\xdf\x0f\x95\x25\x85" +
+	"\x83\xc1\x74\xce\x75\x5e\x13\xdf\x77\xbd\x16\xfb\x64\xcc\xfa\x68\x28\xa1\xfd\xef" +
+	"\x62\xb2\xda\x3e\xeb\x4a\xf8\x8d\x3a\xf4\x8a\x47\x0b\x13\x43\xe8\x23\xad\x67\x68" +
+	"\xd7\xb2\x24\xa6\x4e\x27\x5f\x72\xc9\xd4\x3a\x32\x38\x33\xe5\xa9\x6a\x44\xa9\xaa" +
+	"\xd5\x2f\x8c\xd9\x1f\xfb\xec\x4b\xfe\x55\xfd\x79\xa6\x5d\x0d\x11\xf2\xfc\x84\x76" +
+	"\x59\x66\xd3\x55\xcb\xc1\xfc\xaa\xd0\x6d\x3f\x09\xaf\x26\x4d\x36\xa6\xb4\xe6\xf4" +
+	"\x4b\x06\xe9\x0d\xad\x7b\x69\xe1\xc8\xf3\x4f\x5a\x42\xcb\x1a\xe4\xe6\xb4\xae\x8d" +
+	"\xcc\x31\x15\xe1\x7b\xaf\x4c\x35\x6c\xc6\x52\xf9\x70\xe9\x4f\xaa\x73\x6e\x29\xa4" +
+	"\xe7\xb3\xd1\xea\xf2\x1e\xa6\xf5\x4a\xe1\x4d\x13\x5b\x6a\x39\xc2\x92\x7e\xd3\x5a" +
+	"\x0e\xba\xb4\xbc\x2f\xe5\x3d\x26\x30\x73\x0a\x4b\xa6\x66\xcc\xcc\xd9\x18\xcc\x5b" +
+	"\xa6\x14\x36\xfe\x1b\xf3\x61\x4a\x9d\x99\x1b\xfd\x4d\x2d\x53\x9c\x98\x57\xa6\x67" +
+	"\x0b\x2d\x9e\x61\x23\xfe\x77\x56\xd9\xbc\x55\xbf\x6b\x3e\x19\x5d\xd7\xb4\x3f\xcf" +
+	"\x17\xe5\x44\x23\xfd\x49\x07\x4d\xee\x4c\x0a\x26\xd6\xad\x51\x35\x35\x2c\x57\x6b" +
+	"\x60\x89\x39\x65\x98\x52\xbc\xce\xfc\xb8\x56\x6a\x7b\x4d\xe1\xbf\x32\x85\xb4\x3c" +
+	"\xf9\xb4\x74\x9f\xf9\x49\xc1\xcd\xac\x1b\x1e\x79\xcf\x8d\x7e\x77\xc9\xad\xf7\xb1" +
+	"\xef\x4c\xb7\x1a\x8f\xe3\x13\xa6\xa4\xd6\xf6\xa4\xaf\x69\x3f\x9f\xf0\x54\x53\xdd" +
+	"\xf2\xba\x52\xf8\x6f\x52\xc1\x1c\xec\x9d\x6c\x5a\x53\x4b\x21\x7d\xed\xac\x17\xf5" +
+	"\xb7\x85\x8d\x5e\xe1\x7c\xe5\xba\x84\xad\xec\xe7\x8b\xf2\xaf\xf1\x59\xe3\xf1\x0a" +
+	"\x35\x66\xfa\xe5\x9a\x7a\xaa\x5b\x79\x68\xda\xab\xa0\xe6\x95\x99\x5a\x0b\x8f\x95" +
+	"\xea\xdc\x42\x7b\x51\x80\xa7\x46\x89\x1a\x5e\xf4\x8b\x4b\x6a\x73\xcd\x53\x0a\x0b" +
+	"\xd3\x85\x60\x63\x56\x3b\x52\x64\x54\x5e\x7f\x53\x88\xc7\x41\xeb\xda\xd2\x49\x45" +
+	"\x2f\x7b\x48\x59\x61\xab\x4f\x5e\x69\x8c\xbf\x79\x5d\x69\x9f\xf4\x65\x45\xf5\xb1" +
+	"\x5d\xeb\x19\xfe\xbb\x36\xbe\x71\x63\xaa\x5f\x29\x00\xba\x22\x92\x52\x6a\x78\x51" +
+	"\xc7\x8f\x4b\x85\x36\xc5\xe9\x13\xa6\xeb\xa3\x6a\x7d\xd5\x0f\x4b\x23\x15\x18\x03" +
+	"\x94\xd7\xe9\x93\x74\xc7\xd5\x6a\x3a\x65\xc3\x0c\xf8\x78\xd5\xa8\xd9\x59\x5e\xc3" +
+	"\xa4\x0e\xf3\x60\xb0\x4e\x29\xbc\xa8\xe1\xed\x50\x8f\x32\xe3\x66\x8d\xd7\x93\xb8" +
+	"\x76\xeb\x21\xfe\x77\xb1\x4e\x3a\x3f\x07\xab\xc2\xc4\xd0\xf7\x9b\x5a\x38\xd3\x76" +
+	"\xa4\x1a\x4f\x06\x8f\xc9\x56\x05\x2b\xf1\x28\x1d\x7c\x3c\x66\x81\x5a\x71\x58\x33" +
+	"\x5c\x59\xda\xcc\x65\x6f\x65\x67\xd6\xc6\x1c\x8a\x9e\x2b\xcb\x36\xd6\x4f\x78\xd1" +
+	"\x07\x50\x32\x51\x13\x9f\xd0\xe6\xb8\x32\x9f\xbe\xe8\xb8\xe2\xea\xfd\x1b\x13\xc3" +
+	"\xa9\x96\x9f\xb4\x87\xad\xf0\xc5\xc3\x99\xf7\x4f\x38\x4a\x6b\x99\x8e\xe7\xc9\x78" +
+	"\xb8\xe5\xf5\x87\xd1\x26\xb1\xf4\x39\x2f\xda\xf5\x5a\x4e\x6a\xe6\x9e\x42\x9c\x4f" +
+	"\xf5\x2d\x38\x5b\x16\xfa\xaa\x83\xc5\xc1\xac\x48\xe7\x48\xa5\xd8\x12\x19\x68\x31" +
+	"\xaf\xa1\x16\xf5\x87\x13\x5f\xf5\x5d\x6c\x2e\xcc\xa4\x92\x65\xcf\x73\x65\x75\x1c" +
+	"\x95\x83\x10\x1f\x9a\xb8\xff\x58\xff\xb9\xa5\xb5\x2c\x4c\xc4\xce\xb3\x6c\xe1\xac" +
+	"\xf9\x60\xea\x40\x76\x4a\x65\xc8\x7d\xd1\x52\xeb\x51\x73\x23\x88\xb4\xcf\xaf\xb0" +
+	"\x30\x86\x4f\x29\x9e\xcc\x91\xe7\x73\xfd\x84\x9a\xd4\x48\x97\xca\xa8\x92\xab\x26" +
+	"\x6d\xfb\x93\xaa\xc9\xac\x75\x4d\x4c\x4c\x66\x4d\x2e\xe7\x35\x6f\x7c\xd4\x77\x2d" +
+	"\x4c\x6f\x5f\xe5\x3a\x7d\x4c\xcc\xfb\x95\x16\x16\xa7\x4c\x58\xd2\xca\x0a\x85\x21" +
+	"\xa3\x85\x67\x6c\x1a\xcb\x19\x59\xa6\xcb\xe6\x2d\x4b\x52\x43\xfd\xaf\x64\xa6\x50" +
+	"\x0f\x9a\xd6\x1a\xdf\xac\x0f\x5a\x78\x56\xda\xe1\xb3\x32\x01\xfc\xc9\x7b\xf8\x8d" +
+	"\xeb\x9a\xac\x1a\xb6\xf0\x93\x3e\x81\x36\x95\x17\xbe\xc8\x54\x19\xd1\xcf\x5a\x2e" +
+	"\xbe\xc8\x5c\xca\x1a\xe6\xb5\x5c\x74\x7f\xf4\x05\x38\x69\x9f\x6d\x2a\x6b\x0b\x6f" +
+	"\xca\x75\x8a\x4b\x68\x4c\x1e\xd2\x9f\x23\xd5\xac\xaf\x9a\xf6\x27\x03\x03\xcb\x0b" +
+	"\x5a\x5b\x7c\x93\x3e\xe5\xf8\x60\x05\x96\x55\xf8\x67\x61\x0d\x98\x77\x56\x93\x56" +
+	"\x57\xd3\x1f\x92\xa1\xb9\xf3\xf9\x0a\x1f\xa6\x67\x8c\x74\xab\xe6\x09\xac\xd2\xe0" +
+	"\x4b\x68\xf2\xc6\x84\xa1\x96\x35\x1c\xfa\x80\x33\x73\x44\x56\x14\xcc\x2b\xa2\xbe" +
+	"\x8f\xbe\x8d\x16\x01\x4a\x50\x3b\x33\x13\xcd\x58\xcf\x0f\x33\xaf\xa5\x85\x76\x1d" +
+	"\x07\x4b\x96\x77\x61\x31\x3d\x17\x12\x37\xc5\xfd\x87\xb1\x29\x17\x3a\x35\xe6\x55" +
+	"\xaf\x82\x1b\x06\xdd\x3d\xfc\x66\xba\xe8\x29\xd3\xe7\x60\x5a\xd3\x9c\xf2\x2f\xa3" +
+	"\x4f\xac\x4b\xda\xc3\xc1\x37\x65\x2e\x59\xb5\xe0\x9c\x69\x87\x4c\x7d\xac\x1b\xda" +
+	"\xc2\xb3\x2c\x61\x8e\x87\x26\x3a\x36\xc0\x05\xb5\xda\x96\x2a\xbd\x11\x4b\xa0\xb0" +
+	"\xa4\x96\x2a\x5d\xe2\x4c\x0f\x0e\x17\x84\x0e\x19\x96\x46\x3b\xc3\x16\x3f\x13\x23" +
+	"\x6b\xcb\x3b\xa5\xd4\xd2\x11\x2b\xab\x9c\xa9\xd2\x85\x5d\x3b\xe3\xef\x8b\x05\xd5" +
+	"\xfe\xa4\xef\x79\x87\x95\x2e\x84\xd5\xaa\xfa\xd6\xd7\x45\x4e\x19\x6a\x98\x8e\x29" +
+	"\xe6\xeb\x38\x56\x02\xaf\x3b\xb3\xeb\x35\xcd\x67\xcd\x33\x1d\x72\x62\x3a\x3e\xd7" +
+	"\x72\x30\xa9\xdf\x49\xc7\x19\x1a\x95\x6d\x29\x74\x7c\x8c\x48\x8c\xbc\xe1\x7c\x5d" +
+	"\x2d\xcc\xac\xc0\xa6\xb2\xd3\xaf\xd0\xea\xa6\xc2\x97\xdb\xca\xc2\x1c\x99\x25\x88" +
+	"\xe6\x48\x1b\x35\xe2\x91\xcf\x10\x97\x25\xeb\x73\xb3\xf6\x25\x15\xf1\x41\xcf\xb6" +
+	"\x2e\x99\xc6\xf1\xbc\x94\xb7\xbc\xf2\xd9\xce\x0a\xbf\xa5\xda\x60\x41\x48\x74\xab" +
+	"\x73\x2d\xef\x25\x1c\x25\x6d\x21\xed\xff\x95\x0f\x09\xe7\x23\x33\xd7\x5b\xf2\x4c" +
+	"\x89\xa4\x3f\x33\x23\x1f\x35\x72\x3e\xc3\x63\xa5\x5f\x10\xdb\xa1\x8f\x85\xca\x7d" +
+	"\xda\x55\x47\xf4\x11\x26\xca\xeb\x11\xb7\x72\x35\x1a\x87\x06\xf1\xfd\x49\x55\xfc" +
+	"\x55\xe0\xe1\xa0\x03\x48\x7f\xe6\xac\xe2\x9d\x6a\xb4\x89\xec\xf9\xa2\x62\x4d\x2b" +
+	"\x73\xc4\x35\xd3\x1d\x15\x16\x3d\x8c\xdd\xd7\x1e\xd4\x6e\xe8\x36\xb3\xba\x9e\xeb" +
+	"\xf9\x4c\x8d\xb6\xad\xde\xf3\x28\xcd\xca\x41\x53\x1c\x9a\x6c\xbd\xf2\x19\x4e\x7a" +
+	"\x92\x9a\xe9\x53\xca\xba\xd2\x78\x1b\x6c\x95\xa6\x6a\x3d\xbf\x72\x7a\x84\xff\xe2" +
+	"\x11\xf7\x64\x89\xa7\x66\x69\x72\x7a\xb6\x50\x5f\x9f\xf3\xb5\x85\x24\xa7\x9a\x03" +
+	"\x33\xb9\x89\xff\xc1\xff\x9b\xac\x9a\x9d\xd7\x04\x0f\x61\x4e\xad\xd1\x6d\xbf\xcd" +
+	"\xed\x96\xe2\xc9\x72\x9e\xb4\x1d\xb5\x60\x1b\x62\xdd\xe8\xd6\x35\xfb\x38\x12\x0d" +
+	"\x07\x9e\x57\x9e\xaf\x35\xd6\xd0\xde\x79\x0b\x67\x62\x42\x31\x5f\x55\x0c\x99\x23" +
+	"\xd6\x9f\xd0\x12\xdc\x20\x71\x43\x18\x37\xf7\xe5\x6a\xf0\x35\xde\xb1\xbd\xc2\xb4" +
+	"\xda\xf2\x8c\x91\x2d\xcc\xd1\x38\x64\xb0\x10\xda\x2b\xd6\x43\x6b\xd5\xb8\x7f\x42" +
+	"\x8d\x4b\x2e\xc1\xfb\xe5\x39\x1e\x99\xc1\xd8\xc6\xdb\xcc\xca\x21\xe1\x71\x09\x9f" +
+	"\x24\x2e\x95\x96\x4a\xd4\xb3\xb3\x5e\xf3\x4f\x78\x30\x0c\x31\x18\x92\x93\x28\x16" +
+	"\x08\xfc\x1f\xa6\xe4\xa5\x32\x3b\x62\x61\x74\xd6\x6c\xdd\xff\x56\xd6\x34\x5f\x4c" +
+	"\x48\x1f\x7c\x4f\x9a\xf4\x91\x22\x5e\xe7\xb2\x5e\x9b\x4d\x58\x08\x7c\x7e\x5d\x15" +
+	"\x86\x27\x6b\xcd\x1a\xca\x9f\xcf\x53\xed\x5d\x63\xd3\x6f\xac\x99\x5a\x13\x9f\x95" +
+	"\x91\xa8\xd0\xad\x30\xe4\xbe\xb5\x14\x9a\x5f\x34\xb8\xa3\xd2\x56\x55\xb8\xe9\xa4" +
+	"\x0b\x2a\xeb\xa5\x4f\xff\xa8\xa9\xc1\x48\x2d\x47\x68\xd7\xe3\x91\xff\x84\xe4\x13" +
+	"\x6c\x56\x31\x2f\xb1\xd2\xe6\xd3\xd6\xe6\x3b\x9d\x4e\x7c\x54\x58\xb2\x0b\x39\x6b" +
+	"\xd7\x53\x6c\x25\xe6\xba\x4c\x68\x1e\xf9\x09\x9b\x8b\xd9\xca\xeb\x7a\xa6\xf0\xbc" +
+	"\x60\xa8\x6c\x99\x0f\xb6\xa4\xc8\x92\x72\x86\x15\xf8\x28\x55\x4b\xdf\x76\xd6\x74" +
+	"\x6a\x11\x6d\x5d\xb7\x58\x8b\x55\xb1\xa8\x6c\x1f\x74\xef\xf0\x30\x69\x97\x7c\xc2" +
+	"\xbc\x3f\xcf\x02\xdb\x85\xd5\xd2\x9b\x01\x3c\x4c\x8c\xeb\x4c\x1e\xe0\xdd\x52\x46" +
+	"\x5a\x05\xab\xcc\xe0\x4a\xd2\x39\xe5\x53\xd3\xe1\x4c\xca\x98\x2c\x6d\x1a\xf4\xde" +
+	"\x79\x0f\xed\x4c\x7c\x8e\xc2\x17\x5e\x92\x14\xea\x14\x1d\x05\xad\x95\x86\xb6\x17" +
+	"\x66\x89\x07\x03\xd1\x49\xdc\xf1\x60\x6a\xbe\xa4\x33\xe6\x95\x21\xf0\x64\x7d\x4b" +
+	"\x07\xc6\xa4\xdf\xe6\xd3\x2d\xb4\x8d\x6a\x78\x16\xc6\x28\x98\x3c\x6a\x66\xc0\xb7" +
+	"\xa6\xe9\xf3\x4f\x88\x95\x29\x20\x63\x1a\xfd\x05\xad\xab\xc2\x57\x62\xe5\x41\x37" +
+	"\xcf\xd4\xef\xf1\xa0\x87\xd6\x0a\x4e\xbc\xd3\xdf\x9c\xde\x30\xc1\x61\x96\x90\xb2" +
+	"\xab\xa5\x86\xbe\x4a\x61\xe1\xf8\x03\xdb\x27\xda\x0a\x5d\x9a\xf3\xd6\xec\x62\xf5" +
+	"\x36\x54\x8c\xd0\xec\xb0\x95\x1b\x8a\xba\x94\x67\xd1\x04\xfe\xc9\x37\x7f\x16\x4c" +
+	"\xe0\xb1\x90\xea\xcd\xd9\x71\x54\x7e\xb1\x8b\x58\xba\x36\x2d\x60\xd1\xd1\x99\xfc" +
+	"\xa8\x27\xda\x15\x1d\x7c\x72\xe3\x96\x77\x1d\x73\x8b\x48\x07\x09\x3b\x17\x43\x28" +
+	"\x1d\x4e\x66\x76\x06\xcf\x1b\x43\x18\xc0\xcc\x0c\xd7\x15\x5d\x6c\x3f\x0d\x56\xac" +
+	"\x63\x82\x28\xf5\xa7\x49\xc5\x0c\xca\x94\x37\xa5\x4d\xe9\xea\x89\xdc\x08\x57\x6b" +
+	"\xed\x53\xed\xf5\xac\x1f\x6c\xd0\x2c\xc6\xe7\x6a\x22\x18\xc2\x82\x6f\x62\xc4\x77" +
+	"\x5d\x5a\xc4\xea\x64\x4e\x92\x0e\x0c\x57\x8a\x68\xf5\x22\x12\xdb\xb1\xc1\xfc\xcb" +
+	"\xfb\xee\x06\x17\x9c\xe3\xf5\x68\x52\x31\x83\xd2\xc5\xc6\x95\x42\x89\xab\x7f\x82" +
+	"\x95\x95\x1d\x6b\xbf\x16\x4e\x9f\x30\x41\xc9\x02\xbc\x54\xb1\x06\xaa\x3e\x84\x00" +
+	"\xe6\x2f\x4a\x19\x06\x87\xb3\xb3\xde\xb6\xb4\xae\xc4\x15\x35\x6a\xc0\x50\x6e\xcc" +
+	"\xc9\x3e\x5d\x47\xdf\x7d\xed\x0d\x45\x29\x64\xfc\xd0\x28\xd2\x35\x0c\x13\xd4\x8d" +
+	"\x35\x2b\x61\x68\x30\xfa\x95\xd1\xaf\x19\xe1\xca\x57\xa8\xcd\x01\x16\x6e\xb4\x30" +
+	"\x19\x5d\x9a\x54\x19\x93\x14\x16\x76\x99\x59\xd7\xd3\xb9\x68\x4e\x3a\x53\x0d\x0b" +
+	"\x6d\x99\x34\xed\x9c\xa0\xf5\x31\xbc\x39\xf8\x32\xa2\x3d\x9c\x0c\xc2\x67\x2f\x10" +
+	"\xea\x89\x08\x7a\x31\x8a\x51\xa8\x65\x0d\x93\x43\xc5\xe0\xa5\x40\xff\xc4\x3a\xce" +
+	"\x56\x73\x18\x6d\xe9\xec\x8c\x81\xe6\x00\x63\x37\xba\xa7\xba\x03\xb4\x8e\x70\xd6" +
+	"\x08\xaa\x79\x15\x80\xfd\xb1\x53\x23\xa2\x7d\x43\x01\x3a\x6c\x71\xf9\x9b\x51\x2c" +
+	"\x04\xe0\x0f\xa6\xb8\xc8\xbd\x18\x48\x37\x43\xa6\x7d\x31\x67\xbe\x6c\x18\xc7\xc5" +
+	"\xf2\x4d\xb4\x96\x13\x5e\xd0\xd3\x0d\x16\xdf\x19\x69\x4e\xb1\x31\x44\x65\x39\x99" +
+	"\x2b\xc1\x60\x56\xb5\x15\x20\x59\xea\x04\x99\x4a\xa5\xa8\xf4\x0b\xe0\x9e\x65\xa7" +
+	"\xaf\x3e\x31\x6f\x47\xf9\xd1\xec\xe1\xc7\x37\x3d\x58\x5b\x5a\x9a\x23\x51\xb0\x88" +
+	"\xf2\x38\xa3\x47\x19\x17\x96\x56\x8b\xb9\x59\x60\x83\xb4\x43\x33\x05\x54\x05\x6d" +
+	"\xed\x1a\x20\x16\xfc\x9b\x15\x80\x85\x01\xdd\xc5\xd3\x6f\x52\x31\x2b\xce\x7b\x55" +
+	"\xc3\x79\x7b\xc3\x79\x5b\xc3\x79\xb3\xe8\x78\x7b\xab\x7b\x97\x9d\x71\x26\x55\x53" +
+	"\x9b\x11\x5c\x0b\xa4\x65\x70\x6c\xdb\x40\xd8\x76\xcc\x57\x51\xb3\x58\x8b\x55\xf8" +
+	"\xf2\x9b\xee\x69\x52\x1b\x08\xbb\x8e\x19\xce\xb5\x3a\x6f\x4e\xb1\x34\x44\x63\xb9" +
+	"\x3e\x0d\x05\x43\xf8\x96\x7c\xbb\xec\x3d\x5d\x56\xff\xce\xe4\x87\x5a\x54\x7f\xc7" +
+	"\x94\x7b\x61\xd1\xc6\xbf\x85\xe5\xe3\x13\x42\xaa\xb9\x17\x1b\x63\x22\xb3\x60\xa3" +
+	"\xb7\x89\xa2\xcb\x2b\x2e\x4f\xaa\x3d\x2c\x45\x6f\x25\x1f\xfa\x3e\xf5\x69\x0f\xef" +
+	"\xd3\xa0\xf0\x79\xaf\x93\x89\x92\x55\x61\x71\xae\x9d\x7f\xed\x7c\x6c\x8c\x59\x7b" +
+	"\x4a\x69\x52\xbd\xc0\xb4\xda\x33\x7a\x9b\x90\x21\x9b\x4b\x76\xda\x3a\x90\x23\x18" +
+	"\x25\xc6\x2e\x69\x22\x9d\xab\x0d\x3b\x07\x33\x08\x66\xcc\xd6\xc8\xb0\xa5\x95\x29" +
+	"\x78\xa8\xe9\x7f\x17\x43\xaa\xd3\xe6\x00\x7b\x37\x5a\x7c\xad\xd5\x9c\x9a\x15\x28" +
+	"\x2d\xce\xd0\x75\xd2\x8c\xe2\x00\xfe\xb7\x98\xe0\x4d\x2a\x46\xc6\x89\x6b\x4e\x6f" +
+	"\x13\x9b\x8e\xd8\x32\xd7\x81\x17\x3e\x66\x74\xc1\xe5\x48\xf4\x7e\x6a\x68\xb0\x3f" +
+	"\x96\xce\x66\xeb\xb4\x75\x80\x0b\xf6\x83\xf8\xc6\xd0\xcf\x6d\xb0\xd8\xb2\x89\x7e" +
+	"\xd3\x9b\xa0\x18\x13\x7d\x55\xd7\x69\x73\x80\x47\xcd\xf0\x4c\xf5\xe9\x5e\x5f\x44" +
+	"\x69\xe9\x69\xd4\x57\x96\x3e\x94\x98\x19\x89\xa6\x52\xbe\xbc\x43\x75\xbe\x76\xf3" +
+	"\x9d\x8f\x2f\xa3\x85\x8d\x27\xb6\x5f\xe4\x8e\xf9\x13\xdd\x05\xab\x28\x36\x4e\xa4" +
+	"\xd2\x3e\x7e\xbd\x71\x78\x47\x35\xf7\x8e\x8a\xa5\x76\x0b\x4b\x4d\x6f\xad\xba\x77" +
+	"\x5f\xc0\xd2\x2d\x96\xd2\xac\x5b\xa4\xf3\x9e\x5f\xa5\x51\x2d\xe1\x65\x52\x32\x36" +
+	"\xa3\x81\x32\x47\x65\x13\xb4\x19\xc1\x58\x60\xec\xb4\x34\xa7\xd8\x18\x52\x7b\x8f" +
+	"\x2b\xfc\x62\xa7\xcd\x81\xd7\x76\xac\xc4\x41\x67\x6e\x06\xf1\xc1\x72\xa6\xd5\xdb" +
+	"\x38\x32\xfb\x40\x02\x85\xe3\xb1\xff\x8a\x21\xe7\xb4\x39\xc0\x85\x1b\xb1\x86\x8a" +
+	"\xfb\x8f\xa9\x4c\x7e\xa2\x4d\x7e\x04\xd2\xcd\xeb\x6b\x1d\x39\x87\x44\x86\xcb\x3d" +
+	"\xd1\x6c\x03\x9a\x03\x37\x56\x6f\xc3\x2e\xdf\x71\xac\x54\x1e\xe9\xbc\xcb\x59\x99" +
+	"\xc9\xf8\x8c\xbe\xd3\xe6\xc0\x87\x35\x2d\xde\x5b\x47\xdf\x86\xec\x01\x69\x22\x08" +
+	"\xc1\x62\xa9\x70\x95\xd1\xb1\x11\x43\x1f\xf0\x50\x1f\xf0\xf0\x3e\x20\xce\x5a\xca" +
+	"\x1a\x69\xae\xbd\x2d\xe8\x9b\xd5\x2b\xbe\xbd\x4f\x7c\x33\xc3\x7f\x33\x21\x86\x43" +
+	"\x7b\xb5\xd6\x69\x1a\x08\x67\xdd\x90\x49\xe0\xf3\x75\x12\xdf\xf3\xa5\x4a\xf7\xb0" +
+	"\x19\x3d\x32\x04\x6f\x36\xf9\x19\x7b\x44\xb0\x64\xd3\xdf\x4d\x59\xb7\xfe\x57\x3e" +
+	"\xcd\x08\x96\x02\x7c\xbd\x2d\x8a\xfd\xe3\xb4\xb9\x01\x4e\xdc\x88\xf2\x2b\x27\xf3" +
+	"\x6b\xa7\xad\x03\x6f\x0b\xd8\xd1\xcb\xc1\x74\x6d\x46\xb0\x69\x2b\x65\xa1\xf5\x32" +
+	"\x73\x7f\x06\x0b\x11\xd9\xbd\xf8\x1e\xac\x25\x59\x25\xbe\xa2\x6f\x42\x6a\x7c\x78" +
+	"\xe8\x23\x3e\x56\xb5\xff\xc7\x9a\xde\xe2\x8f\xec\x44\xfa\x0b\x17\x5c\xa3\x11\xc4" +
+	"\xa3\x67\x70\x60\xd5\xcf\x12\xcd\x69\xeb\x00\x27\x0e\xbf\xb9\xd1\xed\x86\xde\x23" +
+	"\x9a\xb9\xd5\xc5\xfc\xdc\x9b\xd3\x9b\x55\xa7\xea\x1f\xf3\x66\x2a\x8d\x20\x66\x4d" +
+	"\xa3\x05\x54\x90\x4a\x4b\xa7\xcd\x01\x4e\xdc\x68\x09\xf1\xb7\x64\x57\x9b\x11\xac" +
+	"\x05\x88\x5a\x2c\x1c\xb6\x22\x0e\xaf\x6c\x07\xfd\xa5\x98\xa0\xc6\x0a\x65\xb5\x94" +
+	"\xd5\xff\x8b\x71\x6c\x6a\x33\x82\x29\x3d\x03\x53\x41\x9b\x55\xb5\x88\xd1\x85\xf2" +
+	"\x82\x25\x2c\x55\xc5\xfd\x63\xc5\x0d\x2b\x08\x66\xb7\x14\x0a\xf5\xd2\xb2\xd1\xf7" +
+	"\x24\x9b\xd3\xdb\xc4\x8a\x50\xd2\x31\x4e\x9b\x1b\x78\x29\x62\xa5\xb5\x07\x6c\x84" +
+	"\x62\x5d\xb9\x74\x5e\xd9\x2b\xad\xd1\x78\xbb\xcd\xa9\xcf\x51\x6c\xd6\x00\x23\xb0" +
+	"\x85\x9a\xc9\x01\x84\x85\x3b\x2c\x1b\xf6\x7a\x6c\xc7\x07\x0b\xdb\xd6\xe8\xbb\x1b" +
+	"\xcd\x01\x36\x48\x06\xb0\xae\xcb\xd6\x6e\xa4\x35\x86\x27\x3b\x31\x36\x9b\x88\xd4" +
+	"\x17\x55\x31\xab\x61\xb0\x60\xa5\x34\xa9\x18\xc5\xfa\xbc\x92\xa9\x4d\x64\x18\x32" +
+	"\x77\x4d\xa4\x45\x04\x63\x01\x2a\x07\x1c\x11\xfa\x16\xb1\x46\x7c\x3a\x04\x62\x77" +
+	"\x1a\x66\x64\x33\x82\x85\x00\x93\xa4\xf3\xd4\xfa\x56\xa4\x39\xc5\x81\x21\xda\x02" +
+	"\xfe\x1e\x3e\x12\x41\x15\x13\x53\x08\x98\x8c\x38\x15\x33\x1d\xad\xf3\x12\x05\x18" +
+	"\xc3\xf8\xf8\x4c\xab\x59\x54\xf8\x5a\x83\xaa\xb0\xc5\x1d\xce\xbf\x11\x96\xc0\x3b" +
+	"\x8d\xbc\x9b\x2f\xda\x1d\x91\xd2\xa4\x62\x05\x37\x21\x0d\xa6\x82\x28\x11\xc0\x52" +
+	"\x7c\x69\xe6\xf0\xb2\x7a\xfc\xca\x32\x85\xbd\xfa\x83\x88\x06\x4a\x32\x82\x35\x94" +
+	"\xe4\xd7\x8f\x76\xd1\x99\x67\xeb\x03\x2c\x15\xd9\x28\xa9\x0c\x87\xd9\xc6\x86\xa5" +
+	"\x32\x62\x2c\xf5\xda\x7f\xc2\xeb\xaa\xa7\x94\x26\x15\xeb\x47\xa9\xc8\x79\x19\x69" +
+	"\x4e\xbd\x4b\xac\x48\xb1\x60\x62\x8b\x74\x6d\x2b\xf9\xe6\x12\x4b\x66\x9f\xc2\x9d" +
+	"\x34\x1a\xc9\x4b\x15\x57\x69\x1a\xa5\x9c\xde\x36\xe0\x0c\x32\xbc\x4f\x3e\xbe\x43" +
+	"\xd9\x0a\x61\x66\x22\x95\x2c\x6c\x7d\x9e\xb2\xf9\x3c\x85\x1d\xa0\x15\x5e\x49\xd4" +
+	"\x06\x36\x43\x2c\xc1\x9d\x30\x24\xa5\xee\xda\x73\xa2\x92\xab\xcc\x6a\x81\x67\x9a" +
+	"\xd9\x4f\xd5\xde\x80\x14\xa6\xc7\x9a\x5b\xb5\x17\xb2\x2f\x2f\x26\xa4\x40\x4b\xda" +
+	"\x9b\xee\x07\x9e\x9e\xba\x9f\xf6\x8e\x1b\x2d\xfe\x47\x8a\x1a\xff\x8f\x37\xfe\x1f" +
+	"\x1a\xbf\xf8\x9d\x6f\xa4\x37\x6c\x31\x31\xd1\xa1\x4e\xea\x50\x97\xcc\xd0\xf2\x64" +
+	"\xa8\x79\xaa\x0e\x2c\xd7\x13\x76\x80\x78\x02\xf0\x1a\x52\x52\x1a\x1f\xcc\xa2\xe1" +
+	"\x92\x4d\x5a\x92\x4e\xce\x55\xa5\x36\xee\x30\x1d\x91\xf8\xc3\xe2\x65\x05\x85\x86" +
+	"\x9a\x8b\x74\xc2\x1f\x7a\xf2\xfc\x07\x63\x34\x4b\xf8\xd9\xe1\x2b\x4a\x15\x53\xc4" +
+	"\x5a\xb4\x00\x03\x35\x32\x41\x07\xfb\x06\x82\x58\x41\xa9\x35\x6c\xbb\x98\x4a\xdf" +
+	"\x9b\xb7\x09\x4b\x98\xd9\xd4\x7d\xe3\x9e\xcc\xc6\x3d\xd1\x06\x00\x8e\x52\xd4\x0a" +
+	"\x48\x80\xee\xd5\xf8\x4d\xec\x22\x63\x0c\xa5\xcb\xd1\xdc\xb5\xf6\x29\xbb\xcd\x5a" +
+	"\xdf\x74\x97\x28\xd4\x7a\x85\xfd\x66\xba\x89\xb2\xb3\xa0\xd4\xea\x92\x12\xf2\x55" +
+	"\x3d\x3a\x92\xcf\xf6\xb3\xef\x42\xfb\xea\x5a\x63\x8a\x78\x9c\xa6\x32\x76\xba\x6d" +
+	"\x4b\xe2\xc1\x19\x61\x18\x14\xb8\x6d\xd2\xa2\xfd\x75\xaa\xac\x35\xf5\xd3\xf9\xd1" +
+	"\x15\xe1\x3c\xa9\x74\xd1\x97\x5b\xa2\x27\x97\x62\x32\x4b\xa5\x35\xa5\xb8\x59\x6b" +
+	"\x4a\x11\x66\x0f\x83\x1e\x49\x48\x6a\x99\xa2\x58\x0b\xd0\xdd\x68\x76\xf2\xf0\xd9" +
+	"\x09\x14\x1e\x4f\xe6\x8b\xa9\x07\xfe\xf1\x1e\x18\xaa\x5e\xf4\x61\x9c\x68\xe6\x99" +
+	"\x0f\x25\xe3\x75\xd1\x3c\x50\x69\x1e\xd0\x4f\x37\x56\xf3\x80\xd9\xe7\x3c\x3f\xcc" +
+	"\x05\xc8\x53\x6c\x6c\x7c\xa2\xc2\x07\xf2\x2c\xa1\x45\xba\xf6\x52\xf9\x88\xca\x1c" +
+	"\x40\x60\x6c\xbe\x99\x4a\xfb\x28\x97\x7c\xbd\x5f\xb9\xb1\x75\xe9\xb4\x75\xf0\x65" +
+	"\xc7\x36\x10\x3b\x17\xc9\x77\x30\xb0\xa2\xaf\x67\x86\xe5\x72\x4c\xcd\x01\x56\x6e" +
+	"\x24\xa6\xe9\x8f\xda\xfc\x8f\x37\x79\xd8\x41\x12\xb9\x6a\x26\x79\x85\xb1\x80\xcb" +
+	"\x08\x69\x06\x6d\xeb\x70\x01\x8c\x6b\x7a\x20\xce\x25\xd2\x9c\x56\xff\x08\x8f\x54" +
+	"\x19\x06\x8f\x5a\x36\x56\x85\x4e\x9b\x03\x9f\xef\x6c\xbe\xb3\xfe\x90\x6c\x83\x08" +
+	"\xc3\xf0\xc3\xd6\x05\x02\x8c\xb2\x88\x3a\xbe\xc5\x7f\x79\x3b\xfb\x05\x8a\x8d\x8d" +
+	"\x9a\x6f\x1f\x33\xa1\xc4\x29\x49\xa1\x86\x50\x29\x8c\xcf\xba\xb6\x30\xc5\x89\x8f" +
+	"\x68\x62\x66\x26\xf0\x86\x9e\x31\xcd\xb6\x10\xb4\x8f\x63\x42\x97\xcd\x85\x1f\x87" +
+	"\xcc\x42\x73\x06\x7c\x63\x6f\x9e\x56\x47\x6f\x9b\xea\x00\x54\x8e\x36\xb1\x4b\xe5" +
+	"\x94\x14\x31\x4e\x47\x6d\x0e\x17\x72\xe3\x04\x4e\x48\xdc\x17\x97\xee\x14\x76\x49" +
+	"\xcb\x9d\x1d\x91\xa9\xdc\xc0\x3e\xe8\x69\x6a\xeb\xdb\x7b\x75\x00\x46\x23\x86\xc4" +
+	"\xff\x5d\xc8\x74\x4a\xa5\x74\x25\x66\x67\xa4\xc1\x27\xab\x88\x1a\x8a\xd9\xb0\xc5" +
+	"\x25\x6e\x5d\x32\xa1\xf5\xfd\x39\xdf\x59\x6b\x61\x67\x8b\xd3\x54\xa6\x61\xef\x0f" +
+	"\x12\x73\x22\xac\xc9\x56\xb8\xfb\xc6\xe4\xaf\x9d\xb6\xbe\x7b\xe6\x62\x32\xce\xb3" +
+	"\x67\xe0\xfb\x1d\x44\xf5\x86\xb0\x8c\x18\x4b\x8c\xa0\x45\x9a\xcb\xc0\xf8\x98\x3d" +
+	"\x68\x0b\xe5\xcd\xde\xa0\x54\x63\x1d\xd2\x0e\x60\x24\x56\xe6\x31\x67\x96\x5c\x09" +
+	"\x84\x62\x3e\x5f\xa5\x3a\x61\xa9\xc2\x4e\xa1\x0b\x4a\x50\x91\x52\x65\x28\x51\xa8" +
+	"\x43\x40\x8b\x5d\xec\xbc\xa7\x54\x07\x60\x52\xb6\x96\xd3\x54\x26\x5e\x3b\xab\x4c" +
+	"\x64\xa1\x25\x90\xc2\x30\x7f\x86\xf9\x6a\x67\x21\xab\x1d\x50\x5d\xc9\x88\x13\x66" +
+	"\x46\x09\xb1\x26\x23\x2d\xb0\x6f\xf7\x97\x25\xaf\x51\xd6\x0d\x69\xfb\x48\xe5\x9b" +
+	"\xbc\x52\x2d\x48\x20\x97\x84\xcf\xf9\xcc\x9b\x13\xd5\xcd\x93\x22\x70\x4a\x75\x6e" +
+	"\xe2\x4c\xb0\x51\x6b\x44\x1c\xb0\x2c\xb1\xa8\x0e\x9a\xe4\xd6\xa9\xa4\x1f\xf6\xc9" +
+	"\x13\x8d\x1c\x81\x16\xc9\x80\xc3\xbd\xb8\xaa\x4b\xcd\xb9\x20\x6a\x73\x89\x88\xd6" +
+	"\xe5\x10\x5b\xdf\x03\x69\x26\x02\x8b\xc4\x5b\xd9\xd9\x8e\x75\x21\xee\xad\x54\x96" +
+	"\x68\x1f\xba\xf0\x8a\xe0\x25\xd1\x24\x17\x66\x13\x2b\x8b\xed\xfd\x8b\x02\x83\x11" +
+	"\xc3\x4e\xaa\x11\x8d\x0b\x88\x07\x19\xd1\x87\x63\xb7\x6b\x2b\x3b\xc1\x9c\x57\x6a" +
+	"\xd0\x77\x5a\x76\x43\xe7\xeb\xaa\x02\x8f\x9a\x21\x0d\xc1\x11\x81\x4b\x3e\xfe\x8b" +
+	"\xfb\xc5\x6e\xe2\x23\x4d\x55\x20\x1e\x35\xaf\xe1\xbf\x6b\x4f\xe1\xbf\x8b\x65\xf4" +
+	"\xf5\x64\x80\x69\xe9\x38\xc5\x17\x0c\xd4\x75\xe8\x5e\x7e\xcd\x60\x49\xb3\x81\x29" +
+	"\xd7\xf3\x45\xa0\xaf\xb2\xe6\x2f\xca\xfc\xb8\xe6\x76\x6e\x1c\x80\xb8\x5c\xbe\x9b" +
+	"\xae\x43\xdb\xf5\x3e\x5b\x55\x5e\xb5\xeb\x6f\x5f\x5c\x5d\xfc\x7b\x6f\x5d\x6e\x87" +
+	"\x2a\x9b\x62\x73\x61\x30\x9a\x17\xc5\xf4\xd2\x3e\x0b\x62\x76\xc8\x95\x96\xb2\xb5" +
+	"\x21\x71\xe8\x5f\x96\x50\x61\xa1\xb0\x9f\xa4\xad\x40\xb9\x6f\xb6\x37\xd9\x81\x06" +
+	"\x0c\x63\x02\x33\x69\xa7\x4f\x4f\xa7\x13\x7a\x3e\xf8\x14\xaf\x72\x26\x7c\x9c\x6c" +
+	"\x90\xb0\x11\x1d\xaf\x8a\x40\xcd\x0d\x19\xf7\xa6\x4a\x21\x1a\x25\x47\xdb\xd5\xd2" +
+	"\xb5\x39\x51\x8d\xfc\x69\x2e\x75\xd0\x45\x29\x49\x38\x4c\xe3\xa9\x66\x9a\x89\x11" +
+	"\x2c\x4d\xb2\xc2\x69\x0b\x31\x57\x38\x52\x9d\xd2\xc7\xb2\x89\x2b\xc1\xd1\xe6\xf2" +
+	"\x66\xb7\xf0\x32\x2c\x2b\x06\x57\xef\x41\x90\x02\xb2\xca\x63\x92\x98\xcd\xc5\x27" +
+	"\x35\xa3\x95\x3c\x3f\x7d\x5a\x73\xe9\x12\xc9\x62\xd1\x7f\xbe\xc5\x12\x45\x7a\xb8" +
+	"\xb9\x18\x1c\xa9\x2d\x8f\xf0\x93\xe1\x5f\x21\x4b\xba\x48\x40\x79\x8a\x70\x16\xca" +
+	"\x86\x64\x2a\x33\xde\x98\x35\x75\xa0\x27\x7d\x32\x27\x64\x8b\x0b\x01\xb8\xf3\x7a" +
+	"\x3c\x5c\xa0\x91\xd0\xb5\xf7\x40\x5e\x12\x5f\x13\x79\x2a\x0a\x71\xa7\x72\x20\xe1" +
+	"\xac\x0e\x7a\x69\x92\x81\xa2\xab\x84\x57\x27\x46\x1d\x72\xb5\x47\xf3\xc3\x23\x74" +
+	"\x88\x5a\xa1\x4a\xa4\x02\x45\xc5\xb1\xb5\x2e\xed\xdb\x5c\xfa\xb3\xb9\x6c\x54\xeb" +
+	"\xd2\xf0\x30\xd0\xe8\xab\xd9\xa3\x6e\x2e\x79\xd7\x25\xc3\x9a\xef\x23\x0e\x91\xec" +
+	"\x36\x64\xb8\x9b\x1f\x2a\x30\xb9\x73\xf6\xfc\xe2\x2a\x85\x45\x73\x3d\x9c\x4d\x47" +
+	"\x77\x6f\x84\xaa\xc7\xfc\x12\x86\x3d\x9f\x0e\xf9\xdf\xcf\x17\xa6\xa2\xd4\xdc\x90" +
+	"\x85\x67\xeb\x8e\x33\x13\x6d\xc8\xfe\xb9\x50\x03\xb1\xc7\x15\x7f\x47\x25\x64\xa9" +
+	"\xcd\x05\x0d\x24\x0d\xc4\x24\x79\x5f\x24\x39\x19\xa6\x6b\x61\x41\x14\x67\xb5\xad" +
+	"\x4e\x99\x4c\xfd\xe1\x9f\x08\x5e\x72\xe0\x47\x2d\x08\x76\x18\x61\xb0\xd3\x88\xa7" +
+	"\xc9\x50\x8b\x2b\x75\xf9\xbf\x32\x51\x4c\x95\x8e\xbe\x0b\xb0\xf4\x85\xed\x4e\xca" +
+	"\x5d\x54\x00\x48\x77\x58\xb6\x8f\x76\x84\x9d\x65\x8f\xfc\x9d\xd1\xce\x33\x55\x6c" +
+	"\xcf\x1a\xb7\x4e\x65\xf1\x9f\xb3\x94\xfe\x33\x96\x52\x59\xf3\x8c\x74\xd9\x9a\x67" +
+	"\x02\x5e\x61\x8e\xd6\x0b\x9e\x87\x44\xde\xda\x97\xe8\x2c\x9f\xb9\xaa\xc3\x48\xab" +
+	"\x8b\x9e\x76\xd0\xc2\x2f\x72\x59\x28\xd4\x36\x16\x12\x09\x7e\x45\xac\x1b\xee\x27" +
+	"\x93\x64\x30\x42\x5f\x1f\x91\xb6\x36\xc2\xdc\x61\x67\xc4\xd0\xc0\x8f\x38\x82\xaa" +
+	"\x09\xb1\x20\xcc\xcb\xfe\xfa\x9a\x8f\xb0\x66\xc9\xd9\x70\x78\xe2\x4c\x09\xb5\xec" +
+	"\xb0\x0b\x76\x04\x77\xbb\x34\x05\x7d\xd7\x16\xd2\x3a\x21\x3d\x1f\x7d\xe3\xf6\xc9" +
+	"\x49\x8b\xd7\xb5\x4d\x61\x8f\x3a\x47\xc1\xe0\xc8\xee\x24\xcb\xbb\xb3\x20\xcc\x1c" +
+	"\xd6\xc4\x6a\x28\x25\x29\xc4\x83\xe8\x15\x09\x47\x38\x6b\x62\x99\xc2\x48\x44\x3f" +
+	"\x98\x10\xd4\x83\x4b\x08\xa7\xb2\x53\x4a\x86\xa3\x68\xeb\xca\x47\x0b\x5b\x5a\xf2" +
+	"\x0c\x77\x6f\xa9\x17\x4b\xea\xeb\x49\x3b\xa1\x39\xc0\x48\x67\x6f\x84\xd6\x42\x4a" +
+	"\x10\x50\x49\x4f\x56\xb9\xe9\x11\x0e\x8e\x42\xcd\x08\xcb\xb0\xbf\xe5\x42\xee\x87" +
+	"\xaa\x87\x0b\xbe\x18\x93\x35\x31\xed\x65\xe7\xce\x09\xe5\xbb\xc7\x3d\x3a\xd1\xbc" +
+	"\xed\x48\xa6\xd2\x64\x11\xa7\x3e\xca\x19\xcf\xe2\x44\x5d\xfe\x86\x9e\xec\x2b\xa3" +
+	"\xbf\xe9\x99\x4c\x44\x7f\x20\x78\x37\xeb\x19\x8e\x74\x30\xaf\x9f\xd9\xb7\xe2\x04" +
+	"\x54\x0c\xff\x5d\xa4\x6d\xa2\x9e\xbe\xc9\x25\x99\xfa\xd1\x21\x82\xf5\x32\x39\xbf" +
+	"\x7d\x67\x1e\x73\xc9\xe3\xc6\xca\x1c\x85\x05\xaf\x84\x1c\x34\x0f\x3e\x56\xfa\x10" +
+	"\xa9\x6c\xfb\x1c\xfc\x9b\xc9\xc2\xaa\xdc\xa9\x29\x4e\x58\xb1\x22\x03\xf3\xb3\x8b" +
+	"\x2b\xfc\xa0\x98\x50\xd8\xbf\xe3\xa4\x88\x54\x9a\x9c\x12\x3d\x4b\xcc\x52\x2a\x7d" +
+	"\xee\x22\x9e\xe2\x4f\x68\xe5\x11\x19\x00\xf9\xd8\x71\x3b\x60\xaa\xcf\x3f\xa6\x12" +
+	"\x1d\x2b\xe6\x29\x3e\xc3\x14\x9f\x80\xf6\x93\xce\xf0\x43\xff\x8c\x83\x70\xbc\xa8" +
+	"\x07\x52\xad\x41\x5e\xa7\x0b\x5b\x01\x18\xf8\x24\x2e\x7f\xa6\x35\xb9\x68\x04\x82" +
+	"\xff\x35\xea\x5c\x0d\x95\x43\x2b\x22\xb6\xe8\xa1\x33\x82\x25\x28\xad\x1f\xc3\x6a" +
+	"\xc4\xc0\xe7\x41\x65\x58\xa4\xcb\x4d\xef\x26\x8f\x61\x8b\xcf\xf8\x97\x82\x66\xcb" +
+	"\xfa\x60\x3c\x3b\xd2\x3e\xe7\x95\x29\xbe\x89\x5f\x65\xe4\x74\x7e\x69\x3f\x8f\xbc" +
+	"\x6e\xb0\xc8\x37\x06\x3f\x86\xec\x46\x3f\x48\x41\xd1\x9b\x9f\x5a\x9e\xce\x91\x63" +
+	"\x25\x97\xfe\xc9\x85\xb2\x35\xf5\x65\x38\xe0\xac\x0a\x05\x22\x1e\x0c\x87\x0c\xa7" +
+	"\x3c\x7f\x90\x9a\x93\xf0\x10\xa5\xfc\x27\xdb\x49\x01\x3f\x16\x46\xd7\xf8\x24\x53" +
+	"\x27\x43\x77\xa5\x31\xcc\x9c\xe6\xcb\x4f\xfe\x04\x88\x50\xf0\xb3\xc4\x93\x9e\x5b" +
+	"\xa1\xd6\x25\x2c\x48\x22\xa1\x90\x7e\x56\x54\x1b\x75\xaa\xc6\x33\x54\x62\xae\x71" +
+	"\x42\x88\xd0\x08\x9f\x91\x24\x4b\x3e\x1a\x85\x89\xa3\x5a\x75\xd6\x44\xf9\x5d\xd6" +
+	"\x47\x78\xb3\x19\x42\x33\xfb\x13\xda\x0e\x1f\x41\x2a\xa1\xef\xc8\x6e\xa6\x35\x1d" +
+	"\x2f\x66\x11\x1d\x34\x17\xe1\xa3\xe9\xf1\x67\xa8\x39\x43\x3b\xd8\x48\xd5\x29\xb2" +
+	"\x2e\x26\xcd\xec\x5f\xc7\xe2\xf0\x83\x5c\x24\xe3\x32\x49\x14\x2f\x45\x12\xb7\x2d" +
+	"\xd0\x31\x87\xed\x42\x28\x60\x65\x3d\x71\x70\x3e\x8d\xc3\x49\x48\x5c\x73\xca\x64" +
+	"\xc5\x86\xe3\x13\xb6\x82\x42\x7e\x02\xcb\x8b\xc1\xf8\xe4\xa8\x60\xdb\x60\x75\xb4" +
+	"\x57\x44\x02\x5e\x67\x9a\xd4\x8e\x75\x12\xe3\x13\x0e\x38\x4d\x0c\x79\x2b\xec\x90" +
+	"\x2a\x99\x4c\xce\x6a\x3e\xe2\xaa\xd5\x68\x5c\xe1\x7b\x77\x11\xe1\xb4\x1d\x27\x73" +
+	"\x53\x84\xb6\x11\x52\xd2\xb1\x14\x9d\xe2\x0a\x6f\x4e\x94\x64\x24\xd5\x5a\x5c\x68" +
+	"\x6e\x0b\x03\x3d\xd3\xd1\xfd\xc9\x19\x0b\x6d\x5e\x36\x9c\xb3\xcd\x12\x91\xb3\xa0" +
+	"\x9a\x7c\x60\xe3\x3c\x53\x45\x88\xeb\x7a\xae\x9f\xb0\xf3\xb9\xe6\x7a\xa5\x35\xec" +
+	"\x51\x73\xec\xf6\x46\x2e\xbd\xc1\x4a\x12\x8f\xaa\x86\xc5\xc4\x63\x8f\x6b\x5a\xf3" +
+	"\xcc\x72\xef\x97\x14\x98\xcc\x35\x09\x66\x57\x62\xe8\x90\x32\xa2\x46\x6b\x85\xc0" +
+	"\x70\x74\x66\x0c\x6c\xa0\xcb\x73\x60\x15\x7c\x32\x4c\xd2\x94\xe4\xe3\x78\x7d\x9a" +
+	"\xdc\xa8\xbb\x25\x88\xf4\x44\x0c\x2e\xef\xb0\x9a\x70\x50\x0e\x13\xc4\x63\xfa\x7b" +
+	"\xb1\x36\xa8\x08\xc8\xea\x1c\x97\xb6\x2c\xf7\x33\xe0\x94\x21\x5d\x27\x7a\xe8\xe3" +
+	"\x59\x8e\xfd\xc6\xac\x43\x18\x12\x43\x59\x3f\xe1\xda\x1d\x1c\xa9\x4a\xe2\x89\xb3" +
+	"\x8a\x08\x85\xda\x91\x22\xc4\xd0\xae\x9a\x56\xce\x19\xb6\x8c\x8e\xc3\x11\x1f\xb1" +
+	"\xc2\x2a\xa6\xbf\xa9\x7e\xec\xe8\x69\x2b\x5b\x32\xb4\x17\xa3\x71\x77\xab\xeb\xf0" +
+	"\xc9\x1c\xa7\x6a\x1d\x9e\x6f\x8a\x79\x4a\x7b\x8a\xe7\xcb\x0f\x0e\xd0\x1a\x3e\x9c" +
+	"\xca\x5b\x12\x73\xea\x14\x8a\x4e\x00\xdb\x71\x56\x38\x33\x76\x68\xcb\x65\x37\xcc" +
+	"\xa7\x62\x88\x18\xe9\xfc\xce\x4e\x67\x92\x98\xcb\xed\xe1\x7c\x23\xed\x1e\xce\x57" +
+	"\xae\x27\x7d\xd8\xa3\x5c\x0e\x10\x75\xd4\x71\xc3\x3f\x46\x71\x2e\xc4\xea\x5f\x80" +
+	"\x70\x04\x4e\xce\xa2\xb2\x06\x52\xcd\x7a\x14\xa9\xf9\x41\x2d\xcb\x7f\x50\xe5\xf7" +
+	"\x63\x3e\xa9\x3c\x7b\x3a\x3f\xe3\xd4\x8e\x8e\xb8\xd0\xb6\x15\xf7\x2b\x3c\xf2\x03" +
+	"\x39\xbc\xfc\x07\x55\x3e\x5f\xe6\xf3\x85\x4f\x86\x7e\x19\x71\x60\xc6\xaa\x19\x8b" +
+	"\xd6\x17\xb2\x8e\xe1\x59\xca\x32\x7d\x52\x28\x3f\xf1\x13\xca\x4f\x88\x53\xdc\x17" +
+	"\x44\xe2\x8c\xc2\xfc\x37\x04\x0b\xc6\x21\x2b\xca\x8e\x5b\x88\xd3\xc4\x11\x71\x54" +
+	"\x69\x74\xde\x67\xaa\xe9\x37\x53\x79\x6f\xb8\xdc\x98\xa4\x7f\x6b\xf0\xe7\x42\xb6" +
+	"\x0e\xcc\x05\x07\xf8\x9c\x28\x21\xe8\xcd\x26\xd5\xda\x1d\x89\x3b\x30\xbd\x8a\x09" +
+	"\x8c\xc6\x89\x6a\x4f\xc7\x1e\xfd\x6c\x92\x08\xcb\xc5\xc9\xaa\x73\x3e\x6f\x2c\x63" +
+	"\x09\x16\xc7\x09\x06\x77\x9c\x8a\x4e\xb2\x39\x55\xa4\x42\x96\x7b\x35\xb2\xb2\xdf" +
+	"\x08\x5e\x43\x9c\xca\x96\x77\xd5\xfe\x38\x95\x9a\x9f\x6a\x18\x1d\x2a\x21\x0c\x28" +
+	"\x18\x58\x21\x94\xda\x03\xa9\x3d\xf3\x18\x71\x54\x64\xd2\x3c\x99\x03\xee\x3b\xa2" +
+	"\x37\x06\x08\xa1\x5e\x6c\xd7\x4e\x0d\x69\x4a\xf4\x6c\x02\x3a\x91\x3b\xc9\x7e\xd2" +
+	"\xa0\xe2\xd4\x4a\x9d\x9c\xb8\x55\x9d\xdc\x75\xa9\xe2\x46\x1b\x52\x30\xa5\x1e\x9e" +
+	"\x18\x1a\x10\x2c\xe5\x38\xb1\xfd\x3e\x0c\xf3\x4e\x94\x82\xe3\x58\xc3\x8d\x15\xc6" +
+	"\x55\x17\x2b\xd3\x76\xd5\x45\x49\x60\x01\x6f\xbe\x84\xce\x01\xcc\x16\x59\x50\x54" +
+	"\x22\x43\xcc\xd1\x09\x81\x69\xb5\x0c\xb1\x74\x5e\x8d\xee\x34\x4e\x1f\x4e\x84\x8b" +
+	"\xc2\x01\x8a\x4b\xda\x72\xec\x60\x1e\xc0\xbe\xa7\x6b\x86\xc3\xd4\xd1\x87\xc5\x46" +
+	"\x5a\x39\xa1\x92\xbe\xe0\xf2\x85\xdb\x8d\xfb\x6e\xb8\x69\xca\x7e\x6b\xa8\x6f\x33" +
+	"\xcb\x9a\xe6\xf4\xea\x01\x1e\xa7\x55\xbf\xcf\xd0\xad\xb7\xc5\x3a\x4c\x55\x34\xb3" +
+	"\x58\x78\xb8\xd3\x6e\x93\x83\x6e\x92\xbf\xc2\xf1\x31\xca\xa1\x27\xab\xb5\x9e\xa6" +
+	"\xd6\x8a\x38\x14\x1d\x2b\x1a\x3b\x75\xd1\x41\x5c\xbf\xe0\x7a\x3b\x50\x6c\x65\x85" +
+	"\x89\xd3\x81\x19\x6d\x5b\x59\x3c\x53\x1d\x2f\xdf\x9a\x6f\x57\x5e\x50\x5d\x57\xf6" +
+	"\x7f\x75\xee\x54\x2b\x32\x0f\xc3\x57\x67\x03\x6f\x9e\x56\xad\xdb\x3e\x23\xbc\x43" +
+	"\x6c\xc9\x1b\x76\x1f\xb4\xc7\x2f\xd8\x53\x66\x9a\x6f\x47\xdf\x21\xb5\xd7\x57\x2c" +
+	"\x5d\x6b\x8e\x69\xfd\x22\xfd\xc3\xc0\x19\xef\x66\x3d\x0c\x21\x2b\xba\x52\x97\x9e" +
+	"\x4b\x46\x0d\x28\xdd\x80\x88\xfb\xbb\xf6\xef\x9a\xc0\x02\xb7\x57\x05\xe1\x7d\xbe" +
+	"\xcd\x3d\x51\x06\x5b\xc7\x77\x38\x9e\x80\x9a\x96\xec\x6c\x97\xae\x33\x37\x1c\x25" +
+	"\xc6\x84\x33\x3d\x71\x9e\xaf\xed\x5a\xfd\xcb\x39\xfc\x36\x6e\x37\xf6\x70\x5d\x63" +
+	"\xc1\x5e\xed\xff\x43\xf3\x40\x83\xc5\xd6\xa3\x50\x93\x15\x75\xeb\x1e\x96\xb8\xa6" +
+	"\xb8\xc1\xbd\xbe\xf0\x0b\x84\x94\x23\xaa\x0c\x72\xfa\xf5\xb2\x78\xe5\xf4\x3b\xe8" +
+	"\xd2\x81\x7f\xa6\x81\xbb\x27\xc5\x29\xd0\x4d\x2c\xd6\xbc\xa8\x07\xc8\xd6\x07\xcd" +
+	"\x59\x5f\x04\x29\xe6\x55\x67\x0d\xbf\xf0\xf2\x6d\xe1\xd1\x0c\xfd\xb7\x2f\x0b\xb8" +
+	"\xeb\xdc\x25\x27\x8f\x10\x09\xc7\x61\x61\x2f\x6b\x1e\x00\xa3\xff\x5d\xec\xa9\x79" +
+	"\x0d\xba\x35\xb7\x95\xca\xc0\x65\xdd\x1c\x34\x07\x16\xdf\xff\xae\xec\x47\x37\xbe" +
+	"\xb0\x3b\x39\x9d\xc0\x19\x74\xa4\x60\x60\x09\xc6\xb9\x22\xab\x31\xd7\xbc\x95\x5d" +
+	"\xd3\x36\x0e\xce\x4f\xd1\x52\x28\x24\x47\x1f\xe6\x94\xa3\xdb\x46\xb8\xdf\x2b\x87" +
+	"\xa1\x61\x66\x29\x60\x3c\x52\x75\x3f\xf3\xaf\xf1\xe1\xf1\xe1\x23\xeb\x7c\xfa\xc6" +
+	"\x9c\x1d\x23\xf0\xd3\x04\xf6\x01\x38\x73\x19\xe2\x7c\x1d\xd7\xee\x17\x66\xcc\x17" +
+	"\xf5\x71\x61\x37\x9f\x4d\x0a\x56\x0d\x4e\x89\x60\x89\x8c\x6c\xa8\x56\x2d\x84\x48" +
+	"\xe4\x17\x44\x22\x35\x4a\x2c\xc9\x68\x35\xaa\x72\xc3\x40\x83\x04\x00\x87\x4b\x66" +
+	"\xd2\x20\x22\x0f\x7e\x9e\xf0\x46\xdd\x91\xf9\x02\xb5\x71\x7c\x28\xae\x5f\x50\xc9" +
+	"\xeb\x72\xfe\xe6\xc2\xbd\x00\x64\x44\x39\x74\x6a\xb1\xf5\x92\x31\x64\x49\x44\xbe" +
+	"\x28\x2e\x9c\x3a\xa3\xe1\x0b\xa8\x48\x0c\x28\x1a\xe6\x9b\x4e\x16\xa7\xaa\x4b\x32" +
+	"\x51\x89\x19\x68\x8e\x9c\x5a\xf8\xaf\x64\xa3\x81\x80\x92\xf8\x5f\x64\x60\xba\x8f" +
+	"\x71\xe0\x7c\x48\x8d\x2c\xff\x15\x76\xf0\x31\x31\x61\x75\x47\x9b\x7b\x61\x69\x66" +
+	"\xc4\x3f\x09\x50\xb9\xfb\xcf\x37\x71\xcd\xf6\xcb\xbd\xb7\x54\x61\xe8\xd8\x60\xb9" +
+	"\x61\x5a\xbe\x34\x16\x88\x59\xf5\xf1\xf5\x1f\xed\x1d\x08\xd6\xbf\xe9\x5b\x5b\xee" +
+	"\x60\xa5\x33\xb7\xd5\x13\x6b\xe8\xe3\x68\xed\xb4\x3b\x1a\x71\x55\x77\xec\xe9\xaa" +
+	"\x9e\xd8\x9a\x7a\x78\xc9\x69\x4f\xab\x0f\xb9\x1d\x99\x2d\x25\xb5\xb1\xe2\x16\xf1" +
+	"\x0e\x40\xd0\xc3\x45\x14\xc6\x53\x73\x7a\xd9\xd1\x4e\xdb\x6b\x00\x39\x2c\x13\xfe" +
+	"\xca\x9a\xda\xec\x1f\xde\xf1\xf9\x05\x89\xa5\xa8\x2e\x15\x6f\x0d\xa5\xb7\x82\xe2" +
+	"\xf3\xb8\xa5\xf4\xe6\x50\xac\x39\x94\x5e\x2e\x65\xe4\x1d\x61\x3e\xf4\x16\x82\xd5" +
+	"\x25\x64\xfb\xa4\xba\xe1\xee\x1f\xb7\xa6\x1d\xd1\x10\x3c\xd4\xfc\x20\xea\x6b\x55" +
+	"\xb8\xd7\x7a\xbe\x90\x6b\x8f\xcb\xaf\x67\x5a\x74\x18\xb8\xff\x81\x5b\xd7\x74\x60" +
+	"\xe9\xfe\x8d\xfb\x69\x3d\x83\x23\x75\x60\x5d\x23\xe8\x01\x69\xdb\x29\xdd\xa8\x7e" +
+	"\xc1\xee\x4e\x9c\xa1\x0e\x3d\x24\xae\x8d\x30\xe2\xda\x66\xfb\x1f\x1d\x7f\x1c\xa5" +
+	"\x4e\xd7\x6e\xa2\x0e\x0d\x74\x3a\xc9\xdd\xd1\xa9\xad\xed\x01\x7b\x5e\x6f\x9d\x07" +
+	"\x8f\xb6\x7e\xc1\x2f\xe3\x1b\x7a\x41\x24\xf8\x4d\x71\x81\x1f\x8d\x55\x6e\xfe\xdd" +
+	"\x7e\x3d\xd6\x1e\x65\x0f\x72\x84\xd7\x03\x1b\x21\xe5\x56\xdc\x45\xe9\x2e\x34\x2d" +
+	"\x5c\x7e\xcb\x1c\xe7\x0e\xd4\x03\xfd\xfa\x51\xa5\x8e\x5a\x47\x0a\x2a\x55\x78\x0b" +
+	"\x46\x5a\x88\xa9\x9d\xaf\xa4\x61\xa5\x23\x9b\x71\x77\x5d\x0b\xf1\xf1\xb0\x54\x3f" +
+	"\x38\x33\x27\xbd\xd6\x60\x8f\x47\x9a\x7b\xaf\x2e\x4c\xff\x7a\xa6\x7f\x34\x84\xe4" +
+	"\xdb\x1a\xf1\xf1\xc8\xab\xaf\x19\x3b\xbc\x4d\x3d\x94\x8e\xcd\x42\xfb\x16\x80\x7e" +
+	"\xec\x77\x60\x1a\x83\xb0\x87\x56\x25\x68\x62\xd6\xf2\xbb\x6a\xc0\x78\x3c\xd6\x3e" +
+	"\x50\x3c\x1e\xeb\x65\x8d\xf0\xf1\x60\x5a\xd8\x67\x72\xae\x5b\xbb\x85\x05\xd8\x27" +
+	"\x89\x42\x04\xc8\xae\x57\x88\x8f\x95\x9b\x3b\xe2\x63\x85\x8f\x17\xd9\x03\xbb\xe5" +
+	"\x3b\xa5\xd5\x2d\x68\xd1\xce\xea\xd9\x49\xf1\xbd\x53\x42\x39\x53\x65\x3f\xd7\x21" +
+	"\xbb\xb4\x0e\x9b\xae\x60\x13\xec\xb7\x32\x49\x03\x0f\xe3\x46\xc8\x92\x6b\x1e\x88" +
+	"\xac\x5c\x7c\xc2\xfe\xa4\x96\x3c\xd3\xbe\x44\xa3\x94\xf3\xf3\x59\x23\x83\xff\x8d" +
+	"\x96\x01\x55\x4a\x1d\x53\xe4\xcf\xa7\x46\xb4\x2f\xf8\x9b\xbe\x20\xdf\xcf\x34\xd4" +
+	"\xbe\x27\xb2\xa0\xf1\xc9\x9c\x75\xd0\xc5\x81\x0a\x59\x56\xe6\xf2\xf4\x4e\xfc\x59" +
+	"\x6c\x5d\x00\x45\xb8\x9b\x2b\x1a\x38\x55\x69\x62\xf1\x56\xb1\x40\xde\xc0\x9e\x55" +
+	"\x47\xff\x74\x5e\xf2\xd6\xa4\xa0\x72\xd5\x81\xa3\xe8\x72\xab\x79\x75\x82\xcf\x4e" +
+	"\xd9\xb6\xdc\xf8\xcb\xa1\x55\x8a\xbc\x89\xdd\xe3\x94\x1c\x21\xc3\x16\x73\x9d\xe8" +
+	"\x19\x43\xcc\x75\xae\x91\x5e\x30\xd7\x07\x82\xc1\x31\x57\xff\xdc\xb9\xc2\xfc\xc4" +
+	"\xc2\x02\xaa\x1e\x50\x45\xe0\xc8\xa8\x05\x00\xad\x1d\x28\xec\x8d\x4d\x16\xd1\x5d" +
+	"\x5a\xe6\x4f\xb9\xc2\x3f\x4d\x03\xc8\xa1\x29\xba\x1a\x29\x57\xbb\x91\x08\x03\x5d" +
+	"\x25\x10\x73\x3d\xe1\xf8\xe0\x44\x37\x90\x89\xc8\x07\x9a\x46\xd9\xe5\x06\xbb\x9f" +
+	"\xbd\xb3\xcd\x54\x72\x0e\x55\x92\x1d\x90\x69\xa0\x1c\xa2\x4c\x89\xe3\xd5\xeb\x94" +
+	"\xf7\x02\xb9\x28\x90\x75\x7e\x21\xe8\x10\xd7\xb9\xbc\xca\xda\x69\x9e\x6f\xd4\x06" +
+	"\x6c\x9b\x20\x75\x85\x44\xac\xea\x4e\x51\x15\x3b\x5d\xa6\x62\xb7\xee\x6f\x7d\x46" +
+	"\xdc\x3c\x13\x5b\x48\x04\xf7\x2c\x35\x9f\x2f\xc5\x99\x29\x98\x35\x27\x7a\x8e\xa5" +
+	"\x23\xd5\x25\x0c\xe5\x42\x05\xb0\x9a\x14\x34\x5a\x24\x1d\x64\xee\xc3\x19\x5c\x49" +
+	"\xf4\x5b\xd9\x49\x3d\xe2\xf1\xfa\x4c\xeb\x9a\x9e\x3d\xac\x0e\xdd\x38\x39\x59\x3a" +
+	"\x55\x01\x81\xdc\x41\x66\x84\x73\xa8\xd8\xd6\x44\xa2\xe1\xb3\x3a\x36\x0f\xf5\xa9" +
+	"\xf2\x11\x70\xbf\xf5\x69\x56\xce\x2a\xeb\x68\x19\xd0\x4f\x66\xc1\x5f\xb3\xcd\x6f" +
+	"\x4e\x00\xf5\xf8\xb2\x8f\xa6\xeb\x8a\xc4\x1a\x36\xae\x7b\xd2\x9e\x6e\x24\x43\xef" +
+	"\xf0\x1d\x2c\x1d\xf5\xa3\x5f\x9d\xe5\x36\xa0\x79\xa2\xc8\xd6\xe2\x13\xbd\x75\x45" +
+	"\x4e\xd5\xbc\xbe\xad\x35\x72\x68\xac\x27\xc7\x90\xf9\x53\x7a\x8b\xa5\x97\x8b\x7b" +
+	"\x8c\x2c\x4e\xcd\xb8\x9a\x71\xf5\x24\x5c\xcd\x13\x80\x5f\x84\x46\x9c\xe0\x58\x9f" +
+	"\xbf\xe0\xfe\x78\xc5\x89\xa1\x26\xae\xff\x68\xf4\x4d\x0e\x4d\x53\x56\x36\x0a\xa4" +
+	"\xe2\x49\x6b\x85\xf5\x1c\x3c\x9c\x01\xdd\x72\xe4\xdb\x27\xa8\x6b\x9f\x9b\x02\xa8" +
+	"\x68\x37\x72\xdb\x3e\x54\x75\xec\x01\xed\xbd\x2c\x1d\xff\xde\x3e\xbd\x6f\xbc\x75" +
+	"\xee\x45\xa4\x5f\x68\x17\xd7\xb3\x5e\xd6\x56\x04\x34\xcc\xae\x17\xfa\x8b\x79\x30" +
+	"\x68\xe3\xba\x0d\xa9\x7c\x6f\x2e\x4f\xba\x6a\xa7\xe2\x89\x18\x94\x1b\xf5\x9f\x5b" +
+	"\xfc\xab\xb9\x97\x19\x98\xb9\x72\xb7\x4d\xb1\x35\xa6\x7b\x5f\xb0\x7d\x61\x0d\xfb" +
+	"\x08\x30\x60\x86\x50\x95\xe8\x6f\x5c\x6d\x58\xec\x98\x9b\x9f\x54\x0f\xb6\x89\xb3" +
+	"\xda\x71\x9b\xae\xd5\x2b\xc1\x36\x21\xaa\x8f\x19\xad\x76\x4b\xce\x20\xdd\x10\x25" +
+	"\xc2\x6c\x71\x3b\xeb\xa0\x31\xf0\x3e\x79\x4b\x2e\x76\xe0\xf7\x1e\xca\x77\x1f\x15" +
+	"\xb7\xac\x1b\x8b\xe2\xb6\x21\xa8\xa0\x2f\xba\xed\xa9\xc1\x0c\xdc\x60\x5e\x73\xff" +
+	"\x14\x8b\x97\xad\x68\xe3\x25\x6e\xa5\x1e\xaf\x8e\xce\xfc\xd7\xbf\xf6\xd6\x59\x2c" +
+	"\xdb\xe0\xb0\x08\x11\xfd\xc1\x5f\x8b\xb1\xed\x78\xe5\x49\xc3\x4f\x47\xad\x43\x0b" +
+	"\xf2\x78\x71\x93\x0f\x77\x58\x61\xbe\xe6\xc7\xe0\x5c\x9b\x4e\x59\x34\xc4\xf0\x86" +
+	"\x21\xd7\x66\x80\x2e\x1b\x17\x1d\x2c\x1d\x99\x67\x76\xf7\xa4\x7a\x91\x88\x9f\xbc" +
+	"\xc7\xb5\x3c\x9d\x68\x94\x14\x52\x42\x84\x60\x03\x09\xb0\x33\x15\xd7\x0f\x61\x18" +
+	"\x5d\x3a\x68\x0e\x54\xe4\xb2\xcb\xdd\xe8\xec\xd4\x0d\x54\xfd\x1c\x78\xec\x9f\x53" +
+	"\xbd\x3a\xe8\xaf\x87\xfd\xb7\x87\xfd\x97\x22\x30\x9b\xee\xc4\x23\x41\x52\x78\xee" +
+	"\xa0\x9d\x37\x6a\x0e\x95\xdc\x93\x1d\x2b\xc5\x33\x27\xee\x26\x19\xa0\x39\xaa\xc3" +
+	"\x92\x89\xec\x3e\xbf\x4a\xd5\xaa\x41\x48\xa9\x00\x58\x9c\xa0\xd6\xf7\xa9\x00\x69" +
+	"\x5e\x8a\x38\x27\x86\xd2\x00\xb2\x64\xf2\xaa\x1a\xb4\xf7\x79\x2c\x5b\x7b\x09\xe7" +
+	"\xcf\xb4\x36\x11\xf5\x0d\x50\x8b\x41\x9b\xa8\x71\x7f\xd6\xac\x7c\x43\x95\xb5\xa7" +
+	"\xd4\x4c\x33\xc4\xfd\x1e\xe5\x7d\x4f\x7f\xfc\xcb\xee\x7b\x42\xb6\x3c\xee\x7b\x7e" +
+	"\x65\xf8\x71\x5f\xf0\xcb\xd8\xdd\xe6\xaf\xb5\xca\xd0\x91\xfb\xbd\x78\xa5\xe1\x72" +
+	"\xb5\xe1\xdc\xa1\x62\x2c\x97\xda\xa9\x83\x65\x20\x2a\xd4\x3f\x9a\xdb\xf5\x40\x96" +
+	"\xc5\xbd\x58\x4c\x9f\xd8\x43\xfa\x78\x8c\xf4\xea\xfb\x2e\xb6\xcf\x5e\x36\x86\xe1" +
+	"\xe6\xc8\x2a\x23\x08\xbf\x65\xff\x6c\x9a\x94\x0b\xb9\x9d\x21\x9a\xf0\x5e\x6a\xfa" +
+	"\xa3\x62\xe7\x12\x54\xeb\x1d\x24\xba\xa4\x68\x4e\x4d\x02\xe9\x0e\x3b\x3c\xbf\xa0" +
+	"\x3e\x91\x4a\xef\x4c\xfb\x1e\x3b\x25\x06\xfa\x6f\xd5\x9f\xf3\xc5\x1d\x02\x71\x47" +
+	"\xf8\xeb\x28\x34\x1a\x8d\xc8\x5f\xfa\x76\xfe\x3f\xed\x3f\xce\x49\xe2\x49\x53\xf7" +
+	"\xd8\x1c\xca\x4d\x76\xd9\x1b\xe0\x9c\x0f\xfb\x90\x1d\x2e\x5f\xf8\xcb\x89\x15\x6b" +
+	"\xd7\xe8\x0b\x9f\xb9\x57\xc2\x33\xff\x4f\xed\x17\x3a\x82\x80\xcd\xd7\x63\x41\xc8" +
+	"\xd1\xd3\xe1\x3b\xbe\x98\xaa\xe6\xca\x01\xca\x9f\x9c\xdc\x3d\x88\xf4\xff\x31\x76" +
+	"\xa0\x51\x15\xfa\x47\xf7\x80\xc6\xfd\xa3\xdb\x77\xf9\x1a\xf4\x74\xfb\x07\x69\x0d" +
+	"\x2e\xc0\xe3\x5a\xe4\xb8\x7f\x34\x83\x14\x69\x21\x22\xc3\x64\xea\x2b\x31\xb5\x06" +
+	"\x59\x45\xea\x48\x8e\x7a\xdb\x39\xe2\xf9\xe2\x8c\x23\x37\x9f\x91\x1a\x64\x59\xc5" +
+	"\x07\x3c\xd2\x9f\x10\xed\x7a\xd0\x78\x70\x13\x0f\xae\xd8\xc4\xfe\x1c\x2d\x0d\xa8" +
+	"\xe2\xd6\x17\xf0\xe0\x4a\xef\xf0\xfc\x33\xa5\x8e\xfe\x7e\xa1\xe5\x86\xc3\xe5\x5f" +
+	"\x15\x79\xff\x98\x47\x69\x08\xc8\x38\xc5\xd1\xc1\xcc\x6a\x71\xe0\xc7\x30\xb9\xfb" +
+	"\x88\x22\x3b\x8e\x58\xe9\x02\x0e\x17\x22\xef\x80\x42\x04\xfa\xe0\xc3\x51\xf1\xd5" +
+	"\x49\x3f\x4c\xde\x83\x92\x00\x8f\xac\xec\x1a\x98\x1b\xba\x71\x73\xb3\xd6\x7d\x76" +
+	"\x46\xc2\xc1\x65\xc2\xaa\x54\x5c\xb2\xb0\x38\x51\x7f\x27\x03\x77\xbf\x2f\x99\x02" +
+	"\x3d\xd2\x99\xdd\x2d\xa0\x39\x52\x97\x7c\x1c\x6b\xbc\x96\x4e\xd3\x40\x9e\xc4\x95" +
+	"\xb3\xb9\x32\xf3\x49\x65\x47\x0a\x64\xcd\xf3\x58\xfa\xba\x6e\x75\x37\xb3\x98\xb8" +
+	"\x1d\x0d\xd7\xbd\x50\x3a\x96\x05\x07\x7d\x3b\xb5\x14\x08\xa5\x8e\x3c\x2d\x18\xf6" +
+	"\xec\x77\x3c\xfc\x0b\x70\xe8\x9c\x8a\x6f\x28\x0d\xa0\x30\x2b\xf7\xdb\x4c\x6b\xfa" +
+	"\xc2\x9f\x81\xcf\xdb\xd8\x93\xe0\xb8\xdd\xd8\x93\xe1\x9a\xb2\x7f\x69\x7e\xdd\xfb" +
+	"\xcb\x3f\x86\xa1\x1e\xd0\x2b\xed\xcd\xf3\xed\x9a\xee\x9e\xde\xe1\x1b\x7a\x6c\x32" +
+	"\x46\x6a\x48\xda\x82\x34\x5d\x07\x23\x07\xc5\x77\x4d\x3a\x6c\x03\xf6\x74\x96\xa3" +
+	"\x8e\x8c\x39\x76\xf7\xa6\x59\x3f\xdf\x3a\x49\xd9\xdd\xfa\x9e\xde\xa1\x73\xcb\x5f" +
+	"\x2f\xdf\xf2\xeb\x95\x97\x13\xa2\x9d\x7a\xf0\xbf\x3d\x31\xbf\x23\x29\x7f\x34\xe4" +
+	"\x7d\x63\x77\x6b\x9a\x9e\x14\xe9\x46\xd4\x5d\x47\x68\x35\xcf\x88\x0d\x18\x95\x81" +
+	"\x5c\x55\xb3\xc5\xe6\xf4\xa9\xd3\xc1\x2d\x78\x11\x49\x06\x4d\x89\xe9\x2e\xe9\x25" +
+	"\xfe\x77\x25\x84\x03\x42\xac\x71\x92\x82\x61\x9d\xf2\x09\x07\x61\xfd\x0c\x3c\x10" +
+	"\x31\x0f\xcc\x80\x51\xf1\xc0\x3e\xb1\x13\x24\x23\xf9\x32\x75\x7e\xc5\x74\x8f\x1f" +
+	"\xb7\xb6\x9d\xff\xea\xda\x97\x96\x68\x10\xa8\x53\x98\xaf\x29\x23\xc3\x1d\xfc\xac" +
+	"\x94\x9f\xa2\x12\x39\x3f\x87\x07\x9a\x74\x99\x22\x86\x99\x63\xca\x37\x6a\x37\x34" +
+	"\x4e\xc7\xb7\x6e\xfd\x7c\xeb\xdd\xff\x6f\x77\xf5\xdb\x0d\x3c\x56\xdb\xb4\xa8\xcc" +
+	"\xe2\x2a\x17\xdc\x05\x5d\x31\x77\x3a\x51\x58\x5a\x12\xd4\xe5\xd2\xb8\x01\xef\x17" +
+	"\xd9\xf6\x1d\x8e\x51\x7d\xaa\x71\xd6\xe7\x65\x4d\xcb\x40\x12\xd8\xba\x15\xc0\x1b" +
+	"\x8c\xeb\xf0\xc9\xa0\xc1\x21\x59\x53\xb9\x2d\xce\x58\x96\x7e\x7e\x16\xed\x59\xe6" +
+	"\x2a\xd6\x64\xc7\xe7\x17\xcc\xf3\x97\x46\x01\x9e\xaf\xcd\x46\x87\xca\xb9\x17\x11" +
+	"\x4f\xc3\x16\x7b\xac\x1b\x1f\x80\xdc\x70\x9e\x2c\xd6\xed\x71\x51\x2c\x9b\x32\xc8" +
+	"\xb0\x27\x43\xf1\x45\xeb\x56\x58\x48\xd5\xed\x80\xd3\x51\xcb\x16\x4d\x25\x2a\xc4" +
+	"\x3d\x15\x11\xa2\xd3\xdc\x54\xdf\x54\xba\x88\xf5\x3b\x21\x1c\x49\x95\x76\x3a\x0c" +
+	"\x3c\x49\x03\xb7\xae\xa9\x1d\x74\x13\xb5\xa4\x5a\x19\x75\x51\x3d\x08\x66\x5a\xb5" +
+	"\xea\x68\x47\xac\x7e\xd9\x2a\xc4\xac\xfd\xb0\x9d\xd9\xc8\x25\x8b\xb2\x4e\xdd\x20" +
+	"\x39\x71\xad\x7b\x28\x4f\x3a\xf9\x8e\xce\x01\xf4\x5d\x11\x4d\xc3\xe0\x8d\x9f\x06" +
+	"\xfb\xdf\xa8\x4a\x81\x03\x1e\x12\x22\xd2\x0a\x51\x40\x5f\xf2\xd4\x43\x03\xf5\xb4" +
+	"\x42\x3e\x5f\xdc\x06\x24\xf3\x3c\xbf\x10\xd3\x13\x5a\x07\x75\x2b\xdf\xaa\x1e\x70" +
+	"\xb9\xb1\xa5\xb4\x6b\x2c\xd6\x8c\x30\xfc\x00\x16\x06\xe7\x28\x3a\xf0\x34\x39\x54" +
+	"\x66\xb8\x4e\xdf\x93\x96\x1b\x4b\x39\x18\xee\x5a\xc8\x01\xac\x0a\x01\xcc\x89\x8c" +
+	"\x39\xe7\xd4\x29\x97\x55\xcd\xea\xf5\xd9\x96\x41\xa8\xe5\xe3\x30\x75\x33\x25\x14" +
+	"\x64\x83\x80\x6f\xd9\xb4\x7e\x79\xf6\x40\xee\x41\x51\xfa\x8d\xb1\xb1\x5f\x1c\x11" +
+	"\x59\xbc\xb7\x97\x4e\x3e\x72\x68\x19\x6f\xea\xf3\xb9\xee\x3c\xa1\x39\x55\x37\xb4" +
+	"\x4c\x69\x5a\x92\xa2\xe2\x80\xc3\xcd\x51\x57\x19\x84\xc8\x25\x6f\xa7\x13\xec\x8e" +
+	"\x57\x5c\xa5\x1f\xdb\x5e\x03\xca\x9a\x2b\x59\x8c\x2c\x4e\xdd\xd8\xf2\x24\x10\xc4" +
+	"\x73\x68\x0d\x01\xbf\xfd\xbc\x91\x19\xb2\xc1\x39\x80\xbe\xe5\x8d\x97\x2f\x8d\x45" +
+	"\x6e\x3a\xf3\xc9\x9d\x6d\x4e\xdd\xe5\xb5\x5a\x7d\x36\x28\x57\x09\xbe\xc2\x00\xcb" +
+	"\x40\xc3\x32\x7f\x41\xf3\x8b\x4b\x4a\xca\x04\xa4\x9a\x2e\xbe\x44\x57\x3d\x41\x9d" +
+	"\xdf\x68\xc8\x92\x95\xba\x1c\x96\x41\xba\x4d\x21\x33\x69\x9d\x78\x00\xfc\x1c\xf4" +
+	"\x90\x5a\xdb\x7a\x74\x06\xbb\x03\x3a\x9b\xc6\x3b\x16\x68\xe9\x95\x9a\x6e\x53\xa5" +
+	"\x1c\x8c\x47\xd9\xfa\xe5\x0d\x66\xd4\x83\x71\xd8\x3d\x18\xdd\x7c\xc9\x38\xe0\x72" +
+	"\x63\xcf\x89\x96\x49\xad\xf5\x3b\xa0\x3b\xbc\x0d\x3d\x0d\xad\x7b\xf6\x3e\x44\x48" +
+	"\x56\xc5\xa7\x4b\x1d\x2d\x37\xbc\xed\xdd\x4f\x19\x53\xa5\x1b\x9b\xa3\x5e\xda\xa5" +
+	"\xaf\x14\x5a\xbb\x58\x59\x88\x2c\x4e\x9b\x51\x0f\xec\xda\x7c\x07\x73\x40\xb3\xf7" +
+	"\xc9\x73\x47\x6e\xe8\x26\x16\x0b\x67\x5e\x69\xed\x42\xee\xc0\x42\xa5\x7f\x6a\x3f" +
+	"\x06\x74\xa3\x85\x03\x1c\x9d\x2f\xfa\x79\xc4\x91\xc5\x3b\x73\xa0\x10\x0d\x7a\x10" +
+	"\x1d\x2b\x06\xb3\xf1\x3c\x9d\x48\xe7\x75\xea\x1e\xaf\xde\x23\x9c\x1a\xac\x9b\x37" +
+	"\x5c\xae\xb0\x8f\xd7\x79\xa3\xe6\x90\x63\x86\x5f\xf0\x36\xb6\xe9\x86\x6b\x14\x84" +
+	"\xc4\x28\xda\x47\x4c\xc1\xf6\xd9\xb6\xa4\x7b\x55\x6f\x28\xf7\xa6\xf9\x04\xa4\xa6" +
+	"\xc5\x4e\x14\x3d\x3b\x6d\x00\x3d\xde\xe0\xb4\x1b\xe4\x79\x00\x8c\x56\x86\xfc\x73" +
+	"\x2b\xed\x40\xd8\xe0\x86\x72\x56\x08\x96\x14\x8a\xe0\x50\xcb\xc7\xb3\xec\xbe\x80" +
+	"\x39\x6b\x99\x59\x72\x3a\xb2\x55\xa9\x41\x52\x76\x6a\xca\xcb\xd5\x11\xaf\x7e\xb9" +
+	"\x84\x7a\x3b\x99\x8c\x6b\x25\xbc\x94\x07\xec\xf7\x4e\xa4\x3a\x80\x19\xd1\x05\x89" +
+	"\xd8\xec\xf9\xec\xbd\xad\x40\x0f\x2e\x7b\xdb\x3a\xb9\xa4\x7a\x50\x8b\x1e\x13\x77" +
+	"\x07\x54\xa8\xea\xf4\xb9\x38\xc0\xa5\xc2\x1c\x9e\x37\xea\xce\x3c\x8c\x7d\x49\xdd" +
+	"\x99\x64\xe3\x65\xd4\x43\xdd\x17\x37\xb1\x2a\x6e\xe8\xd7\xdd\x77\x51\x9b\x93\x73" +
+	"\xf3\x4e\x2c\x50\x95\xb0\x2e\xa3\x8c\x67\x9f\x00\x3b\x50\x78\x6c\x4a\x7d\x06\x90" +
+	"\x11\x42\x9a\x9d\x5a\x20\x20\x4f\xc7\x90\xe0\x1c\x70\xf8\xf1\xd4\x38\xb4\xe5\x82" +
+	"\xb9\x32\x90\xa7\xcb\x05\x74\x5c\xf3\x85\x96\x1b\xde\x8e\x7b\x94\xc2\x64\xfa\xe2" +
+	"\xae\x88\x10\xbb\x08\xaf\xd1\xb4\x0c\xc4\x77\xbd\x7a\xa2\xae\x25\x7a\x0d\x12\x82" +
+	"\x8d\x74\x2d\x26\x06\x29\x6a\x7a\xe3\x46\x3b\x90\x5b\x2e\x79\xbd\x16\xa6\x71\x97" +
+	"\x4b\xc8\x41\x95\x16\x01\xc5\x6c\x60\x58\x7a\x84\x59\x7c\x47\x4c\x8a\xad\x2d\x0c" +
+	"\x0e\x3b\x8b\x92\x0e\xf4\x72\x99\x30\xa3\x0a\x47\x5d\xfe\x55\x63\xe0\xbc\xe5\x1a" +
+	"\xe2\xd5\x0e\x16\x79\x0e\x3c\x27\x74\x3e\xc9\xa9\x5a\x02\xb7\xcc\xb2\x1a\xbc\x91" +
+	"\x4d\xe9\x87\xee\x4c\xff\x68\x96\x6f\x9d\x3e\x64\xd7\xf6\xe0\x6c\x5e\xab\xb3\xd2" +
+	"\xf9\xf4\xcd\xd3\xae\xa3\x9e\x0d\x9d\x9a\xa4\xe3\xee\x75\xf0\xc7\x5d\x37\x5c\xff" +
+	"\x1d\x81\xda\xb2\xf5\x3a\xd5\xb5\x5c\x7d\x0a\x75\x9d\x14\xfb\x59\xa6\x5c\x38\x43" +
+	"\xf5\x22\x1f\xff\x18\x28\x06\xb3\xbb\x91\x05\xca\x04\x3b\x0d\x30\x8c\x3c\xd8\x2d" +
+	"\x7e\x15\x4a\xe9\xcb\x47\x2c\xca\xa4\x1d\xe6\x01\x9b\xe3\x9e\xcb\xb2\x17\x58\x8d" +
+	"\x1d\x5a\x00\x47\x33\x8a\xe3\x3f\x08\x10\x30\xef\xe3\xf0\x42\x3f\xc2\xe0\xec\x0e" +
+	"\x34\x24\xef\x37\xb2\x9f\x81\x10\x23\x02\x29\xa8\x9c\x6d\x84\x6a\x97\xe9\x37\x69" +
+	"\xda\x2f\x82\xeb\xb4\xc3\xc5\x13\xe1\xda\xa4\x64\x3c\x6a\xa3\x4b\x07\xdd\x46\x95" +
+	"\xd2\x05\x4f\x20\xca\x19\x12\x21\xb2\x57\x51\x0c\x59\x79\x00\xb5\xf3\x97\x31\xec" +
+	"\x37\x4b\xca\x1a\x31\x8d\xa5\x74\xea\xd7\x34\x59\x67\xc5\xfd\x84\xa6\x12\x4a\xbf" +
+	"\xb5\x50\x06\x0d\x3d\x13\x72\xd4\xdd\x89\xb9\xfa\xb1\xfb\xd7\xa2\xdf\x24\x12\x11" +
+	"\x23\xc0\xdc\xa4\x61\xa1\xc3\x58\xa1\x54\x85\x52\x9d\xb7\xc0\x6d\x3b\x21\xbe\x31" +
+	"\x93\xf3\xc4\xc9\x8d\x10\xdf\x5a\x11\xa0\x92\x66\x7f\x20\xea\xfd\xe3\x61\x8b\xca" +
+	"\x42\xc8\x43\xf2\x64\x68\xdd\xf0\x47\xff\x16\xe2\x1f\xcd\xee\xff\x64\x06\x9f\x3f" +
+	"\xec\x5b\xfc\xe1\xb8\x5b\x9c\xa6\x41\x16\xd3\xe2\x79\x8a\xdc\x69\x1e\x27\x9d\x51" +
+	"\x9b\x3e\xdc\x39\xca\x19\x51\x04\xc8\xfd\x8e\xa2\x89\xc1\x67\x2d\x18\x1a\xc0\xe5" +
+	"\xfc\x33\x31\x92\x01\xb8\xdd\x4a\x26\xdc\x29\x03\xa9\x4e\xcc\x9d\x5e\xc0\xe9\xc0" +
+	"\x5c\xf8\x83\x3a\x37\x34\x97\x1e\xd9\xcf\x4a\xf9\x08\x14\x33\x38\xb8\x52\xa9\x03" +
+	"\x73\xeb\x2a\x73\x7a\x01\x44\xa0\x64\x74\x1d\x4e\xcc\x05\x65\x35\x80\x19\x7d\xdc" +
+	"\xa8\xec\xa8\x74\x30\x71\x80\x35\x4c\x11\x59\x58\xa9\xe4\x43\xf2\xae\x53\xa4\x1a" +
+	"\x4d\xc8\xad\xed\xa7\xbc\x3d\x1e\x2a\x4b\x08\x59\x42\x22\x46\x89\x7f\x26\x42\xd0" +
+	"\x66\xc8\x14\x9f\x4f\x4b\xd4\xf3\x89\x67\x2e\x66\xb7\xb6\x02\x4e\x66\x54\x2e\x6e" +
+	"\xbd\xe7\x5a\x7b\xab\x81\x13\xa7\xcb\x50\x70\x65\x25\xf9\x63\x0b\x47\x01\x27\x1f" +
+	"\x11\x69\xac\xcc\x68\x7a\xa9\xd3\xa5\x83\xe6\xc0\xdd\xcc\x26\x33\x62\x88\x20\x56" +
+	"\xb9\x5c\xdc\x5a\xfe\x95\xd9\x95\x93\x5b\xbc\x3a\xc3\x71\x59\x51\xdd\xcd\xcf\xf2" +
+	"\x30\xeb\x24\xe8\xba\x26\x33\xe0\x84\xa4\x53\x73\x59\xdc\xfc\x34\xed\xd1\xdf\xaf" +
+	"\xf1\xe3\xe5\x9c\x43\x9f\xe2\x2a\xb8\x91\x84\x6d\x2a\x25\x4c\x91\x50\x58\x3d\x73" +
+	"\x50\x06\x28\x09\x58\xa7\xc3\x00\xaf\xdc\x25\xed\xc4\xb4\xa6\xbe\xd9\xf8\x30\x94" +
+	"\x17\x4e\x33\x71\x53\xd6\x04\x33\x41\xbe\x75\x13\x12\x54\xae\x99\xc3\x1a\x51\x81" +
+	"\x8f\x59\xaa\xcc\x4e\x95\xf9\xfe\x9f\x52\x65\x9f\x61\xb7\xef\xb0\x7b\xf9\xef\x5e" +
+	"\x4f\xf7\x1f\x9d\x34\xe9\x60\x26\x3b\x3b\xb3\x29\x91\xea\x44\x3e\x76\xf7\xf1\xbf" +
+	"\x4b\xe5\x26\x2a\x0b\x29\x16\xe5\xa1\x81\xc2\xe8\x69\x94\xb1\x64\xd2\x0b\x0f\x75" +
+	"\x92\x12\x99\xc5\x3a\x88\xfb\x8d\x08\xa6\x4e\x69\xbe\xf8\x86\x95\x65\xb2\x88\xf9" +
+	"\x94\xa5\x4c\x64\x6b\x96\x49\xcf\x53\xa0\x55\xc5\xae\x3c\xac\xd5\xa9\xb9\x78\xfa" +
+	"\x8c\xd0\xa1\x42\xd1\x47\xa9\x4f\x77\xa0\xba\x52\x33\x73\x59\x00\x2d\xb4\x5a\x89" +
+	"\x55\x2f\x22\xb8\x02\x53\xac\xbc\x74\x31\x71\x77\xcd\xa4\xbb\x6b\x26\x58\x45\xc9" +
+	"\x72\xcd\xb3\x1c\x52\x65\x5c\xbd\x61\x57\x46\x01\x51\x0b\x8e\xfd\x05\xe9\x54\x7d" +
+	"\x38\xbd\x24\x92\xc5\x83\x1c\xc8\xec\xd8\x67\x74\xea\x06\x96\x94\xda\xbf\x2d\xe7" +
+	"\x53\xe1\xcb\x70\x53\x9d\x21\x3c\xb4\x44\x7d\x15\x20\xca\xc6\x1d\x79\xe4\xd6\x37" +
+	"\x1a\x3a\xc0\x2f\x8b\xda\xc9\xba\x28\xaf\x42\xed\x45\xd7\x3d\xf9\x65\x6a\x53\x6c" +
+	"\xfa\x4c\xcd\x87\x63\xe9\xe5\x45\x5d\x00\x7c\x00\x30\xe7\xd2\x63\xcb\x7e\x22\x78" +
+	"\xf1\x83\xc1\x3d\x15\x7e\x67\x45\x93\xf5\x69\x3d\x16\x1f\x8c\xab\xdb\x27\xbf\x76" +
+	"\x94\xeb\x1a\x30\x7a\x91\xd0\xd3\x92\x72\x7a\x12\xce\x97\x5f\xa4\x11\x79\xc4\x07" +
+	"\xfd\x79\x51\x89\xac\xfb\x52\xd8\xe7\x19\x57\x86\xcc\x81\xcc\xd0\x82\xb1\x52\x61" +
+	"\x2c\x18\xfa\x7e\xd8\x3e\x79\x91\x82\xc8\x5d\x1f\x25\x38\x55\xbd\x38\x35\x51\xaf" +
+	"\x2f\xdc\xcf\xe9\x6b\x56\x34\x45\xfc\xb1\x93\x46\x96\xb8\x3a\x38\xfe\x8d\x54\x74" +
+	"\x5d\xe8\xc6\xdd\xdb\xd6\x3b\x8b\x70\x26\x91\xfe\x88\x7b\x65\x8c\xc8\xe4\x07\x65" +
+	"\x93\x42\x0a\xfd\x52\x2c\xb4\xb2\xe6\x52\x0b\x35\x8c\x04\x8b\x9b\xce\x5a\x8f\xe9" +
+	"\x71\xd8\xd7\x83\x41\xf0\x0f\x42\x46\x45\x4c\xeb\xa1\xf8\x9d\xde\x7a\x33\x45\xea" +
+	"\xb8\x35\xae\xdd\xaf\x35\xd1\x76\x78\xb9\x49\x84\xa3\xb9\x53\x9a\x7f\x94\x70\x88" +
+	"\xa2\x99\x75\xb7\x89\xde\xeb\xb3\x90\xed\xbe\x68\xdd\xb8\xc1\xa0\x06\xd5\x85\x27" +
+	"\x69\xe1\x00\x26\x06\xda\x59\x9c\x52\xe2\x4b\xa7\xf4\x20\xf0\x04\x67\x1a\x7a\x48" +
+	"\x51\xc8\xea\x5b\x52\x62\xa7\xce\x48\xf3\xa7\x46\xf5\x06\xe7\x94\xfc\x8e\xa7\x0e" +
+	"\xe4\x87\x88\x9f\x3c\x5a\x60\x04\x1f\x4f\x35\xe7\xf4\xb4\x3e\x12\xc1\x2c\x84\xee" +
+	"\x3a\xf8\xba\xec\x5d\xb0\x72\x25\x05\x40\xba\xcb\xa4\x05\xa7\xf4\x8a\xeb\x83\x57" +
+	"\xd9\x10\xb7\x34\xb2\x38\x6d\x46\xbb\xb3\xdf\x5c\xea\x00\x71\x1d\xb0\x3b\x83\xbb" +
+	"\x3c\xa5\x97\x2e\x32\x10\x51\xe2\xb8\x69\x3d\x4c\x29\x2b\x6a\x4f\x0a\x0c\x9e\xa5" +
+	"\x53\x7d\x88\x35\xa7\x87\x93\x7e\x6b\xbb\x7f\x79\xa0\x32\x65\xc0\x6c\xa9\x8d\x00" +
+	"\xed\xca\x43\x65\x6c\x21\x15\x1b\x39\x04\xfc\xe6\x77\x43\xcd\xa1\x51\xbf\x27\x9e" +
+	"\x6b\x05\x57\x5d\x2b\x88\x24\x0a\x46\xdc\x54\x20\x55\x41\xef\xf3\x6b\x53\xff\xd8" +
+	"\x91\x0c\xe5\xce\x2b\x83\xc6\xb1\xb4\x27\xb8\xb6\x2a\xa0\x3d\x39\x93\x76\x40\x9b" +
+	"\x04\x0c\xdd\xc7\xf1\xd9\xa9\x92\x22\xe4\x41\x02\x2d\xd4\x5f\xee\x28\xf4\xb3\xbe" +
+	"\xc2\xf2\x04\x77\x4d\xa7\x1d\xa7\xf4\xbf\x2b\x79\xff\xc1\x51\xde\xdf\x1b\xd0\xe5" +
+	"\xc9\x10\x1f\xd5\x93\xc0\x6d\x70\x53\xaa\x2d\x29\x4b\x95\x3e\x28\xc1\xb8\xe3\x08" +
+	"\x71\xd2\x27\x12\x25\x90\x76\x96\xb7\x13\x05\xda\x4e\x2e\xbb\xd6\xa7\xc0\xfd\xc9" +
+	"\x43\xa2\x62\xfb\x18\x51\x7e\x01\x72\x0c\xb0\xbc\xa8\x6c\xad\x67\xf2\x1b\x21\xa7" +
+	"\xe4\x4b\x86\x0e\x70\xc1\x0c\x1b\x92\xb9\xae\x43\x8e\x79\x19\xcd\xbb\x2b\x37\xb6" +
+	"\x1c\xe5\xc8\x55\x01\xd6\xd7\x67\x5d\x15\x3c\x69\xc5\x8c\x4a\xaf\x6d\xa0\x2f\xc8" +
+	"\xfc\x96\x02\xbc\x70\x6b\xe2\xa2\x81\x83\xfb\x1d\x69\x81\xd9\x1b\xb4\x1a\x2f\x47" +
+	"\xaa\xa6\xfc\xe4\xfe\x00\x11\x3a\x8f\xfc\x2c\x70\x99\xa6\xac\x69\x44\xd6\xbc\xcd" +
+	"\xde\x1e\x10\xc9\x9c\x50\x46\x30\x4a\xb1\x2a\x0d\x34\x2a\x84\x46\x38\xba\x6c\x6f" +
+	"\xf9\x0c\x84\x5f\x6d\xc5\x43\xf2\x30\xb0\xd0\x56\xba\xf3\xa8\xe9\x36\x66\xea\x3a" +
+	"\x75\x97\xf1\xc4\x90\x93\x77\x04\xf8\x27\x1e\xd2\x43\x51\x62\x76\x4f\xbb\xea\x1f" +
+	"\xb7\x99\x60\xf6\x2c\xa8\x85\xed\x04\x65\x50\xc1\xf8\x3a\xb4\x0e\xe8\xc5\xe4\x9a" +
+	"\x74\xbb\x21\x8e\xb1\x09\x78\xc3\x75\x98\xb7\xf3\x46\xcd\xa1\x2c\x11\xd7\x83\x9e" +
+	"\x69\x7e\xed\xdd\x54\xf5\xcb\xef\xa4\xc2\x75\x3d\x5f\xdc\x4e\x4b\x1e\xab\x86\x36" +
+	"\x2e\x52\x66\x52\x68\x54\x06\x6c\x21\x1a\x91\x96\x1e\x39\x6b\xe0\x34\x7b\x06\x2e" +
+	"\x25\x07\xa0\x85\xd4\x94\xff\x32\xbd\xb0\xf7\x50\xbd\x86\x0b\x5a\xe9\xaf\xbe\xb8" +
+	"\x11\xdd\x07\x22\x23\x18\x6d\xac\x8a\x65\x64\x81\x81\x34\xb1\x07\xb8\xbe\x6d\x3c" +
+	"\x13\x3e\xad\x91\x6a\x83\x11\xd5\x05\x55\xf6\x74\xf8\x08\xc1\x2b\xe4\x2d\xad\x1e" +
+	"\xcc\xe6\xb6\xfa\x48\xdc\x95\x85\xe1\xfe\x63\x2a\x2d\xce\xa8\x1c\xed\xbc\x0d\x2a" +
+	"\x2a\xad\x65\x46\x0b\xae\xd5\x07\xb5\x95\x6d\x0e\x64\x91\x27\x2e\x90\xc0\x5a\xbc" +
+	"\x6d\xd1\xee\x80\xf2\x59\xe3\xc9\x0a\xde\xa9\x85\xfa\x57\xc1\xfd\xb5\xe0\x90\x97" +
+	"\x80\xf8\xdd\xa3\xb0\x28\x8d\xc8\x9a\xb7\xb0\xd0\x5a\x7a\xcc\xa8\xab\xee\x83\x9b" +
+	"\x91\xa6\x15\xbe\xa1\xd4\xd4\x69\x35\xea\xae\x76\x73\xd5\x5c\x35\x57\xad\xb9\xad" +
+	"\x00\x56\xaa\xd0\x6b\xf6\x60\xfc\x2d\x15\x01\x25\x20\xef\x8b\x7f\x15\xdd\xb1\x03" +
+	"\x21\x7d\x79\xff\x71\xf7\x3f\xdd\x3d\x53\xcf\x95\x4b\x6e\xa5\x6a\x46\xe7\xc0\x02" +
+	"\xb2\xf9\xe3\xea\x57\x20\x4e\x6b\x3e\xff\xa2\xfd\xfb\xd7\xea\x48\xb1\x01\x69\x2d" +
+	"\x13\xca\x2c\xe5\x47\x13\x54\xc0\x74\xb9\xf7\xe2\x55\xa9\xf8\x7a\xd7\x2f\x89\x41" +
+	"\x62\x71\x5a\x8b\x75\x1d\x46\xcd\xf4\xe9\xae\x18\xf4\x56\x64\x7d\xa5\x2a\xd4\xb2" +
+	"\xd8\xfc\x93\xc7\xaf\xfc\x25\x2a\x99\x92\x5a\xad\xb7\xd6\xa2\x43\xa6\xd2\x79\x30" +
+	"\x9a\x6b\x70\x41\x94\xd9\x43\xcd\x05\x48\x56\x7c\x79\x93\x5e\x9e\x56\xed\xdd\xf2" +
+	"\xa4\xe6\xe8\xfd\x1d\x13\xc5\x95\xb8\x5e\x11\xe7\xf0\x70\xa6\x95\x4b\xc1\xa4\x5a" +
+	"\x54\x97\x7f\x50\x51\xd9\xab\x52\x5d\x5e\xa5\x18\xe8\x57\x9e\xae\x22\x9e\xcb\x43" +
+	"\xe4\xc1\x62\x54\x25\x89\x5b\xac\xa7\xd5\x1f\x6e\x5c\xfd\x1e\x6b\xf5\x82\x58\x8b" +
+	"\x56\xa3\xc3\x82\xb9\x54\xe8\x1d\xa5\x3d\x29\x57\xbc\x96\x43\xf5\x4d\x4b\xaf\xee" +
+	"\xc5\xaa\x7b\xf1\xbb\x80\xf9\x6e\x65\xd2\xe2\xa9\x2c\xfd\x1d\xe8\xe7\xa5\x8f\xcb" +
+	"\xc7\x29\x5a\xc6\x97\x27\x77\xdb\xf0\x64\xfd\x54\x6c\x31\x0f\xa9\x22\x16\x4c\xa6" +
+	"\xc3\xd5\x84\xa5\x30\xf8\x08\x2b\xb4\xd5\x2b\x62\x59\xad\x2a\x18\x55\x10\xeb\x29" +
+	"\xc5\x31\x6e\x36\x52\xb3\xd9\xca\xcd\x68\x1a\xc8\xc6\xb0\xa2\x37\x0b\x71\xa4\xc0" +
+	"\x20\xe6\xd1\x92\x21\x6a\x06\xa8\x54\x9c\xe2\xeb\x6a\xea\x8f\x69\xcd\xa9\xda\x45" +
+	"\xd9\xe1\xcf\x60\xaa\x59\x79\xd9\x95\xcf\x5d\x27\x97\x27\xf1\x0b\x58\xa9\x97\xf2" +
+	"\x33\x73\x37\x15\x77\x0f\x29\x76\xbb\xa6\xd7\x29\x3e\xca\x8f\x98\x03\xdc\x4d\xf4" +
+	"\x4a\xcc\x0a\x85\xe8\x90\x01\x5c\xcd\x07\xda\xa4\x58\x08\x9b\x87\x40\xcd\x2c\xbc" +
+	"\xe9\x2d\xd5\xec\xbc\xa4\xfc\x62\x6c\x59\xc8\x95\x1c\xd1\xa1\x96\xa2\x41\xa0\x70" +
+	"\x55\xe5\x54\x0a\xfd\x54\xf1\x8a\xe1\x97\xb6\x4e\xe3\x1a\xd7\xc9\xef\x75\x9d\x2c" +
+	"\x11\x6e\x99\x16\xd5\x19\x76\x74\xb9\x88\x42\xd1\x70\x82\x65\x42\x30\xfa\x49\x14" +
+	"\xec\xf0\x3b\x31\x2f\x80\x3a\x40\x73\xe4\x5e\x2b\xd7\xeb\x4d\xa5\x29\x54\xbf\x70" +
+	"\xaa\x35\xca\xef\x8c\x3b\x23\xa1\x21\x19\x20\xce\x6e\x44\x01\x9f\x7e\x4c\x75\xf2" +
+	"\xeb\x56\xed\x8a\xaa\xc5\x1f\xc2\xa4\xcf\x73\x88\x15\x97\xc5\x4f\xfe\xd0\x9b\x53" +
+	"\x19\xaf\xe9\xd7\x6a\x0d\xa2\xaa\x4e\xba\xb6\x3a\x6d\x46\x2d\x2e\x76\xe6\xa4\xda" +
+	"\x61\x3f\x87\xa4\xef\xf2\x8a\xe2\x3b\x75\x02\x9e\x0a\xbd\x2b\x8a\xc1\x29\x87\x70" +
+	"\x34\xa0\xf5\x99\x4a\x6e\x37\xca\x94\x23\x43\x21\x37\xf9\x22\xb8\x89\x0e\xfb\x37" +
+	"\x7f\x65\x9a\x57\xbe\xc1\x6a\x36\x5e\x88\x6f\x19\x59\xd6\xdf\xd6\xbc\xde\xde\xbc" +
+	"\xde\xe6\x0e\x05\x01\x61\xd4\x6a\xaa\x8c\xcc\xd1\x87\xd7\xca\xc2\x54\x3e\x7d\x11" +
+	"\xd4\x11\x6e\x3e\x3a\x8c\x39\x95\x8f\x18\x41\x5c\xa4\xa5\xb7\x78\xa5\xf2\x2a\x49" +
+	"\xe4\x26\x8b\x73\x00\x2e\x56\xd3\x11\x7d\x88\xbf\x3d\x38\xff\xb8\x83\x1f\xb7\x97" +
+	"\xa9\xf7\xd5\x7a\x20\x8e\x43\x39\x2a\x3c\xa8\x82\xc8\x3b\xe2\xda\xdb\x17\xec\xae" +
+	"\x77\xbf\x7e\x5d\x58\x6e\x61\x02\x56\xe3\x02\x42\xcc\xcc\x9d\xf3\x7c\x35\x9c\x76" +
+	"\x27\xe6\x66\x37\x69\x37\x8c\x4c\xef\x71\xfb\x87\xc3\xd8\xf9\x5d\x40\x85\xb7\xf3" +
+	"\xc2\xaa\x9e\x25\x9d\x90\x21\x9a\x78\x20\x00\x03\x4d\xff\x21\x74\x5a\xd0\xda\xa9" +
+	"\x11\x1a\x68\x8d\x7c\x21\xee\x9b\xa3\x0d\x72\x45\x9b\xad\xb4\x84\x9e\x0e\xb8\x6b" +
+	"\xe8\x46\xfe\xd0\x0b\xdf\x12\x23\x55\xef\x7e\xb7\x9b\x43\xcd\x99\x30\x3b\x5f\xde" +
+	"\xf4\xbe\x34\xe6\x46\x91\xfb\x72\x9c\x97\x9c\x5e\x4e\x52\xa7\x4b\x07\xb5\x83\xee" +
+	"\xd2\x0a\x02\x64\x55\x78\x40\xe5\x13\x4d\x37\xf4\x67\xe3\x26\x75\x10\x7a\x54\x50" +
+	"\xaa\x25\x1d\x2a\x87\x3e\x9b\xa9\x36\x9b\xa9\x29\xd1\xf7\x88\xb8\x56\xc1\xf2\x60" +
+	"\x07\x5f\x2c\xfd\xb2\x5b\x32\x55\x6e\x6a\xaf\x89\xe7\xa3\xc0\x16\xe4\xdb\x79\x2b" +
+	"\x42\xe6\xc8\xdf\x15\x83\x35\x6f\x72\x2f\xd2\xe8\x4b\xe8\x51\x95\xa9\x72\x8d\x96" +
+	"\x54\x3c\xe6\x85\x6e\x85\x5b\xe8\x4d\x6d\x4e\x36\xab\x8a\xdc\x45\x2a\xca\x02\xb9" +
+	"\xe6\xf4\xb0\x3e\x1c\xd4\xcd\x3d\x42\x01\xf9\x4e\x3c\x4a\x2f\x2a\xed\x53\x33\x19" +
+	"\xa3\xcd\x9f\x2c\x4e\xfb\x00\x16\x0c\xb0\x76\xa0\x4f\x05\xb2\x70\x9e\xaf\xd3\x86" +
+	"\xbf\x9a\xfb\xc1\xac\x0e\xcf\x1b\x99\x5b\x1a\x89\xdf\x85\xcf\xab\x6a\xca\x68\x73" +
+	"\xd5\x5c\x48\x40\xd9\xe9\xd2\x0d\x94\x2e\xf6\xaf\x45\xcd\x45\x81\x93\xc0\xd5\xac" +
+	"\x30\x87\x0d\xcd\x9a\x17\x74\x94\xea\x37\x6e\xb7\xc6\x9f\x24\x32\x1b\x37\x57\x8e" +
+	"\x45\x93\x07\x66\x1b\x41\x66\xe9\x21\xa5\x1e\xbc\x92\x5a\x78\xbb\x32\x0d\xa0\x80" +
+	"\x7e\xfa\x33\xcc\xac\x5c\x1d\xc9\x62\x57\x1d\x2a\x45\xad\x5b\xbd\x54\x2d\xc5\xeb" +
+	"\x59\x29\x94\x00\x83\xa8\xc8\xd6\x6f\xe5\x7e\xb9\x3b\xea\xbc\xc5\x48\x15\x2b\xef" +
+	"\x9e\x30\x46\x23\xd4\xe4\x64\x71\x5a\x3b\x75\x7b\x73\x7e\x21\x7c\x68\x64\x71\xda" +
+	"\x8c\xba\xbd\xf5\x3c\xd7\xce\x7d\x83\x58\x30\x15\xa9\x9a\xaa\x55\x9f\xaa\x89\x36" +
+	"\xa3\xee\x89\x23\x25\x46\xac\x15\x80\x94\x56\x04\x35\xa6\x8b\x2d\x29\x27\xcd\xa8" +
+	"\xfc\x01\x78\xae\x9b\x9a\x7e\x59\x97\x2b\x82\x9b\xf9\x47\x5e\x20\xdc\x79\xa9\x39" +
+	"\xf4\x05\xf2\xe5\xf8\xb5\xe8\xd8\x01\x7a\x82\x10\xd7\x0a\xde\x95\xae\x79\x93\x5e" +
+	"\x33\x5c\x01\x79\x45\x61\x02\x7b\x3d\x1e\x71\x2d\xa2\x7c\x25\x11\xb3\x53\xfc\x04" +
+	"\x6d\x2c\x33\x2e\xde\xd4\x4b\xd8\x72\x96\x7d\xfc\xc5\x80\x2d\xa8\x6b\x9d\xa4\xe0" +
+	"\x66\x55\xe4\xab\x8d\x0e\xd7\x4a\x6e\xd6\x1f\x52\xb5\xae\x52\xb8\x64\x8c\x30\x6c" +
+	"\x5b\x45\x24\xef\x03\x34\x47\x46\x39\xbf\x68\xd4\xf5\x2c\x6e\xd1\xf3\x05\x2e\x2d" +
+	"\xf6\xb5\x7f\x88\x9d\xee\x07\x9a\xae\x2d\x4c\x3c\x00\x82\x22\xcf\x9c\xd9\x12\x71" +
+	"\xeb\x03\x3f\x1b\x8f\xc7\x5f\x84\xa5\x0f\x7c\x39\xef\x4a\x03\xbe\x93\xc5\x29\xce" +
+	"\x59\x84\x5e\xec\x88\x40\x98\x1c\xf2\xe6\xe2\x74\x15\x50\xf9\xa8\x49\x5f\xd5\x1f" +
+	"\xc0\xf0\xd6\x22\x80\xa3\x9a\xe2\xe5\xc4\x24\x59\x07\x3e\xbf\x60\x9e\xbf\x34\xdd" +
+	"\x9b\xa8\x96\x48\x57\xd5\xc9\x22\xb4\x5c\xc3\xe7\x74\x18\x28\x50\x6d\xe7\x5d\x76" +
+	"\x22\x1f\xad\x72\x6f\x5d\xed\x55\x55\x46\x7a\x77\x19\x61\x0b\xc5\xac\x96\x73\xf5" +
+	"\x69\xde\xc5\x1d\x88\x52\xad\x9c\xaa\x7a\x81\x4b\xd5\xa7\x7a\xd1\xaa\xd0\xad\xb6" +
+	"\x5f\xed\x85\x4d\xcb\x7e\xc3\xae\x7a\x81\x4b\x57\xf6\xf5\x0b\x77\x79\xfe\x79\xe0" +
+	"\x74\x63\x7b\x02\x9b\x15\x22\x46\x56\x2f\xad\x83\xbb\xda\xe9\x4d\x01\x8e\x7f\xed" +
+	"\x54\x2e\x4e\xcd\x8b\x2e\xe6\x39\x17\x8c\x8c\x87\xd7\x09\xc3\xa4\xe5\x3c\x6d\x6d" +
+	"\x79\xe9\xe5\x56\xe9\xf5\x95\x3e\xf2\xa8\x0b\xed\xa7\xeb\xef\x5f\x29\x0a\xf2\xaf" +
+	"\xd5\xc3\xbf\x3a\x0e\xc2\x55\x77\xd3\xe7\xa9\xdd\x9d\x0f\x2f\xd6\x39\xc1\xe5\x07" +
+	"\x01\x39\x75\x78\x1d\xb5\x30\x7d\x68\xcb\x1f\x8e\x55\xcc\x71\x0a\x73\x9c\xd8\x08" +
+	"\x85\x72\xeb\x3f\x6a\x3a\x3b\x95\x13\xe4\x1b\xed\xa0\xc4\xec\xb7\xab\x46\x5d\x52" +
+	"\x3c\xbf\x30\x99\x4f\x5e\xb6\x8e\x8b\x3c\x2d\xe6\xe5\x81\xc5\x43\x2e\x1f\x6c\x20" +
+	"\xe5\x08\x7a\x24\xb6\x6a\x66\x8b\x8c\xa6\x89\x8a\x1b\x9e\xcc\xd7\xec\x69\x8e\x6b" +
+	"\xd4\x01\xab\x39\xae\x33\x02\x2b\x50\x89\xd1\xde\x68\xb9\x61\x1b\xd0\x9f\x45\x1d" +
+	"\x92\xb5\x5f\xf8\x76\x54\xaa\x43\x25\x18\x46\x06\xf7\x6f\x72\xe8\x73\x81\xc5\x3e" +
+	"\xc3\x1b\x9f\xe3\xca\xf8\x8d\x31\xb7\x8a\x9f\xe9\x46\xcb\x80\x1e\x03\xdf\xd5\x88" +
+	"\x3c\xb3\x08\x87\x2a\xd5\x2c\xe7\x45\xe4\x64\xf3\xf4\x6d\xdc\x17\x19\x57\xce\x77" +
+	"\xca\x0b\xb7\x9c\x75\x2a\x03\xf8\xf0\xdc\x1a\xbb\x9a\x6a\x9a\x22\xb5\x46\xe9\xca" +
+	"\xf5\x58\xad\x04\xb9\xbf\x36\x6e\x47\x84\x3f\xdc\x41\x5a\x6e\x58\xbf\x60\x1b\xd8" +
+	"\x52\x62\xd8\x4c\xdd\x8f\xbb\xef\x6e\xb5\xc8\x04\x74\x0f\x66\x7c\x75\xa2\x84\xb2" +
+	"\x15\x3c\x73\x36\xd2\x34\xbc\x2e\xc4\x06\xf7\xea\x44\x21\x03\xc6\x57\xb9\x35\xad" +
+	"\xeb\x6e\x47\x16\x13\x86\x6e\x59\x9d\x58\xc1\x82\x44\x97\xbc\x38\xa1\x53\x72\x74" +
+	"\xa6\x1b\x75\x57\x2a\x6d\x7a\x42\x27\x76\x98\xde\xb0\xb9\x91\x03\xf9\xb4\xaa\xa9" +
+	"\x7d\x61\x88\xa5\x76\xf7\x74\x16\xd9\x15\xcb\xb3\x59\x15\x5b\x22\xce\x71\xb8\x39" +
+	"\x14\x98\x9c\xe8\x3e\xcc\x39\xee\xbf\xfa\x66\x10\xf3\xfa\x31\xbf\x1f\x0b\x96\xef" +
+	"\x77\xdc\x97\x3b\x0e\x0d\xe1\x1c\xde\xde\x8e\xe8\xb2\x86\x0e\x65\x47\x6a\x18\x1b" +
+	"\x78\xf4\x9b\x97\xcf\x3f\xfd\xf9\x6f\x76\xa6\x07\x3c\xbf\x60\x1b\xf8\x6f\xfa\x82" +
+	"\x4b\xc7\xb2\xe7\xc1\x33\x88\x7d\x87\x43\x66\xed\xb2\x64\x40\x65\xc0\xb4\xcd\x80" +
+	"\x3f\x3b\xde\x3f\xe6\xa1\x9b\xb6\xd2\x8d\x96\x01\x3d\x40\x89\x31\x39\xed\x3e\x3c" +
+	"\x47\xc8\xa9\x19\x31\x0b\x66\x62\x4e\x97\x0e\xba\x8d\x85\x56\xbd\x95\xb0\x24\x42" +
+	"\xf7\x1b\x49\x4d\xa5\x3f\xab\x53\x79\x7d\x16\x6f\xbc\xb7\x06\xff\x75\x52\xa2\xd9" +
+	"\x6c\x9f\x39\x13\xd3\x44\x17\x71\xa2\x84\x72\x9c\x8d\x3e\x2d\xbc\x45\x37\x5f\x76" +
+	"\x20\xd7\x2a\x25\x2e\x68\x37\xdd\x83\xb7\x2e\x05\x2e\xaa\x43\x35\xa6\x70\x07\xaa" +
+	"\x82\x06\xbd\xd1\x33\xb5\xc4\xb6\x6b\x9a\x87\xc7\xe3\xd7\x14\x42\x7d\xd2\xde\x6b" +
+	"\x9e\xd8\x17\x15\x9a\xa3\x97\x47\xcf\x3a\x2f\x8e\x88\x72\x2a\x02\x50\x5c\x55\x90" +
+	"\xbc\x62\xab\xca\x51\x0f\x7f\xfa\xcf\xa1\x9a\x67\x3d\xd4\x51\x43\x64\x51\xb3\x07" +
+	"\x26\xe0\x46\xe6\x9d\xa3\xc0\x66\x50\x4e\x27\xd2\x4a\x63\x1d\x71\xe5\x95\x06\x23" +
+	"\x66\x65\x3e\xcf\xec\x7d\x54\x65\x40\xc3\xdc\xea\x48\x3d\x4b\xd7\x23\x74\x7c\x76" +
+	"\x68\x76\x7a\x8a\xe1\x46\x66\x28\x55\xf5\xa6\xb2\xe0\x45\xe3\xa5\xc0\x59\x2d\x6c" +
+	"\x9b\xa4\x0b\x8c\x9a\x55\x7b\x61\xcc\x2a\x1a\xc2\x40\x3f\xb3\x8f\x2f\x2b\xae\xfc" +
+	"\x30\x22\xbf\x3f\x52\x54\x24\xad\xa5\xca\x53\x61\xd4\x0a\x4d\x9a\x59\x4f\x60\xee" +
+	"\xd0\x82\xf1\xca\xac\x61\x1f\x3b\x7f\xd6\x40\xc3\x9e\xee\x14\x36\xa2\xef\x0e\xf0" +
+	"\xc6\x2b\x88\xd9\xc9\x79\xf6\x4e\x79\xc9\x19\xc4\x61\x75\x8c\x0e\x8e\x10\x00\x10" +
+	"\x52\x95\x15\xc2\xd2\xc7\x2b\x7d\x61\x2e\x94\x96\x5c\x01\xc9\x39\x39\x6c\xce\x35" +
+	"\x18\xfd\x7a\x8e\x2f\xbc\xde\x36\x4a\x82\x59\xfc\x4d\x5f\x70\xe9\xd8\x9c\x2a\x44" +
+	"\xb1\xb8\x44\xfd\xe5\xd4\x54\xad\x97\xc1\xf2\x95\x16\x64\x41\x07\x52\xb8\xdc\x88" +
+	"\x32\x0f\x80\xd1\xb5\x2e\x08\x8c\x00\xb2\x5f\xa0\xcd\x9b\xa9\xf2\xd9\x2f\x64\x9c" +
+	"\xe3\xf5\x4d\x15\x29\x26\xea\xeb\x0d\x28\x07\xbf\x1c\xc7\x26\x65\xbf\x71\x55\x0d" +
+	"\x56\x65\xf8\xe5\x0d\xfa\x59\xef\x09\xa2\x22\xae\x02\x95\x6a\xe9\xf6\x8b\xfd\x04" +
+	"\xb8\xa5\x99\x95\xb4\xd4\xc5\x34\x36\xc0\x81\x68\x9c\x86\x5a\x98\xd3\x42\x4e\x93" +
+	"\x31\x89\x9d\x62\xbc\x26\x9f\x0e\x74\xb4\xdc\xf0\xb6\x37\x4f\x8e\xcb\xfe\x8d\x6f" +
+	"\x47\x1c\xd0\x32\xec\x49\x74\x6c\xa6\x6e\xd9\x4e\x66\xc5\x73\x5a\xf3\xa4\x51\x8e" +
+	"\x87\xfa\x19\x24\x95\x3a\x88\xfc\xad\x45\xaa\x2e\xa5\x17\x62\x2f\x30\xf8\xa1\x78" +
+	"\x88\x44\x3f\x38\xc9\x43\x07\x2b\x42\x89\x09\x48\xc2\x03\x2b\x4a\x9c\x9e\x62\xdf" +
+	"\x2d\x4a\x3a\x0d\x73\xa5\x6f\xcf\xe1\xe6\xb9\xf7\x24\x08\xea\x2a\xd4\x2f\x68\xc6" +
+	"\xd5\xfd\xd6\x3e\xb2\xd0\xe3\x58\x43\x00\x25\x27\x6e\xc1\x97\x49\x35\x22\x70\xef" +
+	"\x54\x06\x1c\x3d\x36\x62\xda\xa9\xba\x01\xf7\xcb\x7d\x41\xf3\x0e\x1c\x17\x70\x33" +
+	"\x90\x70\xd4\x45\x37\x56\xa4\x6f\xdc\xbe\x34\xaa\x79\xe6\x4c\x69\x04\x7d\x02\xc7" +
+	"\xea\x78\x03\xd8\xa4\xec\x45\xf5\x8a\x88\x6e\xd2\x13\x65\xe2\x6f\x20\x39\xb0\x2b" +
+	"\xc8\xc1\x2b\x0f\x36\x30\x0f\x64\x5f\x76\xa0\xe5\x86\xf5\x0b\xb6\x1b\xdf\xd0\xdf" +
+	"\x61\x67\x6b\xa0\x53\x59\x6e\x47\x7c\x32\x2f\x79\x31\x0f\xb3\xc8\x04\x2c\x55\x82" +
+	"\xee\x0e\xa4\xef\xf9\xa5\xe9\x0f\xa1\xac\x2b\x1f\x1f\x69\x13\xde\xc5\xaf\xfe\xe2" +
+	"\xbb\x4d\x30\x5e\xc8\xc0\xad\x9d\xfa\xfb\xf0\xbb\x7a\x73\x2e\xc8\x97\xf6\x74\xe3" +
+	"\xb3\x3f\x17\x4f\x67\xf7\x8a\xc5\x54\xa4\xa5\xe7\x57\x54\xd4\x07\xa3\xc1\x2b\x1e" +
+	"\xee\x5f\x6f\x52\xcb\x40\x15\xc6\xa8\x6c\xe8\xdf\xcc\xc8\x90\x09\x5d\xff\xab\xb5" +
+	"\xba\xf3\x8f\xd1\x97\xdf\x2e\xd8\xfd\x65\x90\xfe\xd1\x2c\xff\xe8\xbe\x7c\x1a\x9c" +
+	"\x0b\xd5\xe9\x15\xab\x7d\xb3\xea\x1f\xac\xfa\xd7\xaa\xfe\x79\x6a\xff\x36\x35\x33" +
+	"\x08\x42\xdb\x16\x07\xe8\xa9\xce\x67\x2f\xce\xde\xa6\x71\x40\x95\x7c\x71\x33\x15" +
+	"\xe6\x9b\x47\xb0\xf5\x00\x37\x4b\x88\x97\x6e\xed\xe5\xe1\x8d\x0f\xd4\x5d\x5a\x79" +
+	"\xd3\x7f\xbd\xac\xff\x82\x54\x23\x66\xe5\x2e\x99\xfe\xbd\x74\x2e\x4a\x94\xee\x0a" +
+	"\xe2\x09\x51\xfa\x2d\xac\xd3\x63\xf5\x27\x48\x86\xb0\x18\x0e\x7a\xb4\xa7\xf9\x82" +
+	"\xb1\x72\x11\x59\xd2\x37\x4e\x7a\x8d\x42\x3a\x56\xce\x02\xfa\x4e\xea\x90\x5f\x3c" +
+	"\xd4\xb9\x38\x35\x4f\x1e\x9c\x9e\xeb\xb6\x07\x5b\x65\x3e\xff\x30\x2f\x35\x04\x3f" +
+	"\x59\x40\x07\xe3\xbf\x20\x69\x4d\x89\xe6\xa5\x87\xc1\x44\x14\x97\x3f\x9c\x62\x25" +
+	"\x95\x52\x65\xc3\xb0\x83\xd5\x5d\x78\xbc\xa9\xfa\x03\x6e\xc2\xcd\x1f\x5c\x71\x2a" +
+	"\xa7\x0f\x29\x32\xb1\x2b\x5b\x3a\xb0\x90\x64\xe6\xb6\x96\xe2\xcd\x4e\x57\x3a\x32" +
+	"\x2b\xb1\xf8\x22\x6b\x00\xf6\x86\x98\x81\x18\xb5\x14\x32\xa7\x92\x3b\xf6\x4f\xe1" +
+	"\xd8\x48\xd3\xce\xdd\x72\xee\x81\xbc\xc1\xb0\x9c\x21\x96\xfc\x3c\x8f\xd7\x60\x70" +
+	"\x22\x36\xf2\xfc\x32\xa6\xaf\xa8\x4c\xd7\x45\x42\x16\x06\x99\x4c\x1a\x12\xc7\x51" +
+	"\x88\x1d\x14\x01\x1b\xeb\x5e\x79\xcd\x52\x57\x53\x15\xa5\x8b\xa7\xc8\xc0\x42\xb5" +
+	"\x38\x36\x6a\x91\xde\x68\x83\x70\x3a\xc7\xa9\x1c\x71\xbf\xf5\x5f\x66\xc0\x2f\xb8" +
+	"\x07\x2f\xe4\x8e\xd9\xe2\xe0\x4d\x9b\xd9\x3a\xa4\xac\x97\x94\x67\xef\x90\x72\x3d" +
+	"\x3c\x96\xa6\xce\xa2\x70\x47\xf7\x99\x6e\x84\xa3\xa2\xc7\x5b\x10\x96\xd7\x51\x5b" +
+	"\x52\xa7\x7d\x4b\x28\x97\x97\x1b\x85\x0f\xcc\xcc\x17\x29\x92\xf9\x55\x3c\x1a\x68" +
+	"\x35\xea\x3e\x58\xc9\x70\xb9\x8e\x9c\xf2\xea\xca\x4b\xb7\x1a\xa2\x51\x04\x35\x0d" +
+	"\xa1\x99\x2f\x1d\xd9\xe4\x4a\x43\x91\xab\xbf\xaa\xe6\x84\x43\x28\x03\x59\xf3\x72" +
+	"\x8d\x1c\x72\x4c\x00\xb2\xab\x7b\x10\x5d\x87\x89\x66\x8c\xc0\x21\x7c\x33\x74\x84" +
+	"\x73\x4d\x93\x88\x3e\xbc\xf8\xa6\x9d\x2e\x1d\x34\x07\x96\x3f\xde\x99\x95\x2a\xe3" +
+	"\x4a\xc2\x2e\x3d\x8c\x17\xb8\x29\xbd\x86\x39\x23\x48\x28\xd5\xa6\xb5\x1d\xf1\xf4" +
+	"\xdb\x53\x13\x89\xbc\x27\x3a\x35\x91\xae\x55\xd7\x96\xf7\x3d\xf2\x72\xd0\x9c\xeb" +
+	"\x8c\x83\x3a\x2b\x19\xa2\xcd\xa8\x45\x54\x91\xe9\xe9\xd4\x6c\x6c\x2a\x82\x09\x9f" +
+	"\xf5\x46\xcb\x80\xc3\x6b\xe7\x0f\xe0\x64\x7b\xf8\x3d\xc1\xb2\xd9\xfc\xd8\xe7\xb7" +
+	"\xc6\x83\x97\x95\xcd\x92\xb0\xc4\xd4\x6f\x0e\xea\x40\x46\x04\x64\x91\xf6\xc7\xee" +
+	"\xf6\x67\x7f\xdd\x90\xc6\xe8\xef\x1c\xaa\xae\xe6\x53\x5f\x3a\xff\x2a\xef\xbf\x9a" +
+	"\xcc\xe6\xdf\xbc\x4a\xc3\xbe\xc7\x00\x6e\xa1\x4f\x2f\xa3\x3e\x1c\x7d\x69\xba\x9b" +
+	"\xbf\xfd\xfd\x72\x0d\xa8\x0e\x78\x24\x37\xea\x45\xf3\x8d\xd1\xd9\x9f\x23\x72\x76" +
+	"\x0c\x3a\x39\xd8\xa5\x2c\x3a\x17\x9f\x84\x65\x7c\x48\x71\xa7\x5e\xcf\x91\x1e\xba" +
+	"\xa7\x38\xa6\x33\x07\x55\x53\x1c\xa3\xc3\x29\xc2\x03\x02\xe4\x40\x07\x39\xe6\xd5" +
+	"\xd7\x4c\xa2\x8a\xa7\x29\xa2\xe6\x11\x69\x8f\x5c\x94\xe2\x31\x4a\xe9\x1b\xea\x56" +
+	"\xdf\x69\xf8\xd2\xde\xd6\x3d\x30\x4f\x15\xec\xd6\x33\xdd\xc8\x1d\x6a\xe7\x64\x20" +
+	"\x37\x94\x07\xc6\x2a\x28\x22\x2f\x46\x64\xfb\x96\x62\x81\xbf\x65\x82\x6b\x9e\x1f" +
+	"\xae\x9d\xfa\xc3\xc4\x1a\xb7\x78\x98\x18\xd1\xf1\xe6\x86\xab\xbb\x56\xa0\x3c\xd9" +
+	"\x37\x9e\x2f\xbe\x28\x84\x7e\xe3\x8d\x90\x3f\x74\x5c\x3d\xe4\xda\x03\xac\xdd\x0f" +
+	"\x6d\x6a\x4d\xf1\x57\x8b\x60\x64\x3a\x5f\x4e\xcc\x27\x77\x24\x1a\xd1\xb4\x92\xe3" +
+	"\x1a\x1a\x76\x56\x24\x35\xb1\xa0\x93\xe7\x65\x96\xea\x44\x89\xcb\xe2\xb7\xae\x1a" +
+	"\x11\x50\x15\x96\x3f\x0a\x2c\x03\x3c\xe9\x62\x3b\x23\x89\x9e\x43\x48\x16\x8f\x87" +
+	"\xa9\xd2\x78\x91\xe7\x5e\xe0\xd9\xdb\xfb\x9a\xb7\xf8\x47\x44\x53\x51\xa3\xf2\x61" +
+	"\x79\xc8\x3d\x0f\x1e\xa9\x4f\x5d\xd7\xac\x9e\x4e\x44\x19\x31\x60\xc9\xde\x91\x83" +
+	"\xdd\x6f\xd4\x1c\x8a\x52\xb6\xf9\x70\xfe\xcb\xea\x43\x05\xd4\xc3\x15\x30\x97\x52" +
+	"\x11\x4d\x98\xd7\x12\x29\x83\xe2\x65\x50\xe6\x9f\x37\xbb\x61\x86\x8a\xca\x50\xeb" +
+	"\xa1\xd5\x96\x95\x90\x26\x62\x81\x96\xc6\xe5\x85\xa2\xfe\xfd\x8b\xa6\x53\x18\xe8" +
+	"\x4b\x16\xa4\x64\x65\xcf\xb8\xbc\x96\x26\xf6\x8b\x51\x19\xc8\x96\xcd\x6c\x23\x4a" +
+	"\x84\x6d\x5c\x0b\xc8\xcd\xc5\xce\xbe\xa8\xe2\x62\xa0\x5f\xb9\x79\x46\xaa\x62\x7b" +
+	"\x53\x28\xbc\xf3\x13\xe6\xf5\x9a\xa4\x70\xb1\x1f\x4e\x2e\x18\x54\xab\xf2\x7a\xf9" +
+	"\xfa\x54\xaf\x12\xce\x2b\x3b\x44\x52\x65\xb4\x69\xdf\x42\x80\x58\x78\x64\x7e\x5e" +
+	"\xd9\x9a\xa8\x9d\x2a\x11\x42\xf2\x80\x74\xa5\x11\xb3\x80\x91\xd0\x2d\x2c\xfd\xfe" +
+	"\x2c\x9d\x51\x1c\x71\x43\x8f\xa9\x5d\x47\x42\xfa\xbd\x3d\x36\x8f\x2e\xd1\x9f\x91" +
+	"\x19\x48\x4e\x58\x06\x14\x6e\xda\x80\x34\x36\x17\x67\x17\xf3\x32\x5a\x8d\xd2\x0e" +
+	"\x0d\xc9\xfd\x29\xc5\x22\xf3\x77\xf5\x35\x79\x2c\x54\xcd\x12\x91\x26\x99\xcb\xc4" +
+	"\x3d\x10\x73\xd1\x8e\xa4\x11\x4e\xbb\xa7\x30\x97\x99\x55\x1c\xf4\x47\xca\xc1\xb8" +
+	"\x54\xe6\x9f\xea\xf9\x98\x7f\x4e\xc4\x40\x3b\x20\xdc\xf9\xe7\x83\x5a\xa2\x54\xcd" +
+	"\xc9\x8c\xca\x52\x2c\x2b\x26\x52\xbc\xa6\x33\x17\x7d\xd6\xc2\xe6\xfa\x6c\x3b\xbe" +
+	"\x73\x49\x75\xb6\xbc\x03\xf8\xc4\x25\xfd\xd1\x5c\xc1\xa8\x8d\x66\xbc\x8f\xba\x3b" +
+	"\xc1\xef\xd3\x6f\x7b\x74\x24\x6f\xfd\x8a\x7c\x47\x84\xe0\xf7\xe0\x1b\x30\x47\xa5" +
+	"\x62\xa1\x3c\x64\x0d\xc7\xc5\xb2\xc1\x04\xac\xe4\x9d\x51\x7f\x80\x65\x20\x0b\x77" +
+	"\xe0\x76\x6b\xec\xbb\x98\xc6\x8c\x2d\x50\x2a\xa6\xe6\x60\x26\x1f\x27\xa2\x5c\xad" +
+	"\x9c\xb2\x43\x8b\x83\x75\xe5\xf9\x1f\xf5\x27\x37\x5e\xbe\x34\x56\x46\x43\x57\xf6" +
+	"\x7f\x75\xdf\x61\x88\x41\x3c\x74\xa5\xfe\xa3\x71\x87\x4f\x77\x72\xb4\x1b\x78\x74" +
+	"\x87\x86\x02\x33\x1b\xb1\x7a\x20\xd5\x6d\x4c\x80\x1b\xa3\x14\x79\x3a\x72\x20\xb3" +
+	"\xe7\xb6\x11\xa7\x9e\x09\x2e\xf3\xb6\x4a\x26\xd3\x1e\xae\xbf\x7d\x79\xc3\xe1\xbf" +
+	"\x67\xc2\xa0\x95\x14\x3e\x3d\x3b\x42\xc3\xb1\x03\xcf\x94\x6e\xe0\xc5\xc0\x3e\xa1" +
+	"\xa8\xa5\xc2\x1f\x14\xec\xc8\xbc\x95\xff\x5d\x9a\xfe\x94\xd5\x52\x52\xb4\x94\x87" +
+	"\x0e\xe3\xec\x9b\x02\x32\xb4\xc0\xc5\x79\xed\xa8\x4f\x5b\x64\xac\x89\x0a\x48\x69" +
+	"\xd6\xb9\x6b\x51\xad\xae\x00\x9e\xf9\xd2\x9a\x87\xcf\xc2\x50\xc4\x9d\x5e\x56\xcc" +
+	"\x2a\xc3\x6b\x23\x22\x47\x18\x33\xc5\x2c\xdb\x24\x85\xc5\xa2\x88\x4d\x85\x04\xad" +
+	"\xe0\x24\x2d\x09\xe9\x97\xac\x7c\xe1\xe6\x9a\xd4\x69\x77\x99\xba\xcd\xd3\x6d\x3a" +
+	"\xe2\x71\x07\xe5\x6f\x4b\x1c\x29\x15\x58\x34\x1c\x39\x68\x8e\x66\xa7\xc9\x0d\x28" +
+	"\x82\x8d\x61\x80\x23\x70\x32\xe3\x45\xb3\x4e\x6d\xb5\x3f\xf0\xa7\x63\x4b\x3a\xa6" +
+	"\x9e\x00\xa0\x7c\x33\x3e\xfa\x0d\x18\xd2\xc9\xd0\x4a\x64\x43\xe2\xc5\x69\xba\x51" +
+	"\xbd\x91\x3b\xde\xbd\xbf\x11\x34\x16\xdf\x8d\x87\x1b\x03\x69\xeb\x8d\xb2\x63\x2f" +
+	"\xde\xcd\x7b\x02\x03\xcb\x40\x23\xbc\xe4\xa9\x61\x5b\xd4\xb3\xe9\x97\xdb\x1a\xea" +
+	"\xae\x38\x8b\x9f\x3c\xb2\x5d\xe2\x1a\x1d\x9e\xa5\x7e\xe3\xe1\xe6\x0e\xa2\xe7\x63" +
+	"\xa4\x3b\xd5\x9e\xa8\x3a\x5b\xe5\xed\x70\xf5\x70\x65\x6e\x41\xf9\xb3\x15\x37\x4c" +
+	"\xcb\xb7\xa6\xfe\xa3\xf9\xf6\xe2\xf8\x74\x32\x32\x93\xfd\x6a\x3e\x33\xee\xf4\xec" +
+	"\xc1\x9e\x67\x4a\x37\x1a\xd6\x3d\x13\x65\x71\x9e\x8f\x63\x4b\xf0\x48\x48\xd9\x3d" +
+	"\x0b\x65\xb7\x03\x11\x66\xeb\xee\xf7\xb7\x3d\x26\x2b\x4d\xaf\xab\xdb\xb5\x7b\x19" +
+	"\xf0\x32\xb8\x51\x67\x6b\xde\x78\xf9\xd6\xb4\x2f\x4d\x4f\x97\xeb\xca\xfe\xaf\x6e" +
+	"\x38\xbd\x2d\xda\x36\xd0\x79\xa3\xe1\x70\xe4\x0e\x6c\x79\xb9\x7a\x5a\xfc\x0d\xd4" +
+	"\xcd\xde\x40\x35\xe4\x09\x38\xb4\x89\xc1\x0b\x58\xde\x99\xc2\x2b\xf0\x1c\x1a\x1a" +
+	"\x0f\x1b\x77\xee\x25\x8e\x6b\xaf\x75\x47\xbc\x6b\x6d\xc7\x56\x94\xdc\x56\xd9\x7a" +
+	"\x40\x82\x1e\x92\xdf\xcd\xf3\x85\xdd\xa2\x0d\x3a\x12\x20\xe8\x65\xca\xfd\xa5\x7d" +
+	"\xef\xd5\x75\x9e\x06\xf5\xa8\xdb\xe1\x1b\xd5\x32\x58\x7b\xfe\xd2\xde\xba\x7f\x83" +
+	"\xee\x62\xef\x5b\x1c\xb8\xe9\x0e\x86\x6d\x9f\x0f\x18\x3c\x6f\xe4\x79\x4b\xfe\x35" +
+	"\x8f\x34\x0a\x92\xeb\x30\xef\x00\xc7\xfc\xca\x35\xbf\xe9\x1b\xdb\x94\xd6\xf5\xa5" +
+	"\x7e\x41\x2b\x82\xdc\x17\xdb\xc2\xa9\xd3\xa5\x03\xf3\x41\x45\xe5\x66\xb0\x81\x4f" +
+	"\x87\x9a\x64\x18\xf0\x0c\xad\x7e\x00\xb9\xe3\x36\xd0\xed\xe7\x74\xc3\xd1\xcb\x38" +
+	"\xf4\x5e\xc3\x75\x3d\x40\x3f\xc1\x6b\x16\xbd\xdc\x40\x5e\x42\xc0\x91\xcd\x75\x94" +
+	"\xd7\x7a\x17\xd8\x9a\x46\x1e\xd7\xf4\x47\x79\x5c\xd3\x9f\xe1\xee\xcf\x97\xe5\x68" +
+	"\xbb\x32\xf7\x0a\xe0\x82\x8f\x1d\x7a\x92\x47\x13\x74\xe8\x09\xbb\x1b\xe0\xb8\x25" +
+	"\xf4\x1f\xcd\xb0\xea\xd5\x6b\xcd\x77\x31\xe4\x7f\x8a\x21\x7f\xe5\xdc\x8d\x3e\x3d" +
+	"\x4f\x65\xef\x9e\x84\x2c\x54\xae\xf0\x73\xba\x74\x50\x07\xe8\x6e\x46\x10\x2d\x7b" +
+	"\x06\x8a\xbf\xcb\xf5\x85\xbb\x63\x8f\x83\xc5\x8e\xa1\xab\x77\xfe\x82\xe6\xcc\x6f" +
+	"\x0e\x1d\x70\x34\x2b\xd7\x79\x9d\xac\xfd\x2b\xf5\x8d\x6e\x87\xc3\x75\xf6\xf4\xd7" +
+	"\x3c\xac\x75\x91\xa2\xa3\x9e\xf0\x5a\xb6\xe1\xb2\x6c\xb7\xdb\xb2\xdd\xae\xcb\x76" +
+	"\xbb\xbf\xd6\x91\xa0\x6b\x1d\xa9\xb9\xd6\x56\xbc\xa4\xfb\xcd\xe4\x5f\xd8\x7a\xdc" +
+	"\xc3\x7b\x36\xe8\xd2\x81\xef\x27\xc8\xd6\xa3\xa8\x51\x53\x34\x51\x8a\x84\xa0\x76" +
+	"\xb6\x20\x45\xb5\x8f\x65\x54\x49\x15\x32\x9f\x82\xfe\xfd\x19\x80\xdd\xc3\xd2\xdd" +
+	"\x79\x70\x73\xca\xee\x22\xff\x7a\x87\xd8\xf1\xa7\xe3\x61\xd8\xfd\xb2\xe3\x76\xba" +
+	"\xa9\xe1\x7f\x2c\x3c\x60\xd9\x8c\xc4\xec\xfd\xe6\xd0\x7f\x74\xb7\x53\x4d\x5c\x76" +
+	"\x9e\x7e\xec\x74\x38\xf6\x2b\xcd\x1c\x0f\x70\xf5\xcc\x57\x77\xa7\x07\xb9\x1c\x8d" +
+	"\x78\x6b\xf7\x59\x3d\x60\x9f\x3d\xc9\xa4\x74\xe0\x6e\x7a\x8d\xe9\xd0\x8c\xad\x4a" +
+	"\x88\x7a\xa1\xf0\x14\xc3\x00\xcb\x40\xad\xa3\x1e\xbb\x3f\xcd\x70\xc3\xee\xc2\xeb" +
+	"\x4a\x87\x1e\x6c\xe9\x93\xfd\x7d\xe6\xfd\x1a\x8b\xb5\x26\x2f\x6a\x4d\x82\x21\xd5" +
+	"\x33\x00\xf2\xef\x6b\xd0\x83\xb9\x46\x36\x96\xb4\x0d\x3a\x4a\x1f\x43\x0b\x01\xe4" +
+	"\x49\x05\x2a\x71\x08\x65\x7e\x39\xdd\x2d\xef\x98\x76\x3f\xe3\xee\x46\x1c\xf4\xe6" +
+	"\xed\xc8\x3e\xc9\xb8\x25\xea\xc6\xf5\xc6\xdd\xab\x0a\x7f\xe1\xfc\xb1\x78\xad\x65" +
+	"\x5f\x60\xc5\x89\xd8\xab\x0d\xb2\xde\xdc\x4a\x13\x94\x9d\xdb\x7a\x30\xbe\x7c\x01" +
+	"\x0c\x3a\x3b\xf5\x08\xaf\xbe\x7a\x92\x61\xa9\x37\xea\x7e\xd5\xf5\x59\x80\x16\xf3" +
+	"\x03\x29\x7e\xed\x7f\x77\x7c\xa6\x2f\xec\x59\x7c\x68\x0e\xb2\x0f\xc6\xf1\x80\x1e" +
+	"\x46\xff\x30\x0f\xeb\x8d\xf6\x47\xef\x8c\x0c\x0d\x33\x4f\xdd\x63\x74\x50\x1d\x9a" +
+	"\x8b\xac\xcb\xd2\x0c\x25\x37\xf1\x60\xc6\xfa\x5a\x66\xb7\x2b\xe7\x4f\x7f\x69\x6c" +
+	"\x0c\x70\xfd\xea\xf8\x79\x7d\x65\x66\xe8\x3c\xd6\xa7\x75\xc4\x8e\x3c\xbe\xdd\x8d" +
+	"\xfc\x63\x02\x7a\x9f\xf2\xf0\xe5\x10\xe7\xc4\xb6\x4e\xbf\x82\xaf\x5b\xf7\xe3\x7b" +
+	"\x91\x78\xe1\x71\x1c\x99\x35\x1f\xe3\x3a\x34\xa7\xbc\x23\x35\xc0\x97\x59\xf7\x8f" +
+	"\xbd\x05\x50\x3c\xce\x32\xa2\xf1\x03\x34\x1d\x7a\xfe\x19\x4a\x06\x70\x97\xb5\x78" +
+	"\x0e\x00\x77\x8a\x87\x2e\xae\xff\xea\xdb\xd0\x7e\x05\xd0\xe3\xc2\xd8\x1c\x88\xa5" +
+	"\x66\x74\xe9\xa0\xdb\x74\xd7\xa3\x3f\x78\x26\x5f\x3c\xb3\x41\xef\xe1\x82\xba\xf5" +
+	"\x5a\xb6\x51\x0b\x9f\xdc\xac\xbe\xfe\x7f\xcd\xf2\xad\xeb\xa9\x7c\x56\x7f\x82\xe7" +
+	"\x1f\x4d\xb7\x6a\x37\x18\xd9\x94\x4e\xfc\xe1\x8e\x93\xb0\x2f\x21\xf7\xff\xfc\x7e" +
+	"\xe4\xb9\xec\xff\xf1\x2e\xa5\x87\xfd\xdf\x30\xf3\xba\xb2\x1b\x4b\x63\xdf\x3b\x4b" +
+	"\x43\xc8\xf3\xbd\x0f\x36\x46\x87\x6d\x60\x6b\xc7\x7b\xe7\x5c\xc8\xd0\x6c\x4b\x6e" +
+	"\x2d\x59\xaf\x37\x5e\x6b\xf8\xc2\x72\xf4\xbf\xcb\x1a\x25\xd4\xa2\x05\x79\xb4\xc0" +
+	"\xd2\xad\xc5\x23\x30\x20\x9f\x8d\x47\x2e\xac\x31\x09\x4a\x14\xc8\x75\xff\x20\x6b" +
+	"\x3d\x66\xea\xd3\xc1\xbd\xa5\x79\x0c\x7b\x2d\xcd\x57\xcf\x46\x43\xce\xc5\x0a\xae" +
+	"\xb9\xc8\x8b\xd0\xd9\x69\xf7\xf2\xbf\x6b\x44\xee\xd8\x9d\xfe\xef\x1a\xae\x05\x3d" +
+	"\xf6\x54\xfd\x2d\xb1\x6f\x8d\xf1\x3d\xba\xbe\x6d\x5f\x9a\xdf\xf4\x8f\xc6\x03\x47" +
+	"\x67\x53\x13\x69\x86\x9b\x9e\x2a\x17\x7a\x2b\x3b\x77\x8f\xf4\x05\xd2\xd0\x7c\x6e" +
+	"\xcd\xf9\x65\x31\x92\x35\x74\x16\x9b\x04\x86\xcb\x3e\x2e\x67\x95\x99\xa7\x38\xf7" +
+	"\x3a\xcf\xed\xa9\xbe\x02\x90\xed\x97\xc3\x3b\xf3\xd9\xc5\xe1\xcc\xa3\x85\x5e\xc6" +
+	"\xfa\x02\x9c\x3a\x5d\x3a\xe8\xae\x5c\x66\xf7\xc6\xdd\x45\xf6\x07\x98\xbf\x74\x3d" +
+	"\xb8\xdd\xb9\x45\x88\x2f\x9d\x9d\xfa\x88\xc4\xed\x84\x57\xaf\x1f\x8e\x3d\x99\x47" +
+	"\xe6\x44\x60\xea\x56\x7d\x40\x11\xf4\xca\xdd\xf1\x70\x93\x06\xb0\x74\x75\x96\x0f" +
+	"\xc8\x8b\xc2\x4f\xdd\xcb\xec\x96\x71\xfe\xd6\x35\xd7\xd6\xc1\xca\xda\x91\xc6\xb8" +
+	"\x46\xc1\x9a\xc6\x83\x33\xec\x7e\xf2\x79\x79\xf1\xe0\xa6\x97\x8e\xe1\x2f\x27\xbd" +
+	"\xa0\x4c\xf3\x15\xc5\xdd\x5f\x7e\x69\xd7\xcf\x3f\x06\x1e\x0e\xa7\x19\x6f\xd4\x63" +
+	"\x02\x7b\xc2\x6a\x5f\xc1\x81\x7d\x5c\x37\x34\x1c\xdf\x63\xbb\x6b\xca\xfe\x8f\xe6" +
+	"\xcb\x77\x6f\x91\xd2\x0c\xf3\x61\xe6\x41\x8a\xe5\xb8\xb7\xd1\xbd\x36\x89\x46\xca" +
+	"\x82\x1d\xe1\xcf\x8d\xbf\x8c\x3d\x91\xe0\x91\x82\xae\x19\x56\x23\x82\x91\xe2\x6b" +
+	"\xed\x1e\x37\x8f\xcb\xc7\x2e\x40\x1d\x60\xb8\xe9\x60\xf8\xdf\xb6\x91\x4a\xbf\xf2" +
+	"\x77\x66\x8c\xb2\x92\x3a\x3b\xfb\x43\x26\xee\x07\xa8\x60\xce\xf8\xec\xfd\xdb\x39" +
+	"\x4a\x5f\xa8\xde\x68\x58\x0f\xdf\x7e\xf7\xab\x63\xb7\xe7\xcd\xfe\x33\x7d\x63\x0f" +
+	"\x6d\xbb\x79\xa9\x3b\x97\xc4\xf6\x42\xed\x78\xf9\xd2\xf4\x28\x5c\x77\x7b\x2a\xb5" +
+	"\x33\xfc\x86\xf6\xd3\x35\xe7\x00\xbd\x09\x72\x6f\x57\xa7\x3d\x7c\x67\x6a\x3a\x1a" +
+	"\xce\x46\x8c\x1c\x78\x35\xe0\xe1\xf5\x6e\xb9\xdf\x32\x3b\xe0\x88\xa3\xf3\x7b\x64" +
+	"\xee\x86\xcd\x0d\x46\x4b\x75\x38\xac\x7b\xa0\xde\x65\x9d\xe9\x8f\x3b\xfb\x73\x1b" +
+	"\xf8\x60\x71\xe6\xe7\xc8\x51\xf6\x05\xb8\xa3\xee\xa0\x2f\xc6\x31\x7e\xf6\x96\x6d" +
+	"\xb8\xbb\x1e\x81\xed\x2e\x1a\xec\x78\x64\x2a\xef\x97\x4f\x8c\x30\xbf\x23\xbc\x3b" +
+	"\xb1\x93\x1b\x97\x9c\x9a\x6d\x8d\x3a\x2a\x69\xf0\x6b\x5d\xd6\x75\xd6\xcc\xd0\x9d" +
+	"\x03\x78\xce\xfb\xfd\xb9\x03\xdf\x3e\x47\x53\x91\xa6\xd4\x2f\x78\x1b\xdf\xa8\xe7" +
+	"\xac\xc6\xc5\xa7\x88\x0e\x47\x4c\xcb\x3d\x4d\xec\xba\xb2\xff\xab\xeb\xc1\xe1\xd4" +
+	"\x47\x41\xd7\x75\x9b\xef\xcc\x0d\x07\xfe\x9d\x6b\xdf\x51\xc4\x72\x0c\x48\xc2\xee" +
+	"\x9d\xfb\x7a\xbd\x30\x04\xdd\xb1\xf0\x97\x93\xe1\xb3\xdf\xef\xfb\xad\xf9\x72\xd7" +
+	"\x0b\x05\x67\xa3\x54\xfa\x22\xfc\xac\x65\x5d\xbf\xa1\x3b\xc6\xb4\xbb\x2d\x2e\x69" +
+	"\x65\xb8\x1b\xea\xc2\xd4\x5e\x9c\xec\x11\xb7\xde\xda\xcc\x4e\xd9\x86\xbf\xd7\xe9" +
+	"\xe2\x60\x4c\x97\x0c\x9f\x5f\x70\xed\x9e\xba\x61\xcf\x97\xc3\xb8\xfe\xa3\xb9\x9d" +
+	"\xf7\x1c\xff\x26\x09\x48\x3a\xf0\x18\xeb\xf3\x8e\xd1\x05\x24\xe5\xe0\x5e\x9f\x0e" +
+	"\x4d\x5c\xff\xd1\xf6\x30\x5b\x0f\xb3\xaf\xdc\xfb\x6d\xb1\x37\xec\x4e\x3d\xe5\x9d" +
+	"\x3b\xa0\x7b\x64\x6b\x47\x77\xdc\xa7\xbb\x87\xd9\xb7\xff\x26\x0f\xf4\xd3\x3d\x39" +
+	"\x17\x0d\x54\xdc\xf7\xc7\x7c\x78\xb5\xfd\x1d\x75\xf6\xf7\xae\xa2\x0e\x87\x43\x07" +
+	"\x3d\xf3\xd9\x37\x50\x0c\x0d\xcb\x1e\x95\x41\xcf\x5f\x31\x02\x37\xca\xf2\x56\x7e" +
+	"\xb8\x83\x43\x40\xbd\x62\xf9\xe1\x2c\x18\xf7\x73\xe8\xab\xfb\x94\xa9\xe8\x90\x59" +
+	"\x61\xde\x44\x32\x8b\x57\x9c\x22\xd9\xd8\x52\x56\x9f\xf7\x5a\x2d\x42\x0e\xae\x94" +
+	"\xa3\x4f\x03\x1d\x2d\x03\xba\xdf\xe3\x9e\x1b\xba\xb9\xba\x0a\x3d\xfa\x1b\xcf\x6f" +
+	"\xb8\x7c\xe1\x76\x63\x0f\xc7\x35\x16\x90\x69\x54\xb6\xa4\xe2\x20\x18\x09\x73\x94" +
+	"\x23\x77\xd2\x44\xe4\xd9\x84\xf4\xb8\xf7\x69\x2e\x87\x7f\x9c\xe3\xa3\xd3\xe7\x37" +
+	"\x92\x3f\xdb\xae\x45\x6c\x8f\xd8\x5d\x63\x22\x11\x26\xc6\x27\x29\x3e\x93\xd4\x93" +
+	"\xec\x07\x37\x88\xdb\xac\xad\xfa\xd0\x29\xea\xd2\x01\xb4\x49\xe3\x3a\x73\x7d\xbd" +
+	"\x93\xa1\xf7\xdc\x54\x67\x8c\x54\x46\xb9\xb5\x03\x15\x8d\x21\x77\xd6\xb1\x22\x39" +
+	"\x5c\x4d\x4e\x4c\xab\xae\xbc\xd6\xbe\xf8\x12\xb2\xec\x1a\x2c\xfb\x17\x1c\x6e\x7b" +
+	"\xcb\x31\x8d\x3e\x8f\xa0\xbe\xba\x90\x7f\x78\x61\x07\x3e\xaa\x3b\xf2\xd8\xc6\x18" +
+	"\x3e\xb0\xbb\x6e\x07\x97\x14\xdd\xb0\x27\x4f\xe6\xde\xbe\x6f\xed\xf9\xaf\xee\x3b" +
+	"\x8c\x91\x1b\xf9\x34\x9b\xcc\xa3\x3b\x1d\x98\x51\x19\x52\x02\xb5\x16\x6f\xeb\x95" +
+	"\x0b\x59\x3b\xf5\x04\x5c\xbd\xaf\x6f\x9b\xae\xf0\xef\x80\x50\xda\xe6\x06\xbc\x6f" +
+	"\x97\x4f\x2d\x8e\xdb\x26\xd6\x53\xb3\x35\x49\x3b\x79\x94\xc1\xa9\xcc\x31\x25\xf5" +
+	"\xa7\x6e\x1e\x76\x8a\x0d\xf7\xb2\x48\x55\xc4\xd7\xd3\xc5\x59\x74\x85\xc5\xcc\x6d" +
+	"\x2c\x92\xb0\x10\x5d\x95\x1b\x41\x5b\x91\x0b\xde\x86\x0a\x6e\x97\x40\xab\x51\x0f" +
+	"\x0a\xf8\x8d\xdd\x87\x90\xf9\x39\xb9\x72\xc3\x50\xda\x7d\xcf\x81\x87\x3c\x6b\xa7" +
+	"\x36\x7a\x0b\x3e\x52\x1e\x98\x67\xea\xbe\xb1\x87\x96\x7a\xb0\x36\x74\x70\x87\x93" +
+	"\xd5\x93\x6b\x3f\xeb\xa7\xa7\x1d\x98\x06\x64\xb1\x68\x98\xa2\x42\xda\xad\x5c\x87" +
+	"\x76\xbf\x20\x8b\xd3\x66\xd4\x33\x73\xe8\xf3\x5d\x87\x55\xfd\x4b\xd7\x23\x38\xb5" +
+	"\xc9\x0b\xa7\xb8\x95\x87\x6a\x0d\xe1\xb2\xe4\x7a\x2f\xce\xcd\x51\xdd\xdd\x99\x9a" +
+	"\xe2\xad\xa7\x0b\x3f\xe1\xca\xa3\xa9\xa7\x89\x01\x62\xe4\xae\x7d\x9f\xf0\xaa\xa7" +
+	"\xae\x87\xd6\x23\xcc\x28\x2c\x0d\x05\x3c\x2d\xbf\x62\x36\xe0\xc9\xc7\x10\x88\xaa" +
+	"\xc8\xaf\x6f\x29\xe9\xc6\x69\x23\x5c\x80\x6e\x48\x2e\x00\xf8\x7c\x4f\xea\xb5\x21" +
+	"\x68\x3f\x45\xd5\xf7\xef\x27\xcc\x3c\xc9\x85\xd2\x80\x88\x15\xa0\xda\xa7\x04\x11" +
+	"\xbe\x68\xed\xd4\x1d\x2a\x5f\x98\x50\xbe\x50\x99\xeb\x84\x6a\x8d\x8b\x4a\x5b\x8f" +
+	"\x71\xeb\x49\xee\xae\x73\x4f\x0f\x86\x38\xf4\x4d\xdf\xb1\x23\x2b\x10\xd3\x25\x37" +
+	"\x57\x0a\x37\x29\x5a\xc9\x54\x49\x97\x56\x97\x2e\xad\x26\x5d\x5a\x79\x8c\x5b\xaa" +
+	"\x69\x94\x8b\xfd\x47\x7e\xe5\x1a\x99\xd0\xea\x32\xa1\xd5\x65\x42\xab\xdf\x9d\x2b" +
+	"\x2e\x0b\x5a\x55\x55\x11\x85\xa2\x71\x82\xdb\x67\xd0\x68\x4a\x54\xe3\xaf\x7b\xf0" +
+	"\x03\x7b\x35\xbe\x57\xb4\x6f\xcf\xae\x5d\x18\x24\x20\x5b\x1d\x92\xad\xd1\x0e\xc9" +
+	"\xd6\xc8\x81\x35\x54\xb9\xfd\xab\x5a\x55\xe3\x5f\xbd\x4e\x2a\x03\xeb\x08\x6b\xd4" +
+	"\x67\xe1\x4e\xbb\x99\xcb\x68\x14\x74\xb2\xfb\x61\x30\xa0\x30\x52\xdc\xcc\x0d\xb5" +
+	"\x4c\xc4\x1c\x59\x7f\xe3\xa0\x39\x52\x0e\xd2\xd8\x73\x96\xad\x9d\x24\x03\xd2\x90" +
+	"\x8d\xba\x7b\x71\xdd\x1c\xc8\xc8\x19\xc2\x35\x0d\x26\xf0\x80\x66\x3f\xf6\x9b\x85" +
+	"\x55\x03\x12\x97\x66\xcb\x4e\x35\x54\xc0\x62\xf2\x67\x1b\xcc\x4e\xfe\xcd\x01\x67" +
+	"\x23\x2a\xb7\xcd\x62\x72\x78\x66\x13\x23\x11\x44\x81\xd3\xc1\x56\xed\x0d\x18\x90" +
+	"\xfa\x96\x82\xb3\x4c\x45\x46\x9a\xf4\xec\x54\xa6\x74\xa2\xd5\x84\xfe\x6b\x66\xf9" +
+	"\xb8\x0e\x60\x89\x36\x6c\x6e\xb5\x15\x4f\x73\x4b\x4e\x48\x5b\x3e\xd4\x89\x18\xed" +
+	"\x06\x96\x42\xdb\xb3\x63\x7b\x4f\xfe\x91\x32\xae\xb9\x69\xc7\x13\x2a\xe2\xb7\x06" +
+	"\x18\xb0\xf0\x35\x1a\x88\xc4\x75\x00\xf3\xc4\x1b\xa4\x6d\xbb\x51\xeb\xf0\x6f\xba" +
+	"\xd1\x72\xc3\xdb\xde\x53\x64\x03\x0c\x2e\x25\x61\xea\x00\x23\xc9\x9a\x56\x64\x4d" +
+	"\x99\x25\xd4\x82\xd4\xdf\x9a\x6e\x24\x37\x45\x6e\xec\xa8\x82\x5d\xfd\x32\x73\xd9" +
+	"\x3e\x8a\x26\x41\xb5\xd8\x8e\x83\x68\x33\x6a\x31\x17\xbf\x1f\x51\x88\xdb\x69\x1c" +
+	"\xbe\x0b\xbd\x53\x2d\x17\xc2\x97\x22\xa9\xeb\xdd\x23\xdf\xb0\xbc\xb5\x6f\x58\xcb" +
+	"\x7b\xe9\xa6\x4c\x89\xaa\xf5\x44\xe5\xbd\xbb\xed\xde\x6d\x77\xb3\x45\xbd\x10\xa8" +
+	"\xe9\x54\xc5\x78\xcd\x2e\x9e\x8d\xd9\x23\xff\xc1\x88\xb1\x01\xd5\x1c\x24\x77\x98" +
+	"\x56\x55\x02\x6e\x9d\x71\x52\x9d\x36\xa3\x16\xdf\x85\xb4\x57\xbd\x78\xdf\xaa\xd3" +
+	"\xc5\xc1\x70\x20\x0f\x9b\xd7\x11\xae\x33\x71\x32\xf4\xee\x72\xd7\x51\xd7\x7a\x35" +
+	"\x6d\xbc\x1a\xad\x03\x28\x14\x75\x58\x97\x77\x58\x97\x77\x58\x7e\xb9\x0d\x80\x2a" +
+	"\x7e\x35\x4b\xb9\x04\x61\x6b\x97\x7f\xad\x17\x99\xe5\x8e\x8c\xb5\x53\x13\x4a\x35" +
+	"\xdc\xc2\x8c\x80\xee\x35\x25\x29\xd2\x52\x19\xaf\x89\xe3\x1c\x50\x8c\xe6\x9f\x52" +
+	"\x20\x17\x42\xd3\x03\x60\xb3\xa8\x87\xbf\x16\xeb\xe1\xa9\x60\x12\xe6\xe5\x22\xc6" +
+	"\xf9\x92\x48\xb6\x8a\x0c\xf3\xd5\xae\x7f\x9d\xaf\x75\x70\x70\x1c\xaa\x97\xba\xd6" +
+	"\xa3\xd2\x49\x18\xc5\x23\x6c\xb1\x6b\xed\x87\xef\x3b\x5a\x06\x54\xf1\x75\x6c\x41" +
+	"\xb8\x3f\x3d\xeb\xd2\x01\xdf\x55\x30\x75\xba\x74\x80\x6b\xb2\xd4\x74\xac\xe5\xda" +
+	"\xe0\xc9\x89\x89\x77\xe9\xbe\xe9\x30\x5f\x5d\x80\xdb\x01\x1e\x0e\xbb\xd6\xe3\x72" +
+	"\x0e\xeb\xc5\xc9\xfc\x4b\xe7\xee\xd5\x55\x7a\xf8\x9a\xb5\x5f\xf5\x91\xde\x68\x2c" +
+	"\xb0\x9a\x11\x48\x20\x39\xd5\x38\x63\x57\xed\xdc\xfe\xab\xae\x68\xad\x14\xeb\xea" +
+	"\xee\x59\xfb\xe8\x9e\x18\x6d\xc1\xee\xc6\xe9\x35\xd8\xcd\xce\x61\xab\xbc\x73\x11" +
+	"\xfd\xb5\xc6\x81\xe0\x20\xeb\x6a\xcb\xd9\x76\x77\x51\x15\x85\xea\xf3\x55\x9b\xc7" +
+	"\xd4\x2c\x2b\x94\x38\x67\x4e\xd7\x4e\x2d\x3d\x70\xed\x3a\x95\x37\x95\x91\x1d\x5b" +
+	"\xbf\xea\xaf\x82\x6a\x2f\xfb\x8c\xa2\x32\xd0\x9d\xf1\xd0\x62\x72\x89\x42\xa4\xb6" +
+	"\x9d\x4c\x7d\xd0\x6e\x56\x23\x40\xf9\x6f\xba\x91\xfb\x63\xeb\x4e\x0c\x0e\x24\x15" +
+	"\x80\x37\x91\x0d\x2c\x91\x16\x74\x35\x72\xf0\x0b\x92\x21\xb6\x91\x04\x20\x73\x50" +
+	"\x86\x54\xa3\xe6\x42\x2b\xa6\x8f\xae\x6c\xad\x03\x34\x47\x7e\x66\xe1\xe3\x1b\x52" +
+	"\x50\x24\x4d\x97\x38\x85\x25\xea\x7a\xce\x25\x2e\x61\x89\xdc\xeb\xb4\xd0\xad\x2d" +
+	"\xf1\xf1\xa0\xef\x1c\x40\x46\x67\x58\x22\x97\xa8\x3a\xc1\x0c\x91\xf6\x05\x96\xb3" +
+	"\x48\x95\x2a\x5e\x08\x14\x1d\xb1\x6c\x4c\xf3\x8c\x2c\x4e\x9b\x51\xbe\xd5\x12\x37" +
+	"\xd9\xee\x52\x94\x16\xdb\x51\x5f\x98\x09\xa1\xe8\xae\x23\x80\xe6\x0f\x4b\xdc\xf0" +
+	"\xad\x9b\x17\x8d\x48\xbb\xa4\x95\xcf\x05\x22\x5e\x1d\xe5\x35\x62\x15\x72\xf1\x77" +
+	"\xd3\xa1\x6b\x72\x42\x64\xfb\x53\xa5\xba\x70\x14\x0f\x05\x33\xc6\x7f\xce\x12\x81" +
+	"\xf0\x52\xdd\x05\x07\x22\x45\x1a\x65\x51\xb9\x8e\x17\xe2\xe9\xaa\x3d\x20\x92\x51" +
+	"\xe5\x82\xd6\xbd\xf8\xf1\x50\x28\x81\xb5\x97\x14\x35\x3f\x21\xb9\x51\x17\xb8\xf8" +
+	"\x94\x6d\x89\x67\x94\xc2\xcb\x08\x03\xe0\xf9\x24\x91\x16\x2a\xf3\x37\xdf\x7f\x59" +
+	"\x22\xeb\x08\x81\x37\x91\x6b\x30\x59\xfc\x29\x9b\x25\x7e\xb8\x83\xef\x27\x2c\xf1" +
+	"\xb3\x68\x7e\xb5\xc4\x8f\xdd\xaf\xb2\xc4\x0f\x07\xc3\xc3\xc2\xd4\x0d\x85\x70\xff" +
+	"\xfe\x55\xf9\x40\x5c\xab\x50\x52\x5c\xd4\xcf\x76\xd0\x0c\x21\xbb\x2f\xf0\x41\xff" +
+	"\x90\x62\x17\xb8\x2d\x29\xc2\x11\x31\xd2\x3a\x65\x0a\xbc\xb8\x84\x8d\x53\xb3\x3b" +
+	"\x51\x77\x29\x32\x90\x4f\x9d\xd7\x81\x52\xd2\x7a\x8a\x14\xcd\xf9\x42\x73\xbe\x54" +
+	"\x73\x01\x78\x98\x22\x8d\x06\x6a\xfb\x3f\x42\x9d\x2c\x4e\xcd\xa1\x15\x55\x9a\x32" +
+	"\x71\x72\xd1\x1d\x44\x98\x2d\x26\x23\x72\x79\x4a\xbd\x4e\x53\x15\x1b\x37\x58\x30" +
+	"\x5d\x74\x84\xd5\x1c\x49\xdc\xac\x2b\xd6\x45\x2d\x78\x5d\x53\x20\x2f\x2c\x06\x8d" +
+	"\x3c\xae\xd5\x0c\x7e\x53\xa7\xee\xe2\xb7\x7b\xa2\x27\xc4\x64\x3f\x9d\x28\xeb\x9d" +
+	"\x69\xec\xc8\xbc\x23\xd3\x60\xb6\x79\x63\x82\x67\xc0\x72\x3b\x67\x9d\x92\xec\xc0" +
+	"\xcd\x9a\x87\xe1\x92\xe9\xd8\x36\x4f\x4b\x76\x21\x9a\x25\xe9\x8b\x6a\x65\x04\x56" +
+	"\xb0\x6b\x74\x4e\xca\x17\xee\x16\xa9\xd3\xa5\x83\x6e\xe3\x59\xf2\x6a\x43\xef\x65" +
+	"\x61\xf6\xea\x83\x89\xbb\xe1\xc0\x86\x11\xb9\xb0\xe3\x1a\x4b\xba\xe5\x81\xdd\x1c" +
+	"\x69\xb7\x34\x50\xee\x69\xd2\x67\x9b\x0b\x8b\x9b\x01\x96\x8e\x7a\x50\x77\x16\x06" +
+	"\x2f\xa8\xe3\xdf\xe1\xcd\x43\x52\xa9\x6a\xe1\x31\xc0\x32\x50\xeb\xc8\x43\xe6\x5a" +
+	"\x19\x23\xee\xa6\xbb\xe0\x40\xcb\x92\x16\x84\x0b\xd3\x00\x4b\x47\x96\xf6\xe5\x52" +
+	"\x6d\x82\xc8\x86\x4d\x3b\x23\x43\xaf\x35\x87\xc3\xee\xa7\xa7\x5f\x8e\xb8\x2e\x73" +
+	"\x49\x69\x03\xb2\x8a\x84\xc8\xe4\x48\xbb\x13\x33\x3d\x7a\xd3\x4c\x87\x9a\x66\x3a" +
+	"\xac\x81\xa5\x43\x0d\x4c\x66\x0f\x1e\x40\xed\x54\xde\x64\x42\x78\x8f\x14\x4f\x27" +
+	"\x66\xe1\x67\xc6\xcd\xc8\x5c\xcc\xe6\xc2\x93\xff\x70\xc6\x99\x23\x95\x33\xc8\xdc" +
+	"\x5a\x43\x7a\xb0\xff\x64\x24\xee\xe7\x8d\xdc\xcd\xe2\x01\x69\x68\xeb\xa0\xdb\xf5" +
+	"\xd8\xd9\x8f\x12\x6d\x1e\xa2\xdf\x15\xe2\xb8\x83\x9e\x80\x7d\x54\xf6\x87\x07\x5a" +
+	"\x47\x62\x24\x82\xb4\xa4\x87\xc9\x95\x1a\x38\x3b\x55\x31\xf1\x78\x84\x4f\x5e\x86" +
+	"\xe6\x36\x3f\x1d\x0d\x6a\xae\x3c\x50\x35\x85\x87\xb7\x84\x87\x37\x84\x47\x6f\x07" +
+	"\x80\xd3\x5d\xe4\xd3\x53\x98\xfd\xf8\xd1\x0d\xbb\x9f\x5e\xa0\x0e\xcd\xf9\xea\x9f" +
+	"\xc0\x0f\xc6\x2c\x49\x82\x3e\x46\x86\x9e\x59\xdb\xc2\x43\x28\x28\x16\xf7\x33\xed" +
+	"\xc9\x1b\x90\x43\x8b\xf4\x59\xa3\x6f\xec\x1b\x4e\x6e\xa6\xb0\x00\xee\xdd\x5a\xc1" +
+	"\xd3\x6b\xbf\x45\xb0\x46\x57\xe5\x78\xed\x5d\xe3\x1a\x3f\x64\x61\x45\xb6\x26\x0d" +
+	"\xb0\x0c\x34\x2c\x2d\x05\x1d\x9a\xb1\xfb\xe8\xee\xbd\x02\xba\x18\x31\xd3\x80\xa9" +
+	"\x67\xa3\xc3\xf5\x73\x6b\xfe\x71\xd4\xc3\xcc\x33\x72\x06\x18\x7b\x4b\x35\xe0\xfe" +
+	"\x24\xb5\x22\xa4\x31\xd3\xa8\xc5\x0e\xb2\x3e\xbd\x43\xf7\xf3\x7c\x69\xec\x58\x33" +
+	"\xd2\x32\xaa\xd4\x6b\xae\x23\x1c\xbd\xf0\x08\xe0\x78\xa1\x53\xaf\x6d\xc2\x1e\x38" +
+	"\x8c\x31\x15\xa6\x0c\x3d\x9f\xc0\x61\xa6\xc8\x98\x2b\x24\x64\x27\x9d\xc8\xf3\xc5" +
+	"\x9c\x2b\xb9\xf0\x64\x07\x71\x1d\x66\x0a\xe2\xfa\x23\x47\xbf\xae\xe2\x71\x63\xa3" +
+	"\xd2\xc8\xd0\x5b\xcc\x18\xe1\x6b\x4b\x71\x57\x2f\xe9\x72\xe0\x98\xc0\x2c\xc1\x84" +
+	"\x45\xe6\x92\xb6\x32\xbb\x14\x47\xc7\x9f\x8e\xce\x01\xf2\x3c\xa0\xb9\xf3\x27\x30" +
+	"\xbe\xb1\x59\xe8\x22\xce\x0e\x2c\xc2\xe2\x67\x18\x31\x75\xd5\x7d\xea\x83\x6e\x12" +
+	"\x67\xb0\x4f\x39\xf0\xf2\xad\xf9\x76\xe5\xd9\xeb\xba\xb2\xff\xab\xfb\x76\x5a\xea" +
+	"\x3f\x1a\xb3\xea\xd7\xe8\x80\x3d\x4a\xab\x9c\x04\xb4\x67\x15\x3a\x04\xd7\x3b\x8b" +
+	"\x76\x11\xd3\xd1\x30\xf7\x32\x16\x8d\xdf\x1a\x39\x80\x8f\x69\x64\x71\x6a\xc6\xdc" +
+	"\x38\x3e\x80\xac\x64\xae\xe2\x87\x7d\x4d\x81\xef\x08\x8e\xab\xfe\x80\x64\x42\xf1" +
+	"\xef\xac\x9c\x97\xfe\xed\x76\x5e\xd1\xec\x54\x6e\x55\x71\x77\x39\xf7\xe6\x0a\xd7" +
+	"\xda\x89\xfc\x1c\x7d\x46\x9b\xc6\x31\x8e\x1b\xc6\xf5\x4b\xd3\x1c\x77\xca\x82\x72" +
+	"\x20\x19\x5a\xdf\x7f\xf4\x13\x4c\x06\x53\x37\xf3\x4e\xd9\xe1\x67\xc0\xd3\x91\xa7" +
+	"\xc2\xbb\xff\x83\x0d\x42\x23\x16\x82\xef\x67\x3b\x52\xf9\x82\x14\xb1\x77\x24\x7e" +
+	"\x6c\xc1\x0c\xac\x4a\x1d\x6b\xa9\x3d\x31\x6b\xf9\x38\x71\x47\xa5\x97\xc9\x5a\x3e" +
+	"\x3d\xe7\x48\x38\x19\xe9\x5f\x11\x6c\x1e\x6c\xba\xc2\xac\xc5\xb5\x9a\x12\x88\xde" +
+	"\x26\x4a\x50\x91\x85\x4b\xdf\x3b\xf2\xb8\xba\x1c\xfe\x0d\xe5\xa3\x76\x46\x81\xe1" +
+	"\xd4\xa9\xc2\xd5\x24\xf9\xb0\xb9\x31\xb2\xf0\xc9\x01\xf9\xa9\x91\xc5\x8d\x88\xe2" +
+	"\xaa\x2c\x8e\x04\x26\x59\xd3\xe9\x91\x1a\x8b\xe0\x37\x7d\x41\x42\xf1\xb8\x7a\x54" +
+	"\xf5\x36\xb6\xf4\xc2\xc1\x5c\x3a\xb5\xc1\xb3\xc3\xd6\x71\x1a\x0e\xbe\xfc\x74\x5b" +
+	"\xf3\x51\x73\x9f\xf3\x0e\xdc\x6e\x8d\x92\xc0\x3b\xe9\x16\x12\xa0\x3a\x90\x2b\x44" +
+	"\xf2\x3a\x75\x17\x96\xcc\x2e\xa2\xb7\x24\xc4\xea\xac\x93\x70\xb4\x0c\xe8\xf1\xda" +
+	"\xe5\x9c\x1d\xc8\x77\xee\x75\xa3\x71\x2b\x99\x91\xc5\xa9\x3b\xf0\xd0\x7f\xa4\xfd" +
+	"\x39\x0b\x2b\x1e\x09\xdb\xa5\x0e\x3c\xf8\x23\xe6\xda\xa9\x7b\xf2\x2d\xe1\x81\x34" +
+	"\x9e\xb4\xb1\x0f\x8c\x79\x9e\x7b\x0a\x0e\xeb\x6f\x45\x95\x06\x72\xdf\xa9\x62\x3f" +
+	"\xef\x9e\x65\xe0\x6e\x61\x05\x77\x66\xb5\x75\x97\x57\x13\xaa\xaa\xf4\xdc\x67\xf7" +
+	"\x71\x37\xa0\xda\x91\xa7\x14\x6b\x0b\xaa\x4b\x91\x0d\xac\x92\xe7\xe5\x6c\x27\x0a" +
+	"\x65\x3c\xaa\x8d\x99\xea\xdf\xd9\xeb\x1f\xc0\x42\x85\x21\xe3\xa4\xdb\xec\x36\xa1" +
+	"\x38\x6d\x5a\x73\xf6\x59\xcd\xe9\xbb\xb3\x8e\x54\xa0\xe7\x98\x63\x3a\xea\x1e\xd5" +
+	"\x6f\x9f\x3e\xef\x3c\x5d\xb6\x02\x64\xe6\x48\x49\x58\xe0\x35\xf7\x45\xc4\xc0\xcb" +
+	"\x97\xa6\x47\xe8\xba\x1e\x08\x5d\x76\x0f\xe0\xab\x2f\x3f\x53\xed\xd3\x4a\x83\xee" +
+	"\xa4\x7a\x06\xd4\xf1\x9e\xc5\xeb\xa0\x81\xa5\x23\x0f\x02\x59\xa2\x85\x97\xbc\x2c" +
+	"\xf7\x30\xfa\xf7\x2f\xa4\xde\xf6\x37\xb6\xd3\xc2\x70\xb4\x0c\x68\x09\x76\xac\x10" +
+	"\x7f\xd3\x8a\x28\xc1\x8d\x5a\x87\x1e\xf2\xc0\x71\xfd\xd6\xc9\x59\x9f\x13\xff\xfa" +
+	"\x9c\x98\x97\x81\x5d\xdf\x83\xef\x0f\xf1\x63\xe8\x6e\x11\xb8\x70\x86\x0a\xaa\x0d" +
+	"\xe7\xbf\xec\xc5\x42\x6c\x52\xf4\x6b\x55\xf9\xd7\x6b\xf2\x2f\x4c\x56\x25\x9d\x87" +
+	"\xf3\x21\x96\x3f\x1f\x08\xd1\x76\xbd\x05\x5e\x7a\xfc\x56\x60\xbf\x45\x6c\x4b\x01" +
+	"\x8a\xeb\x1d\x96\x1c\x27\x9d\x3f\x33\xc0\x74\x21\xc7\xe7\xae\xfe\xd9\xc1\x32\x50" +
+	"\xeb\x28\x0f\xd4\x7d\xd8\x2c\xca\x01\xad\x33\xc7\x67\x85\xa9\x62\x54\xee\x57\x29" +
+	"\xaa\xb0\x46\xcd\x94\x04\x65\x67\x7d\x64\x2e\x95\x74\x72\xa5\x01\xcc\xa1\x54\x5d" +
+	"\xd8\x27\xc0\xee\xbf\x53\xd9\xe8\xf3\x65\x6e\xf7\x92\xd6\x26\x89\x39\xd6\xfa\x4a" +
+	"\x51\x80\x54\x51\xf6\x79\xb6\x7a\x61\x74\xe9\xa0\x39\x50\x89\xc9\xae\xd4\x01\xb4" +
+	"\x49\x39\x34\x32\xee\xee\x10\x75\xf0\xc8\x12\x3b\xe8\x4b\x4e\xe4\x30\xe9\x50\x1c" +
+	"\x94\x80\xb9\xdc\xdd\x89\xf2\xfb\x18\x07\x3c\x3a\xd6\x41\x8b\x2f\xcd\x99\xfe\xd5" +
+	"\xdd\xbe\xce\x5e\xad\xba\x5e\xe5\x0d\x56\xe5\x90\xa9\x85\x0b\x93\xd8\xc6\xb8\xae" +
+	"\x31\x97\x1c\x28\x70\xba\x38\xb0\x00\x39\x28\xe0\x44\xc9\xec\x07\x07\x1c\xd1\x83" +
+	"\xe5\x27\x63\x35\xaa\xd2\x2b\xaa\x04\x3c\x35\x62\xe7\xe7\x6e\x7b\x30\x42\x67\x2f" +
+	"\x19\x30\x8e\xd6\xb4\x6d\xb1\x53\x9c\xaf\xd9\x64\xb1\x1c\x9d\x03\x28\xcc\x55\x9d" +
+	"\xaf\x08\x21\x52\x9f\x60\xcc\xd1\xdc\x3d\xc5\x8e\xe2\x7a\x63\x82\xdd\x14\xeb\x96" +
+	"\x79\x73\x77\x80\xb4\xdc\xf0\xb6\xb6\x42\xdb\xf2\x7e\x79\x06\xb9\xe4\x7a\xc9\x9b" +
+	"\x58\x06\x62\xd2\xd8\xa0\x20\x89\x56\xa9\xf8\xb5\xa5\xa9\xde\xb0\x94\xb6\xb4\x78" +
+	"\xd5\x01\x30\x2a\x33\x77\x88\x2d\xaa\x37\xc7\x5a\x94\x6d\x51\x16\x55\x86\x5a\x07" +
+	"\xe7\x00\x6a\x4f\x06\x65\xcb\xee\xdb\xe2\xd7\xc9\x2c\xdc\x21\xb3\x68\x80\xcd\x2e" +
+	"\x90\x83\xce\x7b\xfc\x7c\xcb\xdd\xb8\xa9\x15\x45\xed\xdd\x7f\x47\xdd\x50\xd9\xac" +
+	"\x3e\x0e\xc8\xd2\x7b\x79\xc7\x79\xb8\xb4\x2a\x6f\xa8\x7b\x92\x09\xe9\xae\x27\x7b" +
+	"\xda\x4b\x6e\xf7\x4d\xd0\x43\x83\xe3\x66\xe3\x32\x46\xe2\x9d\xe6\x16\x17\x5e\x6e" +
+	"\x17\xff\xfb\x4b\xf3\xaf\x95\x05\x62\xcb\x67\x07\xcb\x40\x96\x5f\xc3\xf4\xc2\xff" +
+	"\x68\x6e\x9f\x86\x56\xee\x6f\x35\xbf\xc7\x91\x62\xbd\x91\x75\xdb\x5d\xf7\xed\xa6" +
+	"\x47\x20\x67\xcd\x61\xc9\x1e\x93\xc1\xe1\x1e\xcd\xed\x01\xdd\x48\xd3\xad\xed\x81" +
+	"\xd4\xc2\x95\xc7\x03\xf7\xf8\x81\x3d\xbc\x5a\x7e\x7b\x70\xb0\x28\x44\xe2\x08\xd2" +
+	"\xd8\x15\x00\x9e\xb3\xc4\x07\x77\xe3\x0c\xd0\x1c\x55\x0d\x20\xb9\x4d\xbe\x64\xcd" +
+	"\xcd\xdf\x2d\x5d\x72\xa3\x8a\x35\xae\x84\xee\xd4\x1c\x70\xce\xb1\x53\x4f\x80\xdf" +
+	"\x2f\x39\x90\xb9\xcb\x6c\x8c\x77\xd0\x3a\xb2\x7d\xbf\xa1\x19\x2e\xbc\xf5\x74\x6c" +
+	"\xee\xd7\x98\x91\x4c\x17\xb4\xc1\xc6\xc0\xd2\x51\x4f\x80\x5f\x5e\x74\x43\xf3\x5f" +
+	"\x4c\xd5\x9d\x15\x06\xd9\xf3\xe8\x9f\xc1\x0f\xfb\x7c\x41\x0f\xd8\x65\xb3\x1d\xe6" +
+	"\xfd\xea\xe6\x5e\x16\xe5\xea\xb6\x57\xff\xc0\x82\x1e\xa9\xe4\x83\xbe\x60\x77\x50" +
+	"\x7d\xd3\xc6\x2c\x3c\x3b\x88\xf2\x0c\xe0\xad\xa2\x8b\xf5\x0c\x7c\x9b\x5a\xd1\x49" +
+	"\x3e\xe2\x46\x9e\x84\x9a\x34\xa4\x60\x9d\xd8\x09\x34\xfb\x23\x3a\xb7\x6d\xe8\xba" +
+	"\x8d\xf7\xd9\x1d\xf6\xef\x52\xb5\xb8\xc3\x75\x76\x49\xfb\x6f\x8d\x47\x65\x56\x3d" +
+	"\x8d\x6e\x39\xc2\x33\x6d\xf1\x00\x2d\x6e\x3f\x15\x7a\x43\x19\xb3\x01\x06\x75\x26" +
+	"\x71\x6e\x89\xc7\xe5\x7e\x64\xb4\xf7\x5b\x59\x97\xdc\x9e\x6c\xba\x0f\x70\x1b\x29" +
+	"\x90\x27\x7b\xde\x4e\xbb\xf3\xd6\x13\xf7\xe2\x90\xe5\x79\xa3\x8f\x43\x75\x67\xed" +
+	"\xf5\x66\x13\x49\x30\xaf\xc6\xdd\x49\xcb\xb7\xae\xb7\xa8\xbc\x9f\x70\xf0\xce\xf4" +
+	"\x8f\x66\xf9\xd6\x79\xf6\x94\x78\xd6\x2f\xb9\xad\xf9\x27\x75\xba\x74\x60\x36\xc5" +
+	"\xd9\xd9\xb9\x6d\xd6\xd2\x37\x38\xa4\xe9\x46\x6e\xf7\x31\xbb\x8f\xbb\xe5\x3d\x48" +
+	"\xa7\xee\x2d\x37\x2f\x0f\xee\x05\x49\xc3\xd6\x73\x5f\x26\x2e\x7b\xf1\x1e\xac\x4c" +
+	"\xe9\xd3\xa9\xf9\xd1\x0b\x74\x03\x7c\x99\x59\xf5\xf1\x07\xea\x64\x9f\x9d\xeb\x91" +
+	"\xdb\x11\x7b\x39\x70\x41\x45\xef\xca\x85\xe5\xeb\xe0\xde\xee\x01\xba\x1f\xa0\xca" +
+	"\x99\xdb\x73\x8d\x78\x26\x0f\x8e\xfc\x5a\x5d\x01\xa6\x01\x86\x6d\xf7\x58\xb9\x90" +
+	"\xc7\x91\x3b\xab\xc3\x59\xed\xf5\x4b\xd7\x8f\x2c\x03\xf5\x2f\x78\x88\xa1\x6a\xd4" +
+	"\xfd\x74\xa6\xaa\x41\x95\xdd\xb1\x8e\xaa\x28\xe8\xdd\xca\x51\xc6\x00\x05\x5c\x1d" +
+	"\x74\x03\xf7\x30\x76\x55\x72\xe3\x60\x7d\xa7\x4b\x07\x8a\xe1\x7f\x57\x5c\xfb\xe4" +
+	"\xa7\x71\x5e\xb2\x7e\xa1\x6e\xda\x0e\xef\x99\x1c\x8a\x51\x9a\x1b\x02\xa4\x9d\xba" +
+	"\x53\x04\x49\x2d\x0f\x5d\xa6\xf4\x86\x6d\x60\xcd\xc9\x1a\x92\xeb\xed\xe1\x27\x21" +
+	"\x86\xde\x93\xd2\x3c\xc6\x96\xbe\x1b\x73\xf3\xd2\x6b\x7e\x9d\x8d\x1c\x0c\x66\x0d" +
+	"\xfb\x4b\x4b\x77\x91\x9d\x01\x06\x3c\xbc\x86\x18\xb2\xf0\xcb\x7a\x0d\x5f\x65\xf5" +
+	"\x14\x01\xba\x75\x1f\xd0\x38\xf9\x64\x69\xf2\x8b\x30\x0d\x29\x75\x27\xe3\x97\x40" +
+	"\xde\x2d\xbd\x67\xf6\x63\xa1\x5f\xb8\xdd\x9a\xdf\xdb\xb5\x55\x6a\x8a\xeb\xb2\x09" +
+	"\xdf\xc0\xfd\xdb\x76\x7d\x5a\xfe\xd1\xf5\xe0\xe4\xb6\x27\xd2\xc7\x94\xd3\xd9\x55" +
+	"\x66\xf2\x65\xd9\x93\x64\xd0\x82\xb0\xa5\xa8\x03\xf7\xd5\x4f\xa9\x0c\x5c\xf6\x6f" +
+	"\xdc\x3d\x5e\x62\xa0\x63\x9e\x9a\x9b\x39\xbb\xcb\xe1\x08\x83\x03\x1b\xe9\x0b\xba" +
+	"\x13\x3f\x07\x32\xf0\x3f\xee\x7b\x62\xbb\xe6\xcb\x5d\xa9\xdf\xb8\x5b\xf4\x7c\x18" +
+	"\x4b\x10\xe4\x9d\xf0\x79\xd5\xa9\xd3\x3e\x75\xea\xb8\x75\x8d\xa7\xe9\xaa\x93\xa7" +
+	"\xc2\xd7\x18\xd6\x71\xf4\x5e\x03\x8a\x17\x7d\x42\xab\x26\x36\x5a\xea\x94\x46\xa7" +
+	"\x16\xc7\x6f\x67\x44\x38\x8f\x5f\xc4\x2d\xbe\x6e\xeb\x1c\xfa\x1e\x9a\xee\xe7\x74" +
+	"\x74\xdb\x9f\x6e\xe6\xe1\x9d\xee\x5a\xe1\x22\x47\x6c\x44\x4b\x4b\x03\xdd\xa6\x3a" +
+	"\x75\xbd\xe5\xee\xd7\xd6\x07\x10\x55\x41\xaf\xe7\xbf\xd9\x97\x3b\xbf\x79\x2c\x4e" +
+	"\x7e\xfb\xee\x72\xfe\xf5\xdd\xe5\xfc\x5b\xea\x3c\xe8\xd2\x01\x4e\xfe\xba\x58\x28" +
+	"\xe8\x13\x16\x89\x13\x20\xc1\xb7\x08\x2b\x12\xbd\xb8\xb4\x94\xf9\x94\x84\xbf\x03" +
+	"\x1a\x50\x99\xcf\x9a\xf7\x1b\x34\x8c\x2e\xeb\x2b\x1d\xf8\xaa\x6c\xe8\x3e\x37\xb6" +
+	"\xb2\x74\x5d\x5a\x06\xf4\x38\x2f\x9f\xcb\x16\x5e\xba\x90\x8a\x93\xc5\xa6\x3f\x05" +
+	"\x23\x43\xeb\x2a\x85\x48\xd6\xe3\xc5\x4c\xc0\x28\x06\x9b\x66\x06\x22\xd2\xe2\x4b" +
+	"\x93\xda\xa2\x55\x1b\xaa\xd8\x0a\x0e\x24\xda\xa5\xab\x1b\xa8\x68\x1d\x9d\x0e\xce" +
+	"\x6e\xa2\x2f\xe9\xa8\x75\x64\x89\xb9\x7b\x3d\xec\x2d\x83\xee\xb3\xfb\x1b\x6e\x87" +
+	"\xcb\xc1\x15\x2c\xfb\x0f\x83\xab\x88\xb4\x34\x1b\x66\x19\x68\xca\x26\x45\x71\x97" +
+	"\xca\xb3\xfe\x02\x08\x38\x43\xdb\x99\x0e\x19\xbc\xe3\x47\x06\x6f\x0d\x46\xe5\x20" +
+	"\xd5\x95\x89\x81\x28\xc2\xb1\xd8\xdb\x28\x58\x9a\x66\x99\x1a\x83\x8c\xeb\x82\x8c" +
+	"\x25\xcd\xa3\x90\x6b\x15\xbf\xee\x3f\x5f\xca\xa5\x77\xaf\x8d\x36\xa3\x96\xe5\x8b" +
+	"\x5b\x56\x20\xf8\xb8\xa6\xee\x9a\xbd\x38\x33\xe8\xce\x4e\xdb\x10\xc6\x48\x01\x3c" +
+	"\x5f\xa6\x72\x89\xe4\x82\x6c\x3a\x0a\x36\xef\xfd\x11\x95\xbb\xf7\xee\xfb\x9d\x1d" +
+	"\x11\xf4\x7b\xe7\xd6\x6d\x81\x95\x97\x10\x3b\x48\x37\xb4\x18\xcd\xd8\x02\xb4\xd3" +
+	"\x32\x20\xd3\x33\xe2\x18\x38\x4d\x22\xa7\xbc\xf7\x13\x49\x50\xcc\xce\x8b\xd5\x49" +
+	"\x79\xef\x08\xb7\x0f\x20\x8f\xcc\x30\x78\x90\x97\xe7\xed\x88\xef\x6f\x62\xe1\x5b" +
+	"\xf4\xb8\xd8\x82\x38\x79\x58\x5c\x1c\x7d\xe9\xe2\xe8\x8b\x89\xa3\x2f\x35\x3e\xb1" +
+	"\xd4\x43\x7b\x4b\x7f\x58\x15\x40\x1d\x10\x69\xa2\x52\xa9\xb6\x1c\x25\xd6\xe7\xf1" +
+	"\xa3\xc6\x32\x76\x4f\xaa\xcf\x35\xb2\xd2\x47\x75\x8d\xea\xb2\x03\xab\xcb\xa6\xa1" +
+	"\x53\xad\x91\x9a\x80\xaa\xb4\x1d\x7c\x6c\xce\xfd\x99\x97\xbb\xf6\xd7\xf8\xd6\xed" +
+	"\x7f\x1d\xc8\x19\x4f\x03\x1b\x31\xad\x09\x0d\xf1\x56\xaa\xa9\xc9\xa9\xbe\xb9\x03" +
+	"\x05\x96\x78\x8d\xab\x53\xfc\xa6\xb8\x91\x18\xc9\x97\x43\xaa\x88\xc9\xb0\x2e\x7d" +
+	"\x30\x12\xad\x4e\x9b\x51\xcb\xb8\xa6\x19\x3a\x2f\x01\xc6\x2a\xab\xcc\xb3\x97\x79" +
+	"\xee\x65\x9e\xad\xcc\x55\x53\x78\x92\xd3\x6c\xed\x30\xa1\x8c\x65\xbb\xeb\x91\xca" +
+	"\xa5\xc2\x39\xa9\xce\x01\xa9\x9d\x05\x52\xc5\x03\x61\x73\x45\x4d\xaa\x66\x24\xb2" +
+	"\x02\xaf\xb5\xb8\x8a\x6d\x29\x44\x50\xd8\xbc\x92\xf6\xe0\x82\xfe\x45\xaf\xbb\x0e" +
+	"\x2a\x0b\xea\x96\x4b\x19\x2f\x5d\xca\x18\xd0\x08\xfa\xf2\x5a\xc1\xae\x74\xbd\x36" +
+	"\x2b\xb3\xcb\x5e\x0b\x05\x10\xc2\xb5\xff\x20\x4c\x52\x6d\xb6\x59\x3f\x94\x2f\x47" +
+	"\x4a\x97\xcb\x24\x08\xaf\xc9\xb6\xb7\x79\x4e\x72\x11\x91\x43\x89\xb3\x5c\x18\x50" +
+	"\xe3\xe1\x74\x5e\xcc\x9d\xc5\xe9\xbc\x58\xeb\x5d\x2c\x2f\x2e\xca\xc9\x36\xcf\x2f" +
+	"\x29\xdb\x84\xc2\x47\xe2\x16\xdd\xc5\xde\x59\x84\x58\xa0\x9c\x8a\x58\xb8\x70\x0f" +
+	"\x45\x10\xd3\xfd\x99\xa8\xcc\x57\x09\xcb\xe5\x77\x7d\xdd\x68\x19\x50\xdd\xe2\x55" +
+	"\x07\xdf\xe6\xf2\x1d\x9d\x7e\xb3\xcb\x72\xb5\x9f\xb0\xf8\x3a\x4c\x42\xc8\x8b\xef" +
+	"\x9f\x5d\x67\x56\x15\xd3\x2e\xda\x3b\xd6\x87\xa9\x64\x1f\xda\xc2\xf2\x56\xbf\x88" +
+	"\x4a\x19\x1a\x75\x63\xa5\xdc\x81\x19\x9d\x61\x79\xf3\xa6\x7e\xea\x74\x71\x20\xa7" +
+	"\x9f\x14\x16\x2d\x99\x3e\x94\xd5\x67\xd7\x0b\x2c\x4e\xad\xad\x18\xee\x96\x64\x16" +
+	"\x93\x22\xd2\x2c\xc5\x42\x9f\x90\xe2\x33\x55\x53\x57\xd7\x49\x68\x31\xc5\xe7\x9a" +
+	"\xd0\xaf\xa9\x05\xc4\xec\xa4\x50\xbf\x8d\xb6\x13\x83\x0d\x91\xc7\x05\xb4\x4b\x71" +
+	"\x6c\xae\xc4\x16\x94\xa9\x39\x66\xa5\xaa\x38\x8c\xb5\xe2\x54\xa1\xef\x52\xf5\x02" +
+	"\x8e\x50\x75\x07\xb5\x3b\x38\x5f\xe6\xf3\x7c\xfd\xef\x42\x26\xf9\x86\x6e\x8d\x6d" +
+	"\xc3\x9c\xa9\x16\xea\x0a\xb6\xf4\x36\x4f\xae\x36\x0e\x78\xcf\xd8\x4c\x4f\x78\x3d" +
+	"\x68\xd5\x9b\xda\x63\x17\x52\x5b\x48\xd3\x14\xd2\xdc\xaf\xce\x1a\x88\x4a\x91\xe6" +
+	"\x57\x91\x42\x3c\x90\x26\xa2\xa0\x67\x38\xfa\xf2\xb0\x66\xce\x10\x3a\xc5\xe1\x78" +
+	"\x27\xe0\x86\xe4\x48\x1a\xd1\xdd\x2f\x16\xbd\xe1\x7a\x9b\xb7\x8e\xd2\x40\xed\xbc" +
+	"\x91\x0c\xdb\xa7\x9d\x69\xbb\x11\x86\xed\x8c\x8d\x50\xb4\xc9\x33\x87\xa4\xb9\x0a" +
+	"\x6a\x03\x7f\x82\x9f\x5a\x41\x10\xed\x44\x63\x8a\xdc\x64\xf5\x57\xc9\xef\xa0\x72" +
+	"\x8a\x37\x06\x6a\x27\xb0\xeb\x07\xea\x76\x46\x51\x2f\x5a\x57\xa7\x8b\x83\xbc\x3f" +
+	"\x43\x5a\xae\xf9\xff\xb8\x3a\xbf\x3d\x57\x5d\x1d\x8a\xdf\xfb\x96\x4e\xa5\x2d\x7b" +
+	"\x50\xfc\x81\x76\xa6\xfb\xe9\xcf\xe7\xbb\x92\x60\xf7\xb9\x28\x59\x84\xf0\x47\x8a" +
+	"\x80\x10\xc2\xec\xe9\x8a\xa7\xf9\xa1\x61\x25\x6e\xa0\x4f\x29\x95\x29\xa1\xeb\x22" +
+	"\x97\x0a\xb9\xbb\x02\x59\xba\x87\xda\xd8\x40\x23\x38\x0c\x28\x26\x16\xfc\x51\x31" +
+	"\x11\x60\xa1\xe0\x82\xc7\x85\x2c\x5e\x6d\x87\x93\xe2\x71\x1d\x8e\xe0\x3e\xa5\x47" +
+	"\xe5\x07\x78\xef\x87\xb9\xe8\x7b\x27\x06\xf1\x94\xb6\xe3\x69\x90\x17\x2c\x61\xdb" +
+	"\xc7\x5c\x8a\xeb\x16\x90\xd3\x85\x3e\x98\x64\x81\xbd\x63\x13\x64\xc8\x9b\x52\xd1" +
+	"\xbd\xeb\x49\x56\x9b\xe5\x4a\x68\xd1\x3b\x65\x36\x9c\x13\xad\x6d\x4a\xae\xcd\x95" +
+	"\xc2\xb0\x71\x0a\xfb\xc5\xc9\x0d\x16\x3b\x9d\xcb\x40\x87\x07\x9a\xfa\xf7\x05\x95" +
+	"\x85\x79\xf4\x58\x1e\xa0\x66\x6f\x58\x5f\xf6\x16\x01\xc5\xcc\x81\x7a\xc0\x88\x85" +
+	"\x8d\xa3\x0f\xf8\x91\x36\x21\x97\xb8\xd0\x43\xb3\x5a\x03\x87\x33\x58\xe3\x4b\x6e" +
+	"\x6b\xd2\xa9\x3d\x41\x4c\xd7\x13\x77\xef\xdb\x93\xbc\xbc\x3a\x93\x6b\x3d\x18\xaa" +
+	"\x6d\x00\xe5\xc2\x91\xf8\xe7\x84\x06\x43\x3e\x9c\xe8\x61\xf2\x23\x8e\x71\xa1\x9e" +
+	"\x65\x2f\x44\x89\xd5\xc1\x81\x96\x0b\xf6\x01\xf5\x8a\x04\x56\xc6\xd8\x66\x94\x8b" +
+	"\xd0\xf7\x44\xa7\xae\x9e\xa0\xac\x53\x2a\xd5\x0d\x13\x39\x3a\x82\x65\xcf\xda\xd3" +
+	"\x0f\x16\xc3\x27\xa9\x6a\x4d\xae\xb0\x95\xdc\x9c\x5d\x5a\xe7\x9b\x6d\x81\xa5\x15" +
+	"\x1d\x0d\xb9\xe1\xeb\x90\xed\xc6\x6a\x84\x1e\x7f\xfd\x9a\xb7\x6f\xea\x69\xd2\x82" +
+	"\x23\xad\x16\xfa\xed\x44\xd1\xf0\xab\xf8\xb0\x74\x33\xfd\x40\x11\x0e\xf7\x1f\x91" +
+	"\x91\x24\x73\xf1\xee\xe8\x0d\x4d\x8b\x0a\xab\x13\x26\xf8\x0b\x3d\x91\x88\xc4\xea" +
+	"\xa2\x76\x63\x20\x38\x91\x5a\x5d\x94\x04\x16\x2f\x82\x2e\x01\xba\x03\x2f\x46\xab" +
+	"\x98\x41\xf2\x60\x61\x8b\xfa\xae\x4e\xd4\xea\x84\x88\x89\x99\x70\x64\xf9\x7a\x76" +
+	"\x12\x5e\x35\x38\x47\x56\x24\xc3\x6f\x47\xce\xb1\x7c\xf3\xa3\xc9\xf4\x88\xa1\x23" +
+	"\x0d\xb0\x04\xb2\x6a\x97\x49\x91\xa0\xfc\xa7\x2b\x06\x89\x94\x3a\xcb\x46\x26\xe3" +
+	"\xcb\xc3\x69\xa5\x15\xae\xea\x29\x65\x93\x58\x69\x1d\xf6\x0a\xbb\x15\x61\xa7\x73" +
+	"\xb9\x90\x52\xad\xde\x17\x63\xe4\xf6\x09\x63\x97\x29\x2e\xa7\x24\xb8\x73\xbb\x54" +
+	"\x80\x3c\xc0\xdf\x74\xa1\xe5\x82\x57\xb8\xe5\xbe\x3f\x6d\xb8\x70\xe0\xb9\x62\x47" +
+	"\xc9\x89\x22\x64\xef\x3e\x56\x94\xa0\x9c\xe8\xaf\xc1\x9f\xd2\x00\x3d\x50\x1b\x20" +
+	"\x58\x9e\x5d\x68\x4c\x19\x54\x60\x65\x66\xef\xd4\x12\x85\xe3\xf2\xc0\x88\xc0\x94" +
+	"\x1c\x7a\x58\x0b\x63\xbd\x30\x38\x9e\x3c\x47\x9d\x92\x53\xfd\x4f\x9b\x66\x75\x46" +
+	"\x16\xf7\x76\xa3\x8a\xb1\xb1\x8e\x25\x97\x24\xa1\x96\xd9\xa6\xeb\x4a\xaf\x95\x7b" +
+	"\x47\x16\x27\xee\xd5\x49\xdb\xad\x70\x74\x16\x5a\xfb\xa0\x8b\x03\x56\x5c\xd3\x86" +
+	"\x3a\xbb\x13\x05\xd4\xc5\x13\x71\x33\xc7\x03\xa5\xe5\x03\x7f\xf0\x43\xdc\x53\x63" +
+	"\xa7\xa6\x5d\xc8\x63\x89\x1b\x92\x7e\xb0\x5c\x50\x5b\x38\x03\xb9\x30\x4c\x7f\x4e" +
+	"\xf7\x5d\xd2\x9e\x88\xae\x5f\x08\x99\xf6\xde\xbd\x12\x40\x56\xb1\x3a\x70\xb4\x63" +
+	"\x93\x7d\x92\x56\x97\xe6\x96\x0e\x24\x6b\xd0\x92\x5b\xd2\xfc\xaa\x6d\x80\x1e\xe8" +
+	"\x34\x9e\xc4\xb9\xc3\x6b\x72\x55\x30\x23\x12\xb3\x21\x9a\x89\xb1\x6e\x67\x4d\x1b" +
+	"\xeb\x06\x54\x85\xe8\x12\xc0\x8b\x3a\x70\x77\x8f\xa7\x56\x7f\xe4\x67\x67\x54\x34" +
+	"\xc4\x4f\xbb\x26\x46\x28\x88\x44\x4e\x2f\x39\x1d\x84\x5e\x90\x8d\x2e\x82\xf7\x1b" +
+	"\xb3\x88\xf4\x28\x46\x97\x00\x9e\x22\xd8\x62\x3e\xac\xe6\x1f\x5e\xed\x8f\xab\xce" +
+	"\x03\x76\xc7\x4e\x3d\x5a\xde\x92\xd7\xa0\xc1\x8b\x2d\x39\x8e\x69\x07\x5d\x1c\xb8" +
+	"\x88\x9f\x5a\x4d\xdb\xe3\x2c\x77\x81\xa7\x3f\x9d\xe8\x60\x78\x31\x06\xee\xe1\x09" +
+	"\x60\xe9\xe5\xc7\x3a\x3b\xb1\x1a\xf8\x53\xdf\xfe\x3a\xfd\x89\xb7\x78\x2b\xda\x31" +
+	"\x75\xba\x04\x23\x02\x99\x21\xa5\xed\x42\x69\xb9\xb0\x65\xe2\x9e\x11\xa1\x07\x71" +
+	"\xd1\x97\xa2\xcb\x0c\x65\xda\x5c\x67\x5d\x80\xef\xcc\x00\x6a\x17\xff\x9d\xea\xb4" +
+	"\x8c\x2e\x0e\xd4\xdf\x8b\x85\x88\xbf\x0c\xf1\x26\xb4\x7c\x7b\x3a\x19\x7e\x2f\x08" +
+	"\x66\x47\x9c\x58\x50\x2d\xc5\x83\xd8\xd9\x53\x25\x18\x20\xfd\xfe\x64\xe5\x11\x39" +
+	"\x74\x04\x21\xa7\x04\xd8\x6f\x85\xd8\xa3\x7a\x87\xd0\xbd\x89\x41\xbb\x51\x0b\x47" +
+	"\x01\xce\x89\xe2\xe1\x47\xe0\xf0\x63\x6a\xac\x50\x36\x59\x5e\xfe\x80\xfd\xc2\x9e" +
+	"\x4a\xe2\x88\xe3\x76\xa1\x88\xe9\x46\xca\x3e\xb0\xc7\x0d\x43\x65\xc3\xe3\x0f\x7a" +
+	"\x3c\xcf\x9e\xe7\xbe\x5e\xf0\x83\x6b\x2d\xe2\xf2\xd8\xc0\x31\x18\x4a\x1a\x33\x0d" +
+	"\x46\x54\x84\xec\xed\xea\xc8\x0d\xdd\x64\x38\x2d\xe9\xef\xc4\x12\xba\xfe\xa8\x23" +
+	"\x1f\xfe\xa4\x80\x28\x47\x60\x97\x50\x8c\xd1\xb3\x1d\xf1\x06\x1b\xe8\x8e\x8e\xa0" +
+	"\xc6\x40\x67\x76\xc3\x06\xe6\xa7\x47\xdf\x34\x1f\x7e\x13\xf5\x92\xe8\xfc\xb1\x53" +
+	"\x2b\x52\x23\x63\x29\xc6\xa5\x01\x10\x7d\x65\x7f\x2b\x4c\x8d\x0d\x46\xf3\xfd\xd6" +
+	"\x0f\x3c\x97\x7f\x7d\xe5\xfd\xe9\xb7\x68\x5a\x09\x0d\x40\x9e\xac\x9d\xab\xb7\x35" +
+	"\xa0\x72\xbc\xaa\xca\x81\xf3\xf7\xbd\x26\x27\x7d\x42\xbf\x19\x07\x0d\xfc\x0b\x88" +
+	"\x5f\xd2\xce\xb7\xe1\x9e\x4d\xcf\x2c\xed\xb9\x6b\x54\x32\x2a\x91\x83\xc3\xc2\x69" +
+	"\xaf\xbc\x11\xda\xd8\xe3\x8f\x01\x14\xa7\x5d\xc4\x67\x23\x20\xfa\x73\x59\x01\x6d" +
+	"\xfa\x80\xf8\xef\xcc\x25\x7f\xe9\x50\x43\xfa\xef\xcc\x3b\x81\x79\xe7\xd9\x0c\xb1" +
+	"\x76\x03\xb0\x83\x79\x42\x4a\x99\x3b\xb1\x6d\xa6\x1c\xf8\xf8\x80\x7d\x4a\x6d\xe6" +
+	"\xe7\xe7\xa7\x06\x5a\x06\x53\xc3\x11\xb7\xed\xa7\xc6\x49\x2d\xb9\x0a\xe5\x92\xa8" +
+	"\x84\xee\xce\x94\x5c\x7d\x28\xb9\x9e\xd0\x94\x9a\x9e\x1f\x17\xae\xd9\xb7\x49\xad" +
+	"\xaa\x55\x37\xa2\x35\x8e\x40\x18\x21\x65\xe6\x7d\x0a\xaa\x5b\xd2\x9f\x1c\x88\xea" +
+	"\x69\x9a\x91\xe1\x12\xc0\xce\x66\xf2\x7d\xcd\x14\x5b\x99\x01\xfa\x94\xfa\x6d\xb6" +
+	"\x59\x8a\x83\x25\x90\xde\x0e\x87\x44\x31\x48\xd2\x9d\xdb\xe4\x29\x30\x20\xc8\xe2" +
+	"\xd4\x92\xdc\x3d\xb6\xbe\x6d\x45\xa8\x6f\x01\x04\xb8\x7a\x56\x8d\xab\xf7\xf9\x6d" +
+	"\x2e\xec\x6e\x35\xdf\xbb\x2b\xe4\x0d\x54\xde\x17\x46\x90\x7f\x8d\xad\xc6\x81\xd2" +
+	"\xf2\x81\x3f\x24\xac\x18\xee\xb1\x7f\xff\xd3\x67\x92\x7a\x7a\x88\xbc\x9c\x59\x33" +
+	"\x62\x89\xea\xf8\xe2\x00\x17\xab\x07\xf2\x3c\x08\xd6\xff\xc6\xae\xa2\x74\xd7\x53" +
+	"\x3f\x4e\x7d\x2f\xb2\xde\xc3\x0b\x67\xb4\x38\x47\x83\xc8\xc1\xf1\x12\xb9\x12\x78" +
+	"\x46\xd7\x05\xa7\xc3\xd0\x77\x85\x88\xc9\xe7\xff\x4e\x6e\x5f\x9c\xd2\xd9\xea\xce" +
+	"\xe9\xd4\x00\x5d\xac\x3e\xa5\xd7\x7c\x3b\x55\x64\x07\x4b\x20\x95\xee\xc5\x69\x29" +
+	"\xb9\xe2\x33\xb1\x81\x96\x88\x52\x46\x14\xa1\x1e\xe8\x43\xce\xd3\x71\x28\x89\xed" +
+	"\xc1\x67\x2d\x8f\xf0\x9a\xdd\xd8\xd5\x40\xcb\x60\x7a\xc4\x3e\x28\x73\x07\xfb\x71" +
+	"\xcb\x89\xbe\x93\xe5\xd1\x4e\x89\x00\xa9\xa7\xed\x60\x55\x37\x80\x49\x36\x74\xcc" +
+	"\x48\x2e\xb5\xe2\x76\xfb\xc0\x6f\xfb\xa8\x03\x2d\x34\x2d\x6c\xb0\x99\xab\x87\x72" +
+	"\xcd\xd1\xf4\x72\x93\xdb\x01\x14\xc6\x3e\x7b\x50\x65\xc2\x00\x28\x9d\xe0\xf4\x42" +
+	"\xaf\x44\x5f\xc3\x2f\xee\xb4\x92\x4b\x9c\xaa\x65\xc0\x57\x6c\xc1\x0f\xa4\xbf\xfd" +
+	"\xc5\x26\xbc\x93\xc5\xa9\x49\x6b\xa7\x32\xfd\xce\xb7\xd4\x38\x02\xfc\x01\x17\xe1" +
+	"\xc3\x5c\x97\xe2\x80\xbb\x4b\x39\x5c\x2e\xfc\x8f\x8c\xca\xf0\x3b\xaf\x04\x5f\x4b" +
+	"\x03\x17\xee\xee\x49\x41\x97\x00\x2d\x40\x88\x78\xba\xab\xfc\xae\xf8\xbf\x5c\xd8" +
+	"\x72\xe2\x5a\xf5\xff\x83\xc8\xdf\x38\x75\x6a\x64\x00\x4b\xcf\x11\xb5\x2b\xae\x49" +
+	"\x17\x73\x8b\x0b\x17\xef\x81\x03\xbe\x07\x3c\x2e\xe4\x49\x78\x7f\x16\x68\x2e\x9f" +
+	"\xf8\x53\xc6\x72\x6a\xf4\x86\x46\x8d\xd1\x83\xa4\x00\xfa\x97\x03\x59\x0a\x4f\xbe" +
+	"\x1d\x06\x58\x06\xea\x81\xfc\xe1\x9e\xd8\x7c\xa6\x26\xb9\x53\x40\xe4\x08\xb2\x04" +
+	"\xb5\x14\xf3\xe1\xd3\x89\x5f\x5d\xed\x19\xd4\x2a\xa0\xcc\xd1\x95\xfc\xea\x26\x82" +
+	"\xa0\x4b\x80\xee\xc0\xb3\xf5\x13\x75\x03\x79\x70\x3c\xc9\xb8\x8b\x20\xfd\x62\x93" +
+	"\xc2\x45\x0d\x49\x14\x85\x64\x23\x8b\x53\xd8\x98\xbf\x4e\x41\x17\x07\x96\xa3\x50" +
+	"\xdd\x2e\x14\xf2\x96\xa3\x23\x31\x31\x03\xcb\xbb\xf0\xcb\x17\xb6\x2f\xe2\x81\x0f" +
+	"\x27\xc3\x6f\xc5\x72\xa4\xa8\x8d\x5a\x5c\x06\x1a\x3c\x2f\x03\x23\xcb\x2f\x47\x6c" +
+	"\x4c\xc4\x0b\xf4\x9c\x99\xfb\x88\xc0\x7f\xce\x9a\x37\x19\xbd\x38\x96\x84\x98\x11" +
+	"\x0d\xa8\xd2\x3f\xf3\x17\xeb\x23\x46\x2d\x0a\xc8\xa3\x08\xd6\xed\x03\xf6\xc0\x06" +
+	"\xec\x58\xba\x84\xb9\x1f\x27\xfd\xea\x3c\x89\x08\x02\x9c\x6c\x93\x6b\x4c\xbf\xde" +
+	"\xcf\x51\x04\xf9\xe8\xf7\xeb\x06\xb2\xc5\x54\x64\x09\xd8\x13\x47\x89\xb4\x8c\xf3" +
+	"\x5b\x17\x66\x01\xbf\x36\x63\xf8\xdd\x35\x5b\x10\x49\x01\x4c\x1a\x16\x82\xbb\xae" +
+	"\x2a\x35\x6a\x35\x20\xa4\x0a\xd8\xe7\xa3\xb1\xe0\x07\x4c\x37\x54\x85\xde\x03\x1e" +
+	"\x81\xbc\x12\x06\x56\xa2\xd2\x89\x3b\x1c\x0d\x89\xb1\x80\xff\x8b\x8a\x1a\x81\xfe" +
+	"\xa6\x73\x5a\xce\xc9\xf0\xe7\x43\x9f\x25\x17\xb6\x78\x5e\x5a\x74\xd6\x82\x61\x6d" +
+	"\x6d\x67\x8d\xde\xbe\x26\x07\xee\x17\xf6\xa7\x4e\xe3\x64\xca\x87\x67\x14\xd0\xfc" +
+	"\x23\x89\x50\xb8\xb8\x7c\x9e\xe0\x91\xbd\x14\x32\x05\x97\x7e\xf7\x3c\x3a\x42\x5b" +
+	"\xa1\x82\x63\xc9\x64\x2f\x78\x56\xdb\xdf\xcb\x3c\xfa\xe2\x81\xfb\xf0\x54\x17\x62" +
+	"\x5d\x78\x00\xcb\xa1\x68\x62\x68\x74\x09\xd0\x1d\x78\x21\x4b\xf5\x68\x35\x6e\x30" +
+	"\x76\x4f\xc4\x88\xa6\x22\xa6\x47\xbe\x4a\x1e\xf8\x0a\x88\xf2\x60\x1a\xd3\xa9\xa7" +
+	"\xd4\x52\x1b\x20\xe4\x53\x80\x91\x49\xb4\x28\x14\x89\x47\xba\xf1\x7f\xc1\xd4\x30" +
+	"\xf8\xbb\x57\xfc\xba\x8d\x68\x00\x6b\xf7\x03\x5b\x25\x68\x12\xf9\xeb\xe7\x17\x09" +
+	"\xd5\xb9\xba\x00\xdd\x59\x9e\xbd\xcd\x30\x7f\x39\xdd\xe8\x64\x71\xea\xec\x90\x73" +
+	"\x9d\x42\x41\x6f\x73\xae\xe2\x9c\x7e\x77\xdf\x76\x0f\x34\x78\x1e\x39\x0e\x3f\x5e" +
+	"\xf0\x92\xb0\xc7\x6c\xbe\x3e\x64\x88\xdd\xf2\xf4\x1b\xf7\x0a\xa5\xdf\xff\x30\xd8" +
+	"\xa4\x37\x0c\x63\xd6\x4e\x52\x00\xcb\x84\x10\x52\x3d\xfc\xb4\xc5\x40\x83\xf9\xf2" +
+	"\x04\x46\xf7\x4e\xcf\x21\xc2\x59\x30\x03\xda\xde\x72\x60\x32\x68\xef\x05\xf5\x74" +
+	"\x5d\x03\xef\x03\x5a\x59\xac\xe2\x43\xe9\x2e\xfd\x1e\x7c\xfc\xe0\x5a\x0a\xae\x6c" +
+	"\xe7\xc8\x4b\x1d\xaa\x76\x0e\x55\x58\x3e\x8d\x2e\x26\x86\x58\x39\xb4\x53\xde\xff" +
+	"\xf8\xdd\xe7\x11\x5e\xb3\x6f\xcd\x0c\x8f\x65\x99\xf8\x2e\x34\xea\xf1\x93\xbe\x36" +
+	"\x05\xb0\x20\x14\x28\xc4\xbd\xeb\x14\xb4\xcf\xfa\x77\xfa\x42\x97\xc6\xa9\x31\x1a" +
+	"\x2b\x72\x46\xc5\x58\x70\xf4\x44\xef\xe4\x17\x5a\xa7\x77\x2a\x59\xfc\x92\x17\x09" +
+	"\x75\x16\x8b\x00\x3f\xf9\xd0\xd6\xf5\x5d\x1f\x93\x72\xfb\x74\x9f\xbf\x5a\xbe\x39" +
+	"\xd1\xe4\x29\x20\x35\x61\x58\x52\x67\xa9\xe7\x05\xca\x7b\xba\xcf\xd8\x71\x81\x28" +
+	"\xd2\x2d\xb1\xd8\x28\x70\x98\x8b\x34\x66\xd7\x44\x0a\x6f\x7d\xfa\x80\xcb\x07\xee" +
+	"\x17\xe6\x69\x86\xa7\xb6\xc0\xf9\x92\x51\xce\x2e\xe6\x85\x74\x55\x49\x18\x16\xe5" +
+	"\x50\x67\x60\x7e\xc9\x33\x23\x0f\x6a\x65\xf7\x53\x4f\x86\xe0\x50\x20\x3d\xcf\xa2" +
+	"\xe7\xe1\x13\xff\x6e\x26\x1a\xee\x28\x23\xdd\x59\x68\xc2\x51\xa8\x9d\xba\x74\x6a" +
+	"\x81\xe6\xf2\x8e\x3a\x15\x63\xa3\x32\xb2\xfa\x6d\xa3\xcd\x69\xf7\x00\x4f\x47\x73" +
+	"\xf9\xfb\x9c\xe9\xbb\x44\x2c\x5c\xba\x32\xf8\x3d\xd4\xff\xc0\xdc\x94\x78\x33\xe6" +
+	"\xfb\x60\x3a\x71\x9f\xf3\xf1\xc4\x7f\x3c\xf9\x2e\x09\x60\x11\xc5\x8b\xc8\x1c\x6d" +
+	"\xbe\xa3\x25\x80\xc3\xd3\xf0\x79\x70\x9f\x0b\x17\x7c\xa1\xc0\xc5\x96\x30\x14\x8d" +
+	"\x98\xfb\x5c\x7a\x7a\xd6\x8a\x58\xd1\xf1\x78\xa8\x4d\x91\xee\x73\xb1\xe7\x81\x0c" +
+	"\xbf\x3d\xd0\x4a\xea\xab\x52\x5f\xb9\x4c\xbe\x0d\xc0\x07\xe2\xc0\x7f\x25\x86\x2a" +
+	"\xe2\x7d\xf6\x45\xc9\xfb\x4c\x29\x18\x89\x6e\x41\xf5\xdf\x09\x51\x4e\x4e\x34\x2c" +
+	"\x4e\xdd\x6f\x0f\xcc\x14\xe0\x3e\x6f\x77\x2c\xeb\xdc\x67\x15\x83\xf5\xf0\xfb\xbc" +
+	"\x6d\x2a\x88\x9a\xc8\x76\xcc\xdd\xe3\x81\x54\x00\x78\x9e\xdd\x71\x2d\xcc\x99\x8f" +
+	"\x24\xdb\x4c\xd7\x78\x9f\x51\xc5\xf4\xd4\xad\x7d\x31\x7e\xe2\xa2\x9c\x02\xd3\x1e" +
+	"\xb8\xad\x66\xe5\x15\x64\xb5\xd1\x56\x6e\xb8\x47\xe2\x40\x0f\xde\xa8\xfe\xe1\x7e" +
+	"\xe3\x03\x28\x5d\x68\x19\xd0\xa2\x3a\xd6\x5f\xd3\x6f\xf4\x1d\xe2\x45\x64\xfa\x8d" +
+	"\x3b\xc6\xaf\x4c\x00\xea\xaf\xb7\x78\x2a\x8f\x90\xc4\x3c\xf9\x23\x6d\x4e\xc2\xdf" +
+	"\xcc\x6f\xa9\x7a\xce\x60\x2c\x1e\xc8\x8d\x57\x50\x90\xba\x38\xbc\x8e\x54\x76\x4b" +
+	"\xf7\x48\xf6\x3f\xd8\x0b\xcd\x93\x1a\xfb\x99\x9a\xb7\x20\x6e\x1a\x48\x41\x15\x26" +
+	"\x49\xb5\x88\xf3\x96\xc8\x11\x13\x3a\x61\x40\xc7\xcd\xe7\x88\x48\x64\x9b\xa7\xbb" +
+	"\x36\x45\xe4\xfa\x83\x1a\x7a\x1b\x52\x8e\x2f\xbb\xc5\x57\x0c\x36\x9c\x03\xf4\x40" +
+	"\x56\x8d\xda\x54\x81\x9c\xcd\xc9\x48\x51\xcb\xcd\xc6\xb3\x04\x7e\xa7\x7b\x9a\x9b" +
+	"\x9e\x0f\x05\x1f\xbd\x49\x9c\xfb\x62\x05\xf0\x8e\xea\x8c\x6f\x91\x1b\x26\x15\x34" +
+	"\x67\xcc\x55\x59\x00\x8a\x73\xc8\x51\x33\x48\xa3\x92\x0c\x59\xb8\xb9\xea\x48\xcc" +
+	"\xd6\xea\x12\x20\x42\x2c\x41\xac\x11\x94\xa0\x7a\xa2\xeb\xc2\xb0\x7b\x22\x76\xd2" +
+	"\xe3\xa4\xb4\xa0\x0f\x88\x1f\x65\x89\x7b\x4a\xde\x69\xb1\xf5\x78\x4f\x2c\xbe\xcb" +
+	"\x55\x39\x38\x7e\x05\x29\xf5\xc7\x49\x0f\xca\xf1\xd6\x7b\xe2\x1a\x66\xb9\xef\xe9" +
+	"\x9e\x56\x75\x3c\x22\x88\xa1\x39\xab\xe8\x02\x7a\xd1\xd9\xec\xb5\xb2\x09\x1c\x03" +
+	"\x20\xaf\x19\xb1\x88\xf9\xec\xb9\x78\x51\x52\xab\x66\x3c\xc6\x90\x52\x6a\xd6\x19" +
+	"\x26\x6c\x18\xde\xf9\x94\x52\xde\x50\xcb\x49\xc8\xef\x7a\x0e\x9f\x9e\xb7\xb1\x24" +
+	"\x23\x4a\xcb\x61\x11\x8c\xf3\x75\x01\xba\xa3\xe4\xd4\x5b\x7a\x52\xd3\x4f\xe8\xa4" +
+	"\xdf\x93\xe9\xa4\x8b\x5a\x19\x0f\xc6\xc2\x74\x52\xd2\x73\x91\x20\xba\xe9\x77\xd6" +
+	"\x76\x98\xa2\x04\xa0\x17\x49\xec\xd4\xba\x4d\x24\x23\x69\xba\xe7\x99\xf3\x69\x77" +
+	"\x2c\xeb\x98\xdb\x21\xfc\xe5\xbe\xf6\xe3\x94\xa4\x41\x76\x1d\xc8\x3d\x63\x5d\x73" +
+	"\xe2\x5e\x35\x6b\x6c\x79\x79\xf0\xd2\x88\xa8\x60\x19\x55\x98\xc5\xa8\x33\xd4\x69" +
+	"\x65\xee\x1e\xa6\x34\x99\x53\x64\xf7\xcc\x82\xe2\x74\x47\xf9\x92\xfd\x08\x21\x3d" +
+	"\xb5\xa8\x18\xec\x30\xb5\xa0\x84\xd8\x8d\x6d\xaf\xf4\x01\x95\x20\x57\xb7\x2d\x4e" +
+	"\x5d\x4e\x6d\x88\x9b\xdc\xac\x08\xc5\x4e\x26\xde\xf9\xd2\xc4\x41\x9a\x3d\xa0\xbb" +
+	"\x8f\xa2\x31\x88\x66\xd7\xf0\xbb\xe7\xa2\x8e\x34\x63\x13\x54\xe1\xeb\x3a\x63\x41" +
+	"\x70\x20\xc5\xb5\xf2\xc5\xd8\x93\xc7\xd8\x23\x64\x12\x3c\x48\x39\x9e\x14\x73\xe3" +
+	"\xa7\xe6\x0a\x61\xe6\x15\xa0\x3b\x52\x85\x02\xfe\x86\x10\xaa\xc2\x0a\x33\x11\x66" +
+	"\x7a\x4e\x97\x00\x11\x62\x9f\x01\x8e\x55\xb0\xcd\xf7\x04\xef\xb2\x28\x09\x63\xf1" +
+	"\xa7\xd3\x68\x64\xc7\x01\xf0\x48\x58\xd5\xc1\x00\xad\x2c\x50\x5f\xba\x67\xdf\x15" +
+	"\x36\xb0\x69\xfe\x31\x60\x77\xbc\x37\x4e\x9c\x7e\xe0\x08\x38\x50\x82\x75\xab\x58" +
+	"\x77\xb4\x8e\xef\x99\x8c\xd0\xdc\x5d\x83\x8a\xa9\xc2\xb4\x74\xfd\xe3\x03\x5b\x30" +
+	"\x7a\xea\xa2\xc9\xa8\x0e\x58\x19\x42\xcf\x40\xc8\x24\x7f\x6a\xfb\x16\xb2\xc7\xb6" +
+	"\x7c\x50\x89\x85\xa8\xad\xb4\x95\x47\x14\x90\x60\x3f\x9e\x33\xaf\x7b\x6e\x52\x7d" +
+	"\xd4\x75\x7c\x3c\xa5\xce\xe0\x88\xa0\x00\x1f\x28\x05\xb2\xa6\x4a\x28\x89\x6a\xde" +
+	"\x7b\xf7\x65\x74\xa8\x98\x54\x8a\xfd\xb7\x3e\xeb\xb5\xcb\xac\x01\xbf\xde\x47\xe4" +
+	"\x5f\x3a\xd5\xa0\x84\x70\x92\xe2\x5e\xe6\x87\x1c\x16\xc7\xa1\x5d\x3e\x9d\xee\xbf" +
+	"\x17\xb7\xb7\x01\x68\xf0\xbf\xe5\x24\x73\x25\xf9\xad\x24\x18\x16\x68\xf7\x4a\x82" +
+	"\xb9\x3f\xc4\x63\x6e\x8a\xb4\x69\xee\x55\xe6\x8d\x3a\x2b\xcc\x51\x0a\x74\x97\xc3" +
+	"\x4e\x85\xa8\x47\xe1\xbf\x2c\x9a\x79\xe0\x2a\x4c\x25\x76\x5b\x81\x77\x66\xff\x30" +
+	"\xfb\xd3\xba\xfa\x40\x12\xb1\xda\xf4\x0f\x04\xf9\x47\x1c\xed\x24\x5b\x98\x8a\xdd" +
+	"\x55\x34\xfd\x17\x45\x23\x51\x99\x0f\xcd\x16\x8c\x5a\x3a\x18\x5d\x0d\x7a\x71\x3c" +
+	"\x4d\xa0\x62\x73\x4c\xf6\x5e\x18\x79\x9d\x98\xa8\x0d\xba\xc5\x47\xdd\x32\xff\xc8" +
+	"\xb1\xb0\x1f\xfb\x84\x70\x60\x85\xf8\xa1\x10\x69\x96\x23\x88\x24\xa3\x5b\xb1\x33" +
+	"\xde\x78\xc4\xa7\x23\x2c\xc9\xfb\x41\x80\xb8\xaa\x96\x64\x4f\x97\x7e\xc1\xbf\x63" +
+	"\xa8\x16\xa6\x47\x28\x59\x75\x86\x65\xf0\xc5\x69\x0b\x7a\x71\x2c\xe1\x7c\xfb\x76" +
+	"\xa0\x4a\xf0\xfa\x83\x90\x5f\x5e\x3b\x09\x67\x4c\x3c\x1b\xb1\xf8\x16\x83\xff\x36" +
+	"\xfb\x7f\xeb\xca\xea\x00\x45\x6c\x14\x3f\xc7\xd9\x3a\x41\x8b\xda\xfc\x89\xea\x8c" +
+	"\x44\xb5\x29\x62\xa9\x3e\x3f\x04\x10\x9f\xe3\x3f\x72\x2d\xd4\xfe\x79\x4d\xba\x4a" +
+	"\xd5\x86\x9d\x7c\x1e\xa5\x2e\x6e\x67\x4d\xd8\x24\x9b\x5b\x35\x16\x76\x79\x7f\xe4" +
+	"\x4a\xb9\xab\x97\xbb\xee\x3b\x4f\x58\x1b\x7f\x89\xce\x15\x41\xd4\x9f\x96\xaa\x7f" +
+	"\xaf\x1e\xb9\x14\x85\x72\xeb\x25\x95\x84\x65\x11\xb9\x1a\x1b\x1d\x58\x6a\x82\x9e" +
+	"\x0d\x9f\x1b\x76\x10\xff\x5e\x5c\xaf\xc9\x80\x87\xff\x04\x25\x97\x93\x1f\x77\x33" +
+	"\x1d\x69\x20\x0b\x77\x5c\xb7\x4f\xac\x28\x1a\x85\x8a\x2b\x14\xde\x0b\xa6\xa9\xe5" +
+	"\xf2\x40\x67\x26\xb7\x93\x8f\xe6\x7b\x41\xb5\xfe\x5e\x4e\x4b\x4e\xaf\xeb\x59\xc3" +
+	"\xbe\xfc\xbd\xc8\x8a\xf2\xbd\x9c\x7a\x98\xd3\x9f\xc4\x4d\x70\x03\xe2\xb5\x60\x65" +
+	"\x1a\xd7\x5e\x97\x73\xbc\x2e\xe7\xf5\xba\x9c\x34\x49\x2c\x04\xc9\xed\xd3\xbd\x52" +
+	"\xa1\x75\x5e\xe5\xe8\x79\x2a\x4a\x64\xf7\x7a\x63\xc6\x81\x4b\xbd\x89\xba\xdf\x84" +
+	"\xd2\x74\xaf\xbc\xdd\x15\xcf\xe3\xf1\x9e\xee\x95\xf1\xa2\xda\x70\x5b\x51\x90\x75" +
+	"\x82\x50\xc9\xec\x6b\xde\x6b\xf9\xc6\xf3\xcd\x4a\x99\x80\xc2\x54\xe5\x03\x18\x8b" +
+	"\xf4\xe8\xa8\xeb\xb6\x38\xb6\x4e\x95\xcb\x4c\xef\xba\x84\xf4\xce\x81\x0a\x1c\xe5" +
+	"\x57\xd9\xbb\x0c\xaa\x18\xe2\x78\xa4\x5a\x48\xb5\x62\xc3\x41\x54\x2b\x1d\x01\x54" +
+	"\x50\x59\xab\x81\x63\x4f\x57\x0f\x0c\xb8\x0d\x60\xb1\x7d\xe8\x0b\x64\x4c\x4e\x53" +
+	"\x19\xfa\xc1\x8e\xc3\xbd\x4a\xe3\x01\x42\x21\xda\x97\x96\x2c\x6a\xfb\xe2\xdf\x16" +
+	"\xc1\xd8\x1c\x01\xfa\xe3\xed\x90\x1d\xea\x48\xf6\x29\xe3\x40\x0f\xa0\x63\x77\x04" +
+	"\xe5\x2f\xf7\x5b\xd9\x54\x75\x3e\xac\xd6\x18\x56\x6b\x4b\x37\xcd\xff\x1d\x84\x28" +
+	"\x4c\xe5\x93\xee\x31\xe3\xaf\x2d\xe9\x4e\x57\x71\x1f\x35\x24\x9f\x9c\xd1\x01\x68" +
+	"\x7e\x6e\x34\xb5\x0b\x59\x4c\x8d\x8e\xb5\xa5\xb5\x7a\x66\x5b\xe7\x3b\xb6\xb6\xd4" +
+	"\xce\x2d\xe4\x3b\xdd\x25\xed\x37\x25\xfb\xc0\x31\x6c\x31\x6d\x79\x48\xe8\xa0\x59" +
+	"\xd7\xc6\xdc\x55\xf4\x87\x33\x09\x56\x08\x59\xea\xbf\x57\xbb\x9e\xc5\xa9\xd5\xd4" +
+	"\x03\xd5\xaa\x7b\x45\xa1\x49\xae\x31\x35\x06\xe0\x3d\xbc\x1e\x1f\xfe\x5c\x8f\xec" +
+	"\xe2\x28\xa1\x0f\x60\xcd\x42\xcc\x00\x2e\xaf\x1e\xb9\xb6\x52\x9b\x8a\xcb\x71\x79" +
+	"\x23\xea\xc1\x85\x8a\x83\xc3\x89\xfa\xcf\x40\x3d\xa0\x65\xbb\xaa\xb7\x14\xb5\x16" +
+	"\x26\x53\x19\x22\xcd\x89\x27\x97\x97\xa8\xab\xd5\x8b\xb2\x16\x2f\x26\xc6\xe1\x83" +
+	"\x0e\xc6\x91\x2e\xb4\x0c\xe8\x65\xe9\x5a\x6d\x31\x2a\x86\xca\x6a\x51\x98\x6d\xe3" +
+	"\xde\x6a\x64\x74\x3c\x5b\xfc\x29\xf6\x2d\xc2\xd2\x66\x72\xb9\xeb\x34\xeb\x3f\x3e" +
+	"\x97\xb2\x75\x19\x02\x8e\xd3\x5a\xfa\xb1\x8d\xb4\x9a\x97\xff\xf0\xd5\x62\x62\x58" +
+	"\xbc\xd3\xd6\x20\x02\x59\x15\x1c\x1c\x13\x72\x2a\xb1\x53\x2d\x9c\x43\x4d\x77\x4e" +
+	"\x29\xea\xbc\xba\x23\x2f\x39\x4c\x42\x7b\x57\xe7\x03\x91\x57\x3d\x60\x8d\x8e\x51" +
+	"\xc0\x62\xa8\x71\x9c\xd6\x47\x9d\x9b\x5b\x4b\xbd\xe0\x5c\x3e\x3c\xa4\xc4\x88\xe2" +
+	"\xb2\x4a\x52\xd4\x03\x3c\x45\x74\xc1\xb6\x01\x14\xc6\x8c\x43\xb7\xf0\xd8\xc7\x4e" +
+	"\xfd\x21\xd9\x5f\xd5\x80\xfa\x5e\x5f\x0d\x9e\xcb\x80\xdd\x90\xa6\x87\x0e\x96\x81" +
+	"\x14\xf8\xd0\xd7\x4c\x9b\x1f\x3e\x99\x68\xf3\xc3\xbe\x72\x1c\xf8\xa3\xb8\x2f\x2d" +
+	"\x03\x5a\x6c\xe9\x5d\x05\x3a\x00\xd4\x18\x2e\xed\xba\x61\xda\x5d\xae\xe2\x69\xfe" +
+	"\x88\x8f\x19\xf6\x85\x8c\xa9\xc7\x26\xb9\x27\x1b\x3f\x03\x29\x90\xa1\xab\xcd\x1b" +
+	"\xe6\xd6\xf1\x69\x8d\xcb\xa8\x7d\xae\xa0\x02\x81\xb2\x47\x20\x7f\x12\xbe\x6b\xdb" +
+	"\x7c\xd2\x23\x42\xce\xe2\x0f\xa6\xb3\x71\xf7\x26\x0b\x0a\x77\x59\x46\xbf\xfb\x3d" +
+	"\x34\xf7\xb8\x87\x46\x80\x78\x89\xfb\x7f\x04\x4c\x38\x71\xc1\xba\x53\x63\xbb\x78" +
+	"\x2a\x5e\x15\x49\x1f\x44\xb2\x82\x0b\x5b\x4b\x6e\x2d\x25\xbe\xc6\x44\x9a\x53\x8b" +
+	"\xfd\xd7\xa3\x5b\xeb\x6e\x69\xfb\xab\xa6\x0f\x50\x3c\x1d\x25\xd0\xdb\xe3\x78\x70" +
+	"\x8f\x01\x2c\x42\x9c\x30\xb8\x33\xc2\x57\x38\x36\x64\x41\xd5\xbf\x0a\x24\x07\x36" +
+	"\x84\x81\x7e\xa8\x3c\xa0\x12\xd4\xd7\xc5\x74\x6f\xf1\xfd\xde\xf8\x28\xc7\xaf\x4c" +
+	"\x72\xda\x16\x9b\x31\xb2\xe1\xc7\x39\x35\x4b\x46\x9e\xb7\x03\xfb\xc4\x18\xd0\xc2" +
+	"\xe5\xda\x33\xba\x62\x68\x00\x4b\x78\xa8\x85\x7e\x60\x95\xde\xcd\xea\x0a\x31\xda" +
+	"\x31\xe3\x48\x4e\x94\xe8\xab\xb2\x92\x3f\xdd\x1b\x73\x86\x56\xf9\xf0\x66\x1c\x52" +
+	"\x6b\xc0\xf2\x8d\x4a\x6b\xc0\x83\x44\x18\x68\x1a\xaf\x30\x69\x56\x0e\xf9\xdd\x5b" +
+	"\xe5\xb5\xe2\x70\xa4\xb9\x2a\x99\x1f\x95\xbc\x37\x8e\xdd\xca\xa5\x56\xce\x87\xd2" +
+	"\x3f\xb3\x97\xed\xcc\x5e\x5d\x27\x0a\x8b\xaa\x93\xd3\x2d\xd0\x0e\xb4\x0c\xa6\x55" +
+	"\xb1\xe3\xba\x7d\x62\x62\x52\x14\x29\xb5\xdf\x4f\xa6\xc3\x76\xa0\xf2\xee\x07\x2a" +
+	"\xef\xa7\xfe\x10\x0e\x51\xde\xcf\xa4\x2e\xe7\xd4\x4a\x98\xbc\x7d\xba\x9f\x0f\xd9" +
+	"\x82\x1e\x00\x56\xb9\xf3\x5a\x8a\x0c\xaa\x98\xc5\x57\x2b\x02\xda\xbb\x7f\xda\xf4" +
+	"\x05\x42\x35\xd9\xea\x3b\x37\xc1\x24\x27\x44\xf5\x8b\x61\xee\x5c\xe2\x86\x43\x3e" +
+	"\xd6\xf5\xc7\x15\xe7\x01\xe6\xf2\x01\xf5\x6e\xba\xef\x53\x9a\xe8\x4c\xcd\xe8\x33" +
+	"\x7d\xfb\xf8\x03\x6b\x62\xfe\x8f\xb7\x7f\xfa\xcb\xfb\xd3\x67\x41\x7a\x3e\xef\x4e" +
+	"\xcf\x6d\x69\x73\x46\x29\x30\x60\xb0\x25\x6b\x2b\x90\x46\xc5\x78\x64\xb9\x34\xab" +
+	"\x93\xce\xe7\xdc\xf8\x1c\x66\x2a\xa2\x47\xd9\xf4\x0a\x9c\x1b\x56\x7c\x55\xae\x8d" +
+	"\x50\x58\x66\xe2\xd9\xa9\x4a\xd5\x36\xad\x33\x9c\x0d\x03\x6c\x41\x55\x36\x71\x6c" +
+	"\x2d\x05\xae\xf5\xd2\x67\x6b\xf6\xe9\x60\xb3\x73\xf5\xf9\xa7\x2f\xb7\x1b\x5d\x35" +
+	"\xcb\x3d\x63\xe9\x9d\x1b\xd8\x98\x14\x9c\x12\xa7\xeb\xb4\xb9\xf5\xd9\x53\xd1\x9c" +
+	"\xd1\xa6\xd8\xd2\x55\x81\x90\x1e\xa7\x79\xee\xa7\xad\x3c\x9e\xb1\xf0\x78\xfa\x98" +
+	"\x00\x45\x17\xe2\x36\xdd\x4f\x16\x12\x1e\x33\xcb\x72\x4e\xfa\xf4\x98\x1f\xfc\x58" +
+	"\x55\x78\xb0\xa8\xf0\x98\x33\x8a\xbc\x8f\x39\x17\xb9\x9b\x1c\x85\xb2\x7a\x8a\x30" +
+	"\xc3\xd7\x63\xce\xc7\xc4\x4b\xf3\x98\xcb\x0c\x98\x7f\xe9\x14\x04\x88\x57\x12\x8e" +
+	"\x82\x0b\x23\x09\xf4\xab\xcc\x0b\x13\x7e\x30\xda\x06\xdd\x91\xe4\x59\xc0\x35\x62" +
+	"\xec\xba\x3b\x51\xd6\xf8\x2d\xf3\x42\x30\x6d\xd6\xc9\xe2\xb4\x05\xf5\x70\x93\x66" +
+	"\x99\xe7\x61\x83\xd1\x63\x5e\x57\x4a\xba\x9e\x94\x46\xa1\xfc\x57\x40\x06\xfe\x01" +
+	"\x60\x91\xb7\xe7\xb7\xe3\xd7\x6c\xdd\x88\xbc\x5f\xee\x5f\x38\x54\x30\x40\x77\x64" +
+	"\x11\x9b\xf6\x56\xa0\xf9\x46\x00\x6d\x39\xa8\x24\x69\x2e\xd0\x96\xb9\xc4\xeb\x31" +
+	"\x77\x74\x50\x45\x09\x56\x18\xf7\xb3\x6f\xe4\xd4\x29\x51\xe7\x53\x17\x62\x19\x74" +
+	"\x15\x0d\x9d\x5d\xd2\xa7\xe3\xc3\xed\x72\x19\xa5\x1e\x9a\xca\x3b\xb1\x50\x16\xa9" +
+	"\xb6\xc7\x85\xba\x43\x28\x06\xc6\xe5\x22\x79\xda\x83\xb2\x58\xf2\x98\xcf\xbf\x84" +
+	"\x90\xe0\x1b\xa6\x7c\xac\x41\x02\x8d\x81\x4e\xe5\x83\x0b\xe1\x1e\xd3\x83\xef\x1b" +
+	"\x1c\x04\xd8\x8a\x98\x1e\x29\x7d\xe3\xf4\x34\x3d\x52\x99\x1e\x69\xe5\xd7\xa7\x07" +
+	"\xdb\xf6\xcd\x89\xbc\x08\x6c\x09\xc3\x99\x6f\xa1\x36\x3b\x19\x0b\xe8\xff\x7a\xfb" +
+	"\x15\x9c\x3e\xe0\x12\xb8\x44\x32\x25\x24\x8f\x90\x3b\x86\x94\xaa\xcc\xed\xe3\x6f" +
+	"\x8f\x01\x47\x66\xde\xe3\x5d\x9e\x21\x5e\xdb\x85\x9c\xc9\x5f\x8d\xa0\xcf\x64\xc4" +
+	"\xc3\x00\x53\xc0\xd3\xe5\xac\x37\x81\x67\x0c\xae\x63\x83\x1e\x9e\x40\x4c\x85\x1c" +
+	"\x2b\x90\x3d\x6b\xc8\x71\x51\xe3\x9f\x41\xec\x49\xea\x0d\x63\x14\x8f\xb4\x35\x77" +
+	"\xc5\xc5\x8e\x97\x11\x26\x0f\x8e\x52\x20\x4d\x1f\x0c\xea\xa1\x98\x78\x40\x9a\xc8" +
+	"\xc9\xca\xb9\x53\x0b\x50\x96\x61\xd5\xfd\x03\xce\xe5\x1f\x8f\x84\x4d\x4c\x28\x4e" +
+	"\x53\x1b\x14\x6b\xc5\xf2\xed\x2d\x10\xac\x46\xfb\xd0\x02\xab\x91\xee\x5e\x0b\xc3" +
+	"\x87\xb9\x9e\x14\x74\x09\x10\x21\xd6\x0a\x0f\x6d\x8e\x3e\x9e\xb3\x96\x70\x1f\xcf" +
+	"\x74\x1c\x75\x7a\x3c\xf9\x20\x95\x2b\x66\xe5\xb3\xf7\x21\xdb\xb7\x72\xf1\xdc\x0f" +
+	"\x39\xb4\x0f\x19\x7d\x78\xe4\xc7\xf4\xc8\xe8\x50\xf0\xa4\xf9\xc1\x01\x7b\x23\x8b" +
+	"\xd3\x6e\x54\xf9\x66\xba\x94\xac\xdd\x8e\x47\xc6\x8a\xcc\x23\xaf\x2b\xeb\x78\x8f" +
+	"\xbc\x4d\x7c\x6d\xd2\x5f\x88\x50\x80\xdc\xe6\xfb\x9d\xe4\x5a\x61\x52\xb5\x2d\x1f" +
+	"\x90\xa8\x8d\xd5\x89\xe9\xc1\x68\xf9\xc8\xaf\x64\x8f\x84\x0d\xa0\xe9\x51\x38\x58" +
+	"\x5a\x8c\x86\x5f\xaf\x51\xd1\xdd\x3d\x8f\x32\xaf\xd5\xda\x1b\xe8\x94\x04\x13\x5a" +
+	"\x23\x8b\xd3\x6e\x54\x45\xb7\xfe\x0a\x57\x5c\x5a\x8f\xad\xea\x3e\x6c\x55\xf7\xe1" +
+	"\xab\xba\x4e\x2d\x13\x86\x9e\x47\xe1\xba\x21\xb9\x08\x63\xff\x44\x6e\xf8\x2c\x12" +
+	"\xea\xd6\x72\x8d\x4d\x84\xa4\xe5\x0c\xa7\x4a\x8f\x06\x59\xd4\x7c\x71\x11\xe4\xb4" +
+	"\xa8\x88\xa5\x82\x71\x53\x9e\x25\xaf\x9c\xcf\x75\xba\x04\xa0\xe0\xac\xbe\xc8\xf5" +
+	"\x08\xec\x27\x07\x75\x16\x0b\x5d\x41\x8d\xc5\x2a\xe6\xa3\xd4\x2f\xda\xaf\xc8\xe8" +
+	"\x70\xe4\x53\xd9\xea\x17\x19\xd6\x2f\x55\x05\x77\xa3\x3e\x4a\xad\xab\x42\x6c\x30" +
+	"\x63\xc1\x51\x5f\xba\xe2\x58\xdd\xa3\xe8\xf1\xd0\x0a\xa4\xdc\x39\xbc\x22\x2f\x25" +
+	"\xc8\xd9\x2f\x11\xc9\xff\xc8\x31\xd6\x8f\x17\xee\x87\x90\xf3\x86\x56\xd6\xa3\x9c" +
+	"\xe6\x20\x70\x52\x06\x86\xb3\x6d\xfe\x91\x23\xf9\x3a\x97\x6f\x6e\x86\x69\xd3\xa3" +
+	"\x7e\x69\xa0\xac\x0b\x57\x71\x4d\x8f\xba\x60\x4a\x3c\x28\x45\xae\xcb\xdd\x47\x89" +
+	"\xba\xe0\xf5\xe6\xcc\x8a\xdc\x36\x3d\x6a\x59\xd8\xb8\x00\xdc\xe5\x48\xb0\xc8\x48" +
+	"\xef\xa3\x2a\xaf\x6d\x9b\xa7\x47\xad\xfc\x96\x2f\x0c\x5b\x00\xac\x37\xd1\x1a\x2e" +
+	"\xde\x1f\x66\xa9\x8f\x5a\xef\x6f\x98\x7a\x7f\xaa\x9e\x85\x95\x18\x73\x55\x59\x15" +
+	"\x3b\x2a\x24\x47\x25\x55\xe5\x8b\x45\x3e\x27\x08\x60\xc4\xcd\x89\x3d\x2a\x9a\xfe" +
+	"\xd3\x83\x8b\x85\xa7\x47\x3d\xdb\xca\xc4\xa6\xbe\xb0\x10\x61\x84\xb6\xee\x90\x2a" +
+	"\x35\xa0\xc2\xbd\xdc\x54\xa8\x05\xfa\x0c\xd5\x3c\xb5\x0d\x10\x92\xd0\x1f\x12\xc5" +
+	"\xe0\xcf\x83\x2b\x68\x71\xb0\x93\x22\xaa\x84\x74\x27\xed\x83\x73\xd0\xe6\x2a\x4c" +
+	"\x0b\x75\x03\xd0\x8e\xb8\x49\x43\x8f\xeb\xc0\x78\x34\x79\x5c\x45\x52\x93\xc7\x27" +
+	"\x29\xd3\x0b\x8d\x8b\x59\xa0\x68\x0d\x39\xf5\xd8\x5a\x6f\x0e\xb0\x0c\x56\x0f\x74" +
+	"\xc5\x64\x04\x83\x7b\xbf\xe7\x23\x0b\x28\xed\xac\x14\xe9\xa3\xb0\x0a\xa4\x78\xab" +
+	"\x9c\x95\xe1\x5c\x54\x63\x92\xb0\x82\xe9\x2c\x38\x52\x73\x7b\xe6\xf2\x09\x1b\xaf" +
+	"\x1b\x01\x8b\x3e\xcc\xed\x51\xb6\x85\x03\x5b\x12\x8a\x15\x46\x79\xb2\xda\x01\x88" +
+	"\xb9\x99\x68\xfd\x08\xde\x9d\xb9\xcf\x18\x8b\x76\xa6\x66\x4b\x88\x62\x7f\xcf\x72" +
+	"\x46\x19\x02\xba\xa9\x36\xe8\x00\x58\x4e\xb0\x7f\xc7\x3b\x02\x80\x12\xd8\x93\xbe" +
+	"\xea\x0c\x62\xfe\x4c\xe8\x39\xf9\xa0\x15\xd4\xaa\x18\x64\xb1\x9e\x46\xb0\x1f\x6b" +
+	"\xd4\x53\x65\x66\xd6\x7c\x6a\xd6\x62\x6e\xc6\x11\x7c\xde\x05\x68\xf1\x42\xf6\xde" +
+	"\x6a\x3d\x9c\xa9\x72\x5a\xa1\xbd\x98\x47\x34\x94\xe3\x6a\x28\xd7\x3a\x5a\xf8\x5c" +
+	"\xd6\x33\xf1\xe5\x34\xd0\x99\x0f\x6f\x54\x74\x2a\x1c\x28\x2d\x4e\x94\xd2\x2b\x79" +
+	"\x98\x6e\x6e\x86\xe1\xea\xae\xf6\x98\xba\x5d\x17\xae\x84\xdf\x7a\xe7\xb9\xf8\x17" +
+	"\x9f\xe6\x53\xcd\x27\x77\xba\x38\xcb\xc8\x5b\x44\x3d\x29\x97\xef\xf2\xf1\x22\x46" +
+	"\xf1\x00\x7b\xcf\x58\x59\x31\x57\x6c\xe6\x3e\x0e\x4c\x19\x49\xd0\xf2\x4b\xd8\xbe" +
+	"\x82\x28\x21\xdd\xe2\x21\xea\x19\x6b\x0b\xcd\x05\x44\x36\x16\xcd\x9d\xc2\xc8\x94" +
+	"\x33\xa7\xbb\xdc\x97\xbd\xf9\x81\x14\x9e\x5e\xce\x51\xca\x39\xbd\x2c\x65\xf5\x4e" +
+	"\x6e\xd3\xe9\xd1\x32\xcd\x3e\xaf\xf6\x16\x8b\x4a\x78\x35\xaf\x1e\x4e\x6f\x49\xd6" +
+	"\xbf\x6a\xbb\xe7\x02\xac\x49\x8b\x06\x43\x79\xd2\x3a\x6c\x6f\xed\x11\x86\xa0\x1e" +
+	"\x32\x04\xf5\xe0\x2e\x51\x1c\xd5\x7c\x65\x70\x6c\x75\xb6\x44\xea\x6c\x5f\x5a\x30" +
+	"\x90\xac\xdc\x8d\x68\x44\x03\x8d\x90\xa2\xa9\x28\x1a\x8b\x5a\xad\x2c\xcb\x89\x7a" +
+	"\x64\x6c\xa0\x19\x51\x9c\x5d\x3e\x2b\x8a\x06\x24\xce\x18\xeb\x81\xea\x91\x3a\x77" +
+	"\xef\x3e\x74\xc7\x2d\x0c\x16\x30\x17\x07\x9e\xda\xa9\x79\x81\x82\x58\xe5\x53\xa0" +
+	"\x27\x76\xfa\x73\x19\x90\x94\x65\xed\x39\xb3\x71\x63\xc4\xbc\xd4\x77\xfd\xb1\xfa" +
+	"\xae\x3f\xfe\x26\xd5\x9f\xed\xa4\xb6\xea\xcf\x41\x6b\xd0\xb2\x88\x88\xcf\x35\xda" +
+	"\x99\xa4\xd6\xf4\x68\x6c\x90\x3d\xfc\x6a\x5b\xa7\x8b\x33\x2c\xa9\x73\xdd\x15\x41" +
+	"\x1d\xc1\x69\x1d\xc1\x39\xd3\x03\xa4\x74\xa1\x0f\x66\x17\x34\xc6\xe2\x01\xba\x12" +
+	"\x34\x80\x0b\x28\x79\x58\xf8\x53\xf3\xf1\x2a\x90\x98\xbd\x5b\xed\x9c\x59\x97\x59" +
+	"\x0a\x90\x69\x5e\xd2\x57\xad\xdf\x86\xc8\x41\xda\x32\x9b\x07\x69\xe5\xcd\x20\xa9" +
+	"\xf8\x6c\xe7\x54\xcf\x7a\xe6\x72\xc0\xc3\x04\xfe\x83\xa9\x38\x59\xb2\xa8\xfa\xe0" +
+	"\x04\x66\x73\xa2\x39\xa2\x10\x09\x94\x3b\x4e\x91\x23\xbd\x2f\x80\x02\x76\x39\x7a" +
+	"\xc6\x95\x1f\xcc\x0d\x35\x09\xa8\x26\xdd\x27\x67\x5c\xf1\xa9\x31\x42\xc8\x63\x93" +
+	"\x3d\x70\x03\x3f\x1a\xa0\xce\xad\x3f\xeb\x11\x94\x74\x9a\x4f\x82\xcf\x76\x4e\x0f" +
+	"\xf6\x21\x71\x94\x88\xed\x42\x3e\x38\x4f\x82\x83\x30\x88\xee\x50\xdb\x8e\xd3\xe3" +
+	"\xe4\xe1\xde\x7d\x7a\xbc\x57\x7e\xdb\xdc\x39\x7e\x6a\xe8\x08\xaa\xce\xf8\xbd\xf6" +
+	"\xe9\x39\x73\xce\x44\xee\x3c\xa8\xb3\xd5\xaf\x19\x74\x0e\xe3\xe5\x13\x05\x04\x9c" +
+	"\xb4\x18\x69\x4e\x90\xb1\xa5\xb8\x27\xba\x2c\x38\x12\xc9\xed\x76\x92\xb4\x4e\x25" +
+	"\x40\x10\xe4\x48\xc2\x73\x2e\x77\x26\xe2\xcf\xb9\x94\x75\x6e\xa4\xea\x86\xda\x01" +
+	"\x48\x95\x72\x0e\xf5\xc8\x7f\x7c\x16\x18\x91\x65\x51\xf1\x39\x97\x0a\xf7\x90\x63" +
+	"\x89\xd0\xd3\x41\x79\x7d\x9e\xf3\x3a\x3d\xe7\xf5\xeb\x64\xf7\xea\x42\x0a\x28\x89" +
+	"\x58\x9a\x11\x1b\x51\x74\x80\x25\x00\x92\xe0\x5a\xf5\xf0\x18\x1a\x71\x62\x92\x5a" +
+	"\xf8\x40\xd7\xe5\x6b\x46\x7e\x5b\xd4\x44\x01\xdc\x8d\x2c\x19\x83\xa4\xa2\x2e\x01" +
+	"\xc2\x40\x05\x7d\xa6\x62\x12\xf9\x36\xef\x03\xec\x2e\x65\x45\xd8\x96\xef\xd4\x6e" +
+	"\x4f\xba\x65\x3c\x25\x39\x59\x9c\xb6\xa0\xdd\x81\xd3\x88\xbd\xd2\xc9\x03\xaa\xfe" +
+	"\x0c\x51\x13\xe9\x4f\x76\xaf\x84\xe8\x16\x00\x3f\xe8\x50\x7a\x44\x30\x33\x7f\xf8" +
+	"\x54\xf7\xf6\x98\x95\x17\x0b\x5d\x22\xe6\x63\x9e\x37\x3d\x31\x9d\x4f\x97\x4b\xca" +
+	"\xfb\x9e\x9d\xa5\xd5\x40\x81\x42\x0a\x68\x46\x30\xa6\x3d\xe7\x36\x0f\xa2\xd4\x86" +
+	"\x91\x9d\xe7\xdc\xbe\x2a\x91\x21\x92\xfc\x42\xc9\xe0\x39\xb7\x45\x46\x3f\x00\x2a" +
+	"\x13\xc4\xa2\xf2\x2a\x89\xad\xbc\x1a\xdf\x6e\x78\x23\x27\x2d\xb9\x0f\xa0\x24\x31" +
+	"\x51\xc7\xf3\xb6\xe5\x07\x4d\x0a\x80\xe2\x88\x47\x63\xd1\xe6\x21\xc4\xfe\x28\xd3" +
+	"\xf6\x85\x16\x4f\xd4\x6e\x57\x73\xc0\xb4\xc1\xa0\x52\xd1\xcb\xd5\x22\x7b\xa8\x25" +
+	"\xb6\xe3\xf4\xa7\xb9\x56\x66\xed\x47\xc8\x1f\xe2\x2f\xab\x31\x2e\x5c\xa6\xd3\x75" +
+	"\xe4\xd9\xc3\x24\xf1\xde\xd5\x0a\x30\x99\xcc\xd7\xb9\xea\xc3\x95\x7e\xf1\x67\x25" +
+	"\xca\x02\xfe\x53\x36\xbb\x9f\x6e\xb0\xdb\x28\xc9\x6b\x86\x04\xb5\x37\x53\x89\xea" +
+	"\x49\xb5\x63\x07\xb6\x1c\x59\xbd\x92\x6b\xdc\xcd\xdb\xc6\xc9\x44\xf0\x39\xb3\xd1" +
+	"\x2b\x57\x1e\x0b\x7a\x55\xaa\xe5\xe7\x5b\x0e\x7c\x0a\xf1\x97\x29\x93\x11\xab\x2b" +
+	"\x90\x28\x0f\xf0\x37\x91\xf3\xdf\xf7\xc4\xce\x38\x57\x23\x0d\xd0\x85\xc8\x1b\xd2" +
+	"\x44\x94\x8d\x51\x0b\xd6\xec\x50\x80\x8d\x11\xe7\x31\x29\x0d\xe0\xac\xea\x11\x57" +
+	"\x6a\xcc\xd2\x72\xbb\x7b\xc0\xff\x4e\xae\x72\x6b\x26\xab\xee\x25\xd1\xe5\x71\x53" +
+	"\xaf\xb2\x2f\x96\x7d\xf1\xec\x8b\x04\xcb\xf1\xbc\xa9\x25\xa5\xb9\x1c\xcf\xec\x22" +
+	"\xa8\x25\x3e\x13\xef\x73\x9a\xf5\x2a\xa7\x79\x97\x78\x8b\x32\x37\x8e\x9d\x43\x8f" +
+	"\xaf\x44\xe7\x2b\xc4\xfe\xda\x07\xf4\x8c\xda\x71\xc7\x2a\xa2\xd0\xd3\x82\xf5\xf7" +
+	"\x02\xe2\xc9\x1b\xff\x34\x13\x48\xe5\xe5\x0f\x67\xd2\xc7\x93\xff\xc8\xd5\x92\x9f" +
+	"\x7e\x37\x29\x54\xe5\x78\xa9\xe8\x7c\x3d\x8b\x6c\x4e\x2c\x7d\xfb\x5f\xd3\xfc\xf2" +
+	"\xe7\x7a\xd9\xcb\x0c\xc3\x05\x2c\xad\xdb\xa1\xb5\x0d\x40\xbe\x4d\x4f\x8c\xec\x99" +
+	"\x4b\x16\x4a\x9a\xff\x37\xb1\x13\xf3\x4c\x77\x15\x95\x3f\xca\x89\x8a\x37\xb6\xbd" +
+	"\x8c\x87\x60\x26\x4f\x8d\x18\x9c\xec\xae\xbb\x3d\x55\x40\x63\x9f\x2b\x84\xc4\x8b" +
+	"\x10\xdf\xae\x46\x14\xae\xae\x3a\x95\xdd\xc5\x77\x35\x79\xa3\x7a\x80\xb2\xdb\xdb" +
+	"\xec\xe0\x83\x67\x2f\x63\x22\xd1\x35\xf7\x9d\xc5\x5f\x20\xff\x28\xc5\x65\x52\x22" +
+	"\x97\x3d\x74\xaa\x59\x15\x25\x93\x5b\x4f\x96\x4a\xd5\xcb\xa7\xf6\x05\x93\xf5\x10" +
+	"\x88\x24\xc4\xf6\xd0\x45\x9c\x84\x69\x5a\x8a\xd9\xd2\x17\xf9\xeb\x0a\x88\x99\x21" +
+	"\x32\xb5\xd4\x45\xb2\xb4\x69\x50\x93\x64\xbc\xe6\x9b\x7b\x86\x54\x55\x76\xab\x79" +
+	"\x73\x42\x99\x5a\x45\x25\xfb\xc9\x6c\x4a\xff\x8d\x9e\xbc\xf5\x54\xee\xd3\x33\xf5" +
+	"\x2c\x6b\xeb\x06\x8e\x34\xc0\x12\xc8\xfe\xcf\x3e\xc6\x7f\x6e\x5b\xaa\x3d\xfd\x6a" +
+	"\xf0\x4f\x14\x46\x87\xda\x9f\x79\x7a\xe6\x19\x45\xe9\x27\x0a\xce\x31\x4a\xe7\x45" +
+	"\xab\xef\x46\x8f\x89\xd7\x61\x66\xdc\xe2\x93\x77\x78\x52\x1f\x98\x84\x1e\x2c\xaf" +
+	"\x8b\xd0\xb4\xf2\xe3\x69\x4d\xda\x01\xb2\x8f\xa7\x34\xda\x2e\x94\x3c\x1c\xac\x02" +
+	"\x87\xc7\xa5\x2d\x59\xfb\x07\xf3\xe3\xa9\x57\xd9\x28\x02\x7f\x34\x87\xc9\x7f\x7c" +
+	"\x16\xc3\x45\x0e\xcf\x1c\x90\xa2\xd8\x74\x46\xf7\x39\x3c\xb3\xcd\x69\x32\xab\x75" +
+	"\xf6\x68\xa5\xc8\x4c\x31\x80\x3b\xec\xa0\x24\xaf\x32\xb3\x06\xef\x44\xe9\xb1\xab" +
+	"\xa6\x96\x92\xb7\xa5\xfb\x33\xb0\x51\x2b\x57\x02\x7a\x45\x98\x12\x3e\x39\x37\x24" +
+	"\x96\x3f\x92\xfa\xd7\x4c\x06\x8c\xa1\x10\xfc\xb4\x42\x9b\x4b\x49\x33\xf7\x99\xed" +
+	"\x5f\xe6\xf2\x88\x27\xf6\xfb\x49\xc1\x0c\xd7\x3d\x65\xb6\x9e\xe9\xf7\x40\x3d\xe0" +
+	"\x6d\x80\xb9\x7c\x40\x9a\xbf\x79\x94\xb4\xc6\x8d\xec\xe3\x06\xf3\x00\x2a\x87\xfe" +
+	"\x02\x65\x42\xb9\x5d\x9e\xdf\xe9\x59\xbf\xbe\xf8\xa6\x82\xbe\xa7\x27\x9a\x8a\x10" +
+	"\x66\xd3\x72\x29\x2f\xab\x61\xcd\x09\x92\xc5\xbb\xef\xaa\xd9\x4a\xf5\x5d\xf3\xa7" +
+	"\x34\xd3\xe0\x96\xb7\x0d\xcc\xb5\xd4\x1b\xa7\xa4\x41\x24\xca\x45\xf9\x10\x54\x0b" +
+	"\x48\x76\xb5\xb3\x69\x01\x3c\x95\x95\x4f\x76\x78\x36\xf5\xa9\x6b\xaa\x3f\x5b\x6a" +
+	"\x17\xb2\x18\xfd\x40\x13\x0b\x24\x3b\xd3\x00\x7d\x78\x3c\xb1\x42\xaa\xbf\xd9\x81" +
+	"\xc4\x2b\x1b\x09\x6a\x05\x75\x1d\x2f\xc5\x80\xf9\x78\x7f\xf8\x10\xc2\xe8\xab\x13" +
+	"\x95\x06\xbf\x51\x77\xd7\xca\x5b\x83\x2d\x71\x73\xa5\x95\x64\x88\xf7\x1f\xa0\xaa" +
+	"\xdb\xec\xcc\x8b\x42\x94\xb0\xe6\x43\x75\xb3\xeb\xdc\x74\xfe\x06\xc7\x31\xad\xa5" +
+	"\xd6\x3b\xce\xb7\x1c\xe3\xa3\xf7\x8a\x8f\x04\xb4\x4e\xfa\xa4\xf9\xd6\xdd\xe6\x01" +
+	"\x46\x55\xcc\x3d\x6a\xd4\x80\xf1\x34\x8a\x55\x66\x6f\x94\x83\xc1\x8c\x2b\x44\xcc" +
+	"\x95\x27\xff\xd5\xb3\x88\x0e\x06\xbb\x2c\x17\x54\x4a\xcc\x91\x8c\xbb\x62\xb3\xd9" +
+	"\xa9\x62\x28\x3e\x1d\x69\xe5\x5a\xc0\xc5\x6a\xba\x35\x7d\x7a\x39\x78\x1b\xa0\x2c" +
+	"\x8d\x75\xe2\x5b\x80\x14\x2c\xe9\x08\xc0\x54\xa5\x42\x94\x4a\x4f\xa8\x4c\x83\x8e" +
+	"\x7c\x3b\x8b\xf6\x3e\x9e\xac\x9b\x3d\x2b\x77\x47\x3a\x3d\xfc\x1f\x10\x94\xee\xcf" +
+	"\xc0\xac\xe0\xb0\xe5\x81\xa0\x75\xc7\x46\x49\xdd\x5b\x78\x3f\x18\xe6\x50\x9f\x50" +
+	"\x1b\x64\x16\x96\x9c\xda\xc1\x93\xc0\x3c\x85\xcf\xe8\xb4\x79\xf2\xac\x6c\xaf\x3c" +
+	"\xab\xbd\x63\x10\xe5\x73\xd2\xa9\xb0\x54\x40\xcc\xb3\x49\xe4\xd4\x3b\xce\xaa\x0f" +
+	"\xaf\xd0\x85\x24\xd2\x93\xaf\x59\x0b\xff\xe4\x3b\xd9\x9f\xd6\x1d\xd8\x1c\x1d\x57" +
+	"\x09\xf8\x3d\x44\x4f\xd6\x11\x70\xc4\xb4\x55\x84\xe7\xf9\x35\x3d\x4f\xbd\xc9\xa7" +
+	"\xce\xa4\x18\x59\xa6\xe7\x99\xf8\xf5\xe9\x79\x22\xf5\xd0\x3b\x66\x56\xb2\x9f\xe7" +
+	"\x83\x7d\x19\xb8\x04\x3f\xa7\x27\x1f\xce\x4f\x86\xe7\x73\x9d\xf9\x9f\x21\x1a\x99" +
+	"\x04\xf8\xd6\xa6\x6b\x32\x8f\xea\xc6\x20\x29\xae\xf3\xf6\xcd\xca\x90\x50\x71\x8e" +
+	"\x24\xf4\xef\xf8\x5a\x85\xbc\x0a\xcc\x12\xcd\x8b\xc7\xe6\x48\xe2\x91\x2e\xb4\x0c" +
+	"\x68\x25\x74\x4c\x73\x95\x88\xc5\xd2\x74\xdf\xad\x7b\x3f\xcf\x95\xd6\x83\xab\x46" +
+	"\x78\xae\x7a\xe1\x30\xba\xfd\x3c\x51\xff\x96\xab\x08\xdb\xd2\xb8\x67\xfe\x79\xda" +
+	"\xc7\xd0\xb9\x3d\x78\x73\xd1\x8f\x78\x6a\xe6\xec\x13\x67\x48\x73\x22\x69\xfe\xf5" +
+	"\xb3\x59\xed\x42\xe4\xa5\xc6\x9a\x66\x9b\x67\xa3\x47\xde\xd2\x85\x24\x80\xc6\x91" +
+	"\x2a\xa4\x49\xc5\xe6\xc9\xf2\xc0\xd3\x96\x07\x9e\x67\xff\x26\xa4\xb3\x47\xf9\xe4" +
+	"\x3b\xef\x24\x8f\xf7\x57\xa3\x7a\x44\xf0\x2e\x8d\x99\xfd\x4d\x88\xfe\x6c\x7a\xbe" +
+	"\x1f\x39\x91\xd1\x7b\xdd\xe4\x20\xb5\xe3\xc7\xca\x80\x9f\xfc\x7e\xbe\x77\xbb\xf6" +
+	"\x51\xe0\x60\xde\xf1\xde\xeb\xad\x65\xe6\x27\x86\x8e\x34\x90\xd6\x7d\x9f\xef\x9d" +
+	"\x45\xe8\x9e\xfa\x80\x79\xc0\x10\x61\x1a\x9e\xe7\x00\x1f\x3c\x1b\x8d\xf2\x2d\x7d" +
+	"\xa5\xf6\x08\xda\x01\xcb\x94\x39\xcb\x8a\x93\x6f\xc2\xb0\xdf\x53\x5e\x98\xad\xcb" +
+	"\xa5\x99\x39\x38\x06\x40\x58\x90\x17\x59\xcc\xe2\x91\x3a\x84\x8d\x49\x8b\xbf\xb1" +
+	"\x44\xac\x7e\x20\x3c\xbe\x7a\x1c\x5e\x8b\x2f\xb1\xd4\x2e\x18\xc9\xdc\xdf\x03\xd0" +
+	"\xde\x0c\x1f\x57\xf8\xa1\xf0\x30\x84\x3d\xb0\x85\x03\x09\xcf\x95\x47\xc8\x75\x85" +
+	"\x9b\x6b\x7f\x6f\x7e\x3b\x38\x3e\x1e\x2a\xd7\xe1\x43\x44\xa5\xd5\x03\x55\x1e\x02" +
+	"\x25\x6c\xdd\xbf\x98\x9c\x2c\x46\x59\xd3\xc9\x0f\xc6\x17\xa6\x25\x8e\x0e\xc2\xf8" +
+	"\xb0\x36\xb2\x38\xed\x46\xf5\x0c\x05\xf3\xc4\x25\x28\xf9\x80\xb2\x5b\xa6\x42\x5b" +
+	"\x0a\xc3\x11\xd0\x83\xcd\xfe\x44\x38\x23\x31\x0f\x55\xc6\x93\xb2\xae\x93\x4b\x39" +
+	"\xdd\xbc\xf0\x05\x97\x8b\xed\xd9\xb9\x87\xe2\xfa\x75\x5d\x03\x58\x1a\x9d\x3d\x41" +
+	"\x81\x23\x32\x1c\x1a\x65\x17\x76\xd9\x50\x2f\xbb\x3c\x75\xfb\xc7\xf3\x21\x57\x5b" +
+	"\x60\x69\x2d\x65\xcd\x37\xe4\x92\x21\xb4\x8b\x64\x4c\xa6\xa6\x80\x1e\x36\x0a\x7d" +
+	"\xe1\x21\x2d\x8d\x24\xc3\x8f\x29\xaf\x5f\xb3\xf6\x8e\xa7\xbc\x32\xd9\x1e\x74\x71" +
+	"\x60\x42\x31\x0f\xcf\xeb\xca\x59\x76\x93\xa3\xce\x1b\xdb\xd6\x82\x0c\x64\x0e\xe8" +
+	"\xff\xd8\xda\x5d\xf2\x7c\x1b\xc8\xa2\x60\x96\x54\xba\xbf\x70\x31\x65\xe2\xd4\x39" +
+	"\xcd\x39\x32\x20\x4a\x90\x4c\xf3\xc0\xc2\xc2\xef\x76\x5c\xa8\x07\x8c\x72\x65\x0c" +
+	"\x65\x00\xea\x17\xc3\x1d\x00\xc5\x31\xa7\x5e\xa4\xda\x8e\xe0\xf9\xb8\x9a\xd7\xf5" +
+	"\xdc\x24\x7e\xea\xae\x9c\xbc\xee\xf3\x8d\x7c\x20\x2a\x04\x80\xdc\x74\x1d\xb4\x11" +
+	"\xe7\x67\x8e\x8b\x99\x6c\x0b\xe2\x41\x4d\x76\x6b\x60\x71\xda\xce\x68\xae\x9b\x87" +
+	"\x76\xec\x54\x48\xee\x90\x99\x96\x01\x8f\x0b\xa9\x46\xf6\x74\xbb\xf9\xdf\xbb\xcb" +
+	"\x82\x94\x68\x8e\x6c\xdd\x42\x06\xcc\x8d\x5b\x88\x43\xd2\x0f\x51\x0a\xde\xb1\xb3" +
+	"\x37\x90\x55\xd6\xe5\xd1\x83\xc5\xbf\x68\x40\xbd\xd6\xc0\x1e\xb7\x6b\xb2\x34\xa0" +
+	"\xfe\xcc\x3d\xf1\xe1\x95\xb1\x6b\x64\xc5\x81\x5a\x19\x8b\xff\x43\xbb\xdb\x8a\x1c" +
+	"\x28\x9a\x91\x7b\x5d\x5a\xd8\x9f\x24\x2c\x4a\x02\xd1\x3b\x4f\x17\x5a\x06\x8c\x44" +
+	"\x1c\x87\x74\x3e\x06\xb0\xea\x2b\xd9\x73\x50\xb7\x87\x65\x63\xb5\x52\x37\xe4\x42" +
+	"\x80\x89\xbd\x3d\xf3\x4a\xc7\x21\x60\x7f\x28\xed\xc5\xff\x1f\x41\xa6\x47\x86\x2d" +
+	"\x3a\x86\x58\x9a\x83\x48\x02\x7d\x77\xa8\xfd\xef\xb2\xba\x62\x5e\x67\x87\x9c\x5b" +
+	"\x5d\x11\x1c\x47\xbb\xc3\xe7\xf9\xf0\x11\x24\x70\x44\x33\xa9\x87\xd7\x27\x33\x28" +
+	"\x3b\xe1\x94\xd7\x1d\xa5\x71\xeb\xc5\xdd\x18\xb3\x53\x0f\x75\x1b\x2d\x17\xf4\xff" +
+	"\x75\x58\x6c\x09\xfc\x4a\x1f\xd0\xca\xc0\xb1\x97\xa0\x9e\x9c\x54\xdc\x02\x7c\xf0" +
+	"\xe2\xaf\x6e\xf5\x2b\x9a\x62\xab\xeb\x7e\x9c\x86\x58\x04\x09\xe0\x69\xd7\xd7\x68" +
+	"\x1f\x81\xbb\x7b\x72\x1f\x7f\x33\x17\x91\x76\x4f\x0e\xa4\x1c\xcf\x62\x2c\x68\x77" +
+	"\xe0\xe5\x1f\x6f\xb2\xfa\xa4\x2d\xae\x12\xc9\xdb\x7c\x93\x31\x33\x95\x0d\xcf\xc9" +
+	"\xae\x7d\xea\xc3\xc3\x7f\xbf\xb9\xc5\x0f\xa4\xed\x2d\x32\xe0\x29\x2c\x18\xdb\xbc" +
+	"\x5d\xf0\x48\x1f\x90\xa7\xda\xe6\x45\xf7\x79\x6d\xc7\x27\xb6\x90\x9d\x87\x37\x8b" +
+	"\x52\xff\xf8\x2c\xf4\xb4\x8b\x20\xf2\x36\x9f\x1c\x7e\x2e\x03\x79\x79\xbe\x38\x9f" +
+	"\x92\x37\x4c\x4e\x9a\x1c\xe8\x16\xbd\xf5\x76\xc3\x74\x44\xfb\x3f\x8f\xc5\xbc\xcd" +
+	"\xb1\x34\x92\xb7\x1b\x96\xa3\x82\x4a\x94\x77\xef\xe5\x2c\x10\xf5\xe1\xa6\xe2\x84" +
+	"\x7a\x67\xad\x66\x20\x15\xf6\xf6\x9c\xfc\xe0\x61\xde\x6e\x6e\x41\xd0\xd1\x31\xc0" +
+	"\x5c\x3e\xa0\x45\xf3\x1b\xfc\x90\xe8\x9e\xbe\xde\x38\x11\x25\x56\xc6\xd8\x75\xe1" +
+	"\xee\x9e\x14\xd4\x24\x7d\x3c\xde\xdc\xae\x1b\xbc\xca\xba\x95\x95\x96\x6b\xbb\x8c" +
+	"\xea\x8d\x03\xd0\xb5\x7a\xd5\xd5\x75\x4f\xfe\x52\x0d\x8f\x47\xdb\x0b\xf7\x93\x1a" +
+	"\xe4\x8c\xd4\x16\x2d\x46\x97\xbb\xe6\x57\xd4\x7e\xfd\x27\xe3\xed\xd1\x4e\x0d\xd4" +
+	"\xe0\xce\x62\xc3\xe6\x6d\xeb\xf2\xbf\x3f\x7d\x96\xdb\xf6\x4a\x9b\x8f\x01\x97\xcf" +
+	"\x82\x9a\x9b\x8f\xfc\xc0\xcb\x67\x40\xff\xf0\xc4\x23\xba\xcf\x6a\x96\xcf\xd7\x9b" +
+	"\xa7\xe5\x17\x2d\xe7\xed\xc6\x76\xbf\xed\xc2\xca\x13\x8d\xce\x61\x70\xcd\x14\x8f" +
+	"\xf0\xea\x05\xd2\xf1\x6c\xff\x53\x5b\xbc\xab\xdb\xed\x5c\xbf\x5c\xc0\x90\x31\x9b" +
+	"\xb9\x9a\xc6\x6d\x4b\xd2\xcd\x5f\x2c\x48\xe9\x78\xa3\x40\x34\x80\x25\x79\x90\x1f" +
+	"\x24\xbf\xa0\xca\xbb\x24\x0c\xa0\x79\x0d\x85\xe7\xf8\xc4\x2e\xf6\x6b\xae\x27\xc6" +
+	"\xc9\x1b\xf6\xf3\x67\x7c\x5a\x73\x82\xfa\x28\xe2\x68\xf0\xac\xf6\x86\xd1\xda\x0b" +
+	"\x5e\x12\xfa\x97\x05\xe9\x91\x03\x79\xb8\xe7\xe5\x36\xea\xf3\x76\xdd\xab\xfe\xe1" +
+	"\x51\x99\xb9\x65\xdc\x2a\xf6\xba\xd4\x5c\x78\x9b\x3d\xe6\x63\x34\xff\xb8\xad\x9c" +
+	"\x70\xff\x23\xc7\x85\xd9\xf0\xc6\x35\xb8\x56\x34\xdd\x63\xea\x6d\xf3\xe3\x32\xc9" +
+	"\xc1\x79\xe5\x85\x85\xa2\x0b\x6a\xa0\xbf\x7c\x9e\x93\x85\x79\x16\xe6\x51\x71\xab" +
+	"\x3d\x77\xf5\x87\x3e\xed\xd9\x4e\x7d\x08\x89\xc6\xb3\x9f\x28\x68\x88\x7a\xb5\x9e" +
+	"\xd1\xa1\x2e\x67\x61\x0e\x6b\x74\x09\xe0\xb5\x04\xcf\x13\x28\x8f\x88\x89\x3e\xb4" +
+	"\x95\x59\x13\x61\x9f\x90\x5c\x3e\xd7\xeb\xfb\x08\xff\xeb\xe5\x19\xf3\xe6\x6d\xdc" +
+	"\x47\x33\xe5\x2d\xee\x9b\xb9\xbd\x3f\x3c\xa4\xe8\xa6\x95\xed\x85\x1d\x76\x96\x31" +
+	"\x9c\xa0\x50\xa6\x73\xd0\x57\x3e\xae\xd1\x24\xbd\xdc\x66\xf2\x80\xe2\x0e\x93\x7c" +
+	"\x86\x5b\xbc\xdd\x97\x87\x12\x62\x09\x2e\xfb\xdc\xee\xf2\x90\xc0\x1d\x0d\x4f\x8a" +
+	"\x61\xc6\x86\x44\x0f\x27\x2d\xfc\x0a\x37\xdb\xc9\x06\xac\x86\xaf\x59\x9d\xc3\xd3" +
+	"\x20\x7f\xdd\xd5\x22\x1d\x79\x10\x06\xd2\x02\xd8\x83\xdd\xd1\x96\xb3\xc0\xe6\x19" +
+	"\xb0\xd5\xe3\x51\x7d\xe5\x08\xdc\x3d\xff\xb0\x15\x92\x37\x4c\x61\x78\xa7\x15\x70" +
+	"\xb9\xb0\x17\x32\xde\xf3\x8f\xb7\x5c\xd0\x12\x68\x2b\x9b\x0e\x79\x73\xd3\x06\x06" +
+	"\xfc\xc8\xe5\xf0\xd4\x10\x89\x7f\x00\x38\xa8\xc7\x1a\x51\xfc\x0c\x3e\x4c\x8c\xe0" +
+	"\x3a\xf5\xc4\x39\x4c\xa5\xe6\xa6\xc3\xdb\xb7\x74\xa1\xe5\x82\x7d\xc0\x0f\x59\x9b" +
+	"\xe3\x9b\xf4\x5f\x3a\x9a\x7b\x39\xe9\x85\xee\x55\x4e\x5b\x9d\x98\x54\x9c\x23\x75" +
+	"\xb8\x1d\x17\xea\x01\x5d\x89\x6b\xf8\xd4\x8a\xfc\x00\xa9\x83\xe6\xc0\xcb\x51\x51" +
+	"\xa2\xce\xdb\xbd\xcd\xfe\x57\x71\x5d\x5f\xe3\x78\xba\xe6\x3e\x71\xf4\xec\x03\x5a" +
+	"\x7d\xb3\xea\x96\x06\xf0\x77\x97\xa3\x31\x51\x7d\x82\x9e\x89\x16\xfb\x44\xad\x46" +
+	"\xd1\x53\xb7\x36\x2a\x3d\x72\xb5\x06\x7a\x33\x27\x12\x7e\xf8\xc5\x84\x79\x7b\x34" +
+	"\xb7\x86\x79\x41\xa2\x4a\x99\x23\xe8\xbc\x7d\xc2\x11\xac\xf8\xcf\x59\xff\x1d\xc4" +
+	"\xbd\x56\xac\x31\xd4\x1b\xd0\x73\x69\x93\x2a\xa8\x7d\xd7\x1a\xb6\x94\x92\xeb\x14" +
+	"\xe4\xed\x99\x3d\xf7\x3c\xb2\x71\x2b\xa6\x1f\xb0\x07\xd6\x2b\xe2\x4b\xb0\xd6\xde" +
+	"\xb4\x26\x18\x14\x46\xf6\xd6\x90\xdd\x5a\xa3\xa3\xee\xe0\x08\x19\xab\x5e\xb1\xac" +
+	"\x24\x06\x55\xaf\x06\x5f\x43\xd4\x27\x63\xdc\x5f\xe5\x44\x91\xff\xf8\x2d\x53\x8e" +
+	"\xea\x76\x21\xb2\xfb\xe3\x07\xb8\x04\xd5\x0a\xfe\x68\x7d\x5e\x5e\xeb\xe1\x00\xca" +
+	"\xfc\xcf\xa9\x17\xe9\x0f\x36\x5f\x6f\xe9\x42\x08\x7d\xf3\xd3\x4a\x6c\xde\xb4\xc9" +
+	"\x91\x37\x74\x54\xf2\xc6\x87\xbf\x13\xe4\xc8\xde\x47\xa1\x8d\x29\x3e\x3a\x46\x2b" +
+	"\x2b\xd4\xae\x3e\x67\x44\x92\xf5\xe6\x6f\xd8\x56\x7d\x22\x60\x40\xff\xdd\x56\x6f" +
+	"\x3e\x83\xda\xea\x2b\xaa\x24\xe0\xc5\x56\xf5\x08\xea\x5f\x51\x67\xb7\x9d\x6b\xf2" +
+	"\xcf\xdf\x6d\x3f\x49\x77\x67\xd5\x31\x6f\xb2\x83\x2f\x8a\x52\x94\xd3\xc5\x81\xd5" +
+	"\x85\x90\x8a\x09\xa2\x20\x66\x93\xd2\x46\x0c\x24\xba\xfd\xc3\x10\x15\xb4\xcf\xde" +
+	"\x59\x31\x02\xa3\x36\x6c\x28\x66\xcc\x03\x2b\x6e\xba\xd9\x57\x88\x40\x8c\x30\xee" +
+	"\x51\x1a\x8c\x16\xfe\x54\x3d\xed\x73\x3c\x46\xb7\xa1\x07\x62\x39\x24\xff\xc4\x34" +
+	"\xa4\xca\x01\x29\x39\xe9\xd5\x3b\x0d\x86\x53\x7f\x51\x6d\xdb\xcf\x29\x6b\xb6\x0e" +
+	"\x3d\x8c\x9d\x5c\x07\xac\x33\xea\xb5\x62\x12\xeb\xc4\x4a\x10\x36\x7a\x03\x46\xa8" +
+	"\x17\x4b\xe7\xfe\xf2\xd6\xeb\x7d\xe6\x8f\xe9\xb5\xb8\x48\x5d\x3d\x79\x6c\xe4\x06" +
+	"\xb5\x24\xf7\xd1\x94\x75\x09\xb9\x3f\x96\xc3\x1e\xb8\xb6\x0b\x19\x33\xec\xcd\x7e" +
+	"\xe0\xb9\x7c\xfa\x42\x2c\x05\x5d\x02\x44\x88\x67\xfb\x31\xa0\x77\x3e\x53\x82\x8e" +
+	"\xf4\x87\xa7\x87\xcf\x8a\x0e\x7f\xa4\xe1\x87\x33\x3f\xb0\x4b\x7b\x7d\xf9\x35\xe3" +
+	"\x86\x5c\x6e\x3b\x66\xdb\xc6\x73\x9f\xb5\xad\x23\x3f\xbc\x0b\xf6\x0b\xc8\x03\x78" +
+	"\x13\x09\x1c\xd2\x9b\x56\xa7\x40\x07\xb6\x40\x06\xf2\x14\x60\x5e\xe1\xf1\x48\x8e" +
+	"\xe7\xf2\xe9\x33\x31\xc6\x8e\x01\xbc\x1c\x6d\x4c\xe1\x06\x9e\xcb\xa7\xef\x8a\x1a" +
+	"\xa5\x04\xd7\xf6\x01\x43\x64\xd4\x93\x41\x4b\xe7\xfc\x98\x8a\x75\x37\x6f\xe0\xc8" +
+	"\xf3\x45\x0d\xc8\x02\x5d\x66\x88\x44\xfb\x91\x19\x3f\x38\xbe\xa8\x0c\x0a\xb2\x38" +
+	"\x6d\x41\x5d\xf2\xe1\x53\x41\x83\x11\x7f\xd5\xb9\x7e\x7f\x0d\xf9\x86\x89\xa7\x3f" +
+	"\xb4\x34\xb8\x1d\xe8\xaa\xe1\xdb\x1e\x3e\xaf\x38\x12\xbb\x2e\x4e\x49\xe5\x48\x0f" +
+	"\xfb\x82\x17\x38\x5c\x26\xa6\x43\xc6\xb4\x72\x3b\xd6\x53\x82\xad\x67\x39\xb0\xf7" +
+	"\x6e\x99\x19\x3a\xe7\xf2\x8f\xa7\x7c\x48\xf9\x14\x9d\xc0\x1c\xf3\xe9\xf0\x44\x12" +
+	"\xd9\xcf\x81\x85\x6f\x14\x5c\xfd\xba\x1b\xdd\x25\xd0\x97\x14\x85\xec\x49\x6c\xbd" +
+	"\xe0\x88\x65\x5a\x42\xdc\x38\x67\xe0\x8b\xfb\x1e\x91\x7b\x3c\x48\x4c\x86\xbd\xc3" +
+	"\x80\x78\x6d\x1a\x9a\xcb\x27\x8e\x1c\xa2\x55\x8d\xc5\x93\xc3\xff\x1f\x16\xf6\xbd" +
+	"\x62\xdc\xea\x6e\xc0\xc8\xda\xcd\x2b\x7c\xe2\x2b\x62\x94\x05\xec\x5c\xd6\x3a\x2e" +
+	"\x14\x09\x5e\x2b\x20\x88\xa0\x5d\xe8\x11\x65\xe6\x3e\x79\xf3\xc0\x5f\xcf\xe6\x35" +
+	"\xd4\xf8\x28\xcb\x9c\x26\xb0\x29\x2c\xb6\x28\xbc\xf3\x34\x18\xdf\xdd\x47\x6a\x98" +
+	"\xaf\xbd\x90\x17\x85\x99\xf9\x85\x3c\xde\x35\x71\x37\xcf\x78\xce\xbc\x3a\xd5\x8b" +
+	"\x66\xc0\x13\xd2\x92\xbb\x0f\x70\xc3\xeb\x39\x8f\xe5\x78\xf2\xf1\xbb\xf0\xc2\x73" +
+	"\x8c\x7f\x29\x3c\x51\x5e\xaf\x89\xf8\xbb\xdc\x8a\xb2\xc3\x7f\xfe\xbb\xb6\x25\x4f" +
+	"\x22\xea\x7d\x93\xde\xa3\x64\xaf\xb5\x64\xf9\xca\xec\x71\xf6\x16\x91\x40\xd7\x0b" +
+	"\x71\x79\x3d\x2d\xfc\x51\x33\xc2\xfe\xec\x86\x3f\x84\x46\x35\x11\xe2\xfc\x56\x1f" +
+	"\xe3\xc9\x0d\x7b\x4a\xf2\x8c\x3c\xb9\x13\xe6\x42\x43\xe6\xdc\xaf\x44\xe3\xfe\x98" +
+	"\x4f\x8f\x67\xd2\xaf\xfe\x62\x78\x22\xe8\x18\xd9\xbf\xa2\x0e\x5e\xf1\xf6\x62\x42" +
+	"\x32\x02\xa5\x82\x16\x70\x64\xfa\xfa\x78\x77\xc2\x13\x71\x73\xfa\xb9\xd0\x88\x9c" +
+	"\x65\x19\xf2\x03\x7f\x88\x5f\xc9\xe6\xf4\x63\x7c\x5a\xa8\x97\x0b\x98\x2e\xa4\x70" +
+	"\x4e\x50\xbc\x1d\xd8\x73\x68\xe3\xad\x38\x2f\x2f\x83\x2b\xb8\x5c\xd8\xf3\x72\x8f" +
+	"\x55\x4e\x2d\x63\xce\x66\xd8\x1b\xb9\x61\xb5\x86\x3a\x16\x43\x8e\xfa\x3b\x56\x6f" +
+	"\x1c\x7b\x40\xf3\x5d\x02\x6e\x07\x7e\x9c\x17\x58\x02\x79\xde\x8d\x29\xca\xed\x42" +
+	"\xd1\x5e\x5b\xf5\xc5\x0c\x47\xcb\x05\xfb\x80\x91\x06\x38\x4a\x34\x3c\x97\x98\x7f" +
+	"\x97\x1e\xad\x7e\x4c\x67\x8e\xc6\x5a\xbd\x9e\xa7\x61\x30\xc7\x69\x1b\xc0\xe2\xc7" +
+	"\xc7\x95\x90\xf5\x52\x6e\xb8\xc2\xd1\xc5\xf3\xd1\x9f\xab\x6b\x9c\x2c\x4e\x5b\x50" +
+	"\xd2\xf4\x3b\x6d\x00\xd8\xeb\x16\x3d\xbd\xc6\x5f\x28\x3e\x7c\x79\x32\x9e\xb3\x81" +
+	"\xee\x48\xd9\xd1\xc8\x9c\xa8\xea\x5f\xb1\x51\xb3\x5d\x6d\xf1\xa3\x21\xbe\xc6\x5a" +
+	"\x32\x48\xdd\x92\x01\xeb\x89\x0c\x5f\x48\xb9\xf3\x8e\x38\x8d\xf4\x34\xf3\x7d\xc5" +
+	"\xcc\x17\x55\x63\x27\xc3\x9f\xfd\x3d\x1e\xf8\x9f\x80\xfe\xe1\x89\xd2\xba\xaf\x6e" +
+	"\xff\xfa\xfe\x11\xf5\x92\x9b\xaf\xb6\x7f\x3c\x43\x70\xa4\xe7\x33\x99\x80\x21\x30" +
+	"\xe2\x5d\x71\x44\x59\xf3\xf6\xba\xcf\xd7\x7e\x0f\x77\x8a\x0d\xf6\xe8\xf7\x02\x5a" +
+	"\xc4\xc3\x43\xed\xe9\xb3\x3f\x5f\x7c\xc9\xda\x85\x3c\x16\xad\xa2\x41\xe4\x54\x71" +
+	"\xb9\xa2\xc7\xc8\xe2\xd4\xd9\x11\xb7\x30\xd5\x91\x05\xfa\xcb\xe7\x38\xf6\x66\x36" +
+	"\x2c\x2e\x39\x91\x1c\xfe\x3e\xe5\xba\xa8\x87\x20\x67\x95\xb4\x65\x1c\x53\x87\xb8" +
+	"\x2e\x1c\x86\x63\x7c\xf0\x35\x55\x6f\x2d\x3d\xce\x32\xb7\x0b\xf9\xc7\x51\x6b\xdc" +
+	"\x13\xac\x0e\xa0\x61\x3a\xac\xfb\x9a\x3d\x9e\x3d\x56\xf2\xe4\xa9\xb1\x32\xdf\x64" +
+	"\x4f\x6c\x78\xc6\x1f\xdd\x5a\x7c\xaf\x0b\xa9\xb7\x37\xa4\xc7\x77\xc8\x03\xf4\x32" +
+	"\xaf\xe6\x52\xfa\xce\xe7\x6e\x6a\x03\x74\x50\x92\x03\xac\x4c\x50\x83\x2e\x0e\x94" +
+	"\x61\xef\x74\x40\xb8\xb0\x3b\x3a\x4a\x10\xe5\xc6\x86\xf3\xbc\x4d\x28\x71\xbd\x27" +
+	"\xd4\x19\xe5\x58\xd0\xed\x09\x2b\xad\x72\xfa\xc4\x5f\x8b\x96\xea\x7b\xca\x2f\xbd" +
+	"\x25\xaf\xf7\xf4\x67\xfe\xe2\xc7\xe7\x26\x2a\xa3\x72\xd2\xe1\xa4\x8b\xee\xd5\xfc" +
+	"\xf8\x96\x24\x07\x61\x29\x46\xfd\x99\x39\xf3\x34\xfd\xe1\x54\xcc\x1f\x3b\x15\x03" +
+	"\x41\x74\xe5\x87\xda\x11\x84\xf2\xfc\x99\x57\xf8\x1b\x37\x89\x4f\x7f\xe6\x7d\xde" +
+	"\xa6\x3f\x8a\xdb\x1e\x55\x90\xd0\xce\x15\x49\xd3\x9f\xf9\x87\x1f\x8c\xbf\x7f\xa7" +
+	"\x3f\x98\x7b\x38\x7b\xd0\x37\x60\xc3\x9b\xf6\xe9\x4f\xa2\x35\xfc\x49\x75\xe7\xe8" +
+	"\xd5\xdf\x14\x10\x5e\xfb\x96\x43\x11\x12\x26\xa7\xfe\xd0\x27\x10\xd0\x0f\x39\xa9" +
+	"\x41\x94\x30\x8c\x43\xe0\x40\xe0\x27\x15\x73\x25\x82\x89\x74\x8e\x24\x8a\x13\x14" +
+	"\xe1\x9f\xdc\x9f\x90\x3e\xfd\xc9\x8f\x4e\x99\x99\x89\x25\xc8\xef\xf4\x87\x89\x0e" +
+	"\xd2\x95\xdb\x0a\xfa\xf4\xc7\xf4\x46\xff\xd4\xc7\xf4\xa7\x5a\xe5\x55\xd3\x2a\xfb" +
+	"\xa3\x53\x53\x72\x79\x16\x28\xe2\xdf\x49\xce\x22\xb7\xc9\x15\xdb\xa2\xa0\xb9\x2b" +
+	"\x69\x3d\x7f\x2d\x07\x90\x76\xf3\x07\xc3\x05\x44\x85\x73\x32\xa1\x0a\xca\xca\xfc" +
+	"\x80\x23\x94\x35\xfd\xdb\x87\xa7\x07\x76\x90\xde\x41\x95\xb8\x10\x41\x2f\x16\xa6" +
+	"\xfe\x54\x42\xdf\x7c\xf9\xff\xa9\x6f\xbe\x3a\xff\x70\xcb\xef\x9f\xf3\x2b\xb3\x59" +
+	"\x6f\x20\xa5\xe9\x0f\x47\xf5\x16\x23\x7a\xfd\x85\x10\x5c\xac\x0a\xce\xe5\x31\xf8" +
+	"\x00\x05\x65\x99\x2f\x70\x40\x55\x9e\x4b\x9d\xfe\x9c\x0f\x7e\xaa\xe7\xd3\x4d\x2a" +
+	"\xfc\x39\xd5\x33\x41\x90\x42\x83\x4e\x04\x69\x94\x0b\x44\xde\xd3\x58\xc2\xfa\x73" +
+	"\xda\xff\x24\x42\x56\x1b\xcb\xd5\x46\xe4\xfd\x9e\x7c\x51\x0b\xd2\x17\xb7\x8c\xf5" +
+	"\xe9\x41\xac\x29\x92\x74\x30\xb5\xe2\x15\xeb\x5d\x63\xb5\x4b\xc0\xd5\xad\x1c\x7b" +
+	"\xaf\xfa\x8f\x6f\x08\xa6\x65\xa0\xe0\x79\xaa\xa6\x6d\x05\xe4\xef\x3e\x8f\xe9\xcf" +
+	"\xc9\xa6\x5e\x49\xd3\x37\xeb\x98\xdf\x3a\xbb\x54\xeb\xf4\x3d\xf3\x35\x08\x59\xe7" +
+	"\xe9\x9b\x4b\xf6\xbf\x31\x97\x88\x53\xde\x93\xeb\x4f\x1a\xe9\xf2\x62\xd7\x6f\xfa" +
+	"\x4e\x32\x73\xf4\xcd\xc9\x77\x62\xb4\xda\x99\x34\x7e\x63\x35\xfc\xdc\xa7\xef\x74" +
+	"\xd0\xa4\xbe\xd3\x5b\x96\x56\x07\x20\x05\x0c\xaa\x7d\xa7\xf7\xb3\x9a\x80\x8c\x5d" +
+	"\x7e\xa7\x77\x3f\x50\x7f\xfd\x7e\xce\xdf\x79\xfa\xce\xb7\x6f\xa9\xa7\x02\x52\x93" +
+	"\xbf\xde\xef\xd3\x77\x5e\xf8\xa1\x80\x02\xe1\x01\xbf\xf3\xb2\xcd\xbb\x13\x94\x72" +
+	"\x03\xb5\x0b\xf5\x80\x11\x21\xbd\x9d\x28\x04\x87\xf7\xd5\x89\x79\xa5\x8c\xfd\x9d" +
+	"\x4b\xc5\x87\xb1\x91\x75\x00\x09\xa0\x22\x6d\x51\x0c\x0d\x66\x4b\x17\x82\xb9\xf1" +
+	"\x5b\x66\xb9\x8a\x40\x8f\xfe\xe0\x64\x8d\x85\x30\xa9\x80\x52\x1b\x22\x3c\x82\x0c" +
+	"\xa5\x41\x50\x1a\x93\x9f\xaf\x30\xde\xe0\xa5\xae\x41\x95\xba\xbe\x68\xbe\x73\xed" +
+	"\xdf\xd3\x77\x26\x25\xf4\xd3\x37\x82\xa8\x56\x8d\xd1\xdf\x1c\x59\xfc\xa6\x73\xd9" +
+	"\x9c\x98\xf7\x3d\x7d\x6f\xaa\xe4\x4d\xff\x3a\x2e\x99\x40\x55\x51\x1b\xa7\x6c\xbe" +
+	"\xcd\xa4\xa2\x11\x05\x03\x3a\x54\xdc\xc3\x99\x66\x12\xee\x7b\xab\x5f\x72\x10\xa8" +
+	"\x08\xa0\x8e\x87\x6b\x62\x32\x3e\xc1\xa3\x6d\x52\xb1\x7d\xa4\x0b\xa9\xc9\x7f\x6f" +
+	"\x27\xa6\xf4\x82\xf6\xe9\xbb\x62\x09\xaa\xcc\x5f\x53\x99\xbf\x52\x31\x37\x2d\x46" +
+	"\xc9\x51\x60\x70\x3a\x01\xb5\x99\x3b\xfb\x7c\x6f\x78\xde\xc6\x37\x61\xcc\x86\x06" +
+	"\x75\x19\x2d\x51\x0a\x19\xe3\x6c\x4e\x4c\x52\xcc\x37\xfa\x27\xcf\x49\x76\xc1\x71" +
+	"\x48\xeb\x96\x08\xba\x7d\xcb\x51\xea\x76\xf4\x13\xaf\x42\x74\x07\x5d\x99\x09\x91" +
+	"\xad\x2a\x23\x04\x61\x11\xa3\x70\xe5\x0a\x86\xb8\xdd\x0e\x77\x99\x1f\xe8\xc1\x97" +
+	"\x39\x13\x21\x0b\x51\x80\x95\x4a\x58\xbf\x90\x5d\xd3\xe4\x86\xe6\x8d\x28\xd6\xba" +
+	"\x13\xc4\xc7\x8b\xa6\x0d\xb0\xb6\x05\x7b\x6d\x02\x56\x20\xad\xaa\x48\x6c\x29\xf3" +
+	"\x42\x85\x6c\x4b\xc1\xde\x69\x00\x0b\xd3\x09\xd4\x00\xc6\xb2\xf3\x02\x03\x19\x53" +
+	"\xf7\x8b\x5e\xc8\x99\x58\x59\x01\xa9\x20\xaa\x9b\xed\x71\x4a\x51\xb0\xcc\xdc\x6b" +
+	"\xa4\xdc\xf8\x5e\x0f\x8a\xc8\xce\x4f\xff\xb0\x0e\xb6\x17\x0e\x88\xe1\x90\x88\xac" +
+	"\xb1\x94\x79\xe7\x80\x89\x11\xc2\x9a\x14\xae\x45\x29\x58\xd3\xce\x4f\xb1\x92\x63" +
+	"\xc5\x4d\x2e\x82\x9d\xff\x4b\x2a\x4a\x6e\x17\x3c\xcc\x82\x63\x69\x86\xa0\xc3\x82" +
+	"\x0e\xe7\xb1\x3e\x85\x97\xb8\xcc\x8f\x8a\x1f\x16\x64\x9a\xa5\x20\x96\x9f\x9d\xf2" +
+	"\x0f\xb0\x8a\x43\xc1\xf9\x44\x15\xf9\xc5\xd5\x1f\xe7\xc6\x14\xcc\x6a\xf8\x54\x30" +
+	"\x5e\xa1\x86\x5e\xc2\x8c\x45\x99\x51\x9d\x26\x64\xf3\x3c\xf0\x8f\x00\x15\x16\x8e" +
+	"\x15\x8d\x13\xf4\x6d\xc0\x46\x19\xce\xc6\x31\x37\x03\x14\xe2\xc5\xeb\xf2\x8a\xe6" +
+	"\xfe\x62\x41\x8d\xc4\x5e\xcc\x3c\xca\xfc\xc3\xc0\x5b\xe6\x1f\xbf\x59\xa0\xcc\x3f" +
+	"\x94\x12\xfb\x23\x58\x1f\xc7\x82\x85\x53\x18\x3c\xc6\x5b\x45\x79\x4b\x4b\x5e\x3e" +
+	"\x02\x34\xb4\x94\xf9\xcd\xd6\x53\x99\xdf\xf5\x3c\x9c\x28\x10\xe7\x2f\x9f\xea\x02" +
+	"\xef\xa9\x24\x4d\x00\x45\x36\xf3\x35\x75\x5b\x25\xcd\x77\x76\x72\x9c\x76\xc2\xee" +
+	"\x92\xe7\xfb\xd9\x88\x98\xdf\x72\x50\x3c\x85\x5a\x5a\xf6\x86\xc9\x00\x27\xf6\x7f" +
+	"\x26\x37\x02\x04\xf1\x90\x4d\x21\xbb\x1c\x8b\xb3\x2b\x2f\x1a\x56\xe2\x14\x6a\x0b" +
+	"\xea\x0c\x85\x76\x65\xd2\x2d\x13\x54\xba\x20\xcf\x89\xf5\x4d\xb5\x58\x96\x3d\x5b" +
+	"\x0a\xba\x04\x68\x03\xf4\x40\x01\xac\x38\x9c\x01\x94\x8b\xe4\xfd\x60\x4f\x03\xca" +
+	"\x27\xf9\x00\xc4\xe0\x38\x20\xda\xc5\x37\x23\xfa\x3a\x33\xe0\x4a\x06\xee\x49\x1e" +
+	"\x8c\xa2\x41\x68\x21\x97\x84\xdd\x33\x27\x4c\xdb\x0d\x91\xec\xc3\xa3\x6a\x22\x02" +
+	"\xed\xfe\xad\x30\xf0\x2b\x0d\x5c\xdb\x07\xbc\xc4\x59\xc7\x06\x53\x72\xec\x47\xae" +
+	"\x5e\xc8\xd0\x7b\xbe\x20\x6f\x49\xca\xac\x70\x07\x15\x87\xa3\x2e\xbc\x0d\x69\xb5" +
+	"\x52\xa8\xac\xd6\x40\xdd\x5e\x5b\xf1\x75\xdf\xe2\xc5\xde\x1e\x3a\xae\x19\x40\xcf" +
+	"\x0a\x8c\x30\x52\x35\x4d\xa5\xc2\xb0\x86\xa3\xbf\x4c\x0c\x4d\xf1\xa7\x92\xfa\x17" +
+	"\xc7\xbe\x9c\x12\xca\xf2\x82\x13\x79\xbb\xb2\xe0\x3a\x63\x65\x00\xb0\x42\x74\xf5" +
+	"\x23\xa9\xa3\x68\x88\xcf\xe5\x49\xfc\x78\xce\x05\x72\xb0\x68\x53\xd2\xf9\x9d\xd6" +
+	"\x3c\x4f\x25\xbd\x6c\x7c\xe2\x43\xd0\x5c\x6b\xb6\xfe\xef\xbe\x28\xf0\x0f\x99\xfc" +
+	"\xa2\xaa\x3f\x95\x6c\xdb\x3e\x1a\xa7\x1c\xbf\xc5\xa5\x93\xc8\x33\x9a\x8d\xd0\x26" +
+	"\x07\x19\x8d\x82\x1c\x32\x9c\x07\x65\x9a\xee\xb0\x3b\x38\xd2\x00\x4b\x20\x3d\x92" +
+	"\x43\xa5\xfa\xc5\x06\x5e\xf2\x28\x34\xb3\xfc\xd5\x58\x8e\xd9\x2e\x44\xa0\xed\x95" +
+	"\x8a\xf6\x41\x97\x00\x2e\xa1\x43\x35\xdc\x1d\x80\x97\xaf\xfc\xb8\x3c\x80\x15\x1f" +
+	"\x5d\xcf\x26\x1d\xd8\x92\xd3\x29\xe7\x48\xd2\x34\x2a\xf9\x9e\xbe\xb0\x93\x05\xa0" +
+	"\x86\x0d\x54\x25\x86\x62\xc7\xbb\xa4\x0b\x91\xd7\x3d\x1d\x79\x4d\x03\xc0\x7a\xf8" +
+	"\x50\xe8\x53\x94\xe2\x87\x68\x8b\xdf\xf2\x21\xca\xb9\x9f\x34\x8d\x23\x92\x02\xb4" +
+	"\x49\xa8\x75\x55\x42\x23\xcc\x19\x3f\x3a\x7d\x3b\x95\xfc\xed\xff\xc8\x77\x2a\x99" +
+	"\xb3\x65\x82\xe4\xf1\x9d\x3c\xfa\x77\xfa\xc1\x7e\x46\xc9\x65\xbe\x4d\x25\x17\xfb" +
+	"\x4b\x95\x07\x23\x77\x5e\xbf\xaa\x5c\xb8\x7a\x80\x35\x15\x4f\x9c\x83\x77\xcc\x7e" +
+	"\x0b\x1a\xee\x84\xc4\x7a\xc0\x05\x15\x4b\x4b\x2f\xa2\x56\xce\xd5\xed\xc8\x0a\x49" +
+	"\x42\xa7\x9b\x94\xd0\x2e\xc7\xe4\x75\x4a\x8d\x13\x85\x33\xb5\xa1\x6e\x12\x35\x75" +
+	"\x61\x5a\x34\x5f\xf9\x72\x4f\x62\xe9\xe4\x8e\x11\x93\x7c\xf8\x98\x63\x88\xd4\xb7" +
+	"\x07\xcf\xc2\x58\xce\x77\xcb\x07\x54\x62\x2e\xfc\x0d\xb6\xa6\xb0\x79\x53\xe0\xfe" +
+	"\x0f\x2e\x81\x38\xac\xa1\xfc\xa7\xb9\x80\x88\xba\x39\x21\x24\xfe\x3b\xe9\x82\xd8" +
+	"\xe7\x25\x9c\x3d\xde\xe4\x7e\x9b\x7a\xf8\x66\xaf\x53\x62\x68\xb4\x56\xe5\x31\x1a" +
+	"\xd3\x2f\x65\x1f\x96\x8d\x96\xe0\xb4\x00\x7a\x45\x08\xb3\xfe\x3c\x1f\x4f\x6c\x83" +
+	"\x94\x3c\xd6\xe7\xcc\x94\x1b\x21\x87\xd7\xc4\xeb\xfa\xf3\x03\x92\xab\x96\x41\x21" +
+	"\x94\xd1\x5e\x74\x34\x26\x0e\xec\x63\xd0\x6d\x33\xbd\x12\xe9\x53\xa9\xcc\x02\xab" +
+	"\x0d\x88\x75\x66\x36\x26\xaa\x90\x3b\xce\x26\xc7\x82\xf9\x6f\xea\x7c\x3c\xcd\x4d" +
+	"\x46\x2c\x0a\xc7\xd1\xe4\x0e\xaf\x46\x14\x07\xc4\xfb\x42\xfe\x8b\xd3\x43\x13\x47" +
+	"\x46\xa3\x93\x31\xf8\x76\xa0\xd1\x03\x56\x71\x0e\x31\xb5\x8e\x2d\x62\x89\xfb\x17" +
+	"\x68\x00\x89\xe8\x7f\x65\x81\xa2\x39\x31\xae\x47\xe0\x6f\xae\xb7\xba\x56\xd6\x88" +
+	"\xa7\x52\x35\xf8\xe1\x12\xc9\x3e\xe0\x4b\xbd\x53\x5e\x0d\x77\x15\xaf\xcd\x7d\x7d" +
+	"\x85\x43\x67\x64\xa6\x38\x29\xe3\x54\x65\x7c\x78\x93\x73\xe0\xa1\xde\xf6\x2a\xed" +
+	"\xb2\x3e\xaa\x20\xce\x96\xfc\x20\xad\x20\xf1\xd5\xd4\xab\xce\x1b\x8b\xb8\x4f\x95" +
+	"\xb7\x3d\x50\xa0\x93\x94\x97\x62\x7b\xf8\x04\xae\x6e\x0f\x36\xc7\xfd\x0f\xdb\x1e" +
+	"\xd6\x09\xd5\xfa\xad\xc9\x0f\xc6\x92\xb0\xdb\xa7\x44\x4d\xd1\xb7\x54\x26\xab\xb5" +
+	"\xee\xfa\xd0\x0d\x40\x41\x34\x7b\xad\x9c\xb5\x94\xab\xa7\xe2\xf3\xd9\x89\xa5\xa1" +
+	"\x81\xa7\x56\xa5\xad\xb7\xc0\xef\x87\x28\x75\x47\xa9\x02\x86\x26\xeb\x9c\x53\x2a" +
+	"\x2e\x6d\xd3\xdd\xea\x7f\x16\x2f\x04\xdf\x5d\x34\xf8\xca\xf2\xbe\x11\x3d\xe8\xc9" +
+	"\xf7\x25\xde\xbe\x33\xa1\x22\x2b\x0e\xec\x41\x3a\x01\xa6\x5b\x8c\xf9\x40\xc9\xa9" +
+	"\x65\xfb\x04\xa5\x62\xe0\x4a\xdc\x1f\x71\xed\xee\x92\x71\x75\x89\xdf\xa3\x01\x51" +
+	"\x4e\x3f\x7c\x11\x88\x12\xe5\xad\xbf\xeb\xcd\x90\x75\x0c\x40\xa2\xef\x19\x43\x44" +
+	"\xe5\xe4\x0a\x4e\x8d\x0b\x18\xb5\x2e\x58\xb3\x26\xab\xf3\xf6\x2d\xd7\xcf\x93\x14" +
+	"\xd6\x77\x64\x86\xb2\x9c\x8f\x87\xc6\xd7\xf3\x3b\xfd\x70\x2b\x45\xe1\x98\x65\x39" +
+	"\xd7\x2f\xca\xc6\xa1\x29\x93\x5a\x77\x7c\xd4\xfb\xb9\xd1\x07\x72\x07\x00\x8d\x88" +
+	"\x99\x75\xa2\xb6\x62\xae\x0d\xb5\xbf\x96\x0b\x51\xb8\x15\x85\xba\x87\x28\xee\x03" +
+	"\x97\x1a\xb7\xa9\x9f\x4d\xf2\x4e\x4e\x14\x96\x93\xef\x8e\xb3\x59\x47\xc7\x39\x44" +
+	"\xce\x4f\x4d\xe5\xfc\x75\x73\xcf\x42\xef\xa9\xbc\x1b\x19\xe3\x52\x17\xd0\x3e\xad" +
+	"\xf3\xb4\xce\x37\x7e\xf3\x57\x4b\xa2\x2c\x5c\x03\x9e\xe8\x80\x43\xe9\xa3\xdf\x81" +
+	"\x88\x72\x7b\xd6\x69\x9d\x17\x7e\x58\xd0\x5f\xf9\xa6\xdc\xf0\x15\xc4\x16\xe6\xe6" +
+	"\xeb\xbc\x68\x70\x5a\xe7\x7b\x26\x0b\x59\xd3\x55\xe4\xc7\xa3\x1e\x4e\xe4\xcd\xe4" +
+	"\xae\x97\x2c\xa8\x52\x79\xe4\x1b\xd3\x81\x00\x26\xaa\x63\x61\xe9\x03\x8a\xad\x67" +
+	"\x40\x69\x58\x21\x5b\x92\xa5\x25\x21\x65\x24\x8b\xb3\x01\x7a\x84\x28\xe6\x96\xef" +
+	"\x3e\xc7\xb8\x3c\xc7\x47\x00\x9f\x63\xe6\x73\xaa\x37\x72\x9d\x9f\xf5\x81\xfd\xd6" +
+	"\x95\xef\x64\x9c\x44\x41\x99\x69\xac\x2c\x2e\xe3\x7c\xd5\x5f\x58\x4c\xc8\x56\xbf" +
+	"\xcc\x0a\x6a\x55\xc3\xa2\xf3\x3a\x67\x8a\x92\x59\x75\x5e\x67\x57\x2f\x13\x50\xba" +
+	"\x68\x87\x60\x78\x14\xc8\x05\x0b\x03\xb8\x38\x3c\x4f\xd4\x2f\x60\x10\x62\x6a\xa3" +
+	"\xa7\xc9\x7f\x71\x59\x39\x56\xfd\x02\x48\xf7\x4f\xa5\xf5\x88\x5a\xa7\xec\xd0\xc3" +
+	"\xf0\xf3\x4e\xfa\x37\x1a\xa4\x3f\xf3\x9d\x2a\xf9\x4e\xe7\x0e\xd1\xa0\xb7\xce\x58" +
+	"\x61\xa0\xda\x0b\xd9\xd0\xb9\xac\x73\x09\x53\xe9\x70\x58\x5a\x14\x51\x0b\x04\x3d" +
+	"\x34\xeb\x42\x01\x94\x70\x3e\xbe\x20\x8a\xb8\x9d\x07\x5a\x89\x16\xd3\x4f\xf2\x28" +
+	"\x3a\x32\xfa\x77\x57\x6e\x24\x31\x42\x8c\x75\xad\xc7\x73\x5a\xe7\x6d\xf6\xd5\x1b" +
+	"\x83\x9a\x8b\x19\x44\x13\x1a\x01\x36\x61\x83\x2e\x01\xba\x03\x36\x16\x74\x24\x1a" +
+	"\xe7\xa4\xab\x09\xa0\x4a\x32\x68\xb5\x6c\x58\x11\x1f\x34\x7e\x16\x63\x25\xa3\xd6" +
+	"\xbd\x65\xbd\x43\xec\xfb\xc0\x62\xf6\x38\xc0\xec\x0f\xf6\xe1\xeb\xc3\xeb\x69\x00" +
+	"\xeb\x40\x16\xbc\x4b\x09\xe8\x03\x2e\x17\xf6\x32\xb9\xc7\x93\x37\xcf\x8b\x8a\x63" +
+	"\xd2\x23\x71\xb5\x5f\xdd\xcb\xba\xce\xdb\x96\xfe\x3b\xd5\x92\x50\x4f\x74\x12\x21" +
+	"\x2d\xf7\xb5\x3b\x34\xea\x79\x54\x55\xca\x4e\xdf\x0a\x50\x58\xf7\x27\xea\xf1\x2c" +
+	"\x7d\x2c\x2a\xa0\xcb\x9b\xf8\xab\x30\xbd\x2c\xd2\x54\x41\x28\xef\x18\xb1\x22\x49" +
+	"\x61\x07\xc6\xdd\xef\xff\xf8\xc0\xcb\xa7\xa7\xfd\xe3\xf9\x27\xce\xa7\xc7\x4b\x1b" +
+	"\x29\x99\x02\xe2\x07\x44\x16\x5b\x97\x2b\xcb\xb2\x64\x60\x4b\xb2\x58\x03\x5d\x59" +
+	"\x86\x7e\xea\x89\x1a\x63\x8f\x11\x22\x34\xad\x8b\x18\x75\xbf\x47\x92\xd4\x43\x95" +
+	"\xd9\x1e\x52\x18\x08\xa0\x79\x4d\x78\xf4\xac\x40\xc5\xce\x7f\xce\x79\xa3\x36\x5a" +
+	"\x0e\x92\x9c\xe8\x65\x6b\xde\x43\xb2\x27\x47\x6d\xf1\x4e\xa8\x7b\x6d\xdf\x89\x51" +
+	"\x93\x15\x31\x55\x71\xf3\xb7\xb3\x7d\x4b\x11\xc4\x80\x97\xec\x3b\x1d\x3b\xeb\x82" +
+	"\x12\xf3\x97\xb5\x7d\x77\xeb\x78\xd0\xbf\x66\xc7\x7c\x65\xfd\x7d\x73\x62\x29\x60" +
+	"\x2f\xd1\x88\x68\xd3\xd5\x55\x01\x94\x19\xd7\x62\xaf\xb3\x95\xb4\xf3\xf6\xb5\xce" +
+	"\x87\xa8\xa8\x3a\x80\xc6\xeb\xca\x39\x01\x31\x8f\x77\x73\xc2\xc2\xb1\x21\x49\x61" +
+	"\xca\xd3\x88\xe5\xfc\xe2\xfe\xd3\x33\x82\x2e\x04\xed\xb7\x59\xed\xa7\xdf\x34\x68" +
+	"\xf4\x1b\x4a\x70\x69\x20\x66\x4c\x2b\x6b\x24\x38\x4a\xad\x7f\xcb\x71\xac\x24\xf4" +
+	"\xcf\xf6\xba\xe9\x65\xef\xd6\x62\x31\x0f\x89\x41\x5a\x38\xf2\xf7\xf9\xa6\xa6\x63" +
+	"\x60\x19\xc8\x03\x1f\x1e\xc6\xdc\x0d\x86\xf3\x99\x62\x3a\xd5\xff\xa3\xd7\x5e\xf3" +
+	"\x5e\xb3\x6b\x65\xd2\xe8\x2c\x9d\xc5\x91\xfd\x4d\xa0\x3d\xa7\x5b\xfa\xc4\xdd\x3d" +
+	"\x41\x2d\x45\x7c\x24\x7b\x78\x63\x3c\xbc\x31\xfa\xce\x29\x6b\x1e\x84\x2a\xab\x63" +
+	"\x1c\x5f\xbd\xb0\x0d\x7e\xee\xd3\xa8\x60\x58\x69\x60\x30\xa8\x38\xb0\xca\xb4\x31" +
+	"\x55\xbd\xeb\xf1\x4c\x61\x25\xf7\xd3\x53\xde\x1f\x5e\x1b\xa3\x3f\x7c\xfd\xc3\x6b" +
+	"\x58\x6e\xde\x92\xb2\xf6\x22\xb7\xbc\xb2\x1b\x2e\xc4\x40\x79\x34\xfd\x4b\x87\x64" +
+	"\xed\x81\xa2\xfa\x0e\xaf\x91\x43\xf7\x29\x0d\x60\xc5\x8c\xa3\x81\x7e\xfa\x7a\xf5" +
+	"\x8b\x7c\xc7\x19\xec\x75\x56\xc5\xcb\x90\xda\x3a\x9f\x28\xe4\x6a\x6e\xc0\xc9\xd1" +
+	"\x1b\x4d\xe5\x57\xa3\xf0\x6f\xb6\xf1\xe5\x37\xaf\x36\x70\x0a\x58\x59\x7f\xf3\xaa" +
+	"\x28\xef\x67\x12\x51\x87\xf8\x66\x1f\x6d\x9d\x91\x4d\xf3\xc2\x8b\x21\xd2\xa1\x4c" +
+	"\xec\xd7\xc4\x3a\xf8\xa0\x4a\xc9\x97\x07\x61\xe5\xed\xc1\x6a\xa8\x43\x7d\x48\x3b" +
+	"\x56\x0a\x34\xd1\xa4\xd1\x12\x97\x39\x21\xcc\x9f\x27\x07\x50\xd6\x34\x6b\x0f\x1e" +
+	"\xea\x37\x29\x1b\xf4\xd1\xef\xc2\x4a\x84\x1a\x4d\xe8\xeb\xe5\xdb\x00\x73\xf9\x80" +
+	"\xe5\x3d\x3c\x43\xb4\xaf\x17\x82\xc9\xd5\xbb\x72\x8b\xea\x1a\x24\x36\x36\x33\x44" +
+	"\xec\xf9\x38\x6a\x26\x57\x32\x52\xf8\x77\xba\x38\xc3\xa3\x67\x86\x5e\xb1\x28\xd4" +
+	"\x32\x8a\x01\xe2\x5f\x4c\xe3\x1c\xda\x05\x95\x61\xbe\xd1\xab\x06\x4a\xce\x33\x92" +
+	"\x5e\x1e\x52\x6f\x9e\x86\x2c\x60\x58\xba\x47\x14\xc6\x57\x37\x04\x53\x6b\xf3\xc6" +
+	"\x02\x2e\x3e\xfe\x63\x11\x52\x4b\xdf\x38\x87\xff\x1f\x8f\x59\xa6\xe9\x26\x19\x3a" +
+	"\xba\xc9\xf8\xd1\x4a\x9f\xc5\xbf\x5e\xea\xa2\x9a\x85\xaa\x3c\xa5\x2e\x4d\x73\x96" +
+	"\x40\x87\x87\x93\x4d\xb1\xe7\x28\xfc\x4f\xe5\x58\x30\x74\x04\xa0\x71\xa6\x42\x76" +
+	"\xa2\x88\xf0\x19\xa1\x95\x6a\x20\xc5\x1c\x40\xa1\x68\x11\x41\xcd\xc9\xcd\x89\xc2" +
+	"\x6a\xd3\x62\xde\x1c\x58\x7f\x12\x68\x5b\xf4\x90\x2b\x16\xa8\xca\x00\x1e\x47\x93" +
+	"\x41\x81\xbf\x2e\x6f\x23\x19\x88\xe7\x5d\x2b\xe1\xba\x2c\x46\xc4\x0a\xcb\x9c\x95" +
+	"\xf5\x53\x23\xce\xb3\xd4\xa5\xd8\xec\xd7\xe1\xf0\x26\xc6\x11\x58\x58\xfa\xf7\x21" +
+	"\x1e\x43\x6b\xbd\x6b\xda\x4e\xd8\x27\x19\xb5\x5b\xda\x66\xed\x6a\x07\x26\xa6\xb4" +
+	"\x50\x97\xdc\x53\xe0\x63\x00\x8b\x94\xed\xb5\x6a\xb7\xec\x2f\x55\xbb\x9d\x1e\x53" +
+	"\xae\x22\xb2\x66\xb2\x26\x6c\xb5\xcb\xa5\xec\xda\xc8\x31\xa2\x84\xf4\x0d\xbe\x72" +
+	"\x56\x06\x1f\xbb\x98\x2b\x77\x37\x30\xdd\xc7\x9c\x9b\x25\xa0\x84\x19\x7c\x52\x47" +
+	"\xc8\xaa\xcb\xa0\x45\x57\x09\x7c\xf4\x30\x1a\x8c\x08\xb7\x28\x3d\x69\x35\x60\x20" +
+	"\x93\xd2\xb3\xf7\x9e\x67\xe5\xd0\x23\x0a\x99\x1e\xf3\x57\xc5\x74\x4c\x20\xbd\xb0" +
+	"\x54\xaf\xf3\x98\x5b\xa5\x83\x66\xdb\x06\xf0\xf7\xde\x3c\x0a\x4f\xfa\x1b\x8e\xc4" +
+	"\xa1\xb7\xe4\x68\x18\x43\xc1\x6b\xc1\xfa\x6b\x8e\xa7\xb5\xc1\xe3\x49\x83\xb7\xf0" +
+	"\x67\x5d\x3e\xc5\xdd\xab\xf2\x61\xd8\x49\x23\xf9\x80\xaa\xb2\x43\x6f\x26\xfd\xb7" +
+	"\x88\x95\xb6\xd1\x88\x8f\x56\x77\xee\xae\xbc\xe0\xa1\x17\x13\x35\x47\xa7\x75\x5a" +
+	"\x89\xc0\xf7\xb7\xb9\xdc\x5c\x20\xe0\xaf\xe7\x80\xce\xee\xb7\xba\x3b\x1b\x18\x91" +
+	"\x7f\xb8\x4a\x71\xe5\xaf\xcc\x0b\x16\xf4\x56\x5d\xf7\xad\x09\x52\x5e\x74\xa5\xf7" +
+	"\x00\xfc\x29\x79\xd1\x06\x34\xa0\x9b\x8b\x85\x22\x05\x60\xce\x0e\xc2\x85\x30\x50" +
+	"\xac\x3c\x89\x72\x68\x69\x25\x16\x5a\x65\x02\x22\x3a\x0f\x36\xb9\xf9\x8e\xa0\x92" +
+	"\xe4\x5b\x36\xe8\xe2\x40\x7f\x78\x0e\xd5\xf8\x61\xe9\x03\xf9\x6f\xf2\x29\x08\x7a" +
+	"\x19\xcb\x92\x7e\x44\x94\x1f\xfb\x57\x92\x50\x31\x8a\x2f\xf1\x0e\x24\x26\x67\xc3" +
+	"\x8e\x01\x9c\x45\x07\xae\x3d\x81\x59\x0c\x56\xda\x20\x56\x92\xf2\xad\xb4\x8b\x9c" +
+	"\xb4\x71\xa0\x25\x10\xfd\x0a\x90\x5a\x29\x25\x7b\xdb\x19\x50\x89\x31\x76\xcc\x9c" +
+	"\x46\xf9\xc0\x23\xc0\x00\x2e\x83\x5d\xb6\x3f\x9a\x7b\x46\x2c\x6b\x76\x01\x56\xb4" +
+	"\x18\x28\x8f\x0d\xac\xd9\xec\xf6\xae\x6e\xb1\x1c\x6a\xaf\x3c\x06\xfe\xf8\xb8\xd4" +
+	"\xd1\x3b\x34\xb4\xe2\x2f\xd5\x47\x0f\x6b\x19\x34\xd7\xec\xd7\xe8\xac\x3e\xf9\xb6" +
+	"\xd1\x44\x83\xb1\x13\x45\xc9\x72\x7c\x72\x91\x37\x9b\x26\x18\x65\x1d\xc9\xa1\x2a" +
+	"\x7d\xf3\xb9\x03\x2c\xeb\x3a\x0d\x79\xe9\x6c\x16\xe1\x1f\xed\xba\xa3\xdf\xbf\x51" +
+	"\x1d\x77\x47\xd6\xe7\x89\x49\xff\x92\xb7\xf1\xa5\x6e\xd0\x79\x12\x57\x01\x78\xcb" +
+	"\x72\x9b\x6f\x74\xf3\x46\x9d\xe1\x6f\xe0\x80\x2a\x25\x57\x3e\x41\x1a\xef\xbe\x88" +
+	"\x4a\x0a\xb0\x92\x81\x14\x8b\x29\x60\xee\x5f\x09\x63\xb2\x12\xee\xb7\xf1\x59\x90" +
+	"\xfb\x2d\x95\xe2\x67\x77\xd6\xdc\xb1\x25\x79\x0f\xf0\x0a\x26\xb6\x02\x76\x6f\xbc" +
+	"\x1f\xbe\x08\x44\x75\x18\xe8\x2a\xd0\x81\xde\x86\xac\x1a\xba\x66\xc1\xb9\xfb\xfd" +
+	"\x98\x04\xd9\xa5\xa5\x0a\x74\xdb\xe3\x2b\x67\xbc\x77\x18\x1f\x67\x48\xcd\xeb\xba" +
+	"\xef\xff\x78\x2c\x06\x9b\xc1\x41\xed\xc9\xbb\x26\x89\xb9\x7f\xae\x25\xe0\x63\x1f" +
+	"\x1e\xc3\xa3\x7c\x55\x49\xa0\x79\xd4\x96\x30\x19\xe2\x72\x5d\x93\xb3\xcc\x5d\x9c" +
+	"\x56\xf2\xee\x85\x80\xfa\x48\x16\x9e\x77\x40\xf1\x14\x5d\x07\x6a\x9c\x6e\xc1\xd8" +
+	"\xf4\x97\x09\x9a\xa4\x5a\x70\x3f\x6a\xa5\xbf\xd1\x0a\x91\x75\x17\x06\xa3\xc8\x3e" +
+	"\x51\x06\xb0\x10\x48\xb0\x79\x95\x9a\x36\xf2\xb5\xa0\xfc\xaf\xcf\x2b\xe1\x1f\x46" +
+	"\xff\xe0\xb0\xf9\x80\x8f\xc1\xd7\x76\x2a\x2e\xb0\x04\xb2\x67\x3e\xc8\x14\x6d\x1f" +
+	"\x27\x24\xf3\xcb\x4f\x85\xfd\xd5\x53\x60\x9d\x60\xcd\xbf\x96\xeb\xaf\xbf\x60\x7e" +
+	"\x43\xfc\xca\x5e\xc4\x6a\x7b\x11\xab\x5f\x1e\x00\xed\xd3\xca\x86\xdd\x5a\xbf\x26" +
+	"\xb7\x71\x24\xa2\x97\x42\xc0\xf7\xa9\x8d\xfb\x37\x82\xf5\x32\xa2\x18\xb4\xb2\x2d" +
+	"\x82\x23\x06\x3b\x08\x44\xf4\x4e\xa6\x6a\x96\x5b\xf9\x26\xac\x0b\x9f\xa8\xb8\x12" +
+	"\xe4\x68\x37\xc5\x84\x71\x21\x92\x5b\xf8\x24\xa8\x0b\x3b\x39\x69\x00\xfe\x34\xe3" +
+	"\xa9\x36\x0c\xf2\x06\x81\x98\x52\x0f\x74\x04\x3a\x3c\xca\x16\x3b\xed\xe1\xb3\x54" +
+	"\x2d\x2b\xfe\x4a\x11\xcf\x40\xff\x67\x5d\x2e\x3d\xbd\x4f\x4f\x77\x9f\x3d\x00\xea" +
+	"\x7a\x2a\x80\x16\x32\xf1\xbf\xfd\x39\xd0\x3a\x76\x1a\x69\x9c\xaa\xd7\xe5\x54\x47" +
+	"\x82\xcd\x50\x73\xf5\x0f\x61\xe8\x70\xc5\x70\xa8\x11\x4b\x04\x93\x87\x2b\x5b\x0d" +
+	"\x38\x37\x4f\x51\xc8\x58\x16\xaa\x74\x8a\xaf\x79\xb1\x22\x50\x69\xaf\xb3\x4d\xa6" +
+	"\x1c\x07\x52\x0f\x22\x1e\x9d\x66\x5d\x57\x05\xc0\xdb\x58\x8c\x09\x3a\x18\x04\x63" +
+	"\x4f\xdf\xbb\x0d\xc7\xc1\x65\x30\xa9\x5b\xf2\xf4\x4d\xa7\xd7\xa9\xca\x22\x64\x8f" +
+	"\x22\xa8\x14\x68\x28\x1b\xca\x81\xa2\x62\x31\xd9\x61\x95\xa4\x6e\xcc\x55\x94\x7a" +
+	"\x3d\x30\xfd\xd9\x03\x8a\xd7\xb5\xb4\x52\x37\x8a\x13\x54\x12\xfd\x68\x2e\x7b\x60" +
+	"\x69\x7c\xad\x9c\xd9\xdd\x8e\x01\xd4\xfe\x8c\x87\x3c\xef\x9b\xee\x1c\x02\x22\x5e" +
+	"\x37\xdb\xb5\x05\x49\x80\xc7\xf0\x67\x88\x07\x50\xe7\xcd\x1e\xce\xb4\xd6\x9d\x9f" +
+	"\x56\xbb\x74\x81\x3c\x9f\x00\xfa\x93\x9a\x0f\x5e\x61\x15\x4c\x01\xc5\x29\xd1\xb9" +
+	"\xa6\x1b\x92\xd8\x78\x01\xa0\x14\x83\x05\x46\x9e\xab\xed\x6c\x03\x00\xba\xde\x12" +
+	"\xd9\x13\xbb\xac\x89\x19\x52\x2a\x5c\xe6\x00\xd1\x3e\x49\x00\x0b\x89\x96\xd9\x67" +
+	"\xfd\x39\x2c\xc7\x38\x21\xbc\xff\xc7\xf5\x2f\x03\xa8\x1d\x32\xb4\x57\x6f\xfb\x18" +
+	"\xf7\x94\x0b\x8f\x71\x0a\x43\x86\x8d\x3d\x51\x41\x71\xf3\xdd\x5c\xf3\xe8\x1f\x81" +
+	"\xa8\x36\x00\x36\x59\xa8\xf1\xae\x1c\xf9\x65\xef\xb0\x81\x65\xa0\x11\x68\xf5\x6b" +
+	"\xd0\x93\x7b\x85\x2a\xff\xe5\x71\x71\x4f\x49\x25\xb7\xe6\x76\x70\xf9\x39\x7d\x7c" +
+	"\x3d\x6a\xbb\xbd\x6f\xe5\x13\xba\x98\x67\x51\x9b\xbd\x74\x70\xd4\x75\x57\x6f\x94" +
+	"\x07\xb7\x40\xab\x02\x54\x3b\xa7\x5e\x46\x4c\x93\xae\x66\x9a\x14\x42\x44\x0e\x34" +
+	"\x64\x6f\x97\x98\xbb\x34\x01\xfb\xbe\x44\x44\xfc\xc6\x33\xa0\x21\xbd\x38\x6d\xce" +
+	"\xd6\xb4\x0a\xe0\xf2\x98\x6f\x83\x1c\x6c\x09\x21\x72\x68\x21\x0a\xea\xa2\x5a\xe3" +
+	"\xf1\x9d\xbb\x75\x18\xb1\x5a\xbd\xf5\xfc\x4c\xab\x6e\x93\xc0\x95\x5f\xe9\x9e\x4c" +
+	"\xba\x9d\x2c\xa2\xef\x69\xe5\x10\xec\xe6\xa4\x8b\x6a\x4c\x3e\x91\xd7\x26\x2d\xc4" +
+	"\xa2\xb3\xb0\xa9\x9e\xca\xfa\xa9\xb3\x1c\xd9\x5c\x33\x29\xcb\x9f\x82\xcf\x57\x25" +
+	"\x80\x71\x46\xcf\x02\xb4\x18\x6c\x00\xdd\xb2\xc0\xa3\x2f\x75\xaf\xf2\x14\x7c\x0f" +
+	"\x60\x05\x28\xae\x60\xa7\x79\x1d\x06\x50\x93\x13\x62\xf8\xd5\x2d\xeb\xa9\xae\xeb" +
+	"\x64\x69\x9d\xfd\x3d\xdc\x65\xe6\xed\x39\xb7\x7c\xcb\xfb\x5c\x2e\xe4\xb3\xbc\xcb" +
+	"\x4b\xc4\xcd\x8d\x30\xac\xfe\x44\xd2\xb3\x59\xcf\xa6\x29\xb0\x88\x0a\x28\x30\x38" +
+	"\x2d\x44\xac\xa0\xf0\xd4\x16\xc4\x13\xfd\x26\xed\xb6\x9e\xcd\x89\x25\xb2\xfa\x32" +
+	"\xba\x10\x72\x5d\xad\x54\xc4\xbc\xd6\xb7\xa3\xce\xb2\x9e\xfd\xc9\xd5\x3c\x03\x48" +
+	"\xc0\x3e\x05\xcf\x38\x5a\xe6\xc8\x83\xb4\x54\xe8\xc0\x58\x2a\x1e\x44\xde\x6f\x9c" +
+	"\xc2\x7a\x9c\x88\x78\xde\xe6\x68\x7c\x8d\x32\xd2\x97\x4e\xeb\xc9\x27\x8b\x88\xff" +
+	"\x57\x63\x5b\x85\xf3\xdf\xab\x8e\x7e\xaf\x5c\x1c\x3b\x07\x62\xad\xd1\x2e\x66\x59" +
+	"\xcf\x58\x59\x3c\xe3\xba\x3c\x58\x96\x8c\x76\x29\x4e\x3f\x5c\xbc\x9e\x7f\xff\xf2" +
+	"\xfc\xef\x96\x99\xef\xbd\x65\xb8\x7d\x7d\x8f\x51\xc6\x50\x3d\x3f\x21\xbd\xe8\xdb" +
+	"\xc7\x9e\xb7\x8d\x3c\x6f\xd7\x15\x40\x28\xdf\x34\xfc\xbf\x43\x81\x7e\x7d\xd3\x7b" +
+	"\xbd\x8f\xa7\x04\x21\x14\xe0\x7d\x3c\xaf\xef\x6a\xf7\x90\x2e\xaf\xd9\x36\x3f\xa6" +
+	"\x6d\xb6\x97\x60\xb3\xfd\x45\x08\x01\xf9\x95\xa6\x4d\x3a\x8a\xdb\xbc\xba\xab\x5e" +
+	"\x4e\xe0\x2d\x82\x1c\x9f\x37\xd3\xa6\xcb\xc5\xd0\x5f\xdf\xe6\x1d\x55\x71\x23\x84" +
+	"\xb7\x9b\x0c\x9a\x06\x10\x8b\x79\x4d\xd0\xc5\x01\x55\xef\xe8\x15\x81\x28\x9f\x38" +
+	"\xac\x4d\x40\xaf\xbe\x83\xe0\x58\x01\x40\x2a\x56\x9f\x0b\xee\x01\xf6\x4b\x98\xfb" +
+	"\xfa\x81\x8f\x4f\xdc\x2f\xcf\x91\xd3\xa7\xef\x8a\x5e\x2e\x18\x02\x41\x7f\x50\x10" +
+	"\x76\x0b\xa0\xdb\x28\xef\x71\xfa\x98\xe8\xd0\x12\x60\xc6\x23\x86\xb2\x61\x83\x0b" +
+	"\xf6\xd9\xd3\x0c\xb1\x7f\x75\x9b\x59\x76\xdc\xb8\x98\x6c\xda\xe6\x97\x4d\x8b\x03" +
+	"\x2c\x81\xec\x81\x5f\x31\x43\x76\xa8\x1a\x7a\x91\xe6\x7b\x42\x41\xea\xcb\x08\xf5" +
+	"\xc5\x7d\xb3\xcd\x48\x3f\x44\x95\x44\x9a\xe5\x61\x14\xdc\x92\x8c\xd1\x69\xfa\x64" +
+	"\x18\xcd\xad\xe4\x7c\xeb\x4c\x02\x4b\xfa\x9b\xef\x17\x01\x05\x7c\x1f\x59\xc2\x0c" +
+	"\xd2\x5b\x4a\xcc\xe8\x20\x96\x4d\x5a\x4a\x72\xd2\x83\x5e\x40\x39\x72\x01\xda\x96" +
+	"\x78\x4a\x23\x6a\x0d\xc9\x8f\x10\x06\x28\x2e\x63\xcd\x22\x3d\xd0\x4a\x0d\xaa\x0c" +
+	"\xc5\xb1\x3c\x1f\x71\x72\x3f\xe0\xe1\x48\x67\xda\xb6\xf4\xa8\x47\x9e\x3f\xe0\xf1" +
+	"\x81\x96\x01\x3d\xad\x1a\x16\x65\x3e\x70\x1f\x01\xb5\x7d\x40\xd8\xf9\xf1\xfc\xaa" +
+	"\x36\x81\xf8\xf4\x5c\x41\x96\xac\xf0\xd9\x2e\xf4\x19\x83\xab\xb0\xb7\x94\x99\x89" +
+	"\x4c\x1b\x9a\x1d\x5b\xda\x9f\xe9\xc7\x09\x61\x8d\x36\x97\xb8\x42\x46\xa4\x9e\x83" +
+	"\xaa\x9e\x84\x36\xbd\xb0\xec\x1f\x63\xa2\x5e\x75\xd4\xfd\xa1\x38\x2a\x65\x7e\x24" +
+	"\x10\x30\x60\x8f\x7f\xc8\x5a\xbe\x53\x8b\x20\x88\x08\xad\x5b\x64\x74\x2b\xf2\x50" +
+	"\x44\x88\x89\xf0\xae\x03\x0e\x17\x3e\x7c\x76\x18\xf0\x6f\x32\x68\xf2\x1c\x4d\x7d" +
+	"\x7a\xf7\x92\x7e\x64\x97\x71\x4b\x3f\xb7\xca\xca\x58\x00\x05\x99\xdf\x1e\xe8\x47" +
+	"\xcf\xf9\x53\xde\xea\x13\x38\x8b\xb6\xa5\x9f\x7e\xe3\x42\x2c\x00\x2a\xad\xa9\x7d" +
+	"\x40\x0b\xdf\xe7\x3d\xa0\x46\x9c\x2d\xfd\x1c\xd3\x96\x75\x15\xa8\x91\x65\xda\xf2" +
+	"\x8d\x55\x5d\x48\x93\x87\x24\x33\x63\x87\xdc\x8e\xe7\x5b\x0e\xef\x69\xbe\x7d\xd3" +
+	"\x19\x0e\xa0\xf8\x28\x39\x21\xce\xa6\x9c\x5c\x22\x3d\x9e\xc7\xad\x9c\x5f\x86\xee" +
+	"\xe8\x2e\x08\x71\x6f\xa6\xf1\xd0\x0a\x75\x44\xf6\x8f\xe7\xa1\xdd\xe1\x81\x3c\x11" +
+	"\x26\x22\xd3\x96\x89\xce\x14\x61\xda\xf2\x96\x8e\x94\x38\xcf\x21\xa8\xb7\x35\xef" +
+	"\xd3\x96\x77\x26\x26\x46\x60\x1d\x66\x1a\x7c\x8b\xef\xdc\x8d\x13\x7a\xe6\xb2\x62" +
+	"\x29\xd0\xf1\xf3\x89\xb0\x55\xb6\xc2\xd9\x21\xd9\xea\x32\x6d\x5c\x8b\x6a\x44\xed" +
+	"\x81\x4f\xdb\x8d\xef\x46\x58\xc4\x61\x1d\x5d\xae\x79\xd4\x93\x54\xec\x88\x6f\x15" +
+	"\xed\x1e\xb9\xb4\x09\x28\x22\x6b\xda\x6e\xae\x8b\xbd\xa1\x69\xa6\x7c\x56\xdf\xe7" +
+	"\x36\xce\x11\x41\x6a\x94\x75\x98\x8e\x76\xa6\xbd\x85\xe0\xe4\x82\x49\xc5\xd9\xf0" +
+	"\x6d\x69\xb4\xa8\xba\xa5\x5f\xb7\xaf\xb3\xd5\x6d\x6f\xf5\x9e\x0f\x24\x3a\xfa\xbf" +
+	"\x02\x47\xdd\xa7\xad\x56\xf5\x53\x22\xc4\xaa\xdf\x38\x92\x68\xab\x1c\x6f\xc2\x86" +
+	"\x68\xc1\x61\x82\x8c\x40\xa2\xd0\x8a\xd5\xfe\x02\xa1\x69\x6f\xec\xa6\xfa\x68\xc7" +
+	"\x93\xc3\xbe\x8e\xd4\x8e\x2b\xba\xf9\x1b\x77\x0e\x94\x47\x9e\x07\xba\x09\x35\xfe" +
+	"\x64\xa3\x12\x22\x0d\x3b\x81\x6b\xd4\xfc\xcd\x88\xd7\x0d\x2b\x4c\x1c\x46\xfb\x62" +
+	"\x61\x27\x00\xb1\xad\x16\x0f\x65\x77\xa4\x1f\x8a\xa0\x88\xec\xfc\x5a\x4f\xc1\x5b" +
+	"\x9b\x22\x79\x83\x6f\x87\x8b\x53\x97\xb2\x36\x70\x1d\xe4\xfb\xc7\xd3\xdd\xe7\x91" +
+	"\xee\x6f\x27\x11\xc9\x89\xc7\x72\x79\xce\x38\x31\x68\xf3\x79\xc2\xe4\xe7\x42\xaa" +
+	"\xb9\xe3\x27\x1f\xcf\xb1\xb4\xb4\xd5\x53\xb1\xce\xc6\x71\x10\xa7\xca\x4e\xc8\x45" +
+	"\x80\x7c\xff\x4e\x1b\x0a\x80\xe6\x6a\x5c\x76\x40\x0a\x2f\xd6\x5e\x44\x94\xf9\x2b" +
+	"\xf3\xc2\xd6\x9f\x79\xe1\x52\x99\xed\x9c\x37\xfc\x22\x8b\x53\xd8\x37\xce\x59\x18" +
+	"\xcd\x46\x28\x30\xea\x5c\x1b\xc7\x3b\x81\x7c\x60\x6c\xa7\xee\x2e\xd8\xce\xdc\x3d" +
+	"\x21\xde\xf9\x73\xfd\x92\xa3\x2d\x69\x03\x2a\xf0\xb9\x7e\xd9\xdf\x20\xe3\x5b\xc5" +
+	"\x81\xf5\xb0\x20\x55\xca\xb9\x4d\x9b\x9e\x1d\x23\x29\xe4\xd0\xb4\xaa\x09\xb5\x44" +
+	"\x9a\xbd\x52\x46\x17\x07\x16\x74\x4c\xd2\x8d\x4a\xdb\x05\xfa\x74\xa9\x4b\x0d\xa4" +
+	"\x0c\x0d\x2b\x4b\x46\x86\xf3\xe8\xba\x90\x69\x7b\xb3\xf9\xb8\xbd\xd7\xfd\x39\xd5" +
+	"\xf9\x9b\x5f\x9f\xea\xdc\xf8\x01\xb8\x4c\x00\xed\x5e\x39\x82\x2b\xf6\xfb\x2b\xd7" +
+	"\x52\xd5\x2f\x59\xab\xbb\xa5\x40\x07\xa0\xcb\x2b\xf3\x36\xf5\x2b\xbd\xf1\x70\xb4" +
+	"\x12\x42\xb1\xab\xae\x28\x6c\xf0\xdd\x60\x9b\x01\x4a\x3c\x90\xda\xec\xf0\xf5\x08" +
+	"\x78\x0d\xa6\x66\x0f\x03\x5f\x02\x96\x6b\xf1\x09\x96\x23\x4b\x3a\x60\x0f\xcc\xd6" +
+	"\xa5\x60\x72\xe2\x11\xac\x94\x28\x7b\x5b\x80\x2b\x65\x5f\xd0\xe4\x5e\x91\xec\x4b" +
+	"\xd5\x5a\xbf\xb6\xfa\xeb\xa8\xdf\x38\x74\x6a\xd4\x4a\xd4\x6f\xfc\x8b\x4e\x17\x07" +
+	"\x1e\x94\xda\xcb\x9f\x57\x70\xbb\x5d\xf0\x08\xe4\xd5\x33\xf0\x5c\x3e\x43\xfa\xf0" +
+	"\xd8\x33\x81\x55\xb1\x02\x6d\x80\x90\x4b\x01\xec\x51\x3b\xad\xd4\x88\xc7\xd2\xfa" +
+	"\xf3\x85\x22\x94\xd9\x5b\xfd\x42\x4f\x42\xf5\xd1\x0f\x36\x14\x06\x90\x94\xbe\xa4" +
+	"\x2d\x10\x13\x57\x03\x58\xc2\x61\x0f\x6b\xaa\x5f\x2c\x42\x38\x51\x58\xe8\x2c\x1a" +
+	"\x8b\xb4\xa2\x5e\x5f\xde\x69\xd4\xdb\x4d\x06\x38\x06\x98\xcb\x07\xfc\x10\xe8\x20" +
+	"\x0c\x61\x41\x76\x59\x09\x75\x34\x58\xb4\x5e\xb1\x0e\x4f\xd0\xe1\x5c\x3e\x3c\x2e" +
+	"\xc3\x62\x94\x03\xe7\x78\x7a\x5a\x26\x05\x35\xf9\x5b\x73\x41\x6e\xc5\xb9\xa5\x0f" +
+	"\xd8\x1d\x0f\x79\x18\xa8\x74\xdc\xa6\x7a\x3b\xd8\x71\xac\xb7\xa3\xee\xb4\x9d\x65" +
+	"\xe1\xa7\x96\xb1\xa0\xd6\x55\x97\x05\x6e\x9a\xea\x52\x9b\x1c\xbc\x5c\x73\x52\x97" +
+	"\x77\xef\xbc\x5f\xf7\xbb\x65\x07\x5d\x9c\xa4\x01\xda\x00\xdd\x91\x4a\x01\xea\x1e" +
+	"\x89\x11\x4b\x41\x32\x6a\x02\xe2\xcb\x57\x3c\x76\xd4\xa1\x7c\x97\x33\x32\xd6\xfb" +
+	"\x5d\x3a\x56\x95\x5b\x32\x71\xd2\x41\x70\x7f\xa2\xef\x0d\xd8\x89\x3a\x55\x7d\x7b" +
+	"\x56\x3e\x3d\x2b\x33\x07\x2c\x01\xa8\xe7\x66\x75\xb8\xc9\xed\xc7\x54\x8b\xf2\xc3" +
+	"\xed\x53\x2d\xef\x75\xcf\xb7\xa0\x7d\xaa\xe8\x56\xe0\xa2\x0d\xca\x72\xef\x54\x5d" +
+	"\x6f\xda\x29\xe9\xfa\x56\xc9\x00\x0a\x44\x9c\xad\x85\x65\xaa\x61\xde\x5a\x1b\x9b" +
+	"\x75\x7b\x54\xf3\x28\xca\x66\xf2\x9b\x3d\xd0\x56\x2a\xd6\x09\xfa\xc4\x44\xe2\xc0" +
+	"\x35\xb5\x45\x10\xf7\x07\x4d\x55\x06\x3e\x9c\xf4\xa9\xd6\xbf\x09\x47\x09\xb2\x56" +
+	"\x53\xf7\x59\x3d\xc6\xae\x43\x21\x35\xee\xd8\x04\x50\xd2\x9d\x93\xe9\xbc\x67\x5c" +
+	"\x3f\x60\xae\x7b\x78\x97\xf6\xe8\x58\x0c\x44\x88\x25\x1e\xbb\x05\x03\xcd\xe5\xc2" +
+	"\x43\xf4\x15\xc9\x58\x75\x0a\xd6\x36\x00\x72\x99\x47\x0e\x2a\xc6\xb9\x4e\x75\xdf" +
+	"\xab\xee\x86\x08\x40\x00\x66\xfa\xcf\x8d\xc1\xf5\x03\xf3\xef\xec\x58\xea\x77\xb2" +
+	"\x38\xb5\x08\x2c\xd5\x38\x38\x06\xcb\xcb\xcd\x56\x58\x0f\x4a\xcb\xd8\x87\xd1\xfd" +
+	"\x80\x2a\x3e\xd9\x59\x35\xf0\xbd\x21\x57\xcf\xaa\x53\x14\x78\x57\xf7\xae\x2c\x95" +
+	"\x38\x38\x06\xf0\x38\x6b\x6c\x94\xb8\x47\x29\x6b\x8b\x15\xaa\x52\x8e\x60\xaf\xcc" +
+	"\xa8\x49\xfa\x85\xfd\x94\x61\xc6\xaa\xed\xd2\x89\x65\xef\xc9\xd7\xc0\x59\xa5\x3d" +
+	"\xcc\x15\x13\x83\xa8\x24\x07\xa3\x4f\x15\x5d\x93\xb6\x04\x35\x46\x42\x15\xfd\x42" +
+	"\xe5\x82\x1f\xe1\x7a\x64\xf4\x47\x83\x10\xb6\xa8\x83\x6c\x8b\x77\x90\x8d\xab\x70" +
+	"\x44\x98\x7a\xd6\xb6\x64\xd3\xe6\x1e\xa8\x3b\xd4\x47\xbf\x43\x04\x11\x79\xcc\x24" +
+	"\xf5\xb0\x1e\xa7\x3d\x86\x72\x97\xf1\xba\x8b\xe8\xea\x00\x47\x6b\xb0\xd6\xee\xac" +
+	"\xa8\xd3\x0f\xcf\x5c\xfe\xf1\x0e\xc9\x48\xef\xef\x48\xef\x6f\x6a\x17\xba\xe4\x06" +
+	"\x52\xdb\x21\xdc\x38\xfc\xb9\xed\x41\xf9\x98\xd4\x38\x51\x6e\xf8\xa3\x20\x60\xe5" +
+	"\x90\x1f\xaa\x2a\x88\x09\x09\xa8\x13\x75\x5c\x2e\xd8\x03\x1d\xe9\x42\x91\x8a\xf4" +
+	"\xbf\x03\x5a\xa1\xc0\xc4\xe1\xa3\x50\x65\x31\xa0\x8c\x0c\x5a\xa8\x92\xdb\x9f\xaa" +
+	"\x69\x88\xfa\x0c\x21\x95\x11\x80\x20\x3a\x32\xf5\x77\x80\xf7\xc4\x3d\x64\x32\xd4" +
+	"\xe3\x50\x13\x7f\x86\x02\x3e\x13\x6e\xcf\x49\xdb\x17\xdd\x88\x4e\xf2\xe9\xf0\xcc" +
+	"\xa4\xcb\xcb\xab\xf5\x50\xa7\x1a\x02\x6e\x9f\xb0\xb6\x27\x27\x95\x17\xcf\x72\xf2" +
+	"\x0e\x9f\x5a\x2d\xa8\xa7\x75\x64\xa7\xdf\x60\x1a\x00\xa9\xe3\xeb\x6c\x92\x34\x20" +
+	"\x96\xbe\xbd\xa1\x5c\x39\xec\xd4\x02\x98\x65\x9c\x87\xa9\xcd\xd7\xf3\x58\xaa\xbc" +
+	"\x98\x40\x77\x2a\x31\xfe\xf3\xf3\x30\x0d\x0b\x81\xc3\x89\x42\xbd\x2f\x3e\x8f\x07" +
+	"\xba\xc3\x95\x75\xd8\x87\x13\x85\x73\xe6\x81\xaf\x01\xc1\x1f\x27\xf6\x14\x9c\x05" +
+	"\x17\x65\x64\x10\xb1\x18\xea\xc8\x8d\x2e\x01\x22\xc4\x53\x67\xb0\x11\x87\xaf\x29" +
+	"\xe8\xdb\x03\xc6\x0c\xbe\x9e\x47\x5c\x36\x03\xc4\x50\x2f\xf4\x0c\xa2\x04\x9b\x57" +
+	"\x24\x74\x09\xa0\xb1\x89\x49\xb7\xfd\x29\xf4\x25\xe7\xa1\xc1\xe4\x3c\x38\x1c\xd5" +
+	"\x06\xb0\x90\xef\xdc\x5c\xa6\x9e\xed\xe6\xe5\xe8\x7b\xfd\x66\xac\x3b\x8f\xf1\x75" +
+	"\x04\xf7\x68\x98\x30\xb1\xe2\xd9\x38\x64\x94\x86\x7d\xda\x71\xd0\x01\xfa\x54\x59" +
+	"\x77\xac\x2f\xf6\x34\xc3\x54\x70\xe5\x0a\x60\x1c\x42\xf9\x1e\x29\x41\x8d\x21\x3b" +
+	"\x28\x42\x37\x56\x3f\x0c\xf0\xec\x00\x36\xd6\xc5\xa9\x11\xe4\xc3\x2a\xbc\x56\x7f" +
+	"\x58\x4a\x80\xbd\x68\x70\x00\x30\x12\x72\x87\x5d\xf7\x33\xd8\x84\xde\xd1\x8a\x0c" +
+	"\x10\xd1\xf9\xf7\x55\xb0\xc6\x5d\xc6\x06\x50\xa1\x70\xd0\x06\x18\xac\x23\xa2\xfe" +
+	"\xa9\x6f\xcf\x17\x33\x0d\x4e\xf7\x08\xc5\x3c\x83\x81\xea\xc9\xf9\xf9\x46\x83\xf5" +
+	"\x7b\x80\x0f\xde\x88\x4c\xc5\xb1\xe5\xae\xbf\xf2\x95\x9a\xce\x38\x44\xa8\xf7\xd8" +
+	"\x2f\xb4\xfd\x96\x14\x60\x84\x9e\xf6\x3c\x3d\xcd\xdd\x41\x0a\x1a\x32\x1d\x5b\x36" +
+	"\x0e\xec\x11\xfb\x73\x5e\xb4\x1c\x2e\xcf\xc8\xb7\x4b\x5b\x08\x9e\x95\x03\x75\x8f" +
+	"\x01\x2c\x89\xe3\xd9\xbc\x5e\x85\x9c\x99\xfd\x9f\x62\x39\x58\x54\x1f\x20\x06\x5c" +
+	"\x84\x7d\x3d\x7b\x0c\x6c\x22\x0a\xf8\xb9\x62\x41\xee\xe7\xbd\x90\x4b\x0a\xc7\x53" +
+	"\x84\x87\x2c\x7e\x12\xbf\x05\xa7\x4f\xfe\xf7\xfe\x94\xa9\xfe\x30\x07\xfc\x51\x46" +
+	"\x58\xea\x90\xda\x6a\xfd\x61\x6e\x04\xbb\x4f\xf4\x85\xbf\x54\xc6\x2f\x8b\xef\x55" +
+	"\xfb\x23\x4e\xfa\x54\xff\xa2\x9b\xb6\xb3\x36\x8d\xb3\xc8\xe5\xb8\x51\x13\xea\xb8" +
+	"\x2c\x53\x38\xed\x87\xc0\xf6\x80\x7c\xcb\xe1\x3d\x75\xba\x04\xe8\x0e\x42\xd0\x43" +
+	"\x2c\xd1\xef\x74\x38\x31\xb1\x10\x92\x8f\x20\x84\xb5\x76\x06\xb1\x50\xed\x56\x1a" +
+	"\x59\xdc\x8b\xb8\xeb\x3e\x03\xd0\x2d\xd9\xb9\xd9\xda\x28\xa1\x8c\xc9\xfb\xfc\x40" +
+	"\x89\x1b\x9f\x72\x67\x07\x7c\xe7\x94\xd8\x6e\xa3\x3e\x84\x6d\x54\xa7\xe5\x2d\x14" +
+	"\xf6\x80\xc0\x5a\x64\x05\xb8\x7b\xcc\x5e\xe2\xbc\x1d\x5f\xbc\xe4\x86\x1a\x27\xff" +
+	"\x04\x95\x8f\xa8\xc5\xe0\x6d\x12\xe2\xeb\x05\xe2\xd1\x1b\xcc\x3f\xf3\x3a\x43\x49" +
+	"\x46\x3b\x04\x46\xc4\x9a\x59\xb0\xa2\x72\x75\x12\x69\xc7\x78\x13\x0e\xc9\xa3\xc8" +
+	"\x81\x66\x1c\x0f\xa8\x75\x5b\xa8\xd2\x60\x2e\x0f\xc9\xe4\x55\x56\x39\x4a\x6d\x8f" +
+	"\xc4\xe4\x3b\x1a\x4f\x2a\x83\x57\x7b\xdc\xe0\x0f\x78\x5a\x7c\x03\x48\x92\xc3\x76" +
+	"\xe3\x9d\x70\x2a\xa6\xee\x70\x01\x2c\x33\xae\x02\x13\xf9\xeb\xd8\xed\xc3\x01\xa3" +
+	"\x9c\x90\x11\xb9\x0a\x7b\x08\xaa\x55\xb1\x08\xac\xbc\x37\x57\x37\x54\xc0\x37\xa5" +
+	"\xe3\x4e\x3e\x54\x11\x77\xc6\x75\x1c\x31\x29\x9e\x66\x25\x10\x56\xfb\xa1\x2c\xe0" +
+	"\x8a\xaa\x6a\x75\x4d\x05\x5e\x98\x3b\x25\xb4\x0a\xde\x53\x93\x71\x29\x21\x56\xe7" +
+	"\x09\x69\xf9\x1b\x09\xd2\x69\x5e\x45\xa2\xc4\x6d\xf3\xed\xc9\x9e\x26\x68\x09\xb2" +
+	"\x38\x35\x01\x6f\xa0\x80\xc7\xea\xa0\xbb\x68\xfd\x0d\xaa\x35\xa9\x0f\xac\x76\xd6" +
+	"\xe6\x47\x9b\xf7\xa7\xa5\x53\x8a\xea\xcf\x80\xb3\xde\xac\x0d\x19\xfa\xeb\xd9\x4a" +
+	"\xa1\xf3\x42\x16\x2c\xf5\x02\xa1\xad\xe6\x39\x80\x45\xd8\x9f\x6d\xf6\xf2\xf4\xec" +
+	"\x8f\x02\x50\xcc\x9b\xe5\x79\xf3\x1c\x35\xfc\x61\x3a\xa3\xf2\xaf\x43\xcc\xcf\x8c" +
+	"\xb0\x38\xd0\x9e\x8b\x41\x7f\x72\xa6\x10\x46\xf4\x27\x00\x72\xdd\xbc\x6c\x4c\x11" +
+	"\x77\x0e\x48\x29\x21\xff\x83\x1b\x8a\xfc\x2a\x4a\xc9\x9a\xdf\x7d\x40\x46\xd4\xcb" +
+	"\xa7\x04\x4b\xd5\x9f\x53\xf8\x26\xdf\xe7\x56\x17\x49\xa0\xf1\xb4\xb3\xb1\x79\x38" +
+	"\x91\x6c\x2f\x49\xa1\xea\xc5\xa1\xf6\x04\x3a\x15\xe5\xd4\xea\x9e\x25\x59\x6f\x4d" +
+	"\x03\xf7\xcb\x73\xa4\x4f\xec\x69\x98\xe7\x53\xca\x9f\x27\x7c\x56\x6f\x47\xe6\xb3" +
+	"\x26\x40\x88\xb3\x79\x3f\xb2\xc6\xe3\x01\x91\x42\x9f\x3d\x72\xb7\xe6\xde\x8e\x7c" +
+	"\x25\x08\x32\xa6\x27\xb1\xf9\x1b\xdb\x8e\xe8\xf2\x3f\x30\x92\xbd\x7b\x63\x46\xdf" +
+	"\x7d\x50\x0f\x79\x24\x14\x59\x80\x52\x78\xbf\x90\x85\x07\xc7\xbd\x4c\x6e\x9c\x5a" +
+	"\x47\x14\x50\x65\xf1\x55\x81\xb8\x53\xd0\xa9\x07\xf1\x65\x3b\x80\xa5\xf6\x83\xd1" +
+	"\xa9\x00\x62\x1d\x34\xd9\x6e\x29\x5b\x5d\xeb\xfe\x60\x08\x63\x2c\x54\x2d\x00\xa2" +
+	"\xbf\x11\x60\x31\xa5\x0a\x20\xa0\xfc\x34\x04\x3b\x45\x80\xa6\xc1\xea\xfe\xee\xc7" +
+	"\xb7\x76\x3f\xbe\x25\x4a\x0c\xbe\x06\x9c\x28\xe3\xc3\xdb\x9c\xdb\x99\xde\xc7\xc9" +
+	"\xac\x9d\x15\x5a\x1c\x34\xea\x11\xbc\x74\x04\xc2\x63\x42\x8a\xcd\x0d\xeb\x41\xc5" +
+	"\xb0\x45\xdc\x98\xf5\x3a\x55\x1d\x09\x99\x4c\xc5\x6d\x19\xd5\xba\x0b\x59\x29\x5a" +
+	"\xae\x47\x50\xcb\x1f\x4e\xee\x6b\x40\xa5\xd0\x6a\x71\x62\xc3\xa4\x90\x35\x51\xa0" +
+	"\xcb\x6c\x26\xc3\x31\x5d\x47\xa6\x25\x6d\x58\x42\x72\xec\xaf\xd0\xa7\x90\x91\xcd" +
+	"\x19\x2e\xac\x37\x1b\x65\x94\x7d\xd6\xf5\x2c\x22\x5d\xc4\x24\x5e\x04\xbd\x14\xe2" +
+	"\x96\x5c\xf7\xf9\x95\x51\x44\x87\x63\x32\x3f\xd3\x8e\x25\xaa\x5d\x96\xa8\x76\xbe" +
+	"\x3a\xf6\xf9\xed\x2d\xf7\x7d\x7b\x26\xf5\xd6\xba\x69\x7f\x97\x9e\xd0\x3e\xbf\x99" +
+	"\x64\xc2\xf4\x14\xdf\xd1\x4f\xbc\x31\x14\xb8\xcf\x6f\x1e\x7a\xda\xd3\xcc\xef\xc6" +
+	"\x5d\xde\x03\x50\xe1\x40\x8c\x19\x2a\xfb\x34\x53\xd5\xb8\x34\xa3\x34\xdf\x34\x8b" +
+	"\x48\xb3\x39\x94\x5c\x66\xa6\xf6\x34\xb3\x17\x60\x44\x82\x4d\x4e\x31\xd7\x38\x72" +
+	"\xcd\x51\xb7\x62\x14\x06\x7b\xa4\x4e\xf0\x2a\x0b\x1f\xeb\xd0\xd2\xc4\xc6\x4f\x00" +
+	"\x53\x1c\xd8\xd3\x32\x3f\xa2\x75\x71\xdc\xcb\x5c\x05\x2c\x9a\x00\x24\x96\xf7\x8c" +
+	"\xcf\xfa\x86\x26\x02\x03\x4a\x2e\xd3\x44\x6e\x42\x8f\x96\xd2\xb4\xdb\x8f\xbc\x93" +
+	"\x7a\x63\x4c\xff\xed\x66\xf9\x6f\x77\xc3\x7f\x7b\x4a\x3b\xe1\x64\x60\xfd\x4b\xb2" +
+	"\x9d\x16\xbc\xa4\x0a\x62\xec\x4e\x5c\x16\x45\x96\x45\xd3\x05\x8c\x9e\x32\xd4\xa7" +
+	"\xf2\xa2\x71\x42\xf0\xb1\xf0\x23\xd7\x1f\x0a\xc4\x3f\xa0\xc5\x14\xa8\xbb\x2c\x62" +
+	"\x72\x93\x9a\x55\x9b\x34\x9f\xe1\x2f\x67\x39\xd7\xc9\x2f\x16\x3d\xd2\x85\x96\x01" +
+	"\x5d\x32\xc5\xd5\x4e\x7b\xc2\x32\xcd\xa0\x4a\x36\xdf\x38\xf0\x20\x1e\x97\x29\x14" +
+	"\xda\xc5\xc6\xb8\xca\xce\x01\xd6\xa2\x35\xe2\x70\x8c\xc2\x66\x7a\x09\xcd\x9c\xdd" +
+	"\xaf\x7b\x76\x9a\xda\x85\x7a\x40\x07\xb4\x72\xc6\x2d\x2c\xd3\xee\x6c\x29\x57\xbd" +
+	"\x13\xa9\xee\xea\xfb\xd3\xbe\x63\xd6\x5e\x8f\x06\x84\xd5\x6e\x49\x5d\xa5\x83\x65" +
+	"\x20\x0f\x94\xb0\xa8\xde\xd1\x01\x3d\x78\xf7\x67\x0d\x78\xb1\x3d\x51\xda\x34\xeb" +
+	"\x61\x96\xf0\xe9\x3d\x38\x46\xfc\x37\x8d\x83\x18\xf1\xd7\xc6\xd0\xc7\x9d\xac\x17" +
+	"\x64\x4d\xd2\x7d\x7a\x61\x1a\x4a\xfd\x41\xfd\xaf\x1b\xb8\x87\xc7\x53\x03\xb5\x0b" +
+	"\x8d\x60\xfb\xa7\x04\x8d\x77\xd2\xb3\x73\x32\x17\xad\xa8\x3d\xb1\x6f\x2b\x97\x4f" +
+	"\x7c\x01\x23\x3e\xdf\x41\xbd\x8b\x93\x7e\x01\xe6\xf2\x01\xad\x94\x79\x7f\xf2\xb1" +
+	"\x3d\xa0\x31\x99\x9b\xc4\x35\xa5\xe6\xb7\xce\x25\x35\x99\x61\xdd\x13\x93\x40\x99" +
+	"\xfc\xe5\x6f\x9a\x37\x6f\x94\x06\x8f\x0b\x59\x1e\x9c\xe8\xb4\x07\xd5\x6a\xb8\xa7" +
+	"\x14\x4b\xe4\x17\xec\x03\xbf\x5c\xe2\x70\xe2\x21\xc7\x48\xc6\xbb\xd3\xd4\x68\xf1" +
+	"\x36\x4b\x20\x60\x57\x9b\xae\xff\xe0\xee\x1e\xd4\xe4\x06\xb2\x82\x71\x13\xae\xc6" +
+	"\x66\x6e\x34\xd0\x15\x84\x34\xb5\x74\x3b\x3d\x1f\xae\xfb\x89\xbf\xb9\xcb\x36\x99" +
+	"\x3f\xa7\x3c\x86\xac\x13\x69\xdd\xff\x7f\xbf\x75\x27\x50\xc8\xbb\x5e\xc0\x05\xad" +
+	"\x00\x48\xab\x7c\xdc\xd3\x1b\xd4\x75\xc4\x2e\xdf\xfb\x0a\xf9\xeb\x69\xfb\xce\x92" +
+	"\x41\x4c\xca\x82\xc2\xca\xf6\x07\xb6\xc4\xe3\x72\x1d\x79\x4e\x4e\x90\x07\x58\x02" +
+	"\x79\x75\xf6\x73\x8e\x3f\x45\xd0\xfe\x88\xd8\x03\xe3\x46\xf7\x1c\xff\xf0\x71\x36" +
+	"\x8c\x5a\xef\x6c\xf6\x85\x20\x0b\x03\x03\x78\x3a\x7c\xda\x07\x95\x7c\xef\xb6\x4c" +
+	"\x1e\x48\x53\x03\x74\x14\x76\x94\x87\xf8\xef\xac\x52\x60\x68\x06\x6d\x67\x03\xf7" +
+	"\x74\x68\x26\x9e\x62\x86\xe7\x20\x2d\x17\x6c\x03\x4a\x5e\x8e\xfd\x97\xc7\x91\xb5" +
+	"\x5a\x04\x7a\x4f\x2c\x7a\x1e\x75\x85\xb6\xb9\x3e\x45\xd7\xf9\x96\x5c\x3f\xee\x5f" +
+	"\x6f\x8f\xe0\x9c\x2e\x48\x71\x8d\xab\xd4\xe8\xbb\x9e\xb3\xc6\x73\x08\x72\x69\x63" +
+	"\x73\x68\xbe\x50\x19\x90\x8a\x79\xca\x7e\xf3\xb3\xd5\x5d\x2d\xe7\xf2\x92\x5e\x2e" +
+	"\xb5\x57\xde\xcc\x4f\xdc\x2f\x8f\x97\xf2\xc3\x57\x3e\xa2\x59\x39\xdd\x03\xbf\x7e" +
+	"\xf1\x7d\xf3\xac\x69\xcb\xbf\x50\x4d\x49\x9e\x55\x8b\x1d\x78\x25\x73\x60\xac\x77" +
+	"\x7f\x7e\xc0\xb4\x7c\x7a\xda\x3f\x9e\xfe\xe1\xcb\xb7\x4f\xcf\xf6\xf8\xf0\x7d\x8a" +
+	"\x91\x4b\xf3\xca\x79\x87\xb6\xad\x41\xbd\x44\x81\x22\xdc\xea\xc5\x51\x30\x9d\x5e" +
+	"\xd3\xc8\xf0\x79\x52\x6c\x75\xed\x99\x0d\x82\x43\xb4\x9a\xdb\xa7\x9d\x2f\x66\x78" +
+	"\x32\x98\x6b\xc4\xb8\xe7\x0e\x61\x0b\xc2\x88\xb8\x87\x9d\x37\x06\x69\x67\xdc\x81" +
+	"\x74\xfe\xf7\x4c\x1e\x49\xeb\x3e\x99\xff\x28\x33\xd6\x38\x31\x9e\x56\x4d\x01\x24" +
+	"\xc6\xdf\x21\xa5\x93\x3d\x3f\xd0\xb1\x33\x02\xf3\xf1\x78\xe3\x32\x17\x9b\xf6\xcc" +
+	"\xb7\x17\x07\xb2\x76\xed\x52\xe2\x22\x53\x1e\x2d\xaf\x41\x35\xb2\x19\x54\x98\x65" +
+	"\xc3\xcc\x2d\x17\xd4\x9c\xc1\x9a\x54\x65\x1d\xf5\xc6\x5b\x7f\xcc\x2b\xb7\xea\x6d" +
+	"\xc8\x2b\x8f\xcc\x05\xde\xd3\x9e\x29\x0f\x5a\xde\xbb\x5f\x0c\xbc\xb3\x5a\x8d\x33" +
+	"\xef\x2e\xa0\xf2\x2b\x23\x19\x65\xd9\xfd\x7e\x38\xa8\xb6\xa2\xf6\xbc\xed\xec\xa4" +
+	"\x22\x20\x51\xaa\x59\xb3\x4b\xde\x49\x55\x90\xa8\xe2\x0a\x59\xb1\x05\x25\xc5\xe8" +
+	"\x95\x77\xb2\xe5\xe4\x07\x2e\x6b\xe5\x03\x58\xa8\x5c\x8b\xd9\xf4\xda\xd1\xab\x25" +
+	"\x27\x04\x32\x0f\xc9\x5d\x2f\x61\xee\x07\xb3\x7a\x11\x71\x0f\xd5\x3b\x05\xd3\x97" +
+	"\x4d\xf6\x2f\x1b\xd1\x16\xb4\x3b\x70\x6a\x79\x49\x83\x4e\x1c\x9d\xd3\xde\x35\x1a" +
+	"\xd9\x36\xee\xae\xbe\x39\xbf\xf8\xca\xc0\xa5\x3d\xe6\xdf\xe4\x2e\x62\x7f\xff\xce" +
+	"\xe6\xf6\x09\x03\x27\x2c\x65\x43\xd3\x57\x95\xdf\xe7\xf7\x81\x4c\x88\x35\xa8\x82" +
+	"\x2d\x57\x27\xe1\x55\xa8\x05\xf2\xa7\xe1\xf2\xd6\x43\x8f\x7c\xbf\x5f\x48\x82\x28" +
+	"\x5b\x1a\xd1\x4c\xad\xcc\xdb\xb7\xd8\x8c\xd9\x45\xc6\x73\x82\x1a\xc3\x77\xaf\x2e" +
+	"\xe8\x6c\x6a\xb3\xc4\x1a\x51\xd1\x56\xf2\x5e\xe6\xbe\xce\xb0\xad\xbf\x36\x6a\x82" +
+	"\x6c\x76\x2a\xe6\x91\xe6\xd3\xa8\xf8\x07\x73\x98\x01\x4c\x22\x6f\xcc\x54\x31\x9a" +
+	"\x6a\x19\x1f\x9e\xda\xe9\x73\x84\x32\xbf\x6d\xe1\xa9\xcc\x6f\x55\xbd\x51\x7b\xe8" +
+	"\xf7\xa3\xe9\x14\x09\x27\x21\xf4\x09\x63\x54\x29\xbf\x7f\x6c\xaf\x63\x2f\x33\xf5" +
+	"\x5f\xf8\xaa\xe1\xc8\xa4\xb9\xc9\xa9\x3d\x91\x56\xe4\x4a\x7c\x7e\x38\x50\x16\xc9" +
+	"\xfa\x73\x37\x6b\x2b\xc9\x11\xc5\x8d\x58\x38\xbe\x80\xa5\xa5\x84\xd0\xcf\x32\xb2" +
+	"\x38\x55\xe0\xe6\xcd\x88\xbd\x5c\x72\xb1\x3e\xa2\x78\x79\x69\x49\x45\x8b\x32\x85" +
+	"\xed\x34\x62\x56\x9f\xeb\xe8\x7d\xc6\x4e\x9c\x98\x44\xc5\xe6\x9b\x5c\x15\xf3\x44" +
+	"\xc6\x0e\x9c\x40\x49\x42\xd5\x7b\x5a\xa7\xe1\x46\xde\x60\x7e\x59\x7a\x9a\x4b\x16" +
+	"\xce\x85\x1f\x41\x2d\x2e\xbd\x43\xe1\xf8\xc7\x5e\x74\xb0\x12\x1f\xd6\xee\x76\xb7" +
+	"\xef\x26\xea\x69\x48\x88\xe9\x62\x39\x8f\xaa\x33\xd5\xbb\x2a\xef\xfd\xa3\x15\xaf" +
+	"\x2d\x9d\x9c\x4f\x9b\xa7\xbd\xea\xa3\x11\x97\x4c\x44\xdb\xb4\x73\xac\xf1\x70\x82" +
+	"\xfe\xa0\x43\x89\xf8\x5a\x37\xc9\xd4\x45\x7b\x3e\x7b\xc5\xd6\x05\x5e\xf8\x89\x12" +
+	"\x56\x9a\x79\xb5\xe5\x85\x9a\xb4\x82\x59\x2d\x9a\xdb\xee\x52\xcf\xc4\x83\xd7\x58" +
+	"\x5c\x06\xd8\x47\x0b\x6a\xa4\xe6\x92\x87\x6c\xc7\x1a\x19\x7e\x3d\xa5\x90\x66\xd8" +
+	"\x42\x50\xba\x6a\x5d\x1d\xb1\xeb\xea\x88\xbd\xda\x87\x69\x55\x1f\x5c\xd5\x71\x63" +
+	"\xeb\x40\x02\x50\xd4\x5e\x1d\x25\x47\x1e\x41\xeb\x80\x18\x11\x78\xba\xb0\x99\xda" +
+	"\x06\x31\xdb\x11\xa1\x46\x05\xa4\xa9\xa1\xa0\x18\x3e\x0d\x67\x4f\x3e\xac\xd9\x38" +
+	"\x16\x28\x72\x2c\xf1\x62\x99\x17\xcf\x81\x2f\xc8\x5a\x8a\x4e\xa6\x04\x4c\x41\x17" +
+	"\x07\x9a\x6b\xd5\x52\xf1\xbe\xf5\xb9\x86\x2a\x8c\x6a\x94\xd7\xaf\x5a\x0b\x11\x49" +
+	"\xe1\xb7\xf4\x31\x49\xbc\x6b\x76\x51\xb7\xf7\xa1\xed\x83\x4a\xaf\x5c\xab\xc6\xb7" +
+	"\xaa\xb5\x95\x8a\x41\x79\x78\xec\xc9\x89\xea\xb1\x76\x7e\x37\x34\xb4\x77\xec\x21" +
+	"\xec\x76\x26\x10\x62\x69\xef\x44\xc5\x78\x98\xac\xa5\x38\x2c\x81\x54\x73\x61\x80" +
+	"\xcc\x91\x3d\x47\x40\xa2\xb7\x5b\xa2\xcb\x14\xe2\x4f\x60\x15\xac\xea\x1d\xb6\x7c" +
+	"\xdb\xe6\x53\x9a\xdb\x87\x87\x2c\x74\x22\x72\xaf\xec\xb2\xf1\x5a\x54\x26\xb8\x9c" +
+	"\x20\x54\xaf\x05\xe0\x21\x9b\x7a\xb3\xda\x8e\x7b\x2d\xb9\x5e\x48\xc9\x7b\xad\xb6" +
+	"\x51\x94\xa3\xcd\xf9\x18\x20\x58\xef\xa0\x9e\x62\x9b\xd9\x59\x74\x64\x15\x21\x68" +
+	"\xf2\xb8\x6a\xd0\xd6\x9e\x35\xd3\x32\xf5\x97\x50\x79\x31\xbe\xe6\xca\x9f\xec\xee" +
+	"\xe8\x95\x06\x50\x55\x1a\x4f\xa1\xa8\xd9\x05\xb5\x54\x84\x06\xcf\x93\x0b\x15\xbd" +
+	"\x0b\x5e\x12\x96\xba\x5f\xa1\x94\x3d\xd9\xb8\x50\xc9\xb0\xe5\x4a\x3d\x74\xa9\x04" +
+	"\x88\xf2\xe8\xfd\xb0\xb1\xd3\x81\xe2\xda\x7f\xcb\xd0\xe3\x84\x2b\x46\x0c\x59\xb8" +
+	"\x97\xc9\x77\x92\x6a\x3f\xec\x2d\xec\xc7\xae\xdd\x3b\x07\x96\x88\x09\x68\x21\x94" +
+	"\x3e\xb8\xf2\xe9\x46\x61\xf8\x56\x73\xa2\xf9\x60\xa0\x12\x81\xba\x2b\x78\xaf\xfe" +
+	"\x87\xaa\xe7\xb1\x8e\x9b\xfe\xbb\x39\x41\x18\xbd\x88\xbd\x9e\xc5\xfa\x28\x94\x22" +
+	"\x9c\x50\x00\xfa\x59\xa7\xdc\x84\xb0\x63\x25\x15\x47\x09\xf1\xe5\x44\x9c\x1f\x7b" +
+	"\xd3\x7e\xe2\x4d\x03\x90\xdf\x4f\xf8\x59\x18\x28\x03\xa8\x88\x3f\xa9\xf9\xd2\x0a" +
+	"\x1b\xc5\xde\xed\xc9\xe4\xa8\x9b\x2c\xb4\x71\xcc\x71\xb9\x90\xfe\x94\xf0\x94\x81" +
+	"\xd3\x32\xd0\x48\x42\x25\x16\xb3\x47\x52\xfd\xe2\xc5\x87\xd9\x87\x47\x31\x1f\x18" +
+	"\xd6\xba\x0d\xc4\x07\x61\x63\x9b\x4f\x49\xa8\x63\x6e\xb3\x34\x7f\xcc\xab\x38\x23" +
+	"\x5d\x09\x33\xa3\x69\x33\x36\x92\xf7\xa6\xe5\x50\x5c\x79\x54\x5c\xbd\x2a\xbc\x31" +
+	"\xcd\x89\x05\x79\x0a\x6f\x7c\xac\x61\x3a\x91\x70\xf2\x61\xc9\x38\x2d\x44\x2c\x4a" +
+	"\xba\xcd\x76\x72\x4e\xd0\x3b\xc6\x01\x8d\x9d\xf8\xb6\x36\xba\x04\xd0\x3f\xed\xf0" +
+	"\xb8\x50\x44\x88\xc4\xb9\x1f\x76\x00\x0b\x1c\xb9\xe5\x3d\x7b\x22\x79\x0f\x6b\x50" +
+	"\xf2\x75\xe7\xca\x2c\xae\x71\x22\xac\x9c\x5e\x96\xb3\x75\x5e\x8c\x96\x96\xf9\xf8" +
+	"\x00\x7d\xa0\xb7\x10\x87\x47\x42\xd0\xb1\x89\xe4\x9b\xef\x36\x71\x1f\xf8\x6d\x50" +
+	"\x6f\x38\x81\xdf\x81\xad\x22\x17\xbf\x9e\x3d\x60\xdd\x3e\x60\xa4\x4b\xef\x12\xd0" +
+	"\x79\x3d\xfa\x24\x79\xd1\xe6\x98\xb7\xe3\x13\xdb\x73\x72\xf7\xa6\xa8\xdb\xe1\x18" +
+	"\xf0\xed\x30\x6a\xdd\x61\x1f\xd8\x5e\xe3\x96\xee\x7c\x0a\xb7\xc4\x24\xe1\xa6\xce" +
+	"\xc8\xf1\x3b\x90\x72\x7d\xf2\x05\xa1\x15\xe1\x96\x74\x4d\x52\xba\xd0\x72\x41\x45" +
+	"\xc7\xaa\xb8\x74\xdb\xc0\x5e\xf9\x6e\xe2\x6d\x20\x2b\xfd\xaa\xaf\x46\xa3\x29\x80" +
+	"\x34\x10\x84\xbb\xf3\xac\xd1\xa7\x55\x73\x1e\x18\x9a\x93\x35\x6e\x03\x18\x55\x74" +
+	"\x69\x05\x0f\x1f\x7a\xc1\x7b\x63\x4d\xba\xe9\x5e\xea\x08\x0c\xdc\x87\xc7\xff\x7a" +
+	"\x7a\x3e\x53\xfb\xdf\x5b\xe2\xb6\x79\xae\xd1\x56\x19\xb0\x48\xf9\xf5\x81\x96\x80" +
+	"\xbe\x98\xfa\xe1\x51\xe4\x1e\xd5\xde\x87\x3a\xdc\xa7\x27\x64\xd4\x3f\x3a\x6a\x81" +
+	"\xbc\xb5\x74\x5f\x1c\xb3\x60\x8f\xe1\xfa\xe4\x1f\x9e\x97\x67\xd3\x3e\x80\x27\x2a" +
+	"\x3d\x72\x47\x91\x28\x3a\x1c\x4e\x97\x00\xfe\x5f\xa3\x01\x35\x5a\x85\x79\x2c\xd7" +
+	"\xee\xff\x9c\xc0\x32\x90\x05\x1e\xf9\x61\x81\x47\x7e\xc4\x6b\xda\xcf\x35\x5a\x60" +
+	"\xd7\xe4\xda\xe8\xe2\x60\xd4\x19\x57\xf4\x06\x4d\x03\x79\x51\x81\xdd\x81\x35\x04" +
+	"\x8d\x38\x9e\xc5\x91\x7e\xd5\x2e\x8f\xc3\x9b\x10\x80\x79\x53\x4b\x2f\x4d\xb0\x8c" +
+	"\x5a\xaa\x2f\xb7\xe1\x2b\x58\xe2\xaf\x11\xb4\x18\x1f\x34\xde\x22\xbf\x31\xd2\x51" +
+	"\xc4\xf6\x3b\x23\x03\xbe\x86\xa8\x0a\x25\x2d\x1d\x51\x2f\x65\x68\xc6\xef\x8d\x6d" +
+	"\xe5\xe6\xef\x0a\xa7\xdd\x34\xfe\xb4\x18\x32\x32\x1f\x30\xd8\x34\x54\xa5\xd9\x7f" +
+	"\xc4\xe3\xb8\x54\xea\xbe\x6f\xce\x7e\x25\x23\x6b\x93\x9e\xad\x08\xac\x40\x16\x7b" +
+	"\xb5\x57\x4f\x7b\x9f\xac\x80\x2b\x9e\x96\xd5\x5b\x5e\x7d\x72\xd3\xb2\xf7\x05\xa2" +
+	"\x3d\x20\x47\xd7\x2f\x64\xc9\x19\x1e\x02\xc5\x63\x67\x3e\xe5\x2e\x68\xe1\x1a\xe8" +
+	"\x5b\x7c\x5f\x70\x9d\x2f\xec\xb0\x81\x14\xf0\x6f\x0a\xa8\x0c\xec\xe5\xd3\x42\x68" +
+	"\xe3\xb3\xc2\x89\x25\xd5\x63\x98\xf4\x0f\x0e\x6c\x2a\x68\x45\xa4\xe5\x97\xd6\x3e" +
+	"\xa0\xb6\x95\x2c\xe4\x9a\xae\xf2\x65\x6e\x31\xb9\xd0\x72\x41\x25\x64\xc5\xd0\x42" +
+	"\x32\x04\x5e\xe5\xe7\x97\x2c\xef\xad\x7e\x5d\x77\x6c\x5c\xbe\xb7\xe3\xe2\x22\x9e" +
+	"\x5d\x55\xf7\xc0\x39\x1b\x23\x16\xc5\xfe\x5e\x8e\xf9\xc5\x20\x5f\x6f\x69\xd1\x89" +
+	"\xce\x80\x69\x30\xf5\x2f\xd4\x5b\xb2\x24\xa0\x17\xf2\x84\x1c\x0e\x41\x07\x36\x15" +
+	"\x75\x34\x78\x23\x8e\x4f\x45\x2d\xbc\xb6\x0b\x99\x68\x99\xf3\x3a\x80\x27\x04\x2f" +
+	"\xe2\x97\xd9\x8d\x32\xe1\x89\x02\x7b\x57\x20\xe0\xa3\x22\x1f\xa8\xad\x2e\x99\xb5" +
+	"\x3c\xdd\x2d\xab\xbf\xd0\x40\x77\x14\xe9\x38\xbc\xd8\x56\xe3\x62\xbf\xbc\x92\xef" +
+	"\xd2\x0c\x12\x0d\x4e\xcc\xaa\x07\x9c\xcb\x3f\x1e\x6b\x45\x97\xbf\x7c\x46\xb3\x56" +
+	"\x1c\xbe\x81\xad\x42\xee\x57\x85\xd8\x55\xee\x6a\x5c\x35\xae\x75\x17\xdb\xfe\xf2" +
+	"\xbb\xad\x47\x0a\x78\x15\xdd\xf3\xf1\xd1\x38\xee\x7e\x69\xa7\x05\x58\x35\xdd\x6d" +
+	"\x8d\xc6\x81\x17\xeb\xf4\xd9\x4a\x7d\x6c\x55\xba\x3a\x8d\xef\xac\x75\x4d\x17\x5a" +
+	"\x2e\xd8\x3e\xe0\x87\xec\x05\xbd\x30\x0f\x7a\xea\x01\x22\x85\xd1\x32\x04\x3f\x45" +
+	"\xbd\x42\x1f\xa1\xe5\xff\x81\xad\x74\xcf\x8c\x56\x7d\x00\x4b\x50\x3c\x4f\xc5\xb0" +
+	"\xa5\x62\xd8\x52\x11\x56\x9e\x7f\x7c\xbf\xd0\x90\x96\x76\x1d\x5a\x0a\x7e\x8e\xec" +
+	"\x03\x8e\x68\xf6\xef\x14\x56\xef\x32\xdb\x16\xad\x72\x68\x77\x0c\xe6\xf8\x34\x2b" +
+	"\x71\xf3\x3e\x7b\xab\x5c\xc2\x10\xd4\x32\x55\x9b\x60\xe7\x41\x1d\x2b\xf3\x27\x1f" +
+	"\x03\x0c\x1e\x17\xb2\x07\xc6\xc2\xd9\x69\x5d\x38\x57\x51\xa4\xa0\x4b\x80\x36\xc0" +
+	"\x90\x09\xe0\x75\xe2\x66\x6b\x06\xb2\x76\x1a\x16\x6b\x60\xef\x87\x13\x2f\xe3\x3e" +
+	"\xa2\xee\x51\x8c\x5d\x7d\xbd\xbe\xd1\x5a\xe5\x08\x66\xd0\xdb\xe0\xd8\x88\xe2\x30" +
+	"\xde\xc3\xed\xdc\x6e\x7e\x82\x7e\x6f\xb5\xde\xe1\xd5\xbb\xd2\x62\x32\x54\xa5\xdb" +
+	"\x88\xea\x9d\xc1\x23\x0d\xe4\x51\x76\x7f\xf3\x76\x96\x70\x45\xac\xb3\x37\xd4\x0c" +
+	"\x6d\xdd\xdb\xfb\x1e\x1c\x0e\x3e\x18\x88\x3e\x73\x7f\x26\x9f\x54\xb2\x8b\x63\xaf" +
+	"\xd4\xfe\x64\xa9\xca\xa8\xfd\x75\x20\x2b\x5d\x8d\xbf\x23\x4e\xba\x08\xb6\x78\x14" +
+	"\x87\x73\xf9\xf4\x78\xf1\xc7\x1a\x02\xb8\x87\x48\xf7\x97\x9e\x55\x80\xa0\x4b\x80" +
+	"\x08\xf1\x8a\xff\x98\x71\x0f\x6c\x22\xbb\xc7\xe1\xc8\xac\x8d\xae\x86\x6b\xfb\x80" +
+	"\x7d\x60\x13\x30\xff\x59\xe2\x05\xb3\x02\x68\x2b\xf5\x03\x2d\x03\x7a\x29\xae\xbd" +
+	"\x56\xc7\xb5\x7d\x40\x25\xaa\x4d\xcd\x01\xfc\x6d\x13\xbe\x82\xcf\x80\x2d\xa8\x3d" +
+	"\x03\x1c\xff\xdf\x80\xde\xca\x31\x6d\x6f\xa9\x60\x17\xe7\x1f\xe8\xe1\xf9\x18\xa5" +
+	"\x92\xb2\x82\xf6\x95\x2e\x2c\xa9\xe3\x7a\x8d\x1d\x5e\x6c\x2b\xe4\x31\xde\x68\x43" +
+	"\x1e\x9e\x3d\x8e\xb6\x4d\x00\x9e\x38\x5a\x2a\xdb\x27\x1c\xc1\xf6\x30\x87\x6f\x94" +
+	"\x06\x1a\xc1\x5e\x97\x87\xdf\x62\xed\xd0\x40\xbd\xd5\x32\x80\xb3\x8e\xf7\x9e\x2e" +
+	"\x64\xcc\x76\xc6\xa8\x7b\x2a\xb3\x93\xe3\x7e\x7b\x43\x45\x1f\xef\x2b\xb5\xaf\xa0" +
+	"\x36\x69\x46\xe3\xfa\x4b\x71\x5f\x39\xbe\x76\x05\xdb\x00\x1e\x3a\xc2\x7c\x16\x26" +
+	"\x14\x89\xc4\x97\xab\xa3\x4f\xae\x45\x8f\x7b\x75\x06\xb6\xba\xe5\x72\xec\xa0\x4b" +
+	"\x00\x8f\xf1\xed\x4f\xf2\xe3\x43\x03\x16\xb2\xad\x11\xfc\xe2\x9e\xfe\x45\x7e\xea" +
+	"\xb5\x3f\x6d\x57\x4b\xed\x5c\xa7\x2f\xf6\x2e\x55\x60\xdc\x3e\xed\x3d\x9d\x4b\xdd" +
+	"\xde\x30\x50\xb1\x32\x12\xfa\x42\x03\xf7\xe3\x1f\x4f\xbf\x7c\x6f\x87\x21\x7d\xf3" +
+	"\xb0\x6b\x2b\xf3\xd3\x57\x5c\x5c\xfe\x7e\xfc\xe3\xf9\x88\x18\x52\x68\xcd\x39\xd4" +
+	"\x7c\xeb\xfc\x9a\xf6\x93\xfb\xca\xde\xd0\xe2\x07\xe6\x3f\x71\x77\x8f\x1a\xb4\xa1" +
+	"\x21\x8c\x09\xbf\x81\x16\x87\xc5\x43\x59\xaf\x36\x1a\x41\x5d\x9b\xc3\x81\x3c\x61" +
+	"\xd3\x6b\x35\xa6\x2a\xf3\xa4\x8d\x9c\xae\x9a\x7e\x9a\x6a\xfa\xe9\x2a\xe9\x27\x1b" +
+	"\x38\xe7\xfd\xae\x14\x31\xa7\x85\xc8\xfd\x4e\x8e\xfc\xb9\x67\x91\xed\x3c\xbc\x65" +
+	"\x97\xc3\xc0\x7c\x16\xba\x16\x5c\x12\x66\xd3\xe2\x5c\xd5\x63\x9d\xab\x0e\x5b\xc0" +
+	"\xc3\x48\x90\x53\x13\xc2\x85\xa5\x1d\x4f\xbf\x43\x72\xf7\xdb\x6c\xf6\xb8\x38\x12" +
+	"\x80\x3d\xa5\x01\xd4\x73\x18\x3e\x3c\x02\x9a\x23\x6a\x8d\x62\x33\x3b\x64\xed\xee" +
+	"\x70\xa2\xc4\x1e\x6a\x5d\x1c\x51\x7c\x3a\xb1\xe9\xb3\x38\x96\xcc\xa8\x1c\x58\xeb" +
+	"\x25\xef\xbb\x81\xe7\xe6\xb3\x8a\x93\xb5\xae\x53\x4b\x5d\x27\x9d\x85\x34\x97\x4f" +
+	"\x96\xd6\xd9\xbd\x3e\x77\x3e\xfd\x70\x09\xda\xa5\x3a\x21\x62\x5e\x8d\x44\xe7\xbe" +
+	"\x23\xcd\x19\x40\x55\x9b\x81\x65\xa0\x76\xa1\x3e\xe0\x40\x56\x9f\x7a\x4a\xa9\xc5" +
+	"\xf9\x52\x06\x93\x77\xf2\xc2\x2c\xb5\x5c\xa5\x37\x0c\x34\xb8\x27\xb8\x8a\x60\xed" +
+	"\x8d\xdb\x22\xa8\x3b\xfb\x18\x3a\x1b\xe3\x93\xd6\x2e\x0d\x96\xc1\x54\x09\x54\x36" +
+	"\x4d\xbc\xfd\xb0\xf2\xce\x39\x32\x98\xfe\x04\x2e\xc7\x2d\xee\x46\x8c\x7b\x86\xec" +
+	"\xa9\xf6\x22\x8a\x60\x7f\x92\x3e\xb3\x8c\xf3\x20\xc0\x77\xd8\xcc\x74\x96\x91\xc5" +
+	"\x69\x37\xaa\xf0\xb7\x1f\x3b\x78\xa3\x60\xbf\x04\xed\xd3\x7f\xe7\x7c\xfb\xc6\x5d" +
+	"\x30\x8d\x0c\x60\x33\xfc\xbf\x13\x65\xea\xff\x68\x3d\xa3\x3e\xfe\xf1\xf5\x08\x4c" +
+	"\xcb\x40\x6d\xa0\x08\x7d\x07\xa5\x08\x82\xac\xa5\xbc\x3c\xe5\x23\x04\x57\xc9\x6f" +
+	"\x0b\x6f\x09\xe0\xf8\x10\xdb\x50\x89\x31\x39\xa0\x0b\x9c\x2b\x94\x12\xf3\x61\x09" +
+	"\xe4\x42\x54\xa7\x56\xa4\xd6\xd8\x7e\x7f\x04\x54\x0a\xcd\x32\x68\x7a\x40\xf6\x38" +
+	"\xbe\xfc\xe1\xc1\xc5\xc3\xe8\x05\x0c\xb8\x98\x7b\xff\x42\x90\x49\x73\x17\xd1\x03" +
+	"\x63\xef\x41\xae\x72\x4d\xbc\x9a\xdc\x74\xc1\x3a\x96\xd1\x2e\xaa\x08\x9d\x04\x19" +
+	"\xe9\xea\xa6\x97\x29\x3c\x26\xdb\x63\x67\x23\xb0\x0c\x36\x8f\x10\x37\xdf\x2c\xaf" +
+	"\xd2\x3c\x79\xf0\x44\x73\x11\x81\x87\x9a\x48\x73\xaa\xcc\xb2\x74\xe9\x8c\x06\x43" +
+	"\xcf\x99\xd3\xa1\xfd\xb8\xff\x4e\xee\x0c\xc6\x3d\xcc\x55\x32\x8d\x16\x91\xdb\xb7" +
+	"\x7b\x14\x41\xe1\x87\x38\xd6\xe4\xfe\x3b\x75\x0b\x9e\x11\x95\x02\xe0\x21\x54\x56" +
+	"\xfe\xcb\x17\xfa\x7f\x67\x3d\x66\x73\xdd\xe3\xad\xc9\x91\x31\x79\x16\xcd\xd6\x45" +
+	"\x8c\x47\x52\x6d\xfe\xfa\xca\x13\xf6\xf5\x17\xb9\xa9\x4f\x6d\xbe\xdd\x30\xf8\xd9" +
+	"\x74\x78\x09\xb7\x99\xab\x20\x13\x60\x5c\x36\x52\xde\xf2\x2b\xa9\x1b\x9f\x9a\xf8" +
+	"\xfa\xe1\x44\xc2\xdf\x72\x2c\x2d\x76\x74\xf1\x11\xb0\xcc\x24\xcc\xed\x0a\xb7\xe4" +
+	"\x80\x40\xdd\xaf\x10\x74\x71\x60\x65\x5d\x7c\x06\x6f\xa8\x5a\x74\xd4\x9d\x9c\xaa" +
+	"\x30\x42\x4a\x3f\xc7\xba\x85\xb0\xb1\xfa\x53\xe4\xc4\x77\xbf\x17\xc2\xee\xe4\x7a" +
+	"\xd7\xe1\x22\x28\x21\x64\xf6\x20\x8c\x25\x12\x5c\x31\xdd\x63\x65\x79\xc0\xca\x30" +
+	"\xb4\x6e\x28\xd2\x9c\x58\x90\xc9\xd1\x09\xb4\x39\x53\x46\x5b\x71\x83\x36\x14\xae" +
+	"\x03\x58\xb8\xb9\x68\xb9\x3b\x15\x63\xfb\xaa\x3f\xa2\x52\x50\x03\x2c\x9a\x46\x83" +
+	"\xd0\x3d\x51\xd8\xbd\xaa\xab\x05\x2a\x76\x67\xce\xd8\xe8\x61\x70\xc8\x08\x6d\x2a" +
+	"\xdc\xec\x3e\x5e\x1b\xa8\xa6\x32\xdc\xc7\xde\x66\x37\xf0\x48\xf7\x75\x75\x42\xfe" +
+	"\xbd\xdd\xe6\x75\x97\xc3\xbe\x9b\xe8\x76\x88\x22\xc1\x2b\x29\x37\xb5\xa0\x62\x73" +
+	"\x49\x8e\x11\x65\xae\xb4\xd1\x14\x68\xb3\xbe\x41\x45\x9a\x13\x45\xb0\x5a\xde\x64" +
+	"\x09\x90\xad\x1b\x78\x56\x7c\x6e\x7f\x7a\x04\x95\xd0\xb7\xdc\x6e\x59\x50\x96\xcd" +
+	"\x9b\x08\xe5\xdc\xc9\x84\xd1\x1e\x17\xc1\x5d\xff\xd3\x6e\x37\x63\xca\x5b\x9c\x5a" +
+	"\xa8\x2a\x0f\x55\x62\x11\xb6\x6c\xdb\xbc\x9b\xc3\xda\x4e\x9b\xdd\x51\x2c\xb6\xa4" +
+	"\xe1\x28\x92\xc6\xa8\x36\x77\x6b\x84\x6a\x5c\x9a\xdc\xb4\xb9\xef\x5f\x9c\xb0\xfc" +
+	"\xc0\x44\xb6\x16\xfd\x61\xb3\xc8\x3c\xce\xd5\x83\x1f\xfe\x8c\x47\xae\x93\x7f\xd3" +
+	"\x0f\x0b\x0a\x01\xd2\x60\x59\xfe\x47\xae\xdb\x5c\xf2\xdf\x34\xfd\x8f\xab\xb3\xdb" +
+	"\x77\x95\xe5\xb5\xf8\xb9\x77\x89\x4a\x2b\x6b\xa2\xf8\x00\xb6\xab\xeb\xea\xf7\xef" +
+	"\x3f\x12\xec\x7c\xf7\x41\xc9\xe0\x53\x8a\x80\x10\x42\x32\x70\x1e\xc1\xa3\x50\x7b" +
+	"\x9d\x3d\x15\x11\x6c\x59\x19\x59\x9d\x7a\xb0\x25\xbf\x16\x36\x41\x35\xbc\x7c\x00" +
+	"\xbc\x7c\x08\xbc\xc8\x05\x33\x1d\x97\x7a\xbd\xf8\x3e\x88\x90\x8d\xae\xca\x73\x3f" +
+	"\xa4\xfd\xa7\xf1\xf9\x8f\xbd\x06\x07\x65\xdd\x5c\xf2\x42\xf5\x1c\x81\x72\xdc\x80" +
+	"\x0f\xd4\xc0\x9e\x4b\xc3\x97\x20\x4a\x33\xea\x31\x22\xab\x9f\x8d\x00\x13\xff\x19" +
+	"\xaa\x99\x57\x21\x6a\xc9\x18\x1e\x8f\x54\xf7\x1b\x58\x15\x64\x63\xc2\x68\x1f\x34" +
+	"\x2d\x5f\x64\x4d\x18\xc7\x77\x54\xe1\xdd\x43\x9c\xa1\x4a\x98\x8a\x65\x0f\x50\x31" +
+	"\x8f\x5d\xcd\x3c\x36\x84\x0b\x0c\x37\xb0\x30\xfd\x83\x81\x7d\x30\x0c\x64\x81\xe2" +
+	"\x96\x73\x11\xd0\xbf\x60\x37\xfc\x38\xb4\xcc\x2e\x7c\x42\x50\xbb\x18\x73\x2a\x48" +
+	"\x67\x11\x37\x58\x07\xb2\x96\x9e\x35\xa9\x46\x2c\x1e\xd7\xe8\x66\xfe\x0d\xa8\x83" +
+	"\x0d\xd4\x6e\x78\x39\x14\x49\xb5\x6f\x50\x8c\x68\x42\xaa\xb2\x88\x7f\xe7\x54\xc9" +
+	"\xae\x94\xd7\x41\xfd\xb1\xfa\xf2\x41\x99\x8d\x44\x48\xc1\x5c\xed\xe4\xf6\x5b\x06" +
+	"\x62\x28\x6b\x51\x6b\x2e\x63\x04\x72\x18\x3a\x88\x32\xc4\xc4\x00\x35\xaa\xf4\x08" +
+	"\xfb\xd7\x1b\x58\x90\x4e\x8b\x00\xa7\xbf\x2f\x47\xac\x33\x6f\x4f\x1b\x50\x3d\x0d" +
+	"\xa4\xae\x66\xc0\x22\xdb\x20\x5e\xca\x86\x9d\x7c\x82\x90\x4b\x34\xa2\x14\xe9\xe4" +
+	"\xea\xd1\x17\x79\x60\xc5\x18\xac\x60\x1f\x34\x3a\xd1\x7f\x49\x3e\x1a\x24\x76\xda" +
+	"\x6e\x60\x75\xff\xb1\x47\xfe\xf8\xbb\x5f\x7e\xc6\xbb\x17\x6b\x7a\x50\x8b\x93\x60" +
+	"\x24\x21\x69\xa4\x29\x4f\xa9\xca\xb8\xd1\x3a\x02\x47\x83\x90\xe0\x9f\xf7\x35\x45" +
+	"\xfc\xfb\x85\xee\xd4\x2c\x34\x06\x1c\x25\xf3\x49\x13\xb1\x67\x23\x40\xb4\xf4\x2f" +
+	"\x1a\xe5\x0f\x8f\x15\xb0\x63\x55\xe7\x8b\xc6\x38\xfa\xed\xfd\x95\xd0\x4b\x16\x1e" +
+	"\x8f\x95\xc7\xd2\x1c\x18\xc5\xf9\xa2\xf5\x86\x63\x99\x40\xcc\x11\x52\x6b\x3e\x44" +
+	"\x96\x72\x70\x88\x57\x7f\xc1\x91\xcb\x55\x3f\xfd\xc2\xff\x1b\x33\xca\xab\xde\xff" +
+	"\x4a\x1d\xe5\x00\x9a\xa3\x51\xc9\x3a\xa6\x1e\xa0\x45\xb2\x2b\x73\xea\x25\x5c\xde" +
+	"\x41\xca\xa5\xae\x2e\xfd\x17\x02\xde\x91\xe1\x9d\x78\x52\x16\x7e\xfe\x0a\x7c\x0d" +
+	"\xe8\xf0\x0e\x53\x5f\xc1\x66\x42\xbc\xc1\x3a\x90\xd7\xdd\xa1\x75\xc1\xca\xb6\xc7" +
+	"\xa9\xa7\xbc\xee\x7e\x48\xe4\xee\x3d\x19\xac\xa7\xf4\x20\x5b\x22\x8e\x90\xae\x52" +
+	"\x28\xbb\x10\xa8\x0d\x9a\xa1\x47\x6a\xc0\xee\xc8\x0b\x46\x35\xf8\xa0\xab\x03\x8b" +
+	"\x5a\x63\xdc\x9d\xa8\x3a\x80\x11\xe3\xa7\xa3\x40\xec\xe9\x9d\x5e\xb5\x75\x43\x6c" +
+	"\x13\x09\x01\x9e\x4f\x00\x06\x22\xd2\x7c\x8d\xd4\xfe\x1d\x58\x8b\x1c\x0d\x0d\x16" +
+	"\x63\x91\xd3\x17\x27\xab\x53\x0f\xf6\x27\xde\xef\xfb\x3e\x92\x21\x90\xeb\x54\x0b" +
+	"\x7f\xd6\x20\x7f\x4d\x55\xb5\x42\xe3\x43\x8e\xe0\x0f\x4e\x96\x63\x29\xbe\x03\xc2" +
+	"\x26\xd4\x87\xde\x30\xf2\x07\x71\x50\x0f\xf6\xd6\x73\xb4\x7e\xe1\x1d\xbf\x5e\x34" +
+	"\xd4\x03\x8d\x0e\xf9\x06\x1e\x6b\x1f\x17\x80\xfd\x93\x87\x75\xcd\x07\x9b\x10\x88" +
+	"\xda\x00\xb2\x3a\xf5\xa6\x04\xea\xdb\xfb\x18\x33\xc7\xc3\x47\xf3\xc3\x35\xdb\x3a" +
+	"\xfa\x15\x69\xff\x67\xc0\x76\x63\x4d\xa3\x82\x1e\xf6\xd7\x89\x3a\xb0\x44\x7d\x6b" +
+	"\xfc\x5a\x35\x31\x6c\xf5\xd6\x05\xa7\x1b\x8c\xe4\xfe\x4c\x89\x06\xd7\xf8\x60\x99" +
+	"\x4b\x4c\x8d\x2c\xb7\x8c\x5a\x76\x90\x27\x06\x8e\xff\x36\xb0\xca\xab\xe9\xe9\x4a" +
+	"\xa6\x6a\x7c\x5c\x9a\xc5\x21\x37\x55\x22\xfb\xae\x3d\xfc\xb3\xf6\xb8\x60\x9d\xe3" +
+	"\xd7\x27\xf4\x69\xcf\x87\xe8\xb1\x4f\xbf\x00\x43\x10\xc9\x9e\x08\xa3\x19\xf1\xf8" +
+	"\x31\x2f\x10\xe4\x33\x3c\x90\x47\x3d\x39\x92\x36\x72\x0c\xda\x6f\xa0\xc7\x1a\x1c" +
+	"\x89\x8d\xfa\x28\xc6\x68\xb0\x7a\x92\x01\x7b\x5c\x1a\x46\x19\x07\x6e\x1e\x5a\xc3" +
+	"\x48\x3a\x96\x95\xe6\xe1\xbd\x3f\xb1\x29\x63\x04\x56\x8b\xa1\xe6\xb4\xfb\xf7\x41" +
+	"\xd8\x1e\x52\x6d\xd2\x1a\xd3\xc0\x73\x08\x5b\x3a\xcc\x37\x6a\x0e\xac\xe9\x5c\xea" +
+	"\x72\xa0\x3b\xd2\xab\x3b\x44\x31\xbf\xf0\x4e\x51\xc6\x63\xfc\xa3\xec\x90\xe7\x6c" +
+	"\x61\x36\x97\xa3\x68\x9b\x00\x6f\x8f\x95\x86\x4e\x16\x7b\x87\x8e\xda\x80\xf1\x06" +
+	"\xeb\x40\x56\x97\xf4\x24\x63\x7a\xda\x50\x49\x4f\x7f\xc5\xe9\xa9\xc9\x20\xed\xb3" +
+	"\xcf\xd6\x8e\xfc\xb5\xa9\x67\x60\xd6\x49\x6f\x45\xc6\x7b\x96\xf8\x45\xeb\x17\xde" +
+	"\x19\x6e\x5f\xfb\x7a\xbf\xd0\x9f\x6a\x0f\x45\xa9\x9b\x8d\xc5\xa1\xdf\xf1\x46\x04" +
+	"\x72\x4c\xe8\xe4\xf6\x5b\xfe\x71\x96\x78\xa3\xe6\x50\xb4\x20\x3f\xe6\x54\xf9\x8a" +
+	"\xfe\xc6\x9f\xe2\x1d\x1c\xa5\x34\x24\xf0\x97\x38\x5e\xe1\x78\x81\xf7\xeb\xbb\x5f" +
+	"\x5e\xfe\x7e\x67\xbe\x6f\xd1\x91\x2d\xd6\xbf\x9e\x11\xf5\xf2\xa2\xed\x84\x75\xc0" +
+	"\x3b\x56\xdb\x9c\x98\x03\x73\x49\x0e\x7f\xbf\x4f\xfa\xeb\x75\xf9\x3b\xea\x60\xe9" +
+	"\x3e\x1e\xcc\x4e\x24\x22\x88\x1f\x07\x5d\x07\x18\x31\x9e\x11\xad\xde\x96\x69\x28" +
+	"\xff\x26\x50\x2f\x09\xe2\xe3\x76\x40\x75\xf0\x1c\x5f\xbe\xb0\x10\x52\xd2\x34\x0e" +
+	"\xbd\x0d\xeb\x73\x27\xe0\x21\x9e\x3e\x8d\xe4\xec\x38\x32\xd7\x22\x20\xf6\x7c\x2c" +
+	"\x4f\xc9\xe7\x81\xd6\x34\x29\xbe\x46\xf4\xcb\xab\x8c\x74\xd1\x71\x83\x36\xd0\xf5" +
+	"\x0b\x59\x3d\xd3\x81\xdc\xd6\xf6\x0b\x7a\x51\x1e\xe6\x4f\x18\xe5\x72\x80\x12\x6f" +
+	"\xb0\x0e\xe4\x0f\xbb\xf8\xfe\xdb\xbf\x10\xec\x5f\x64\x4f\x33\xc7\xca\xc2\x86\xb7" +
+	"\x2d\x94\xd0\x80\x23\x52\x7f\x9c\x78\xeb\x38\xfc\x38\x54\xcd\x00\xfc\x0b\x8c\xf4" +
+	"\x8a\x67\xc1\xaa\x40\x4c\x0b\x80\x92\x9a\xf1\x48\xd5\x03\x53\x69\x4e\xe2\x0d\xea" +
+	"\x0d\x9a\x23\xaf\x11\xe6\xd4\x2c\x04\xa3\x5f\x5f\xa4\x17\x32\x2e\x9e\xd6\xb8\x4b" +
+	"\xcc\xcf\xa9\x8a\x28\x36\xe2\x75\xfa\x6d\x44\xff\x77\xd8\x09\x21\x08\x15\x56\xa2" +
+	"\xee\xb5\xea\x14\xeb\xc8\x7b\xf1\x06\xfe\xbd\x24\x45\x1b\x9b\x13\xd2\x7a\xc5\x5d" +
+	"\x60\xde\xfc\x9e\xc9\xff\xc9\xb1\xc6\x7f\xe8\x55\x17\x4c\xde\x51\x0f\x6d\x1b\x8f" +
+	"\xf8\xf6\x9a\x80\xa8\x09\xea\xca\x55\x6c\x7c\x7b\x29\x3a\x14\xbf\x81\xe2\xd0\xb6" +
+	"\x15\x6f\x70\x07\x79\xc9\x0e\xf9\x03\x87\xe9\x44\xf2\x65\x9d\x7f\x9e\x20\x8a\xf4" +
+	"\xac\x3e\x19\xd8\x17\x8b\x23\x71\x27\x8a\xfd\xad\x79\xef\xeb\xe5\xe1\x27\x96\xe7" +
+	"\x45\xaa\x93\x38\xfc\x56\x20\x41\x14\x79\xc2\x21\x8b\x43\x59\x41\x8d\x67\x54\x35" +
+	"\x20\x96\x23\x06\x0f\xee\x91\xd3\x49\xa5\xf0\x4a\x81\x54\x86\x76\xc1\x7e\x84\x8f" +
+	"\xd7\x4b\x3a\x46\xcf\xfe\x5e\xf2\xb6\xe4\xb5\x17\x78\xc5\x35\xde\x37\x4c\xbf\xb0" +
+	"\xdd\xc1\x1a\x46\xdf\xab\x5b\xbf\xb0\xd2\x64\xab\x79\x8e\x9c\xe7\x08\xa5\x25\x0c" +
+	"\xda\xe3\x17\xad\x37\xf4\x27\xf9\xcc\x70\xfa\x9c\xc0\x2d\x1a\xb9\xfe\xaf\x4a\xbd" +
+	"\xff\x2a\xb0\xde\x40\x89\x39\xe5\x17\x49\xfe\xbd\x74\xf1\xc9\xbb\xf4\xff\x11\xc2" +
+	"\xfc\xed\x7f\xc5\xff\xe7\x1d\xd1\x37\xb5\x6a\x0d\x89\x99\x01\x3d\x9f\xcf\x5c\x48" +
+	"\xda\xa1\x91\x95\x58\xce\x3e\x44\xed\x6b\x6c\xc0\x4a\xe3\xd6\x8f\x01\xd6\xe3\x5f" +
+	"\xb4\xde\xf0\xae\xef\x2d\x1d\x55\xd9\xce\xe7\x9b\xaa\x20\x4e\x15\x97\x1b\x84\xe3" +
+	"\x17\xfc\xc6\x1b\x1a\xeb\x9a\x73\xd8\x5d\xf9\x42\x4f\xa0\xff\xa7\x23\x81\x41\xbf" +
+	"\x21\xd6\xf8\x7e\x5e\x00\x48\x2e\xf5\xf5\x0b\x7b\x84\x44\x66\x91\x87\xbd\x06\x59" +
+	"\x9d\xd6\x41\x9b\x03\xa7\x56\x78\x8b\xe8\x2b\xb9\x41\x5c\xbf\xb0\xfe\x82\xed\xc6" +
+	"\x23\xdb\x3e\x67\xef\xc9\xc2\x5f\xb0\xde\xa8\x0d\x34\xf2\xa8\xe6\x2e\x80\x6b\x7e" +
+	"\x5b\x09\x82\xfc\x7f\x21\x52\x3b\x5a\xea\xc6\xcd\x3d\x71\xd0\x75\x80\x11\x53\x52" +
+	"\xfd\x22\x0b\xd4\xb3\x10\xea\x1c\x72\xb7\x2e\x5e\xbb\x8c\x10\xdf\xf1\x0c\x59\x5c" +
+	"\x47\x23\x9b\xc9\xe4\x0e\x3c\x12\x3a\xf5\x3f\x94\xd6\x6b\xa4\xb1\x66\xb7\x25\x5d" +
+	"\xe3\xee\xd7\xf8\x0f\x63\x7d\xd7\x52\x4e\xf7\xc3\xb3\x33\x71\x9a\x96\x41\x5c\xc3" +
+	"\x77\x32\x9a\x54\x70\x04\x7a\xfe\xd1\x1f\x08\x52\x3d\x8a\xee\x4b\x0d\xa0\xd1\x29" +
+	"\xe8\x4f\x76\xe8\x49\x5f\x9e\xd2\x1b\xaf\x64\xff\x52\x70\xe5\xdf\x1f\x5a\xfc\x7b" +
+	"\x44\x90\x17\x0c\x9f\x5a\xc4\x73\x55\x7f\xb2\x5f\x14\x11\xac\x9e\xfb\xaa\x4b\xf4" +
+	"\xf7\x59\x2e\x5f\x62\x4a\x3c\x66\xd0\xef\xba\xc5\xbd\x1a\x57\xc2\x56\x3c\xc8\x4b" +
+	"\x70\x98\xef\xd4\xfe\xb8\x21\x7a\xf3\x85\xdf\x24\xf6\xc0\xa1\x6c\x60\x60\x9b\x91" +
+	"\xda\xe9\x03\x84\x7b\x61\x83\xfa\x53\xb9\x33\x66\x9d\x4f\x81\xfe\xb6\xcf\x72\xff" +
+	"\x47\xe0\x08\x6b\xa3\x94\xe6\x7f\xb0\x1c\xbf\xee\x14\xfd\x4f\xc0\xe7\x1b\xef\xff" +
+	"\xb4\xb8\x46\xd3\x81\xed\xa5\xf4\x60\xcd\x0c\xb5\x2a\x0d\xe2\x8d\x31\xfe\xfc\x58" +
+	"\x22\xb6\xef\x02\xd1\xa0\xb3\x21\x10\xbb\xb9\xff\x3c\x96\xfa\x06\x5d\x1d\x8c\x72" +
+	"\x7c\x83\x6b\xc0\x63\xeb\xbd\xc9\xb4\xe0\xfe\x45\xf6\xcc\x9a\x16\x0f\x4b\xe3\x85" +
+	"\x09\xde\x99\xfc\xa2\xc5\x2f\xfc\xcd\x38\xfe\xaa\xac\x91\x08\x5c\x8b\xf3\x5d\x07" +
+	"\x1e\xe5\x28\xd3\x95\xc7\x00\xb8\xb2\x0f\x00\xac\x8b\x59\x12\xa4\xdb\x87\x70\xbb" +
+	"\x51\xcb\xe3\xfb\xf8\x86\x28\x80\xf7\x69\xb8\x45\x56\x6d\x43\x9e\x43\xd8\x1a\x4a" +
+	"\x37\x03\x8d\x68\x61\x64\x80\x02\xd1\x3b\xe1\x44\xd9\x86\x1e\x0a\x0b\xb2\x14\x99" +
+	"\x23\xc4\x1b\x79\x89\x2e\xaf\x5e\x63\xdf\x12\x87\x4c\x48\xdc\xd9\x80\x47\x0f\xbc" +
+	"\xfc\xfa\xe7\xdd\x97\x20\xd0\xe1\x57\x27\xea\xdf\x89\xbe\x8f\xd5\x49\xd7\xa1\x11" +
+	"\xd2\x6f\xd6\x1e\x32\x5f\x3a\xe8\x37\xc4\x13\x57\x5f\x87\xfc\xe6\x56\x61\x0b\xc1" +
+	"\x96\x8f\x42\xf1\x06\xeb\x40\x23\x33\xec\xac\x2e\xa9\xb5\xa5\xff\x82\xf6\x12\x2f" +
+	"\xd4\x64\x1b\xed\xee\xb7\x1a\xbd\x26\xc4\xfb\x39\xc7\x8b\x2f\x5b\x32\xbd\xc6\x92" +
+	"\x09\x60\x85\x83\xa8\x18\xa6\x2a\xe4\x8e\x56\x1b\xd0\x22\xed\xe4\xe2\x15\x8f\xeb" +
+	"\xa6\x16\x51\xbd\xbc\xc1\x04\x14\xf2\xa0\xe4\x01\xcd\x1f\x6e\xab\x69\x51\x4f\xe1" +
+	"\x5b\x31\xae\xb4\x39\xb1\xe2\xec\x50\x84\xb3\x91\xdb\xaf\xce\xa0\x10\x2d\x8a\x41" +
+	"\x9e\x39\x61\x85\x07\x6a\xc5\x3b\xcf\x1b\x59\x31\x8f\xf7\xa5\xca\x90\x1e\x53\x52" +
+	"\xf1\x42\x45\x3d\xb5\xbd\x89\x97\xb5\x29\xc4\x83\xbd\x10\x5d\xef\x85\xea\x70\x03" +
+	"\x32\x22\xb2\xe6\xa7\xd7\x77\xa6\x1f\xd0\xce\xb6\x86\xcf\x13\x59\xf1\x63\xde\x57" +
+	"\x40\x1d\x60\x24\xf1\x92\x5d\x68\xb2\x46\x34\xe8\x3a\x89\x03\x58\x9a\xb7\x33\x95" +
+	"\xde\xb6\x1f\x7a\xcb\x0a\xba\x51\x4f\x50\xd1\x4d\x70\x4c\x75\xa3\xa7\xa6\xe5\x06" +
+	"\xfc\xdb\x2d\x1d\x65\xaa\xdb\x87\xe1\x8b\xdb\x20\x7d\x43\x5d\xa4\x81\x36\xd5\x34" +
+	"\xf3\x9b\xa9\x87\x88\x82\x70\x58\x86\x18\x71\x1f\x1f\xcd\xb4\x60\x15\x14\xaf\x4d" +
+	"\x81\x69\xf9\x91\x83\x34\x68\x65\xb9\x2f\x3b\xe5\x4e\x86\x97\xfc\xda\x04\xf9\x41" +
+	"\xba\xae\xd1\xea\x32\xad\x3c\xe8\xf3\x89\x37\x58\x07\xd2\x86\xe8\x86\x7a\xea\x83" +
+	"\x64\x3a\xe1\x4f\x0f\x2b\x96\x83\xfe\xf4\xe4\xf7\xa4\xe5\x92\x19\x9d\x93\x3a\x80" +
+	"\x68\x05\x38\xf2\xea\x3e\x37\x9b\xe3\x49\xe0\x9f\x3b\xa0\x4a\x7f\xaa\xfe\x4f\x3f" +
+	"\x52\x4e\xcf\xa4\xbd\x46\x7a\xc2\xa7\xc2\xf5\xf2\x04\x2c\x06\xff\xce\x0f\xc0\x0b" +
+	"\x4a\x76\x0e\x9e\xfc\xc4\xdb\x4e\x0c\x34\x25\x25\x8c\x02\xc9\x25\x19\x67\x6e\xc9" +
+	"\xc5\x35\xa4\x97\xae\xc2\x43\xd1\xd1\x53\xd2\xcd\xe7\x9a\xec\xe6\x33\x54\xff\x17" +
+	"\xc2\x53\x10\x14\x48\x4d\x7d\x35\x35\x3b\x43\x4f\xed\x87\xda\xd0\x31\xa4\xb2\xb2" +
+	"\x62\xd9\x84\xff\x08\x21\xb9\x7a\x7e\x7a\x85\x6c\x27\x0e\x42\xe4\x78\x79\x34\xca" +
+	"\x7b\x58\xb7\xa5\x17\x1c\x43\x5c\x15\xff\x8a\x56\xc1\x12\xd6\x39\x17\x5e\x74\x09" +
+	"\xab\xa5\x2c\xc8\x17\x14\xdb\xe0\x96\xb0\x7b\xb2\x8a\x47\xf3\x45\x09\x75\x84\x35" +
+	"\x5c\xba\x0e\xae\xc5\xf9\xc2\xaa\xcc\x53\x2d\x33\x57\x49\x44\xaa\x13\xd5\x51\x01" +
+	"\x83\x7e\x44\x3d\x4f\xc4\x63\x49\xf8\x5a\x94\x99\xb6\xc4\xa5\x53\x1b\x55\xa4\x5a" +
+	"\xb5\xcc\x72\x50\x3e\x5e\xd1\x34\xc0\xa3\x96\x1f\x3d\xca\xe4\x56\x08\x54\x42\x53" +
+	"\x35\x47\x30\x4f\x23\x9d\xad\x51\x8a\xaf\x4f\xca\x1a\x0b\x3e\x20\xba\x3a\x10\xd6" +
+	"\x97\x43\x00\xac\xf8\x82\x32\xdc\x69\xe8\x12\x84\x12\xb3\xdb\x02\xb3\xb8\x1a\x30" +
+	"\x05\x20\xb1\x39\x80\x1d\x49\xbb\x87\x0c\x45\xd2\x90\x50\xf9\x7e\x98\x62\x4b\xd9" +
+	"\xf7\xd0\xbf\x40\x31\x3b\xf5\xe4\xf2\xb4\x5c\x3d\x5a\xd2\x40\xb5\x78\xbf\x2a\x3a" +
+	"\xc1\x2c\x5a\x36\x95\x46\x6a\xd2\xca\xac\x13\x44\xe5\x39\xf3\x11\xaf\xe5\x19\xbb" +
+	"\xa6\x5b\xd2\xa8\x72\xaf\x99\x76\x16\x63\x1d\x45\xef\xc2\x9a\x05\xa0\x36\xae\xfc" +
+	"\x42\x73\x1d\x17\x9a\xab\x9f\x1f\x43\xe0\x2a\xeb\x4a\x32\xe3\xc6\x12\x35\x4f\xa3" +
+	"\x3a\x48\x3a\x5c\x1e\xfe\x83\x44\xb1\x21\x3a\xf7\x34\x6a\xcf\x02\x8d\x28\xaa\xaa" +
+	"\x23\x35\xe7\xbf\x94\xf7\x4a\x1a\x71\x46\x4c\xe9\x73\x45\x0f\x4e\x2d\x1f\xba\x3e" +
+	"\xae\x96\xfc\x00\x0f\xb5\xd5\x23\x01\xcc\x5c\xd7\xcc\x4f\x5d\xf1\xb2\xae\x78\x79" +
+	"\x87\x83\xb2\xb5\xbf\xa4\x9f\xb0\x22\xd5\x5a\xaf\x99\x2c\xcb\x4f\x43\x14\xae\x5e" +
+	"\xab\xe6\x36\x19\x87\xad\xdc\x03\xc5\xa1\xca\x88\x1b\xab\x75\xae\x35\x0d\xa5\xad" +
+	"\xf5\xa2\xcc\xa7\x0a\x90\xee\x8e\x8a\xea\x8e\x8a\x3a\x3e\x1c\x05\xf8\xa2\x47\xaa" +
+	"\xf9\xaa\xcd\x88\x97\xfa\x16\x2e\x89\xad\x87\x89\xe0\xdd\xf9\x59\xed\x7c\x3f\x78" +
+	"\x8d\x3d\xe0\xb5\x4b\x1b\x48\xbd\x76\x26\x31\x5c\x95\x86\x5d\x64\x91\xab\x3a\xa1" +
+	"\x9c\x23\x48\x54\xe9\x52\xe1\x68\x8a\x71\xa2\xb8\x43\x31\x96\xc0\x0f\xf0\x8d\x52" +
+	"\x9e\x8e\xad\xf4\x8a\xd1\xef\x8c\x92\x67\x3d\xc6\xc4\x66\x2e\x3f\xc1\x81\xf9\xc2" +
+	"\xde\x5d\x83\xd1\x2c\x01\xd4\x0b\xb1\x0c\x05\xa9\xfa\x2c\xae\x2d\xd4\xab\x8f\xf9" +
+	"\xf0\x7a\x91\xbe\x6f\xac\xbb\x6f\x40\xfb\x7e\xe2\xd4\xc2\x3c\x23\x0a\xdd\xc2\x8c" +
+	"\x8c\x7e\xbc\xc1\x3a\xe9\xe5\xe0\x38\x6e\xb8\x4c\x4e\x90\xf4\x80\x41\x7e\xa3\x5f" +
+	"\x81\xed\x86\x6c\x47\x6f\x7c\x3c\xa7\x16\xd6\x35\x1e\x4e\x54\x8e\x5e\xbc\xfc\xad" +
+	"\xcb\x9b\xa3\xbc\xfc\x89\x16\x56\x93\x3e\x69\x61\xcd\x1f\x82\x35\x52\x5a\x78\x84" +
+	"\x9a\xa6\x16\x1e\xf1\x79\xf1\xd5\xbf\x11\x7f\xf8\xf6\x50\x8b\x07\x6c\x57\x48\x95" +
+	"\xc7\x82\xf4\xa0\x07\x5f\xdb\x16\x48\xff\x0c\x38\xfa\xda\xb4\x90\xf2\x8c\x48\x19" +
+	"\x40\x35\x30\xe1\x34\xbc\xa5\xca\xcb\x6b\x87\xca\x3d\xba\xb9\x78\x7e\xa8\x78\x46" +
+	"\x6e\x0d\xa2\xcf\x44\x43\x24\x09\x47\x61\xb1\xa1\x4c\xc1\x80\x1a\x21\xa7\x17\x8f" +
+	"\x46\x52\x1b\xe2\xae\x91\xa6\x18\x5e\x30\x44\xf9\x55\x61\x76\xcd\x4e\x14\x85\x08" +
+	"\x92\x51\x4d\x3a\x20\x1e\xb9\x47\x6f\x2a\x69\xac\x32\xb2\xba\xd7\x83\xed\x7f\x1d" +
+	"\x8b\xe7\x33\xa0\xe7\x1d\xbe\x0f\xb2\x40\x3d\x16\x81\xed\x50\x85\xd0\x12\x6a\x44" +
+	"\x29\xd6\xb9\xfc\x15\xb5\xac\xfa\xfb\xc7\xda\x3a\x77\x91\x08\x7e\xa7\x65\xbb\x81" +
+	"\xda\xe4\x58\x55\x8e\xa2\x53\xf7\x52\xc7\xa9\x94\xc2\x88\x3f\xf9\x9d\x1b\xfc\xc5" +
+	"\x16\xea\x12\xda\xee\xd4\x3a\x84\x23\xcd\xfd\x4d\xaf\x5e\x65\x37\x9e\xd5\xd5\xcc" +
+	"\x1d\xf5\xde\xd0\x98\xd1\x03\xf3\x45\xa4\xeb\x49\x49\xac\x74\xb6\x2b\x88\xa2\x80" +
+	"\xda\xc3\x85\xaf\x6e\x0f\x7b\x71\xf3\xc0\x6e\x1c\xa8\x39\x1a\x71\x12\x19\x6c\x5c" +
+	"\x58\xd7\xe7\xc0\x91\xfd\x23\x18\x77\x72\x51\x72\x2e\x40\x57\xba\xe0\x36\xb4\x70" +
+	"\x49\x6b\xb2\x53\x02\xfc\x8d\x42\xf4\xdf\x5e\x23\x5c\x03\x85\xb9\x5a\x3e\x6e\xcc" +
+	"\x8a\x5c\xa2\xc5\x5d\xe5\x78\xe1\xbe\x57\x66\x89\x86\xa6\xdb\x16\xfe\x16\x94\xd6" +
+	"\x51\x0d\xaf\xe8\xc7\xcb\xf9\xb4\xa9\x2d\xe1\xf1\xc0\xe0\xff\x00\x4a\xb1\x04\x0b" +
+	"\xf9\x7a\xf9\x5b\x0b\xbd\x57\x64\x84\x9e\xf2\x71\xfd\xab\x2d\x61\xc7\xc3\xad\x43" +
+	"\x51\x15\x7d\xc8\x51\xa7\x31\xca\x12\xd0\x83\x0c\xc0\x6b\x32\x5a\xcd\xef\x45\x1f" +
+	"\x16\xdd\x71\xcf\xf8\xd4\xb0\x5c\x82\xd2\xc0\xbe\x31\x42\x13\x01\x58\x6f\x12\x62" +
+	"\xe1\x71\xc1\x8e\x82\x90\xca\xe6\x0a\x3a\xe4\x21\x0f\xaa\x64\xa1\x23\xd2\xe2\x5e" +
+	"\x9e\x46\xe5\xb0\x09\x56\x25\x3a\x9f\x54\xa7\x96\x7c\x58\x93\x6e\x0b\xc2\x7e\x4f" +
+	"\x45\x47\x6c\xf5\x94\x1b\x50\x10\x66\xe3\xe4\xd2\x7d\xa0\x16\x48\x97\xd4\xa1\x0a" +
+	"\x1e\xf5\x14\xa8\xe2\xdc\x44\x52\xe3\x82\x05\xab\xf9\x01\xd6\x1b\xb5\x81\xec\xf9" +
+	"\x9b\x5f\x15\x16\xb2\xf4\xbb\xa7\xd9\x3d\x45\xfa\x57\xce\xad\x46\xd4\x49\x35\x2e" +
+	"\xc3\xa8\xf9\x44\xd5\x70\x42\xed\x06\x9c\x42\xfe\xc2\x23\xa2\xf9\x43\x4a\xc9\x73" +
+	"\xf9\x38\x5a\xb6\x94\xd7\xca\x3c\xa6\x08\x7f\x5e\x29\xb9\xbb\x46\x90\xc6\x75\x58" +
+	"\xf5\x71\x00\xe2\x9c\xcb\x2f\x68\x03\x77\x49\xa6\x33\xa3\x2d\xe5\xc1\xbb\xc1\x60" +
+	"\x19\x7f\xd7\x7a\x23\xb6\xc5\x9c\x5a\xf1\xa5\x50\xbf\x52\x58\xdd\x43\xed\xdd\xa0" +
+	"\xec\xa8\x2d\x28\x24\xb2\x60\x67\xf8\x12\x14\xcd\x14\x8a\xa0\x93\x6a\xa4\x89\x8c" +
+	"\x74\xfc\x8b\x62\x16\xf1\x01\xe8\xd9\x23\x04\xbd\x77\x90\xce\xc9\x44\x43\x48\x69" +
+	"\x45\xe0\x1f\xa4\x62\x2e\x2f\x15\xde\x8d\x28\xfd\xca\x17\x57\x02\x7a\xca\x5b\x39" +
+	"\xde\xf6\x22\x25\x51\x1c\x6f\x70\x07\x59\x4d\x10\xd4\x95\x2b\xc5\x5e\x42\x96\xf6" +
+	"\xf4\x94\xac\x47\x45\xef\xf4\x77\x8c\x45\x58\x95\xab\xab\x21\x1f\xe8\x8e\x1b\xd9" +
+	"\x46\x6d\xa2\x86\x6c\x8d\xda\x86\x98\xdf\x93\xc4\xb0\x2b\x57\x8c\x56\x22\xd4\xd3" +
+	"\xc4\xbb\x9c\x18\x0f\x2f\x27\xfa\xa8\x05\xa1\x4e\xce\xa1\x17\x71\xb0\xd9\xd6\xcb" +
+	"\xaa\x91\x71\x59\xe3\x7b\xd5\xa6\xc9\xb0\x97\xf1\x1e\x45\x30\x65\xa1\x3c\xc3\x5b" +
+	"\xca\x6d\xff\x82\x4e\x5a\x18\x72\xfb\x9b\x53\x96\x53\x37\x52\x20\xda\xb9\x8d\x78" +
+	"\xb9\xda\x4f\xb4\xa5\x5e\x33\xd1\x2c\x52\x57\x07\x9e\x80\x37\xc7\xb9\x9b\x43\xba" +
+	"\xfa\x95\xfd\x59\x00\x26\x59\x07\xcd\x91\x3d\xd6\x91\x02\xf7\xa9\xc5\xf0\x40\x09" +
+	"\x43\x83\xa1\x84\x43\x01\xce\x4c\xc2\xdb\xf0\x29\x99\x16\x04\x31\xec\x5a\x97\x0d" +
+	"\xc0\xc0\xb0\xd6\x8f\x81\x03\x2b\xa8\x59\xe5\x03\xb0\x77\x84\x4a\x92\xa0\x0d\xe9" +
+	"\xd7\x76\x4b\xbf\x36\x9d\xf0\x91\xa7\x0f\xaf\x9e\xf7\x46\x59\x40\x33\x81\xd1\x76" +
+	"\x4b\x6d\x36\x53\x7a\xa3\x98\x7c\x8d\xa0\x62\x97\x62\x0c\x59\xb6\x72\x68\x09\x16" +
+	"\x17\xa4\xd3\x08\x40\x32\xc6\x08\xd7\xad\xf3\x2f\xfc\x2b\x3c\xb6\x1b\x8f\x4c\x56" +
+	"\x1c\x7c\x43\x7b\x16\xe8\x15\x1d\x8d\x47\x74\xe5\x53\x41\xba\xcd\xad\x94\xbe\x28" +
+	"\x31\xa1\xba\xe6\x97\x42\xa1\x72\x91\xaa\x11\xe5\x85\x88\xac\x4e\xad\x50\xe3\xd5" +
+	"\x2a\xc4\xae\xf6\x38\x24\x72\xd5\x6b\x70\xb1\x4b\xbc\x5e\x25\xdb\x73\xdc\x80\x3c" +
+	"\x9c\xbd\x39\xa1\xfc\x71\xfe\xe6\x48\xb9\xa4\x8c\xa1\xb9\x26\x06\x28\x2f\x9e\xbc" +
+	"\xf1\x47\x0e\xe3\x40\xc4\xc2\x3c\xd5\x8f\x7c\x6e\x82\xa7\xa1\x1f\x1d\xc7\x4a\x3a" +
+	"\xad\xa4\xa7\xd7\x46\x74\x54\xf6\x39\x6a\xf6\xac\x2e\xab\x31\xa0\xc5\xab\x3f\x47" +
+	"\x49\xad\xe2\xb1\x92\xd2\x3f\xf5\x5b\xa3\x3c\x38\x73\x2d\xa1\x45\xc4\xd3\x9c\x28" +
+	"\x7d\xf6\x43\x0c\x47\xe5\xf8\xa2\x36\xe0\x2b\x7e\x91\xaa\x9e\xc7\x1b\xca\x0f\x39" +
+	"\x9a\x3c\x8d\xda\xa2\x34\xe6\x87\x77\xf8\xcc\x9e\xcc\x08\x5e\xdf\xd8\x0f\xd0\xa6" +
+	"\xef\x91\x5d\x8b\x3b\x47\x0c\xa4\xde\x93\x1c\xfa\xe6\xe5\x4f\xe2\x72\x86\x8d\x07" +
+	"\xe9\x13\x1a\xb4\x0d\x40\xb5\x74\x3e\x24\xa2\x2c\xa2\x24\x40\x96\x00\xa2\x05\x95" +
+	"\x88\xd6\x12\x42\x8a\x6f\xa3\x29\x8f\x36\x24\x78\x6e\x6c\x09\xd4\x56\x07\xed\xd1" +
+	"\x3c\xc0\xc8\xf7\x8c\xe5\xeb\xfb\x38\x66\x6a\x1b\xc6\xe2\x14\xa2\x17\x73\xb8\x4e" +
+	"\xb9\x81\x7e\xe5\x76\x71\x1f\xb0\xd5\xb4\x8d\xfa\x69\xd5\x17\x8f\x06\xc7\xaa\xb1" +
+	"\xaa\x90\x08\xc3\x40\x6d\x20\x7b\x91\x47\x1f\x9d\xda\x91\x67\xba\x7b\x38\x57\x46" +
+	"\xf8\xc8\x45\x19\xb7\x69\xa6\xf6\x48\xe5\x19\xca\xdf\xc0\x36\x02\xad\xe8\x5b\x73" +
+	"\xd2\x17\x92\x82\x7b\x6a\x46\xec\x45\xfe\x77\x8d\x6a\x81\x74\x64\xd9\x60\x88\x13" +
+	"\x07\xd1\x03\x58\xb3\xe8\xdf\x56\x33\xab\xd4\xa2\xcf\x32\x3e\xa9\x54\x17\xe3\x71" +
+	"\xe4\x1d\xab\x6a\x2b\x25\xc2\x93\x51\x75\xce\x3f\x65\x7f\xcf\x39\xab\x17\x84\x50" +
+	"\x92\xa2\xf5\x01\x72\x73\xba\x78\x6d\x1b\x2b\x60\xff\xa8\xfa\x72\xfb\x9e\x2d\x5d" +
+	"\xf8\xa1\xc5\xae\x3b\x7c\x4e\x29\x8c\x65\xa1\x88\xe7\xe0\xf2\x88\x13\x6f\xef\x01" +
+	"\x47\x6c\x1d\x74\x04\x38\xb5\x47\xf7\x8b\x19\x00\x85\x41\x66\x3e\xde\xb0\xfd\x79" +
+	"\xab\xf7\x50\xae\xee\x9a\xd5\x9b\x1f\x34\x18\x55\x23\x72\xbc\xa0\x46\x7c\xf3\xd3" +
+	"\xbe\x82\xa3\x01\x3c\x36\xe0\xec\x8b\x1b\xdf\x34\xda\x5f\x7e\x2a\x1e\x93\xec\x10" +
+	"\xeb\x4f\x7f\xfd\x32\xaf\x21\x95\xfb\xf7\x33\x71\x31\x77\x16\xa1\x19\xb6\x20\xd9" +
+	"\x7c\x00\x9f\x27\xdc\xd5\x48\x13\xd1\x63\x86\x55\x34\x02\xe0\x2c\x19\x55\x19\xec" +
+	"\x08\xb7\xf0\xe8\xe6\x2a\x0a\x3d\xcf\x6d\x93\x65\x34\x88\x3c\x68\x66\x1e\x54\x89" +
+	"\x76\x39\xcc\xde\x1b\x02\x43\x78\x78\x83\x10\xad\xe5\x00\x36\x28\xb7\xb0\x9f\xa5" +
+	"\x40\x8f\xe7\x16\x12\x40\x2b\x27\xc8\x48\xa1\xc5\xd5\xe6\x6b\xab\x2d\xd4\xb8\xb1" +
+	"\x02\xad\xbf\xb1\x12\x54\xfd\xe3\xca\x8c\xbd\x05\xfa\xd8\xa0\x56\x60\x3d\x3d\x0f" +
+	"\x02\x29\xa2\x87\x3f\xc0\xfa\xc8\x76\x6f\x26\xb6\xef\x66\x62\xc3\x74\xb0\x5c\x95" +
+	"\xc1\x35\x22\x88\xc7\xb1\x84\xd4\x7a\x6e\x8b\x76\x1b\x1c\xee\x10\x8f\xc7\xf6\x1c" +
+	"\x6e\x95\xdb\xcd\x55\x44\xfa\xd9\x20\xcc\xc1\xb2\x0e\x0e\xd6\xa2\x0f\xca\x5c\x6c" +
+	"\xe1\x4a\x9b\xad\x5e\x31\x8f\x7a\x09\x59\x94\xb6\x45\x5b\x44\x9b\xfa\x7a\x03\x05" +
+	"\x55\x34\x28\x43\x99\x26\x36\x99\x86\x34\x12\x07\xb0\x9a\x12\x44\x86\xa4\xf7\x9b" +
+	"\x1e\xfa\x46\x6d\xe9\xd1\x3d\xda\x5e\x78\x7a\xd0\xc7\xb6\xe4\xab\x35\x07\x8a\xb1" +
+	"\x0d\xa5\x51\x8f\xe4\x5f\xc3\xdd\x94\xab\x44\xc7\x88\x51\x29\x26\x0c\x3f\x80\x12" +
+	"\xe8\x4c\x40\xd4\x53\x9e\x6f\xae\x94\x28\xea\xa3\x5d\xc2\x96\x58\x6c\xe1\x2a\x83" +
+	"\xb0\x86\xda\xe6\x77\x4d\xcd\x6f\xd9\x61\xe6\xcb\x55\xb8\xf3\xbe\x07\xd0\x8b\x2f" +
+	"\xdc\xe7\x6c\x5b\x81\xa9\xd9\xb6\x42\x65\x4b\x44\x0c\x4a\xa1\xaa\xbc\x6f\x61\x8c" +
+	"\x2a\x58\x8c\x67\x47\x68\xb4\x6c\x5b\x39\xb1\x22\x13\xeb\x2f\xa8\xf8\x33\xdf\x4d" +
+	"\x58\xfc\xbf\x41\xeb\xa0\x96\xc8\x3b\xb3\x56\x8f\xac\x21\x75\x17\x46\xab\x49\xc5" +
+	"\x57\x18\x7d\x37\xf0\x20\x33\xb4\x3c\x3c\x56\x89\x0a\x07\xdb\xa9\xfd\xe3\xea\x55" +
+	"\xaf\xba\xe3\xab\x28\x5d\xbd\x14\x92\xb1\x69\x21\x6b\x0a\x6b\x52\x4c\x33\x75\xf6" +
+	"\x70\x5b\xe9\xcf\x4b\xe5\x59\xf8\xc5\xd8\xb2\x62\x35\x0c\xca\x6b\x78\xb2\x13\xff" +
+	"\x1b\x3e\x20\xca\x7b\x41\x76\xb5\x6d\xe5\xbd\x62\x73\x12\x60\xb5\x79\x8f\x72\xde" +
+	"\xde\x02\x6f\x9e\xcf\xd5\x48\x88\x4a\xad\x51\x36\xff\xe4\xe5\xe9\x6c\x46\x20\x89" +
+	"\xf5\x99\x48\x1c\x7e\x7b\x1a\x41\x4a\xc8\x7d\x01\xc8\x7e\x8a\xa8\x25\xab\x77\x40" +
+	"\x1d\x51\x19\x19\xb9\x0e\xcb\x55\x2e\x7f\x1c\xfb\x8e\x4d\xec\x6f\x3c\x4a\x63\xca" +
+	"\xa7\x0d\x78\x36\x98\xd9\x10\x15\x67\x8c\x71\xa7\x2a\xe5\xd2\xf5\x5c\xa7\x23\xc0" +
+	"\xb2\xaa\x45\x61\x37\x2b\xb8\x7b\xcb\x5c\x3e\xeb\x88\x52\xf4\xc5\xb7\x66\xe2\x42" +
+	"\x7f\xdb\x3e\x7a\x3d\x1f\x9b\xa0\x92\x6f\x43\x8d\xb6\xa9\x71\x28\x69\xe3\xca\x14" +
+	"\xce\x11\xe0\x49\xf5\x97\xd2\x1a\x7f\x38\xbd\x6c\x69\xf5\x8e\xe5\x60\xbd\x51\x13" +
+	"\x7a\x87\xfc\x73\x83\x11\x04\xeb\xc9\xae\x03\xb7\x84\xfa\xb5\xc6\xb1\xfe\xd4\x34" +
+	"\x4d\x8c\xae\x8d\x2d\xd4\x96\x9e\x9a\xfb\xd2\x73\x1b\x61\xe4\xe4\x50\xd2\x42\xef" +
+	"\xa4\x3e\x76\x40\x83\xc4\xe8\x91\x5a\x1e\xa6\xe7\x61\xbb\x31\x81\x6f\xcc\x37\x4c" +
+	"\xf9\xb4\x36\xd4\xa7\x96\x74\x70\xe8\xbf\xc8\x02\x3d\x3d\x0d\x8b\x10\x3c\xf7\x56" +
+	"\xb5\xb8\xb9\x3d\xfd\x37\xa6\x91\x2d\xc6\xf3\xa1\xc1\xa0\x39\xf2\x38\xaf\x0b\xa1" +
+	"\xa6\x77\xd0\xe9\x3a\x80\xc7\x70\x77\xaf\xa5\xbc\x95\x8b\x5b\x07\x84\xa5\x85\xa5" +
+	"\x49\xca\xfa\x54\xa6\xfc\xa3\x78\xfe\x2c\x33\xa8\xbd\x2d\x31\x5b\xb8\x72\xd8\x12" +
+	"\x67\xe9\x6f\xf1\xc9\x04\x09\xdf\x53\x1e\x06\xa1\x6e\xcf\x1d\xae\x9c\xcc\xbc\x4e" +
+	"\xac\xd1\xe0\x23\x57\xa7\x8c\xfe\x04\x43\x79\xf1\x6c\xe7\x2f\xf5\x0d\xc3\xab\x3f" +
+	"\x2e\x38\x92\x18\x57\x29\xed\xdc\x58\x88\x37\x58\x07\xf2\xcc\xf7\x85\x07\xc7\x2c" +
+	"\x7d\x41\x3d\x1c\x3a\x9c\xfe\xed\x51\x55\xc9\x75\x2c\x51\x7f\x50\xd4\x42\x17\x5f" +
+	"\x0a\x25\x63\xf2\xb0\xcd\x52\x95\xa0\xcd\xe8\x47\xc4\xf6\x91\xc8\x3e\x6b\xd8\x24" +
+	"\x1d\xe2\xb4\x64\x87\x38\xba\x42\xde\xd2\xa1\x07\x9f\xfc\x34\xf1\x8e\x6f\x4a\x12" +
+	"\x3f\x0c\x97\x78\xb6\x2b\x52\xc6\xd8\x12\xf6\x96\x27\xc4\x28\xe4\x90\x43\x6c\x68" +
+	"\xc4\x0e\x78\xc6\x58\x34\x26\x57\x96\xd2\xd2\x50\x95\x72\xa3\x90\xbf\x98\x9c\x7f" +
+	"\xc7\xb2\x30\xfd\xf5\x17\x67\xd7\x15\x9b\xed\xf4\xa4\xa5\xb0\x25\x57\xc3\xd1\x7e" +
+	"\xd4\xc4\xb8\xce\x10\x03\x3a\xa9\x4e\x9a\x51\x23\x7a\xcb\x3f\x31\x23\x23\xe4\xa0" +
+	"\x1c\x37\x20\x0d\xb2\xa5\xcb\xa0\x96\x08\xc4\xf5\x61\x87\x4a\x25\x7e\xd4\x4f\xec" +
+	"\xcb\x66\x2c\x2d\x41\x6a\x68\x60\xa4\xf1\xe7\xc9\x58\x5e\xfb\x31\x8e\xd0\x4f\xe2" +
+	"\x47\xd2\x64\x13\xf6\x4f\x72\xb7\x9a\x4b\x66\xfd\xf7\x9f\x64\xf9\x35\x3f\xcb\xa4" +
+	"\xea\xea\xb4\x1b\xd5\x3b\x77\x40\x7f\x00\x2a\x1f\x95\xa5\x5b\x2b\xfd\xbe\x7b\x31" +
+	"\x36\x85\x42\x95\x96\x77\xfe\x03\x6b\xf4\xc7\xdf\xb7\x68\x35\xea\x59\x4e\xa5\xc1" +
+	"\x14\xd3\x76\x03\xab\x1a\x6b\x8a\x1f\x5b\x53\xfc\x24\x9c\x4b\xb5\xbc\xac\x06\x9a" +
+	"\xe6\x7f\x3e\x8c\x54\x28\xbc\x39\x7d\xc3\x6f\xd8\xa6\x96\xc3\x2c\x47\x90\xe9\x36" +
+	"\xdb\x9e\x43\x9a\xd8\x5b\x86\x85\x97\x4d\x23\x01\x1e\xfb\x13\x59\x0c\x24\x0c\x6f" +
+	"\x53\x5a\x0e\x16\xc6\x3e\xc4\x2d\x54\x34\x0c\x2d\xe3\x9c\xee\x39\x3d\xdf\xa9\x7c" +
+	"\xac\x0f\x71\x2d\x92\xf5\x95\x0a\xa0\x1b\xe5\x80\xa9\x72\x2b\xd6\x90\x25\x62\xf2" +
+	"\xc6\x65\x39\x08\x55\x39\xc2\xe8\x93\x6e\x39\x86\x7f\xf8\x94\x18\xdb\x0e\x10\x3e" +
+	"\xb9\xd9\x97\x37\x79\xac\x6d\x00\x89\xd7\x04\xb0\xc5\x39\xc8\x02\xf8\x07\x51\x1f" +
+	"\x0a\x11\x25\xd7\x57\x22\xfb\x4e\x1e\x8b\x01\xe6\xf2\x20\x3b\x8e\xcc\xbe\xa1\xcb" +
+	"\xfa\x5a\xe5\xa4\x18\x76\x2a\x39\x69\x8b\x92\xfd\x3b\x64\x36\x25\x8c\xa8\xce\x02" +
+	"\x6d\x04\xa9\x4e\x89\xe6\xe6\x7e\x1a\xae\x02\xd4\x6e\x8c\xe0\xad\x28\x25\xed\xea" +
+	"\x3d\x25\x7b\x4f\x31\xda\x06\xf8\x18\xf0\x67\xaa\xc5\x93\xb2\xb2\x1c\xca\x05\x7b" +
+	"\xc2\x46\xe4\x85\xbf\x9d\x25\x8a\x80\xe7\x24\xb3\x1e\x84\x78\x46\xcb\x63\x09\x64" +
+	"\x76\x2e\xf0\xab\xe6\x18\x6f\x56\x22\xdb\xe3\x65\x2e\x29\xa8\x25\xb5\x75\xca\x17" +
+	"\x4f\xd6\x52\x23\xb3\x39\x76\x53\x17\x2d\x9b\x3e\x28\xa8\xa2\x94\x95\x13\xa6\x8c" +
+	"\x28\x22\x11\x9f\xa9\xed\xda\xfa\xee\x7e\x2e\xbc\xf3\xf9\xac\x4e\x69\x2a\xc0\xc9" +
+	"\xa1\xe0\x8e\x8c\xa8\x5c\x8a\x16\x6d\x1e\x40\x4b\x42\xfd\x54\x6a\x57\x7f\xdb\xbd" +
+	"\xbf\x89\x36\xa3\xaa\xfc\x8e\x92\x00\xb9\x8a\x8e\xfa\x9c\xed\x91\xef\xd9\x9e\xd8" +
+	"\x8d\xe1\x2a\x2a\x31\x25\xee\x92\x43\x13\xa9\x4e\x28\x4d\x3a\xce\x20\x56\x66\xe1" +
+	"\x43\xb9\x97\x62\x39\xb0\xc9\xbf\x0e\x50\x1d\xa4\xe8\x20\x8f\xa4\xd6\x86\xfb\x9d" +
+	"\x08\xaa\x67\x5d\xcf\x27\x8b\x2a\xa3\x77\x40\xbd\x41\x73\xa4\x67\x1f\x6a\x40\xdc" +
+	"\x36\xb5\x83\x43\xe8\x03\x81\x4e\xb9\x0a\x39\xe5\xe8\x4d\x1c\x63\x64\x1e\x18\x41" +
+	"\x27\x98\x55\x33\xf1\x7c\xe2\x8e\x50\x95\xaf\x6a\x05\x73\xc0\xf6\x77\x72\xfb\x2d" +
+	"\x33\xa6\x26\xe5\x2a\x1c\xca\xe7\x8c\x80\x3b\xfe\x83\x9f\x37\xe5\x86\x87\xf0\xc1" +
+	"\x3c\x84\x58\x22\x6d\xf8\x8e\x64\x87\x34\x50\x0f\xa6\x83\x1e\x49\x1d\x5d\x84\x92" +
+	"\xcb\x2c\x47\x92\x1f\xed\x28\x45\x25\x69\x1b\x72\xf8\xb9\xcb\x51\xea\x0f\x9c\xa1" +
+	"\x43\x3c\x6e\x5c\x15\x5b\xd8\x70\x1c\xe5\x2d\x93\xd7\xed\xb0\x5e\x7d\x94\xf7\x63" +
+	"\xf8\x1f\x59\xc6\xa1\x89\xf2\x72\xde\xe2\xa3\x1f\xea\xd9\xb8\x9d\xdb\xb4\x84\xf3" +
+	"\x97\x30\xf9\xd2\x0e\xfa\xfb\xe1\x2f\xe9\x18\x2f\xa9\xd0\x28\x45\xca\x60\x5a\xf1" +
+	"\x96\x28\x4c\x8b\x45\x6d\x5d\xe8\x75\xc5\x3a\x9d\x4b\x63\x35\xfe\x16\x82\x55\xe4" +
+	"\xf6\x73\x83\x82\xb0\x8b\x5c\xf7\x92\x75\x41\x3f\x50\x2b\x0b\x77\xdc\x22\xfe\x84" +
+	"\xfc\xcc\x7e\xa3\xfe\x45\xcd\x21\x9d\xac\x2c\xe8\x8d\xcc\x0e\xf4\x71\x13\xb2\xa8" +
+	"\x5b\xef\xdd\xf0\x28\x98\xff\x80\x08\x96\x13\x65\xe1\xf3\x50\xd6\x80\x83\x2a\xf4" +
+	"\x56\x1e\x81\x90\x87\x19\x12\x07\xb0\x52\x11\xd1\xbf\x03\x58\xed\xd1\xf8\x63\x01" +
+	"\x8c\xd5\xf2\xd0\x7a\xb3\x3c\xba\xf5\xfc\xf2\xe8\xb6\x78\x2c\xe2\xcf\x98\x9a\x83" +
+	"\x56\xd4\x6d\x0b\x7b\xdb\xa9\x71\xa4\x88\xcb\xbf\xce\x92\xbf\x28\x39\xee\x87\x13" +
+	"\xf7\x2b\x79\x5a\xb8\x7b\xf3\x45\x16\x48\x79\x39\xad\xbe\x08\x2d\x59\x22\x84\x0a" +
+	"\xb2\x78\x3b\x4b\xc0\x5a\x0b\x82\x42\x0d\x33\x2b\x38\xe2\x59\x95\x7c\x59\x73\x0f" +
+	"\x71\x52\xbf\x3c\x20\xc2\xc7\x0f\x6a\xa1\xf6\x6f\x6d\xd6\x2b\xfb\xac\xff\xb4\xc7" +
+	"\xb9\xb0\x59\x2f\x7b\xc4\x10\x6c\x2b\x7b\xdc\xe0\x38\x95\xdd\xee\x2c\x09\xc1\x35" +
+	"\x15\x78\x6f\x9c\x48\x23\xc2\x2a\xb7\xd3\xda\xc7\x73\x9c\x74\x15\x49\x1f\x60\x69" +
+	"\x90\x44\xe8\xa6\x36\xa2\x6a\x32\x80\x99\x57\xa2\x13\xfe\x73\xf1\x33\x67\xac\xd3" +
+	"\xb1\x36\xb6\x15\xda\xd7\xa7\x05\x71\xc1\x86\x03\x7f\xb8\xae\x7c\xc4\x34\x9e\xd8" +
+	"\xd5\x53\x59\xed\x4d\x4a\xd5\xc9\xb7\x88\x56\x3d\x42\x8a\xb0\xf2\x30\x47\xc8\x53" +
+	"\xf8\x72\x43\x48\x72\xe5\x87\x53\x12\x9a\x1c\xda\x7d\x5f\xa2\xb9\x1a\x62\x51\x8f" +
+	"\xef\x28\xab\x02\x6a\x98\x70\x71\xc2\xc9\xea\xd4\x83\x3d\x7f\xdf\x62\xd0\xff\x76" +
+	"\x14\x2b\x7f\xe5\xea\x1b\xa2\xb9\x8e\xf4\xb5\x2a\xd7\x2b\x1e\xa9\xde\x80\x62\x60" +
+	"\xbd\x70\x47\xe5\x46\x6c\x32\xca\x2b\xa9\xc3\xf3\x57\x35\x41\x68\xaf\xcb\xe1\x74" +
+	"\xf9\xcc\x91\xd9\xc0\x68\x9b\xda\x19\xf8\x2d\x71\x41\x8b\x95\xc1\xd5\x48\x73\xa2" +
+	"\x63\xef\xd3\xd4\x75\x11\x02\x6b\x99\x28\xba\xd7\x19\x9e\x1b\x4c\x5d\x10\xab\x2d" +
+	"\x89\x70\x60\x39\x9e\x99\x0b\xca\xf4\x76\x8a\xbd\x70\xba\x42\xa6\x76\xba\x4c\xc3" +
+	"\x39\x64\x19\x4e\xc9\x32\x60\xcb\xdc\x5c\xc5\x55\x8f\xaa\xbe\xf7\xc0\x6a\xb9\xb9" +
+	"\x1e\x3f\x0a\xab\x58\xdf\x73\xea\x31\xf9\x8e\xd2\x7f\xe0\x15\x0f\x6a\x01\x30\x47" +
+	"\x30\x52\xae\x9e\x71\x4a\x78\x06\x23\xd5\x0d\x3b\xd3\xc4\x18\xd7\x1d\x63\xc8\xe6" +
+	"\xea\x91\xfa\x2a\x0c\x4a\x39\xfa\xec\xa2\xb1\x47\x9e\x7b\xe2\xba\xe1\x37\xf8\x5f" +
+	"\xfc\x05\xd7\x2f\xb6\x7a\xc6\x31\xbd\x59\x92\x3b\x5b\xf7\xa0\xe8\x21\x12\x11\x70" +
+	"\xf0\xcd\xf0\xdd\x4b\xfe\xf6\x7d\xb3\xdc\x68\x3c\xf8\xf1\x2d\x70\x14\xf1\xb9\x2b" +
+	"\x82\x46\x81\x01\x2c\x27\xaf\x8e\xab\x3d\x28\xe1\xb8\x51\x1b\xd0\xf6\x83\x5f\xec" +
+	"\xb5\xea\xb0\x08\xbe\xc8\x93\x47\x0f\x42\x98\x4f\x01\xd5\x8b\xd4\x09\x84\x6c\x2f" +
+	"\xdb\x0b\x70\xb4\xde\x70\xd4\xef\xde\xfa\x0e\xcc\x4a\x57\x0b\x81\x93\x13\x78\x27" +
+	"\xf6\xff\xe2\xb2\xd9\xea\xf8\x1c\x87\x8d\x00\xf7\xab\x9a\xb6\x13\xe2\x46\xa7\x47" +
+	"\x6b\xd3\x71\xfa\x4a\xf9\x8c\x7c\x26\x4f\x96\x30\x4e\x88\xdc\xa2\x4b\x43\x9d\x5a" +
+	"\x48\x9f\xb6\x90\x3e\x6d\x21\x7d\x26\x4e\x7f\x4f\x13\x42\x6f\xa7\x36\x64\xb2\xd6" +
+	"\xd8\x64\x8b\x51\xae\x82\xf4\x85\x3e\xb5\x21\x3b\x7d\x43\x76\x0e\xde\x2e\x40\xb1" +
+	"\xfc\xcd\x74\x70\xc3\x51\x54\x69\x0f\x64\xdf\xa1\xe6\xfa\x00\xd2\xbe\xfb\x4c\xd6" +
+	"\xae\x10\x0f\xae\xd6\x9b\x24\xc7\x75\xa6\xca\x22\x5a\xc4\x9e\x57\xd1\xd1\x42\x2c" +
+	"\x3b\xe7\xfc\x45\xfa\xb2\x0c\x8f\x5e\xa8\x65\xd4\xab\x49\x5d\x37\xc2\xb8\x7c\xe5" +
+	"\x6f\x85\xfd\x8f\x13\x95\x7b\xef\x87\x86\x01\xbe\x86\x91\x3a\x4d\xc8\x02\xea\x18" +
+	"\x39\x1d\x1e\xe5\x46\x0e\xda\x99\xf5\x94\x6c\x95\xca\x77\xf1\x85\xa5\xdf\xe9\x9f" +
+	"\x52\xe8\x1d\x4c\x3a\x56\xad\x5a\xde\x60\x36\x0d\x3d\xcc\xd8\x3d\xb0\xe0\x77\xf1" +
+	"\x08\x2e\x9e\xe8\x72\x96\x9e\x2c\xaa\x8a\x0a\x79\x69\x7e\x5c\xe8\xc0\xa6\xbc\xc2" +
+	"\xcd\xde\x68\xad\x61\xd8\x66\xbe\x1b\xab\x6d\x8a\x56\xca\xb2\x43\xd6\xce\x52\x8c" +
+	"\x81\x72\x16\xf4\x05\x2e\x37\xf0\x76\xd4\x07\xe9\x34\xee\xf2\x39\xbe\x35\x00\x95" +
+	"\xd0\xbd\xbb\x96\xee\x1b\x2d\x59\x7f\x22\x42\xdf\xa6\xd3\xed\xf6\xb5\x13\x29\x67" +
+	"\x27\xaa\x0f\x4b\x42\x6c\x19\xf1\xcf\xd1\x10\x26\xc2\xa1\xc8\x39\x44\x59\x04\x92" +
+	"\xc7\xf0\x30\xcc\x17\x59\xb8\x8f\x7d\xe9\x5f\x6b\xa7\x2b\x40\x23\x20\x4a\x58\xd3" +
+	"\xe8\xa6\x9d\xe5\xc9\x9d\xa6\x76\xd6\xa4\x72\xd8\xd2\x39\xb5\x8f\x3e\xd0\x26\x63" +
+	"\x03\xeb\x8d\xaa\xa1\xee\x64\xc4\xf8\xdf\x41\xe0\xda\x89\xc5\x98\x68\x10\x6a\x75" +
+	"\xdb\xc9\xee\xca\x75\xb5\xe2\xa1\x46\xd4\xdf\x6a\x8d\x86\x54\x73\x2b\xaf\x1d\xd0" +
+	"\x44\xb4\x4e\x42\x29\xa9\xf6\xbc\x0e\x28\x02\xa5\xa1\x37\xe5\x9d\x08\x58\x26\xba" +
+	"\xf1\x7f\x97\x77\xe3\xff\x2e\x3e\x08\xb8\x7d\x78\xbb\x3f\x53\x9b\x05\x11\x8b\xb1" +
+	"\xcd\x01\x34\x3b\xf1\xf0\xc8\xbc\x6f\x74\x04\x78\x11\x56\x3d\x6e\xe4\xe1\x39\xfc" +
+	"\x11\xa3\x45\x74\x13\xd8\xc8\x6e\x44\x72\x55\x0e\xda\xd4\x3a\x2c\x8f\x1e\x6c\xa1" +
+	"\x0d\x55\xb1\xf7\x6d\x4c\xe2\xd3\xad\x70\x6e\xf8\xe2\x48\xf1\xcf\x73\xf1\xaa\x74" +
+	"\x65\x53\x25\xb2\x62\xe9\xbe\x69\x85\x2a\x50\xd6\x11\x9d\x2a\x80\x2d\x4e\x0f\xb6" +
+	"\xc5\x11\xad\x46\xbd\x02\x8f\x87\x52\x09\x33\xea\x5c\x50\xda\x4f\x4f\x7a\x78\x3e" +
+	"\x6d\xef\x64\xc8\x33\x3d\x07\xd5\x65\x55\x02\xfc\xae\x6d\xe3\x52\x1e\x31\xc6\x33" +
+	"\x1f\x97\xf5\x14\x60\x63\x05\xa4\x82\x59\x15\xf5\x90\x90\x7b\xd5\x63\x53\x45\x90" +
+	"\xdc\x80\xce\xf7\x3a\x9a\x24\xe4\xaa\x3e\x3f\xdf\x13\x4b\xc2\x54\x86\xb5\x47\x46" +
+	"\x6c\xd0\x90\x5a\x24\x7b\x8e\xac\xaf\x7f\x0f\xf9\xc7\xeb\x90\xd9\x45\x74\x6d\x18" +
+	"\xba\xf3\xc8\xa1\x0a\x44\xac\x42\x74\x1f\xff\x97\x43\x11\xdc\xe1\x93\xa0\x11\x40" +
+	"\xe9\xc5\xd1\xee\x48\x7d\xd6\xf5\x06\xe3\x45\xc9\x63\xc9\xd6\x59\x7f\x4a\xd7\x58" +
+	"\x3d\x44\xa2\x81\x24\x92\x5d\x4a\xc2\x24\xd3\x2c\xa2\x24\xd5\x99\x9a\x9d\xbb\xde" +
+	"\x0a\x90\x34\x4b\xd7\x7a\xab\xdb\x7a\x0b\x62\x89\xfd\xcf\xb1\xd8\xea\x2e\x03\x0a" +
+	"\x1d\xc1\x6c\x2e\xba\xb3\x2c\xba\xeb\xe5\x25\x5c\x67\x26\x46\x57\x07\x23\x8b\x8e" +
+	"\xea\x7b\x18\x97\xc0\x09\xe2\xab\x2d\x62\x69\x5f\x9e\x94\x01\x68\xf7\x45\xa4\xb3" +
+	"\x86\xe1\x09\xf5\x33\x4a\xa0\xc9\x88\x23\x0c\xbd\x18\xb1\x8c\xe3\xaa\x9c\x43\x2f" +
+	"\x00\x34\x02\xdb\x9d\xa3\x39\x43\xf6\x8b\xfd\x39\x12\xa6\x4f\xa3\x7c\x3c\xf6\xd0" +
+	"\x2b\x3a\x71\x6f\x75\xbf\x7b\xbb\x7b\xfb\x88\x97\xc2\x84\xd6\x03\xba\xbf\x89\xd2" +
+	"\x9f\x8d\x61\x7d\x68\x91\xdf\x5d\xe7\xa4\x00\x0f\xd6\x6c\xd2\x63\x50\x6f\x8a\x21" +
+	"\xc3\x70\x31\x6a\xb1\xbb\x62\xc5\xbe\x14\xad\x46\xad\xc9\x62\xd8\x95\xca\x62\x91" +
+	"\x8a\xea\x2e\x16\xd5\x9d\x6d\x08\xb5\xc7\xb9\xaf\x7a\xac\xbf\xd2\xa8\xf2\x77\x2f" +
+	"\xdf\xd9\xa3\x1d\x4b\xa8\x18\x98\xd7\x3f\x40\x38\x86\x4e\x1e\xcf\x47\x80\xa3\x23" +
+	"\x38\x98\x3c\x3d\x9e\xfe\x3c\x67\xc4\xf4\xc8\xae\x87\xa3\xf2\xe2\x04\x4b\x00\xbf" +
+	"\xe0\x88\xed\x9f\xd3\xdf\x44\xac\x49\xdd\x27\x56\xef\x34\xda\x01\xf5\x58\x0f\xab" +
+	"\x79\x2d\xcc\x97\x46\x95\xfb\x2d\xc7\x7a\xb5\xae\x32\x72\x4a\x3d\xf1\xca\x6c\x90" +
+	"\x8a\x92\x32\xf9\xc1\x33\x31\xee\xa7\x44\x71\x6a\x30\x6c\x6c\x42\x09\xa0\xec\xe1" +
+	"\xb6\x91\xef\xdc\xfe\x73\xc2\x43\x92\x1f\x22\xf6\xf4\xdc\x03\xfe\x9c\x47\xc2\x71" +
+	"\x42\x33\xd0\x7a\x43\xcf\xf1\x3d\xaf\x51\x92\xe4\x94\xa5\xc4\x48\x72\xf8\x14\x98" +
+	"\x0e\xd8\x06\x5d\x67\xa5\x7d\x1c\x95\x02\x2c\x2d\xdd\x27\x0d\x23\xa4\xad\x6b\xce" +
+	"\x4b\x3e\x46\xd3\x18\xa3\xda\x16\x76\xcc\x50\x3b\xb1\x7f\xe8\xc7\x09\x5d\x07\xf3" +
+	"\xdd\x0f\xe6\xa1\xdf\x79\x70\x9c\xd2\x3b\xf0\x20\x91\x3d\x2c\x9b\x01\xba\x58\xf1" +
+	"\x69\xcc\x47\x5a\x39\xa8\xb4\x16\x40\x5d\x26\x58\xf1\x28\x5d\xf1\x74\xe7\xa9\x73" +
+	"\xf4\xee\x27\xf0\xdc\x69\x37\xbf\xd5\xbd\xa0\x62\xad\x68\xee\x2f\x18\xf8\x6a\xe3" +
+	"\x7c\xd1\xef\xb7\xe3\xdf\x3d\xa5\x77\x50\xe9\x75\x93\xdf\x92\xfb\x11\x01\x63\xaf" +
+	"\xbb\xdc\xdc\xc0\x9e\x81\x35\x46\x2f\x1a\x8c\x45\x5c\x8e\x5e\xfd\x3e\x0d\x77\xe3" +
+	"\x61\x64\x1f\xbf\xa0\x9e\x66\x9e\x47\xa9\x74\x31\xf3\x7b\x9a\x3b\xde\x3f\x48\xd5" +
+	"\xbf\x43\x98\x5a\x75\x62\x5e\xce\xf9\x7b\xf5\xfb\x1f\x02\x1e\x6e\xd7\x17\x08\x79" +
+	"\x6a\x6f\x2f\xe4\xb5\xf1\xd3\x37\x43\xe3\x01\x34\xe8\x10\x68\xee\x26\xd0\xdc\xab" +
+	"\xd8\x2a\x90\xf8\x4c\xcb\x17\xf9\x9c\x66\xe1\xfe\x5f\x81\xfe\x20\x83\x77\xb0\x27" +
+	"\xd5\x97\x1e\x75\xbc\xf8\xde\x43\x0f\xf1\xed\xb1\x54\xf0\x10\xb1\x0f\x6b\xae\xd7" +
+	"\xe5\xe3\x75\xb4\xa9\xac\xfa\xc2\x48\x7e\x25\x1f\xd3\xd7\x2d\x3f\x4d\xd4\xee\x6f" +
+	"\x6c\xc0\x91\xc0\x72\x44\xac\xb2\x0a\x1d\x4f\xcd\x88\x06\xe2\x71\x87\x8d\x77\xe4" +
+	"\x9e\x51\xae\xf9\xda\x8d\x1d\xc9\x0a\x11\xa8\x0d\xe2\xb9\x65\xcd\xc9\x22\xb4\x72" +
+	"\xef\xc3\xaa\x13\x61\x36\x88\x44\xd5\x6f\xeb\x38\x95\xb3\xb8\x91\x0c\x36\x01\x7a" +
+	"\x13\x74\xc4\x0c\xe8\x4e\x2c\x53\x5a\xc4\x25\xec\x5c\x51\x76\xa2\x22\xfc\x54\xa5" +
+	"\xd7\xf4\xd0\x7b\xd7\x16\xb1\xd7\xf4\xe3\xd9\x7c\x0e\x03\x38\x1d\xe9\x87\x10\x4d" +
+	"\x67\x78\x44\x0d\x9c\xea\x23\x96\x6b\xbe\x72\xa3\x93\xd5\xa9\x47\xde\x01\xa7\x3f" +
+	"\x65\x4c\xdb\xba\xf8\x0b\xd1\x10\xc1\xd6\x9b\x3d\xac\x58\xa5\xb1\x89\x82\xae\x07" +
+	"\x7f\xb5\xc5\xeb\xe4\xc7\x05\x1d\x39\x72\x27\x9e\x60\x0c\xbc\x5a\xd0\xd5\x3d\x80" +
+	"\xf5\xc0\x72\x3c\x99\x76\x1c\xda\x3f\x11\x23\xda\xf5\x46\x84\xfc\x0b\x8e\x68\xff" +
+	"\xf2\x3a\x5a\xbf\x50\xff\x4c\x4c\xeb\x1b\xac\x37\xba\x23\xbd\x36\x97\x13\xea\x81" +
+	"\xc4\x49\xb7\x6b\x97\x78\xa4\xa1\x78\x00\x3d\xd5\xa0\xcf\xf4\x56\xc1\x8b\xa2\x91" +
+	"\x37\x31\x9a\x6e\xda\x8c\x16\x27\xc3\xab\x6e\x77\xad\x3e\x44\x2e\x5f\x39\x5f\x63" +
+	"\xc5\x2c\x9b\x24\xad\x73\x9d\x32\x0e\xba\x3a\xf0\x14\x5a\x39\x5e\xb6\x50\x64\x4b" +
+	"\xd4\xed\x2f\xb8\x24\x4b\xbf\x9c\x09\xd0\xd1\x15\x4b\x30\x7b\xa7\x7e\xa1\xbd\xdc" +
+	"\x88\x36\xb0\xfd\x3a\xd9\xfd\xf6\xab\xae\xf2\xd9\xd1\x7a\xff\x68\xc6\xf9\xd8\x74" +
+	"\xf3\xb1\xd6\xfa\xf8\x83\x3f\x18\x5a\x71\xaa\xd5\xd3\x25\x21\x3c\x1a\xed\x9a\x51" +
+	"\x78\xeb\x9c\xc0\x6b\x5e\x93\x2b\x48\x68\xd7\xbc\xb2\x5c\x12\x59\xa1\xae\x57\xd0" +
+	"\x91\x64\x77\x07\x54\xbd\xcc\xa3\x52\xb2\x36\x91\xd7\x8c\x01\xc6\xe3\x17\x52\xe4" +
+	"\x1e\x65\x0b\x04\xe4\xca\xd6\xbe\xd0\x12\x80\xad\xf8\x9d\x39\x58\xc4\x63\xba\x57" +
+	"\x61\x1f\x4c\x85\x6b\x2e\xdc\x89\xd3\x3a\xff\x8b\x95\xda\x0d\x94\xde\x68\xfd\x06" +
+	"\xd6\x5f\xf0\x4e\x6b\x5a\x66\x7f\x7b\x2c\x4a\x02\xbd\xfd\x17\xa4\x43\x5d\x33\x4a" +
+	"\x8c\x08\xd3\x54\x60\x74\x75\x90\xfc\x46\xc0\xf0\x7c\x06\xfc\x06\xfe\x1b\x99\x6c" +
+	"\x6b\x20\xe8\xc9\xb4\x5a\xb3\x68\xdf\x4e\x18\x32\x56\xe6\x17\x7b\x35\x14\xf3\xf2" +
+	"\x54\x98\xa4\xfa\x0d\xd7\x5f\xb8\x7d\xb1\xff\xd1\x8e\x12\xd9\x43\x5c\xe4\x6b\xee" +
+	"\x49\x2b\x7f\x07\x4a\x3c\x02\x72\xb4\x17\xec\xff\x1c\xa6\xb8\xfe\xfb\x55\x67\x27" +
+	"\x56\xc4\x55\x11\xf9\xba\x66\xe4\x8c\xfd\x0d\x6a\xef\x76\x2d\x18\x69\x1c\xf4\x8b" +
+	"\xd4\x3b\x0d\x92\x6f\xe1\xb2\xc6\xa0\xf1\x46\x9a\xcc\x6f\x98\xbd\xbc\xd1\x75\x14" +
+	"\x6e\x0f\x5b\xdc\x96\xe3\x8d\xac\x08\x19\x4f\x06\x5c\x48\x15\x29\xe8\xda\xe7\x41" +
+	"\xad\x36\x3f\x72\x1c\x33\x9e\x2e\x5f\x41\x5d\x5a\x6d\x32\x4b\xf0\x30\x9e\xa2\x44" +
+	"\xda\xf3\x31\x05\x54\x27\x0a\x15\xb8\x43\x98\xf2\x95\xc4\x0a\x72\x34\x02\x8d\x8a" +
+	"\xf3\x78\x3d\x6e\xf3\x8a\x37\xee\xdf\x60\x6b\xf5\xc7\x03\xb5\x8c\xa3\x28\x5b\x75" +
+	"\x5d\x4f\x71\xac\x21\x24\x79\x32\x29\x5b\xab\x0c\xd8\x6e\xac\x16\x4a\x4b\xd2\x05" +
+	"\x45\x81\x11\xa0\x96\xb6\x82\x35\xdc\xbe\x86\x1b\x09\x80\xab\x30\x80\x85\xd8\xc6" +
+	"\xdc\x81\xf2\x6a\xf0\x99\xbc\x0f\xea\xa4\x2d\x8c\x49\xea\xf2\xd3\x2e\xe9\x90\x6e" +
+	"\x9c\xbe\x10\x88\x88\xdc\x95\x33\x9f\x58\xc9\x37\x4d\x8d\xab\xe7\x3e\x64\x84\xfe" +
+	"\xc5\x2f\x5a\x1d\xf2\x70\xdb\xdc\x5c\x48\x69\x35\xa7\xdd\xe6\x9a\xdd\xa6\x8a\xdd" +
+	"\xa7\x8a\x5d\xf2\xf1\x22\x9e\xc3\xda\x82\xa5\xe6\x75\x70\x0d\xdc\xfe\xf0\x31\x5f" +
+	"\x7c\x29\xae\xc3\x44\x0b\xae\xe3\xe1\x62\x05\xd7\xf1\xcc\xc1\x7b\xe1\xa1\xd5\xc0" +
+	"\x75\x38\x2f\x0f\x20\x72\x24\xb9\x54\xec\xa8\xa8\x59\x6f\xd8\x5f\xc2\xd1\x95\x30" +
+	"\x82\x6d\x92\x38\x1a\xca\x74\xa1\x9b\x56\x4a\x97\xbe\xd8\xb8\xb3\x13\xbd\xe2\x33" +
+	"\xd6\x71\x95\x9c\xb8\x47\xb6\xa5\x0e\x18\xd6\xaa\xb4\x5f\xb9\xb7\x78\xfe\x71\x89" +
+	"\xc3\xb1\xa7\xd6\xd6\x9a\xa0\x3d\x54\x9d\x97\x7e\xb1\xa7\x90\x88\xdf\x78\xd0\xe9" +
+	"\x7f\x19\x9e\x6b\x0f\xbf\x90\x27\x66\x5a\xb1\x6e\x75\x7b\x46\xbd\xa4\x66\xe7\x46" +
+	"\xeb\x1d\x68\xad\xeb\x78\x64\x7d\xa5\x36\xea\x2d\xa8\x32\xc4\xe2\xb8\xd0\x58\xb2" +
+	"\xfb\xdf\x73\x18\xf2\x6f\x4f\xfd\xfc\xf2\xc5\xf5\x97\xc7\x4b\x49\x23\x50\x52\x53" +
+	"\x0e\xee\x38\x07\x1f\xaf\x97\xeb\x49\xbc\x51\x1b\x81\x36\x91\x9c\x58\x97\x5a\x6f" +
+	"\x60\x2f\x87\x30\x4f\x37\xfe\x9e\xd4\x1d\xde\xc0\x73\xdc\xab\xce\x81\xed\xef\xd7" +
+	"\xb8\x63\x9c\xd7\x50\x1c\x54\x45\xd7\x87\x1c\xd9\xdb\x71\xd0\x04\x54\xc5\x6a\xeb" +
+	"\x0b\xbb\x7a\x79\xf9\xb7\xb3\x3e\xa3\x8a\x15\x55\xe2\x67\xf4\x01\x54\x9f\x28\x41" +
+	"\x21\x84\xdd\x83\x02\xbc\x00\x7b\x18\xb6\xc3\xa1\xd2\x3c\xda\xae\x7a\x06\x4d\xba" +
+	"\xa2\x56\x81\x33\xeb\xf5\xd6\xb3\xfa\x3b\x35\x74\x87\x79\x71\x0e\xad\xd4\x2a\x96" +
+	"\x29\xd4\x8e\x5c\x06\xb2\x12\xbf\x8a\x4c\xdb\x55\x6b\xe1\xfa\x96\x82\x39\x69\xbd" +
+	"\x81\x27\xbd\x8f\x63\xbf\xb8\xdd\x1e\xa1\x57\x4c\xd9\x2f\x4b\x5d\xf5\xc5\x2e\x53" +
+	"\xc4\xf2\xbf\xa2\xbf\x64\x82\xd4\xdd\x00\x96\x0f\x65\x36\x0e\xe2\xa0\xeb\x00\x23" +
+	"\xc9\xc8\x9d\x5e\x9e\x1b\x40\xa4\xdd\x5c\xb6\xe9\xb0\x6d\x8c\x7d\xce\xb1\xfa\xa0" +
+	"\x7a\xfc\xad\x0d\xcf\xa1\xf2\x71\x0c\x35\xe8\x48\x45\x7b\xdc\x91\x77\x06\xf4\xfe" +
+	"\x38\xb0\x01\xd7\xce\xb4\xfc\x0f\x6a\x37\xbc\x7e\x43\xbd\x04\x67\xb2\x1a\xfd\xce" +
+	"\xe1\xee\xb5\x7a\x0f\x0e\xac\x21\x7f\x70\x8f\xa6\x66\xb0\xbd\x75\xe3\x64\x50\xd2" +
+	"\x19\x52\xba\xf7\xb8\x8e\xf2\xc6\x1c\x50\x7b\x3b\x0b\x14\xaa\xc0\x43\x8e\xa0\xa2" +
+	"\x4f\x8f\xf6\x6d\xc9\x3b\x70\x08\x87\x6b\xc1\xbe\xff\xd7\x02\xe1\x6d\x1b\xd0\xb7" +
+	"\x1f\x61\xbc\x75\x1e\x8b\x7b\x7b\x29\x36\xc2\xc7\x7f\x73\xed\xb3\x0e\xea\xc1\x23" +
+	"\x59\xf7\x64\xba\xdb\x60\x90\xd2\x75\xc9\xf0\x3d\xae\x17\x02\x94\x0e\x33\xea\x83" +
+	"\xea\xf1\x31\x7a\xd1\x66\xce\x4b\x51\xe8\x04\x77\x88\x94\x14\x41\x34\x36\xd4\x76" +
+	"\x13\x20\x15\xc7\xb6\xe9\xed\x77\x4f\xde\x83\x5b\xf1\x8e\xdc\xe4\x7d\x73\xf7\xca" +
+	"\x09\xb1\x12\xfd\x7e\x27\x93\x39\x79\x23\x97\x59\x07\xa5\xa8\xb4\xf3\x01\x05\xd8" +
+	"\x21\xa1\x51\x25\xe1\xf3\xe1\xaf\x63\xf0\x9a\xf0\x2a\x17\xbc\xb9\x77\xb2\x56\x4f" +
+	"\x88\x4b\xbd\x53\xcd\xc3\xe7\x49\xd9\x07\xbc\x8d\xa5\x24\xe2\x1c\xe5\xf7\x60\x2f" +
+	"\xbd\x07\x7b\x49\xc0\x33\xa1\x0e\xae\xbd\xd9\x10\xf2\x8e\xc5\x11\x7a\xfb\xe5\xf4" +
+	"\x77\xa9\xee\x92\x47\xa7\x62\x6f\x6d\xbe\xde\x30\x21\xde\xda\xe0\x7c\xf6\x19\x8e" +
+	"\x92\x48\x5a\x6e\x60\x2b\x58\xf3\x70\x6e\x6f\xe8\x5f\xfc\xa2\x06\xdc\x23\xfb\xee" +
+	"\x2f\x62\x08\x1b\xfe\x00\xb0\x6e\x89\x0a\xbf\x81\x35\x09\x3a\xb4\xb2\x80\x96\x74" +
+	"\x13\x7f\xeb\xb3\xa3\x0c\x97\xea\x7d\xf6\xb3\x97\x9b\x86\x51\x4a\x2f\x2c\x13\x3e" +
+	"\x47\x78\x4a\x7b\x53\xfb\x1c\x54\xfa\x58\xb6\x5a\x0e\x5b\x90\x7c\x0e\xac\x8c\x31" +
+	"\x89\x7d\x8e\xb5\x16\x26\xd2\xcf\x21\x03\xa0\x4e\xf5\xe1\x36\x68\xe0\xc4\x98\x75" +
+	"\xfb\x1c\x3d\xfc\xc5\xdf\xb7\x38\x02\x40\xff\xe2\x2f\xb8\xfe\xc2\xd5\xb1\x55\x0d" +
+	"\xce\x00\x29\x2b\x7c\xf8\x0f\xcc\x5e\xaf\xf3\x80\xde\xa4\x78\xd3\x32\x71\xb6\xc4" +
+	"\x91\xd2\x07\x94\xe3\x92\x4b\xdf\x0c\xea\x78\xf3\x17\x6c\x86\xbb\x13\xf3\xc2\xaa" +
+	"\x75\xaa\x80\x52\x70\x81\x4b\x22\xe5\xf2\x23\x27\x47\x27\xab\xd3\x66\x94\xbe\x03" +
+	"\xe0\xe9\x0b\x59\x97\xa2\x18\x65\xa5\xda\x46\x42\x76\x60\x91\xb0\x8f\x7b\x20\xe7" +
+	"\x93\x2f\x5d\x0f\x4f\xc2\x13\xdd\x18\xa2\x2f\x07\xa0\x54\x05\x4b\xdd\x01\x40\xa9" +
+	"\x0e\xca\x66\xa9\x44\xe2\x9f\x28\x15\x45\x80\xf2\x78\x28\x00\xc1\x9a\x89\x73\x21" +
+	"\x39\x4a\xcd\x96\x40\x45\x00\x28\xc4\xea\x9f\x7f\x82\x96\xcc\x7e\x58\xc4\x69\xd0" +
+	"\x20\x4d\xd9\x32\x7f\x6b\xa7\xae\x2c\x49\x39\xfd\x51\x3c\xe4\xf6\x5b\x0b\x1c\x13" +
+	"\x47\x3a\x71\x87\xc8\xea\xa7\x28\xdb\x61\x85\xe8\x82\xac\xdb\x56\x31\x42\xf6\xe3" +
+	"\x49\x8b\x1d\xb4\xf0\x01\x13\xc9\x08\x35\x83\x83\xdc\x4d\xee\x86\x9d\xdf\x90\x3d" +
+	"\x01\xd7\x6b\x1f\x94\x34\x27\x3f\x6a\x78\xaa\x86\xac\x39\xe5\xba\x4f\x49\xd0\xf5" +
+	"\xc9\x3f\xd1\xcc\xd8\x8d\x27\xd9\x7d\xc6\xee\x70\x26\x59\x0f\x76\xcc\x1d\x75\x27" +
+	"\xca\x0d\xb0\x24\x20\xa5\x82\x11\x6f\x44\x5e\x37\x63\xda\x43\xa5\x1a\x95\xdc\x8d" +
+	"\x7f\x23\x6d\x74\x10\x52\x35\x93\x40\x10\xd0\x29\x20\x88\xea\xf8\xbd\x41\x80\x7a" +
+	"\x1d\xe4\xf6\x93\x13\x0e\x89\x5c\x85\x5e\x87\xd7\x46\x2c\x93\x1e\x2e\x22\x5f\x1c" +
+	"\x3d\xf4\xf0\x97\x1e\x3d\xf5\x61\x33\xa0\xcb\x62\x40\x37\x7b\x01\x10\x4a\xfb\x7b" +
+	"\x86\x8f\x5a\xc7\x40\x9b\xa4\xda\x43\xd3\x2a\xe8\x3a\x27\x3f\xb7\x81\xe8\x4c\x71" +
+	"\x00\x25\xdd\xdf\xa5\xfe\x00\xce\xd2\x21\x55\x0e\x86\xfa\x04\xf4\x17\xfd\x5b\x86" +
+	"\x5f\x79\x5a\x34\x57\x65\x36\x8f\xf2\x61\xe9\x80\x74\xcb\x26\xe7\xb0\x71\x32\x90" +
+	"\x5d\x96\xfe\xe5\xfd\x7c\x3d\xf9\xc6\x1c\x80\x7d\xe1\x48\xff\xdf\x15\xbf\xc8\x03" +
+	"\x6f\x71\xcb\xff\xf1\x8d\x92\x14\x3b\x9e\x87\x87\x70\xee\xfa\xf5\xb8\x6a\x19\x02" +
+	"\xa5\xeb\xb1\xe7\xe9\x31\x1e\xec\x4d\x9d\xae\x03\xd4\x1b\x50\x10\x6c\xdd\xce\x5d" +
+	"\xfa\xb2\xef\xd7\x71\x0b\x3a\x11\xf4\xac\x61\x1f\xe0\xe4\xdf\xe7\xc8\x04\xce\x7c" +
+	"\x11\x73\x34\x31\xea\x1b\xad\x5f\x68\xb9\x4d\x49\xca\x8d\x2c\x90\x4d\xca\x3a\xd0" +
+	"\x78\x10\xdf\xd3\x1e\xf7\xf3\x26\x41\xfb\x8f\x5f\x58\xed\x21\x1f\x6b\x23\x87\xfd" +
+	"\x8b\xc4\xaf\xb4\x60\xba\x14\x80\xb9\x21\xee\x48\xcb\xc4\x1b\x50\x07\x6e\x0f\x39" +
+	"\x31\x6f\x31\xb7\xfa\x33\x4a\x0d\x35\xa9\xbd\x0d\x1b\xb2\xc2\x5c\x5f\xd4\x17\x5a" +
+	"\x09\xdd\x9f\x69\x3d\x3f\x1e\x2e\xcd\xc7\x52\x4e\xd7\x93\x00\x68\xce\x16\xed\x4e" +
+	"\xc8\x7a\x90\x4f\x0b\x51\x91\x43\x52\x69\x0e\x95\x9c\xf5\xbb\x91\x3c\xfc\x5a\xe9" +
+	"\x74\x5f\xa9\x42\x55\x21\x96\xe3\xc8\xd5\x7a\xc3\x21\x00\x8a\x97\x21\x18\x8f\x23" +
+	"\x89\x30\x4f\xdb\xdb\x66\x6d\xdb\x7d\x61\xeb\x54\xc1\xca\xe4\xa2\x68\xfd\x56\x3c" +
+	"\x7f\x23\xab\x83\x95\x79\xf9\x3f\x84\x7d\xdc\x23\x7c\xd1\x8e\x64\x17\x8e\xfe\x0e" +
+	"\x4b\x4a\xb5\xa8\x81\x36\x50\x8f\x5f\x74\xa7\xf3\x86\x73\xac\x3f\x04\x1e\x1d\x1c" +
+	"\xac\xa7\x55\xac\x9e\x0e\xea\x01\x49\xa9\xd1\x87\xac\xcb\xfa\x60\x9b\xc7\x0d\x7c" +
+	"\x0c\x20\xe5\xe7\xc0\xaa\x57\xb9\x04\xe7\xd4\x9f\x5e\xe1\x90\xdc\x65\xf8\x69\xd9" +
+	"\xc0\x96\x58\x4d\x08\x41\xac\x7a\xa0\xfe\x45\xcd\xa1\xa8\x1a\x19\x71\x05\x7b\x27" +
+	"\x9c\xcf\x2a\x20\x79\xfb\x36\x37\x37\xa9\x30\xd5\x46\xd4\x6a\xc3\x71\x66\x39\xac" +
+	"\x36\x8e\x47\xa6\x9d\xa5\x53\x8f\x3a\x29\xee\xf1\x2f\x65\xff\xed\xba\xe9\x34\x00" +
+	"\x09\xff\xda\xf7\xdb\xa8\x05\x98\x8b\x54\x9a\xa8\xde\x9c\xe8\x3a\x40\x9b\xfa\x16" +
+	"\x8e\x1f\x4d\x93\x0e\x78\xe9\x40\xfb\x28\x80\xda\xd3\x76\x66\x7d\x73\x99\xff\xbe" +
+	"\x85\x37\x51\x08\xc5\x22\x6c\xab\x3f\xba\xf9\xa6\x40\x01\x3c\xca\xe8\x08\xb0\x39" +
+	"\x6e\x8b\x8f\x6e\xae\xc2\x39\x65\xed\x43\xc3\x14\x40\xd9\x34\xdb\xe3\x51\xa4\x26" +
+	"\x80\x2d\xd2\x37\x34\xab\xde\xb0\xdd\xd8\x8b\xf6\xee\xb3\xc5\x52\xf9\xe4\x8b\xfa" +
+	"\x2a\xe7\x8b\xb3\xa7\xd0\xc8\x13\x68\xfd\x06\x1e\xa4\x24\x5c\x8c\xba\xbc\x5e\xe8" +
+	"\x42\xb2\xf4\x6e\xb2\xf4\x46\x23\xd0\xb2\xc4\xf0\xf0\xb6\xa8\x71\xf6\x20\x2c\xc4" +
+	"\x1a\xa2\xe7\x42\xcb\x43\x74\xb7\x5a\xd7\x1d\x79\x6f\xcf\xb5\x17\x84\x1f\x80\x2d" +
+	"\x5c\x95\x31\xb0\x45\x76\xff\xbe\x66\xed\x9b\x1d\x8f\x39\x55\x3b\x81\xaa\x53\xd5" +
+	"\x0c\xe0\x54\x7f\x76\xdc\xa7\x65\x7d\xce\x93\xb9\x01\x2b\xdf\x73\x33\x57\x9e\xc3" +
+	"\x4b\xf1\xb5\xce\x96\x90\x1a\x20\xfc\xf0\xf0\xc3\x4b\xaf\xc8\x69\x8b\x2a\x59\xf5" +
+	"\x50\x2d\x1e\xd8\xfa\xd9\x95\x41\x62\xec\x4b\x69\x80\xd5\xef\x96\xd0\x1b\x39\xf5" +
+	"\x8d\x4d\x8a\x5c\x4a\x28\x95\xde\xbd\xa1\xad\x57\x15\x2a\x43\x6d\x69\xdf\xca\x85" +
+	"\xff\x72\x5d\xc0\x5f\xe8\x91\x5c\x96\xb2\x3f\xa6\x08\x80\xec\xc0\x1a\x51\xe3\x54" +
+	"\x97\xa2\xec\x1b\x02\x78\x4e\xe2\x00\xca\xc1\xce\xd6\x48\x3c\x6e\x30\x92\xf8\x8d" +
+	"\x85\x81\x47\x06\xa3\x4d\xa7\x6f\x7d\xab\x70\x92\x21\xea\xdb\x10\xea\x57\x53\xce" +
+	"\x4e\xac\x34\x24\x54\xab\x03\x2f\x54\x32\xaa\x50\x96\xbb\x22\x96\xd2\x87\x5b\x45" +
+	"\x1d\x9d\x11\x3d\xb1\xcc\xe6\xce\x23\x9a\x75\xac\x88\x45\x5b\x28\x1a\xf9\x8c\x58" +
+	"\xb2\x8b\x32\x2f\x21\x16\x55\xdb\xb5\xcf\x46\x4e\x73\x55\x3b\xb7\xcd\xdf\x37\x98" +
+	"\x41\xd4\x52\x54\x73\xbe\x05\x21\x1e\xb0\xff\x8f\x87\xa8\x37\x1b\x74\x23\x2a\xe6" +
+	"\x53\xb5\x73\x49\xec\x4f\xe8\x87\x86\xf9\x38\xe1\xe3\x4b\xe2\xb2\x24\x04\xd3\x17" +
+	"\x92\xef\x5e\x24\x59\xd2\xc5\x21\xef\xb0\xc7\x71\x48\xcd\x52\x27\xe1\xa5\x17\x26" +
+	"\x75\x4d\xf5\x2a\xb1\x83\xbb\x0b\x1c\x38\x55\x39\x42\xfa\xe3\x7e\xbf\xae\xfb\xa5" +
+	"\x3a\x51\x72\xea\xb1\x5a\xea\xdb\x44\xa9\xf7\xc4\xbd\xe5\x9e\xa4\x51\x1f\x62\x05" +
+	"\xe8\xe2\x06\x4c\x6e\xd2\xba\x92\x0f\x01\x6d\x20\x00\x14\xbb\x7b\x22\xf6\x6b\x0a" +
+	"\x56\x0b\x88\xc3\xd2\x19\xf2\xda\x38\xf2\xc5\x53\xd9\x2e\x8b\x80\x38\x8a\x2a\x62" +
+	"\x5b\xa0\x74\xf2\xd3\x0e\xc0\x8f\x92\x3b\x07\xc9\x3d\x9d\xbd\x44\x27\xc4\x31\x95" +
+	"\xa4\x61\x5e\x11\xaf\xd7\xc9\x00\xe3\x42\x96\xd4\x89\x69\x6c\x8d\xd1\x3a\xa9\xc2" +
+	"\x1a\x07\x28\x46\x28\x56\x5b\x23\x6c\xfd\x33\xbf\x89\xb2\x88\xd4\x29\x94\x8e\xa0" +
+	"\x78\x16\x83\x6b\xea\x58\xcb\xee\xa8\x82\x06\x4a\x19\xac\xd1\x3a\xf5\x32\x63\xae" +
+	"\x7c\xea\x05\x01\x90\x3a\x68\x9b\x7a\x89\x87\x6d\x0b\x51\xb5\x17\x21\xd7\xd4\xcb" +
+	"\x53\x5f\x30\xab\x39\xe2\xc8\x1d\x69\xe4\xee\x84\x5c\x9a\xd9\xca\x8f\x86\x59\xc9" +
+	"\xb1\x5a\x7b\x0a\xe9\x23\x60\xa8\x3b\xe8\x23\xc4\xea\xa4\x20\xb5\x39\x67\xe8\x5d" +
+	"\x6a\xd8\x7a\xd9\x67\x39\x14\xb8\xcf\xa6\x2f\xb4\xb3\xdc\x28\xda\xc3\x21\xd9\x26" +
+	"\x4c\xbc\x76\x1c\xe5\x78\xaa\x81\x74\xb1\xa6\x63\xc1\x94\x77\x57\x8e\xc3\x5d\x45" +
+	"\x99\x63\x7f\xb0\xf0\x2c\x54\x16\xfe\x85\xf6\x6d\x46\xa9\xae\xc1\x93\x66\x9a\x7a" +
+	"\x39\x55\x94\x49\x69\xc9\xd7\xf0\xea\x5d\xbb\xea\x0c\xbc\xea\x90\x98\x17\xef\x28" +
+	"\x19\x34\x57\x35\xe1\x95\x23\xb8\xc3\x9a\xc2\xa8\x52\x32\x85\x96\x7a\x84\xb5\x0c" +
+	"\x6a\xa9\xcf\x68\x21\xd5\x33\x54\xd7\x7e\xc4\x61\x90\x22\x7a\x81\x09\x0d\xd0\x52" +
+	"\xce\xe8\x3a\x40\x73\x60\x2d\xa9\x57\xd5\xb4\x82\x2f\xc6\x59\xef\x6c\xb3\x0a\xe6" +
+	"\x1d\x5c\x67\x5a\x2f\xbe\x23\x87\x92\x5c\xff\xb9\xf3\x01\x2e\xd7\xb2\xe9\x6c\xe7" +
+	"\x46\xc4\x5f\x5c\xbf\xee\xcc\xd8\x78\x9e\x9b\x5e\xfa\xf5\xd4\xe2\x46\x7e\x75\xb5" +
+	"\xa1\xb1\xba\xa3\x05\x11\x47\x75\x18\xf5\xba\x6c\x3d\x26\xda\x07\xb5\xc4\x87\x2f" +
+	"\xbf\x6e\x68\xc1\xb8\x6f\x7e\x2a\xe7\x1d\x69\x90\x37\x06\x41\x21\x3c\xe1\xed\x3c" +
+	"\x03\xbc\x0a\xe5\xc0\xe8\x9c\x7a\xf9\xab\xd7\xf7\x97\xdb\x63\xd4\xfb\x2f\x43\xba" +
+	"\xfc\x45\x50\xa9\x17\x02\xe0\x9e\xf6\x22\xe6\x69\x2f\x9f\x36\x71\x42\x1b\xcd\x5d" +
+	"\x8d\x58\x98\x12\xb8\x35\x7e\xfc\xfa\xde\x42\xef\x08\x4b\xd7\xcd\xd5\x52\x1d\xc0" +
+	"\x12\xb4\xba\xf1\x6d\xc5\x88\x68\x0f\x51\xc3\x6a\x46\xdd\x6e\xe4\x71\xd5\xe2\xaa" +
+	"\x7b\x8d\xf8\x73\xd6\x34\xca\x36\x14\xf2\x6f\x9c\x3f\x5f\x9f\xb2\x71\x10\x3a\xe8" +
+	"\xa8\xea\xd3\x0c\xc2\x19\x52\x06\xe4\xa6\x8c\x8c\x22\x18\xe0\xd5\x99\x47\xa2\x75" +
+	"\x50\x65\xb4\x0b\x08\x02\xe6\x3f\x3c\xe1\x11\xe3\xa0\x23\xa2\x7a\x44\xf5\x80\x91" +
+	"\x53\x2d\x95\xbc\x81\xd2\x68\xa0\x64\x0d\x84\x40\x84\x56\xf1\xda\xd3\x62\x30\x9f" +
+	"\xd4\xb6\x19\xac\xae\xaa\xb8\xfb\xc4\x52\xc3\xf1\xdf\x65\x15\x16\xd0\x9b\xae\xe1" +
+	"\x68\xdf\xf7\x30\x70\x73\x4f\xe7\x86\xbb\xfd\xe9\x03\x2d\xab\xeb\x17\x79\xc1\x86" +
+	"\x8f\xfe\xf5\x78\x5e\x49\x38\xfc\x82\xf7\x13\xe4\xf3\x44\x9c\x6e\x0f\x50\x47\x89" +
+	"\xc3\xb8\xec\xf0\x8c\xb4\xfa\x32\x0f\xe4\x1b\xaa\xff\xf1\xfe\x4a\x78\x97\x05\x1e" +
+	"\xcf\x70\x81\xc0\xdb\x33\xd2\x9b\xb2\x46\xe1\xa7\x6f\x4e\xc1\xe9\xfe\x5b\xa9\x79" +
+	"\xf3\xa3\x3b\x6e\xd0\x3b\xcf\xaf\xfe\xe5\x1e\x2f\x98\xbd\xf9\x17\x79\x8d\x7c\xc3" +
+	"\xee\xf0\xae\x4f\xfe\xf5\x87\x86\x9e\x8e\xe1\x19\xcf\x76\xd3\xa4\x16\x7c\x2d\x77" +
+	"\xf5\x86\x94\xcb\x6f\x8f\x27\xdb\x47\x7d\x5d\xb0\xe5\x8b\xbd\xcc\x21\xe6\x22\x0f" +
+	"\x17\xea\xb4\xa3\xbf\x3d\x9e\xfb\x4c\xf7\xdb\x39\x73\xb8\x43\x5d\xff\xc1\xd7\xe3" +
+	"\x4f\xe5\xf0\xf3\x8b\x7e\xfd\x33\xbc\x77\x06\xbf\xd3\x72\x7b\x94\xf9\x94\xa3\xcf" +
+	"\x46\x1d\x5c\x42\x09\x30\x76\x16\xb9\x44\xda\x9a\xb7\x86\x6b\x0f\x4e\xbc\x87\x0a" +
+	"\xc1\x3c\xef\x35\xbc\xa2\x0f\x45\x81\xe6\xc8\x47\xc3\xcb\x79\x33\x03\xdd\xd1\xfe" +
+	"\x34\x84\x4b\xe2\x0d\x94\x45\x6d\xa2\x69\x0f\xbe\x9d\x2f\x19\x1d\x13\xa7\x65\x37" +
+	"\xe6\xf0\xf5\x0e\x63\x68\xfa\xea\x10\x60\x73\xba\xa3\x7a\xa3\x36\x90\xe5\xee\xca" +
+	"\xdd\x3d\xa9\x6d\x29\x86\x39\x1f\x0b\xe1\x6b\x06\xb0\x79\x7f\x20\x4f\xe6\x44\x65" +
+	"\x69\x39\x51\x23\xd3\x65\xfc\xf1\xb9\xcc\x4c\xb4\x39\x5d\x1d\x8c\x28\x98\x2d\x57" +
+	"\xfb\x05\x35\xb5\xc5\x5d\xdd\x0e\xa2\x38\x04\xd4\x8d\x58\xdd\x60\xf2\x54\x1f\xee" +
+	"\x11\x3d\xe4\x08\x43\x72\xc2\x7b\x03\x2b\x5f\x5f\x65\x78\x18\x24\x4c\x62\x3f\x0f" +
+	"\xc4\x1d\x40\xe0\x6c\x49\x66\x6a\x98\x66\x15\x9f\xe6\xeb\xf0\x50\x80\x07\xb9\x1e" +
+	"\x54\xc3\xd1\xc3\x2c\x80\xa5\x78\xf5\xb5\xb8\xa8\x26\x45\x5f\x7e\xd7\xb1\xfe\x06" +
+	"\x78\x72\x8b\xc6\x4c\x38\x00\x09\xee\x2e\xb3\x2d\x75\x50\x2b\x69\x5c\x3d\x71\xa8" +
+	"\xcc\x39\x5b\x87\x4e\xb6\x13\xc7\xe8\x4a\xaf\x1c\xad\x29\xc7\xee\x93\x4c\x92\xd6" +
+	"\xc5\x5e\x53\x21\xf4\x54\xf9\x36\x2f\x27\x9f\x96\xd3\x59\x94\xc3\x78\xe2\x43\xba" +
+	"\x92\x00\x3d\xe6\xda\xe1\x4e\x1a\xb5\x31\x37\xa0\x5e\x90\x3c\x4a\xf8\x4a\xc1\x09" +
+	"\x2d\xa6\x32\x39\x49\x23\x51\xd9\x67\xad\x06\x2b\x47\x6a\x72\x35\x2c\x44\xc9\x5b" +
+	"\xb4\x16\xab\x5c\x8d\x8e\x0e\x94\xcd\xd7\x71\xd5\x17\x72\x18\xeb\xe8\xb2\xbd\xa1" +
+	"\xc2\x2f\xef\x45\x02\x98\x5d\xad\xc3\xd3\x6e\xa0\x75\xb9\xb0\xfd\x29\x16\x3d\x8a" +
+	"\x54\x4b\x70\x2b\xad\x0e\x6a\x99\x78\x02\x1a\x61\x71\xad\xc4\x0b\xcd\x26\x46\x78" +
+	"\x1a\xb6\x0c\x71\x59\x39\xd5\x4b\x6d\xa0\xdd\x5d\x45\xd1\x46\x1f\x94\xc2\xd0\x04" +
+	"\x23\x57\x1e\x2e\x30\x0c\xea\x01\x6f\xf4\xaa\xa9\x00\x6d\xe8\x31\xe3\x80\xa7\x6f" +
+	"\x8f\x2b\xdf\x20\x7b\x4c\x9b\xfa\x35\xf3\x0b\x38\xd8\xed\xcc\x85\xa3\x35\x3c\x72" +
+	"\xf5\x07\x2f\xb6\x9b\x88\x79\x75\x13\xf3\xea\x2e\xdf\xd5\x2f\xf6\xcb\x6c\x4c\x4d" +
+	"\x41\x59\x77\xf5\x64\xfd\xf2\x4f\xc8\x95\xd9\x16\xe1\x52\x80\x0d\x54\x17\xed\x34" +
+	"\x6f\x9d\x6e\x09\xcf\x7e\xed\xfb\x07\x2f\x63\x13\x57\x59\xb4\x78\xbc\x50\xde\x34" +
+	"\xf5\xeb\xa0\x96\xbc\xf2\x4b\x6b\x8d\x4b\xab\x79\x38\xe0\x84\x58\x19\xc7\xc1\xc2" +
+	"\x10\x99\x50\x56\x86\x26\x5b\xd7\xaf\x3a\x6b\xbf\x67\xd4\xc2\x11\x67\x5b\xe2\x0d" +
+	"\xf9\x1f\xf5\x81\xf3\x13\x3f\x4e\x94\x70\xd7\x46\x87\xa5\xa8\xe4\x29\x08\xd2\x7a" +
+	"\xe6\xaa\x87\xde\x2b\xf4\xe5\x01\x5c\x6b\x25\xc1\x7f\x97\xad\xd0\x11\xdd\xe8\x4e" +
+	"\x54\x98\x76\x67\x17\x97\x83\xf0\xea\xc3\x7b\x0d\x0e\xa6\x03\x8f\xb0\x7f\x63\xdf" +
+	"\xc4\xeb\xaf\x76\x04\xef\x18\x7e\xcc\xe5\xcf\x4b\x51\x73\xe7\xf8\x5c\xb8\x0f\x5f" +
+	"\xc3\x9b\x1f\xbc\xf4\xb7\x6b\x02\x75\xa0\xa0\xf4\x94\xd3\x70\x4d\x22\xab\xbf\x59" +
+	"\x0f\xbf\xd5\x40\x6f\xdf\xd3\x8a\xaa\x0a\x6f\x2d\x93\x39\x0a\x27\x0d\xdd\x13\x57" +
+	"\x0f\x4b\xce\x9e\x20\x40\x69\x38\x17\xef\xe3\x2c\xbc\xdf\x47\xe0\x20\x7d\x93\xdf" +
+	"\x05\xb3\x01\x53\xff\x2c\x3a\xa8\xb1\x05\xf7\x47\x13\xc4\xe7\x8c\xae\x11\xa1\x7f" +
+	"\xce\xcd\xa2\x7d\x9c\x7e\xce\xb1\x24\xfd\x9c\x9e\x43\x5b\x86\x4f\x0d\x28\x5e\x12" +
+	"\x75\x2f\xeb\xc6\x0f\x1f\xa7\x0f\x1f\xa1\x6b\x4e\xff\x21\x42\x77\xb5\x89\xf1\x79" +
+	"\xb1\x6d\x67\x43\x72\x65\x94\x6e\xc8\xc5\xd3\x13\x47\x51\x37\xc8\x9f\x01\xaf\x1d" +
+	"\x54\x83\xb9\x52\x68\x60\xf0\x95\x0a\xdb\xde\x0b\x75\x10\x39\x87\x1b\xb4\x09\x1e" +
+	"\x4c\x8d\xd3\x65\x02\x23\xd7\x11\x16\xa4\x5e\x86\xe7\xf1\x30\x41\x97\xeb\x08\x89" +
+	"\xd5\xff\x75\x84\x23\xed\x7c\x6e\x6f\xc4\xd3\x8f\x70\x20\xeb\x60\x09\xc4\x9b\xbf" +
+	"\x8e\xd0\xb5\x71\x80\xb7\x7f\x1d\xe1\x82\xe7\xa6\xe5\xc0\x75\x84\x57\x48\x79\x3c" +
+	"\xe1\x55\xd2\x3a\xb0\x14\x70\x5c\xc7\x1c\x24\xec\x43\xd2\x39\x86\xea\xb1\x0e\x3f" +
+	"\x06\x47\x0d\x81\xf1\x50\x18\x86\xb2\xef\x50\xf7\x28\x75\x92\x15\xf0\xeb\x40\x20" +
+	"\x1c\x3f\x92\xf0\x64\xc1\x22\x03\x09\x96\x58\x39\x45\xfe\x22\x75\xc1\xaf\x4f\x69" +
+	"\x50\xaf\xaa\x52\x96\x8d\xbb\x73\x86\xa2\xe6\x96\xeb\x58\x32\x87\x80\xa2\xb4\xcc" +
+	"\x52\xf6\x47\xa9\xa3\x8a\x5f\xdf\xc7\x7c\x88\x2a\x02\xdc\x9c\x73\xc8\x0a\x1e\x42" +
+	"\xd9\xbf\xb0\x67\x38\x6e\x19\xde\x91\x54\x32\xfb\x77\xf1\xc7\x90\xdd\x57\x56\x29" +
+	"\xd3\xfd\x26\x7d\x25\x6f\xca\x45\x63\xde\xe9\x37\x84\xfe\x09\x7b\x6d\xf1\xf7\xbb" +
+	"\x62\x52\xc1\x4a\x76\xf8\x11\x64\xdb\x65\x60\x14\xb4\x32\xf7\x76\x0b\x5b\x8b\x95" +
+	"\xc2\x09\x89\x77\xce\xdf\x3e\x2b\xb8\x3e\xcb\xa0\x6a\x5f\x85\x8c\xe7\xd7\x27\x5f" +
+	"\x46\x43\x35\xac\x57\xe8\xff\xcf\x37\x72\xd8\x9c\x36\xf0\xbb\x6f\x16\xce\x48\xf1" +
+	"\x60\xb3\x45\x2e\xb8\xa7\x51\xe8\xfe\x8d\x07\x7a\x69\xbb\x6d\xec\x84\x4f\x29\x4f" +
+	"\x76\x56\x23\xf7\x39\x47\x2b\xdc\x5e\x6f\x09\xbf\xe8\x28\x8c\x94\xc1\x17\x1d\x5f" +
+	"\xe8\x2d\xd4\x4d\xf9\xce\x2f\xec\x8f\xe8\x1c\xa5\x08\xbd\x39\xc2\x18\xd0\x1f\x81" +
+	"\x28\xd4\x40\x87\xb7\xf1\xbb\xd4\xec\x4f\x1d\x16\xfe\xf0\xb4\x34\x46\x08\xf7\xf4" +
+	"\x71\xdb\x92\x91\x62\x24\x69\x6a\x66\xd4\x58\x90\x6f\x88\x05\xeb\x3d\x14\x2f\xc1" +
+	"\xdb\xbd\x5c\xb3\x59\x95\x96\x2d\x08\x26\x1d\xa3\x96\xe3\x52\x1a\x3e\xf3\xd7\xb1" +
+	"\x7a\xfb\x22\x28\xb5\x0d\xaa\x64\x68\xf1\x57\x12\x80\xcd\x5c\x47\x0c\x4d\x21\xeb" +
+	"\xb5\x78\xb3\x71\x2c\x5a\x3e\xbf\x20\x4b\x67\x92\x70\xfb\xdb\x68\x7a\x95\xe5\x7f" +
+	"\xb1\x15\x8b\xf6\xe0\xeb\x88\x7f\x5d\xfe\xee\x0b\x2d\xfa\xef\x99\x83\xbf\xe7\x47" +
+	"\x48\xd5\x89\xe2\x1e\x21\x69\x19\x21\xb8\xa7\x9c\xd4\xc0\x0f\x2c\xcb\x78\xf3\x3d" +
+	"\x92\xcb\x30\x5c\xc7\x23\xd1\xe6\x9a\xfa\x8d\xc4\x01\xec\x9f\x13\xc4\x7f\xe3\x98" +
+	"\xa5\x71\x0a\x2e\xf8\x8c\x7d\x8c\x79\x7c\x69\xcc\x46\xcc\x05\xcc\x87\xff\x83\xad" +
+	"\x52\x2e\x06\x79\x1d\x8f\x9a\xa2\x0c\x11\x5c\xc7\xc6\x46\xeb\x8b\xbc\x19\x09\xb5" +
+	"\x30\x9f\x5f\x37\xbf\x68\x7a\x1d\x48\xac\x51\xbf\x2d\xe6\xd3\xfe\x61\x5a\x6c\xaa" +
+	"\xc3\x98\xb2\x9f\xff\x5d\xc7\x57\xdd\xc8\x75\x7c\xc3\xd8\xc6\x3b\xfd\x86\xb0\x54" +
+	"\x76\x98\x6f\xc4\x1f\xe6\x3c\xf3\x3a\x12\xbb\x66\x8e\xb2\xaf\x23\xed\xe1\x99\xc6" +
+	"\xb7\x23\x71\x98\x8d\x40\x0a\x29\x8f\x2d\xcc\x12\x11\x07\xb3\xc7\x52\xe9\x87\x1f" +
+	"\x45\x3b\xbc\x67\xaa\x5f\x3e\x7b\x22\xaa\x25\xd0\x8b\x3f\x92\x56\x2c\x8b\x59\x69" +
+	"\x9a\x3e\xb5\xaf\xbf\x24\xd4\x80\x0f\x55\xec\x1f\x07\xde\x62\x28\xa5\x74\x6b\xa8" +
+	"\x22\xaa\xbf\x4f\xed\x63\xf8\xc0\xb7\xc1\x55\x56\xb7\x55\x7a\xa3\x7c\x87\xc6\x1b" +
+	"\xb4\x81\xee\x92\x88\xb7\x02\xfe\x70\x08\x62\x64\xb4\xef\x0f\x96\x39\xaf\xe3\xe7" +
+	"\x80\x23\xe9\x94\x5c\x39\xbc\xed\x4d\x65\x2c\x60\x0c\xaa\x9a\x66\x56\x64\x88\x9c" +
+	"\xef\x5e\x75\x84\xcf\xaf\x23\xc3\x13\x37\x12\x07\xb0\xff\x90\xcb\xf2\xe3\xc4\x63" +
+	"\x16\x9f\x73\xf2\x85\x34\xd6\x75\xec\x26\x3e\x04\x90\x0e\x1e\x81\x6a\x35\xdc\x31" +
+	"\x6e\xfd\xe3\x2f\x10\x3e\x26\x81\x43\x5a\x9c\x45\x29\x77\x2c\x4c\x58\xe1\xeb\x13" +
+	"\xc6\x98\xa2\x8a\x28\x0f\x97\x62\xbf\x8e\x13\xed\x49\x46\x14\x75\x3a\xab\x91\x20" +
+	"\xcd\x4e\x67\xe0\x5a\x58\xb4\xaf\xd5\xc9\xbf\xb7\xee\x72\x16\x2e\xc7\x56\x52\x54" +
+	"\x99\xf2\x10\x2b\x43\xbe\x35\xb9\x75\x6a\xf9\xe0\x83\x28\xaf\x9b\x88\xd7\xf2\xe2" +
+	"\xac\xa5\x8f\x19\x81\x49\x64\x34\x3f\x12\x2f\xea\x62\xf6\xed\x15\x87\x61\x50\xab" +
+	"\x96\xc4\x98\x8d\xb0\x38\x5c\x88\x15\xdf\xc0\x9f\x58\xb1\x83\xab\x9a\xa0\x61\xfa" +
+	"\x0e\x74\xed\x5b\x24\xd6\x3b\x1f\x86\x95\x2d\x0c\xb1\x63\x21\x8c\xb2\x89\xfc\xb2" +
+	"\xb8\xc5\x6d\x81\xe0\xf3\xa5\xcf\x1d\x52\x8e\xef\x01\xae\x26\xff\x3a\x86\xba\xcf" +
+	"\xeb\x40\x5d\xd1\x78\x43\xd2\x8f\x42\x57\x72\x2d\x10\x00\xdd\x46\xbf\x0e\xbf\x05" +
+	"\x63\xfd\xea\xeb\xd1\x3f\xbf\x2f\x76\x10\xa3\x45\xd6\x2f\xd1\xe5\xeb\xe0\xa8\x75" +
+	"\x3c\x41\xc7\x5f\x10\xf3\xa8\x06\x7e\xec\x84\xdf\x2b\xda\x6d\x6e\x14\x67\xdd\x02" +
+	"\xaa\x3e\x11\x32\xe2\x78\x1d\x97\x4c\x36\x38\x55\x0d\x90\xe1\x76\xa2\x14\xf8\xed" +
+	"\x15\xbc\x83\xbf\xeb\x77\xa8\xf5\xc6\x31\x2f\xec\x59\x85\xf8\x43\x6f\x3f\xc0\xbc" +
+	"\x91\x8f\xf2\x37\xdb\x16\x02\xc5\x38\xd3\xa3\x7c\x3b\x79\x1d\x6f\xae\xde\x1a\x61" +
+	"\xc9\x7e\x9d\xac\x16\xa7\xeb\x9c\x87\x0d\xd6\xeb\x34\x8d\xe0\xd3\x75\xae\x9a\x9f" +
+	"\x45\x56\xa7\xcd\xa8\x25\x64\x19\x12\x07\x5d\x07\x68\x0e\x2c\xcd\x16\x03\x02\xe7" +
+	"\x00\x5a\xe7\x44\xd5\x3b\x44\x6d\x25\xe2\xe9\x4a\x46\x4e\xe3\x33\x5d\x52\x7b\x3e" +
+	"\x5d\xb7\xc6\xf3\xeb\x64\x80\x13\xe0\xe3\x5c\xb7\xae\xaf\x53\x96\x6a\xa7\x6b\x08" +
+	"\xe3\x0f\xc0\xe3\xb1\xaa\x3a\x5d\x27\xb6\xca\xa8\x1f\x17\x50\xe4\x12\xe7\x56\x1b" +
+	"\x40\x9c\x6f\x5e\x27\x97\x3c\x14\x0f\x83\x6a\x9f\xae\x93\x55\x0b\xc4\xcb\xd7\x6d" +
+	"\x69\x23\x6d\xba\xaa\x1d\xd1\xd9\x96\xf4\xaa\xec\x8d\x26\x2e\x24\xc8\x59\xe5\xc2" +
+	"\x9f\x14\xed\x4e\x78\x03\x15\x95\x21\x7e\x0d\x81\xd3\x13\x35\xad\x04\x20\xb9\x7c" +
+	"\x73\x35\xeb\x69\x32\x0f\x77\x35\x49\x04\x1a\xb1\x57\xea\xd6\x46\xb0\x13\x59\xcd" +
+	"\xe5\xdd\x71\xff\x60\xba\x24\x47\x94\x1b\x00\x41\x85\xab\x0f\x33\x24\x86\x3e\xd3" +
+	"\xd5\xbf\xda\x53\x0c\xc7\x41\x57\x07\x6a\x90\xbe\x63\x1d\xf5\xe2\x68\x2c\x38\x21" +
+	"\x83\x56\x62\x2e\x8c\x78\x0d\x13\x06\x02\xf9\x33\xbd\x02\xba\xb0\x79\x9a\x21\x0f" +
+	"\xe9\x22\x3d\x3a\x59\x8d\x52\x81\x01\x78\xe2\xc0\xca\xbc\xb8\x64\xd2\x80\x9e\x78" +
+	"\xa1\x89\x9c\x2a\xdd\x75\xed\xd3\x4b\x1f\x58\x27\x19\x7a\x91\xe8\xa9\x0f\xde\x8b" +
+	"\x7d\x0b\x8e\x70\xe6\x6b\xaf\x22\xd8\xec\xbd\x30\x82\x7e\x18\xa5\x52\xa8\x1e\x54" +
+	"\x2d\x0d\xdc\x41\xf6\x70\x14\xc5\x76\x15\x52\x2a\xee\xa5\x97\x34\x00\xb5\xc9\xae" +
+	"\xb9\x19\xa4\xba\x63\x90\xf1\x85\x59\x46\x62\x6d\x1b\xe9\x94\x00\x0a\xc5\x2a\x1e" +
+	"\x87\x66\x2f\x37\x8a\xf7\x0a\x87\x2c\x58\x12\xc5\x95\x0e\xd1\xb6\x39\x51\xa1\x5a" +
+	"\x83\x35\x07\xd6\x70\xfa\x3e\xbf\x90\x68\xc4\xbe\x09\xbd\xe6\x15\xb8\x12\x8b\x7b" +
+	"\x41\x6a\x1a\x97\x1f\x0c\xab\xe2\x06\xc8\xc3\x91\xe1\xe2\x41\x6a\x0f\x51\x8f\xf1" +
+	"\xbf\xe4\xc8\x02\x55\x11\xf1\x36\xe5\xeb\x5f\x64\x4f\xb0\xad\xd9\x2b\x54\xaf\x7c" +
+	"\x95\x47\xab\xe2\x57\xc0\x66\x56\xa0\x56\x8d\x47\xb2\x62\x78\x71\x90\x8d\xa3\x97" +
+	"\x04\xbc\xb2\xbb\x7a\xd2\x95\x7b\x9b\xb0\xc4\x3e\xbd\x74\x7e\xe4\x84\xb0\x58\xe5" +
+	"\x90\x2c\xa2\x3b\xf7\x15\x9f\xd1\xcf\x40\x0d\x5a\xf5\x23\x82\x4b\x62\x44\xbe\xe2" +
+	"\x86\xe4\xd7\xa0\x94\xb1\x25\xaf\x11\x33\xb0\xcf\xbf\x10\xe2\xe8\x3d\x11\x7e\xc9" +
+	"\x2b\xe6\xa2\xc3\xc6\x17\x86\x31\xfa\xf4\x62\x99\xd6\x7b\x10\xd0\x1f\x83\x2f\x5d" +
+	"\x9d\x90\xfe\xc0\x36\x07\xc4\xb6\x1f\xb2\x18\x68\xed\x1c\x8f\xb2\x9b\xcb\x7e\x96" +
+	"\xfd\x28\x3e\xf5\x38\x3a\xa8\x9f\xad\x0c\xac\xff\xcb\xce\x8b\x00\xf8\xf4\x4e\xd7" +
+	"\x01\x14\x43\x9f\x37\xb3\xf3\x2f\xce\xe3\x57\x2a\x56\xe1\x54\x1b\xd1\x3f\xaf\x73" +
+	"\xe8\x89\x27\x73\x2b\xf5\x15\x2b\x0b\x07\x7c\x74\x98\x58\xbf\xcb\x5f\x8c\xc7\x68" +
+	"\x81\x20\xa0\x9c\x2e\x11\xc7\x5a\x2e\x48\x58\x63\x20\x6b\x12\x56\x80\x7e\xfa\xe0" +
+	"\xab\x40\x88\x97\x65\xe7\x3b\x00\xfd\xdb\xda\xe3\x5c\x83\xd2\x9b\x1c\xd8\x00\x56" +
+	"\xc7\x9e\x9e\x65\x7a\x71\x7b\x2c\x3b\x21\x13\x1d\xc4\x56\xbb\x60\x42\x08\x60\x91" +
+	"\x7d\x0c\xaa\x40\xf6\xeb\xe3\xf5\x6b\x76\x62\x2d\xf6\x8a\x9d\x22\x25\xad\xf1\x82" +
+	"\x6d\xf7\x8a\x7f\xa7\x57\x44\xee\x19\x5e\xf4\xeb\x3b\x3a\x7c\x6c\xb0\x56\x7b\xa5" +
+	"\x19\x04\xcf\xf4\x95\x66\xbe\xbb\xf8\x24\xe1\xe0\x74\xf5\x00\xbd\x7d\x05\xe9\x0f" +
+	"\x0f\x44\x3e\x14\x3d\xbc\x10\x44\xc2\x51\x80\xb1\xd9\x5f\x7e\x5f\xc9\x29\xff\x3c" +
+	"\x2d\x08\xa9\x30\x0b\xbf\x92\x75\x72\x11\x0d\x2d\x43\x9e\x41\x8b\xa4\x57\x5a\x63" +
+	"\x31\x97\xc0\x35\x16\x64\xd7\xa7\x57\xe2\x51\x2a\x43\x9a\xd4\x5f\x29\x72\x19\x46" +
+	"\x5e\xd3\xa1\x74\x23\xb2\xc5\x37\xee\x93\x6e\x9f\x9e\x49\x4c\xa5\x81\xfa\x0d\x28" +
+	"\xf1\xa9\xfa\xb8\x15\xf7\x01\x54\x6b\xba\x82\xcd\x54\xb8\x68\x43\x18\x80\xa2\x33" +
+	"\xdb\xcd\x41\x3d\x00\xe2\xb7\x45\x56\x87\xba\x59\xf0\xd2\xe4\x9e\x8e\xf8\x54\xa3" +
+	"\xc1\x82\x20\x48\x06\x5a\x06\x50\x10\x1a\xb7\xa0\x1f\xaa\x5d\xf4\xe8\xc2\x22\x74" +
+	"\xd0\x75\x80\xe6\xc0\x5a\xa0\x8c\x71\x35\x90\x45\x8b\x65\x6c\xa0\x7b\x80\xe6\x09" +
+	"\x42\x2c\x40\xff\x00\x02\x8f\xd2\x02\xc8\xca\xc2\xe3\x85\x42\xc2\xe9\x95\xf8\x94" +
+	"\x3b\xb1\xd7\x4b\x00\x89\x2a\x7a\x06\x07\x55\xb1\xb5\x33\x54\x09\x88\x9e\xc0\xde" +
+	"\x6b\x1d\xae\x82\x1b\xff\xaa\x05\xc1\x74\xf7\x4e\x6c\x86\x45\xa7\x1f\x51\x75\xb8" +
+	"\xe6\x2a\xa2\x86\x30\x37\x74\xcc\x7c\x40\xbd\x3c\x51\xc5\x99\x97\xa9\x2b\x69\x15" +
+	"\x6a\x64\x2c\x0a\x86\x2f\x3a\xca\x1f\x07\xe4\x45\x41\xb1\x5c\xfb\x97\xdd\xa3\x3b" +
+	"\xba\x4f\x06\x25\xdd\xcb\x85\xaf\x5f\xe9\x95\x78\x1d\x2f\xa9\xf2\x85\x4f\x31\x33" +
+	"\xd5\x1a\xcc\xe6\xaa\x55\x01\x4d\x7e\xab\xb6\x03\x1e\x57\xd6\x9f\x30\xbd\x74\xf1" +
+	"\xe8\x55\xb4\xa3\x12\x21\x35\x65\xeb\x65\xd2\x0b\x0d\xa8\x62\x25\x2f\x12\xa2\x32" +
+	"\x50\x3c\xa0\xd0\xb2\x30\x02\x3f\x4e\xa4\x11\xf9\x55\xf8\xd6\x94\x6c\xbd\xaa\xe8" +
+	"\x53\x53\xf2\xb5\x47\x27\xe6\x3d\xf8\xa8\xe8\x2f\x18\x1e\x48\x73\xfc\x40\x71\xfd" +
+	"\x62\xf5\xb9\xe1\xa1\x0c\x8e\xb6\xe5\x2a\xd5\x6e\x1b\xec\x57\x29\x6b\xa1\x7e\xea" +
+	"\xb5\x98\x02\x12\x56\x06\x4f\x70\x2d\x9b\xb9\x8a\x63\x37\xa1\xd8\x37\x3f\x65\x92" +
+	"\xc0\xcc\x8b\x7b\x88\xaf\xf2\xb1\x3f\x01\x69\xd3\xeb\xca\x1a\x4a\xac\x1b\x6b\xb8" +
+	"\x0d\xd2\xfd\xf6\x7f\x6e\x1f\x2d\x78\x65\xfb\xbe\x18\x6d\xd3\x5b\x97\x95\xde\x61" +
+	"\x9d\xde\xac\xed\x71\x04\xa9\xd8\x3b\x3c\x38\x84\x34\x42\x52\x82\x9e\x04\x70\x6a" +
+	"\x84\x5b\x85\x15\xa5\x85\xee\x3b\x3c\xcb\x81\x8f\x2e\xfa\x46\xeb\x39\x8e\x12\xdb" +
+	"\x4e\xe7\x1d\xe8\x0a\x72\xe7\x70\x28\xbc\xf5\x05\x69\x4b\x21\x18\x98\x16\xa6\xec" +
+	"\xb4\x95\x11\xf3\xc2\x8a\xbb\x41\x54\xd8\x8b\xea\x48\x7c\x53\x84\xf4\xec\xee\x71" +
+	"\xa9\x88\x2e\x32\x19\x21\x79\xfe\x81\xbb\xc8\x9d\xce\xd8\x9d\x28\x38\x9f\xdc\x11" +
+	"\x02\x1d\x57\x77\xa2\x88\xfe\x6f\x7a\x5b\x3d\xe1\x43\x3a\x89\xc3\x7f\x87\xd8\x5f" +
+	"\x23\x09\xb9\xf4\x1f\x60\x36\xbc\x83\x38\xab\xec\x24\xe4\xc4\xc3\x09\xa9\xea\x5a" +
+	"\xcb\x4c\x4a\xa6\x3c\xb8\xfe\x1b\x27\x8f\x5f\x64\x81\x72\x1f\x9c\x09\xbc\x43\xdd" +
+	"\x38\xd3\x77\xaa\x88\xcc\x45\x44\xbf\x5d\x2a\x52\x8d\xa8\x8d\x4d\xb4\x04\x7f\xe6" +
+	"\x3f\xd7\xbd\x6f\x10\xaa\xa4\xcc\xa7\x1c\xcb\xa9\x6f\xdf\x77\xef\xe9\xa8\x0d\xa0" +
+	"\xfc\x98\x3f\x79\xbb\x2e\x3d\xd1\x52\x07\x55\x42\x89\x6d\x39\x55\x80\x2a\xc1\xb6" +
+	"\x19\x4a\x11\xec\x63\x44\x6a\x29\x3b\x80\x2a\x34\x25\x46\x62\x4f\x2e\x0f\xf7\x2b" +
+	"\x52\x02\x18\xb9\xb0\x20\x4b\x66\x4f\xef\x08\xb8\x39\x50\x99\xa2\xa4\xe8\x8b\x38" +
+	"\x9c\x02\xa8\x04\x10\x67\x79\x29\x59\x95\xed\xfe\xf2\x7a\xac\x52\x38\x7f\xa3\xe6" +
+	"\x81\x52\xe3\x25\xe8\xcf\xe1\x96\x45\x2e\x5e\xcc\x59\x4b\x79\x18\xf4\x0c\xe2\x57" +
+	"\x09\x59\xc7\x32\xe0\x91\x0a\x50\x71\x9d\x10\x75\x55\xeb\xa9\xaf\x08\x33\x51\xde" +
+	"\x2c\x05\x50\xbf\xa0\x25\xa5\xb6\x2f\xaf\xad\x84\x92\xdf\xe1\x45\x79\x7f\xf9\xa9" +
+	"\x8c\x0f\xca\x57\xa0\xf4\xa0\x49\xe7\x8b\x87\x93\x38\xfc\xca\x28\xd4\x2c\xa4\x1a" +
+	"\xa1\x7f\xc4\x80\xd1\x27\xbc\xda\x72\x0e\xc0\xb0\x12\x57\xd5\x49\x8a\xf5\x46\x96" +
+	"\x4d\x1c\xd7\x37\x17\xbf\x8e\xaa\x02\xaa\x25\xd1\xe4\x89\x37\x8d\x02\x2d\xda\xad" +
+	"\x28\xbc\x23\xfa\x77\xe4\x2a\xb9\xfd\xab\x38\xf3\x23\xf5\x8c\xd9\x9c\x41\x09\xe0" +
+	"\x4f\x48\x51\xd1\xdb\xcd\xe2\x39\x55\x1c\xd3\x10\x66\x46\x48\xe4\x0e\xe1\xf1\x07" +
+	"\xd5\xee\x4e\x2d\x40\x95\x8a\xe7\x34\xae\x42\xbf\x65\x50\x44\xae\x32\xba\xe1\x29" +
+	"\x05\x90\xc3\x4d\x4f\x19\x18\x01\x94\x91\xd4\xcc\xa9\xae\xc8\xe3\xcb\x5b\xa6\xc1" +
+	"\xa2\x71\xba\x8e\x80\xe6\xc0\xaa\x9d\x15\x6e\x7f\x05\x6d\x75\xf2\x79\x94\x8d\xed" +
+	"\x98\xf3\x1c\x3d\x24\x7b\xf3\x21\x48\xcb\xc4\x4f\x65\x4f\x9e\x08\xbf\x44\xff\x06" +
+	"\x20\xa9\x3c\xc2\xfc\xf5\x23\x17\x77\x53\x32\x76\x2f\x86\x8d\xfb\xf4\xc6\x86\xa3" +
+	"\xb9\xd4\x62\x83\x4f\x22\xb7\xe1\xa9\x0f\xdc\x8e\x69\x49\x81\xa3\x74\xd1\x56\x3c" +
+	"\x08\x96\xd1\x7b\x8b\x31\x2f\x1b\x47\x0a\x82\x2a\x27\x1a\x0f\xf1\xbd\x69\x89\x05" +
+	"\x19\x39\x6a\x0c\xb3\x14\xe1\xbe\xfd\x4e\xc6\xdb\x6f\x62\x88\x5a\xa2\xb4\x6c\x9e" +
+	"\x9c\x8b\x94\xef\x2d\x65\x5a\x76\x4b\xbb\x1c\xcd\xc8\x46\xf5\xac\xb4\x53\xd9\xb4" +
+	"\x37\x36\x12\x20\xe6\x59\x5c\x45\x32\xad\x41\x4f\x39\xb0\xba\x44\x47\xa8\xb2\xd6" +
+	"\x6c\xae\x45\x56\xaf\x7a\xaa\xf9\x0d\x8b\xfa\xbd\xa5\xf6\x13\x3f\x4e\x69\x5d\x80" +
+	"\xf9\x91\x00\x70\xba\x0e\xd0\x1c\x78\x29\xc8\xc1\x33\x8e\x36\xb1\xd9\x15\xc0\x54" +
+	"\x37\xbd\xb7\x12\x70\xfc\x9f\x96\x1c\x37\x0e\x8b\x74\x5c\xf3\xde\x4a\x8e\xcd\x5e" +
+	"\x46\x71\x09\x15\x90\x45\xd1\x0c\xdc\x61\x79\x23\x3c\xb4\x3a\xb1\x87\x00\xfb\xf4" +
+	"\xc6\x20\xdb\x47\xe4\x30\x97\x7f\x06\x55\x9d\xd0\xe3\x2d\xaf\x69\x43\x36\xa8\x5c" +
+	"\xe5\x6d\xae\x25\x47\xf2\xd6\x68\x83\xf0\x99\xc0\xaa\xa3\xb9\xd1\xa9\x95\xa8\x1f" +
+	"\x9a\xca\x24\x8c\xf0\x4e\xd6\x9b\x45\xbc\x62\xd9\x9f\x91\xd7\x07\xcc\x10\x92\x64" +
+	"\x14\xc4\x09\x50\xd7\x0c\xf3\xf4\xed\x66\x9b\x06\x47\xd3\x29\xff\xfb\x17\x67\x93" +
+	"\x50\x55\x55\x43\x81\x9b\x02\xef\xa4\x24\xea\x6c\x76\xa8\xfb\x4e\x3a\x3d\x82\x58" +
+	"\x41\x68\xb5\xc1\x26\x1a\xc9\xf8\xb2\xe2\x6e\xfe\x7f\x10\x0a\x43\x64\x42\xc1\x4a" +
+	"\xac\x19\x04\x85\x95\xc8\x4e\x38\xf6\x28\xe4\x45\xde\x6e\x1a\x0c\x6a\x53\x8f\xeb" +
+	"\xfb\x7e\x27\x19\xd0\x45\xf3\x80\x1c\x72\xa2\xa4\xee\x9d\x6c\x92\x91\x74\xe2\x7b" +
+	"\xdc\x13\x00\xc8\xf5\x38\x6a\xd1\x50\x63\xfb\x4e\x7c\xf2\x53\xb3\xd7\x88\x00\xd2" +
+	"\x3b\x35\xfb\x80\x25\xfe\x2d\xb2\x19\x72\xcd\xbe\xc1\x50\x54\xf0\x4e\x7d\x5b\xd1" +
+	"\x75\x38\x40\xc8\xbf\xe0\x37\xde\x1e\x67\xf8\x70\x14\x2d\x97\x1e\x35\xa6\xe6\xd4" +
+	"\x37\x38\xb3\x37\x18\xf9\x74\xe8\xeb\x08\x5b\x67\xef\xe4\xb3\x8a\xd1\x78\x87\xc4" +
+	"\x3b\xcc\xb3\xca\xdf\xf9\x53\xff\x34\x3f\x89\xb4\xe9\x5d\xa4\xae\x4e\xe4\x33\xbd" +
+	"\x4b\xe4\xa7\xbf\x5b\x28\x02\x95\xe7\x32\xaa\xf6\xe6\x02\xc1\x1b\xfb\xe4\xd5\x62" +
+	"\x1d\xd1\x4c\x0a\xb5\xd9\xae\xa0\x88\xe0\x22\x63\xe1\xa7\x8e\x00\x39\xe4\xf3\x34" +
+	"\x65\xf5\x29\xb1\x14\x77\x75\x75\xf9\x5d\xd8\x07\x4e\x68\x71\x50\x36\x34\xaa\x3d" +
+	"\x45\x49\x84\x9e\x39\x92\x89\x59\x00\xd0\x77\xa5\xd4\x9f\x47\xc1\x1e\x06\x21\xd6" +
+	"\x1f\x4a\xfd\x11\xbb\x9a\x10\xad\x37\xa0\x56\xee\x4f\x61\x31\x69\x54\x65\xfe\x98" +
+	"\x9d\x11\x10\xd6\x3b\x6f\x60\x91\xcd\xf7\x73\x3a\xe3\xd6\x1f\xad\x9c\xb0\xca\xcb" +
+	"\xd0\x25\x60\x97\xa3\xc0\x66\x0f\x69\x36\xe8\x05\xfc\x0f\xd8\x42\xcc\xf4\xc0\x7b" +
+	"\xdc\x98\x12\x1d\x7a\x42\x46\x3a\xe7\x04\xe2\x35\xcb\xcf\x1c\xac\x0a\xea\x1b\xcf" +
+	"\xc9\xec\xf4\xf6\xf3\xd9\xf7\xd0\x51\x23\x40\x15\xf8\x9c\x17\x4e\xa3\xdf\x0c\x52" +
+	"\xce\x19\xc6\x29\x83\xd1\xaa\x40\x1b\x0f\x12\x98\x7d\xd7\xc0\x23\x51\xac\x29\xd7" +
+	"\x3d\xc8\xb3\x99\xb5\x56\xb9\xec\x6f\x04\x78\x28\xd4\x0a\x40\xce\xd3\x89\x45\xe8" +
+	"\x4e\x9b\xd3\x3b\xa0\xde\xa0\x39\xf2\xdc\xdd\x72\x77\xcf\xed\xb3\x98\x78\xdb\xef" +
+	"\x71\x14\xf2\x76\x0d\xf0\x4e\xd7\x01\x28\x4a\xfb\x96\x2a\x99\xa9\x37\x96\x08\x70" +
+	"\x36\xcf\xc4\x36\x8e\x34\xe5\x78\x9a\x44\x81\xa0\x6a\x59\x8e\xa7\x3a\xb0\x2b\x8a" +
+	"\x54\x84\x92\x72\x71\x6e\x7a\x57\x74\x85\xbc\xeb\x67\xfa\x1b\x8f\x72\x6e\x65\x4e" +
+	"\x61\xfa\x1b\x6b\xf9\x3b\x7d\xc2\xb2\x75\x73\xdb\xf4\xc1\x04\x09\x4e\x5c\x27\xe3" +
+	"\x91\xc8\xe5\x2e\x17\xfe\x63\xfa\x30\xbe\x71\x94\xe0\xad\x8f\xe1\x27\x86\x6d\xfa" +
+	"\xc4\x50\xb9\xa8\x2a\x90\x3f\x22\x87\xb9\x24\x85\x7a\xda\xd6\xa7\x4f\xcc\x59\x8e" +
+	"\xa2\x34\x0f\x8b\x16\x26\x25\xa1\x86\x7b\x4e\x9f\x78\x4c\x1f\xcd\xa9\x72\xa3\x53" +
+	"\xa5\x07\xb4\xe9\x53\x9e\x61\xfa\x94\xe7\x55\xfb\xf4\x41\xf9\xe6\xa7\xe4\x9f\xe9" +
+	"\xa3\xb1\x3b\x7d\xca\x75\x3c\x6f\xca\x83\x01\xac\x6a\xbe\x88\x22\xae\xe1\xc2\x8f" +
+	"\x15\xec\x9b\xb9\xb4\xa9\x40\x9b\x3e\x12\x40\xfc\x07\xeb\x18\xa7\x5c\x6d\xfa\x07" +
+	"\xf7\x71\xfa\x87\x91\xf1\x6d\xfa\x17\x6b\x91\x13\x89\xa8\x05\xb7\xf5\xe9\x5f\x7a" +
+	"\xfe\x0b\xcf\xe9\x5f\x3a\x96\xe9\x5f\x3a\xf9\xd1\x73\x45\xea\xf4\xaf\xac\x29\x2c" +
+	"\xd3\xbf\xb2\xcf\x29\x3a\x69\xd3\x3f\xc4\x44\x70\x56\x41\x05\xf0\x9f\xff\x95\x32" +
+	"\xfd\x63\x4b\x83\x43\x21\xa5\xb4\x29\x3c\x9e\x1b\x2c\x7d\x6e\x7c\x85\x3c\x87\x23" +
+	"\x85\x29\xe4\x27\x96\xce\x27\x74\x4b\x70\x4c\x11\x26\x36\x6d\x8a\xb9\xd0\x48\x9b" +
+	"\x07\x82\xfe\x83\xab\x9b\xfe\x84\x63\x9a\x03\x76\xb0\x1b\x94\x6b\x18\xd3\x8c\xcc" +
+	"\x32\x27\x6f\xdb\x34\x87\x3a\x87\xb5\xb4\x69\x8e\x39\xc0\x5f\x9a\x63\x7e\x72\x60" +
+	"\x35\x47\xce\x7b\xa6\x99\xb5\xc0\x34\x6f\x17\xd5\x40\xc1\x0c\xdc\xd0\xb9\x34\x9e" +
+	"\x39\x97\x8e\xf2\xa2\x30\xcd\x35\xfc\x4b\x79\x9a\xeb\x75\xc4\x34\xcd\xe2\x29\x10" +
+	"\x7f\xd5\x5d\x2e\x03\x7f\x5a\xc2\x3e\xd3\x2a\x80\x58\x91\xf5\x5b\xc2\x11\xd6\x30" +
+	"\x2d\x5b\x58\xa7\x45\x13\x07\xfb\xac\x9d\x3e\xbc\x14\x94\x79\x2c\x5c\xa0\xc4\x87" +
+	"\xa0\xea\xf2\x39\xa9\xdf\xf2\x0f\x43\x27\x6b\xe4\xf8\xfd\x67\x8a\xcb\x15\x60\xa9" +
+	"\x63\xbf\xb3\xc6\x30\x45\xae\x8a\xa5\x30\xc5\xbe\x25\x1d\x4b\x3d\xd2\x9f\x34\x3d" +
+	"\xd2\xc1\x14\x3e\x3d\xc3\x5c\x8e\xe9\x19\xf4\x88\x67\x44\xbe\x24\x4c\xb4\x72\x98" +
+	"\x9e\x35\xc6\x25\x42\x54\xa7\x27\xd2\x52\x7b\xc8\x61\x7a\x5e\x1f\xe2\xb7\x90\x7a" +
+	"\x9a\xb6\x72\xac\x57\x0d\x6d\xda\xae\x43\xfa\x03\xd3\x12\x55\x72\xe2\x76\x7f\x6c" +
+	"\x29\x4c\x09\x76\x73\xaa\xe1\xbf\x89\x8f\xb7\xe2\x5a\x0d\x31\x4f\x7f\xc2\x1e\xd2" +
+	"\x12\xa6\x3f\xa5\xae\xe1\x98\x7e\xc2\xbf\xf0\xa3\x0f\xe4\xf4\x13\x8f\x4f\x98\x7e" +
+	"\x0a\x7f\xe0\xa7\xb4\xf2\x2a\xd3\xcf\x05\x9b\x62\xca\xa1\xb4\x29\x87\x4e\xa3\xe7" +
+	"\x38\x87\xa3\x1c\x53\x46\x15\x27\xfe\x34\x7f\x70\xfb\x76\xa9\x6f\xe4\xeb\x6f\xdc" +
+	"\xe7\x72\xd5\xe7\xb4\x87\x35\x3c\x43\x83\xb3\xcc\x7f\x78\x27\x91\x0f\xd5\xdb\x43" +
+	"\x5e\xa5\xb7\x6b\x0f\x59\xc1\x9d\xb0\x8b\x39\xe8\x6a\xd3\x4e\xb3\xd3\x89\xf6\x82" +
+	"\x2c\x49\x7c\xd6\x32\xed\xa5\x96\x65\x81\xfe\xa3\xe1\x90\x15\x39\xc2\x9e\x68\xc2" +
+	"\x23\x9e\x21\x4f\x87\xae\x07\xd2\x0c\x6d\x3a\xd2\x12\x6a\x78\x5e\x61\x3a\x12\x23" +
+	"\x54\x2e\x29\x51\x22\xfd\x99\xf8\x3e\x4f\x67\xf8\xb1\x5e\x7d\x86\x23\xec\x61\x3a" +
+	"\x2d\xc7\x67\x3a\x63\xbd\x26\x0e\x7c\xf5\x85\x89\x6d\x3a\x0b\xa5\x4e\x08\xc4\x5c" +
+	"\xcf\x90\xa7\xff\x02\x9a\x55\x2a\xa5\xa4\x30\xd5\xab\xf1\x8f\x2a\x8c\x93\x30\xb5" +
+	"\xb0\x97\x30\xb5\xa5\x68\x03\x33\xf1\x59\x23\x4b\x8b\x95\x9a\x72\xd0\xcb\x79\x97" +
+	"\x6c\x25\xbe\xc2\x0f\x41\x99\x6f\x0f\xa0\xd0\x16\x61\x6a\x17\xef\xa5\xbd\x11\x9c" +
+	"\x90\xa2\xa7\x7f\xbe\x4c\x40\x87\x50\x98\x7a\x48\x6f\xa4\x8a\xc3\x9f\xe4\xf5\xef" +
+	"\xe1\xf8\xa7\x9a\xf4\x0d\x61\x4c\xae\x0f\x14\x54\xd0\x94\xe3\x29\x89\xe5\x44\xf5" +
+	"\xae\xa7\xaa\x77\xfd\x30\xf2\x38\xc2\xbd\xf4\x5f\xaf\x7f\x73\xf4\x62\x38\xff\xf9" +
+	"\x77\x45\x31\xa9\x63\x3f\x02\x9c\x0d\x36\x56\x1f\xce\xa3\x26\x35\x7a\x98\xfe\xa5" +
+	"\x7d\x0e\xf3\x3b\xfa\xb4\x30\x66\x84\x63\x4c\x09\xc7\x3d\x27\xc4\x1b\x29\xda\xe6" +
+	"\x87\xe3\x3b\x41\xfc\x86\x6d\xcc\x16\x87\x8d\xfb\xef\x18\x3f\x7c\x50\x0b\xf9\x80" +
+	"\x06\xc1\x1c\x8a\xf7\x58\xf6\x31\xfc\x1b\x34\x1b\xca\xe1\xb8\x07\xf3\x31\xc6\xf1" +
+	"\xa1\x81\x7c\x4c\x2b\xd3\xda\x36\xad\x17\xdf\x54\x1f\xc1\xc4\xc6\xe7\xe7\xec\xd2" +
+	"\x0f\x30\x86\xef\x31\x3d\xe8\x0d\xe9\x28\x8c\x61\xe5\xf2\x61\x7b\xd8\xb8\x25\xc9" +
+	"\x3d\x56\x0f\x1b\xa5\xe1\xf0\xe1\x49\xa4\x0f\xd0\xb4\x7c\x87\xa8\x86\xe7\xe1\xf4" +
+	"\xbf\x34\xa5\x4a\xb9\x36\x4a\xd3\x24\xce\x35\x75\xf0\xf1\x7a\xf8\x80\x4d\x63\xa0" +
+	"\x1e\x36\x52\x07\x69\xd3\xcf\x55\x57\x4a\xd0\x00\xa5\x75\x34\x32\x8f\x7b\xcc\x1d" +
+	"\xd3\x1e\xff\x52\xd2\x77\x7c\x1d\x3e\xaa\xc2\x61\x63\x88\x5c\x3e\x54\x0e\x8d\x95" +
+	"\xf8\x4c\xbf\x46\x4a\x9a\x94\xc6\x5e\x69\xd3\x38\x79\x29\x5e\x03\xe3\x22\xb7\x0f" +
+	"\x8a\xc3\x47\xc5\xd1\x7c\x5c\x1c\x53\x0b\xd7\x9a\x34\x30\x9a\x8f\x86\xc3\xbb\xbc" +
+	"\xf5\x78\x72\xd3\xe9\xf9\x0b\xe8\x3a\x43\xc1\x96\xea\x61\x1d\x9e\x68\xfa\xf7\xd4" +
+	"\xd3\x1c\x19\xb5\xfd\xaa\x3f\xa4\xb5\x8e\x7d\x78\xcf\x4e\xbf\x3b\xf2\x31\x7a\x32" +
+	"\x99\xd5\x8d\xd3\x14\x72\x98\x19\xed\x21\x87\xf6\xc3\x77\x2b\xfd\x2b\x07\xf4\x87" +
+	"\x5b\x79\x6d\x42\x25\xcf\xa3\x54\x86\x13\x5f\x81\xca\xfd\xd8\xa5\x20\x2d\xd5\x13" +
+	"\x22\xb2\x6b\xcc\x01\x86\xe6\xf4\xc8\xa5\xa6\x95\x19\xf9\x1d\x52\x9a\xd2\x1a\xb6" +
+	"\x32\x25\x16\x1b\x25\x35\xde\x72\x62\xbe\x4e\xe5\x1d\x26\x2f\xfa\x87\xc3\x50\xd8" +
+	"\xd4\xb9\x5c\x8c\xc9\x83\x29\x8f\xc1\xb8\x87\xfa\xd1\xb8\xdd\x43\x6b\x61\xd9\x2e" +
+	"\x64\x3d\xda\xb4\xa7\x65\x4b\x9c\x14\xef\xe9\x38\x62\x2b\x4c\x91\x5c\x41\x6b\x58" +
+	"\x74\x4e\xc2\x5c\x60\xe5\x6d\x76\x9e\x75\xb0\x5c\xe0\x4f\x1d\xf1\xc5\xc7\xa3\x6c" +
+	"\xa9\x4c\xe5\x27\x87\xad\xec\x61\x2a\x35\xc2\xd4\x3e\xe3\x71\xb4\x4f\xe6\x24\x3e" +
+	"\xa0\x8e\x85\xbd\x0e\x97\x38\xe2\xdf\x80\xe4\x45\xd8\x38\xd8\xa4\xc4\x71\x46\x14" +
+	"\xd8\xd7\x21\xc6\x9c\x8e\xe9\xfd\x31\x56\x50\xd8\x59\xda\xac\x61\x9f\x90\x7e\x3a" +
+	"\xda\x14\x98\xed\x7a\x98\xe6\xf0\xdc\xd6\xb0\xea\xcb\xfe\x53\x7e\xf8\xac\x2f\x31" +
+	"\xd3\xc0\x73\x4c\x7f\xc8\x3a\x4b\xbc\x77\x9a\xcb\x3e\x87\xcf\x34\x17\x3e\x94\x7c" +
+	"\xb1\xed\xf4\x74\xbe\x56\xb4\x5b\xf5\x69\x09\xa9\x16\x5e\xc6\x72\x71\x72\xbd\x6c" +
+	"\x69\x09\x7c\x89\xcb\x19\x8f\x2d\x3c\x23\xa3\x37\xe7\xd0\xa6\x35\xe6\x2d\x4d\x6b" +
+	"\x3c\x60\x4b\xac\xb1\xd7\x92\xfa\xb4\xea\x6e\xcc\x84\x92\xe2\xf9\xaa\xcf\x4d\x2f" +
+	"\x4b\xac\x9d\x27\x9c\x9d\x30\xa1\x1f\xf6\x59\xde\xd3\x16\x76\x12\x4c\x5b\xcc\x8d" +
+	"\x1d\xf3\x04\xf3\x9a\x1a\xa9\xbf\xcf\x17\x9f\xcb\x9f\x50\x7b\x98\xfe\xc4\x7a\xc1" +
+	"\xe1\xd8\xa7\x9f\x40\x78\x4e\x8d\x8f\x78\x86\x59\x7f\x62\x74\x21\x17\x06\x36\xdf" +
+	"\xbc\x9a\xd6\x69\x0f\xac\xef\x69\x25\x60\xca\x61\xda\x63\xe6\xab\xc8\xeb\x4e\x61" +
+	"\xe7\xf5\x65\x5e\x2d\x02\xe0\xac\xf9\xf6\xd2\x96\xf2\x9e\xf6\x6b\x9f\x43\x9a\xa4" +
+	"\x58\x69\x9b\x0e\x5a\x61\x4e\xd3\x61\x56\x64\x4a\xcb\x65\x2a\x7f\x1f\x70\xcb\x31" +
+	"\xc9\xd7\xf4\x95\x0a\x6b\xcc\xe7\x96\xc2\x74\xf2\xfd\xd2\x10\x8c\x53\xe3\x76\x29" +
+	"\x2d\xd6\x62\x40\x20\x6d\x6a\x51\x56\x14\x3f\xeb\xc1\x05\x93\xb8\xb1\x3c\xe8\xe5" +
+	"\xe7\xc3\x77\x02\x6e\x71\x41\x4c\x63\xc1\xb4\xa1\xce\xf0\xd3\xc2\xa1\x5a\x3c\x8e" +
+	"\x00\xd3\xbb\x85\xf7\xc4\xfb\xad\x52\xfa\xe6\x6f\x3b\x2d\x53\x59\xa2\xfa\x51\x50" +
+	"\x57\xc8\xf1\xef\x14\x30\x69\x3c\x85\x9d\x31\x39\x85\xfd\x33\x05\x24\x8f\xc9\xfd" +
+	"\xe4\xcb\x12\x28\x10\x09\x46\x8a\xdb\x50\x4b\xa3\x85\x5f\x0d\x2c\xf0\xf8\xfd\xe1" +
+	"\x58\x6e\x9a\x63\xef\x9f\x69\xd6\xf0\x5f\xb6\x50\xf9\xf3\xa2\x29\x4e\xcb\xc6\x1f" +
+	"\x97\xdb\xcb\x09\x27\x9e\x59\x31\xe6\x69\x0d\x1c\xe0\xad\x71\x2e\x35\x68\x65\x76" +
+	"\xa4\x36\xad\xc8\xa4\xae\x90\x23\x4c\x6b\xa9\x85\x0d\x61\x5c\xc5\x6c\x64\x65\x19" +
+	"\xe6\xd8\xb7\x29\xee\x29\x7f\xa6\xb8\xef\x61\xc2\xae\xdc\xa4\x35\x95\x66\xf8\x38" +
+	"\x6d\xb2\xb6\xb3\xc1\xd5\x9e\xb6\x78\xd4\xcf\xf4\x27\x2c\x65\x66\x1d\x15\x99\x9d" +
+	"\x8f\x38\xfd\x09\x98\x3b\xfb\x83\xde\x24\xae\xdd\xfe\x89\x0d\xde\xde\xf4\x27\xed" +
+	"\xd3\x9f\x12\xa7\x3f\x65\x63\xf6\x6e\xf1\xdc\x20\xdb\x15\xa6\x3f\x17\x5f\x7a\x49" +
+	"\x97\xb2\x04\xab\x91\x49\xfd\x95\x8e\x29\xeb\x69\x39\x5c\x95\x35\x15\x8d\x98\x53" +
+	"\x63\x9e\xa8\xcf\x50\x63\x07\xb0\x2c\xa2\x7e\x7b\xe8\x7d\x8b\x6f\x4d\x13\x2c\xec" +
+	"\xa0\xec\x79\x26\xd3\x1d\x45\x07\x2a\x8c\x10\x5f\x2c\x9f\x81\x5b\x79\x02\x57\x9e" +
+	"\x4e\x69\x8c\xa9\xdc\xb2\xcc\x53\xa5\x84\xba\x4e\x1c\xe4\xd4\x3e\x55\x6b\xb3\xca" +
+	"\xc7\xa3\xf1\xfe\x58\xd5\xd0\xa6\x4c\xde\x7d\x6a\x5b\x90\x99\xaf\x1e\x4f\xee\xde" +
+	"\x37\xf8\xad\xd2\x5c\x4a\x8f\x62\xaa\x41\x3f\x04\x76\x69\xb8\xdc\xce\x25\xfb\xe3" +
+	"\x33\xc4\x02\x98\x4b\x72\x4e\x74\x96\x66\xcb\x2d\x36\x03\xcf\x2b\xf6\x1e\xa7\x19" +
+	"\x83\xe1\xc3\xde\xc5\xac\xc5\x5e\x4e\xd3\x5c\xcb\xfb\x48\x71\x50\xa6\x08\xe9\x57" +
+	"\xe2\x8b\xdf\x43\x2e\xd7\x49\x97\x88\xeb\x1a\x2a\x34\xb6\xb8\x20\x06\xb7\x6c\xe5" +
+	"\xcd\x16\x6d\x81\x1d\x99\xc3\x7b\x5a\x6a\x38\x46\xe1\x03\x7f\x58\x11\xa4\xc6\x58" +
+	"\x01\x5d\x0c\xfc\xb5\x70\x74\x26\xb7\x4d\xeb\xb5\x63\x19\xef\x79\x83\x36\xc5\xe7" +
+	"\xd3\xee\xe9\xb2\x8f\x4f\x99\x79\x56\x7c\xc9\xd6\xca\xf4\x08\x7f\x52\x0f\xd3\x23" +
+	"\xe4\xf0\x88\x99\x35\x03\xe7\xf2\xcf\x2b\x2c\x61\xc7\xd4\xec\x16\xfe\x45\x8e\xe4" +
+	"\xa6\xed\xda\xf7\xab\x4d\x3f\x71\x0e\xf3\xf4\x93\xde\x69\xca\xa1\x85\xe7\xc1\xea" +
+	"\x1a\x49\x1a\x27\xcd\xdf\xbb\x7f\x23\xda\xb6\x4b\x8b\xeb\x6f\x4c\x92\x4f\x39\x8e" +
+	"\x90\x5a\x9c\xf6\x18\xcc\x74\xee\x00\x4d\x41\xb9\x84\x07\xd3\xcd\x4f\xdb\x68\x9a" +
+	"\x23\x2c\x5b\x31\xb7\x4d\xc7\xd5\xf7\xf8\x9c\x0a\xca\x49\x78\x09\x7c\x25\xc2\x51" +
+	"\xa6\x33\x9c\xe1\x43\x5f\x61\xa1\xc5\x27\x3e\x2e\x72\xd9\x55\x96\x23\x4d\x28\x31" +
+	"\x42\xcd\x5e\x99\xce\x1a\xfb\xbf\x98\x07\x6d\xd3\x7f\x17\x1d\x71\xaa\x01\x0d\xc3" +
+	"\x93\xdd\x57\x4a\x53\x0b\x99\x59\xaf\x05\xf8\x53\x71\x42\xc4\x31\xa3\x8b\x74\x61" +
+	"\x2f\xda\x4a\x9d\x63\xbf\xed\xd4\xa1\x1c\xf7\x58\x03\x7a\x20\x2a\x36\x7e\xc2\x0d" +
+	"\x74\x8d\xed\x60\x9b\x7b\x2d\x88\x30\x26\xe6\x91\x98\xd1\x1b\x17\xea\x1e\x56\x18" +
+	"\xad\xd3\x1c\x66\x6d\xeb\xb0\x96\x17\x36\xd6\x81\xe7\xce\xbd\xc5\xa5\xb0\x9d\x5e" +
+	"\x0a\x2a\xd7\xa7\xb5\x72\xba\xfb\xc8\x9f\x89\x4b\xf1\xb1\x4f\x0f\xea\x77\x3c\xcb" +
+	"\xf4\xe4\x2d\xe5\x38\x3d\xe3\xf2\x53\xa6\x27\x4b\x95\x3c\x3d\x6d\xaa\x79\xd6\xd0" +
+	"\x60\x79\xc1\x14\xda\x38\xb7\xd9\xca\x73\xda\x68\x92\x09\xd6\x54\x31\xf7\x2c\x3d" +
+	"\xf0\x7e\xd1\x50\x14\xed\x6d\xa7\xe3\x39\x73\xf0\xb2\x7d\x22\xab\x82\xe7\xc5\x07" +
+	"\xfb\x0f\x36\xc2\x1f\x2c\x62\x72\x58\x3f\xf3\xf5\x9c\x72\x0e\x7b\x98\xf2\xe7\xf8" +
+	"\x3b\xed\xe1\x79\xa6\xc8\x07\xe2\x59\x4a\x8b\x53\x39\x4b\x6b\xd7\x3e\x15\xcc\xd1" +
+	"\x68\x1b\x7c\x6e\x2e\x72\x8d\xc9\xc9\x63\x0b\x13\xba\xeb\x3e\xe7\xc5\x86\xa5\x2e" +
+	"\x17\x7b\x97\xc9\x33\x9d\x5c\xe9\x3e\x3f\x7d\x2b\xc7\xf4\xdf\x15\x52\x9e\xea\x96" +
+	"\x8e\xb2\xc4\x5a\x9a\xbd\x1a\x9d\xff\x4e\x2d\x86\xa7\x2c\xff\xc7\xb0\xc1\x8a\x63" +
+	"\x77\x22\xbb\x2f\xc1\x6e\xe6\x22\x73\xaa\x0a\x33\x71\x3d\xcd\x00\x8d\xf4\x27\x86" +
+	"\xf5\xa4\x87\x4b\xf5\x1a\x57\xf3\xcb\x45\x7f\x79\x87\x5c\x61\x6b\xa2\x2c\x11\xee" +
+	"\x67\x59\x4f\xf8\x61\x75\xfa\x84\x1f\x76\x0c\x05\xe6\xd3\xae\x6f\x04\x6a\x7f\xc2" +
+	"\x79\x4e\xe1\x3c\x99\x13\xd0\x52\xb5\x4e\x73\xea\x4b\xe1\x7b\x90\xaf\x28\x95\x28" +
+	"\x13\x3c\x20\xed\xb8\x97\x42\x7d\x97\x72\x3c\xd2\x73\x5a\xce\x6b\x5a\x3e\x28\xdb" +
+	"\x91\x8b\x79\xdc\x38\xad\x91\x16\x95\xcb\x16\x10\x95\x8e\x52\x26\x12\xf7\xf2\x27" +
+	"\x4d\xae\x4b\xa6\x4f\xb0\xf7\xdf\x0c\x9a\x47\xaa\xbb\xad\xec\xf8\x1e\xf2\xf8\x67" +
+	"\x7a\x86\xf9\xd3\xe3\xf4\x4c\x7d\xbb\xe6\xe9\x79\xb6\x69\x0b\x6d\x43\xc7\xe9\x86" +
+	"\xb9\x65\x58\x7c\x5b\xe9\xd8\x86\x9c\xb6\xbe\xe7\x29\x1d\x28\x72\xf9\x13\x5e\x41" +
+	"\x0e\x56\x2a\xce\x3e\xfd\xc4\xcf\x9b\x6f\x76\x4e\xc7\xf5\x77\x42\xe1\xd8\x81\x0b" +
+	"\x5b\x75\x0f\x59\xcf\xdc\xa3\x3f\x6a\x4f\x4b\x2d\x74\xd9\x89\x29\x34\xba\xd5\xb8" +
+	"\x23\x9a\xbe\xb6\x33\x07\xd9\x01\x99\xce\x7c\x51\x0a\xa2\xf6\x0c\xa2\x1a\xe3\x61" +
+	"\x16\xd7\x63\x7e\xa0\xea\xf8\x44\x69\x05\x1a\x70\xa8\x3e\x5a\x9a\xe6\x83\xb7\x2e" +
+	"\x55\x33\x5c\x0d\x47\x4a\xc3\xb2\x4d\xd7\x91\xfe\x4e\x57\xcd\x48\xeb\x4a\xe5\xc9" +
+	"\x74\x35\x9d\x66\x2e\x6c\x01\xe3\x2c\xa3\x51\xef\xb4\xa2\x46\xf4\x9d\x1e\x69\x7a" +
+	"\xa7\x9f\x34\xbd\xdf\x6f\xb1\xb0\xae\x99\xa1\xc7\xa9\xf5\x87\x29\xb9\xe8\xf4\x6f" +
+	"\xc3\x44\x1e\x6d\xfa\x13\x3e\xba\x28\x35\xe5\xb0\xd4\xd2\x5a\x9c\x60\xa3\x9e\xe5" +
+	"\x78\x4e\x1c\x04\x10\xd3\x7e\x42\x8f\xfa\x97\xc3\x4e\xfa\xed\x61\x47\xd8\x37\x1d" +
+	"\x5b\xeb\x14\x73\x48\x56\xcf\xa1\x61\xa5\x7a\x9a\x2f\x98\x9f\xcb\x67\x47\x78\x90" +
+	"\xcb\x34\x85\x7d\x3f\x5f\xec\xb3\xf1\xf5\xa3\xc9\x2f\xda\x14\xfb\x7c\x2c\x08\xcb" +
+	"\x5c\xa8\x01\x5f\x96\x32\x21\x78\x84\xde\x50\x31\x2b\x18\x2b\xd7\xcf\x95\x63\x8e" +
+	"\xd3\xdf\x4f\x86\x87\xc9\x22\x65\x91\x88\xc6\x34\xeb\x9a\x3b\xea\x45\xa7\xa7\x69" +
+	"\x8c\x6b\x9d\x4d\x5e\xb5\x5b\x55\x75\xe2\x68\xc8\xa4\x61\xb9\xba\x81\x1a\x88\x6d" +
+	"\x2a\xa7\x1b\xb2\xe3\x4b\xb7\x47\xec\x90\xfe\x2b\x05\x21\xf1\x58\xa7\xf0\xdf\x05" +
+	"\x6b\x0c\x19\x16\x16\xa0\x5c\xc4\x82\x01\x55\x7b\x8d\x48\x4f\x2c\x7c\x7b\x1f\xbc" +
+	"\x23\x58\x39\xc7\x8a\xa0\xe1\xce\xb2\x97\x3d\x40\xb8\x5e\x71\x2a\xcb\x56\x23\x5b" +
+	"\xb0\xe4\xb7\x66\x5b\x78\x3c\x98\x87\x5a\x84\xdf\xd4\x6c\xed\x86\x05\x3b\x2e\x88" +
+	"\x22\x90\xc3\xd0\xd4\x20\x0e\x4b\x65\x74\x2c\x69\x8e\x79\xda\xb0\x19\x5c\xd9\x2c" +
+	"\xb2\x20\xc8\xb0\xaf\xf8\x56\xe4\xf4\x44\xef\x89\x90\x45\x85\xdc\xcb\x54\xe3\xf3" +
+	"\x19\x22\x93\xee\x16\xf7\x29\x5f\x39\x87\xf9\xc3\x8c\x7f\x74\x49\x39\x1e\x57\x9b" +
+	"\xfe\x5c\xd8\xa8\xad\x53\xe3\xe2\x09\xb2\xe6\x81\xd0\x23\x9e\xba\x3c\x7d\xe6\xab" +
+	"\x97\xe9\x88\xf3\x95\x61\x79\x64\x2e\x6a\xc4\x29\x52\x44\xf9\x3b\xcd\xb1\x7e\x72" +
+	"\x16\xdf\xaf\xf0\x67\x30\xa3\x20\x61\xf3\x90\xaf\x3d\x1d\xcc\x64\x5b\x69\xe7\x56" +
+	"\x98\x56\x96\x2d\x7b\x24\x17\x0e\x27\x66\xdd\x26\xdd\xd0\x28\x7b\xde\x01\x7b\x38" +
+	"\x9e\xb6\x75\x9c\x09\x39\xe2\xf4\x53\x3f\x27\x9f\x79\xf8\xd4\xc7\x84\xa2\x9a\x8b" +
+	"\xf9\x87\x09\x22\x22\xcc\x0f\x87\x25\xcc\x61\xb9\xda\x84\x5d\xcf\x06\x19\x02\x80" +
+	"\x61\xd6\x2d\x95\xe3\xe9\xa8\xd4\x29\xb0\x8c\x60\x0a\x66\x1d\x89\x4f\xf7\xf8\xc3" +
+	"\x1c\x3f\x12\x50\x0c\xf3\x86\x02\x25\xb2\x9a\x95\xa9\x30\xff\xe1\x95\x87\x39\xeb" +
+	"\x0e\xe8\x14\x66\xd8\x43\x0e\x0b\x96\x7e\x22\x4f\xa4\xae\xba\xa3\xe8\x90\x04\x0a" +
+	"\xaf\xfe\xf8\x71\x86\x11\xd8\xf1\x79\x64\x65\x88\xf2\x28\xd7\xd1\x1f\xe6\xc6\x0d" +
+	"\x1f\xd1\x72\xac\x83\xaa\x7e\x2d\xf1\x06\x01\x76\xf9\x05\x50\x67\x29\x11\x0d\xb3" +
+	"\xb4\xcb\xd3\x47\x67\xf8\x33\xba\x08\x33\xa0\xaa\x84\xdd\xa6\xc6\x9f\xb8\xd8\xd8" +
+	"\x2f\xaa\xf1\x65\x97\x13\xb8\x71\xbc\x46\x27\xab\xe8\xd1\x2f\x89\x4f\x86\x65\xc9" +
+	"\x41\xaa\xd0\x40\xc9\x34\x8a\x90\xa2\xec\xb2\x50\xde\x85\xeb\x6a\xff\x66\x59\x6a" +
+	"\xe4\x5f\x0b\x21\xf9\x27\x6a\x8f\x88\x75\x4e\xcb\xc4\xea\x43\x67\x11\x61\x29\xf9" +
+	"\xc3\xff\x35\xda\x00\x2c\xac\x39\x5d\x0f\xcb\x7f\x57\x8a\x6d\x89\x5f\x74\x0c\x0f" +
+	"\x0a\x65\x5e\xe0\x1a\x99\xe1\x98\xa0\x10\x3a\x5e\x28\xa0\x9a\xf2\xef\xb0\x74\x17" +
+	"\x30\x14\xaa\x9f\x09\x8e\x66\x2a\x53\x58\xf9\xfa\xe0\x1e\x2b\x1d\x08\xc5\x65\x2b" +
+	"\x04\x35\x0b\x61\x8d\x67\xa7\x62\x6b\x8a\xd7\x14\xd6\x3f\x97\x2b\x1c\xbf\xa1\xfe" +
+	"\xd8\xfa\xe7\xc2\xb8\x0d\x81\x5d\x5d\x68\xdd\x93\x74\x6a\x4e\x61\x2d\x27\x9a\x15" +
+	"\xc2\x5a\xec\x58\x03\xc3\xba\xa9\x3b\x51\xc9\xc3\x98\x61\x58\xaf\xdc\x5d\x87\x99" +
+	"\x63\x45\xef\x48\xf6\x52\x82\x8b\xd9\x13\xfb\x82\xff\x20\xc5\x57\xe0\xda\xd8\x4a" +
+	"\x64\xc2\x53\xd3\x29\x7e\x50\x39\x53\x88\xec\x39\x17\xd1\xf5\x03\x8f\xd5\xf0\x11" +
+	"\x2e\x6f\xd4\xdb\xd3\xa6\x10\x5b\xdf\x22\xff\x4d\xd7\xcb\x5d\x58\x35\x3c\x1e\x89" +
+	"\xed\x58\xe7\xf8\x80\xef\xea\x37\x9c\x35\x43\xe0\x8e\x59\x41\xe5\xd8\x14\x1e\x59" +
+	"\x57\x32\xa6\xc0\xfd\x4b\x57\xc4\x38\x49\x0b\x26\xd6\x5d\x68\x64\x30\xf3\xab\xa1" +
+	"\xb6\x71\x45\xee\x0b\xdb\x14\x9e\x88\x13\x87\xe7\x33\x97\x3d\xba\x64\x73\x78\x3e" +
+	"\x91\x2c\x4f\xff\x60\xfa\x74\x79\x19\x67\x71\xbd\xe1\x48\x95\xa2\xfa\xff\xd3\x44" +
+	"\x0c\xc3\x33\xb9\x39\xd3\xf0\xcc\xdc\xaa\x09\xcf\xa3\xd0\x97\xd4\x6a\xcf\xf2\x9c" +
+	"\x58\x35\xa6\x7f\xde\xf3\x9e\xb5\x1c\x85\x4d\xee\x56\x3e\x53\x48\x3b\x07\x90\x30" +
+	"\x54\xa6\x90\xea\x1c\x9e\x4e\xdc\xcb\x90\x49\x15\x56\xc7\x26\xc0\x14\x93\x90\x9e" +
+	"\x3b\xa1\x92\x89\x0b\x49\xc2\x47\x21\x55\x3e\x23\x04\xf3\xc1\x87\x36\x8e\xc8\x02" +
+	"\x9a\x30\xf7\x38\x85\x9f\xb4\xcf\x05\x56\xd6\x22\x0b\x32\x21\xcf\x17\x3c\xda\x90" +
+	"\x97\x2d\x72\x09\x70\x0a\x99\x9b\xdd\x6d\x0a\x48\x34\xc0\xec\x36\xa0\x34\xb1\xaa" +
+	"\x73\xe6\x58\x75\xf8\x3d\x85\xfc\xa8\xb1\x2d\x94\x07\xa7\x3a\xe4\x34\x27\x72\xa6" +
+	"\xe8\x7a\x76\x43\x4e\x4f\x5e\x5e\x4e\xc3\x9a\x0b\x82\x87\x9f\x77\xf8\x02\x62\xf5" +
+	"\x99\xf0\x86\xcd\x19\x3b\x17\x91\x50\x49\xfd\x43\x7d\x9e\xca\xf9\x7a\xa9\xdf\xe5" +
+	"\x3d\x1c\x81\xd1\x96\x39\x4b\xe4\x60\x38\xe4\x52\x1e\x5e\xa7\x33\x2c\x30\xe9\xd0" +
+	"\xcf\x72\xed\xda\x94\x07\x3a\xfa\xb8\x78\x13\x50\x92\x10\xfd\xbb\x54\x2f\xfd\xe7" +
+	"\x9d\x3f\xb0\x0f\xaa\xae\x7c\xc3\xf5\x8b\x55\xc1\xdd\xb5\xe8\x10\x10\x7d\x50\xf0" +
+	"\x99\x7c\xc2\x5c\x80\xd5\xbd\xc6\xbf\xa8\x4e\x31\x8f\x58\x47\xc1\x35\x85\x84\x7d" +
+	"\xae\x05\x96\x3a\xbc\x23\x29\x8e\xfa\x4c\x01\x9d\x8e\xc5\x86\xcf\x7e\xcb\xe8\x33" +
+	"\x74\x74\xf0\x1e\xf6\xb4\xaa\x86\x7a\x5b\xfb\x11\x1b\xa7\x72\x61\x2f\x88\x64\x07" +
+	"\x49\x06\xf1\x20\x34\xc7\x04\x66\xea\xfd\xdc\xd2\x50\x83\x1b\x76\xae\x7d\x43\x2f" +
+	"\xa9\x39\xa4\xd1\x50\xd9\xa9\x2a\xdf\x98\xeb\x8e\xe1\x08\xcf\x8a\xe5\xc6\x70\xc0" +
+	"\x8d\x6f\x16\xc4\x55\x2f\x4b\x8a\x66\x5c\xb8\x2f\x8b\x2e\x38\xf2\x56\x8e\x65\x2b" +
+	"\xd6\x3d\x40\x2f\xf6\xc6\xdc\x8a\xca\x92\x7b\x86\x85\x83\x48\x7d\x38\xe2\xb2\xba" +
+	"\x92\xbe\x70\xc4\x9d\x0d\x1b\x5f\xcc\x61\x05\x02\x3d\xf8\xe2\xea\x3c\x4b\x15\xc1" +
+	"\x9c\x20\x0f\x7c\x5e\xec\x14\x50\x2d\x31\x2e\x64\x82\xf5\xaa\x50\x3c\xc1\xfc\xab" +
+	"\x07\xa6\x86\x32\x11\xb8\x42\x7f\x29\xf9\x48\x30\xb5\xac\xe7\x1d\x47\x19\xe3\xf1" +
+	"\x38\xae\x6c\xae\xa6\x5a\x00\xdd\x71\x0a\x47\x59\x3f\xaa\x92\x2e\x0d\x18\x51\x0a" +
+	"\xce\x53\xec\xc6\x42\x38\x4a\x65\xc7\x71\xf4\xad\x96\xb3\xec\xa5\x9e\x9b\x5a\xa7" +
+	"\xa7\x39\x60\x1f\x52\x1d\x12\x7d\x6c\x39\xed\xe1\xaf\xc3\xb2\xfc\x20\xcb\x22\xdf" +
+	"\xa3\x62\x3e\x5c\x10\xe6\x23\x14\xcd\xe6\x14\xdd\x13\xc6\xcb\x61\x55\x0d\xac\x50" +
+	"\xb4\xcb\x84\xa3\xfb\x87\x84\x25\x50\x38\x5e\x29\xb7\x29\x70\xaf\x7a\x0a\xc3\xf6" +
+	"\x85\xb5\x2b\x0b\xb9\x9e\x1e\x02\x7c\x03\xda\x14\xce\x4d\x5b\xa2\x2d\xad\xf2\x14" +
+	"\xeb\xa4\x27\x27\x93\x53\x38\xcb\x93\x0f\xc4\x59\xce\x2c\xce\x34\xb0\x75\xae\xe7" +
+	"\x1b\xe0\xbd\x01\x50\x3b\x24\xd8\xb7\xb8\xa8\x66\xe7\xc9\xc2\x1c\xb9\x86\x3c\xbe" +
+	"\x21\x27\x26\x6d\x24\x00\x4d\x30\xb7\x1c\x52\x67\x18\xb0\xd9\x67\x2d\x20\xd4\x35" +
+	"\xeb\x01\x33\x17\xb3\x01\x6c\xca\x2d\x7f\x91\xd0\x63\x38\x31\x7f\xdf\x02\x7f\xd2" +
+	"\xa0\xe7\xa8\x71\xf3\xab\x47\xf2\xd0\xba\xbc\xa1\xd3\x8d\x14\x11\x58\xe6\xbb\x32" +
+	"\x57\x1d\x36\x6d\x58\x25\xbb\x1d\x42\x20\x8c\x17\x0b\x84\xf9\x07\x88\x1a\xa1\xff" +
+	"\x5d\xe2\xbb\xb9\x7a\x91\x50\x67\x66\x22\xa3\x52\x75\x81\xe8\x00\xd1\x33\x9f\x18" +
+	"\x3e\xd7\x95\x53\x22\x23\xb6\x7b\x51\x7f\x16\x42\x25\xfa\x47\x28\x9d\x31\xc3\x41" +
+	"\x05\xf3\x8a\xea\xb2\x69\xca\xab\xeb\xe5\xbd\xaa\x3e\x4b\xc7\xc5\x7c\x23\x3d\x80" +
+	"\xd3\x84\xd0\x70\x75\xff\x0e\x0e\x6d\x2f\x4b\x0d\xd2\xd2\x7e\xf3\x27\x48\xb0\x23" +
+	"\x35\x2e\xa0\x81\x41\xf6\xbd\x48\xb8\x2a\xd4\xfd\xe4\x26\x7b\xa8\x18\x35\x71\xd5" +
+	"\xd0\x81\x99\x48\x6d\x89\xfe\xf2\xe7\x31\xa8\x0d\x00\x5f\x81\xd6\xbe\xd1\x87\x96" +
+	"\x29\x70\x47\x89\x05\x02\xd2\x58\x78\x1a\x13\x02\xc1\x7c\x9f\xa6\xd0\x5c\x19\x8a" +
+	"\x0a\x6c\x4b\x1c\x9f\x37\x76\xab\xd2\x0c\x1c\x1a\xc6\x92\x96\x29\xb4\xf8\x57\x6b" +
+	"\xaf\xb6\xb1\x19\xe0\xc0\x44\x6f\xa5\xfd\xc4\x37\x1c\x3c\x06\x42\x3b\xb7\xcf\xdf" +
+	"\xa4\x89\x8f\x29\x7f\xe2\x04\xe3\x83\x8b\x1e\x34\x35\x7e\x6b\x49\x2d\xf6\x45\x54" +
+	"\xab\xb5\x34\xec\xcb\xb3\x9b\xf3\xf7\xdd\xda\xe5\x79\xa0\xeb\x84\xbc\x74\x4d\x18" +
+	"\x9c\x08\xad\x6f\xac\x19\xa9\x95\xcc\x3f\x77\xab\x73\x4f\x95\x10\x19\x75\x0d\x5f" +
+	"\x4b\xac\x40\x36\x6a\xb1\xde\x90\x87\xa2\x7f\x6b\xfb\x34\x34\x6f\x85\x86\x5d\x45" +
+	"\xaa\xd2\xc3\xcb\xa7\xa7\x1e\x73\x22\x07\x9a\xc5\x3d\x44\xaa\x89\xa7\x80\x3e\x58" +
+	"\x5c\x35\x64\x2f\xe7\x04\x37\x95\xae\xe4\x8a\xbd\x42\xef\xf1\x60\xa1\xfb\x45\x2b" +
+	"\x90\x37\x42\xaf\x1e\xfa\x84\xc2\xc5\x56\x23\x5c\xcf\xcb\xdd\xcf\x14\xae\xaa\x6b" +
+	"\x7e\xe1\xb7\x41\x28\xce\x43\x63\xd5\x0b\x94\xba\x1d\xbd\xb8\xcb\x7a\xd3\x67\xa0" +
+	"\x7e\x03\xaa\x7a\x75\x7a\x0c\x0f\x83\x91\x59\x5c\x9f\x4f\xb8\xfa\xb5\xab\xd6\xdc" +
+	"\x76\x54\xf1\x53\xd0\x05\xbf\x0a\xc5\xd1\xd4\xf5\x4a\x9a\x1d\x5e\xde\x75\x5f\x89" +
+	"\xbd\x74\x9b\xc2\xab\xbc\xe5\x30\x4c\x5f\xd7\xb1\x70\x27\x66\x0a\x88\xfa\x84\xbf" +
+	"\xc9\xed\xee\x84\x7f\x21\x47\xb6\xb3\x10\xb8\x37\x08\xc7\x55\xe8\xd5\xb6\x1f\x4e" +
+	"\x85\x96\x1f\x38\xcd\x02\x33\x8c\x53\x43\xc9\x77\xf3\xcb\x8f\xb6\xfc\x0a\x5c\xca" +
+	"\x25\x89\x44\x30\x57\x5b\x15\xb8\x96\x42\x71\xcb\x0f\xcc\x1a\x1e\xb0\xfc\xc0\x62" +
+	"\x10\x40\xa5\x82\x68\x79\x5a\x0c\x7a\x0a\x62\xfd\x05\x2d\xb8\x72\xac\x24\x50\xca" +
+	"\xae\x10\xee\xee\x77\x4f\xd9\x32\x32\xae\x86\xe8\x4a\x42\x62\x23\x09\x61\x61\xd7" +
+	"\x02\xd9\x11\xfd\x7c\x51\xe4\xac\x6b\xf9\x79\x87\x51\x10\x4c\x2e\x1e\xb8\xae\x30" +
+	"\xad\x83\xcb\xec\xcd\x61\x3d\x78\x85\x73\x78\x22\xf2\x33\xc3\xdb\x3b\xe3\xa0\x84" +
+	"\xa7\x8c\xec\xb0\x28\x02\xb2\x13\x8a\x93\x72\x48\x6a\xbd\xcc\xea\x7c\x0e\x79\x94" +
+	"\x91\x75\xad\x60\x0e\x99\x8a\x64\x64\x6a\x61\xf6\x0e\x42\xbf\xc5\x9f\xda\xff\x20" +
+	"\x65\xcc\xba\x4f\x27\xf4\xd9\x4a\x01\x34\x3d\xa1\x05\xda\x24\x73\x0a\xcf\xfd\x99" +
+	"\x99\x83\xf5\xf2\x2f\x83\x58\x06\x70\x80\x17\x8e\x35\xa3\xfc\x9c\x06\x3b\xd6\x5d" +
+	"\x43\x53\x90\xa3\x32\x9e\x7d\x78\xb5\x50\x44\xe8\x84\x87\x8e\xcb\xbf\x73\x38\x7e" +
+	"\xc4\xc4\x02\xa0\xe5\x88\xb8\xff\xae\xe8\x9d\xe0\x68\x5b\xd4\xe3\xba\xaa\x72\xc0" +
+	"\x01\x99\xc3\xc9\x30\x0f\xd9\x8f\x89\x18\xf2\x86\xe8\xc6\x20\x24\xbd\x9c\x2a\x20" +
+	"\x9a\x25\x3e\x90\x5e\x77\x85\xc3\x3b\xc3\x45\x4f\x87\xde\x10\x67\x07\x08\xce\xce" +
+	"\xcc\xc7\x3c\x41\xab\xe2\x39\xd4\x03\x2b\x16\x37\x50\x82\x03\xe9\x34\x40\xf1\x86" +
+	"\x47\x53\xba\x4a\x2d\x7c\x01\x67\x66\x61\xbd\x2a\xec\xe5\x8d\x24\x68\x25\x24\x06" +
+	"\x3d\x65\x55\x99\x74\x4b\x44\xf4\x8d\xd7\x2f\x65\xcc\xa1\x85\x4c\x61\x92\x93\xf5" +
+	"\xdc\x2d\xb1\xaa\x72\xd0\x28\xba\xfd\xc4\x6e\xcd\xca\xc9\xaf\xd2\x6b\x46\xc1\x0c" +
+	"\x26\x87\x95\x54\xa3\x6f\x08\x4e\x05\x16\x37\xb3\xf9\x47\x7f\xec\x89\x32\x7a\xb3" +
+	"\xbf\xca\xcc\x84\xb7\xbb\xbd\xc6\x2f\x24\x3b\x1a\x07\x9d\xe0\x7d\xaf\x34\xdc\x9b" +
+	"\x27\xbf\x59\x71\xcd\xe1\x53\xae\x69\x46\x31\x27\x7c\xa6\x6e\x50\xdb\x96\x39\x86" +
+	"\x95\xbc\x52\x5e\x31\x47\xb6\x4e\x78\x60\xf4\xce\x31\x1c\x73\x78\xe2\x3d\x1a\x06" +
+	"\x99\x40\xb5\xfd\xc0\xd4\x45\xf7\x26\xca\x81\xd1\xe4\x70\x50\xd1\x18\xf4\x00\x5e" +
+	"\x6d\xd4\xfe\x92\x99\x89\x00\xac\x5c\x4c\x73\x5c\xc3\xbf\x7f\xaa\x4a\x5c\x61\xed" +
+	"\x1a\x21\xf1\x1a\x5f\xc8\x7d\xc5\xf5\x21\x29\x3f\x50\xa6\x17\xc5\x95\x35\x10\xb4" +
+	"\xb2\x3f\xb4\xac\x35\xb1\x6d\x17\x52\x27\x8c\xab\xcb\x95\xcf\x71\x6d\xdd\xfe\x4f" +
+	"\x74\x36\x9d\x23\xf5\x50\xdd\x11\xe0\x5f\xc6\xf6\x0e\xb0\xaa\x74\xf5\xc6\x88\xca" +
+	"\x43\xd3\xd0\x1c\x1f\xae\xf6\x75\x8e\x8f\x42\xae\xc7\x65\xcc\x81\x99\x0d\xe8\x34" +
+	"\x47\xd8\xa8\x33\x6c\x34\x26\x39\x8e\xe4\x29\xf2\x89\x2a\x46\x7b\xca\xf3\x42\x02" +
+	"\x6d\x8e\x5b\x84\x01\x3c\xcd\x11\x89\x66\x95\x2f\x40\x93\x66\x38\x41\xa2\x14\x91" +
+	"\x99\x4e\xb9\xd2\xa0\xbf\x97\xe1\xe0\x4b\x1a\x7a\x8e\xf9\xa1\x67\x64\x66\x22\xbe" +
+	"\x6c\x8a\x4f\xae\x95\x64\x8e\x39\x07\x3b\x17\x05\xce\x45\x49\xf3\x56\x4e\xd1\xb4" +
+	"\x70\xe8\x20\x84\xc6\xce\x63\xf9\xed\xe1\x1f\x78\x43\x43\xec\x61\x6e\x6a\x90\x98" +
+	"\xb7\xb8\xeb\xd3\x1c\xf7\xa2\x37\x08\x51\xa2\x03\x36\xa8\xf5\xdf\x78\x30\x56\x7b" +
+	"\x61\x8c\x80\xd3\xe2\x0f\x39\xfc\xe6\x8a\x10\x46\x24\xe6\xa8\x8d\xd5\xec\x6c\x8e" +
+	"\x19\x9b\x18\xd4\x00\xbd\xa6\x35\x4d\x73\x6c\x11\x59\x3c\xa7\xd6\x8a\x6d\x4f\x68" +
+	"\x41\x9f\x11\x9b\xb0\x4c\x52\x91\xc2\x18\x37\x8f\xe6\x73\x34\xca\x30\xb9\x18\x4d" +
+	"\x9c\x39\x23\xb9\xe7\xff\xc3\x57\x15\x73\xec\x4e\xa4\xae\x65\x46\xc6\xa0\x6f\xca" +
+	"\x26\xa4\xd2\x51\x46\x33\x47\x54\xe2\xca\x47\x41\xef\xa0\x3e\x29\x59\xf7\x41\x95" +
+	"\xf6\x56\x4d\x88\x0c\xcc\xc1\x02\x6b\xe6\xcf\x23\x8c\x91\xdc\xc3\x19\x08\x77\x23" +
+	"\xaa\x13\x55\x48\x3a\x55\x35\x07\xa4\x3b\x29\x86\x85\x33\x89\x9f\x41\x1b\x7f\x81" +
+	"\x0f\x7e\xbf\x9d\x01\x2c\x64\x79\x96\xdb\x47\xfa\xfc\x8c\xb8\x5a\x0f\xcc\x89\x71" +
+	"\x69\xa7\x95\x33\x37\x1a\x90\xa1\x07\xf0\x86\xd3\x78\xc3\xe9\x7e\xc3\x42\x3c\x04" +
+	"\x39\x91\x8d\x37\x94\xca\x1a\xd1\x9d\xa2\x05\xf8\x9c\xca\xea\x7a\x95\x14\xf5\xb8" +
+	"\x68\x9c\x54\x64\x14\x4a\x9f\xe3\xc4\x05\x91\x39\x95\xb3\x29\x41\x3b\x91\xdf\x9f" +
+	"\xe6\x74\xfa\x6a\x15\xa8\xca\xda\x8a\x7f\xb6\x77\x99\xea\xba\xb0\x3a\x9c\x53\x5d" +
+	"\xa5\x59\x09\xa0\xbd\xa3\x00\x87\x0a\x73\xaa\x2c\x13\x2b\x7f\x47\xeb\x61\xb8\x49" +
+	"\x73\x6a\x98\xfa\x84\x68\x39\x3b\x27\x56\x84\x1c\x2e\x71\x8f\x4e\x8d\xd6\x99\xab" +
+	"\xd1\x63\x39\xcd\xe9\x55\xae\xb0\x4c\x73\xf2\x4b\x57\x73\x86\x11\x5c\xfd\x78\x3a" +
+	"\xd5\xf5\x8b\x9a\x41\x63\xfc\x0b\xfe\xd1\xe2\x00\xc4\x8e\xf7\x8b\xa2\xc7\xef\x21" +
+	"\xe5\x51\x16\xab\xc8\x39\x87\x3d\xba\x98\xfa\xcc\xa5\xf6\x8d\x74\x88\x8e\x28\x83" +
+	"\xb4\x88\x89\xda\xf7\x21\x87\x8a\xf8\xc6\x9c\x03\x4d\xc6\x0a\x8f\xb0\x2e\x47\x03" +
+	"\x2e\x87\x7f\x8c\xad\xcc\xd4\xac\x96\xce\x31\x30\x07\xe4\xc8\xdc\x93\x5d\xed\x31" +
+	"\xaa\x65\xd9\x9a\x3b\xe5\x6b\x88\x3a\x52\x02\xc6\xd8\xcb\x69\xa7\x76\xe9\x58\xd1" +
+	"\x8f\xf6\x45\x16\x87\x8d\x97\x41\x3d\xa4\xff\x83\xc0\x54\x36\xa2\x8a\x97\xe5\xc7" +
+	"\x3e\x10\xb0\xb3\xfc\x6b\x95\x4b\x59\xf9\x44\x11\x58\xd6\x0d\x4e\xb6\x41\xcd\x4f" +
+	"\x00\xed\x52\x14\x64\x13\x2e\x48\x3c\xe9\x5f\x70\xa4\x75\x93\xdf\x8a\x18\x56\x64" +
+	"\xf0\xec\x1e\x28\xa3\x9c\x73\x2e\x1a\x83\x22\x8a\xe7\x7d\x43\xb9\x34\xa6\x92\xde" +
+	"\xb6\x1e\xcb\xe5\x2d\xc3\x09\x42\xff\x48\x7a\xf9\xcb\x47\x55\xae\x9a\xd6\x80\x9e" +
+	"\x7f\x45\x26\x3b\x03\xd6\x31\x2e\x46\x81\x6a\x7f\xc5\x3f\xc8\x37\x65\xf1\x40\xe6" +
+	"\xcc\xdd\x83\x3a\x5f\x16\x55\x67\xb9\xb6\x0c\x60\xcd\x65\x4f\x68\x63\x65\x60\x10" +
+	"\xe9\x28\x3b\x08\xf3\x96\x63\x8d\xcc\xdd\x5f\xc2\x49\x60\xd4\x6e\x93\xcc\x25\x74" +
+	"\xdd\xdf\x00\xe8\x6b\x5f\x02\x52\xd1\x7c\x67\x65\xef\x05\xb2\xd0\x09\xca\xdc\x34" +
+	"\x49\x31\x62\xc3\x84\xf8\x24\x53\x7b\x59\xf5\x49\x2e\xcf\xf8\xb1\xdc\x7c\x2e\xa7" +
+	"\xb9\xc0\x4f\x94\x5f\xcb\x19\x75\xb8\x1b\xf2\x8a\x4b\x46\x2c\x7d\x46\x85\x25\x0d" +
+	"\x80\x54\x17\xfd\xde\x28\xdf\x76\x8c\x67\x6c\x6a\xa5\x72\x84\xe3\x1f\x4f\x3c\x56" +
+	"\x5b\x8f\x94\x23\x5a\xef\x28\x87\x24\x3c\xca\xd1\x42\xd2\x09\xef\x9c\x68\x2e\x41" +
+	"\xce\x61\xa1\x6a\xc6\x52\x7e\xc6\x87\xd9\xa1\xda\x4b\x67\xc2\x3f\x1e\xca\xe8\xa7" +
+	"\x96\xe5\xa7\x71\x8c\x6f\x81\xdc\x36\x01\x1c\x2b\xc7\x73\xa0\xea\x05\xf6\x1c\x9f" +
+	"\x83\xb2\x35\x03\xb2\x08\x3e\x27\xe4\x88\xfe\xe2\xae\x7c\xdb\x0c\x98\x20\xae\x43" +
+	"\x75\x4e\x64\x30\xf9\x73\xb5\x2d\x4c\x35\xa5\x5d\xb4\x16\xc2\x92\x6a\x07\xf5\xbb" +
+	"\xe2\x33\x3f\x1f\x8b\xda\x34\xf7\x15\x56\x5e\x07\x77\x45\xbe\x90\x5a\x5f\x6b\x49" +
+	"\x54\xe2\x7a\x3c\xd8\xd4\xcf\xe5\x4a\x99\x03\xd0\xb9\x5c\xde\x24\x5c\xd3\xb7\xf7" +
+	"\x80\x0a\x2f\x2d\x0d\xcb\x55\x91\x45\x9b\x0b\xba\x27\xa6\xb9\xbc\xf5\x5f\xa8\xe7" +
+	"\x1b\x91\x0a\x8a\x79\xf3\x31\x53\x5b\xfd\x45\x1c\x7b\x2e\x7f\xd9\xa8\x4c\x73\xf9" +
+	"\xa4\xa6\x19\x9c\x95\xac\x9a\x84\x95\x12\x5b\xde\xb9\x86\x24\x11\x97\x15\x11\xdc" +
+	"\x74\xd8\xa8\x04\x99\xc9\x71\x05\x62\xff\x70\xe0\x37\xf7\x62\x15\xaa\xe2\xe0\x9c" +
+	"\x19\x51\x01\x3f\x51\xef\xbc\xc2\x45\x65\xc2\x11\x7b\xde\x9e\x37\x2a\xd0\x5a\xd2" +
+	"\x67\x00\xa4\x22\x5e\x9c\x10\x40\x91\x86\x9a\x6b\x78\xeb\x75\x56\xee\x88\xe0\x43" +
+	"\x7a\xac\x86\x7f\x7c\x93\x45\xac\x94\x7f\xec\xe0\x67\x16\x74\xb6\x78\x36\xbc\xd4" +
+	"\x6b\x9f\x7f\x41\x6a\x10\xc3\xea\xd7\xe3\xc0\x3f\xb2\x38\x2a\x64\xef\x05\xe4\xeb" +
+	"\x68\x74\xcc\xf7\x59\x4b\x35\x41\x04\x2d\x06\x1e\x7b\x43\x5d\x00\x0a\xf9\x83\xdd" +
+	"\xd7\x19\x29\xfe\x4d\x7f\x33\xda\xc6\x1a\x59\x36\xc2\x8d\xc1\xa2\xf3\xe4\x99\xd1" +
+	"\xc8\x0a\xe3\x69\x1b\x04\x60\xdb\x03\xec\x3e\x3b\xcb\xb3\xc1\x51\x93\xd6\xd6\x22" +
+	"\xb4\x64\x7a\x86\xd5\xfe\x62\x42\xf2\xd5\xa9\x04\xae\xe7\x9a\x76\xe4\x29\x95\xdc" +
+	"\xdd\x9f\x3d\x98\x21\x12\x82\x54\x8f\xa2\x9d\x78\x85\x89\x87\xc4\xcf\x0c\x7f\x45" +
+	"\xcd\xc4\x25\x50\x52\x94\xb0\xb2\x17\xeb\x0e\xb5\xf8\xa9\x65\xd1\xbe\xb0\x16\x44" +
+	"\x0c\xe7\x8a\xcd\x1d\xea\xa0\x3b\x9e\x73\x2d\xf4\x14\x3e\xad\x86\xe0\x1e\x2a\xb0" +
+	"\x38\x31\x6f\x2f\x6e\x94\x6c\xae\x45\xe2\x1b\xa2\x7a\x64\xd9\x99\x2e\x7e\x80\xd7" +
+	"\x16\x36\x5e\x77\x79\xb3\x31\x70\xc1\x2f\xf5\x14\x14\x2e\xfa\xdf\xbb\x9a\x76\x60" +
+	"\x46\x55\x85\xab\xab\xfb\x5e\xcb\x12\xa4\xce\x68\xbe\x6e\xb6\xc2\xb5\xfc\x48\x38" +
+	"\x82\x20\xdb\x7a\x5c\xcb\xcf\x7b\x53\xe9\xd7\x52\x32\xf3\xd4\xb5\xae\xe2\x93\x3b" +
+	"\x20\x66\x7d\x46\xda\xb6\x0a\x32\x41\x5d\x8f\x87\x8b\x1d\x84\x99\xcd\xf3\xf5\x64" +
+	"\x33\x03\x55\x63\x5c\x08\x78\x22\xa0\x86\xbe\xc7\x00\xf5\xaf\xdf\x95\xf3\x83\x0f" +
+	"\xeb\x17\x59\xf2\xfc\xa8\x6c\x11\xae\x9c\x91\x14\x12\x28\xf4\xbc\x2b\xe7\x93\x5e" +
+	"\x7d\xe5\x6c\x83\xf6\x42\x8c\x86\x3f\x87\xb0\x08\x09\x18\x44\x33\xdb\xe5\xa1\xbe" +
+	"\x7c\xbe\xf6\xd3\xfe\x19\x56\x25\xad\x1a\xd2\xae\x39\x5f\xc7\xf8\xf2\xba\xa5\xd5" +
+	"\xf9\x92\xea\x06\x4d\x43\x6e\x8b\x1e\x11\x04\xad\x1f\xae\x9a\xc3\x89\x88\x5d\x8e" +
+	"\x34\x2c\x88\xed\xfb\xa0\x4c\x62\xe8\x6e\x9b\xaf\xca\x25\xa3\xf9\xe2\x10\x71\x9a" +
+	"\x51\xec\x96\x21\x9f\xb9\xb0\x03\xbc\x1a\xf2\x2e\xd4\x53\x1d\x4c\x07\x7c\xcb\x75" +
+	"\x3a\xc2\x24\xbe\xbe\xca\x0a\x47\x2e\xcd\x21\xe2\x85\x4c\x97\x57\xef\xd6\x0c\xbd" +
+	"\x97\x83\x9b\xc5\x0a\x42\xd2\x62\x9a\xaf\xbf\x0c\x95\x0b\xc9\x93\x69\xbe\xfe\xfd" +
+	"\xb3\xf7\xfb\xef\x9f\xe4\x6b\xe6\x0f\x92\x7e\x72\xdb\x34\x7f\x6c\xf5\xf0\x19\x06" +
+	"\x13\xe6\x8f\xeb\x99\x9c\x66\x8e\xac\x70\xc9\xb4\x04\xa4\x47\x70\x91\xaf\x5c\xc2" +
+	"\x3c\x23\x64\x1a\xe6\xb5\xb2\x86\x05\xa5\x23\x76\x53\xc5\xbf\xb0\xbe\x7d\xc2\x0b" +
+	"\x5d\xe8\x01\x08\x6a\xb0\xd4\x1b\x84\xc2\xdc\x78\xf2\x12\x56\x71\xd7\x9c\xf2\x42" +
+	"\x16\xe3\x09\x41\x10\x95\x59\xb5\xdd\x11\xfd\x17\xf0\x57\x8a\x79\x46\xe2\x52\xe5" +
+	"\x22\xc3\x1f\xfe\xba\x91\xd5\x69\x25\x16\x41\x35\xe9\x15\x97\x78\x74\xa6\x4e\x84" +
+	"\xae\x08\x4c\x2c\x21\x3f\xd4\xc5\x91\x63\x5f\x24\x41\x9d\x38\xa1\x15\x33\x8f\xf3" +
+	"\x1a\xb8\x55\x84\xe6\xe4\xcb\xf1\x5f\x1e\x15\x9e\x13\xd2\x74\x00\x7b\xc2\x0e\xdf" +
+	"\x6c\x41\xfe\xe2\x50\xfc\xe7\x6c\x14\xbb\x07\x94\x28\x56\xfd\xa1\x9d\xf9\x0c\xba" +
+	"\xe8\xc3\x08\x8a\x39\x8f\x2b\x56\xc8\x96\x11\xc4\x29\xbf\x6a\xba\x9f\xae\xf8\x99" +
+	"\x40\x1d\x1c\x2c\x61\x6f\x1b\x17\x83\xe1\xcb\xeb\x9d\x1c\xc8\xd9\x4b\x5c\x8f\xef" +
+	"\x84\x31\xbe\x04\xa3\xc5\xea\x1a\xc2\xf2\xf3\x1b\x5b\x82\xc2\x5b\xe2\xe3\xab\x7f" +
+	"\xc6\x02\x15\x72\x04\xce\x34\x01\x2e\xa7\x74\x24\x7b\xeb\x06\x18\xf8\x03\x5b\x32" +
+	"\x8e\x61\xa1\x05\x0d\xe6\x96\x70\xc8\x37\xa9\x39\x0e\x56\xa7\xfc\x99\x03\xab\xce" +
+	"\xe3\x05\x63\xf6\x54\x55\xf6\xa7\xb3\xbf\x50\x7d\x3b\x4d\x76\xbc\xb0\xe1\xe1\xd4" +
+	"\xfe\xc7\xcb\xfb\xe6\x12\x4e\x57\x43\x04\xaa\xa9\xe9\x55\x9e\x9e\xfd\x44\x06\x52" +
+	"\x3a\xec\xc0\xbd\x50\x9f\x33\x75\xb1\xf8\x7f\xc1\xf5\x8b\x19\xf9\x0b\x9a\x2d\x08" +
+	"\x92\x79\x41\xa3\xe3\x11\x0e\xf5\x27\xcf\x86\x80\x83\x53\x95\x01\x43\x0f\xca\x9a" +
+	"\x7b\x51\x92\xee\x19\x2b\x4a\x50\x21\x62\x8d\x02\x18\x49\x0b\x47\x2d\x87\x22\xe6" +
+	"\x72\x78\x4d\xea\x2c\x1d\xea\x0a\xbc\x6a\xec\x7a\x31\x75\x49\x47\x79\xc6\xe3\x17" +
+	"\x54\xcf\x41\xec\xc3\xc5\xaa\x6e\x8f\xca\x5d\x91\x36\x3e\x41\xa8\x57\x35\xa2\xa6" +
+	"\xab\x11\x75\x97\x55\x95\x62\x07\xa5\x48\x29\xa1\x58\x42\x4d\x73\xb9\xf0\xdb\xda" +
+	"\x69\x09\xf5\x8f\xeb\xae\x5d\x42\xd5\x2a\x79\xe1\xe4\xc5\x6a\x0c\x6f\x5c\x44\x6c" +
+	"\x89\x1b\xa9\xe0\x23\xbd\xb8\xab\x35\x90\xb7\x01\xb3\xa9\xf2\xa8\x5e\x5c\x3d\x83" +
+	"\x44\xff\xdb\xba\x0c\x40\x40\xa9\x74\x14\xf4\xca\xa8\x6e\xf5\x63\x1d\xc9\x8e\xf2" +
+	"\x97\x50\xfb\xd8\x10\xff\xf2\xe8\x3f\x77\xe9\xa5\xf8\x22\x1e\xda\x50\x39\x01\x81" +
+	"\x5b\x87\x7f\x8b\x6f\x27\x8a\xde\x76\x96\x4e\x0b\x47\x29\x2a\x9d\x57\xc8\x69\xf1" +
+	"\x12\x5a\xf7\x77\xd4\x7a\x42\x04\xe2\x8b\x68\x46\x96\xba\x16\x76\x85\xec\xa3\xac" +
+	"\x87\x25\x7f\xda\xfe\x45\x7a\x45\xd8\x80\xdf\xe7\x1b\x58\xc2\x47\xc8\x7c\x26\x80" +
+	"\xe2\xbf\x3b\xb8\xd4\x14\x3d\xe4\x4f\xd3\xb0\xeb\x9a\x2e\xf8\x03\x9d\x89\x45\x74" +
+	"\x3b\xb1\xb2\xa9\xbc\x70\x6d\xec\x79\xf1\x59\xea\xd0\xee\x45\x44\x55\xeb\xf4\x58" +
+	"\xdf\xe1\xa2\xf1\xba\x84\x4f\x97\xd0\x9f\x17\x8d\xdb\xb7\x50\xfd\x09\xc8\xce\x59" +
+	"\x3d\x6d\xf6\xeb\x47\xe0\xf5\x74\x84\x85\x97\xd0\xb5\x6f\x5a\xc2\x85\x60\x97\x11" +
+	"\x8d\xa6\xcb\x42\x5b\xb4\x26\x92\xd8\x10\x01\xec\xc4\x34\x28\x5e\x21\x33\x45\x1b" +
+	"\x4a\xd2\x5b\xb2\x84\x97\xd6\xb2\xd0\x3a\x88\x75\x0d\xd6\x7a\x04\x24\xea\xfa\x52" +
+	"\x07\x88\x99\x5b\x39\xbc\x33\xcc\x25\xc1\x11\x59\x62\x4e\xb3\x5e\x04\x93\x24\x3d" +
+	"\x9e\xfd\x05\x82\x0e\x04\x71\xe0\x7d\x6e\x00\xe4\x0a\x21\xae\x4e\x0f\x88\x70\x90" +
+	"\xd3\x95\x24\x3d\x5c\x4a\xc3\x79\x29\xf3\x1a\x52\xe3\xb0\x61\x94\xaa\xc3\x43\x31" +
+	"\x54\xd3\xe3\x7a\x86\xfc\xc5\x16\x7c\x59\xdf\x8c\x55\xfb\x58\x04\xe7\x22\x07\xb2" +
+	"\x90\x9e\xfa\xc5\xdf\x8e\x15\xcd\x80\xe4\x6c\xcd\x5f\x4b\x6c\x58\x38\xcf\x88\x2b" +
+	"\x32\x19\xe0\x72\x6f\x36\x3c\x1e\x72\x35\xcc\xb6\xf0\xac\xbc\x06\xa3\xf4\x86\x8d" +
+	"\x9d\x00\x04\x26\x13\x44\x93\xd2\x16\xf2\xcf\x87\x50\x64\x2f\x58\xfb\x0a\xc7\x1c" +
+	"\xa9\xd7\x16\x76\xae\x52\x11\x52\x76\x98\xa2\xa0\x93\x70\xcc\x39\x28\x1b\x02\x6b" +
+	"\x7e\x7a\xeb\xcf\x3d\xba\x09\x50\x6e\x9c\xa4\xb3\x56\x5c\xb6\x20\x4d\xf2\x96\xd2" +
+	"\x1a\xc7\xa8\x8a\xae\x5a\xcb\x0a\xa5\x82\x02\x34\xc1\x1c\xba\x5f\x3d\xc1\xa0\x0b" +
+	"\xf4\xcd\xc5\x36\xc5\xa9\x9c\x06\x2b\xdd\xa9\xfd\xbd\xd6\xfd\x0f\x0a\x7c\x83\x6c" +
+	"\xc0\xa2\x96\x26\x5c\xa2\xdd\x4a\x64\xf1\x83\xb0\xf0\xb2\x85\xeb\x85\xc2\xbe\xfd" +
+	"\x0b\x69\xa1\x18\x58\x03\x8a\x4a\x44\x96\x20\x78\x1d\xb6\xa6\x75\x4f\x74\xb4\x7b" +
+	"\x82\xf8\x33\x97\xe3\x17\xe2\x2f\xc6\xd8\x22\x0b\xbc\x78\x5f\x90\x90\x4c\xf7\xc2" +
+	"\xa2\x80\xeb\x22\x70\x8a\x4a\xd1\x23\xeb\x35\x3b\x61\x28\x6d\xbc\x7c\xea\x1a\x5b" +
+	"\xbb\x9f\xca\x87\xf5\xc1\xdd\x78\xc2\x5f\x5a\x72\x6c\x5c\x2a\xb4\x17\x92\x96\x9f" +
+	"\xb0\x46\xca\x4c\xcb\xcf\x19\xc3\x0d\xa8\x08\x9a\xec\x95\x48\xaf\x40\x36\x2a\x16" +
+	"\x4c\x0a\xf3\x99\x21\xe0\xf1\xb0\xd2\x9e\x88\x66\xb2\xf3\x9c\xb3\xc7\xa0\x7b\x45" +
+	"\x97\x3a\x12\xeb\x09\xa7\xd6\x2f\x37\xdb\xa4\x66\x3d\xea\xe8\xff\x20\xb5\x9c\x6e" +
+	"\xf8\x90\x89\x25\x6a\xf9\xb4\xc1\x74\x0d\xfc\x4b\x34\x7a\xdb\xf7\xda\xa0\xaa\xf4" +
+	"\x52\xe9\xaf\x78\x4b\x95\x5a\x83\xe6\x52\xcb\x03\xde\x81\xc1\x73\xfb\x30\x7b\x21" +
+	"\x77\x66\x6e\x98\xb3\xe3\x54\xe1\xa0\x2f\x5b\xf9\x11\xc3\x9e\x20\xc6\x3b\xcd\x58" +
+	"\xd4\x69\xcb\xe9\x2b\x96\x81\x78\x14\x02\x40\x3c\xa7\x54\x5f\xb1\x6c\x85\x53\xfa" +
+	"\x41\x57\xbf\xe7\xa4\x8e\x60\x40\xff\xa5\x7e\x1a\x22\x35\x8e\x10\xe5\xdc\x19\xbd" +
+	"\xdb\x05\x97\x4d\xd9\xcd\x22\xd2\xb2\x59\x30\x9a\x05\x96\x0d\xad\xcf\xcf\xa2\xa7" +
+	"\x00\x75\xb8\x45\x28\xff\xe8\xaa\x08\x9f\x02\xf4\xbf\xaf\x0e\x9f\x71\x49\x2c\x5f" +
+	"\xa7\xc5\xb8\x31\x46\x1a\xde\x83\xd5\xf2\xb4\x24\xfb\x7a\xa5\xba\xb8\xa1\x1b\xc1" +
+	"\x7d\xd1\x40\x18\x50\x73\x86\x3c\xb9\x2c\x26\xf5\x6a\xc9\x8e\xf0\xf2\x0f\x92\xbc" +
+	"\x92\x3e\xf8\x5f\xcf\x3a\x7c\xb0\xee\x1d\xbf\xe2\xf1\x1b\xd2\x1c\xb4\x0b\x13\x71" +
+	"\xea\x5c\x85\x9b\x96\x84\xc6\xd5\xc5\xd9\xb6\x08\x9d\x56\xd4\x0c\x6a\xb5\x7d\x7b" +
+	"\x94\x60\x9f\xb9\x0f\x23\x60\x29\x99\x85\x28\x31\x07\xb5\x58\x46\x42\x4b\x3d\x25" +
+	"\x87\x03\xbd\xa2\x4b\x0e\xdc\x23\xc1\xb5\x64\x07\xbb\x22\xc0\xe9\x03\x24\x4b\x68" +
+	"\x46\x21\x62\x22\x2d\x59\xd7\xb6\x20\xfa\xe7\x99\x05\xdf\xa2\x11\x9e\x39\xe3\xaf" +
+	"\x43\x5b\xc6\xff\x78\x17\x0a\x78\xb9\x08\xbb\x41\xde\x6a\x0e\x1f\xde\x0a\x6c\x5c" +
+	"\xb9\xda\x60\x64\x0e\x43\x16\x8c\x85\xb1\xeb\x06\x48\x8c\x77\xf1\x33\xbe\x25\xc7" +
+	"\xfa\xfc\x70\xc6\xb9\x64\x96\x0c\x39\x89\x17\xb5\x64\x86\xea\xac\x52\xd3\xe3\xa1" +
+	"\x29\x94\xc2\xd2\x1e\x24\x4e\xb4\x38\x93\x77\xc9\xc9\xff\x50\x09\x3f\xe2\x53\x2c" +
+	"\xb9\x78\xc3\x96\xc5\xd9\x6e\x4b\x2e\xde\x7d\x1d\xe8\x01\x05\x4b\xe1\x8a\xec\x5b" +
+	"\x6c\xda\x8c\x2d\xb9\x5c\x2b\xbb\x46\xea\x55\xae\x55\x3c\xa5\x45\x17\xe0\xa7\x25" +
+	"\x5f\xf3\x43\xf3\x50\x76\xfb\x5e\x4b\xbe\xbc\x0e\xd7\xc1\x57\xa2\x84\x65\xd3\x24" +
+	"\x5c\xb8\xa4\xa2\x9e\x53\xb8\x8f\xbc\x7c\xc1\x0a\x92\x4a\x19\xa7\x7a\x40\x09\xad" +
+	"\x1b\x93\x7e\x29\xa1\x77\x99\x0c\x5d\x50\xdd\x43\xe1\x65\x7e\xc7\xd9\x09\x69\x35" +
+	"\x83\x19\xed\x85\x1b\xa7\x4c\xba\x74\xab\xb2\xfc\x44\x2c\x9d\x10\xa2\xd1\x01\x2b" +
+	"\xd1\xd6\x44\x05\x56\x01\x24\x2d\x7c\xfa\xcb\xca\x79\xe5\x52\x22\x2a\x76\x8c\x90" +
+	"\x2d\xa2\x50\x7e\x91\x49\x63\x2e\x8c\x08\x55\x27\x3c\x59\x82\x2b\x4b\x91\x08\x2a" +
+	"\xf9\x9e\x47\x58\x44\xd2\x3f\x49\xc7\x0c\x48\x56\x19\xe4\x80\x6a\xd9\x57\xd2\xe3" +
+	"\xa1\xb5\x81\x14\x15\xa8\x2a\xbc\x5f\xea\x90\x53\x8b\x4c\x02\x70\x68\xe1\x68\x32" +
+	"\x00\xd5\xfd\x08\x80\x6d\xa8\x5c\xde\x9a\x39\xc7\x27\x47\x50\x03\x79\xa0\x5e\xa3" +
+	"\x51\x15\x99\x87\xee\x53\xe1\xff\xae\xe4\x0f\x28\xff\x5d\x16\x7f\x35\x8f\x2d\x4f" +
+	"\xde\x7a\xc9\x1c\x33\x32\xeb\x17\x16\x3a\x1e\xd5\x1a\x23\x4b\x20\x4d\xb4\x23\x8d" +
+	"\xbd\xcf\xac\xc1\xd4\x2f\xcb\x1e\x75\x5f\x67\xe1\x72\x1a\xca\xae\x48\x2d\xdb\x05" +
+	"\x90\x6f\xaa\x87\x55\x6f\x7f\x24\xed\x54\xcb\xae\x45\x56\xd9\xb9\x83\xaa\xef\xf9" +
+	"\x0d\xf5\xf2\xe4\x29\x37\x50\xa1\x3b\xb6\x18\xc7\x5f\xda\x77\xad\xb0\xac\x45\x64" +
+	"\x72\x30\x3b\x6a\x3a\x81\x74\x0c\xe7\xe3\x46\xf6\xc0\xa2\xbf\x08\xb5\x15\x9b\xa0" +
+	"\x36\x10\x65\xd7\x8d\xde\xe0\x55\xde\xdd\xd8\x89\x64\xdf\xd7\xea\x00\x83\x86\xfd" +
+	"\x17\x54\xd5\x24\x8f\xa7\xf6\xdd\x4f\x78\x6e\x16\x98\x11\xb3\x53\x6f\xc1\x10\x6d" +
+	"\xb7\x17\xca\x5d\xc5\x25\x19\xd2\xda\xd3\xa8\x2d\x40\xc0\xf6\x8f\x4c\x1a\xaf\x8d" +
+	"\xe6\x3b\x11\x94\x82\x7f\x05\x46\x22\xdd\x5b\xe1\xbc\x17\xc1\xe5\x60\x29\xce\xbe" +
+	"\xee\x17\xb6\x54\x2c\xc4\xa4\xff\x05\x98\xa4\x9e\x5b\x28\x7b\xdf\x71\x38\x12\xcb" +
+	"\xa3\x45\x40\x39\x98\xd6\xa8\xc0\x81\xd9\x86\x6e\xbd\xfa\x80\x81\xe0\x7d\xfd\x58" +
+	"\x2e\xdb\x54\x96\x63\xc1\x92\x9d\x87\xae\xc9\xff\x8f\x23\xda\xe3\x36\xbe\xa5\xbe" +
+	"\x61\x3c\x00\x34\xa4\xa8\x2d\x8e\x47\x60\x78\x1f\x88\xa7\x7b\x3d\x1c\xc6\xfa\x3f" +
+	"\x1e\xe5\x7d\xa0\x28\xd3\xea\xf2\xe0\x1a\x2f\x57\x61\xcd\xd3\x3b\xbd\xf4\x40\xb0" +
+	"\x1d\xa9\x58\x60\x46\xde\xf6\x2e\x34\x5f\xa3\x8e\x8f\x6a\xa3\x12\x2d\xea\x79\x50" +
+	"\x75\xbe\xe3\x89\x16\x0c\x09\x07\xbb\xa7\x8f\x14\xaa\xcc\xb3\x5e\xfe\xf4\x67\xbd" +
+	"\x3c\x95\x64\x1b\x9d\x5a\xb7\x3a\xfe\x70\x29\x0e\x6a\xab\x7a\x03\x56\x29\x21\xaf" +
+	"\x12\x77\x4d\x54\xec\x91\x5e\xa3\x55\x8f\xf4\x1a\x54\x73\x6c\x39\x8e\x6b\xb6\x31" +
+	"\x7f\x70\x29\xa2\x07\x6f\xbe\x26\x01\xde\xc3\x2b\xe1\x37\xd9\x6e\xe4\x4f\x68\x71" +
+	"\xf1\x21\xe1\x70\x84\x17\x17\x8c\x16\x3e\xc6\xc3\x9b\x44\xb9\xd9\xea\x95\xa3\x5d" +
+	"\xbb\x0b\x63\x2f\xe8\x2f\x78\x0e\x14\x61\x60\x5a\xdf\x64\x37\x33\x5a\x01\x06\xb7" +
+	"\x97\x02\x17\x33\xd2\xdd\xd4\x16\xdd\xa5\x50\xbf\xd0\x46\xc3\x81\x9a\x11\xf8\x2e" +
+	"\xdc\xb0\x0f\x27\xaa\x90\xb3\x7b\xec\x5e\xb9\x43\x9f\x58\xf0\xbc\xa2\xf5\x37\x83" +
+	"\x77\xa9\x35\xf6\xb8\x9f\x9e\x48\xec\x2a\x02\xd3\x37\xfe\xdb\xba\xfd\xda\x07\x1f" +
+	"\x07\x8f\x4f\x83\xc7\x75\xac\x55\x63\xf8\x78\xdd\x1f\xac\x01\x8f\xff\xf5\xa9\xea" +
+	"\xaf\x6f\x5f\x7d\xf9\x55\x22\x60\x7a\x7d\xfb\xce\xad\x7f\x9a\x08\x84\x19\x44\xb3" +
+	"\x2c\x84\x0d\x38\xa2\xaf\xcc\x82\xcc\x80\xbd\xfe\x82\x2e\xb7\x69\x29\x7c\x2f\x78" +
+	"\x22\x46\x4b\x51\xae\x10\x24\x0d\x0a\x4a\x59\x9f\xa8\xd3\xff\xcc\x39\xd8\x45\xe5" +
+	"\x54\x59\x58\xf8\x81\x7c\x16\xd6\x22\xc5\xcf\x12\x1c\x68\x4d\x54\xea\x6a\x6d\x55" +
+	"\x57\x55\xa3\xae\x57\x65\x85\x8c\xae\xb8\x05\x95\x00\x4b\xa9\xcc\xba\xea\xee\xd5" +
+	"\xd9\x6c\xa5\x1e\x9c\xaa\x30\x54\xea\xc1\xf6\xc1\x6e\xd0\xe2\x7b\x20\x86\x40\xcf" +
+	"\xac\x87\xb6\x8e\xa5\x1e\xbb\x0d\xaf\x7a\xd4\xa2\xe2\x90\x30\xe2\xf0\x16\x78\xf1" +
+	"\x1f\xac\x00\x3d\x94\xcf\x9d\xa8\x97\x77\x96\xea\xb9\x4f\x6c\x71\x5a\xd8\xd5\xc4" +
+	"\xb1\x2a\x15\xb9\x7c\x23\x5a\x84\x73\x89\x2b\x07\x5d\x17\x22\xf4\x72\xee\x49\xa9" +
+	"\xb2\x7b\x22\x9a\x54\xb5\x66\x85\xf7\x68\xc1\x3d\xfe\x15\x81\x9f\xa7\x80\x0b\x99" +
+	"\x43\x10\xd2\xaf\x90\x3d\x76\xe7\x75\x95\xb6\xeb\x8a\x0d\xa1\x27\xa3\x86\x02\xf9" +
+	"\x43\x22\x0c\x9c\x69\x41\xdf\x36\x1c\xd7\xc2\x65\x68\x7b\xbb\xf0\xc8\x75\x94\xb7" +
+	"\x94\x2b\xb3\xf3\xd2\x01\x61\x75\xbb\x8b\xc3\xbb\xe4\x90\xf6\xaf\x67\x48\xb6\x8f" +
+	"\x00\xd7\xa6\xed\xde\x47\x94\xfc\xf4\xf0\x69\x7b\x6c\x38\x71\xd9\x3a\xa7\xa7\x77" +
+	"\x5a\x85\xf1\x39\xbd\xb1\x9b\x67\x76\x2f\x0b\x9c\xa3\x59\xab\x29\xe0\x0c\xc7\x17" +
+	"\x4b\x12\x71\x78\xbe\x16\xa0\x3c\xa4\xc6\x5f\x5d\x58\x21\xde\x64\xc2\xc8\x4e\x38" +
+	"\xc4\x00\xa5\xcd\x64\xf2\xda\x4d\x51\xd2\xc1\xc5\x2e\x57\x15\x1b\xa5\x20\x53\x8e" +
+	"\x60\x89\x50\x1a\x41\xf7\xb2\x02\xcc\x3b\xb8\xbc\x1d\xb4\x83\x28\xaf\xd8\xad\xf7" +
+	"\xbf\xb4\xdc\x7b\xc3\x25\x11\xe5\xb0\x7f\x29\xef\x27\x76\x49\x97\xf2\x96\x2c\x2a" +
+	"\x94\xa3\xb2\xa5\xbc\x15\xc8\x02\x0c\x5a\xaa\xd6\xb0\x0e\x28\xed\x8d\xdd\x27\xa8" +
+	"\x0e\x54\xa0\xd8\xa9\x5d\xca\xdf\xf6\xd6\x7e\xb7\x7c\xf4\x1d\xfb\x58\xdd\x6a\x98" +
+	"\xb5\x13\x82\xa2\xb0\x00\xa1\x53\xf1\x33\x0d\x6b\x25\x59\xc3\x13\xdf\xf3\xa9\x04" +
+	"\x32\xf4\x03\xfd\x81\x61\xa8\x90\x1f\x67\xaf\x9b\xd1\xd2\xa5\x72\x63\x6a\xe1\x9c" +
+	"\x95\x27\xd5\xf0\x62\x10\x6b\xd2\x83\xbc\x8d\xc9\x56\xc3\x3b\xdb\x4d\xea\xa5\x86" +
+	"\x8f\x87\xc5\xc0\x4a\xbc\x46\x74\xa7\x0d\xaa\x00\x3f\xc6\xa8\xd8\x8a\xc5\x7b\x65" +
+	"\x35\x5d\x15\x27\xb3\xb2\x3b\x89\x83\xae\x0e\xd4\x81\x15\x55\x24\x4b\xbd\xd4\xa8" +
+	"\x13\x8f\x1a\x4b\x2b\x4c\x1d\x35\xaa\x9a\xb1\x75\x97\xb1\xd3\xa5\x40\x65\x82\x71" +
+	"\xae\x38\xac\x55\x40\xdf\xda\x19\x40\x95\x2e\xcd\xb3\x86\x26\x07\xad\x78\x75\x45" +
+	"\x16\x5a\x78\xe7\x84\xb4\xa6\x1b\xc9\x40\x46\x95\x9e\x5f\x64\xdc\x56\x17\xb0\xe9" +
+	"\x00\x46\x3d\xe4\x47\xae\xfe\x93\x06\x33\x57\x04\xc1\x05\xb9\xd2\xa5\x6a\xe6\x1b" +
+	"\x54\x95\x2f\x1c\x67\x8b\x36\xed\x96\x05\xb8\x53\x66\x41\xc6\x9d\x31\xf8\x76\x50" +
+	"\x11\x66\x12\x14\x7f\xc7\x21\x73\x02\x00\xe1\x67\x43\x1b\xfc\x53\x83\xe5\x35\x1e" +
+	"\x72\x26\x34\xc7\x09\x76\xad\x9b\x41\xc6\xf7\xac\xc5\xd3\x23\x88\xc0\xf7\x03\x63" +
+	"\xd1\x5e\xbb\xb7\x55\xee\x5a\x6c\x41\x58\x2f\xa6\x6d\xfe\xa5\xfd\x1f\xae\xdb\x28" +
+	"\x5e\x96\xa0\x45\x2d\x19\xfa\x1f\x50\x3f\xb5\xd4\xcf\x60\xe3\x73\xb7\xd6\xdc\xe1" +
+	"\xf3\xeb\x2d\x0a\x83\xdd\xbd\xa3\x7a\x6b\xfe\xe0\xea\x2b\x7d\x69\x17\x73\x2d\x3f" +
+	"\x62\x99\x20\x5a\x93\x9d\xb4\x69\xb9\x1e\x8f\x8c\x4a\xdb\x01\x08\x4a\x74\xda\x69" +
+	"\xb9\xf2\x69\x6b\xe9\x2b\x63\x92\x65\x5a\xb8\x1d\x8c\xbb\x47\xce\x1d\x28\x6b\xbf" +
+	"\x32\x2f\xeb\x3a\xa2\x6c\x1f\x4e\xcb\x25\x83\x8a\xcb\x75\x62\x87\x63\xf1\x05\x3e" +
+	"\x44\xb3\xce\x55\x75\x9e\x23\x42\xf6\xaa\xdd\xf2\x55\x77\x6a\x43\x47\xbd\xec\x9a" +
+	"\x85\x53\x4a\xae\xbd\xf1\x4f\xea\x2b\x2c\xba\x87\xb2\x20\xde\xb3\xa0\x3f\x68\xd5" +
+	"\x82\xea\x72\xce\xfb\xd5\xed\xd0\xed\xea\xec\xfe\x45\x35\x68\xa0\x14\xd8\xfb\x56" +
+	"\x4b\xe8\xd3\xff\x51\x75\xa6\xdb\xb1\xba\x4a\x14\xff\xce\x5b\xd2\x4a\x2b\x69\x15" +
+	"\xff\x0c\xe9\x98\xa7\xbf\xeb\xb7\xab\xec\x9c\xbb\xd6\x39\xa9\x2d\xd2\x88\xc8\x58" +
+	"\x23\x68\x4b\x36\xd0\xae\x78\x68\x32\xb9\x1e\xa9\x9e\x72\x5e\x71\x2d\x5a\xb9\x64" +
+	"\xec\xde\xc2\x74\x11\x34\x62\xba\xe4\x79\x24\x4c\x17\x8d\xf9\xcb\x87\xfc\x65\x4b" +
+	"\x13\xc3\x1c\x39\xde\x3a\x99\x9d\xe2\x2a\x67\x5a\xdb\x4a\x03\xcd\x71\xea\x17\x4e" +
+	"\x73\x56\x04\x75\x33\x32\x40\x2d\x18\xa0\x0b\xe7\x4a\x6c\xce\x22\x6e\x96\xf6\xd8" +
+	"\x5e\x10\x84\x1e\x90\x42\x22\x1b\x9a\xe7\x13\xc0\x3d\xb1\x08\x20\xac\x96\x33\x22" +
+	"\x87\x8a\xe6\xda\x1c\xc7\x43\x7f\x94\x3a\x8e\xae\xf3\x3e\x02\xd1\x83\x83\x49\x98" +
+	"\xe3\x9b\x46\x37\x42\x96\x8b\x99\x6e\x8e\xd7\xec\x13\xcc\x1c\x2f\x8c\x03\xc3\x9c" +
+	"\xe2\xc4\x63\x45\x54\x06\xea\x1d\xb0\x41\x04\x08\x8b\x01\xc0\x2b\x14\xaa\xc8\xf4" +
+	"\x6c\x07\xcd\xee\x9c\xbc\x49\x8a\x4f\xcb\x61\x36\x86\x73\xe2\x25\x99\x9c\x67\x54" +
+	"\x37\x36\x4f\xeb\x2b\x35\x4f\x8c\x0a\xfd\x7d\xe9\xa2\x25\x27\xf2\x30\x14\x89\x74" +
+	"\xe1\xf4\x2f\x85\x8f\x3a\x27\x94\x2a\x3b\x7d\xeb\x03\x59\x9d\x70\x4b\x84\x37\x27" +
+	"\xf2\xd6\x9c\x9e\x37\xf5\x7b\x7c\x61\x23\x56\x1c\xfe\x3d\x54\xc4\x14\xc9\x3a\xc5" +
+	"\xe7\x33\x79\x9c\x31\xae\x36\xa5\xed\xa7\xc8\xd1\x9d\xe8\x4d\x24\x02\x8c\xfe\x5b" +
+	"\x83\x94\x38\xb9\x23\x85\x39\x4d\x29\xbb\xce\x18\x78\xb3\x23\xed\x07\x32\x27\xe3" +
+	"\x1a\x60\xf7\x54\xed\x1b\x44\x7d\xc2\x06\x13\xb8\xcd\x20\x27\x47\x45\x23\x99\x93" +
+	"\x9f\x85\xef\x1b\xbb\xf5\x4c\xe0\x81\xc1\xf8\xa4\x5a\x1a\x3b\x4d\x60\xec\x4a\x18" +
+	"\xa7\xff\xbc\xa6\xd3\x64\x0e\x73\x9a\xd4\x90\x53\x45\x23\x70\x4e\xf7\xc6\x60\x4e" +
+	"\x09\xaf\x31\x56\x31\xd4\xfc\x9d\x90\xe7\xf9\x57\x3d\x41\x8e\x1e\x82\xca\x8b\xda" +
+	"\x63\xdb\x3f\xa8\x0b\x4d\x5e\x90\xe2\x73\x09\x1c\xd8\x45\x7e\x8a\x49\x95\x23\x80" +
+	"\x9a\xfa\x3e\x14\xce\xe9\x89\x18\xc2\x88\x1e\x2b\xa3\x82\x39\x3d\xef\x5a\x6d\xde" +
+	"\xdc\x4f\x97\xc1\x82\xb4\x6d\x9d\xa5\x0d\xce\x5f\xc6\x99\x8a\xc2\x01\x9f\xfd\xaa" +
+	"\x48\x0b\xf5\x06\x9f\x5f\xd6\x38\x54\x56\x45\x87\xdd\xa9\x5a\xe4\xa9\x4b\x76\x2c" +
+	"\x73\x7a\x72\x64\x0f\x73\xc2\xb1\x99\xcf\x65\x73\x5a\x07\x4e\xc7\x7e\x29\x7c\xbd" +
+	"\x66\xff\xc2\x8e\xe6\x0f\xb4\xc7\xb0\x7e\xce\x09\x86\xd5\x9c\xd8\x61\xcd\xe9\x2b" +
+	"\x86\x39\x7d\x59\x0c\x4e\x03\x96\x13\x4b\x43\xcd\xba\x0e\x3f\xc9\xcb\xdd\xc7\x37" +
+	"\xd5\x6c\xc3\x1f\x1b\x76\x0c\x3d\x35\x58\x71\x5c\x10\x52\x06\x7a\x10\xe3\xfb\x0f" +
+	"\xf1\x80\x2d\x1f\xff\x71\x74\xa6\x02\x30\xb5\xf9\xfb\xed\x71\x5e\xcc\xb5\x99\x36" +
+	"\x34\x73\xda\x86\xf6\x92\x73\xda\xe3\x52\x96\xf1\x0f\xd2\xd7\xde\xb1\xe7\x53\xe1" +
+	"\x86\xac\x76\x3b\x6b\x94\x11\x1b\x6b\x9c\x40\xa9\xcb\x9e\x97\xc2\xe3\xf7\x2c\xff" +
+	"\x5c\x00\x46\xab\x58\x1e\x5c\xec\xf8\x4a\xd0\x7d\xdc\x4a\xa8\xd8\xc2\x80\xb6\xdb" +
+	"\x05\x76\xb9\xbe\xeb\xae\xfe\xed\xb5\xfd\x5c\xa8\x42\xe5\xae\xc3\x50\x46\xb6\x96" +
+	"\x46\xf4\xe5\x76\xb8\x1a\xd4\xe0\xc0\x51\x06\xb7\x0e\x74\x32\xf4\x06\x7c\xbc\xe3" +
+	"\xa6\x34\xc9\x61\x7e\x20\x0a\x05\x1d\x65\x24\xef\x8f\x47\x47\x97\x99\xd2\x3c\xbc" +
+	"\x9a\x03\x12\x6c\x2c\x1d\xe3\x98\x9c\x03\x33\x27\xb8\x51\xd6\xfb\x40\xf6\xa6\x67" +
+	"\xf6\xa3\xfa\x9c\x4c\xdb\x1a\x5a\xee\x77\x00\xde\x49\xea\x7b\x1f\x8e\xd2\x9c\x64" +
+	"\xf5\x89\x72\x9b\x43\x7b\xd9\x13\x8f\x4f\x5d\x89\xf5\x1e\x64\x8e\xe6\x0f\xb4\x4f" +
+	"\x01\xf6\x31\xe0\xf0\x2e\x22\x79\x2c\xc3\x39\x9d\x43\x7e\x22\x08\x5a\x7e\x2c\x1f" +
+	"\xea\x35\xa8\xc9\x19\xc1\x1f\x68\x05\x10\x51\xf3\x03\xd4\x65\x48\xf9\x86\x77\x88" +
+	"\x19\x7c\xec\x19\x5e\xb9\xc1\x5b\xef\xe0\xef\x4a\x99\xf0\xa1\x61\xad\x52\xb5\x87" +
+	"\x9c\x11\x86\x35\x66\xfd\xe6\x53\x6e\xb3\x69\xea\x66\x81\x7c\xd0\xfc\x81\x56\x99" +
+	"\x96\x27\x6f\x06\x47\xca\x40\x50\x03\x05\xf9\x07\xfb\xac\xd8\xac\x1e\x8a\x16\xcb" +
+	"\xe5\xab\x97\x93\x6a\xb6\x93\x33\x98\xe8\x31\xfb\x48\x71\xac\xfc\x9c\x01\x8c\xe4" +
+	"\xe9\x06\x9a\xef\xf0\xd2\xa4\x9a\x8d\xcd\x5f\x06\x59\x5b\x22\x89\x10\xcd\x46\x3d" +
+	"\xfa\xac\x43\xaf\x34\xb6\x44\x87\xee\x97\xbb\xdb\xc1\xf2\x50\x35\xf1\x71\x31\xc3" +
+	"\xcc\x1e\x37\xbd\x5f\xf5\x3b\x6f\xfe\x65\xbe\xf3\xa6\xe7\x7d\xe7\xcd\xfa\xe7\x37" +
+	"\x8e\x46\x6e\x4a\x0b\x10\x35\x4a\xdc\x35\xe0\xb0\xc9\xec\x2d\x6f\x08\x73\x7a\x73" +
+	"\xf1\xc3\xb6\xbc\xdf\x48\x2d\x94\x23\xae\x9f\xb5\xfe\xe5\xc8\xc6\x9d\xf7\xcd\x71" +
+	"\x4e\x3b\xe4\x76\xd5\xc0\x80\xcf\xae\x7b\x30\xe7\x88\x64\xdd\x7f\x8d\xca\xc1\xa2" +
+	"\xbc\x55\x4d\x9d\x61\xb4\x3e\x52\x40\x07\xfa\xe2\xef\x5a\x3a\xfe\x1c\x66\x19\x5d" +
+	"\x90\xa0\x2f\x94\xd9\x2c\xe9\x51\x98\xf2\x84\x39\xcb\xf1\x00\xc4\xdc\xbc\xce\x19" +
+	"\x7f\x17\x3a\x14\xdf\x90\xc2\x97\x9b\x49\x3d\xe7\x25\x5b\x2f\x66\x80\x9f\x6b\x98" +
+	"\xf3\x16\x4f\x8f\xdc\x09\xa6\x77\x64\x5f\x3b\xee\x98\x8a\xf3\x67\x5c\x66\x5c\xb3" +
+	"\xd9\x7e\x20\x6f\xdb\xe5\x3b\xb4\x0c\xe7\xd7\xc9\x0c\x7d\x33\xc5\xe6\x63\xe1\xde" +
+	"\xb1\xac\x46\x4a\x98\x73\xa9\x71\x8f\x61\xce\xe7\x8a\xfe\x71\xbe\x61\x61\x0c\x9a" +
+	"\x74\x67\xce\x2e\x9c\x9c\x73\x4d\xec\x92\xe7\x0c\x2b\x77\xce\x35\x2f\xfe\x06\xf5" +
+	"\x15\xe6\xdc\xb0\xe8\x24\x1d\x67\x43\xbe\x40\xdc\xd8\xea\x4c\xa8\xed\xf2\x56\x8e" +
+	"\xfd\x91\xe5\x12\x57\x17\xff\x58\x78\x73\xf9\xed\x79\xbe\xcb\x5b\x1f\x53\x27\xa4" +
+	"\x39\x37\x64\x43\x2a\x5f\xc0\xfa\x12\xde\x47\xf7\x47\x79\xf8\x48\xd7\x25\xa6\x56" +
+	"\x1f\xa4\xf9\x4e\x17\xe2\xc0\x5b\xfa\x89\xf9\x92\xa0\x31\x23\xfd\x02\xf6\xb2\xff" +
+	"\xb4\xaf\x09\x1e\x3c\x78\x54\x66\x07\x92\xe7\x98\x0f\x6b\x80\x86\x17\x6b\xe9\x9b" +
+	"\x39\x7e\xb3\xff\xcd\x2d\x1d\xcf\x8a\xa1\x06\xfc\x13\xee\x1c\x8b\x36\x30\xa0\x2e" +
+	"\x9b\x47\x32\x29\x16\xbd\x40\x5e\xbc\x7a\x86\xd4\x60\x8b\x1c\x4b\x02\x6a\x9c\xfe" +
+	"\xc0\xfc\x41\x5e\x83\xa5\x6a\xcf\xac\x74\x33\xe7\x39\xfe\x81\xff\x97\xae\x09\x95" +
+	"\x2b\x33\x41\x22\x57\xc1\xe9\x81\x7d\xbc\xb6\x6a\x03\x9c\xb1\xe3\xb6\x7c\xfd\x7e" +
+	"\x1d\x37\x15\x9c\xb1\xd5\x87\xfd\xe8\xed\x90\x0f\x24\x80\x9f\x0f\x66\x97\xf6\xc4" +
+	"\x2c\x56\xfa\x1f\xf2\x06\x15\x53\x16\xeb\x6e\xbb\x43\x4f\xf3\x74\xc1\xfb\xb7\x9f" +
+	"\x50\xf0\x73\x6e\x5f\xee\xaa\x61\xce\x6d\x43\x84\xf6\x01\x4a\xda\x13\xe2\xd8\x3f" +
+	"\x64\xbf\xd9\xe1\xfa\x7c\x80\xa5\xc9\x95\x64\xfa\x20\x6f\x0b\xe9\x7d\x32\x7b\xd9" +
+	"\x9d\xfc\xa9\xc4\x89\x95\xb2\x25\xea\x14\x92\xdb\x39\xee\x89\x2f\x37\x1c\x06\xf5" +
+	"\x0f\xf0\xb2\xdc\x5d\x90\xe5\xa8\xe9\xd4\x4e\x5e\x68\xf8\xec\xe9\x17\x86\x08\x88" +
+	"\x36\xe7\x46\xb7\xd9\xfe\x90\x97\xd5\x92\x96\x72\x4f\x47\xbf\xc6\x60\xde\x33\xb6" +
+	"\xd5\x06\x3f\x9d\xbd\xe5\xf3\x53\xb5\x56\x7c\xb9\xc4\xf3\xef\xe6\x0f\xbb\xd9\xeb" +
+	"\x37\x54\xdd\xdb\x40\x88\x79\x03\xbd\x75\x47\x41\x07\x9a\xf0\x37\xa2\x14\x6c\x2b" +
+	"\x9c\x7a\x1e\x2d\x3c\x46\x3f\x4f\xe5\x42\x47\x1b\xcc\x7a\xaa\x13\x7b\x17\xbc\xb4" +
+	"\xce\x19\xed\x3e\xcd\x8e\xa3\x6a\x3e\x87\x1d\x1e\xb0\x00\x6b\xfd\x1e\x9c\xdf\x7f" +
+	"\x7d\x09\x1b\x05\x4a\xb9\x43\x44\xce\xf9\x9b\xe5\x2b\x13\x02\x50\x57\xdf\x94\xf9" +
+	"\xfb\x8b\x06\xe6\x5c\xe8\x92\x61\x2e\x93\xf6\xdd\x10\xc4\x01\x18\x71\x48\x7b\x61" +
+	"\x2e\xb3\x47\xad\x9b\xcb\x5c\xc2\x5c\x16\x6c\x15\x37\x01\x98\x34\x73\x71\xdb\x99" +
+	"\xb9\x2c\xd8\x79\xcc\x65\x61\x26\xe4\x2f\x6c\x6e\x07\x2c\x95\x65\x11\x13\x75\x2e" +
+	"\xf6\xd8\x0d\x7e\x3d\xde\x63\xcd\xd4\xda\xa9\xdd\xda\xee\x22\xd1\xda\x22\x01\xe1" +
+	"\xfa\x5c\x36\x3f\xdb\xe8\x30\x5a\x36\x69\xa3\xcf\x65\xcf\x5e\x75\xd4\x0d\xef\xaa" +
+	"\x1e\xc7\x85\xca\xfb\x2b\xcc\xa5\xe0\x2d\x19\xa2\xf7\x83\xf0\xd4\x82\xd6\x4c\xe2" +
+	"\x69\xa5\x7e\xc5\xfd\x21\xb0\x73\xdc\x2d\xa5\x1e\x91\xcd\x40\x29\x55\xb6\xad\x73" +
+	"\x39\x95\xb1\xc2\x90\x9b\x61\xa9\x5b\xe5\xf8\x60\xc5\x8e\x66\xc5\x76\xe7\x45\x5f" +
+	"\xab\x60\xe6\x4b\x41\x03\xf3\x28\x2a\xce\x19\x16\x52\xf5\x7a\xdf\x09\xf9\x78\x98" +
+	"\x0b\x11\x11\x29\x11\x3b\xe0\xd9\x66\x8b\xf2\x3e\xa4\x92\x8f\x58\x16\xd5\x41\xa5" +
+	"\x28\x6e\xb3\x00\xae\x8a\x6e\x40\x7f\x42\x5d\x5f\x91\xcc\x49\x6c\x5a\xff\x0c\x58" +
+	"\x75\xde\x47\x3b\x91\x42\x80\x70\xe6\xa3\x1f\xf4\xca\x27\xa5\x5a\xef\x43\xd1\xac" +
+	"\x00\x14\x4b\xa8\x8e\xb9\xc6\x47\x98\x2b\x4c\x00\x2d\xbb\xb8\x10\x94\x29\xd1\x5c" +
+	"\xa3\xb8\x23\x50\xbd\x54\x8d\xf9\x90\x45\xd7\x5c\x51\xf1\x98\x59\x01\x3b\x2a\xd0" +
+	"\x8e\x7e\x3f\x69\x44\xb1\x9d\xe1\x87\xd2\x60\x35\xbe\xcd\xf4\x42\x70\xb3\xbf\x96" +
+	"\x41\x66\x51\xf0\x25\xe6\x0d\xb9\xbf\xe0\x8e\xb4\xdd\x91\x0e\xbb\x15\x97\xa0\x4e" +
+	"\xf8\x55\x6a\xcd\x39\x0c\x0e\xf5\xe6\x35\x1b\x6f\xc6\x28\xf9\xf2\xb3\x5b\xf7\xab" +
+	"\x19\x43\xd2\xb9\xe6\xdf\x5f\xbe\x06\x92\x59\xfe\xee\x69\xd6\x3e\xbb\x96\x72\x8a" +
+	"\xd8\xaa\x0d\x83\x8f\xbf\xb0\x00\xe7\x5a\xde\x2d\xdb\x8f\xde\xcd\xf8\xda\x33\xfa" +
+	"\x5c\x33\xa6\xc5\xc9\x89\x0a\x19\x8b\x14\x6d\xe7\x3a\xf0\x97\x5c\x71\x0f\xc8\x47" +
+	"\xa5\xc7\xdb\x26\xe0\x46\x94\x70\xd1\x3f\xeb\x25\x0f\x99\xf3\x78\x28\x6e\x36\xd4" +
+	"\xc4\x41\xf3\xd0\x66\x6c\x20\x05\x9a\xe9\x48\x54\x68\x4c\x6c\x3e\x86\x6b\xc2\xdf" +
+	"\x80\x1b\xc6\x4a\x9b\xc7\x13\xf7\xb8\xf3\x58\xf4\xf9\xc7\x2b\x11\x42\x6a\x1e\xdb" +
+	"\xc4\x27\xc4\xa7\x20\x43\x7b\xec\x0f\xf1\xdb\xe7\xb1\x3f\x9e\x52\x17\xff\x20\xbe" +
+	"\xc8\xd8\x4f\xa9\x9c\xcc\x63\xa7\x49\x86\xa6\x40\xb9\xdb\x4f\x1c\x83\xc4\x50\x71" +
+	"\x76\xca\x20\x92\x12\xfb\x38\x7c\x05\xcf\x03\xcb\x85\x79\x9c\x78\xab\x86\xe0\xec" +
+	"\x86\x02\x58\xd4\xb9\xcd\x97\x0a\xf3\x68\xfd\x91\xa9\x6a\xeb\x5a\x3a\x87\x4c\xad" +
+	"\x68\xde\x77\x4a\x8f\x60\x7e\xd1\x98\x38\xae\xb6\xa5\x1f\xf6\x54\x06\x26\xc0\x99" +
+	"\x4e\xfc\x43\xa5\x58\x31\xc3\x0c\x29\x56\xa6\x13\x28\x93\x09\x01\x6c\x78\x61\x28" +
+	"\x0b\x45\x8a\x15\x9b\x35\x6e\xef\xe3\xf9\x6c\x00\x58\x11\xfa\x84\x29\x56\x14\x26" +
+	"\x94\x0b\x9f\x9f\x37\x55\x2e\x19\xb5\xb0\x19\x58\x31\x06\x9b\x49\x42\xd5\xff\xa6" +
+	"\x72\x2c\x2a\x4c\xfb\x5b\xaa\x0c\xe1\x3e\x48\xa5\x10\x0d\x2d\xdd\x9a\xc5\x29\xb6" +
+	"\x6b\x51\xec\x1f\x8e\xe7\xf5\x0a\xc4\x39\x6c\xf4\xb8\x3f\x44\x03\xfe\x5d\x28\xef" +
+	"\x03\x66\x38\x3e\x1d\xb7\xac\x6d\xf0\x0d\x7b\x48\x13\x53\x78\x65\xec\xa5\x09\xc7" +
+	"\x0a\x39\xba\x6f\xbe\x34\xad\x09\x96\x14\xcc\xa5\x5c\x03\xcc\x24\x3b\x89\xc1\x32" +
+	"\x2a\xc4\x97\x0e\x69\xaa\x23\xa4\xe9\x37\xe1\x98\x1b\xb3\x89\x34\xa7\xed\x9d\x08" +
+	"\x0e\x90\xcc\x5e\x9e\x01\xf7\x66\x2b\x96\xe6\xbf\xa0\xe0\x86\x2d\x8d\xdf\x78\x44" +
+	"\x70\x38\x49\x5a\xac\x13\x52\xb4\x1a\xd2\x13\x2e\x92\xbd\xf6\xf3\x79\xaf\xca\x09" +
+	"\x75\x9c\xef\x5b\x32\xfb\xcf\x95\xe5\xea\x4a\xcb\x93\x8b\x79\xc1\xcb\x45\x12\xe2" +
+	"\x7f\x65\xc1\x39\x02\xeb\x65\x7a\x3e\x59\xd2\xac\x74\x63\x5d\xdc\x00\x67\xe3\x0b" +
+	"\x06\xe7\x38\x0c\x22\xe0\x04\x86\x44\xf4\xe6\xb4\x2c\x32\x19\x4a\xcb\x72\x94\x85" +
+	"\x1b\x98\xd6\x6c\x21\x2d\xc5\x9b\x11\x98\xdb\x6e\xa4\x43\xba\x5f\xf6\xbf\x6b\x6f" +
+	"\x60\x9c\xde\x59\x2d\x17\xf5\xea\xc4\xa1\xd3\xfe\x32\xad\x86\xf4\x15\xf1\xe7\xc2" +
+	"\x2b\xe1\x8b\x3e\xdd\x90\x7d\x52\xf2\x0d\x40\xc2\xe7\x00\x5e\x2e\xaf\x20\xae\x4f" +
+	"\x2e\xf7\x3a\xa6\xcb\x02\xc3\xa2\xd9\xad\x8a\x7e\x5f\xfa\x17\xcf\xf7\x85\x1d\xc6" +
+	"\x3e\x17\x7f\xb9\x76\x4d\xdc\xbc\x96\x54\x8f\x8c\xea\x51\x1c\xdb\x74\x7c\xc5\xa3" +
+	"\x2d\xf5\x60\xa0\xde\x75\x82\x37\x9e\xb6\xfc\x43\xbf\xd9\xb6\x7c\x5a\xe9\xb7\x7e" +
+	"\x21\xdd\x6a\xb1\x77\x31\x40\x3d\xb0\x6a\xd1\x5f\xbf\xb0\xef\xbe\x8d\x49\xe7\xb0" +
+	"\x0f\xd2\xef\xf1\x4c\xc7\xcf\x8d\xce\x0e\xf4\xd2\x7b\x6c\x77\x9b\xc1\x89\xdf\x9d" +
+	"\xd0\x62\xbb\xf9\xd3\x10\x92\x6a\xf7\xfa\x87\xfe\x4d\xb5\x27\xef\x8f\x84\x37\x8b" +
+	"\x0f\x98\x3f\xe8\xff\xee\x57\x90\x2c\xd5\x3f\xc0\xca\x32\x53\xef\x1b\x58\x1a\xeb" +
+	"\x01\xc7\x16\x85\xb4\x03\x0c\x34\x3b\x79\x11\xd3\x72\x76\xaa\x9b\x53\x22\x9d\x2d" +
+	"\x60\xda\xd9\xa0\xd3\x4d\x76\xf5\xdb\x5d\xdb\xb9\xb4\xe7\x85\xf9\x63\x2f\xf7\x48" +
+	"\xde\x15\x6b\x5a\x75\x43\x3c\x97\xd0\x53\x5a\x35\x4c\xf7\xdb\x99\x59\x48\xfb\x99" +
+	"\xab\x4b\x7d\x1c\xab\x7b\xa2\x89\x04\x17\x2f\xed\x23\xa4\x1d\x3d\x04\xda\xf9\x40" +
+	"\xc1\x92\xda\x1c\x98\x02\x39\xb1\xd7\x3f\x24\xbb\x24\x45\xef\x72\x48\xb5\xfb\xa6" +
+	"\xf4\x62\x43\x77\x66\xb0\xf5\xf1\x03\xe5\x50\xda\xd5\x34\x8c\x1a\xc0\xbc\xc1\xa6" +
+	"\x83\x00\x30\xd3\xfa\x01\x56\xb0\xa0\xbe\xed\x21\xb1\xd6\x4d\xed\x2e\xab\x63\x75" +
+	"\x60\x4b\x04\xbb\xe5\x58\x9d\x28\x13\xc0\x0a\x00\x79\x95\xd8\x00\x43\x0a\x52\x47" +
+	"\xfd\x0a\x31\x99\x46\xe3\x31\xcb\x01\x1d\x0d\xc0\xba\xa3\x29\xe9\x48\xf5\x3b\xf6" +
+	"\x3f\x30\xdf\xc8\x0a\x7e\xa6\x64\xd9\x30\xc2\x17\xa9\x53\xf2\x5f\xfe\x9d\x47\x71" +
+	"\xeb\x84\x92\xee\x07\xa8\x7a\x3a\x6e\x0a\x68\xc3\x40\x00\x8d\x63\x52\x26\x0e\x60" +
+	"\x4e\x94\x73\xcb\xed\x6e\xd1\x3d\x35\x1a\xea\x28\xf6\x8c\x63\xe4\x90\x0e\xdb\x5e" +
+	"\xda\xc2\x74\xfc\x37\x72\x55\x29\x35\x9e\x5d\xbd\xec\xe0\xb4\x3d\xfd\x01\x8a\x6c" +
+	"\xab\xbf\x7e\x3b\xb4\x1c\x19\xe5\x8e\x71\xa0\x12\x3e\xf9\xd8\x5c\xdc\x40\x15\x31" +
+	"\x68\xaf\xee\xac\x28\xc0\x68\x1f\xaa\x5c\x65\x7f\x38\xb9\xaf\x3f\x6b\xc8\x7d\x71" +
+	"\x91\x5e\xa5\xf2\x29\x70\x4c\x5e\x2a\xda\xb9\x46\xfc\x8d\x89\xc3\xf1\xa1\xf6\x33" +
+	"\x86\x08\xfd\x0a\xdf\x08\x37\xb0\x27\x29\xed\xf3\xc3\x0b\x39\x5f\x3a\xfa\xdb\x5e" +
+	"\x55\x94\x6c\x68\x6d\xd8\x47\x35\xa4\xc9\xc5\x39\xb2\xe9\x83\x2c\xf5\x9b\x59\xe9" +
+	"\xa6\x7a\x88\x52\xac\xb6\x04\xd8\xb7\xf5\x0e\xbf\x0f\x09\xf5\x94\x84\x83\x4d\x1b" +
+	"\x0a\x67\x9e\xd4\xfe\x46\x99\xc9\x4f\xe6\xf8\x9d\x49\xf1\x84\xe5\xb4\x3b\x3d\x57" +
+	"\x00\x33\xeb\x05\x68\x68\xfc\xa8\xa4\x86\xea\xaa\x00\xda\xe7\xa2\x45\x4e\x20\x11" +
+	"\xca\xf8\xcf\xfa\x9a\xba\xa8\xaf\xcd\x67\xf9\xb9\x70\xdc\x6c\xbd\xf0\x3f\xa2\x7f" +
+	"\x49\xf5\x12\xae\x0a\xd6\xe6\xb8\x44\x22\x3d\x73\x86\xd4\xe0\xfd\x6f\x64\xd4\x96" +
+	"\xb6\x0f\xea\xe9\x0f\xaa\x15\x70\xc8\x3c\x89\xdf\x98\xaa\xa9\x92\xa4\xca\xa2\xc3" +
+	"\x13\x70\xf2\xcc\x79\xea\x0f\xb5\xc0\x4b\xf2\x14\xc9\x64\x45\x7a\x34\x72\xcf\x41" +
+	"\xb5\xc5\xfe\x0b\xe9\x16\x13\x32\x99\x80\xdb\xa9\x1e\xda\xa6\x78\xf2\xa5\xa1\x9a" +
+	"\xac\x88\x58\xe6\xbd\xa2\x4d\x18\x5a\x19\xe1\xa3\xa0\x34\xc0\x75\x39\x57\x45\xaf" +
+	"\xc0\xbf\x8c\xd4\xfc\x53\x43\x93\x93\xa3\x72\x80\x01\xab\x9e\x6a\x94\x5f\x69\xac" +
+	"\x84\xd4\x5a\xbc\xd4\x3b\xdb\x3d\x5d\xb6\x9e\x77\x6b\xc0\xd6\xff\x38\xdb\x09\x71" +
+	"\x3d\x7f\x78\xa4\x3b\x8b\x91\x4b\x1d\x54\x96\x52\x5f\x8f\xfb\xed\xfa\x7a\xdc\x86" +
+	"\x63\x49\x5e\xa0\x52\xbf\xee\x6e\xcf\xd6\xfc\x3a\x3b\x95\x1c\x12\xd0\x03\x18\x1e" +
+	"\xcc\xd8\xc3\xf3\x1c\x48\x32\xf1\x7c\x8d\x27\x90\xfd\x83\x54\xb7\x3f\x6c\x4d\x39" +
+	"\xd8\x89\xda\x7e\x02\xa8\xb7\x1e\x35\xbd\x62\x48\xa3\xaf\xf1\x88\xda\xf4\x7e\xc7" +
+	"\xe3\xde\x2e\x7d\xc3\xaa\xdf\x54\xee\x0d\xff\x52\xa9\xc5\xb7\xe6\x22\x18\x14\xc4" +
+	"\x10\x69\x85\xd7\xfc\x86\x09\xc5\xf2\xfe\x9d\xaa\xa2\x03\x0a\x5d\x16\x72\x34\x7d" +
+	"\xe7\xe3\x43\xf8\x55\xc6\x99\xa1\x86\xd8\xad\x2e\xa4\x67\xfc\xc8\x4b\xb4\x77\xaa" +
+	"\x9f\x38\xb9\x39\x53\xfa\xc1\xe5\x96\xfe\x7e\xee\x6d\x9a\x6b\x7f\x70\x67\xc8\x5e" +
+	"\xe6\x0f\x6a\xb8\xfd\xb8\x05\x43\xfa\x61\xf7\xa1\x0f\xf5\x33\x6d\xb7\x88\x31\xfd" +
+	"\xb8\x32\xaf\x18\xfc\xff\x5e\xf9\x6f\x0a\x1e\xec\xf5\x9b\xea\x5f\xf6\x07\x1d\x16" +
+	"\x4f\xb9\x73\xd5\xa1\x69\xe0\x58\xfe\xef\x82\x26\xff\x91\xa6\x83\xca\x46\xcc\x60" +
+	"\xcf\x4f\xf8\x76\x6d\x24\xa1\x08\x5c\x1d\x68\xab\xfb\x83\xdd\x88\x93\xbb\x12\x24" +
+	"\xe9\x15\xd7\xb1\x27\x27\x5c\x4a\x8d\xeb\x72\x40\xcd\xf2\x22\xf6\x5d\xfa\x41\xa5" +
+	"\xc5\xaa\xed\x68\xfe\x40\x2b\xb2\xe0\x5a\x53\x23\xee\xa7\x54\x99\x7b\x90\x36\xe9" +
+	"\x4b\x1b\xe0\xd6\x19\x0f\xda\xce\x52\x3f\x17\x76\xa7\x7b\xbb\x9c\xf7\x1e\x52\x78" +
+	"\xce\x5e\xa5\x33\xd9\x20\x75\x30\xdf\xc8\x7a\xdf\xcf\x79\xff\xfa\x9e\x3d\x7f\xa4" +
+	"\x05\xfd\x9d\xfe\x10\xed\xc3\xe1\xce\xdb\x4c\xd0\x7e\x83\x36\xdd\xe6\xbf\xaf\xe5" +
+	"\xac\xf9\xff\xb1\x97\x47\x64\xcf\x9b\xea\xf1\xa3\xb2\x93\x0c\xe9\xc7\x5f\x1c\x9d" +
+	"\x50\xa4\x69\x52\xfd\xb8\x2f\x29\xc8\xdd\x3f\x7e\x90\xf5\x23\x34\xe5\xfc\x80\xf1" +
+	"\x87\xe7\x7f\x2e\xec\xb9\xf7\x15\x33\xe0\x0f\x7c\x1b\xfc\xc7\x24\xbb\xa8\xde\x13" +
+	"\x30\x00\x4e\x3f\x78\xa4\xd4\x67\x05\xf8\x33\xfe\xa9\x4e\x8d\x67\xf1\xb7\xbc\xb1" +
+	"\x3f\x01\xab\x4b\xd4\x0b\x69\x67\xe4\xdd\x84\x3f\x61\xbe\x66\x6a\xf9\xe1\x88\x71" +
+	"\xff\xca\xdd\xde\x03\xd1\x32\xea\x7f\xc8\x53\x91\x30\xa6\x9f\x81\x63\xae\x63\xfa" +
+	"\x83\xfd\x0f\xa9\x0f\x7b\x36\x0d\xf3\x9f\x81\x54\x82\x44\x0d\xc4\xb1\x79\x6b\x8e" +
+	"\xcf\x88\xbc\xd2\x22\x35\x99\x1b\xa4\x46\x9a\xdc\x33\x42\xc5\x3d\x4c\x57\x32\x6d" +
+	"\xa7\x74\xa5\xa6\x56\xbf\x3c\x3e\xc8\x93\xef\x3d\x87\x27\x31\xba\xe8\x85\x9c\xf0" +
+	"\xcc\x78\xf9\x83\x08\xf9\x12\xc5\x95\x7b\xc6\x49\x5c\xd7\x44\x02\x4f\xc7\xa8\x6a" +
+	"\xb7\x8b\xcf\x8f\x7a\x41\x06\xf4\xc4\x78\xbf\xad\xe1\x19\xe9\xa2\xfc\x40\x1e\xf4" +
+	"\xe1\xbd\xf3\xb4\x5c\xdd\xa4\x1d\xc8\x66\xe0\x19\x5f\xb9\x12\x09\x08\x85\x95\x1b" +
+	"\x54\xd2\xb7\xcd\x8f\x8e\xc0\xf2\x26\x85\x70\x6f\x44\x0f\xda\x1a\x83\x58\xb4\x43" +
+	"\x77\x8e\x10\x46\xf4\x94\xe3\xb3\xac\x3d\x71\xd3\x8c\x31\xc0\x53\x5e\x9a\x17\x7e" +
+	"\x7d\x6c\xd2\x7c\xc4\x6f\x8c\x66\xe2\x67\xac\x18\x70\x6d\xe1\xc9\xee\x34\x42\x76" +
+	"\x66\x5b\x01\x73\x74\x07\x12\x23\xf5\x89\x2b\x43\x95\x42\x84\x24\xea\x5b\x9b\xbb" +
+	"\x82\x7a\xc2\x2c\xe0\x9b\x3d\x63\x9b\x54\x6c\xeb\x79\xf6\x37\xb8\xa1\xda\xd4\x59" +
+	"\x16\xcf\xd8\xef\x76\x14\xe8\x1f\x90\x27\xa0\x0e\xb8\xd0\xa2\x2c\x2b\x76\x1e\x0e" +
+	"\x36\xff\x3d\x82\x0e\x23\x76\xcb\x05\x1c\xcf\xd8\x35\x43\x39\xd5\x43\xc7\xd6\x9f" +
+	"\x1e\x33\xfd\x19\xc7\xd6\xbd\x88\x41\x01\xe3\x27\x3c\x71\x16\xc3\x1f\x15\x64\x01" +
+	"\x85\x9f\x29\x6e\xb4\xaf\xf1\x63\x28\x05\x56\x4c\x6a\xed\x1f\x68\x6f\x92\x22\x3c" +
+	"\x6a\x65\x60\xf5\x7d\xa4\x79\xf6\x02\xfa\xfa\x51\x35\x7d\xa6\x47\x55\x1f\x4a\xf8" +
+	"\x80\xd0\xcf\x26\xb8\x31\x46\xd8\x11\x3d\x13\xc2\x5e\x5a\xc0\xa1\x3a\x4d\x42\x18" +
+	"\x1f\x6c\x63\xef\x8f\x4b\xf6\xb0\x34\x3f\x22\xd5\x4c\xf3\x56\x3a\xd4\x63\xca\xa3" +
+	"\x03\xc4\xb5\x49\x4d\x9e\xb8\x22\x20\xbb\xac\x3e\xd4\x2e\xc9\xb9\x59\xb8\x41\x64" +
+	"\xbc\x73\xd3\x97\x6c\xf1\x37\xd8\xb7\x3d\xd1\x40\x08\x4f\x1d\x0f\x20\x18\xb8\x3e" +
+	"\xa9\x5e\xb8\xd5\x5c\x9c\x52\xc0\x71\x27\xaa\xc2\x55\x97\x47\xf3\x90\x49\x96\x26" +
+	"\xae\x37\x09\x43\x6d\x50\xeb\x85\x0b\x2a\xd0\xb7\x26\x73\x40\x56\xce\xef\x8c\x52" +
+	"\xd8\x33\x89\x6f\x67\x44\x45\xdc\x7e\xaf\x48\x82\x7b\xeb\x54\xf7\xba\x7e\x2a\x66" +
+	"\xba\x11\x75\xac\xd4\x65\x76\x89\x51\x86\x8a\xf2\x53\xb1\x5d\x53\x3d\xce\xe1\x22" +
+	"\x63\x9a\xd8\x93\x3f\x13\x0f\xfe\x0d\xcf\x4c\xd5\xb2\x34\x6a\x45\x2a\x57\xf6\x0a" +
+	"\x19\x09\x63\x78\x12\xe9\x25\x39\xb1\xcf\x92\xef\xb9\x81\xd6\x93\x08\xb8\x3a\x75" +
+	"\x2e\xa9\x2e\xae\xf0\xcc\xe9\x09\x63\xef\x99\xb1\xd9\x69\xeb\x07\xf0\xd6\x19\x2b" +
+	"\x30\x2f\x8f\xd7\x35\xf4\xa4\xc4\x85\x06\x86\x0e\x2c\x73\x18\x1c\x79\x53\x20\xb6" +
+	"\x67\xde\x26\x4a\x31\xdf\x8e\x84\x20\xe5\x2b\xe5\x2d\x63\x24\xc5\x8b\x67\x1c\x77" +
+	"\x24\xca\x80\x09\x22\xc2\xa3\xb6\x5d\x7f\x19\xb6\xf6\x98\x43\x2e\x39\x8d\xaa\xa5" +
+	"\x31\x9a\x25\x94\x29\xe7\x7d\xf3\xc7\x62\xf6\x8b\x86\x3d\xcb\x22\x1f\x57\x37\xec" +
+	"\x7a\xc0\x61\x55\x38\x64\x5d\xf6\xc4\x56\x99\x2b\x1b\x56\x19\x77\x2a\x28\xa9\x3e" +
+	"\x38\x97\x09\xe0\xe5\xc9\x11\xc1\xd2\x85\x06\x25\xd6\xe4\x9a\xcc\xff\x62\xaa\x5a" +
+	"\xd3\x0c\x2b\x00\x60\x3e\x73\x84\xd4\x82\x35\x99\xe4\x85\x14\x9f\xeb\x72\x4d\x70" +
+	"\x43\x75\xf3\xac\xa5\x3c\x85\x1a\xec\x21\x81\x0e\xbb\x13\xc4\x1a\xa8\x7b\xe2\xb3" +
+	"\x2b\x24\x85\xb7\x7a\x6d\xfd\x51\xe8\xd9\xb8\x0e\x0c\x68\x5f\xe2\x40\x4b\x60\x45" +
+	"\x46\x03\x20\x08\x97\xaa\xda\x56\xc4\x0c\x24\x49\x4a\x02\x78\x13\x9a\xe2\x89\xa4" +
+	"\x8d\xd0\x51\xe2\xbd\x89\x8b\xfb\x94\x9a\x5d\x78\xde\xda\x76\xcf\xfc\xc3\xc2\x2a" +
+	"\x02\x6b\xe0\x99\x7f\x92\xc6\x43\xfe\xd1\x90\xfd\x52\xeb\x99\xd5\xea\x82\x70\xe5" +
+	"\x1f\x9c\xfc\xce\x05\x99\x26\xc6\xc4\x16\x17\x0d\xf2\xf4\x07\x35\x5c\x71\x59\x6e" +
+	"\x44\x01\xb9\x00\x3a\x1b\xdd\x80\x27\x6e\x71\x31\x37\x51\xcf\x4d\x6f\x21\xc3\x57" +
+	"\x92\xf7\x87\x1b\xca\x7e\xb0\xaa\xb1\xc3\x42\x31\xc0\x61\x1c\x7d\x36\x2e\xec\xe0" +
+	"\xf2\xc4\x36\x56\xe4\x94\x56\x3a\xaa\x6f\x1a\x5e\x5b\xec\xb8\x1a\xe3\x5a\x76\x9d" +
+	"\x80\x8c\xc1\x38\xc0\x43\xa8\xc6\x8e\xf1\xc5\xf4\x07\xf5\x3c\xce\x2c\xcf\x0d\xb7" +
+	"\xaf\xd6\x0c\xef\xbf\x19\x79\x8b\x3f\xac\x0d\x88\x9a\x9e\x98\xc3\xf2\x1c\x78\xc0" +
+	"\x4e\xad\xbf\x6e\x8a\xa1\x6d\x44\x37\x12\xf3\xd0\x96\xe4\x68\xec\x09\x9b\x5e\xf2" +
+	"\x26\x90\x03\x4d\x05\x1d\x65\x87\x07\xdf\x57\xbd\x8b\x87\x3a\xe4\xd7\x79\xe7\x9d" +
+	"\x01\x87\xcf\x3e\x20\xdd\x39\x4f\x45\x5c\x74\xd4\xef\xa4\xbe\x79\x9a\xda\x4b\x94" +
+	"\xf7\xca\x55\x5b\x1d\xbd\x63\x56\x66\x05\x0d\x12\xb5\xea\x17\xda\xa3\x94\x59\x1b" +
+	"\x4e\x21\x79\x30\xb0\x44\x93\xd7\x03\x7f\x29\x1e\x7b\x4a\x0d\x0a\xac\xd6\x79\xd9" +
+	"\xe2\x1b\x29\x10\x5e\x9d\x9f\x5b\x91\x70\xc4\xa9\xb2\x0c\xe7\x0d\x99\x72\xe2\xe3" +
+	"\x83\x50\x24\x32\x44\xd1\x83\x98\x37\xea\x3a\x83\xf2\x07\x23\x7e\x1b\xfb\x5e\x7e" +
+	"\xa0\xc7\x8b\x39\x15\x7a\x79\x98\x26\x06\x9d\x01\xab\x37\x6e\xa1\xa8\xe9\xfd\x1d" +
+	"\x2f\xa9\x43\x3f\xb7\xeb\xc1\x2f\x2e\x39\x01\x51\xd3\x5c\x5b\x8a\x4f\xe8\x89\x1c" +
+	"\x0e\x80\xb3\x56\x5a\xf8\x6a\x6f\x56\x7f\x65\xba\x97\xdb\xed\x32\xff\x28\xcf\x12" +
+	"\x99\xe7\xca\x23\x3c\x91\x19\xd6\xf0\x2c\x49\x3d\x07\x27\x86\xe1\x89\xe8\x97\x61" +
+	"\x5d\xa4\xc0\x62\x84\x9b\xaa\x4b\x81\xab\x5d\x28\xc3\x94\x30\xa1\x85\xab\x57\xa3" +
+	"\xc8\x6d\x93\xc1\xb5\x80\x7e\x64\x73\x45\xb9\x57\xc2\xc2\xa6\x8b\x62\x70\x44\xe4" +
+	"\x64\x16\xa5\xa5\xe4\xcb\x12\xb2\xe1\x5d\x92\xf2\xca\xe6\x93\x53\x29\x5b\x9b\x98" +
+	"\x89\x4a\xe9\x2e\x69\x64\x6c\xb0\x15\x54\xda\x9a\xff\x01\x3c\xb8\x74\x79\x13\x00" +
+	"\xa8\x27\x5a\xda\x26\xdf\x53\x20\xa4\x92\xd0\x43\xde\x5d\x41\xb0\x62\x95\x89\xe9" +
+	"\x4f\xa0\x59\x6d\xba\x76\xca\x4a\xe8\xe9\x74\x80\x23\x0f\xd0\xbb\xd4\x57\x78\x16" +
+	"\x92\xcf\x53\xab\x6d\x41\xa7\x82\x46\xad\x72\x72\xe6\xd4\x66\x07\xc3\xea\xac\xa5" +
+	"\x4a\x5d\xc5\x46\x27\x17\x27\x55\xac\xb3\xcd\x0d\x18\x59\x55\xed\xef\x4a\x4d\x77" +
+	"\x39\x02\xca\x95\x1e\x28\xec\x38\xf0\xe2\xd2\x84\x53\x0d\x3d\x18\x4f\x15\x7a\x4f" +
+	"\x47\xf3\x07\xc2\x19\x12\x2e\x83\x75\x12\x26\x32\xb6\xe9\x24\xd9\x6a\xa5\x34\x5e" +
+	"\x4c\x80\x9d\xad\x08\x5b\x01\x80\x6f\xd6\x4b\x4d\xb6\x23\x2e\x35\xbd\x0e\xfc\x26" +
+	"\x22\x2a\xe5\x17\x68\x0b\x88\x6a\xa4\x17\xac\x54\xd4\x9a\x35\x29\x42\x1e\xb7\x0e" +
+	"\xed\x55\x00\x8a\x27\x40\x12\xd6\xab\xd9\x12\x71\x65\x6b\x40\x73\x15\x2a\xbe\x9a" +
+	"\x6b\x01\x6b\x9c\xcb\xfb\x1f\xe8\x2f\x66\x17\x77\x33\x34\x9d\xdb\xad\xb0\xf6\xf2" +
+	"\x52\x71\xa1\xed\x85\x80\xee\x1f\xde\xed\xd5\x08\xcc\x6b\x69\x9d\x56\x34\x94\xfc" +
+	"\x27\xdd\x7a\xd2\x5f\x98\x25\xb0\xa2\x3d\x5b\x6b\x7a\xb3\x2d\x68\x9b\xf9\xd7\x5b" +
+	"\xf2\xf7\x0d\x5f\x5b\x7e\xea\x27\x5b\xa9\x78\x65\xc6\xa8\x82\xa8\x42\xeb\x65\x9f" +
+	"\x70\xf7\x4d\xbf\xa1\xdf\x4f\x1a\x02\x76\xf2\xe2\xd7\x1c\x3a\xb6\xc8\x39\xa0\x54" +
+	"\xe7\x9a\xdc\x48\xab\x57\xa9\x8d\x51\xc0\xed\xf6\xf6\xee\xd2\xae\x4e\x74\xe3\x27" +
+	"\x5e\x72\xdf\x04\xd6\x03\xb9\xc3\x89\x3f\x68\x35\xea\xe3\xd0\xfb\xda\x9b\x10\xe5" +
+	"\xd7\x3b\x66\x6b\xd9\x2b\xe5\x88\x4a\xd9\xba\x5c\x3e\xbd\x77\x1c\xb3\x2d\x20\xf4" +
+	"\x0e\x76\x46\xd2\x35\x7a\x96\x9f\x05\x17\x05\xe1\x59\x7e\xe4\x5d\x43\xd4\xfa\x0f" +
+	"\x47\x63\xe5\xb8\xc2\xb3\xc6\x29\x36\x11\x9f\xdd\x6b\xcc\xb3\xd2\x8f\x85\xc9\x47" +
+	"\xe8\xf5\xc4\x12\xad\x1a\x86\x97\x75\x34\xbf\x61\x75\xa8\xf1\x3c\x95\x80\x4b\x0b" +
+	"\x55\xb8\xa2\xa3\x38\x69\x47\x83\x42\x78\xf3\x5f\x8f\x99\x95\x53\x4b\x4c\x8d\x97" +
+	"\x6a\x5d\xe5\x1e\xfd\xa6\x73\x78\xe2\xc1\x52\x63\xb9\xea\x68\x72\x53\xdd\x49\xb8" +
+	"\xb9\x13\x2d\x70\x30\x95\x34\x6b\x36\xa9\xc9\x07\x05\xa0\x6c\x7f\xc0\x73\xc9\x81" +
+	"\xd6\x0d\x3c\xed\xfe\x21\x8c\x5e\x3b\x22\xe9\x82\xc0\x28\x24\xb3\x13\x7d\x79\x56" +
+	"\x9f\xbc\x6f\xe4\x99\xdd\x29\xe2\xb3\x26\x13\x07\xde\x40\x9c\x47\x2e\x7e\xb3\xed" +
+	"\x96\x18\x18\x96\xb1\x21\xea\x76\x4a\x1f\x13\xea\x50\x76\x5c\x37\xb5\xdf\xf8\xfc" +
+	"\x56\xb3\xf5\x66\x39\xe3\x7c\xc2\xea\x50\xf0\x73\x21\x98\x46\xdc\xb1\x45\xb7\x52" +
+	"\xa1\xfb\xe7\x79\xb1\xa8\x18\xcf\xaa\xe9\x99\xbf\x7c\xde\xec\x77\x9b\x4a\x6e\xe8" +
+	"\x1a\x88\x2a\xa3\xad\x5a\x35\xfb\x89\xa4\xe6\xef\xb2\x79\x19\xbf\xbf\xf6\x97\x7c" +
+	"\x9a\x5e\x2a\x7a\x48\xbc\x0b\xca\xad\x4e\xac\x34\x12\x14\x3f\xd3\xa0\x7c\xc7\xe2" +
+	"\x7d\x5f\xf7\xb0\x9a\xf6\x68\xa5\xb9\x19\x3f\x44\x36\x02\x84\xbf\xf9\x20\x1d\xa5" +
+	"\xc1\xe8\x86\x28\xd1\x8b\xeb\xab\xae\xdf\xec\x22\x30\xcf\x10\xeb\x01\xf3\x2e\x91" +
+	"\xdc\x15\x46\x59\x28\x21\xe6\x11\xba\x1b\x64\xe4\x7e\x9f\x97\xe5\xdd\x3e\x3c\x07" +
+	"\xfa\xf8\xcf\xb1\x61\x20\x16\x9e\x63\x73\x5e\xd7\x8d\x34\xb8\xc7\x66\xf5\x1f\xbb" +
+	"\x37\xb2\x01\xbb\x77\xd8\x21\x8b\x51\x37\x8e\x24\xdb\xdc\xe7\x38\x16\xef\xf9\x30" +
+	"\x55\x15\x79\xe4\x39\x8e\x43\x6f\x32\x88\xf9\xc0\x2c\x3a\x2a\xd2\x5e\xa3\x83\x50" +
+	"\xef\xa3\x96\xca\xdf\xfb\x90\x35\x14\x78\x81\x04\xc2\x25\x33\xfe\x56\x67\x58\x39" +
+	"\xde\xd1\xa3\x02\xa3\x7c\x43\xd1\x0d\x7f\x42\x0c\xb8\x81\xab\x2b\xee\xb5\x8e\x9c" +
+	"\x1e\xca\xfd\xae\x1a\x7b\x2c\x3d\x07\x3d\x2c\x38\xc5\x51\x70\xe3\x45\xd6\x56\x24" +
+	"\x10\x46\x18\xaf\xe8\x28\xba\x2c\x71\x26\x18\xc5\x82\xba\xac\xfd\xad\x81\xb0\x14" +
+	"\x74\x56\xa7\xba\x9d\x0f\x22\xe8\x2c\x51\x46\xb8\x4b\xdc\x30\xd1\xd6\x0d\xa1\x6a" +
+	"\x28\x95\xc3\xa8\x5f\x5e\x4d\x94\x6f\x2b\x4a\xd8\x3f\x52\x4a\x5b\x9d\x24\xe5\xf8" +
+	"\x36\xbb\x90\x0f\x9a\xc3\x42\x80\x33\x6a\xb7\x3f\x0a\x15\xd9\x13\x0e\x4e\x04\xdc" +
+	"\xd7\x32\x50\x15\xd8\x53\xbb\xbd\xc9\x92\xa6\xa4\x4c\x3d\x24\x84\x59\xe2\xbe\xab" +
+	"\x56\x6c\x2e\x21\x8b\x16\x23\x01\xab\x97\x98\x18\x10\x0c\x0d\x5e\xca\xc2\x30\xbf" +
+	"\x01\x93\x26\x90\x5d\xe2\x12\x0f\x4e\x5a\xcb\xfd\xc6\xf5\xa1\x3f\x4c\x5e\x4b\xac" +
+	"\x24\x8f\xc8\xb3\x2a\x07\x5b\x23\x76\xa7\x5c\x9e\x00\xa0\x3c\x39\x7a\x36\xa2\xa7" +
+	"\x2b\xa8\xe0\x82\x8a\x0d\x3b\x60\x01\x9e\x53\xf1\xe4\x93\xf4\xd3\xaa\x07\xd7\x8a" +
+	"\x90\x9b\x4b\x33\x65\x5d\xe0\xf5\xf1\xc5\xda\x23\xf2\x32\xed\xa5\x6c\x4d\xdb\x32" +
+	"\x40\xaf\x2e\x9f\xfa\x60\x1e\xd7\x39\xdc\x6a\xca\x02\xdb\x5e\x1d\xf4\x69\xdb\x9e" +
+	"\xa4\xc7\xb7\xc4\x41\x58\xee\x25\x8e\x99\xfe\x0d\xd5\x5f\x14\x80\x79\x0e\x7a\x61" +
+	"\x4b\x7c\xd3\x6a\x6f\xe6\x06\x08\x19\x7e\xd3\x83\x5f\xfd\xc2\xfa\x50\x79\xbf\x67" +
+	"\x54\x41\x29\xca\xbd\xd8\xc2\x5e\x6b\x65\xe9\x5e\x88\x60\x56\x03\x71\xf8\x3b\x5f" +
+	"\x2d\x6d\x9a\xa9\x91\xc5\xcc\x61\x49\xee\x39\x18\x01\x32\x32\xec\x80\x41\x51\x44" +
+	"\x5c\x04\x0b\xf1\x73\x41\x4d\x11\x4c\xb3\xd8\xb7\xbc\x97\xbf\x2b\xdd\xc8\x9a\xaf" +
+	"\xff\x9c\x5c\x80\x12\x37\x0a\xca\x15\x2a\x47\x23\x49\xf4\xce\xd1\x71\x5e\xc4\x96" +
+	"\x82\xd4\xfa\xa7\xc6\xe3\x97\x4a\x1f\x4f\xf4\x45\xc2\x92\x3e\x7a\x30\x4b\x2a\xb3" +
+	"\x62\xdc\x2d\xe9\xe3\x48\x71\x49\x65\x4f\xdc\x55\x95\x09\xcc\xd4\x3e\x98\xe9\xd7" +
+	"\x2f\xdc\x44\x1a\xf7\xbe\xd1\xcb\x72\xd4\xb8\xbb\x63\x05\x03\xcd\xa8\xb3\x3a\xe8" +
+	"\x9f\x24\xaf\x1b\x6a\x3e\x26\x69\x5b\xd0\x8e\xf1\x00\xda\x8b\x6c\x28\xc8\x7b\xdb" +
+	"\x52\x80\x3c\x1c\xd7\xbf\x98\x26\x6f\xe3\x98\xd7\x94\x69\xb9\xf7\x12\xdf\x61\x49" +
+	"\x17\xd6\xea\xcb\x5a\x5a\x7f\xa3\xae\x2c\xcc\xf4\xb0\x96\x81\xe6\x48\x58\x30\x1e" +
+	"\x63\xa3\xa0\x93\x6a\x75\x62\x77\x74\x74\x85\x50\xbd\xfc\x20\x6a\x35\xaa\x9e\xe4" +
+	"\x9d\xad\x4f\x65\xd8\x97\xf6\xeb\x79\xbe\xc2\x92\x17\x0d\xc8\xbc\x10\x0e\x79\xc9" +
+	"\x1b\x3f\xdf\xc5\x97\x01\xa8\xdf\x65\xd6\x03\x24\xcf\xa9\x9a\x63\x06\xf0\x1a\xf7" +
+	"\xb0\xe4\x03\xdd\xf7\xb0\x64\xb9\x7c\x85\x30\xf0\xc4\x44\x59\x60\x71\x5b\x4f\xd8" +
+	"\x14\x23\x55\x54\xae\x5f\x0c\x95\x8d\xa1\xbe\x79\x50\xa4\x45\x7e\xb6\x34\x2d\xdd" +
+	"\x1e\xb7\xec\xa7\xad\x69\x6e\x04\x88\x5f\xb0\x6c\x71\x4c\x65\x8f\x61\xd9\xf2\x43" +
+	"\x7f\x94\x2f\xef\xbb\xf1\xac\x96\x0d\x27\x6f\x4e\x98\xef\xb6\xdc\x7f\xed\x2f\xf9" +
+	"\x8a\x02\xbc\x03\xf8\x71\xb1\x40\x1e\x6a\xe4\x4d\xd6\x05\x37\xa0\x1e\x38\x7b\x3a" +
+	"\x58\x54\x79\x7e\x29\xbb\xda\x8f\x23\xf6\x53\x94\x4d\xa4\x40\xc7\x90\x68\xd1\x91" +
+	"\xd9\x09\x63\x73\x2b\x6f\x29\xda\x2d\xdb\x48\x64\x1b\x3d\x1f\x9a\x43\x08\x76\xca" +
+	"\xe7\x31\xfa\x4f\x12\xb9\xae\x89\xd7\x08\xcb\x11\xeb\x66\x7f\x29\xeb\x40\xf2\xa1" +
+	"\xbf\xaa\xfc\x11\x3b\x69\xbd\x85\xe5\x60\x35\xd5\x5f\xbb\x50\x37\x60\x73\xb6\x94" +
+	"\x88\xb0\x65\x29\xb8\xac\x14\xb1\x29\xa6\xb0\x2c\xcd\xd7\x02\x5b\x6b\x41\xb1\x43" +
+	"\x69\x04\xc6\x36\xd2\xc2\x52\x66\xbc\xe9\xf1\xdb\x79\x8e\xc3\x36\x65\x4b\x99\xd9" +
+	"\x86\x47\xa2\x9f\x2c\x65\x5e\xd5\x0d\x3c\x4c\xc3\x52\xcc\x35\x9f\xe8\x71\xa7\x5c" +
+	"\xfc\x25\x7c\x0d\x8d\x5b\xe6\x33\x62\x4b\x09\x6a\xe8\x1b\x41\x69\x84\x82\x63\x9b" +
+	"\xa5\x58\x3f\x2f\xdb\x8c\x17\x61\x2a\xb6\xcd\xc6\x9f\x04\x59\x28\xed\x45\x6e\xc6" +
+	"\x97\x42\x18\xef\xe8\x14\x31\xc5\x52\x0a\x2c\xdc\xa5\xf8\x33\x0b\xad\x5d\xca\x53" +
+	"\xce\x44\x16\xce\x67\x8a\x9d\x6f\xea\x6b\x0b\xa7\xc4\xb0\x94\xda\xa8\x6e\x6b\xb2" +
+	"\x30\x5e\x0a\xce\x30\xf5\x97\x8a\x0d\xc9\x99\x96\x32\xaa\xae\x08\x11\x25\x40\x43" +
+	"\xe1\xed\xb3\x30\x97\x9e\x01\x1f\x9b\x3e\xba\xcd\xdf\xfd\x52\x61\x87\x49\x99\x8f" +
+	"\xc0\xb3\x91\x35\x0b\xde\x27\x2d\x05\x65\x61\x81\xe6\x0d\x3f\x01\xb4\x05\x57\x9b" +
+	"\xe7\xf2\x58\x5a\xfc\xd2\x47\x44\xbd\xc3\x6a\xe9\x9b\x4b\x31\x40\x59\xad\x97\x1a" +
+	"\xd0\x1c\x82\xe6\xb2\xd4\x40\x35\x05\xb2\xf1\x03\xc0\xd3\xc7\x94\x90\xbe\x59\x63" +
+	"\x97\x0f\x9d\x05\xff\x0e\x6a\x46\x80\x4f\xf6\xb2\xd5\x8b\xed\x06\xfd\x93\xe4\x85" +
+	"\x5f\x5e\x1d\xf4\x43\x4f\xfc\xfd\x0b\x77\xdc\xa8\x81\xd2\x21\xcb\x76\xa1\xa5\x16" +
+	"\x94\x8a\x84\xc5\x79\x11\xba\x7f\x9f\x8e\xc6\x69\x0c\xc8\x5a\xac\x9e\x5f\xd3\xae" +
+	"\xbe\x67\x94\x04\xf8\xe8\x54\x21\xcf\x62\x14\x02\xc4\x6a\x5b\x2a\x66\x55\x64\xcd" +
+	"\x04\xfe\x85\xee\x5e\xb2\x6c\x9c\x96\x8a\xae\xb7\xfe\x52\xed\xcc\xce\x14\x82\x14" +
+	"\x60\x61\xab\xad\xdd\x81\x6b\x55\x2f\xb5\xe0\x28\x63\xa9\x65\xdf\x99\xe2\xf0\x6a" +
+	"\xc7\xbe\x06\x84\x1c\x0e\x49\x1e\x1a\xcc\xd0\x93\xf1\xc3\x84\x64\x44\xb9\xb5\x12" +
+	"\xd7\xf2\x6d\x67\x97\xa5\xca\xa1\xc1\x27\x80\x13\x36\x3b\x2a\x7d\x28\xdb\xf8\xe4" +
+	"\x7a\x3e\xf5\xec\xb1\x9f\x9a\x3a\x46\x3c\x4a\xc0\x29\x1d\x31\x42\xab\xd0\xec\xbb" +
+	"\x03\x60\x85\x59\xa0\x44\xab\xda\x78\x24\x1c\x13\xa3\x4f\x40\xba\x29\x52\x63\xf9" +
+	"\x3a\xd3\x4e\x23\x35\x29\x92\xf0\xfd\xc6\xf3\xc9\x52\x22\xc2\x5b\x2b\x80\x44\xd7" +
+	"\x06\x6d\x64\x1d\xe2\x14\x61\xca\x46\xef\xc8\x5b\xb7\xea\x64\x17\x9d\x2d\x43\x5a" +
+	"\xa9\x8d\x09\x06\xdf\x0f\xdd\xc8\x25\xc2\x5f\xfc\xb4\x2d\x63\x97\x4a\xf0\x32\xf0" +
+	"\x93\xb8\x0c\x5c\x36\xa9\x1e\x7b\x5b\x0b\x0f\x3a\x24\x03\x5a\xc6\x61\x3c\xfc\x65" +
+	"\x1c\x72\xb0\x05\x25\xff\x51\x95\x00\xf2\x01\x3e\x8e\x37\x96\x02\xcb\x38\x59\x6f" +
+	"\x88\xfc\x0c\xb1\x7d\xd3\x68\xb8\xd5\x59\x06\x2b\x2a\x62\x6b\x10\x75\xb8\x2b\x8c" +
+	"\x1b\x86\x65\xf0\x91\x9d\xcc\x4e\x6b\x58\xae\xe3\x4f\xaf\xf8\x73\x71\x85\xe5\x3a" +
+	"\x1b\x3b\x80\x0b\xf9\xb7\x11\x8d\xe6\xab\x16\x94\xa2\x52\x58\x89\xec\x3e\xe3\x5f" +
+	"\xb9\xfe\x8b\x2f\x2e\x5c\x11\x44\x48\x06\x6e\x86\xf8\xd1\x84\xc4\x27\x02\x5e\xbc" +
+	"\x81\xd3\x34\x2b\xa5\xc5\x77\x58\xe3\x8c\xf5\x4a\x58\x15\x65\x83\x74\x05\x5a\x59" +
+	"\x3d\x3c\x99\x28\x1d\x66\x45\x63\x4d\x63\xf4\x83\xda\x9d\x58\xf7\xb0\x7e\x22\x49" +
+	"\x83\xe4\x62\x51\x69\x73\x31\x52\x2f\xd5\x3b\x57\x2c\xae\x05\xd8\x4d\x43\xed\x9c" +
+	"\x68\xe8\xd0\x9d\x76\x12\x93\x5b\xa8\xb3\x79\x5e\x23\xd2\x27\xea\xb2\x4d\x57\x21" +
+	"\xcf\xf6\xd4\x30\x07\xdc\x22\x6f\xf0\x99\xf8\x9a\xb8\xf2\x7d\x0c\x0a\xdf\x72\x2f" +
+	"\x28\xa6\xac\x8a\xd4\x4c\x82\xab\xb1\xac\x71\x93\x94\x79\x8d\xac\xd4\x5a\x3f\xd6" +
+	"\xb8\xa3\x73\xa0\x37\x05\x0d\xa1\x63\x26\xea\x94\x00\xde\xaf\x0d\x60\xe5\x21\xb4" +
+	"\x0c\x2a\x73\xcc\x59\x87\x79\xd0\x86\x83\x86\x3f\xc4\xa3\x99\x4d\x31\xc0\x14\xd4" +
+	"\x28\x02\x58\xd3\x1f\x33\x1d\x49\xf4\xf4\x67\xfb\x34\x2b\x24\x13\x0f\x3c\x61\xce" +
+	"\x45\x95\x59\xee\xeb\xc3\x8b\xf1\x40\x5c\xa0\x4c\x3b\x9d\x6b\x54\x00\x82\x0f\xd2" +
+	"\xbb\x9e\xea\x96\x2b\x8c\x1c\x1c\x52\x71\xd0\xc4\xdf\xf0\xb1\x8c\x3f\x30\x87\x55" +
+	"\x3e\xd3\x61\xb9\xc1\x24\xf6\xf6\x05\xe8\xfd\xeb\x6c\xf2\x38\xee\xe1\x7e\xc8\x72" +
+	"\xd1\x72\xea\x4b\x75\xfe\xfb\x0e\x75\xee\xe5\x24\x2d\x21\x02\x84\xd2\x3d\xea\x86" +
+	"\x8e\x1d\xed\x55\x91\x21\xaf\xc4\x97\xd5\xc9\xef\x83\xf4\x5b\x14\xfd\x44\x59\x2b" +
+	"\x57\xa2\x16\xce\xd1\x81\xd5\x8c\xa3\x33\xe9\x16\xdb\x6d\x65\x17\xa2\x4e\xd7\x78" +
+	"\x94\x39\x1b\x5f\x63\x7f\x58\x13\xf6\x69\xf5\x17\xe9\x93\x0f\x96\x3e\xad\x1c\xe7" +
+	"\xd6\xd8\xbd\x55\x46\x4f\x83\x92\x89\x0f\x66\x79\xdf\x70\x86\xd6\xf8\x26\xcc\x29" +
+	"\xcf\xbe\xf0\x2c\xc3\x8d\xeb\x8d\xd2\x1c\x2f\x6d\xe5\x03\xac\x55\x08\xe8\x22\xde" +
+	"\x1c\x37\x65\x66\xa3\xb4\x27\x5d\x58\x88\x53\x8f\xc0\x8a\x91\xa0\xc1\xed\xce\x66" +
+	"\x27\xb6\xd5\x4d\x79\x94\xa4\x2f\x9d\xe2\xec\xa3\x23\x11\x8d\xc1\x8b\x62\x79\x10" +
+	"\x40\x2b\xf0\x69\x88\x5e\x04\xb5\x01\x2a\x54\x61\x42\x70\xf3\x6d\x07\x77\x83\x62" +
+	"\xfe\x08\x66\x7f\x83\x8b\xfc\x95\xad\x14\xb4\xa3\x85\xac\x94\x4e\x88\x8d\xe0\xd6" +
+	"\x9c\x37\xe5\x63\xff\xd9\x77\x2a\xf1\x7e\x8b\x8a\x36\xea\xfc\x49\x96\x53\x79\x65" +
+	"\xb0\x61\xe5\x79\x10\xd7\x3d\x0c\xbe\xe5\xf2\x5e\xa5\x60\x1c\xc2\x66\x11\xe8\xe1" +
+	"\x6b\x80\x0a\xe0\xb3\x62\xd9\x71\xbc\xbd\x99\xbf\x2f\x7f\xd7\xef\x5b\xfc\x82\x5f" +
+	"\x22\x26\x09\xe7\x24\xda\x25\xaf\x3b\xb1\x8c\xad\xc8\xe2\xd4\x67\xd2\x6c\x9a\x5f" +
+	"\x0e\xfa\x07\xe4\x29\xac\x29\xcd\xfe\x1e\x06\x18\x37\x69\x21\xb4\x38\x20\xb3\x2f" +
+	"\x5c\x93\x6d\x99\xd7\x94\xfd\xfb\x12\x14\x5f\x9f\xc2\x80\x92\xf4\x8d\xb6\x3c\x25" +
+	"\xae\xb6\xfc\xc3\xdf\x0d\x63\x24\xa8\x98\xb5\xab\x6b\xe2\xaf\x69\xdb\xb5\x94\x62" +
+	"\x74\xa2\xfb\xa7\x7c\x9c\xac\xda\x7c\xf0\x42\xbb\xf7\x86\xbd\x2c\x5b\xc1\xf8\x67" +
+	"\x4d\x7b\x39\x57\xa4\xee\x4a\xad\x75\x85\xdf\xe6\xb0\xe4\x99\x67\x62\x28\xc5\x99" +
+	"\x63\x95\x3e\xb0\x8a\x07\xe8\x13\x12\x5a\x71\x4d\x07\xa2\x2c\xb5\xd4\xe9\x7e\x07" +
+	"\xd6\x74\x76\x49\x69\x51\x29\x71\xdf\x41\x82\x3a\x45\x83\x8c\xeb\x25\xf4\x5d\xea" +
+	"\x1f\xf2\x9c\xd3\xd8\xf0\x6a\xb1\x26\xdf\x1d\x48\x31\xf4\x51\x46\xd7\x5d\xd8\xa6" +
+	"\x37\x8d\x9b\x21\x9c\x58\xab\x18\xc2\x10\x52\xdb\xba\xdf\xd9\xf6\x0f\x9b\x95\x8b" +
+	"\x2c\x9e\x22\x1e\xc1\xff\x12\xe9\x4f\x0f\xeb\xe9\x0d\xb5\xbf\x89\xac\x98\xe4\xcf" +
+	"\xe5\xc7\x11\x5c\x00\xab\x1c\x4c\x31\x2b\xf9\x7d\x84\x35\xfd\xf8\x8b\x8a\x52\x9b" +
+	"\xfc\xc0\xde\xba\x85\x35\x4f\x84\x84\x31\xa2\xcb\x17\x6b\xfb\x9a\xe7\x24\x31\x21" +
+	"\xc0\xac\x9a\xd6\x9c\x2a\x31\xb8\x05\xca\xb2\x5d\xe7\xbf\x10\xd1\xcb\x9a\x97\xd5" +
+	"\x26\x4e\x80\xba\x5f\x5e\xd6\x89\x25\x4d\x49\xcf\xa8\x13\x98\xb0\x64\xd4\x80\xcd" +
+	"\x59\xce\xe0\xca\xa9\x09\x20\xa9\xfe\x9a\xc9\x44\x1f\xcc\xdb\xc6\x82\x44\xb5\x36" +
+	"\xeb\x1d\x1c\x92\xd7\x7c\xcc\xe2\x19\x02\x88\xf7\x6e\xa3\x1c\xad\x1c\x8b\x0f\xb6" +
+	"\xe6\xf3\x2c\x32\xdb\x0b\xab\x74\x15\x18\xa5\xb9\x36\xac\x63\x56\x94\xa1\xc5\x2f" +
+	"\x03\xe1\x80\x3f\x4f\xff\x40\x5e\xa6\x4f\xeb\xca\x99\xea\x83\xea\x07\x6a\xbc\xe7" +
+	"\xae\x5d\x89\x48\x2f\x61\x65\x4e\x24\xc1\xa3\xc5\x09\x88\xd3\x2b\x74\x85\x55\xe7" +
+	"\x3e\x27\x33\xd4\x4f\x7d\x6b\x79\xd8\xd2\x56\x1e\x47\x79\x40\x28\x4d\x5f\x07\x73" +
+	"\xec\x93\x3f\x61\x2d\x29\xac\x65\x79\x73\x30\x5a\x0b\x27\x89\xb5\xbc\xc6\x1e\x90" +
+	"\x08\xb0\x23\x16\xd5\x0a\x55\xb6\x99\x2f\x8a\x5e\x62\x75\x42\xbf\xc7\x47\xf0\x9b" +
+	"\x9e\xc4\x21\x0e\xa7\xe9\x20\x1d\x78\xd6\xb2\x23\x01\xe4\x16\xc0\x28\xee\x60\x79" +
+	"\xcb\xb2\x23\xa6\xa3\x13\x95\x3d\x99\x15\x25\xb7\x0b\xf2\xcd\x3c\xfd\x61\xfb\x95" +
+	"\xdd\xb4\xb9\x9a\x03\xb1\xa6\x43\x07\x3a\x29\x70\xbb\x9d\xec\x20\xca\x9e\x6c\x7a" +
+	"\x2b\xb0\x30\x57\x14\xc9\xc5\x9b\x02\xa9\x9a\x3b\x27\xba\x95\x40\x56\x34\xc7\x91" +
+	"\x2e\x22\x2d\x09\xe0\x88\xc0\x92\xe6\xf4\x36\xa0\x37\x3c\xd2\x45\x20\x42\xa6\x9b" +
+	"\x72\xf0\x60\x19\x1e\x42\x54\x50\xc1\xe5\xa8\x9a\xec\x80\x83\xa0\xda\x97\x79\x53" +
+	"\x4a\x99\x0b\x4f\x29\xf3\x1b\x57\x67\x37\xd0\xaf\xcb\x2b\xd2\xe4\x45\x85\x94\x2d" +
+	"\x2f\xf1\xf8\x00\x3e\x11\xcb\x7e\x29\xe7\x16\x45\x94\x52\xed\x17\xda\xd0\x21\xfc" +
+	"\x5c\x8b\xe4\xc2\x22\x47\xd4\x76\xad\x9c\x1e\x67\x89\xa8\xe2\xab\x8d\xb0\xe2\x01" +
+	"\xd2\xd7\xf2\xd9\xff\x96\xda\x92\x54\x26\x6e\xc8\x4f\x6b\x4b\x36\xa8\x48\xd2\x64" +
+	"\x0a\xc0\xde\xd7\x91\xf8\x20\x82\x68\xf2\xdf\x3f\xd6\xa1\xe0\x83\xa8\x68\x6d\xe9" +
+	"\xcd\x41\xda\x50\xf1\xa2\xc4\x1b\xeb\x03\x5d\xb4\xb5\x34\xa2\xf6\x84\x55\x5e\xf4" +
+	"\x78\x76\xc3\x12\x01\xfd\x38\xb8\x63\x09\xef\x22\x00\xeb\x6a\x1d\x15\x86\xb5\x98" +
+	"\xcc\x62\x2d\x5d\x82\xdf\x15\xfe\x03\xe3\xda\xa8\xe5\xb0\x23\xd6\x8a\x34\x9d\x21" +
+	"\x58\x3c\x94\xdb\x5a\xba\x4e\xd1\x6b\x19\x28\x9d\xab\xbf\x8c\x96\x74\x8a\x71\x84" +
+	"\xd3\x34\x83\x88\xd5\xf4\x58\x5d\x28\x28\xac\x6e\xe0\x92\xfd\x86\x9f\x1f\x5a\xc3" +
+	"\x8d\x96\x16\xac\x22\xec\x2e\xec\x6f\x87\xda\xbb\x91\x06\x83\xcb\x9f\xfa\x59\xa9" +
+	"\x95\xae\xbd\x2e\xa7\xcd\xb0\xb2\x11\xf4\xed\xb0\xcc\xb2\xd7\xf2\xce\x1d\x9e\xee" +
+	"\x3a\x1e\x8f\xf1\x08\xeb\x78\xa0\x5c\x40\x18\x00\x1b\x5f\xd8\xd2\x3e\xe2\x46\x0f" +
+	"\xc3\x23\x54\xa2\x2e\xb7\x6b\xa8\x75\xec\x04\x51\x5e\xc7\x3e\xfb\x3e\x94\x43\x1d" +
+	"\x7d\x12\x5b\x15\xe6\x80\xb1\x67\x39\x32\x17\x60\x71\x47\xf7\x83\x71\x35\xf6\xd3" +
+	"\xf6\x79\xe3\xb8\x77\x7c\xe3\xc0\xea\xb9\xaf\x37\xba\x77\x0b\x52\x0f\x61\x13\x66" +
+	"\x8b\xf8\x38\xba\xad\x58\x03\x76\x21\x45\x56\xd5\x89\x60\x07\x4e\xe6\xb0\x8e\xc6" +
+	"\x86\x8f\x2f\x3c\xfc\x0b\x5f\x71\x42\xdf\x36\xc8\x71\x15\xfb\x3e\xf7\x6a\xc5\x75" +
+	"\x21\xc2\x10\x8b\x0c\xd8\x4c\x0c\x19\x61\x5c\x3d\x31\x7a\x53\x1e\x9a\xdc\xb3\x33" +
+	"\x6d\xc6\xf3\x0a\xeb\xb5\xc3\xdf\x5d\x2f\x14\xff\xcd\xc5\x98\xf0\xa3\x6c\x7f\x48" +
+	"\x25\x9d\x34\xbb\x73\x8f\x75\x63\xd3\x58\x05\xa1\x4a\x9d\xff\x7e\x6b\xfe\x32\x95" +
+	"\x47\x76\x0c\xd6\xb9\xae\x13\x51\xa5\x11\x4f\x38\xcc\x6c\xf3\x46\xfd\x46\xbf\x9f" +
+	"\xbb\xc8\xcd\xd7\xeb\x2c\xd3\x5a\x8e\xb9\xe6\xf8\x7f\x17\xaa\x15\x21\xf4\x76\xab" +
+	"\x20\xc3\x5b\x1d\x1b\xa8\x75\x9c\xfc\x74\x02\xf4\xeb\xf7\x2b\xe4\x29\x21\x0d\xc8" +
+	"\xd3\xa7\xfb\xe6\x49\x3a\x2e\x46\x5a\xc0\x4f\x01\xd9\x8c\x41\x0c\x5d\x42\xc6\x10" +
+	"\x77\xda\x50\x46\xfb\x83\x79\x0a\xac\xd1\x92\xa6\xe7\x59\xbc\xf7\x3d\xa0\x05\x3c" +
+	"\x5d\x22\x3b\x4a\xca\x42\xed\x3a\x90\x7d\x90\x8c\xfa\x79\x2d\xa3\xdd\xd0\xd2\xac" +
+	"\x08\xd1\x39\xe4\x99\xd8\x1a\xfa\xcb\xef\xe1\xba\x86\xbc\xd8\xb6\x22\x2f\x66\x87" +
+	"\x06\x45\x8d\xf5\x4e\xd3\x4c\x0d\xa8\x71\x27\x49\x5e\xeb\x95\xd1\xd1\x45\x1a\x61" +
+	"\xa2\xe3\x26\x34\x65\x24\xe6\xa0\xce\x47\x17\xc4\x75\xa4\x7e\x31\x6e\x03\x85\xbc" +
+	"\x3f\x92\x14\xc4\x1d\xb0\xe8\xe5\xfd\x01\x4f\xdd\xc8\x1c\x32\x96\x94\xcb\x96\x0b" +
+	"\x68\x28\x9d\x53\x5d\xde\x77\x37\xa9\xe5\x31\x3b\x63\xee\xa0\xbc\x3d\x61\xcd\x24" +
+	"\xb6\xc1\x3f\x17\xca\xc2\x76\x12\xa6\x4e\xde\x77\x77\xff\x95\x77\xf3\x34\xa6\xa7" +
+	"\x1a\xa4\xa1\x70\x26\x9f\x3e\x19\x66\x66\x13\x72\x6e\x77\x8a\x6b\x00\x92\xf6\x7d" +
+	"\x3f\x69\x1c\x77\x34\x25\xbf\xf0\x54\x9b\xee\x81\xd5\x52\xba\xff\xe2\x8c\x1f\xc2" +
+	"\xdb\x9c\xd1\x1d\x6d\x02\xe1\x2a\x42\x5b\xfb\x24\xc1\x88\xc9\xfb\x49\xb8\xe1\x9b" +
+	"\xaa\x15\x94\x82\xb6\x94\xf0\x34\xfc\x93\xed\x67\x9a\xa5\x41\x44\xea\xac\x07\x59" +
+	"\x99\xa8\x6c\xd8\xef\x8e\xdc\xef\x9f\xa1\x4c\x64\x0e\x94\xfe\xbd\xba\xec\x2a\x6f" +
+	"\x37\xf5\x1f\xd6\xcf\x23\xaa\x6b\xa4\x1b\x6e\xeb\x5d\x5b\x93\xec\xdc\x85\xef\xe9" +
+	"\x9f\x74\x84\x59\x9f\xab\xdb\xd5\xf8\xdf\x85\xff\xa4\x8f\xfa\xf8\xfb\xd1\xf7\xe7" +
+	"\x81\x3d\x2f\xd6\x0c\x7d\x7c\x92\x5c\x41\x3d\xef\xa7\x5c\x26\x40\x61\xe2\x42\x3d" +
+	"\x0f\xa7\x92\x8c\x05\xcf\x74\x97\xb9\xc5\xf1\xa9\xc6\x56\xe6\x0f\xd5\x0b\x6e\x85" +
+	"\xee\x09\x32\x49\x96\x10\x32\xa7\xfb\xd7\xa6\xfd\xf2\x41\xd6\x2d\x60\xfc\x33\xd8" +
+	"\xf7\x93\xc5\xc3\x1f\x89\xaf\xb5\x96\x3e\xc8\x8b\xad\x69\x39\xee\xb2\x84\xbb\xc3" +
+	"\x06\xc3\xd6\x21\x75\xf8\x64\xca\x3b\x9e\xeb\x04\xcb\xc3\xfb\xb3\x99\xd4\xf8\x2b" +
+	"\xd7\xf2\x0d\x2f\x46\xcd\x57\xc7\x8d\x00\xd6\x07\xfe\x92\x16\x55\x01\xbb\x60\xc9" +
+	"\xe0\xc1\x3c\xfe\xc0\x14\x03\x73\x58\xe0\xfc\xf7\x99\x0e\x24\x1b\x5e\x11\x19\x11" +
+	"\x13\x77\x2f\x1f\xcc\xf1\xfc\xa5\x62\x8e\xa8\xc8\x11\xcf\x8f\x29\x50\x3e\xe2\xd9" +
+	"\x49\xaa\x1f\xa9\x5c\x3e\x62\xdb\xb1\x83\x43\xcc\xd7\xdd\xf1\xfb\x07\x7f\x2b\xc3" +
+	"\x68\xa7\x47\xcf\xcb\x78\x28\x9f\x8d\x24\x96\xce\x90\x8f\x7f\x02\x31\x72\x71\xcc" +
+	"\xb7\xfb\x80\x7f\xae\x78\x28\x9e\xe3\x7c\x38\x1e\x04\xfa\xc3\xa6\x4c\xef\x46\xc8" +
+	"\x31\x2f\x7d\xe2\x58\x8f\x00\x01\xed\x20\xc6\xb8\xc8\xf0\x9b\x6b\xf1\x1f\xc8\x7f" +
+	"\x03\x29\x72\x6c\x67\xa0\xd4\x9b\x36\x80\x5b\x92\xe7\x43\x81\x43\x18\xa9\xc7\xbf" +
+	"\x01\x36\xf2\xf1\x31\x57\x9b\xfd\xde\x19\xbd\x37\xfd\x5d\xa8\x1e\x9f\x70\x04\xc2" +
+	"\x9f\xf1\xf1\xb9\xe8\x8e\xe1\x8a\xd7\xbf\xd2\xeb\xed\x3e\xcc\x2e\xc6\x3d\x9a\x0f" +
+	"\x73\x10\xed\x05\xde\xce\xa2\xf9\xec\xc7\x54\xf3\x47\xc6\xfa\x77\x95\xfe\xbd\x63" +
+	"\x2d\x3e\x1e\x9e\x3c\xdc\xad\x98\x43\x6b\x83\x01\x13\x20\xfd\x21\xbe\x97\xbc\x7c" +
+	"\x79\x46\x21\x1a\x09\x5f\xa7\xe7\x7a\x0f\xa2\x63\x4e\x9f\xf6\xc4\x7d\x68\xcf\xcb" +
+	"\xe7\xc6\xf3\x8e\x99\xc1\xc5\xf6\x7f\xe8\x72\xe4\xcf\x9c\xd3\x7e\xb0\x6f\x72\xd4" +
+	"\x0d\xfd\x7d\xf9\xdb\x47\x23\x88\xb8\x0a\x3e\xa7\x70\x25\x47\xa9\x9f\xb2\x6f\x63" +
+	"\x30\xdd\xcc\x13\x3b\x79\x03\xf7\x0f\xdc\x13\xbb\x23\xbe\x02\x0e\xdc\xa6\xfb\x39" +
+	"\xb9\x4d\x28\x5f\x7d\xe0\x5f\xfa\x59\x9a\x17\xd6\xce\x7b\xee\x27\x1d\xb7\x52\x9f" +
+	"\x8b\x9e\x71\x2d\x9a\x0f\x9c\x17\x7d\xf2\x8c\x87\x6f\xc3\x49\xdf\xee\xc7\x97\xcd" +
+	"\x1e\x9f\x9e\x4f\xcf\x88\xaf\x0c\xf4\x87\x37\x52\xb7\x84\xc6\x86\xd0\xa7\x47\xa4" +
+	"\x6d\x7c\x5e\x24\x69\x48\x32\xbf\xcb\x4c\x33\x1f\x30\x2f\x3f\x37\xff\xf3\xae\xa7" +
+	"\x33\xc5\x3d\x8a\xf1\x05\xeb\x4b\xd3\xf1\x67\x45\x9b\x0f\x59\x7b\x52\xd8\xcf\x34" +
+	"\x7c\x9d\x3a\xd2\xcf\x1a\xff\x7a\x3d\xa6\x8a\x9f\x1b\xee\xe8\x92\xfc\x36\xbd\xdd" +
+	"\x37\xd8\x44\xfa\x2d\xb4\x73\xf3\xbd\x20\xfb\x95\x72\x61\x6a\x95\x1d\xf5\xe1\xdd" +
+	"\xcc\xa0\xb5\x34\x4d\x40\xbe\x3f\x13\x1d\x2e\xe4\x25\x2b\x1f\xb8\x0c\xfc\x50\xdd" +
+	"\x61\xcb\x6c\x13\xca\x33\xb3\x0e\xb6\xbc\x7b\xce\x23\xfb\x44\x84\xe5\x82\x13\xaf" +
+	"\x8e\x3b\xe5\x20\x71\x2b\xf5\x6f\xe2\x41\x69\xb9\x22\x86\xe7\x4e\xe9\x31\x1f\x3e" +
+	"\x1b\x48\x43\xf1\xa6\xfe\xcb\x9a\xdc\x05\x2b\x6a\x48\xe8\x42\xa8\x04\x76\x94\x9c" +
+	"\x0d\x8e\x71\x53\x7b\x85\xa5\xf0\xb9\x7c\xb3\x22\x9a\xff\x0f\xda\x3b\x28\xdd\xbf" +
+	"\xe7\x1a\xef\xdd\xc9\x41\x04\x40\xc6\xa8\x33\xa2\xad\x44\xb9\x88\xd3\xd0\xcd\xbb" +
+	"\xb6\xd9\x59\xc6\xe4\xfe\x99\xf2\x7f\xae\xee\xe9\x90\x6a\x7e\x8d\xf9\x33\x21\xbf" +
+	"\xde\x89\xad\x23\x47\x8d\x7c\x6c\x44\x11\x84\x70\x21\x36\x4d\x3e\x0e\xd7\xb6\xc9" +
+	"\xc7\x31\x70\xc4\xf0\x01\x14\x59\x3e\xcb\x80\xa3\xf9\x03\xad\xc6\x7f\x61\x0c\xf2" +
+	"\x51\xce\xcf\x3a\x7b\x10\xe1\xc2\x47\xe7\x0d\xd5\x5f\xe0\xf3\x59\x53\xff\xb9\x8d" +
+	"\xbb\xb1\xde\x51\xec\x26\x23\x54\x80\xc0\x8a\xfe\xa6\x6d\xaa\x9f\xe1\xd8\xf0\x15" +
+	"\x8f\x96\x05\xbe\x27\xe1\x07\xfe\x83\xdb\x7d\x21\xf6\x20\xf8\x9f\x59\x93\x33\x89" +
+	"\x55\xab\xb1\x98\x59\x55\xda\xc7\x50\x0f\x6c\x16\xaa\x1f\x34\x7f\xa0\x97\x90\x4f" +
+	"\x35\x62\xfb\x8c\xf0\xbf\x89\xa1\x95\xed\xdb\xbb\x8a\x41\x95\x5e\xf6\x23\xc7\x49" +
+	"\x08\x63\xe6\xfb\x47\xc2\xca\x80\xca\x39\x04\x8d\xbf\xf4\x41\x6a\x0e\x74\x13\xb7" +
+	"\x9b\x6a\x5a\x6a\xe3\xf1\x4f\xe3\xfe\x5d\x79\xed\xc6\x03\xb9\x8a\x9c\x33\x73\x85" +
+	"\x26\xdd\xdd\x66\x12\x90\x3b\xb5\x01\xd2\xee\xaf\xdb\xc6\xf9\x4f\x3e\x7d\xc7\xcf" +
+	"\x55\xf5\xe9\xb3\xe1\x93\x77\xba\xcd\xc8\x33\x1a\x96\xda\xe3\x1f\x52\x2a\xd6\x7c" +
+	"\xc6\xb1\x2d\x2b\x4c\x8d\x6f\xc6\x61\xfb\x69\x2f\xa0\xe4\x3a\xa5\xd9\x13\x41\xf3" +
+	"\x0d\x5b\xd3\xbb\x82\xcb\x7e\x83\xe3\xb0\x09\xa2\xa7\xca\xb4\x6e\xc9\x5f\x9f\x34" +
+	"\xf7\xc1\xfc\xc1\xf6\xfa\xe2\x32\x4e\xfe\x0c\x71\x25\x6f\x34\xfa\xfd\x0c\xfc\xdc" +
+	"\xdc\x70\xdc\xf5\xd9\x11\xeb\xc4\xe5\x9f\xab\xcb\xa1\xbd\xbc\x2f\x37\xd6\x26\x9f" +
+	"\x8b\x3b\x0f\xa2\xbc\xcf\x8d\x1f\x03\x47\x92\x75\x9d\xb0\x9b\x1b\x3b\x6e\xff\x20" +
+	"\x7f\x83\x9a\x96\x63\xf8\xab\x57\xf7\x32\x64\x17\xf8\xda\xb5\xe1\x75\x5f\xdd\xef" +
+	"\xd1\x3e\x6d\x81\x4d\xfe\x5d\xa8\x61\xff\x35\xcc\x20\xfb\xe8\xba\xc8\x77\xcb\xc8" +
+	"\x09\xc7\x3f\xd0\xb3\xbf\x91\x6f\x38\x54\xdc\x39\x60\xf3\x6d\x58\x2f\x38\x41\xf7" +
+	"\x77\x66\x7a\xf4\x0e\xd2\x39\x5f\xc2\x96\x52\xf2\xd1\x3e\xeb\xee\xe7\x4a\xed\x87" +
+	"\x62\xc5\x61\xf3\x54\xc7\x9d\xf9\xfc\x01\x5e\x62\x9e\x74\xe6\x3c\xdc\xb6\xda\x53" +
+	"\x4b\xe6\xd7\xe3\x90\x1f\xa2\x1b\xcc\x77\x92\x7d\x74\xdb\x2f\xc8\x81\x68\x26\x56" +
+	"\x50\xf6\xcc\x82\xd6\xd1\x15\x34\xe8\x5b\x89\x30\x5f\x9c\xaa\xfe\xe2\x45\x10\xdd" +
+	"\xa8\x51\xb7\x6f\xdc\xd7\xfa\xcf\xdd\x92\x17\xb4\x94\x4f\xa2\xc1\xf9\x0f\xdb\x8c" +
+	"\xfe\x9d\xcb\xbd\xc9\x15\xf4\xdc\xb6\xb1\x51\xeb\x7c\xa3\x4b\xef\x83\xac\xb0\x25" +
+	"\xaf\xb1\x4d\xb6\x5a\x52\x62\x40\xa0\x97\x6b\xfe\xec\x88\xeb\xab\xc1\x25\x47\xdb" +
+	"\x63\xda\x98\x14\x6b\x91\x2d\x21\x09\x70\xc4\x94\x20\x16\x58\xae\xac\xfe\x56\x06" +
+	"\x76\x1f\xc7\x94\xbd\x2e\x78\xd1\x4e\xc9\xd7\xff\x8a\xff\x5a\xff\x6c\xd5\xdc\x43" +
+	"\xc3\x61\xe2\x37\x1b\x9e\x27\xf8\x6a\xe0\xbc\xd8\x7b\x57\xfc\x32\xcc\x3e\xfd\xd6" +
+	"\x9a\x3e\x3b\x60\x30\x2e\x27\xfe\xae\xfe\xb6\x07\xba\xc2\x6b\xd0\xe7\xa6\xbb\xe3" +
+	"\xe4\x31\x78\x3e\xf1\xf3\x77\xad\xb8\xae\x48\xf7\x43\x85\x79\x69\x62\xdb\xf8\x3e" +
+	"\xe6\x83\x55\x47\x9f\x1c\x6b\x45\xcc\xd2\x03\xfe\x52\x8d\x1b\x99\x9b\x2b\x36\x86" +
+	"\xdc\x4a\xc3\x57\xbf\xd2\x3a\xac\xda\xdc\x93\x1c\xb2\xe4\xfb\xab\xf6\xdb\x51\x43" +
+	"\x66\x73\x5e\x29\xe9\x2b\x4a\x5d\x10\xb9\x46\xdc\x8c\xb4\x26\xfa\x80\x55\x0a\x98" +
+	"\xe3\x5b\x09\x26\xa4\x17\x7c\x11\x73\x4c\x88\xe0\x33\x59\xf9\xc6\xef\x6f\x0e\x5f" +
+	"\x31\x6f\x0a\x82\x23\x00\x43\x49\x49\x7a\xc2\x86\x8b\x42\x9c\x56\x96\x9a\x52\xf8" +
+	"\x42\xfb\xf7\x26\xe4\x77\x65\xe0\x2f\x22\xd0\x7c\x49\x60\x91\x53\x05\x62\x2b\x3d" +
+	"\xfd\x01\xf2\x22\xe1\xd5\x5f\x58\x53\x02\x10\x29\xde\x84\xaf\xf8\x96\x4c\xea\x2b" +
+	"\xe2\x9b\xf0\x75\x53\x55\xe1\xf7\xf7\x0a\x5f\x84\xfe\xe3\x4f\x9a\x85\xe9\x55\x5f" +
+	"\xe9\x8b\x95\xfb\x2b\x6d\xdb\xf5\x80\x57\xfb\x95\x6a\xda\x73\x24\x47\xc5\xc4\x06" +
+	"\xc2\x4f\x65\x38\xf7\xa5\x48\x56\xe5\xf8\x00\x95\xc4\x56\x03\x00\x33\xe8\x2b\x3f" +
+	"\x9f\x57\xf8\xca\x78\x1d\x15\xd9\x92\x13\x32\x6c\x5d\x7f\x94\x19\x35\x99\x2f\x36" +
+	"\x4e\xac\x1e\x0e\xb8\x7d\xd4\xfc\xca\x6d\x8d\xe1\x4b\x86\x02\x30\x64\xbf\xdc\x64" +
+	"\xe0\xcb\xc2\x6c\x7e\xe1\x6d\x52\xd6\xab\x5f\x65\xc2\xea\xeb\x4b\x5b\x93\x7a\x53" +
+	"\xc6\x3e\xf0\x98\xc3\x57\x99\xd7\x73\xe8\xa7\xd4\x21\xe0\xd7\xfe\xb8\xc4\x13\xc7" +
+	"\xd1\x13\x75\xb4\xc7\x96\x97\x99\x0c\x7d\x61\xdf\xa6\xdf\x1f\xff\x8d\xbc\x85\xaf" +
+	"\x42\xa7\x90\x99\xa0\x11\x6e\xe1\x11\x4b\x7f\xd5\x82\x98\xc6\x7e\x95\xf7\x46\x3e" +
+	"\x14\xac\x45\x19\xd0\x50\x98\x83\xaf\xf0\x35\x1e\xb7\x0f\xeb\xbf\x4d\xda\x07\xf1" +
+	"\x25\x07\x0d\xa6\x58\x57\x5f\x83\x9a\x56\x92\xec\xa5\xc6\xcd\x81\xfc\x1a\x5f\xb9" +
+	"\xb7\x11\xbe\xc6\x4b\x9c\xcb\xaf\xb1\xa5\x33\x7c\x0d\xce\xfd\x7c\xc3\xb1\x9f\x6d" +
+	"\xd0\x25\xc7\x31\x15\xfd\x95\x0f\xaa\xaf\x71\xa0\x97\x0d\xc9\x49\x44\x9a\x27\x5f" +
+	"\x84\x7f\x0b\x5f\xa3\x66\x4e\x10\x9b\x10\xef\x05\x39\xab\x33\x24\xbe\xc6\x4f\x8f" +
+	"\x2c\x58\x7f\x68\xfe\x40\xdb\xc6\xbd\xe2\xb3\x47\x48\x46\x51\xf7\x15\xb7\x94\x67" +
+	"\x97\x98\xfc\x73\x91\xa7\xf0\x8a\x7b\x7e\xc5\xdf\x14\x5e\xf1\x1c\x3d\xbc\x62\xbf" +
+	"\xe6\x3c\x87\x57\xbc\xe2\x2b\xbc\x52\xda\x56\x02\x9a\xbf\x30\xe1\xa7\xa0\xb4\x9d" +
+	"\xe1\xc5\x8b\x6d\x2d\xbc\x52\x55\x58\x64\xc0\x93\xd0\x37\x29\xbc\xf0\x05\xc4\x5f" +
+	"\xf8\xe3\x75\xec\xe1\x95\xae\x33\xce\x22\xf0\xe0\x01\x2e\xc8\x7f\xc9\xa9\x68\x78" +
+	"\xe5\x07\xaa\xff\x2f\xec\xd3\xb1\xc9\x09\xaf\x9c\xb6\x47\x6c\x31\xe0\x5c\xfe\xab" +
+	"\x5c\xd0\x83\x3f\x5d\x7f\xa8\x44\xde\xcb\x51\xc2\x2b\x1f\xb7\x2a\xe7\x2b\x9b\x31" +
+	"\x0e\x16\x28\x98\x3a\x2b\x93\x34\x7a\x48\x41\x7f\xe8\x95\x8f\x17\x45\x1d\x0d\xdb" +
+	"\x49\x01\xd8\xfb\x50\x93\xea\xbc\x64\xf6\x1a\x5e\xb9\xed\x89\x27\xf5\x86\x6a\xdd" +
+	"\x8b\x3e\x8e\xc2\x7d\x78\x6d\xe9\xec\xe4\xcc\xf1\x5f\x3c\x85\xd7\x11\xcf\x16\xa7" +
+	"\x17\xe0\x3b\xd9\xdf\x7a\x85\xd7\x81\xf6\x90\xfe\x26\xd1\x84\xc4\xe3\x85\x3d\x7a" +
+	"\xaa\x0f\xb4\x76\x6b\xbb\x2f\x1d\xbc\x0e\x2b\x86\xd3\x15\xf2\x35\x87\xbc\xc5\x51" +
+	"\xd0\xdd\x7b\x1d\x04\x7b\x45\x7e\x4e\xca\xf4\xfa\x80\xb7\x22\xd5\xbe\x0e\xbc\xb0" +
+	"\xbe\x0e\x84\x47\x1b\x35\x91\x23\xdd\xd7\x51\xde\x9a\xc2\x5f\x87\x24\x7f\x88\x98" +
+	"\xc2\xab\xac\x5b\x8d\x8f\x1c\x5e\xa8\xd8\xf2\xe7\x0a\xaf\xf2\xee\xe5\x1d\x5e\x83" +
+	"\xd3\xc6\x6b\xec\xff\x8d\xd8\xc3\x16\x15\x73\x91\x05\xc6\xe7\xea\x2d\x12\xcc\x79" +
+	"\xa7\xf7\x6d\x4c\xcf\x73\xcc\xa6\xbb\xce\x55\xba\x44\x36\x33\xfb\xdf\xe4\x00\x77" +
+	"\xba\xa9\xc4\xf4\x1b\x9b\x95\xae\xdf\x76\x2f\x63\x1c\x31\xa0\x7e\x9d\xb9\x42\xaf" +
+	"\x52\x7f\x67\x2e\x2e\x4d\xde\x80\x27\xe7\x3b\x8a\x9c\x2f\xe9\xf1\x02\xa4\xe2\xba" +
+	"\xb9\x82\xda\x16\x97\x23\xcb\x28\x6d\x8b\xcc\x35\x18\x86\xa3\xae\x2a\x20\xbb\x44" +
+	"\xcc\xc2\x0f\x5b\x72\x1d\xfa\x0b\x39\xef\xe2\x06\x3c\x79\x3f\x1f\xbc\x88\x10\x2a" +
+	"\x47\x50\xcc\x1e\xf9\xe9\x11\x33\x7f\x51\x22\x15\xa1\x95\x8e\x99\xe3\xb6\xc0\xc6" +
+	"\xc7\xa5\x8c\x63\xde\x86\xa6\x46\xe0\xce\xea\x08\x80\x1d\x60\x69\xcb\x70\x52\x3e" +
+	"\x94\x19\x48\x26\x27\xfc\xe1\x1d\x14\x06\x2c\x98\x13\xfe\x4a\xc2\xf9\x66\x7a\x53" +
+	"\x44\x5c\xe6\x8b\x2d\xe2\xad\x81\x1b\x28\x1e\xe0\x90\x56\x65\xd7\x85\xa9\x89\x59" +
+	"\x57\xdf\xb0\xbe\xda\xa9\x87\xd5\x6f\x9a\xba\x7e\x47\xde\xa3\x5e\xc7\x4f\xd8\xd8" +
+	"\xf7\x18\x87\x98\x05\xdc\xdc\x4f\x81\x4a\xc0\x2e\x7e\xc5\x78\x9a\x2d\xb0\xc6\xd9" +
+	"\x86\x53\x14\xd2\x91\xeb\x1b\x49\xd4\xa1\xf3\x33\x19\x21\x6c\x58\x27\x1c\x94\xd0" +
+	"\xb5\xc3\xdd\xe2\x98\xbd\xc5\xc7\xcc\x76\x99\xd2\xc6\x82\xa3\xb0\xed\x0f\xb6\xce" +
+	"\xe9\x60\x8b\x32\xf7\xbe\xa9\x4a\x96\x90\x4e\x4f\x26\xa8\x85\x7e\xf2\xbe\x05\x43" +
+	"\x5b\x7c\x2f\x19\x81\xbe\x1b\xde\xdf\xd4\xab\xfa\xde\xef\x5c\xc7\x2e\x71\xef\x16" +
+	"\xdd\xa3\xc1\x16\xe5\xc9\x60\x8b\x97\x0d\x26\x00\xaa\x1c\x5b\xbc\xe0\x0b\x86\x2d" +
+	"\xfe\x5e\xac\xd9\x2d\x60\xef\x4d\x91\x80\x97\x99\x46\x23\x3b\x3d\x3c\xe9\x7c\xd6" +
+	"\xb2\x00\x9a\x19\x20\x12\xf0\x1a\xdd\x15\x42\x4c\x3a\x3e\xb0\x0f\x77\x7c\x91\x95" +
+	"\xad\xfc\x86\x4b\xd9\xea\x44\x4d\x2f\xc4\xfd\x39\xd5\xb5\x10\x8c\x66\x4b\x26\xfd" +
+	"\x17\xe5\xce\x42\xe0\x4d\xca\xc5\x21\x6b\xdb\x05\x7a\xb2\x04\xeb\xc8\x24\x14\x48" +
+	"\xaa\x73\xda\x23\x1d\x27\x2d\x28\xfb\xf2\x00\x54\x89\x6f\xa9\xd3\x2d\x73\x82\x12" +
+	"\xf1\x98\xdd\xee\x86\x16\x43\x51\xb1\xb8\xdf\xda\x52\xee\x7b\xe9\xf9\x19\xb6\xa4" +
+	"\xd8\xc2\x50\xfa\x50\x3a\x96\x8e\xaa\x5c\xbb\x21\x5e\xb5\x36\xcc\x67\x58\x86\x37" +
+	"\xb6\x9f\xd3\x1a\x07\xcf\x3e\x6b\x69\x24\xb5\x47\x8e\xd2\xb1\xa2\x29\x55\x63\x1d" +
+	"\x32\xb7\xd4\xd7\x58\x97\x3c\xdd\x88\xbc\xdf\xba\xef\x3a\xde\x0e\xec\xe5\x14\xac" +
+	"\x05\x9f\x08\x9a\x6c\x72\xd4\x73\xb3\x33\x55\xb7\x8c\x95\x1d\x2d\x89\x10\xcd\xdd" +
+	"\xd5\x92\x28\xb9\x08\xa9\x79\x36\x3d\x2d\xc1\x42\x0a\xdf\x09\x00\xcf\xdb\x06\x40" +
+	"\x9e\x90\x7f\x62\xca\x98\x78\x56\x7e\x26\x7c\x8f\xcf\x42\x36\xeb\x08\x1c\x06\xaa" +
+	"\xfe\xb6\xa8\x2e\x03\xc2\x01\x33\x49\x3a\x07\xa0\xfd\xa0\x95\x5e\xfa\x3b\x2e\xd8" +
+	"\xbf\xb1\xbc\x31\xe9\xc2\xe6\xb0\xfc\x32\x21\xcf\x86\x9a\xf3\xb1\x84\x0d\x81\x1e" +
+	"\xa5\xee\xf8\xec\x7b\x01\x28\x1a\x79\x8d\x48\xa6\x4e\xc7\xb4\xb2\xb4\xb1\x06\x72" +
+	"\x58\x16\xa5\x65\x0e\x62\x92\x1d\x5d\x08\xa1\xb7\xaa\x77\x2c\x23\x42\xf2\x7d\xeb" +
+	"\xe5\xbf\x29\x1b\x61\xb7\x37\x78\x30\xaa\x13\x1c\x84\xb0\xe5\x72\xfc\x55\x53\x6a" +
+	"\x98\x61\x13\xaf\x65\xcb\x67\x69\xf0\x7e\xd5\xe8\xff\x8d\xf4\xa4\xa1\xfe\x1b\x28" +
+	"\x4b\x42\xed\xbc\x77\x23\x7d\x39\xb8\xb5\xf4\x28\xa9\xb9\xb7\xf6\x01\x8c\x66\xce" +
+	"\x0f\x22\xcc\x2b\xb9\xaf\x48\x69\xcf\xf5\x1f\xa8\x9b\x68\x94\x74\x07\x74\x8b\xdc" +
+	"\xfd\x58\xb4\xe5\x8e\x3c\x15\x8f\x14\xee\x04\x07\x58\x38\x01\x6f\xb8\xf4\x5f\xbc" +
+	"\xb3\x00\x29\x49\x4b\xf3\x96\xbf\x79\x91\x12\x77\xfd\xb9\xf8\x7b\xbc\x0b\xb3\x69" +
+	"\x89\x7d\xb5\xca\x96\x87\x45\x58\x21\x84\x38\x4b\x12\x93\x7a\x17\xf9\x8a\x6f\x68" +
+	"\x61\x74\xf0\x7a\x85\x48\x7a\x01\x31\x18\xe1\xe4\x1c\xb0\xe3\xdf\xca\xf2\x60\x79" +
+	"\xdd\xe4\xed\x9f\x1e\x20\x98\x23\xe4\x4b\x4f\x47\xf8\x81\x4a\xf4\x56\x38\x14\x39" +
+	"\xd1\x60\x63\x29\xdf\xd0\x50\xa2\x56\x38\xcd\x29\xa7\xc0\xb5\x44\xee\xa2\xe8\xc6" +
+	"\xd4\x2e\x40\x7d\x30\x76\x3a\xc8\x7b\x2c\x52\x4f\x05\xb0\x6f\x81\x62\xa1\x8f\xe5" +
+	"\x5a\xd8\x4a\x79\x46\xd2\xca\x2b\x5a\x7f\x2e\x68\x32\x62\xeb\xe1\xbe\xbe\xb6\xa2" +
+	"\x80\x78\x10\xeb\x99\xa5\xe2\xf7\x98\x46\x2f\xd8\xa6\x6c\x85\x31\x9b\x4b\xd8\xca" +
+	"\x98\xf7\x32\x54\x77\x5c\xcf\x55\x28\x23\xb2\x0c\x8d\x87\xf2\x9d\x6c\xed\x46\x23" +
+	"\x44\xd3\x25\xc0\x6a\xf6\x2d\x6d\xa7\xb0\x95\xb7\x1c\xda\x40\xd1\x85\xdb\xca\x5b" +
+	"\xde\x9e\xb7\xf2\x66\x14\x85\xad\xfc\xe2\x1e\x36\x6c\xe3\xe1\xce\xde\x6e\xa4\x6e" +
+	"\x85\x43\x64\xfb\xab\x89\x01\x40\xcd\x87\x3b\xb7\xda\xc6\xc4\xe0\x1b\x0b\xbf\x51" +
+	"\x5f\x19\xfb\x23\x2e\xc5\x68\x15\xf1\xf8\xa4\x06\xb5\xff\x96\x90\x3d\xf2\xa2\x00" +
+	"\x3f\xfd\xbb\x2f\x91\x4d\x36\xc7\xdb\x38\xe0\x8d\x6c\x6c\x67\x53\xf1\xb6\x19\xa7" +
+	"\x66\x99\xa1\x45\x98\xbf\x0c\x25\x36\xf1\xaa\x91\x6d\x86\x38\x99\xf8\x65\xb5\xfa" +
+	"\x60\xa4\xbb\x8d\x9f\xc1\x64\xd5\x6f\x44\x53\x5f\x93\xc6\xe4\xc5\x8e\x59\x7f\x29" +
+	"\x0e\xaa\xae\x71\xd5\x14\xf6\x38\xc5\x39\xee\xa2\x04\x87\x81\xbe\xb9\x4a\x62\x5f" +
+	"\xec\xf2\x14\x6c\x0d\xf5\x0f\x6e\x7e\xc1\xe1\x6a\xd7\xcc\x90\x36\x92\x5e\x70\x94" +
+	"\xda\x0a\xac\x78\x8d\x80\x96\x47\x56\x98\x26\xe1\xa9\x34\x9e\x35\xb3\x87\xdd\xa3" +
+	"\xdb\x7f\xec\x71\xae\x91\x22\x67\x8c\xd1\x29\x28\x6d\x6c\xee\xc9\x99\x00\x61\x8f" +
+	"\xcb\x52\xfa\x05\xd5\xf9\x81\xad\x24\x8e\xb4\x6f\x0f\xac\x37\xa6\x39\xfe\xc1\x9b" +
+	"\xdd\x49\xe8\x96\x08\x11\x81\x2e\xec\x11\xa5\xf4\x2f\x03\x7d\x87\x22\xb3\xd5\xb8" +
+	"\x32\xa8\xdf\xe5\xed\x11\x97\xb0\x47\xdc\x14\x95\xbd\x18\xd2\x57\xda\x23\x9b\x46" +
+	"\x91\xac\xea\x6f\x11\x21\x30\x0b\xd3\x2e\xff\x77\x88\x51\x49\x4e\xe2\x71\xde\xc0" +
+	"\xda\x70\x4b\x72\xb1\x57\x75\xff\xdb\xd9\xcd\x1f\xdc\x49\xc6\x69\xc4\xdd\xe4\xba" +
+	"\x48\x33\xc9\xb6\x71\xbd\x81\x41\x36\x6a\xa2\x36\xed\xef\x71\x23\xce\x99\x35\xc3" +
+	"\xbe\xd3\xff\xa0\x4c\x86\xd4\xed\x88\x28\xc0\x38\x25\x0b\x96\xaf\x99\xc7\x1c\x16" +
+	"\x36\x62\x37\xe6\x05\x04\xc9\x26\x37\x98\x0d\xec\x47\x3a\x0c\x90\xc2\x4a\x06\x85" +
+	"\x5b\xc1\x41\x06\x1f\xbc\x1f\x30\xdf\xc8\xfa\xc6\x81\x0a\x27\x49\x07\xed\x8c\x0d" +
+	"\x8c\x1a\xf7\xd0\x59\x68\x8f\xa8\x07\xea\x5b\x1e\x0d\xdf\xcf\x56\x20\xdc\x71\xe5" +
+	"\x46\x77\x78\x8f\xb8\x7e\xd0\xdb\x1a\xb8\x93\xe8\xc2\x58\xa0\xf9\xd0\xd9\x63\xfd" +
+	"\x22\xee\x13\x77\x5f\xa9\x47\x17\x5f\x71\xf5\xb1\xe1\xde\x63\x65\x17\xbc\x23\x88" +
+	"\x41\xcd\x5b\x40\x84\x18\xb6\x6a\xac\x8a\xd4\x02\xda\xc6\x69\xbd\x4c\x0b\x7f\x77" +
+	"\x90\xa1\xbf\x99\x05\x7a\x8f\xad\xe0\x84\x7a\xff\xa0\xfe\x87\xd4\xdf\x5b\x63\x31" +
+	"\x63\xc3\x9e\x10\x2c\x99\xd1\xdb\x8e\x5e\xfc\x1f\x4c\xf7\x85\x9f\x66\xb9\xe3\x3d" +
+	"\xb1\xf5\xfc\x7c\x8a\x16\x6c\x9e\xf6\xd8\xe3\x5c\x28\x0f\xab\x03\xd6\x03\x47\x3f" +
+	"\x96\xc4\x9e\xc8\x90\xed\x57\x05\x8d\x41\xb1\xe3\x41\x09\xcb\xce\x9d\x5d\xb5\x54" +
+	"\x8b\x3f\x28\x7a\xaa\xcb\x84\x94\xbe\x97\x23\x7b\x7a\xc1\x4c\x79\x8f\x5d\x1b\xeb" +
+	"\x3d\x0e\x4e\xca\x61\xf7\x0d\xf6\x1e\xdf\x30\x73\xc2\x1e\x7f\xfc\x93\x5d\x68\xff" +
+	"\xed\xf1\x92\x2b\xae\x3d\xc5\xb9\xbc\xb7\x58\x5f\x61\x4f\xd8\xc6\x30\x23\xa4\x48" +
+	"\x8e\x14\x31\x93\x26\x46\xa1\x5d\xf1\xa5\xd2\x34\xc5\xb0\xa3\x1f\xcf\x45\xaa\x8d" +
+	"\xed\xdf\x0e\x64\x2b\xe3\x53\x85\x36\xad\x7d\x35\xe0\xc7\xe8\x7f\xf0\xe4\x37\xce" +
+	"\x42\x60\x45\x30\xae\x0b\xf6\xc4\xb1\x88\x5f\x13\x30\x7c\x47\x41\x1e\x07\x4e\x83" +
+	"\x1c\x5b\x31\x86\xb0\xd4\x92\xc4\x96\xdc\xd3\x81\x23\x20\xd5\xef\x98\x7d\x3d\x73" +
+	"\xa8\x7a\xc2\xb7\x63\xa2\xdd\xd3\x21\x26\x00\x3b\xa1\x55\xdc\xe1\x39\x20\x82\x74" +
+	"\x21\x29\x30\x55\x4d\x3d\x30\x38\xe2\xd1\x59\x3a\x49\x65\x7a\xde\xc8\x2a\xd3\x6d" +
+	"\x2f\x1f\x1b\x7f\x78\x2c\xbb\x6c\x6e\x98\x8a\x25\x22\xc4\x3a\xf9\x72\x5c\xef\xac" +
+	"\xd5\x76\x5f\xa0\xcb\x83\xac\xec\xa9\xd1\x7a\x04\x0c\x85\x20\x40\xa4\x08\xb3\xdb" +
+	"\xdd\x53\x47\x67\xe4\xe4\x44\xf0\xef\x85\xda\xa8\xb3\x3b\xb2\xa3\xf7\xdf\x85\x6e" +
+	"\x50\x40\x4f\xa5\xd2\x9b\xcd\xa5\x89\x92\x57\x7c\x67\xeb\x10\x2e\x55\x7a\x79\x0b" +
+	"\xd0\x9e\x69\x17\xbf\x86\x2c\xec\xcb\xd8\xfe\xec\xe9\xf7\x97\x38\x94\x29\xec\x39" +
+	"\x36\xe6\xda\xcc\x8a\x90\x9c\x66\x53\xcb\xd2\x85\x2d\x0d\x20\xfc\xf9\x19\x62\xaa" +
+	"\x5a\xc8\x3c\xa3\x84\xbb\xe7\x79\x83\xb3\xb3\x67\x02\xc8\x3c\x49\x66\x58\xab\x55" +
+	"\xf3\xdc\x7a\x45\x7e\xb8\xe7\x19\x81\x0d\x37\x15\x14\x7a\xcf\x28\x1a\xb3\x70\xee" +
+	"\x39\x91\xf1\xf9\x64\xcc\xb1\x65\x66\x5d\x6e\xc0\xe3\x9e\x43\xa4\x8f\xc6\xd9\x72" +
+	"\x97\x23\xc2\x3d\x6f\xb3\xe7\xd9\x72\x1a\xe1\x0e\x98\xd9\xf6\x3f\xd8\x1d\xea\xe7" +
+	"\xdb\x8b\x05\xc4\x01\x0f\xdb\x5e\xad\x9c\xd0\x2d\x1d\x36\xa6\x80\xfa\x0d\xbb\xea" +
+	"\xfa\x01\x7a\xe4\x46\x88\x43\x01\x62\xa2\x09\xc8\x10\x2e\xec\x79\x77\x9b\x7f\x60" +
+	"\xe1\x4b\xb3\xbb\x60\x0a\x43\x69\x66\x4f\x51\x68\xbe\xfd\x52\xed\x14\x19\xbd\x6d" +
+	"\xd1\x16\x90\x43\x79\x14\x09\xdd\x69\x11\x90\x81\x21\xa2\xfc\x47\x79\x43\x68\xc5" +
+	"\x0d\x30\xac\xf0\x41\xf0\x07\x5e\xed\x18\x9d\xa1\x66\x54\x09\x3f\x61\xc7\xf8\xfd" +
+	"\x39\xc8\xdf\x3e\xf1\x94\xc1\x78\xb6\x2f\xe7\xbf\x98\x2e\x94\x1b\x6a\x49\x97\x83" +
+	"\x9a\x56\x0f\xab\xeb\xd7\xb7\x67\xe2\x3d\xb7\x07\x51\x3d\x70\xe4\xb2\xe7\xe6\xaa" +
+	"\x46\x3d\xfd\x7b\xe1\x3f\x9b\x24\xd2\x13\x60\xb5\xc1\x37\xf6\x92\x5c\x6c\x4a\xea" +
+	"\x7a\xd7\xc8\x63\xf2\x5a\x19\x35\x69\xf4\xe6\x36\x23\xc3\x14\x65\x26\x62\x6e\xcd" +
+	"\xcd\xf4\x49\x40\xea\x32\xcd\x16\x2c\xe2\xda\xb1\xc8\x41\xf5\xd3\x85\x03\xbe\xea" +
+	"\x70\x2f\x90\x78\x4d\x8c\x4c\x86\x08\x31\x58\xb5\x41\x5f\xc4\x20\xfa\x00\x3d\x0c" +
+	"\x39\x3d\xae\xc7\x99\x9e\xed\xf1\x07\x8a\x0b\x80\xb2\x5c\x12\xc3\x3a\x54\x3e\xec" +
+	"\xf6\xf4\xc4\x13\x0f\x1b\xc3\xdf\xe7\xbf\x51\xac\x41\xaa\x7d\x9d\xd6\xd4\xb7\x08" +
+	"\x94\x7e\x5a\xb3\x49\xa9\x87\x1b\x27\xaa\x02\x37\xb0\x07\xb6\x53\xb3\x47\x6e\x12" +
+	"\x52\xef\x3a\x46\xf5\xa2\x72\x24\xdf\xfb\x6b\xe0\x7e\xe9\x2b\xea\x59\x9d\x4a\x1e" +
+	"\xd8\x2c\xf1\x31\xcb\x83\x25\x2c\xec\x65\x9a\x62\x63\x4d\x28\xd3\x1a\xf9\xcb\x6c" +
+	"\xa4\x1d\xfb\xce\x34\x31\x76\x51\xda\xa5\xcc\xfe\x19\xcb\x42\x97\x29\x52\xac\xdf" +
+	"\x8b\xb4\x05\xf7\x02\xeb\x00\xdd\xce\xd6\x52\x0b\x7b\xd9\x12\x16\x28\x02\xe2\xb9" +
+	"\x29\x28\xc7\xf3\x22\x61\x1b\xed\x25\x7a\x4d\x45\xe6\xd3\x7b\xd9\x3a\x09\xea\x30" +
+	"\x65\x67\x96\x29\x07\xab\x9c\xda\xb2\xdc\xfb\x3f\x8c\x17\xb4\xa5\x03\x6c\xe6\x0e" +
+	"\x16\x3f\x97\xea\xf0\xe5\x90\xd9\x09\x0c\x61\xed\x91\xca\x51\x96\xb8\x6b\xba\x35" +
+	"\xc8\xa3\x0f\xdf\x48\x15\xf7\x41\xaf\x24\x5b\x8e\x1c\xa8\x65\x8e\x7b\x5d\x2a\x07" +
+	"\xc6\x0a\x8c\x3c\x10\x4b\x52\xff\x40\x96\x05\x05\x2c\xcc\x7a\x7a\x69\x17\xaa\xeb" +
+	"\xfe\x7b\xbb\xf0\x7a\x74\xcc\xbe\xec\xa9\x36\x19\x94\xa3\xfc\x20\x80\x20\xf6\x2e" +
+	"\x93\x17\xaf\x51\x08\x54\x46\xa6\x62\x5e\x91\xb5\x3b\xd2\x05\x8f\x2c\x07\xce\x14" +
+	"\xf8\x05\xe3\xa0\x7c\x50\x1d\xac\xea\xa5\x98\x15\xcf\x8e\x64\x6c\x57\x2c\x06\xd6" +
+	"\x42\xfb\x01\xae\x4e\x54\x57\xc5\xa1\xe7\x9a\x75\x48\x9f\x15\xa3\x8b\x1d\x0f\x70" +
+	"\x74\xa6\x82\x36\x3f\x9a\xbd\x0f\xec\x06\x70\x6a\xc1\x52\x23\xc2\x00\x62\xbd\x49" +
+	"\x2c\x08\x32\x7a\xc0\x6d\x80\x90\xf2\xb4\x44\x86\x6e\x16\x4d\x37\xa0\x30\xf8\x82" +
+	"\x5e\x33\x41\x4d\x6d\x52\xf7\x56\x91\x5d\x3b\x85\xd2\xcb\xa4\x30\x1f\x7b\x61\xf5" +
+	"\xe1\xd9\xc8\x3e\x14\x7d\xee\x5f\x6c\x2d\x62\xb7\xe4\x2f\x55\x17\xe9\x01\x97\x94" +
+	"\x0f\x9d\x24\x73\x02\x51\xe8\xe8\xab\x99\x46\x0a\xca\x16\xc8\x91\x1f\xac\xf7\x81" +
+	"\x3c\x96\xc1\x3d\xa6\x17\x9b\xe7\x0a\x52\x87\x19\x18\x14\x11\x46\x1f\x6b\xf6\xb0" +
+	"\x8f\xb9\x6d\x7a\x9e\xc0\x01\x1f\x2d\xec\x23\xb5\x2d\x43\x7e\x7f\xe9\xdd\xe3\xd9" +
+	"\xb9\x82\xb7\xb6\x8f\xd7\x36\x5e\x61\x1f\x18\x25\x33\xbb\x0d\x2d\x45\x63\xe3\x28" +
+	"\x81\x7d\x83\x2e\xb4\x88\x10\x72\x0a\x1b\x19\xae\xe1\x12\x45\x22\xc7\xe9\x82\xd1" +
+	"\x3b\xb6\x9e\xe5\x03\x9c\xef\x69\x17\xbe\x81\xe0\x82\xa1\x08\x7b\xc3\x2e\xd8\x71" +
+	"\xdc\x17\x0a\x14\xe6\x08\x2d\x2e\x15\xd9\x73\x8f\x0c\xc4\xc5\x2f\x86\x73\xc1\x76" +
+	"\x99\xc7\xef\x63\xdf\x51\x00\x44\xe3\xfa\x49\x94\x8e\x29\xfd\x61\x2a\xda\x5e\x95" +
+	"\x25\x6a\xb4\x17\x99\x68\x06\x08\x01\xf6\x8d\x50\x10\x2a\x2e\x14\xdf\xee\x85\x59" +
+	"\xce\xa7\xf6\xf1\xb7\x97\x77\x39\xf7\x7e\x7b\x17\xd9\xc7\xd8\xc7\x08\xfb\xc5\xe1" +
+	"\xe2\x2a\x67\x76\x32\x85\xfd\xaa\x75\xe5\x2f\xfb\x91\x23\x3e\xca\x23\x1c\x91\x73" +
+	"\xfc\x11\xe7\x5c\xc3\x11\x91\x81\x1e\x92\xe5\x8e\xb6\x72\x8d\x8e\x40\x38\xe2\xfe" +
+	"\xb8\xf8\x8b\x28\xdc\xae\x53\xe3\x60\x74\xc4\xa3\x34\x84\xf0\xfc\x8a\xb0\xb2\x31" +
+	"\x30\xc3\x30\xcd\xb6\xfd\x0f\xf6\x7f\x60\x9e\xee\x8b\xd1\x40\xef\x35\x6e\x50\xca" +
+	"\x67\xb5\x14\x55\xf3\x1c\xb1\x75\x9c\x71\x0d\x4a\xea\xca\xe5\xcc\x4a\xa9\xe4\x52" +
+	"\xdd\xde\xc9\x3e\x7a\xde\x54\xd8\xb7\xab\x69\x1e\xf1\x6a\xf1\x4a\x35\x1c\xe9\xcf" +
+	"\x7f\xf9\x91\x1e\xda\x92\x85\x23\xd9\x16\x0a\x01\xc3\x07\x5f\x96\xcc\x14\xd4\x80" +
+	"\xb0\x38\x8f\x14\x0e\x59\xa8\x9e\xc4\x27\x75\x2c\xe6\xe3\x91\xee\x4e\x73\xa4\x65" +
+	"\xcb\x4b\x4a\x37\x38\xfa\x46\x51\xd8\x1f\x16\x96\xc8\x23\x11\x22\x62\xe6\x3e\x46" +
+	"\x68\xfc\x40\x96\x14\xb6\xb1\x3c\x12\x3b\x3d\xa6\xb1\x23\x15\x7f\x49\xb9\x2b\xa2" +
+	"\x91\x53\x39\xe5\x15\xeb\x48\x1e\xc7\xf9\xc0\x1a\x9f\x3f\x14\xcb\xa0\x76\x32\x3b" +
+	"\xd5\xd6\xf2\x48\xd8\x4d\x2d\x39\x0a\x69\x2f\x6b\x60\x54\xf9\x8a\x38\xd2\x60\x81" +
+	"\x3a\xd2\xfb\xe9\xb2\xf8\x23\xbd\x1b\x46\x77\x50\xf7\xb9\x09\x7c\xca\x05\x0a\x08" +
+	"\x17\x7f\x02\x74\x45\x1e\xf6\x6e\x6f\x9c\x30\xf2\x93\x1f\x1a\x9e\x16\x86\x75\x21" +
+	"\x79\x9a\x38\x6e\xc9\x53\xf3\x01\x5b\xdd\xb0\xc9\x77\x1c\xe6\xda\x1d\x5a\x99\x06" +
+	"\x39\x95\x1d\x19\x53\x5b\x5e\xd7\x40\xff\x00\xba\x0e\xf6\x15\x94\x2d\xc5\xe4\x52" +
+	"\x78\xe6\x91\x4e\xbc\x86\x1c\x99\x93\x72\x0c\x47\xee\xa7\x95\x23\xca\xd8\x3c\x72" +
+	"\x17\xdb\x06\x5a\x3e\xfe\x75\x8e\xdc\x09\x95\x2c\x8b\x12\x6d\x67\x85\x4c\x5c\x79" +
+	"\x94\xa9\xe3\x7c\x22\x1c\x45\x2a\x17\xe1\xc0\x0c\x30\x4a\x1e\xf5\x87\xfb\x3f\x90" +
+	"\x6f\x5d\xa8\xd5\x23\xf6\xfb\x4e\xd9\xf7\xdc\xd5\x73\xb9\x43\xac\x26\xb6\x12\xe4" +
+	"\x33\x85\xdf\x53\xd9\x60\x8f\xd3\x8f\xcb\x81\x7f\x9c\xac\xdc\xe7\xc6\xf8\x9f\x81" +
+	"\x68\xf1\x59\x67\x29\xd2\xb6\x49\x5e\x05\xc3\x94\x20\xb6\xc8\x37\x89\x9f\x08\x80" +
+	"\x82\xe8\x65\xd5\x0f\x94\x0a\x24\xc3\xf5\xb1\xb1\xbf\x03\xcd\x6c\x86\x00\x4b\xe4" +
+	"\xf9\x2c\x7c\x7b\xc0\x83\x2c\x32\x65\xa3\x08\x6d\x8f\x32\x96\xc8\x6f\xc7\x77\x8a" +
+	"\x23\x1c\x05\xff\x31\x11\x4a\xd8\x6b\xda\xb5\xfc\x68\x06\x3d\x8a\x9c\x6f\x1c\x08" +
+	"\xfe\x21\xd3\x96\xf8\x66\x63\xd6\x5b\x0f\x8f\xa4\x7e\x20\xf6\xe7\xda\xb6\x2e\xc7" +
+	"\x40\x7e\x38\xb6\x2d\x78\x70\xa4\x52\x0d\x31\x34\x74\x5b\xbb\x42\x15\x04\xa3\x84" +
+	"\xa4\x53\x3a\x7f\x4e\x5b\x38\x14\x24\x9a\x23\xc6\x31\x3a\x81\xc2\x44\xdd\xaf\xf8" +
+	"\x31\x38\xd2\x93\x42\x9c\x70\x33\xec\x39\x86\x57\x14\x32\x87\x12\x9f\xfc\xcf\x6d" +
+	"\x0d\x25\xd6\x46\x07\x28\x8f\x79\xa8\xcf\x94\x47\x62\xe7\x3e\xa5\x50\x1e\x04\xae" +
+	"\x79\x85\xf2\x78\x8e\x86\xda\xd6\x07\xb1\xed\x2e\x0f\x4d\x8d\x22\x7e\x9d\x59\xd3" +
+	"\xb6\x2b\x94\x07\x5c\x62\x91\xff\x06\x97\xd2\xbe\x28\x0f\xe2\x4d\x47\x22\xf7\xef" +
+	"\xa1\x3c\x1a\xaa\xce\x34\x62\x79\xa0\xa2\xa9\x2a\xb4\xae\x93\xf0\x1f\xfa\xbf\x64" +
+	"\x65\x45\xad\x2c\x89\xdf\x59\x1e\x1d\x05\x06\xa3\xda\xe6\x96\x47\x87\x39\x50\x1e" +
+	"\xf8\x58\x10\xd5\xd7\x2a\x38\x0e\xc7\xaf\xff\x16\xca\x34\x6d\xfa\x0d\x54\x87\x8e" +
+	"\x32\xa5\x78\xc8\x95\xa4\xc1\x5b\x4a\x50\x26\x99\xcb\x97\xc9\x2c\xd6\x9d\xaa\x88" +
+	"\x4e\x3c\x33\x93\x08\x95\xd9\x1c\x57\x94\x79\xd6\x49\xdc\x99\x08\x65\x46\x6b\x29" +
+	"\xd5\x00\x17\x2e\x3f\xbd\xbe\x73\x51\x84\x85\x50\xd2\xf8\xae\x29\x94\xe7\x93\xe2" +
+	"\x9e\x4f\x42\x20\x42\x53\x95\x3c\xb3\x3c\x9f\xbc\xf8\x36\x97\xfd\xc6\x3d\xfd\xa1" +
+	"\xd9\xa1\x8a\x7c\x3e\xf1\x45\x4a\x4a\xc3\xd4\x55\x80\x3d\x06\x51\x7f\xf5\x67\x0e" +
+	"\x85\x60\x76\xfc\x69\x2d\x94\xbc\x69\xb6\x02\x3c\x73\xda\x66\x00\xcb\x79\x28\x18" +
+	"\xf6\x95\x57\x8d\xa1\x6c\x38\x71\x2e\x5b\x8a\x8b\xad\xae\x40\x6d\x92\xcb\x66\x2c" +
+	"\xcb\x2b\x14\xf6\x1b\xb0\x8f\x6e\x70\x05\xec\x2b\xcc\x28\x1f\x8d\x58\xe6\x2c\xe8" +
+	"\x59\xba\x86\xef\x07\x77\x83\x35\xb5\x1b\xb7\x29\x7f\xb2\x08\x5b\x16\xe9\xf7\x3a" +
+	"\x50\x2d\x0e\xf7\x60\x43\x58\x11\x4b\x90\x1b\x18\xb6\xb4\xca\x7a\x28\xed\xfa\x09" +
+	"\xf8\xc4\x4a\x2d\x10\xe1\xcb\xf9\xed\xe5\xfc\x84\x5b\x2a\x1c\x30\x9d\x81\x7f\xe3" +
+	"\xb1\x87\x72\xba\x3b\xf6\xe2\x07\x7f\xa3\x4e\x72\x09\xa5\x3e\x42\x41\x69\x8b\x4f" +
+	"\x56\x67\x8b\x3c\x5d\xe0\xd5\xcc\x97\xd1\xdf\x18\xf8\xd6\x08\xa9\x4b\xcd\xb8\x86" +
+	"\xe4\x1a\xfe\x17\xc1\xe3\xb6\x11\x4a\x3d\xfe\xd1\x12\x28\xcc\x55\xfc\xf4\x40\x34" +
+	"\xa5\x45\xb1\xff\x73\xc5\x9d\xd2\xd9\x42\x33\x9b\xc1\xf8\x53\x14\xdf\xbf\x0b\x06" +
+	"\x54\xfd\x13\x6a\xe9\x06\x91\x82\xa6\x50\xda\x04\x17\xae\xa7\x0f\xd2\xf3\xda\xae" +
+	"\xb5\xb1\xb4\xb3\xa6\x2b\x94\xa6\x90\x2b\x85\xe0\x5b\x93\x06\xa4\xa1\xdf\xf4\x87" +
+	"\xe6\xc0\xc2\x56\xdf\xa5\x6e\xc8\x70\x4a\x97\xbe\x4a\x28\xa3\x23\x05\x14\xcd\xb3" +
+	"\x48\x51\x45\x01\xec\x74\x49\xc1\xe3\x12\x2b\x51\x19\x5d\xab\x3f\xd9\x98\x15\xad" +
+	"\xdd\x46\x27\xae\x73\xbb\x40\x6c\x4d\x45\x51\x8d\xa9\x42\x28\x2b\x08\x94\x1f\x08" +
+	"\xee\x6f\xd8\x73\xb3\x39\xe7\x1a\x63\x4c\xe8\x1e\x8f\x24\xe1\x10\x58\xd6\x62\x65" +
+	"\xf4\xd3\x8b\x3b\x53\x65\xf9\x11\xe4\xa8\x0d\x2d\xa3\x7a\x9d\xaa\x3f\xa1\x62\xae" +
+	"\x27\x40\xdc\x38\x03\x08\xd7\x40\x8d\x03\x33\x54\x47\x26\x00\xbc\x5f\xdd\x39\xf1" +
+	"\xe6\x06\xe8\x56\x32\xfe\x0c\x4e\xc0\x37\xc7\xf1\x32\xb4\xce\x96\x6f\x9f\x23\xbe" +
+	"\x53\x8d\x9c\x86\xec\xdd\xb9\x42\xcd\x49\x15\xd7\x45\x57\xe2\xed\x58\x5c\x18\xa6" +
+	"\x9d\xc0\x06\xef\x4a\xa8\x94\x97\xe5\x1b\x15\x2f\x3f\xc0\x09\x21\xb7\x65\x64\x77" +
+	"\xe0\xda\xef\x76\x29\x2b\x0b\xfa\xbe\xae\xfc\xb7\xb3\x6c\xa8\x1d\xbd\xef\x24\x7f" +
+	"\x82\x29\x46\x58\x9a\x66\x53\xd4\x36\x99\x99\xa0\xfb\xb9\x46\x45\xb2\xe6\x7e\xfa" +
+	"\x41\x45\x4c\x37\x88\xd5\x62\x80\x63\x3a\x00\xff\x41\x4a\xc1\x66\x66\xf3\xea\xbd" +
+	"\xee\xbb\xfa\x14\x50\xc4\xa3\xdc\x91\xfd\x19\x00\xf3\x4a\x65\x39\x3d\xcb\x79\xe7" +
+	"\x3d\x19\x9d\xde\x8b\x75\xf9\xf6\xa6\x3b\x6b\x9e\xfc\xf1\x27\x4e\x4b\x4d\x0d\x98" +
+	"\xcb\xff\xd0\x57\x7f\x66\xbf\xe9\x5f\xd9\x51\xff\x43\x77\x91\xf8\x4f\x53\xaa\x7d" +
+	"\xf3\xef\x7f\xcd\x9b\x2d\xf9\xc7\x8b\x6a\xd9\x23\x8e\x29\x79\x4b\xe9\xb4\x64\xeb" +
+	"\x11\xa0\x7e\x7f\x83\xd6\xbd\xfe\xe2\xae\x18\x80\x7b\x6e\x68\x3c\x9f\x77\x91\x43" +
+	"\xdc\x30\x60\x8f\x3f\xfa\xa9\x78\xac\x86\xe0\x87\x00\x86\x37\xf8\x77\xdc\x86\xa1" +
+	"\x77\x32\xef\x38\xc2\xc6\xda\x14\x2c\xd5\x9e\xf1\xae\xe6\xec\x1c\xfc\x9b\xa2\x8e" +
+	"\x02\xe5\xbb\x30\x64\xbf\x69\xcf\xe4\xd4\x1a\x81\x49\xf0\x2d\x57\x9b\xe5\x07\x09" +
+	"\x30\xac\x05\x7d\xef\x9f\x8b\x43\xfc\x11\xf0\xae\x7a\x61\x78\x0d\xca\xcf\x9c\xaa" +
+	"\x81\x2b\x9c\x11\x95\xd2\x33\x2e\xf0\xd3\x2a\xd7\x4b\x99\x63\x38\x65\xfa\x12\x37" +
+	"\x01\x7a\x3b\x13\xb2\xfb\x0e\x65\x3f\xf0\xcf\x25\xbf\xd9\x9e\xcc\x4b\x67\x44\x1d" +
+	"\xa1\x61\xe3\x0b\x3c\xcc\x99\x08\xb0\xb1\x99\x3e\xf1\x0a\x3c\xe7\xb1\xf3\x83\x8d" +
+	"\x21\x63\xe5\x5a\x30\x03\x07\x74\x02\x60\xd1\x2d\xb8\xd0\x05\x80\x7b\x0d\x01\xfc" +
+	"\x29\xf4\xf4\x41\xbc\xff\x19\xb1\xe9\x0d\x27\x22\xb1\x44\xd5\x0f\xf9\x27\x3a\xf1" +
+	"\x60\x88\xa5\xb9\x81\x22\xef\xa9\xc2\x14\x7d\xa4\xe5\x62\xb7\x72\x7e\xa4\x63\x1f" +
+	"\xa4\xdb\x87\xb5\x11\xcc\x1f\x5e\x8c\xb0\x59\x7a\x14\x11\x3a\x89\xbe\x71\xc6\x33" +
+	"\xe2\xb2\x3c\x87\x33\x9e\x57\x1d\x2d\x70\xfd\xc0\xd3\x23\x80\x6d\x07\xf4\x85\x25" +
+	"\x1b\x00\xc5\x1c\x5a\xb3\xc2\x90\xa2\x64\x7c\x20\xda\xad\x13\xcd\xa0\x88\x54\x93" +
+	"\xab\x56\x94\xed\x81\x13\x80\x93\xe5\x99\x65\x87\x94\x1c\x57\xc8\x4b\xd9\x18\x5e" +
+	"\x10\xb5\x7a\x45\x86\xa5\x6f\x25\x19\x18\xd4\x9d\xaf\x9f\xb1\xb6\x7c\x1b\x2c\xdd" +
+	"\x17\xfa\x49\x3b\xf2\x29\x6a\x15\x6d\x45\x4c\x7a\xfc\xff\x5b\x80\x87\x33\xd6\xef" +
+	"\x74\x8c\x70\xc6\xc6\xf1\x01\xba\xf2\x64\x9d\xf7\x21\xe8\x82\x41\xe9\xa1\xa4\xf7" +
+	"\x6c\x8d\x8e\x60\x5b\xad\xc9\x9a\xb4\xd3\x3c\x32\x75\x10\xad\x1e\xab\xec\x0f\x23" +
+	"\x54\xc6\x1f\x4a\xa1\xfd\x3a\x01\xbf\xce\xd8\x4b\xd6\x95\x49\xb9\x78\xca\xbd\x9b" +
+	"\x3c\x5d\xb0\x65\x69\xe5\xb8\xec\xf3\x0e\x31\x38\xce\x88\x14\xde\x09\x39\xb0\x9c" +
+	"\x09\x27\x12\xa9\x19\x6f\x82\x06\xe9\xe2\x78\x17\x80\x6d\x72\x26\x67\x4a\xcb\xc0" +
+	"\xbb\x3b\xa1\x48\x01\x65\x15\x73\x52\x6b\x33\x02\x52\x80\x7d\x8f\xe4\xac\xac\x33" +
+	"\x11\xce\x71\x0d\x67\x42\x72\xa9\x49\xe7\x4c\xaf\x42\xd6\x4d\xfa\x08\xe1\x44\x65" +
+	"\x6a\x62\x90\xdd\x66\xe2\x67\x3a\x8e\xe8\xf4\xf6\x50\x75\x32\x67\xc9\x8a\x18\xbe" +
+	"\x6e\xb4\x1d\xe8\x49\x08\xd6\x47\x8d\xfc\x74\xd8\xbe\x47\x88\x8a\xf1\xdd\x92\x9a" +
+	"\x22\xd5\xb8\xe3\x09\xb6\x33\x66\x10\x5d\xa9\xc6\xd5\x19\xe7\xd8\xa0\x17\x7f\x4b" +
+	"\x43\x96\xcd\xe3\x88\x9e\xa9\xa6\xa5\xde\xea\x01\x5c\xed\xa7\x76\xb2\xac\xc1\x6e" +
+	"\x67\x61\x50\x4f\x7a\xf2\x92\xe9\x83\xfc\x37\x72\x84\xee\x3f\xca\x53\xac\x36\xc8" +
+	"\x53\x15\xaf\x81\x34\xdc\x5c\x59\xd6\xcc\xb7\xd7\x57\x4f\x35\x9b\x0e\x32\x19\x7a" +
+	"\xc1\x58\x90\xd7\xab\x5f\xd6\xd0\x75\x8f\xe6\x0e\xe8\x24\xa6\xc0\x74\x57\xe4\x9f" +
+	"\xa7\x32\xcb\x29\x27\xe2\x5e\x16\x5d\x0c\x6c\x70\x24\xa0\xbb\x98\x3e\xbb\xdc\xef" +
+	"\xef\x8a\x6e\x92\x90\x01\xff\xdd\xe9\x03\x4e\x0f\x88\x59\xda\xc8\x1c\x4e\xac\x33" +
+	"\x6d\x57\x09\x54\x8b\x76\xf6\x47\x27\xa7\x99\xe7\xe5\x94\x45\x0a\x03\xfa\xcd\x9b" +
+	"\x5a\x88\x2a\xf7\x71\x30\x9e\xd3\x5b\x9f\x51\x87\xee\x9f\x40\x08\xdb\x1e\xdb\x0e" +
+	"\xa8\x19\x1f\xfe\xe7\x9a\x37\x9f\x91\x6e\x74\x63\x19\xda\x9c\xce\x4b\x38\xc8\xba" +
+	"\xa5\x65\x77\x22\x6f\x15\xe7\x2a\xb6\xb8\x01\x36\x98\x48\x03\x09\x71\xea\x3b\xe7" +
+	"\x73\x2d\x3a\xed\x28\x6b\x4d\x87\x4f\xd6\x39\xfe\xfe\xc6\x40\x7b\x54\x44\x8c\x09" +
+	"\xf8\x8a\x3f\x46\x13\x8c\xf4\x33\xa7\x47\xdc\xe6\x20\xe6\xe9\x8e\x53\xfb\x33\xc3" +
+	"\x8e\x91\xf2\xc0\x99\x97\xe5\xd2\x1e\xf2\xcc\x8b\x6b\xb2\x9d\x79\x81\x97\x79\xe6" +
+	"\x05\xc1\xc5\x99\x17\x98\x7e\x67\x5e\xe4\x98\xea\xcc\x5b\x7c\xea\xaf\xb8\x35\x27" +
+	"\x52\x39\xb5\x64\xde\x70\x99\x66\x84\x76\x64\xbb\xcb\x1c\x64\xd4\x12\xd4\xa9\x34" +
+	"\xe4\x4a\x38\xf3\x11\xe5\x11\x0c\xd0\x79\x85\x43\xe7\xb7\x13\xf1\x19\x05\x41\x9a" +
+	"\xe8\x68\xab\x3a\x49\xc6\x77\x26\x8d\x87\x05\xab\xd4\x2e\x81\x7e\x0b\xf5\x5e\x2e" +
+	"\x0b\x72\x77\xc0\x29\xf5\x3a\xf8\x33\x6c\x09\xf5\x2b\x8b\xfb\x20\xef\x07\xe2\xba" +
+	"\x9c\xf9\xa4\xcd\xe2\x2b\x9c\xf9\x3f\xc5\x19\x82\x92\xf5\x3f\x3c\x69\x9c\xb9\x96" +
+	"\x21\x31\xe4\x99\xfb\xb4\x3e\x59\xce\x89\x41\xac\x3f\xbc\x49\xc7\x13\x19\xd9\x7f" +
+	"\x32\x7f\x7f\x7f\xe3\xef\x6f\x90\x37\x05\x7e\x63\x74\x16\xb8\x83\x57\x08\x63\x07" +
+	"\xa0\x68\x4a\x36\xc1\xdc\x88\x1e\x82\x82\x33\x7f\x8f\x04\x17\x90\x71\x27\x39\x39" +
+	"\x35\x63\x2b\x8e\xe8\x9c\xbb\x70\xcc\x49\xe1\x1b\xd8\x4e\xff\x74\x55\xdd\xf4\x87" +
+	"\x9a\x20\x9d\x09\x40\xc8\xe1\x9b\x72\x27\x3d\x92\x26\xe1\x2d\xe1\x0d\x4d\x03\x0e" +
+	"\x33\x5e\xd8\x30\xe7\xc6\xa1\x9a\xda\xa4\xfb\xac\x98\x3f\xd7\xfe\x0c\x02\xb8\x30" +
+	"\x95\x6d\x69\xd4\xdc\xb8\x67\x06\x4c\x50\xd5\x56\xbe\x72\xce\xad\xe0\xef\x7b\x09" +
+	"\x27\x8a\x58\xf6\x97\x86\x45\xed\x8a\xdf\x8c\x7b\x8c\x6c\x4c\xef\x32\x55\x3e\x37" +
+	"\x98\x88\x5a\x02\xb6\x71\x0b\xdb\x6f\xd8\xc3\x79\xa4\xe1\x03\xa6\xa4\xae\x8f\x58" +
+	"\x96\x5a\xf6\x70\x96\x8c\x96\xd1\x74\x81\x0e\xc2\x59\x32\x64\xcb\x96\x70\xc5\x87" +
+	"\xf2\x83\x56\x17\xa3\x8c\x81\x42\xe8\xbc\x58\x01\x2c\xc2\xc8\xaa\xea\x92\xb4\x1b" +
+	"\x2a\x5b\xaf\x68\x86\x9f\x65\xbb\xcc\x4a\x05\x24\x89\x93\x00\xdc\x08\xc0\x4e\x98" +
+	"\xa3\xb3\xec\xda\x0e\xc9\x57\x98\x62\xf5\x87\xb3\xec\x3b\x03\x0f\xff\x05\xb3\xf6" +
+	"\x07\x30\x0c\xa9\xe2\x2e\x2b\x0e\x8a\xd9\x89\xcb\x7c\x53\xbe\x3c\x8c\xbd\x02\x99" +
+	"\xed\x50\x7d\x72\xb4\x7c\x3e\x9d\xa2\x1a\xc7\x43\x8e\x6e\x15\x2b\x5a\xc8\xca\x79" +
+	"\x5e\x8a\x94\x60\xfb\x72\xd6\x07\x80\x16\x65\x03\x54\xb4\x9e\x25\x33\x19\x96\xda" +
+	"\x27\x24\x11\x94\x5d\x7b\x3a\x66\xa7\xfd\xa6\xf6\x58\xa4\x41\x54\xa4\xc2\x4e\x86" +
+	"\xa0\xf6\x03\xf3\xed\x2c\x4d\xc5\xb4\x2e\x45\x50\x73\xeb\xa1\x0f\x25\x44\xb5\x5a" +
+	"\x5f\xe1\xe2\x1a\x1a\x48\x6e\xfe\x81\x2a\xae\x75\xf4\x7f\x1c\x78\xdb\xb6\x8e\xe4" +
+	"\x29\x51\xe9\xd6\xd9\xb4\xd0\x07\x4b\xeb\xce\xa7\xfc\x8b\x96\x7b\x16\x13\x37\x40" +
+	"\x1b\x4d\x4f\xed\xfd\xc6\x86\xe0\xec\x2c\x3a\x5a\xd6\xcc\x3d\x79\xd3\x3a\x0b\xc2" +
+	"\x11\xb5\xcc\xfb\x5d\xde\xe1\xe4\x94\x27\x21\xe8\x59\x63\xea\x28\x3b\x1d\x24\x6a" +
+	"\x16\xe6\xe3\xd7\x08\x3f\x3b\x9c\xa8\x2e\xe8\x59\x35\x3d\xd4\x56\x35\xe1\x0b\xe6" +
+	"\xa6\x2c\xbb\xd8\x00\xfa\xca\xf3\x81\x36\xaf\x70\x79\x4c\x29\x7f\xa7\xf9\x73\x71" +
+	"\xda\x0a\x4d\x70\x41\x6a\x8c\xc5\x20\x72\x15\x5f\x00\xb9\xec\x1e\x4a\xfc\xac\x69" +
+	"\xce\xb7\x4d\xfd\x49\x68\x1b\xbc\x5d\x50\xb7\x94\xf6\xf3\x43\x35\x79\xe0\xd6\x5a" +
+	"\x09\x87\x3d\xea\x19\x5d\xa3\x52\x57\x92\xe7\xdb\x4b\x6c\xfe\x54\x4c\x0d\xfb\x7d" +
+	"\xdf\x2f\xfc\x39\x1e\x3a\xea\xac\x49\x9f\xba\x61\xfa\xc7\x4f\x3e\xc6\xb1\xb8\x27" +
+	"\xd0\xc6\xb2\xa6\xf6\xb8\xf4\xf1\x6e\x74\x91\xd1\x99\x46\x27\xe1\xa5\xeb\x81\x63" +
+	"\x16\x46\x5a\x4d\x1c\xbf\x27\x7f\xbe\x63\x7b\x64\x5e\x16\x6d\xb7\x6a\xde\xd3\xb7" +
+	"\xf2\x66\xc4\x97\xd9\xe1\xa9\x04\x04\x96\x00\x96\x42\x9a\xfa\xe6\xc9\x82\x98\x79" +
+	"\x6a\x79\xa8\x17\xd6\x32\xa1\xff\xeb\xb0\xb2\x89\xb5\xe1\xf8\xcf\x95\x3d\xb6\x4c" +
+	"\x1c\xa7\x79\x0f\x58\x88\xbc\x45\x99\xa5\xe7\x68\xc0\x3f\xe9\x0d\xe9\xb7\xb5\x3c" +
+	"\xe1\xaf\x4d\x7f\xd0\x0a\xde\x39\x36\x31\x35\xd6\xb2\x8f\xed\x4e\x3d\x65\x6a\x6f" +
+	"\x15\x39\x9d\x51\xed\xd0\x8b\x66\x4d\xd7\xb6\xaa\x16\x7a\xf9\x23\xdd\xe8\xee\x25" +
+	"\x12\xd2\xda\xcf\x84\x38\x00\x92\x5a\x66\x95\xca\x76\xdc\xef\xf6\x7b\x0f\xc5\xb9" +
+	"\x3b\x3a\xd5\x47\x2a\xfd\x13\x17\xf9\x44\x79\xf0\xf8\x07\x6a\x31\xaa\xe5\x3b\xb7" +
+	"\x42\xce\x6f\xed\xcd\xc4\xc4\xc5\x16\xcd\x3e\xca\xa8\xf6\x39\x9b\x9c\x29\x5f\xe1" +
+	"\xec\x78\x85\x43\xbc\x79\x8e\x49\x6a\x66\xe7\x98\x97\x2b\x9c\x23\x55\xb8\xee\xe7" +
+	"\x58\xb4\xc1\x09\xf8\xd2\xb9\xf7\x64\xc8\x4a\xab\xaf\x4c\x78\xf1\xee\x24\x35\x26" +
+	"\xde\xb1\xc3\xa1\x3b\x87\x4d\x96\x68\x1a\x63\x66\x70\xb2\xfb\xcc\x9b\xff\xb8\x6e" +
+	"\x85\x1e\x39\x2a\x13\xd1\x4d\xe9\xe9\xa3\x7e\xa7\x8b\x41\x68\x91\x72\x5c\xd3\x14" +
+	"\x47\x22\xd6\x53\x61\x28\xb1\xb1\x83\x8d\x34\x87\x13\xc5\xda\xf3\xa2\x65\xa6\x55" +
+	"\x2e\x2e\xff\x1b\xa8\xb8\x22\x85\x32\xa8\x63\x8e\xd0\x40\xda\xee\xc8\xd2\x9e\x4f" +
+	"\xfe\x2e\x3b\x0c\x80\xff\x06\xab\xf5\x76\x91\xc2\x5b\x60\x4a\x85\x60\x9e\x6b\xce" +
+	"\x4e\xff\x0d\x33\x7e\x33\xca\x00\xfa\x6f\x60\x00\x87\xa4\xc9\x50\xde\x3c\x9f\x6f" +
+	"\xec\x40\x6a\x1e\x03\x2d\xe0\x34\x79\x52\x09\x07\xb3\x01\x94\x85\x2e\xdd\x89\x76" +
+	"\xd1\x19\x24\xff\x8d\x7c\x92\x76\x5a\x9d\xf3\x8f\xf4\x7a\xff\x1b\xa5\x8e\x3d\x54" +
+	"\x6c\xb3\xe4\xea\xb8\xc6\x27\xb6\x73\xa1\xc6\x25\xee\x43\x6e\xec\x6b\x5c\x96\x34" +
+	"\x5f\x24\x6d\x51\x97\x98\xcb\xd4\xb8\xf4\xb8\x70\x25\xd5\xc0\x1a\x97\x37\x5c\xa5" +
+	"\x1a\xb5\x69\x0b\x3a\x9a\xf0\x79\xf8\x38\xa8\x13\xc3\xaf\xae\x71\xaf\x85\x3c\x07" +
+	"\xe7\x23\x48\xa9\x4e\x2c\xdb\x41\xdc\xaf\x2a\x57\x43\x96\x70\x72\x1a\x27\xca\x18" +
+	"\x47\xdd\xe4\x25\x13\x35\x4f\x8f\x6a\x27\xb5\xea\xd3\x9a\x48\xee\xb9\x4c\xf7\xc0" +
+	"\x65\x6a\x56\x5d\x99\xa1\xdb\x81\x14\xb8\x46\x49\xf8\x37\x7e\x62\x27\xc5\x8f\x01" +
+	"\x3c\x41\x04\xf4\xce\x10\x3e\x04\x07\xfc\x3d\xf6\xf8\xfb\x1b\xc4\x72\x62\xbf\x47" +
+	"\x3c\x91\xaf\xd1\x04\x36\x24\xb0\x3d\xbf\xc0\x72\xcd\xa0\x67\xa6\x58\xe5\x03\x3f" +
+	"\x10\x36\xf6\xf9\x74\x42\x4d\x13\xac\xf5\x9a\x50\x80\x42\xc8\xa7\xd2\xa6\x9b\x9c" +
+	"\xd9\xd7\xac\x9a\xa6\x29\x91\x84\xd3\xdc\x55\x28\xcf\xf9\x9b\x35\xfa\x03\xf5\xcb" +
+	"\x8d\x13\x8b\x51\x7a\x30\xab\x86\x18\x89\x06\x8f\x43\x06\x04\x86\x71\xf8\x8b\x23" +
+	"\xd7\x9a\x3e\x1e\x89\xca\xf1\xff\x57\xaa\x99\xef\x08\x6b\x9a\x50\x83\xb7\x9a\xb1" +
+	"\xd1\xf2\x7a\x19\xb4\xb7\x9c\x15\x4f\xa1\xa6\xb9\x8c\xc7\x87\x6a\xb1\x05\x4b\x0f" +
+	"\x1a\x00\x77\xb9\x26\xe3\x4f\xd6\x34\xbf\x51\x3c\xae\x89\x8d\x1b\x7b\x7b\x0c\xdf" +
+	"\x37\x79\x22\x00\xd5\x87\xfa\xdc\x8d\xd4\x64\x12\xc9\x12\x7f\x61\xbb\x2f\x73\x54" +
+	"\x72\xef\xa2\xf2\xea\x51\x93\xc7\xdc\xc2\x78\xbe\x2e\x70\x86\xa8\x2f\x0c\xae\x9a" +
+	"\xfe\x7c\x03\x84\x9a\xb0\x29\x66\x26\xab\xe9\x6b\x30\xbf\x59\xa2\x41\x7b\x2f\x38" +
+	"\x35\x4a\xdd\x19\x81\x15\xce\x91\xcf\x9d\x35\xed\xe3\x48\xff\x0f\xed\x37\x47\x5a" +
+	"\xd8\xd2\x09\x38\x29\x36\x89\x57\xec\x63\x69\x1e\x76\x29\x89\x6b\x58\x24\x10\x4c" +
+	"\xb2\xf5\xde\xe7\x86\xda\x02\xd6\xf9\xa6\x4d\x67\x4d\x78\xd6\x22\x67\x51\x42\xfa" +
+	"\xba\xe7\x98\xbd\x48\x43\xf6\x68\xcd\x9c\x0c\xf4\x1b\x52\x24\x13\x03\xbd\x85\x35" +
+	"\x85\x3b\x6d\x42\x63\xb9\xa6\xc6\x86\x1c\x4f\x4e\xa4\xf1\xad\xbd\x90\xa6\x29\xb0" +
+	"\xa6\x36\x9a\x7b\xf7\xfa\xc3\x96\xa3\xbb\x46\x08\x08\xcf\x3a\x35\xf5\x9a\xe0\xb7" +
+	"\x00\x0a\x3b\x24\x15\x80\xc8\xba\x5a\x53\x7c\x27\xcd\x90\x00\xfc\x9e\x42\xeb\xe3" +
+	"\x6e\xbd\x1b\x5b\xe1\xdf\xac\x05\x10\x1c\x43\xd0\x3a\x59\xe7\x4f\x23\xfc\x56\x46" +
+	"\x58\x0d\xca\xf8\x27\xc3\xc4\xbc\x87\x06\x29\x33\x55\xa8\x79\xd9\x63\xe5\x78\x8a" +
+	"\x90\x60\xe3\x54\x5a\x1d\x92\x99\xfd\x20\xb7\xbc\x6d\xf1\x41\xd0\x4b\xa8\xf9\x7b" +
+	"\xb0\xba\xd4\xc2\xcc\x7d\x20\x7e\xae\x85\xd9\xa2\xe0\x6d\x6c\xbb\x42\x2d\x53\x99" +
+	"\x4a\xa8\x48\x40\x75\x30\xaf\x65\x3f\x43\x2d\xe5\xc5\xee\xa5\x96\xc6\x11\x87\xd5" +
+	"\x96\x03\x5f\x75\x89\xb4\x4b\x7e\x8c\xc4\xc0\x79\x90\x3f\xcb\x6a\xca\xcf\xb5\xf0" +
+	"\x4c\xe5\x27\x34\x84\x4c\x0e\x2b\xb6\x2f\x8c\xa5\xf1\xc8\xb2\x89\x67\x3d\xb5\x49" +
+	"\xce\x41\x0b\x75\x30\x52\x9c\x50\x37\x26\x65\x2a\x3b\xdc\x60\xd5\x81\x1a\x74\xec" +
+	"\x27\xad\x43\xb8\x8a\x2d\x54\x84\xf3\x14\x0e\x1b\xa3\x86\x86\x59\xc8\x5c\x10\xa8" +
+	"\x36\xf8\xa4\x9d\x14\x66\xe6\xc3\x50\x41\x7e\x0c\x5c\x7c\xf6\x35\xd4\xaf\xd0\xe2" +
+	"\xf6\x49\xc2\xa0\x9d\x4c\x78\x49\x86\xdc\x76\x35\xc0\x4e\xb3\xb4\xb8\x7d\x97\xd0" +
+	"\xe2\x5e\xbe\x23\x0f\x65\x0d\xb8\x19\x91\x0d\x73\x57\x16\xaa\x16\x4f\x2f\xa7\x4e" +
+	"\xe5\x5c\xe3\xa2\xc7\xd5\x59\x27\xd0\x16\xab\x47\x0e\x69\xb1\xb5\xf8\xc4\xbc\xa4" +
+	"\xb9\xd3\x26\x68\x52\x95\xa4\xf0\xd5\xd8\x38\x6a\xe9\x6b\xb1\x5f\x3c\x0e\xad\x30" +
+	"\xa8\xcc\x11\x5a\xfc\x2e\xb9\x86\x36\xc5\x07\xc1\xee\x00\xdb\x76\xbd\xe3\x02\x3a" +
+	"\x39\x84\x4d\x09\x58\xe3\x23\x30\x52\xe0\x60\xc6\x3f\xd4\xb9\x49\xbb\xb2\xef\xc2" +
+	"\x1f\x09\x45\xd5\xf2\x24\xf0\x3a\xae\x8f\xce\x8d\x0c\x75\x9c\xa6\xb1\xf3\x07\xb7" +
+	"\x2b\xa0\x79\xbb\xd1\x5b\x5a\xba\x9d\xa6\x37\x71\x1d\x2b\xd3\x79\x4b\x8c\x45\x35" +
+	"\x57\x72\x7e\x1c\x7a\x0e\xb6\x5a\x36\xf7\x30\x17\x5a\x42\xce\x00\x6f\x26\xb4\x84" +
+	"\x73\xd1\x51\x43\x4b\x7b\x14\xab\x27\x34\x4c\xdd\x6c\x6c\x22\x96\xbd\xcd\x17\x5b" +
+	"\xd2\x3e\x4a\xbf\xf9\x0f\x27\xbe\x42\x30\x9b\x9d\xd0\xc2\xb8\xf6\x98\xf3\xc9\x5e" +
+	"\xf8\x0f\xdb\xaf\xeb\x93\xdb\xf0\x22\xd5\xac\xa9\x32\x70\x67\xd2\x34\x58\x45\xb5" +
+	"\x54\x34\x98\x48\x4c\x0e\xdd\xb5\xa3\xdb\x4a\x34\x2f\xac\x38\x43\x43\xff\x33\x6d" +
+	"\x71\x59\x43\x5b\x6b\x7a\x33\xbb\xb7\x8c\xa3\x05\xbe\x4a\x7e\x5c\x5b\x68\x79\xe7" +
+	"\x3c\x85\x68\x86\x1a\xc2\xb0\x81\xff\xd7\xf2\x91\xde\x57\x68\xaf\xb1\xcd\x68\x60" +
+	"\xd0\x44\x9b\x14\xa9\xb0\x65\x68\x98\x8d\x09\x20\x02\x16\xcf\xbb\x95\xaf\x42\x2c" +
+	"\x82\xc6\xf9\x5d\x6d\x5b\x3e\xee\xcb\x5a\xd9\x14\xc2\x88\xfc\x5b\x3e\xa5\xee\xd5" +
+	"\xca\x8e\xa2\xd8\xd0\x7c\xc3\x85\xe9\xa7\x34\xd4\x39\xed\x37\xa7\xfc\x9b\xf3\x9b" +
+	"\x73\x2d\x28\x45\x4e\x40\x77\x85\xdd\x38\x73\xcc\x3a\xba\xb5\x32\xce\x89\xde\xc1" +
+	"\x0c\xcf\xab\x9c\xb1\xa1\x2e\x3b\x05\x54\x74\xe7\xbe\x56\xe2\xd8\x69\xe6\x15\xb7" +
+	"\x0e\xeb\x6b\xfb\xc0\x08\xba\x4a\x0d\x8d\x33\x29\x3d\xfd\x08\xad\x17\xe2\xda\x69" +
+	"\xbd\x89\x4b\xda\x03\xc2\x49\x6b\x78\x76\xa5\xaa\x61\xe7\x78\xfa\xbc\x42\xeb\xe3" +
+	"\xc4\xe0\xb2\xf5\x6b\xb1\xdf\x5e\x7b\x4e\xa1\x8d\xa8\x13\x4d\x1b\x0f\x6d\xc2\x8f" +
+	"\xd0\xc6\xe3\x0b\x75\x16\xee\x3d\xb6\xbc\x3b\xd2\x27\x54\x89\xe3\xd1\xb0\xf2\x6d" +
+	"\xe3\xd1\x71\xde\xb0\x00\xa7\x89\x9a\x8d\x69\xc2\x9d\x1e\x2d\xcd\x16\x86\x3d\x1a" +
+	"\x37\xe1\x24\xfb\x86\x5b\x17\xc9\xdd\x1f\x91\x4d\xc6\x6f\x78\xaa\xc2\xb3\xa0\x4a" +
+	"\xaf\x4f\xa2\xc5\xc9\x49\xd5\xd9\xef\xce\xfd\xef\x15\xc5\x5f\x8f\x48\xec\xb8\xd0" +
+	"\x2e\xcd\x04\x47\xff\x43\xb4\xd9\xb5\xb9\xea\x59\xbb\x88\x01\x19\xda\xb5\x3f\xb2" +
+	"\x64\xe2\x86\xd4\xb0\xd7\x91\xea\x72\x85\x8e\x8d\x07\x7d\xa1\xc7\xe1\x12\xaa\x1e" +
+	"\xdf\x73\xbd\x42\x4f\xb2\xc1\x10\x38\x8b\xcc\x3a\xba\x3b\xe4\x84\xbb\x71\x8f\x1d" +
+	"\x0c\xdc\x1f\xd5\x51\xa3\xbb\x73\xce\x8e\xb2\x3b\xc5\x4f\x54\xcb\x13\x43\x2f\x1e" +
+	"\x01\x27\x4c\x94\xd6\xd9\x47\x76\x4c\x5d\x98\x89\x78\xe0\x9a\xda\xc9\x57\xe9\x6b" +
+	"\x4d\x47\x99\xaf\xc0\xf4\x67\x65\x32\xc1\x1c\x47\x7c\xdc\xf2\x3b\xd6\x61\xdc\x88" +
+	"\xf4\x82\x45\x5a\x2f\x53\xcb\x47\xe8\xa5\x62\x61\x0b\x29\xdc\xaa\x5d\xb6\x2e\x7f" +
+	"\xfe\x9d\x7a\x8d\xf3\x98\xa0\x69\xb7\x79\xc2\x16\x64\x55\x52\x7e\x9c\xbc\xf4\x4a" +
+	"\x64\x88\xf9\xea\x64\x1d\x18\x02\x28\x83\x21\xfd\x7e\x30\x1e\x3a\xee\xb2\x03\x95" +
+	"\x37\xc2\x6e\x74\x4e\xa1\x8f\xce\xf8\x4d\xa1\x5f\xb5\x84\xf1\x70\x4f\x76\x63\xa3" +
+	"\xf2\x29\x48\x3a\xb1\xa4\x30\x8e\xc9\xea\x37\x90\x1b\xeb\xce\xf1\x64\x99\xc3\xf5" +
+	"\xd3\x38\x9e\x5b\x3c\xcd\xed\xe1\x38\x08\x1a\xba\x5d\x61\x10\x50\xe6\xe8\x61\x1c" +
+	"\x2f\x38\x13\x61\x1c\xff\xcf\x44\x18\x87\xef\x5e\xf8\x3d\xe7\x72\xec\x87\xc2\x38" +
+	"\x7a\x91\x21\x3c\xbe\xb9\x71\xf9\xc3\xa6\x70\x9c\x0f\x82\x16\x87\xf1\xe3\xc6\x35" +
+	"\xdf\xf1\x56\xa2\xb8\x11\x0d\xf1\x1d\x27\x55\xf1\x1b\xff\x09\x01\xa6\x82\x36\x7a" +
+	"\x73\xf8\x4e\x7e\xa4\x60\x13\xb8\x05\xcd\xf0\xfa\x31\xf2\x7d\x2b\x50\xa8\x5f\x80" +
+	"\x47\xa6\x39\x00\x30\x19\x10\xb8\xd3\xe2\x88\xcc\x99\xca\x30\x34\x9e\x95\xcc\x18" +
+	"\x94\x0c\xd8\x35\x71\xbe\xd9\x70\xe9\x97\x50\x96\xb5\xef\xf4\xe3\x35\x83\x17\x6f" +
+	"\x0f\xca\xe8\x9a\x7a\x19\x7f\xb8\x85\xef\x2c\xf5\xb3\x6f\x5c\x5c\x8a\x2f\xf2\x9d" +
+	"\x39\x80\x85\xef\x8c\xf1\x00\x15\xc8\x4d\xf5\xca\x8d\xd3\x4d\xb8\x95\xdd\xbe\x33" +
+	"\x21\x1a\xb6\x9b\xe6\x09\xe4\xbb\xf8\x6f\x6e\x7f\xfb\x8b\x95\xe9\x56\x75\x52\xc8" +
+	"\x5f\x35\x98\x7b\xcf\xfb\x2e\x1b\x7b\x0b\xbb\xf7\x69\x92\xa2\x65\xfe\x9b\xa8\x63" +
+	"\x2d\x3e\x53\x78\x47\xe3\xcc\xbc\x63\x7e\x86\x77\x3c\x60\x3c\xbf\xa3\x99\xa2\xbc" +
+	"\xe3\xf5\x94\xb4\x17\x76\x3a\x7c\xc8\x37\x91\x4c\xc2\x7b\x2d\x75\x0b\x6f\x5c\xee" +
+	"\xed\x29\xbc\x73\x23\xf4\xf1\x4d\xb7\x2b\xbc\x33\x7e\x23\xe8\x9d\xef\xfc\xab\xb8" +
+	"\x32\x6f\x54\xbd\xde\xa5\xc0\x37\xc1\xfb\xe2\xb1\x84\x77\x8d\x30\xd8\xdf\xf0\x67" +
+	"\xd6\x14\xde\x6c\x6c\xc3\xbb\x12\x67\x22\xfc\xa4\xa3\x9c\x6b\x79\xfc\xa1\x3c\x85" +
+	"\x8b\x7e\x53\x03\x16\xbe\xb1\xee\xe1\x4a\x52\x8c\xb9\x0a\x7e\x2d\xaf\xf2\x4a\x5b" +
+	"\xf8\xc5\x0c\x5d\x52\xf7\x1e\x7e\xd3\xb9\x5e\x35\xc4\x07\x5a\x61\x87\x51\x29\x12" +
+	"\xc5\x07\xd2\xa7\x5b\xad\x39\x3e\x36\xfc\xe6\xc4\x87\x14\x1c\xb7\x10\x1f\x35\x4a" +
+	"\x45\xcd\xc0\x37\xb7\x6a\x62\xe7\x1f\x1f\x26\x60\x8d\x0f\xf6\x9d\x24\x8f\x13\xbe" +
+	"\x69\x7c\x34\x8d\x47\x51\x5c\x39\x18\xb0\x3b\xe6\xa8\x4b\x40\x45\x35\xb1\xf3\xe3" +
+	"\x83\x85\x62\xa2\xcc\x46\xec\xbf\x38\xb1\x0c\x87\x38\x4d\x5b\xcc\xbb\xa8\xcd\xf6" +
+	"\x71\xe2\x64\xc8\x5b\xc5\x09\x35\xbe\xe9\x06\x76\x8f\xc8\x64\x64\x4f\x32\x4d\x90" +
+	"\x51\x30\x0b\x9b\xc0\x12\x22\x87\xf4\x38\x61\x9c\x1d\x27\x63\x66\x08\xc0\xdb\xc0" +
+	"\x1c\xf8\x11\xbb\xe8\x71\x51\x86\x86\xe9\xc7\xff\xb1\x83\x52\x83\x9f\x10\x44\xf8" +
+	"\xa2\x0e\xba\x83\x4e\x8d\x3a\xcf\x64\xc2\xe1\x62\xb8\x9b\x91\x78\x1f\x13\x62\x82" +
+	"\x6d\x37\x35\x01\xa4\xe4\xa2\x52\x19\x8a\xcf\x58\x43\x7c\x3e\x31\x46\x21\x44\xf1" +
+	"\xc4\x8a\x69\x40\xd5\x31\xc4\xd7\x78\x3e\xf3\xcc\xfa\x28\x64\x8a\x07\xa0\xba\x2b" +
+	"\xe1\x87\xbf\x5b\xbe\x7f\xbe\x69\x86\x8a\xcf\x27\xfa\x2e\xf1\x69\x8a\x86\x51\x61" +
+	"\x1a\x22\x11\xfd\x08\x9d\x69\x48\x7c\x7d\x43\xa5\xf9\xdd\x03\xbe\x7b\x5c\x92\xc4" +
+	"\xc4\xd1\x58\x55\x90\x39\x8a\xf4\x10\x97\x45\xe7\xd8\x14\xe2\xb2\xaa\x6b\xa0\x82" +
+	"\x19\x30\x71\x96\xfa\x30\xba\x8b\x2c\x51\x46\xed\x16\x02\xdf\x48\x8c\x42\x59\x39" +
+	"\x0a\x69\xea\x8a\x4b\x95\x1e\x17\xa6\xcf\x21\xe6\xb9\x85\xf8\x45\x9b\x6c\xf1\x01" +
+	"\x7b\x1d\xf4\xc0\x99\x3d\x55\xd9\x1e\x63\x0f\x71\x7b\xf2\x2f\xc4\x6d\x49\x48\x98" +
+	"\xd1\x3f\xe0\x5e\x7e\xe4\xf0\xe7\xa6\xda\x10\x1f\x72\xc3\x49\xc0\x71\x03\x6a\xb5" +
+	"\x61\xd8\x37\x41\x51\x87\xbe\x42\xdc\xca\xb3\xf3\x77\xa5\xb4\x52\x9e\x21\x6e\xe7" +
+	"\x1a\x1f\x89\x44\x45\x26\x0f\xd8\x8f\x32\x9f\x85\xb8\x8d\xfd\xc8\x21\xee\xd8\xbf" +
+	"\xf5\x02\xa0\xc8\x3d\xfe\x72\x66\xbb\x02\xd1\x9d\xb5\xd7\x00\x10\xdc\xcf\x92\x16" +
+	"\x4d\xe0\x20\xfb\x9a\x06\x2c\xc9\x65\xb0\xec\xe9\xda\x1a\x38\xcf\x98\xaf\x19\x21" +
+	"\x6b\x23\x10\x79\x91\x49\x51\x60\xf6\x0c\xee\x33\x37\xb2\x02\xc6\x1d\xb7\xe8\x38" +
+	"\xff\xd1\x5f\xda\x0b\x89\x72\x8e\x4e\x69\x3a\xa2\x38\xd2\x61\xf7\xc3\x36\x1e\x91" +
+	"\xbd\x2b\xb3\x6f\xdc\x39\x0b\xf0\xd7\x74\x6c\xa2\xeb\x1b\x41\xf9\x8b\xc0\x21\xee" +
+	"\x1c\x00\xb9\x68\xfe\x78\x09\x9c\x45\xbc\x9a\x03\x13\x52\x12\xf4\xcd\x0f\x98\x72" +
+	"\x74\x9a\xc3\x47\x00\x48\x36\x4c\xc8\x8e\x08\xa0\xda\x1d\x5d\x21\x22\x12\xaa\x46" +
+	"\xbe\xb9\xc4\x51\x9c\x91\x09\x32\x74\xec\x88\x47\x7a\x07\x84\x84\x9b\x78\x7c\x42" +
+	"\xf4\x37\x4e\xce\xb3\x91\x6a\xa4\x89\x58\x35\x96\x8a\x15\xaa\xa8\x0d\x4c\x0e\x71" +
+	"\x62\xd2\x70\x9c\x63\x03\x83\xc5\xbe\x46\x94\xa8\xfd\x2a\xbb\x15\xbc\x21\x26\x82" +
+	"\x03\x3e\x21\x97\x26\xd8\x32\x66\x1f\x6e\x74\x7e\x42\x3c\x8e\xd2\xad\x84\x03\x4f" +
+	"\x6e\x34\xc3\x71\x94\xcb\x8a\x3a\x86\x2c\x2e\x00\xf6\x43\x0c\x1d\x94\xa5\xbd\x6d" +
+	"\x2d\x40\xcc\xa9\xd9\x8a\x43\x15\x42\x9b\x20\x41\x94\xfa\x3f\x47\xa3\x23\x52\x74" +
+	"\xe7\x54\x22\x6a\x9b\x43\xb4\x9a\x1f\x65\x36\xc0\xfc\x72\xf4\x3c\x17\xd5\xa2\x67" +
+	"\x7c\xf2\x08\xfc\x37\x34\x2f\x19\x6a\x0e\xac\x1e\x3d\x73\xb8\x56\x52\x2f\x3f\xf6" +
+	"\xd4\xdc\x3f\x69\x2c\xd5\xdb\x8d\xe8\xac\xac\x8d\x4c\x96\x07\x31\x20\xe3\xf1\x9d" +
+	"\xb9\x7b\x49\xd0\x1b\x60\x7a\xae\x29\xc4\xf3\x4c\x98\xe4\x2d\xa0\x8a\x7b\x9b\x1a" +
+	"\x62\x7d\xb8\x69\x40\xac\x3b\xb1\x46\x42\xac\xfb\x0a\xd3\xc3\x43\xaf\xc4\xba\xdb" +
+	"\x64\x53\xcd\x14\x2f\xd6\x5d\xab\x61\x6c\xeb\x15\x62\x3b\xb3\xfd\xb8\xbb\x33\xfc" +
+	"\xd8\xb5\x7a\xf0\x4d\x06\xda\xa1\x1c\x30\xd9\x31\x9f\x19\x71\x25\x4c\x51\xcd\x47" +
+	"\x8f\xf8\x40\xcd\x01\x91\x3f\x7e\x90\x0c\x99\x02\x32\x08\xad\x85\xa6\x34\x3d\x5b" +
+	"\x40\x83\x1d\x84\x46\xcd\xa6\x9b\x16\xcd\x5b\x88\x13\x8e\x00\x0e\x6b\x04\x7a\x7c" +
+	"\x28\x53\x8f\xdb\x4b\x40\xb6\x5a\x8f\x38\x61\xa7\x35\xf6\xf0\x88\x33\x0a\x86\x8f" +
+	"\x68\xb6\xc3\x0f\x71\xa2\x45\x72\x32\x72\xd8\xb3\x17\xaf\xf1\x2b\xb5\xb5\x9c\xe1" +
+	"\x11\xb7\xc6\xf0\x0e\x18\x88\xe9\x9d\x01\x07\xdf\xf6\x21\xf7\x05\xf4\x8c\x47\xac" +
+	"\x13\x61\x0b\x1e\xb1\x4a\x1f\x37\x70\x12\x69\x3d\x42\x65\x09\xfc\x88\xb5\xc6\x69" +
+	"\xcc\x4a\x61\xbe\x52\xde\xd6\x4b\xe1\xd5\x2a\x9b\x5c\x4a\xe9\x98\xa5\x3c\xe2\x6f" +
+	"\x29\xaf\x18\x1e\x98\x78\x3d\x98\x92\xb4\x86\x3e\xb6\xd8\xcc\x76\xee\xb1\x61\x71" +
+	"\x31\x5d\x01\xc3\x86\x33\x18\xc3\xff\x41\x10\xc4\xc7\x56\xcc\x09\x35\x6e\x86\x50" +
+	"\x5d\x7a\x7c\x84\xdd\x8f\xf2\x20\xec\xa2\x28\x2f\x51\x1e\xd2\xac\x78\x94\xdb\xb2" +
+	"\xfd\x81\x57\x99\x39\x3c\xca\x2b\x3c\x0a\xda\x6c\xb4\x86\x7d\xac\x72\xa4\xcf\xa5" +
+	"\xdc\xbc\xf0\x11\xaf\xf0\x28\x65\x49\x8a\x1d\x09\xca\xc9\x11\x2b\xdf\xa3\x94\x4e" +
+	"\xbb\x42\xf4\xd0\xd2\xd5\x35\x1f\xa5\xfc\xf2\xc3\x4e\x18\x22\xd2\x07\x4b\xda\xa3" +
+	"\xfc\x78\xb1\x3f\x57\xc0\x1b\xd7\xb4\x42\x92\xbf\x78\xcd\xed\x65\x1f\x87\xed\xce" +
+	"\x1f\x92\xd3\xa8\x47\x2d\xd9\xca\xaa\xe5\xf8\x75\xf0\x3e\x30\xf2\x08\x8f\x3a\xba" +
+	"\x79\xf9\x7a\x0c\xf9\x50\x83\xf4\x82\x02\xbc\xb3\xd3\x21\xfa\x8d\x4c\xee\x1e\x63" +
+	"\xbb\xbb\xc1\xd8\xf0\xa6\xf6\x18\xdb\x46\x4c\x4c\x68\x4b\x57\x22\x7d\x53\xfc\xa6" +
+	"\x07\x92\x23\xae\x8f\x17\x51\x51\xa0\xda\x26\x3d\x70\x86\xf1\x18\xb2\x2f\x7a\x8c" +
+	"\x26\xed\xfc\x29\x3e\xb0\xd3\x99\xd8\xa6\x04\xd3\x24\x8b\x13\x60\x47\x18\x37\x45" +
+	"\x34\xf7\x5b\x98\xe2\xc6\x5a\x96\x01\xe2\x14\x4c\x71\xfb\x65\x33\x35\xb1\x86\x30" +
+	"\x44\x27\xfa\xdb\xb1\x84\x29\x1e\xe8\x08\x42\x4e\x0e\x1a\xc1\x1c\xc2\x5f\x50\xd8" +
+	"\x9b\xf5\x08\x28\x50\xa5\x59\xc4\x94\x4e\xd1\xd4\x31\xe7\x66\x13\x2a\x7f\x8d\xe7" +
+	"\x55\x1b\xaf\x18\x4f\xc3\x57\xdc\x48\xea\x5e\xe3\xaa\xe1\x33\xc5\x1e\xb7\xeb\x97" +
+	"\x47\x77\x57\xea\x98\xb0\x57\xd6\x38\x98\x62\xb7\x20\xb7\x53\x74\xdd\xec\x29\x76" +
+	"\x7d\xc7\x29\xf6\x36\x4e\x7e\xa4\x3e\x06\xb5\xcf\x00\xe2\xef\xc0\x56\x38\x1e\x61" +
+	"\x4a\x88\xc9\xc3\x94\x5a\xd4\x59\x78\x5a\xd9\x42\xf1\x8a\x8a\x22\x3a\x8b\x36\xfe" +
+	"\x56\x4e\x81\xd3\xca\xfe\xa3\xec\x01\x01\x4e\xe1\x2f\x51\x4e\x78\xa7\x35\x7d\x5f" +
+	"\xfc\x35\xcf\x84\x00\x4b\x7d\x93\x9a\xd7\x11\xd7\x11\x41\xfb\xc9\xdf\x7a\x92\xbc" +
+	"\x15\xd8\x21\x61\x5a\x4b\x61\x6a\x14\x25\xbd\xbc\x25\x86\x9a\xd6\x41\x3d\x06\x3f" +
+	"\x11\xb7\xa9\x96\x00\x27\x63\x0d\x93\xfb\x67\x9f\x38\x10\x4d\x41\xfe\x7b\xa7\x2d" +
+	"\xee\x8d\x90\x8b\x5c\xf2\x02\x28\xce\xd0\xb3\x26\x79\xff\xaa\x61\xda\xf2\x9d\x50" +
+	"\x6c\xd5\x9e\xe4\xcd\x6f\x36\xaa\x3b\x25\xb2\x08\x1e\x3d\x4c\x45\x92\x63\x25\xb5" +
+	"\xae\x09\x6b\x2a\x11\x15\xf8\x1a\xa6\x02\x7b\x4f\xe0\xc9\x7e\x8d\xaa\x97\x85\x53" +
+	"\xd1\x16\xd0\xcd\xe3\x0f\xd1\xcf\x31\x97\x60\x7a\x72\xc0\x47\x2c\xfb\xf3\xe2\xef" +
+	"\xa9\x59\xf1\x46\xdb\x27\x4d\x85\xea\x0d\x4d\x7d\xbc\x3a\xb0\xca\x1d\xcf\x7c\x38" +
+	"\xc2\x3d\x42\x03\x20\x0a\xe1\xc3\x94\xe3\x6b\x54\xfb\x39\xfc\x31\xcb\x05\xe3\x69" +
+	"\xe2\x65\x58\x37\x39\x9f\x80\x90\xa8\x4e\x05\x3e\x97\x7a\x6e\x91\x58\x8e\x3e\x3b" +
+	"\x95\x87\xa8\x8a\xab\x87\xad\x0e\x24\x3c\x37\x64\x6e\xa0\x15\x8b\x6f\x40\xd3\x2c" +
+	"\x4f\x68\x0d\x66\x5d\xa3\x56\xb5\x5a\x8b\x8e\x4a\x53\x69\xb0\x39\x79\xc2\x70\x1f" +
+	"\x8d\x84\x93\xf5\x46\xfd\x65\x1b\x32\x95\x5f\x1b\xec\x93\x9c\xfc\x72\xa3\xc6\xc7" +
+	"\x22\xfb\x66\x92\xac\x2b\xd7\xf8\x90\x5b\x85\xa9\x46\x7c\x9e\x28\x13\x5b\x73\xa3" +
+	"\x2a\x07\xf0\x2e\xf5\x45\x92\xd8\x97\x53\xc5\x71\x60\x55\xc9\xf6\xa8\x8a\x0b\xb5" +
+	"\xd9\xa8\x97\xeb\x6b\x33\x49\x5e\x3f\x14\x30\x34\x8c\xd1\x97\x20\x47\x63\x2a\x15" +
+	"\xf5\x9c\xee\xf0\x6c\xaa\xe9\xfd\x01\x9a\x76\xa6\xaa\x66\x94\xf6\xc2\xc4\x3e\xc6" +
+	"\x7e\x90\x8f\x97\xca\xcb\x0d\x51\xed\x84\xcf\x57\xbf\xd1\x4e\x7f\x75\xb3\xba\x98" +
+	"\xa4\x7a\x34\x21\x8a\xf7\xf4\x91\xb6\x1b\xed\x7b\x66\x0b\x20\x44\x69\x88\x3c\xf5" +
+	"\xb4\xe1\x21\x86\xa6\x3a\x6c\x18\x12\x11\x5c\xbb\x89\x09\x31\x04\x2d\x31\x66\x4c" +
+	"\x47\xa6\xb1\xb9\x88\x61\x1a\xa7\x6b\x6d\x4f\x03\x1e\xca\x34\x6c\x9c\x8f\x93\x30" +
+	"\x41\x61\xf2\x58\x51\xd3\xa8\x6c\x20\x21\xfe\x8d\x88\x61\xc2\x77\x1d\xd2\x10\x9a" +
+	"\x46\xfd\x86\x4d\xa6\x04\x86\xfe\x60\x7f\x32\x8d\x76\x02\xd9\x0c\x4f\x17\x23\x2f" +
+	"\x4c\x57\xc7\xf6\xbe\xed\x1f\xd4\x03\xfa\x26\x72\x9d\x35\xb3\x19\xe4\xb1\xb3\x29" +
+	"\x50\xca\x0b\x12\x67\x87\x19\xdf\x41\x2c\x7d\x06\x2c\x4b\x95\xf2\x01\x54\x73\xd0" +
+	"\x1c\xeb\x3b\x1f\xec\x85\xe7\xd8\x93\xcc\x33\xe6\xf8\x9e\xa9\xf6\x1c\x2f\xe6\xdb" +
+	"\x30\xc7\xcb\xb3\x62\x76\x57\xc3\x9c\x1e\xf6\x01\xe7\x24\x35\x1d\x52\xa6\x6c\x67" +
+	"\x0c\x90\x69\xec\xce\x68\x0a\x6b\x9f\x3b\xa7\x64\x9f\x89\x90\x2c\x93\x6a\x91\x9e" +
+	"\xb0\xd1\xb7\x30\xa7\xa7\x2d\x7b\x73\x7a\x6e\x9e\xf9\x89\xf6\x8e\x7e\xf6\x64\x36" +
+	"\x9e\x13\x21\xeb\x05\x24\x8f\xd4\x9d\x8d\xc7\x52\x45\x79\x37\xe4\xde\x7e\x35\xf1" +
+	"\x82\x67\xc4\x97\x72\xdc\x34\xa7\x23\x7b\x9d\x4e\x04\xdb\xb3\x80\xd5\xa3\xbd\x58" +
+	"\xb3\x49\x69\x2f\xf5\xf9\x3b\x76\x4b\x98\x13\x1b\x57\x15\xf4\x8d\x07\x10\x07\x56" +
+	"\x35\xec\x62\xe8\x08\xa0\x61\xcf\xff\x76\x6b\x1c\x7c\x90\x8b\xab\x34\xe3\x01\x99" +
+	"\xeb\x27\xd5\xaf\x0e\x18\xcc\xac\x98\x61\x26\x64\x18\xa9\x1c\x9d\x5a\x40\xc1\x0f" +
+	"\xb6\x01\x34\x7b\x8a\x69\xf3\xce\x19\xe1\x29\xb5\xcd\xd5\x4d\xc8\xe7\xac\xc0\x7a" +
+	"\x61\x86\xb5\xb5\x51\xa3\xdc\x98\xba\xa0\x1b\x9e\xa9\x78\x6c\xc3\x35\x5f\xe7\xf7" +
+	"\x6d\x2b\x57\xdc\x00\x58\xdf\x10\x2a\x26\x55\xab\x51\xb3\x1d\x36\x40\x8e\xb1\x66" +
+	"\xbc\x03\x86\x39\xf7\xdf\x2b\x20\x54\xf7\x6f\x09\x42\x8f\x94\x24\x04\x86\xba\x59" +
+	"\xea\x94\x52\x98\x8b\x65\x29\xe8\xb0\x20\xe3\x0e\x33\x2e\x2c\x02\xbc\x21\xcd\x0c" +
+	"\x04\x9d\x39\x4a\x6a\x61\x2e\x9c\xc8\x6a\xc0\x54\xea\x29\x37\x6a\xa0\x17\x01\x4b" +
+	"\x01\xad\x97\x93\x7b\x3c\x59\x1f\x02\x66\x9e\xdc\x69\xcd\x35\x2e\x3a\xb0\xcf\xe8" +
+	"\x88\xd8\xe3\x40\x4a\xb9\x83\x3f\xcf\x4c\x45\x12\x19\x0a\x69\x38\x7c\x66\x27\x92" +
+	"\xaa\x27\xb1\x1e\xcc\x15\x21\x0c\x3f\xcf\xbe\x09\x9e\x6b\x26\x60\x3d\x96\x42\x7e" +
+	"\x8d\x6d\x32\xf1\x06\x1f\xe5\x87\xab\xf3\x95\xf5\x94\x82\x68\x3a\xcc\x63\x7a\x3d" +
+	"\xf4\x15\xd9\x81\xb1\x29\x20\xe5\x0a\xf3\x58\xc3\x2c\xf1\x96\x88\x7d\x36\x0f\x11" +
+	"\x37\x8f\x6f\x7e\xe9\x7e\xc7\x53\xac\xb0\x80\x43\x8a\x15\x65\x12\xa8\x74\x8b\x01" +
+	"\x7d\x55\xad\xe9\xec\x70\xff\x34\xb3\x52\x12\xcc\x32\xeb\xae\x20\xad\xa5\xe1\x9e" +
+	"\x6d\xd3\x23\x5e\xc1\x86\x6d\x62\x9b\x14\xd2\x84\xe6\x33\x2e\xd9\xd3\xec\x3d\xca" +
+	"\x9c\x9d\x86\xb4\xdc\x4e\xa5\x70\x47\x83\x2e\xf5\x14\xd2\x36\xa7\xaa\x41\x9e\x18" +
+	"\x75\x6a\x83\xb4\xa5\x6f\xdb\x7e\xa7\xed\x19\x3c\xe2\xcf\x05\x90\xc9\x2b\xee\xa6" +
+	"\x4e\xfb\xed\x77\x6a\x21\xe1\x23\x90\xbf\xe2\xfa\xa5\xfd\x81\x25\xfe\x4e\x42\x2d" +
+	"\xf4\x9e\x90\x70\x08\x89\x54\x28\xed\x1e\xfa\x15\x2e\x37\xf3\x7c\x3a\xa6\x7a\x9d" +
+	"\x3d\xa4\x63\xd6\x41\x24\x1d\x33\xea\xa7\x24\xa4\xba\xc0\xef\xc1\x23\x55\x45\xa3" +
+	"\x39\x1d\x4b\xc1\x46\x2c\x11\xa2\x86\xed\x5f\xe2\xa0\xfd\x0c\xe9\xf8\x2a\x97\x9a" +
+	"\x59\x48\x37\x36\x9c\xa7\xf2\xbe\x47\xdb\x88\x15\x81\x2c\x03\xad\xc4\x74\x7c\x33" +
+	"\x1f\x5c\x01\x89\x40\x12\x6f\x12\x24\x3d\xbb\x74\x66\x9c\x57\x53\x39\x94\x92\x52" +
+	"\x48\x35\xda\xf6\xe6\x2f\x4e\x91\x10\x3b\x11\x40\x25\x86\x4e\x6a\xaf\xbc\x97\xc0" +
+	"\xd4\x81\x76\x79\xc0\x5d\xeb\x51\x36\x28\x35\x81\xcd\x9e\x12\x74\x1b\x1a\x02\xe9" +
+	"\x67\x8a\xdf\x2a\xdd\x80\x25\x55\x34\x29\xaf\xf0\x17\xf0\xe8\x46\xa4\x21\x5e\x62" +
+	"\xcc\xa6\x1f\xda\xfa\xe7\x89\xcb\x3f\x15\x80\xfd\x05\x83\x23\xfd\x9c\x99\xe9\x9e" +
+	"\x24\xdf\x91\xa5\x9f\x4e\xac\x05\x65\xc3\xb6\x98\xbb\x98\x27\xaa\x97\x00\xcc\x78" +
+	"\x16\x24\xc7\x92\x80\x8d\x33\x29\x00\xb5\xe7\x24\xc4\xaa\x2d\x4b\xd9\x6c\xb4\xad" +
+	"\x58\xf0\x3c\xe3\xe1\x1e\xde\x9f\xbe\x8f\x7f\xd2\x3d\xe3\xb6\x05\x22\x23\xa9\x07" +
+	"\x33\xb9\xe7\x16\x9e\xac\xf8\x62\x68\x3e\xf3\x6c\xbb\x8e\x27\x0c\x5f\x25\x68\xb0" +
+	"\x3c\xf3\x66\xc7\xf8\x27\x4a\x9a\xf4\x75\x36\x67\x5a\x84\x9f\xf9\xd8\x3c\xc1\xca" +
+	"\xc4\x2b\x12\x33\xcc\x73\x8b\x0b\x47\x34\x28\x7d\xf8\x15\xd8\x59\x31\xca\x9f\xf7" +
+	"\x66\x15\x20\x57\xec\xf2\x85\xf0\x18\xdb\x83\x3c\x6d\xd5\x00\x11\xf2\xec\xdd\x5e" +
+	"\xc4\xc4\xa9\x3c\x14\x54\x4e\x51\xc5\x67\x7b\x6e\xf1\xbb\xd4\xe7\xd8\x48\xfa\x2e" +
+	"\xd5\x4b\xff\x91\x53\x58\x85\xd7\x15\xa5\xd3\x3e\xe5\xc4\x54\xf7\xe9\x91\x09\x2a" +
+	"\x8b\x20\xbe\xd9\x14\x9e\x1c\x46\xf5\xf3\x52\xfa\x03\x26\x29\xdc\x53\xd3\x3f\x07" +
+	"\x29\x63\xe9\x0a\x7c\xcf\xb5\xbd\x7c\x29\x1d\x9b\xfe\x67\xe5\x8c\xf8\xac\x29\x3d" +
+	"\xb0\x92\x17\x48\x46\xe5\x5f\x1b\x80\xb3\xbe\x43\x49\xf6\x52\x15\x07\xc9\xd5\x32" +
+	"\xbd\xb3\x67\xfa\xb5\x9a\xe9\xe8\x4e\xb1\x79\xc9\xac\x67\xcf\x8a\x93\x2a\xf2\x96" +
+	"\x66\x67\x9f\x27\x8b\x21\x87\xcf\x67\x1d\x28\x1a\xf1\xcd\x84\xb6\x2b\x2c\xf1\x11" +
+	"\x40\xa9\xe6\x28\x50\xde\x4d\xd4\x7c\xf1\xe0\xbd\xae\x42\x18\x05\xa9\x02\x78\xef" +
+	"\x25\x0e\x0d\xcb\x25\xe5\x85\x54\x86\xe5\x96\x44\x5b\x58\xcc\xa1\xc6\x14\x16\xd4" +
+	"\x78\xa5\xbf\x04\x52\x23\x00\xcc\xc7\xf4\x92\xfa\x38\xc3\x82\xea\x4a\x0f\x4b\x5e" +
+	"\x22\x01\xb4\x7f\xc3\xc2\xac\x3e\x87\x25\xff\xee\x85\xbf\xbf\xb4\xe9\xb2\xa1\x7b" +
+	"\xb3\x70\xe8\x40\xc7\x20\x2c\x9b\x38\xaa\x61\x81\xb7\xc0\xf3\x6f\x1e\xc3\xc2\x01" +
+	"\x4a\x3c\xca\x05\xd1\xc2\x11\x96\x23\x56\xde\xb2\xc4\x2e\x9b\xf2\xa5\x6c\x33\x6e" +
+	"\xc7\xc2\xa2\xc1\xb7\x06\x0c\xe8\xe7\xb0\x94\x42\xaf\xa5\x82\x28\x1f\x68\x7e\x22" +
+	"\x15\x6f\x30\x4b\x90\x26\x85\x55\x9f\x7d\x75\x9a\x8d\xfa\xad\x63\x9e\xa3\x52\x8e" +
+	"\xf9\x95\x0d\x70\xe4\xe1\x3c\xc2\x1e\x46\x73\x9c\x15\xcd\xd6\x87\xce\x0a\x7b\x7b" +
+	"\xfe\x00\x2f\x38\x69\xa3\xb5\xd4\x74\x59\x1c\xe8\x85\xd0\x25\x30\x41\x96\x9a\xf7" +
+	"\xa8\x6d\x08\xc8\x6b\x59\xf3\xce\xcf\x75\xf6\x5b\x2a\x9d\x55\xc5\x95\xf2\x6d\x19" +
+	"\x4b\xf9\xe6\x7a\x3c\xf8\xb3\x33\xfd\x2c\x15\xf6\x40\x58\x86\x6f\x08\x96\xb1\xcb" +
+	"\xcc\x64\xc1\x3f\x8e\x15\x2a\xb4\x04\x54\xcd\x94\x01\x21\xf0\x1a\x1f\xf8\x2f\xee" +
+	"\x61\x8d\x16\x1d\x62\x8d\xf9\x35\xc2\x8a\x07\x37\xe2\xe8\xc6\x8d\xcd\x18\xb6\x86" +
+	"\x0f\xcc\x02\x85\x26\x16\x45\x07\xcd\x11\xeb\x23\x60\xc1\x2a\x19\x60\x2f\x02\x2a" +
+	"\x7e\x0b\x43\x1d\x03\xf2\x2b\x06\x6a\xf4\x73\xa5\xe9\xec\x2f\xc0\xc6\xc2\x00\x6e" +
+	"\xc6\x85\x24\x62\xc7\xc8\x57\x31\xd0\xe1\xdb\xea\x19\x44\x3b\x8e\x75\x9e\x0a\x01" +
+	"\x86\x63\x9d\xe7\xdc\xf8\x29\xb6\xca\x84\x58\x86\x1f\xa1\x49\x8f\xa4\x95\x18\xd1" +
+	"\x50\x1c\x5c\x92\x70\xd7\xaf\xce\xef\xcc\x0e\xd4\x43\xa4\x11\xe1\xde\xaa\x72\xfb" +
+	"\x02\x5a\x63\x67\x7f\xb1\xc2\x84\xb0\xd2\xfa\xb4\xaa\xdf\xac\x76\xd2\x82\x78\x4e" +
+	"\xf5\x5a\xac\x8b\xf0\xb5\x2f\x80\x91\x13\xc1\xfc\xa3\x39\x2a\x10\x90\x37\x16\xd0" +
+	"\x9b\x09\x64\x4d\xf1\xdb\x7f\xaf\x5d\xc0\x12\xd6\xf4\xbc\x9f\x9d\xb6\x53\xf2\x0b" +
+	"\xf8\xb9\x68\xf3\xda\x20\x52\xa8\x7f\x58\xe9\xeb\xf0\xb9\x7a\x55\xfb\xa3\xda\xb3" +
+	"\x8e\x4d\x51\xa3\x1f\x9e\xbe\x17\x9a\x4d\x11\xa6\x0b\x0c\x42\x03\xea\x94\xeb\x20" +
+	"\x32\x2c\x09\x78\x2c\x5f\x87\xf3\xca\x57\x3b\x08\xad\x3a\x08\x51\x44\xc5\xdb\x16" +
+	"\x07\x61\x65\x66\x9c\xe9\xd7\x16\x16\x7a\x76\x90\xef\xa8\xd0\x3f\x4c\x08\x8a\xca" +
+	"\xdc\xd3\x8f\xc7\x5a\x6e\xa9\x85\xfc\x18\x67\x2d\x4f\xa2\x40\xb1\x0d\x53\x5c\x63" +
+	"\xbd\x76\xde\x5c\x13\x14\x23\xb8\xca\xf9\xe8\x13\x57\xf7\x22\x50\xa9\x07\xfe\xdc" +
+	"\xcf\xad\x78\x36\xd9\x43\x4c\xba\xa9\xb1\x44\x04\x53\x29\x48\xe4\x32\xb3\x15\xc9" +
+	"\xcc\xc3\xd9\x9a\x3e\x9f\x65\x0e\xd9\x82\x1a\xdd\xd1\x14\x85\x08\x21\xd4\x88\x97" +
+	"\x40\xf0\xb1\x86\x85\xa1\x1e\xd3\x23\x0a\x38\x14\x22\xd4\x42\xee\x03\x37\xfa\xc4" +
+	"\xdc\x31\x96\xd3\x57\xdc\xe2\x99\x8e\x12\xbe\x30\x58\x25\x50\x4b\x7c\x13\x78\xc6" +
+	"\x3d\xf8\x7f\xc5\xb7\x3e\xd9\x57\xb4\xc8\x0f\x1e\x10\x87\x58\x2c\x6c\xc7\xc8\x99" +
+	"\x5d\x3a\xf1\x05\xff\xd3\x43\xc3\xa8\x29\xbe\xca\x65\x7a\x72\x1e\xf0\x85\x1f\xe1" +
+	"\xe8\x45\x32\xa2\xaf\x31\xbb\xb3\x93\xaf\x71\x64\x58\xad\x5f\x62\x01\x12\xfa\x85" +
+	"\xf3\x14\xd3\x22\x48\x25\x11\x63\xe3\x11\x5e\xb1\x46\x05\x2a\x49\xe8\x73\x92\x9c" +
+	"\x16\x42\x90\x14\x8c\x1a\x04\xde\xb1\xf7\x3b\x0c\x09\xb7\xcc\xad\xe8\x2b\x7b\xdc" +
+	"\x61\x00\x91\x48\xb6\x94\x90\x74\xbc\x8a\xd6\xfb\xd7\x38\x96\xb0\xc5\x87\xad\xb1" +
+	"\x1b\xc2\x62\xdc\xc3\x6e\xce\xc8\x66\x1e\xf7\x60\x06\x5a\x47\x89\x9c\x70\xd8\xb4" +
+	"\x0c\x90\x07\x34\x5b\xfe\x2d\xd3\x09\xbf\x13\x5d\x0b\x0f\x0b\x31\xdf\xa1\x17\x00" +
+	"\x1a\x62\xb8\xfe\xc7\x7f\x74\x5a\x72\xd3\x9e\x67\x4b\x78\xc1\x4e\x0b\xe1\xdb\x71" +
+	"\x4c\x9d\x16\xcd\x1b\x5b\x42\xbe\x4f\xc6\xae\xdd\x92\x3b\xdf\xa7\x6e\x30\x29\x51" +
+	"\xc3\xdf\xee\x38\xd7\x5b\xa6\xa1\x71\x4f\xcf\xe2\x03\x25\x6d\xb7\xd3\x2f\x83\x67" +
+	"\xe0\x73\xd7\x00\xce\xd1\x99\xb3\xb6\xdc\x4e\x5c\xb0\xbb\xa3\xfb\xef\x8f\xbb\x6e" +
+	"\x7f\x15\x74\xbe\x6e\x07\xdc\x7c\x32\xdc\x6d\xdf\xb7\x40\x0b\xf7\x4e\x0e\x4c\xf2" +
+	"\xbd\xfd\x90\x47\xee\x63\x5a\x75\x64\xdf\x46\x75\xdd\xac\x6d\x88\x75\x09\xb9\xaf" +
+	"\x5d\xb7\x60\xfb\xb8\x28\xdb\xb0\x03\xd7\x60\x11\x62\x1f\xef\xa0\xe3\x03\x3b\xd6" +
+	"\x84\x67\x32\x4e\x8e\x78\x9f\xb6\xde\x01\xf3\x55\xdb\x1c\x64\xa0\x08\xeb\xf7\xe8" +
+	"\x8e\x9d\x0f\xbc\xbf\xe1\x46\xb9\xf4\x9e\x41\xda\x58\x88\xaa\x06\x7b\x3c\x64\xfd" +
+	"\xb5\xc7\xfa\x60\xfd\x16\x75\x37\xc8\x33\x3f\xa8\x0b\x8a\x50\x3c\xb1\x66\x16\x61" +
+	"\x40\x5b\xf9\xdc\x86\x28\xbe\xfe\xb8\xcb\x62\xf1\xd8\x71\x4e\x8c\xb3\x5b\x7c\x00" +
+	"\x8b\xb9\xb3\xb3\xb3\xa3\xe8\x3e\x2a\x42\x2b\x01\xcb\x79\xcd\x91\xeb\xeb\xb9\x29" +
+	"\x0a\xc6\x5e\x22\x7c\x36\x3c\x3e\x3a\x19\xe7\xed\xe4\xb1\x90\x80\x88\xe4\x30\x6a" +
+	"\xd5\x2f\x6c\x2b\x6e\x37\x8c\x78\xf2\x93\x1f\xc6\xe6\xe8\x6d\xce\x00\x8f\x97\x42" +
+	"\xd6\xc8\xf1\xa1\x9c\x4f\xe2\xe4\x10\xb3\x82\xbd\xa0\xbf\x2b\xea\xeb\xc6\x5e\xca" +
+	"\xed\xe6\x90\xd6\x90\xb7\x43\x1a\xac\x94\x03\x13\x59\x01\x8e\x20\x96\x44\xb0\x2c" +
+	"\xdc\x0f\x3e\xcc\xcf\xbb\xa1\xdb\x35\xa1\x95\x53\xf5\xa3\xda\xf5\x45\xb1\x12\x63" +
+	"\x6f\x01\x1d\x11\x47\x7c\xa5\x35\xfe\xf6\xd5\x36\x57\x1c\xd3\xbe\xfd\x5d\x6f\x5e" +
+	"\x23\x06\xfa\xd6\x2a\xc3\xcf\xa2\x12\xdc\xe2\x93\x8f\x2a\x42\xba\x3b\xe0\xc3\x9f" +
+	"\x9e\x3b\xce\x43\x46\xb6\x13\xdf\x29\xec\xf4\x48\xf6\x50\x3b\xfc\xb1\x1e\xf6\x51" +
+	"\x7d\x84\xee\xd8\xa5\x52\x2d\xe8\x27\x45\xd7\x2f\xf9\x02\xbc\x97\x04\x1c\xe6\xe1" +
+	"\xaf\x13\x67\x71\x56\xbb\xd1\x7b\xd8\x2f\x53\x77\xb8\xb9\x3e\x38\xb4\x33\xb6\xd3" +
+	"\x41\x03\x11\x30\x3a\xc9\xb7\x9d\x06\x08\x94\x3c\x18\x32\x3b\xd5\x23\x51\x12\xd6" +
+	"\xf9\x44\x2c\x23\x16\x32\x1c\xcf\xd9\xad\x14\xbb\x83\xc7\xc0\x65\x24\xae\xd7\x16" +
+	"\xe7\x4c\xc9\x27\x1c\xae\xd2\x52\xfd\xc6\x99\xda\xb8\x7d\x5e\xa5\x81\x2d\x7e\x38" +
+	"\xf2\x93\x42\xb3\x36\x51\x07\xfe\xb6\x33\x3a\xa0\x47\x3e\xbe\x22\x8e\xcf\xd0\xb0" +
+	"\x2b\x01\x83\x76\xd5\x6e\x58\x3e\x7c\x67\x31\x0f\x09\xc8\x6d\x96\x6d\xc5\x8f\xd1" +
+	"\xef\xfa\x0e\xe4\x0e\x30\x3b\xe4\xa5\xca\x9d\x42\xa1\x04\x89\x27\x99\x09\x56\x24" +
+	"\x2e\x45\xa6\x4e\x1c\xed\x72\xb8\x1b\x9b\x83\xb8\x06\xa4\x1f\xf8\x8a\xc5\xe7\x4a" +
+	"\x39\x5b\x10\xc7\xa5\x60\x57\x42\x91\x77\x64\xcd\x82\x23\x5b\x45\x21\xc1\x04\xd2" +
+	"\xd8\xdd\xb8\xa5\x61\x38\xe1\x18\x46\xce\xc8\xca\xe8\xd4\x9d\x22\x47\xc7\xbd\x89" +
+	"\xbb\xac\xc1\x5a\x13\x6f\x32\x13\xfb\x61\x00\x12\x21\xee\xb4\x32\x2a\x3e\x63\x46" +
+	"\xef\xf1\x85\x4f\xa3\xd1\xff\x5c\x83\xe0\xd9\xc0\xbd\xc5\xcc\x4e\xdd\x79\xc9\x63" +
+	"\xe4\xcd\xfc\x7e\x4c\xb5\xbc\x67\xf7\xe4\xb2\xb8\x83\x10\x8c\xd0\x1c\xfd\xa4\xda" +
+	"\xe5\x8f\xe4\xf6\x45\xc2\xc4\x76\x7b\x71\xb1\x94\xf5\x76\x01\xc3\x96\xc4\x5d\xb8" +
+	"\x3c\x78\x4d\x21\x39\x13\xc1\x35\xee\xed\x88\x44\x76\xc2\x7f\xce\x59\x84\xea\xed" +
+	"\x11\x05\x3d\x33\x01\x7f\x41\x43\x0e\x98\xa7\x3e\xae\x51\x2c\xe9\xcd\xb9\x19\xa4" +
+	"\xe3\x11\xc0\x76\x9f\xf0\xf9\xf4\x98\x9f\x5b\x43\x91\x14\xd3\x6f\xe1\xc3\x3c\x99" +
+	"\xda\xce\xa8\xf5\x0f\xcf\x1e\x3a\x4d\x19\xc0\x2b\x84\x9d\xbe\x71\xcc\x51\xe3\x8e" +
+	"\xb7\x88\xa3\x5f\x2b\x47\x36\x39\xd9\xb8\x5a\x6a\x72\xb2\x21\xc1\x17\xa0\xc1\xaf" +
+	"\x12\x50\xf8\x79\x1c\x68\xbc\x23\xde\x21\xb4\x2e\x1b\x90\xf8\x17\x90\xea\xc3\x52" +
+	"\x60\xfd\x51\x0b\x36\xf6\x27\xdd\x40\x8b\xa2\x39\x9d\x60\x5f\x74\x2a\xce\x0b\x7f" +
+	"\xed\xf4\x77\x46\x45\x62\x3a\xa3\x5b\xc6\xc7\x0b\xe3\xfe\x78\xd9\xce\xe9\x4c\x0f" +
+	"\xfa\xfa\x89\x12\xd2\x61\x3e\x25\x94\x3b\xdd\x9e\xc6\xce\xb4\xb8\x4d\x76\xda\xf0" +
+	"\x1e\x80\x9b\x0c\x9e\x92\x8e\xdb\x08\x3c\x1d\x16\xd6\xf0\x4c\xc7\x89\xd5\x21\x6a" +
+	"\xe3\xda\xb8\x9e\xe9\xbc\x9d\x26\x50\xce\x3d\xd3\x80\x78\x62\x25\x47\x45\xe5\xdc" +
+	"\xfd\x23\x0c\x99\x46\x9c\x09\x27\x9e\x67\xc2\x94\x8f\x00\x91\x3b\xd3\x00\x8e\x0e" +
+	"\xdc\x6d\x08\x4e\x0e\xf0\x62\x80\x49\x05\x36\x87\xe9\x1e\x8c\x7a\x21\xb1\x54\x84" +
+	"\x2e\xf3\x3d\x70\xdf\x6a\xe7\x4a\x77\x39\x57\x9a\x9b\x37\xc4\x60\x80\x95\xfd\xf4" +
+	"\x4d\x21\xd6\xe4\xc6\x53\x65\x04\xb9\xf9\x77\xbc\x50\x5e\x3a\x85\x6c\x63\x08\x62" +
+	"\x89\xad\x8e\x28\x73\x8b\x97\x9c\x0b\x6c\xce\x97\xe7\x4e\xc3\xe9\xc0\x16\x2f\xc6" +
+	"\x95\x0a\xbf\x24\xfd\xc3\xf9\x69\x5e\x36\x7c\xc2\x6c\x05\xdb\xf1\x72\x86\xb3\x2c" +
+	"\x98\x28\xe7\x83\x2f\x5a\xb6\xcc\xc5\x76\x99\xc0\xff\x44\xb6\x80\xcd\xb5\xa2\xbe" +
+	"\x41\xc8\x73\xb6\x8c\x17\x61\xcc\xba\x24\x16\x01\x68\xc7\x85\xff\x24\x72\x63\xcd" +
+	"\x58\x60\x52\xfe\x88\x1e\x11\xff\xcd\xa0\x37\xb6\x8a\xe8\xaf\xa8\x56\xe5\x3d\xa7" +
+	"\xfa\x87\x28\xfa\x07\xa3\xe1\x03\x53\x46\x88\xee\x61\xf4\xc6\xc3\xa1\x6c\x6b\xc8" +
+	"\xf0\x6a\x6e\x30\x8b\xf7\x1e\xe8\xa4\x86\xc7\x0e\x0b\xd3\xcb\x84\x1c\x01\xe7\x06" +
+	"\x35\x3d\xe5\x2f\x58\xc8\x42\x4d\x9c\x68\x96\xd1\x14\x78\xe8\xbe\x6d\x77\x37\xf7" +
+	"\x90\x02\x7a\x43\x70\xa3\x80\xdd\x6c\x62\x42\x3d\x2f\xb7\xe8\xb5\x67\x9e\xa8\x3e" +
+	"\xa0\x93\xcb\x68\x51\x58\xd1\x43\xf4\x74\xbb\xdd\x15\xf5\x07\xe6\xcd\x24\x16\x8c" +
+	"\x90\x6a\xd5\xd6\xa2\xc2\x5b\xc1\x4b\x42\x4d\x6d\xec\x6e\x15\xdc\xdd\xf2\xb8\xdf" +
+	"\xc6\xa4\xf0\x6a\x8c\x48\x8f\xe8\x44\xa6\x0b\xdb\xe9\xac\xac\xa6\x58\xf2\xaa\x3b" +
+	"\xd5\x0c\x6b\x96\x01\xf1\x09\x44\x72\xd6\x42\xc4\x0a\x6a\x5a\x9e\x49\x42\xa3\xb3" +
+	"\x72\xd0\xa6\x76\x65\x3f\xad\xe1\x8a\x9e\x8b\xe0\x12\x02\xe7\x42\xd7\xe5\x89\x11" +
+	"\x1f\xe8\x36\x89\xc5\xfc\x55\xc5\x77\x37\x8b\x2d\xbf\xc5\x0d\x63\x51\x91\xd1\x1d" +
+	"\x37\x75\xd5\x71\x4a\x79\xbf\x91\xcd\x0a\x21\x30\x34\xea\x4f\xf8\x8d\xd3\x6d\x15" +
+	"\x4b\xef\x47\x81\x01\xc3\x53\x22\x48\x85\x73\x2c\x66\xd0\xca\xa3\x07\xa6\x9d\x9a" +
+	"\x51\xa4\x42\xad\xea\x08\x71\x92\x3e\x87\xb3\x05\xcf\x51\x3d\xf8\x94\x90\xdd\x73" +
+	"\x41\xc9\x39\xaa\xeb\xde\x83\xf4\xfc\xe1\xac\x1d\x8c\x5f\xe5\x15\xea\x1c\xb5\x0d" +
+	"\xab\xb6\x10\x26\xba\x43\x9c\x41\x07\x72\xe3\x0d\xd2\xaf\xee\xcd\x09\x09\x4c\xdc" +
+	"\x4a\x42\x59\x07\x3a\x4e\x4c\x64\x75\x28\xc0\x3c\x56\xcc\x75\x4c\x59\x25\x88\xc1" +
+	"\xa2\x61\xe7\x4a\x5a\x31\x6e\xbf\x9a\x0e\xa3\xaa\xae\x90\x9c\x6a\xc9\x4a\x75\xa0" +
+	"\x30\xf7\xdf\xc8\xbf\xbf\xec\xc1\x03\x2e\xd1\x55\x4f\x2c\x51\x0b\x86\x9b\x33\x32" +
+	"\x59\x11\x2d\xcb\x35\xe6\x6d\x8a\xd8\x82\xea\x79\x98\x97\x2a\xf7\xe1\x91\x7f\xf0" +
+	"\x7c\x1e\xa7\x17\x29\x1d\xc3\x51\x93\x99\xd6\xf8\x8d\x7a\x5e\x4d\xf1\xd1\x70\x06" +
+	"\xc8\x88\x32\x05\x56\xa4\x26\x58\x6f\x1e\xa6\x2a\x21\x3b\x4e\x1e\x03\x15\x57\x5e" +
+	"\x29\x6e\x80\x17\x1b\x72\x69\x40\xef\x38\x25\xab\x29\xbe\x61\xe5\x54\xdc\xa5\xe8" +
+	"\x64\x59\xd3\xc3\xe2\x42\x00\xe4\x4c\x15\x6b\x4f\x2c\xda\x12\x7a\x10\x8b\x0c\x0f" +
+	"\x27\x4e\x72\x02\x12\x67\x41\x15\x1a\x5f\x29\x07\xea\xe7\x42\x18\xb5\x22\x9d\xd8" +
+	"\x44\xf8\xa2\x33\xe8\x9a\xe8\x6c\x35\x61\xca\xa0\x77\x4a\x07\x3d\x94\x3d\x0e\x9d" +
+	"\x5e\x14\xb3\xb4\x94\x7e\xd0\x62\x24\xc7\x1d\x69\x1c\x83\xd9\xdb\x56\xd0\x58\xce" +
+	"\xa1\x7e\x04\x8f\xa0\xf1\x43\x42\xc1\xf5\x35\x14\x13\xbc\x84\xc7\x15\xbd\x00\x1e" +
+	"\xf9\xe2\x0d\x58\x2f\x6a\xb2\xa0\xfc\xd0\x94\x7e\x55\xaa\x06\x2e\xa6\x9c\x44\x46" +
+	"\x5b\x48\x19\xf5\x7e\x90\x7b\x7e\x66\x66\x62\x6f\x5e\x11\x71\x36\x9e\xc4\x0c\x05" +
+	"\xb1\x0f\x02\xef\x83\x71\x50\x53\x6e\x6d\xb8\xcd\xa6\xd8\x7a\x35\xe9\x7c\x4d\xd2" +
+	"\x16\xcf\x66\x49\x9b\xcb\x84\x6a\xda\xd8\xc6\x92\x02\x93\xd5\xee\x69\x56\xac\x49" +
+	"\x7a\x31\x10\x7a\x46\x22\xa2\x38\x8f\xd7\x71\x29\x78\x28\x65\x5a\xd9\xa3\x2f\x08" +
+	"\xd1\x12\x3b\x3a\xe1\x6a\xd3\x9d\x0d\x0e\x09\x8c\x06\x0c\xa5\xb4\x2b\x61\x04\xa2" +
+	"\xb4\x42\x61\x47\xb1\x63\x44\xbd\xa3\x4a\xd6\xfb\x7b\xf8\xdc\x6a\x93\x2c\x19\x6c" +
+	"\x8a\xad\xe9\x24\x62\xa6\x3e\x21\xc8\xde\xe6\x44\xbc\xad\x14\xf5\xb8\x33\x5e\x9e" +
+	"\x6e\x1b\xbf\x9a\xb4\xb6\xea\x96\xd9\x4a\x18\xe2\x23\x9c\x3a\x2f\x41\xcb\xe4\x37" +
+	"\x64\x8c\xb9\x73\x4f\x97\x3d\xe3\x6a\x05\x34\xb6\x76\x5b\x8f\x6e\x2d\xbb\x75\x69" +
+	"\x65\x61\x13\xea\xfe\x52\xb5\xa0\x76\x5e\x53\x1d\x58\x69\x36\xb3\xc4\x6d\x68\xb3" +
+	"\x0a\xe0\x19\x08\x42\x0f\xd2\x35\xdc\x71\x81\x83\xd6\x52\x1c\x03\x51\x36\x8c\x50" +
+	"\x8b\x0b\x5b\x53\x63\xc6\x63\x65\x78\xec\x59\x77\x86\x8f\xba\x36\x2a\x42\x18\x0c" +
+	"\x49\xa3\x0f\x12\x36\xcf\x10\x29\xdd\x23\x35\xd2\x97\x90\xf2\x1d\x57\xc3\x4d\x50" +
+	"\xfd\x57\xde\x21\x3b\x22\x53\x51\x26\x7a\xa8\x7d\xe1\x0e\xaf\xd8\x8a\xd5\x18\xe9" +
+	"\xde\xbc\x0a\x91\x50\x93\x8b\xa2\x00\x94\xf6\xed\x5f\x9f\x5d\xb9\x2e\xab\xb7\xda" +
+	"\x77\xbe\x33\x7e\x3b\x0b\x43\x48\x05\xdf\x41\xb2\x09\x91\xad\x9a\xb3\xb8\x41\xac" +
+	"\x77\xbf\xd1\x25\x17\xa1\x2b\xbe\x6b\x3c\x43\xcd\x8f\x49\xd3\x53\x9e\x8a\x8c\x9a" +
+	"\xf3\x3c\xdb\x28\xcd\x4f\x1b\x45\xd9\xf4\x3a\x2b\x52\x74\xcc\x62\x0f\xeb\xfb\xf9" +
+	"\x9c\x54\x5a\x46\x93\x8c\x2f\x0e\xf0\x3b\xa7\xff\xf2\xec\x6c\x59\xe0\x28\x6c\xb4" +
+	"\x88\x84\xdd\x15\x3d\x02\x34\x21\x31\x81\x4d\x15\x1d\x38\x03\x1c\xb2\x40\xea\x6a" +
+	"\x25\x22\xb2\xaf\x65\x7a\x49\xfc\x0e\x90\xba\x30\xc0\xd6\x07\x90\x0e\x6b\x00\xf3" +
+	"\x8f\x2f\x44\xe4\x3b\xad\x0c\xa1\x16\x9b\x7f\xb1\xbf\x25\x8d\x6d\xe4\x82\xe9\xeb" +
+	"\xa1\x70\x12\x02\x5e\xd4\x80\x51\x5d\xf7\x50\xc7\x43\x8b\x0b\x61\x0d\x98\x87\xc6" +
+	"\x11\x95\x0d\xe4\x37\x30\x0e\x8b\x38\x66\x7b\xc4\xc5\x10\xa5\x62\x85\x62\xdb\x47" +
+	"\x90\x4a\x95\x49\x4c\x8b\xa8\x07\x62\xbc\xaa\xc9\x09\xdb\x55\x2c\xd4\xe2\xf6\x6d" +
+	"\x87\x8c\x06\x4b\x3e\xde\xf4\x78\x39\xc0\x7a\xf4\x98\x51\xdc\xe4\x69\xc7\xac\x77" +
+	"\x07\xdc\xbf\x41\x49\x16\x6a\x6e\xa7\x85\x30\x73\x83\x2f\xd7\x91\xa2\x91\xf2\x76" +
+	"\x90\xd9\xbe\x28\x53\x8f\xa1\xc5\xd3\x2b\x7c\x3a\x4f\x0b\x44\x15\x1b\x62\xd8\x69" +
+	"\x05\x35\xae\xfb\xb4\xa6\x0d\x8a\x08\x97\x3a\x77\x73\xf3\xdc\xe2\x70\x71\x24\x88" +
+	"\x9c\x84\xcd\x95\xb5\x2b\x14\x3b\x57\xde\x14\x8a\x09\x6a\xdc\x70\xd2\x05\x95\x17" +
+	"\x36\x80\x3e\x0b\x12\x5d\x78\x03\xa2\x56\xdc\xe4\x9c\xd8\x36\xa1\x24\xed\x08\x4e" +
+	"\x02\x76\xad\x85\x43\x2b\xc4\x44\xef\x42\x92\x2e\x0a\xa9\x8b\x62\x7d\xe4\x05\xf8" +
+	"\x6e\xa8\xa1\x8e\xa5\xda\x4f\x78\x90\xd0\xe3\x6c\xd9\xae\x42\x84\x3b\x70\x87\x26" +
+	"\x22\xfe\x9b\xbc\xef\xfa\x78\x28\x37\x39\x45\x2c\xa3\xdf\xa0\x7a\x67\xe0\x89\xfd" +
+	"\xa3\x29\x35\x85\x36\x8d\x07\x2f\xcf\x09\x14\x67\xd5\xdf\xdc\x1a\x38\x7e\x08\x0d" +
+	"\x1f\x09\x62\x7e\x37\x96\x52\x85\xfd\x6f\x09\xd5\x1f\x59\xd9\x6a\x71\x69\x04\xe8" +
+	"\xc0\x8a\x30\x6d\x5d\xdf\x0a\xb7\x4f\xec\xf0\xb0\xb5\xcd\xad\x60\xc3\x09\xd0\xb9" +
+	"\xb6\xa5\xa3\x30\xa6\x82\xbb\x1a\xfd\x4d\x42\xa6\xa0\x2e\xe4\x36\xb8\x9c\x39\x21" +
+	"\x63\xc7\x74\xb6\xa0\x86\x79\x84\x96\x1a\x1a\x21\x2d\x7d\x23\xea\xdf\xe6\x80\xb3" +
+	"\x79\x7d\x08\xa8\x7d\x88\x35\x9a\xae\x46\x5b\x4d\xcc\x2b\x7a\xdf\xe2\x95\x56\x44" +
+	"\x90\x1d\xba\x33\xe8\x00\xf4\x5d\x0c\x26\x95\xbb\x32\x29\x54\x43\x98\x85\xb2\xc8" +
+	"\x62\x86\x8b\x02\x83\x11\xbd\x35\x6a\xd9\xbc\x2d\xd4\xbe\xe0\x9a\xf1\xb4\xc5\x1e" +
+	"\x70\xce\xca\x80\x3d\x80\xe8\x5d\xb5\x82\x55\x03\x8f\x2e\xa7\x74\xf9\x70\xe2\xdc" +
+	"\x15\xb1\x5d\xc8\x4c\x6a\x41\x77\xfe\xda\xdf\xe8\x24\xe8\x2e\x45\x97\xf7\x23\xff" +
+	"\x8a\x2e\xb9\x6e\x02\xcc\x16\x50\x64\x5c\x50\xd3\x0f\x11\x92\xd6\x0e\x48\xe7\xbe" +
+	"\xb6\xd6\x78\x62\xd7\xde\xd6\x9a\x66\x56\x52\x03\xf6\x36\x35\xbd\x39\x6f\xb6\x95" +
+	"\x69\x4c\x79\xd4\x59\x48\x11\x73\x99\x3c\x83\x81\x9c\x89\x0a\x81\x9d\xf1\x03\x81" +
+	"\xee\x11\x5a\x46\x37\x0b\x82\xea\x37\x46\x9f\x32\x3f\x68\x19\x33\xd6\xd0\xf2\x56" +
+	"\x64\xaa\x4c\x4b\xb8\x8c\xbe\xe5\xc3\xbe\x72\xfe\xb1\x8f\x98\x7f\x8c\xe3\xd5\xf2" +
+	"\x4f\xbf\x3c\x09\xd1\xf3\x05\x55\xf5\x32\xaa\x64\x01\xf1\x0f\x93\x96\x10\x5c\x58" +
+	"\x14\x2a\x76\xa7\xa7\x3e\xf6\x2b\x1f\xd2\x4f\x81\x1f\xb8\xb2\x84\x01\xec\xa9\xaf" +
+	"\x6c\x2c\x10\x52\xba\xf6\x37\xed\x95\xdd\xba\xb2\x11\xa2\x5c\xf4\x9a\x99\xd0\x01" +
+	"\x9b\xe7\x61\x85\x6b\xaf\x8b\xbe\x42\x07\x7e\x5d\xb2\xa3\x6c\x1b\x7e\xe7\x6f\x5a" +
+	"\x8d\xaa\x78\x80\x7d\x3f\xf6\x5a\x52\x39\x68\x1e\xec\xb9\x71\x7c\x6c\xc4\xca\xb5" +
+	"\xa7\x82\x48\x45\xc1\xc4\x08\x5f\x0b\x64\xc1\x2c\x0c\x58\xa9\xae\x01\xf6\x09\xa2" +
+	"\x02\x78\x61\xd1\x6d\xf5\xdd\x8a\x7f\xae\x4d\x21\x6c\xda\x66\x0b\x08\xd4\x5a\x66" +
+	"\xc3\xe0\xd0\x93\x06\xc7\xe2\xb6\x29\x3c\x53\xdb\x46\x3d\x43\x83\xd3\x1f\xe4\xeb" +
+	"\xd9\x1e\x03\x8f\x9c\x72\xa1\xe3\x0c\x6d\x4f\x16\x53\xb7\x61\xaa\x29\x53\xa3\xb6" +
+	"\xc3\x95\xe2\x2f\x9c\xee\x06\x2b\x3c\xb4\xbd\x90\xa5\xbc\x92\xb5\xf9\x5e\xee\xb1" +
+	"\xc7\x46\x50\xe5\xd9\x43\x09\x10\x73\x41\xac\xa4\xb1\x58\xae\xc3\x0c\x14\x70\x38" +
+	"\xa3\x87\x1d\xf1\xf7\xf7\x0a\xed\x60\x85\xc4\xf1\x2a\x5c\x61\x51\xbb\x9b\xd9\x87" +
+	"\xb6\xc3\xb4\xfe\xdb\xc1\xe8\xe0\xd3\x00\xc4\x15\x52\x92\xd6\x40\xd0\x84\xc3\x83" +
+	"\xa3\xbc\x89\x9f\xd6\x1d\x15\x4b\x32\xbd\x5b\x21\xe9\xdd\x82\xbc\xe2\x47\x31\xa1" +
+	"\x1a\x49\x27\x76\x6b\x80\xb6\x96\x64\x60\xb0\x74\x1d\xe3\xc1\x1f\x46\xe0\x61\x6f" +
+	"\x74\xdc\x6f\x74\xa2\xc2\xa5\x15\x10\x64\x5d\x12\xa4\x61\x2b\xc0\xbe\xb5\x9d\xc9" +
+	"\x7a\x13\x74\xbb\x41\xe3\x7a\xdb\x1e\xb8\x20\x11\xa2\x01\xcf\xdb\x3e\xab\x9d\xe9" +
+	"\x1d\x1a\xdc\xfb\x9f\xd0\xce\xac\x19\xfd\x84\x05\xca\xd1\x4d\x33\x18\x2e\x1a\x87" +
+	"\x5d\x6b\x1b\x01\x50\x26\x42\x24\xb4\x3d\xa0\xd7\x87\x5c\x04\x13\x7b\x3e\xe1\x49" +
+	"\x7f\x34\xa2\xf6\x3d\xb7\x22\xfb\xec\x86\xa3\x40\xbe\xda\x59\x2c\xea\x8f\x80\x0a" +
+	"\x2c\x07\x5b\x84\x13\x89\x40\x3b\x4b\xb1\x19\xf7\x24\x7c\x99\xee\xc2\x80\x6e\xb8" +
+	"\xdd\x63\xdd\x38\xc5\xc6\x6c\x67\xc5\xfa\x1a\xa2\x67\xe0\xa0\xe0\x1c\xbc\xe0\xf0" +
+	"\x5f\x5d\x70\xa0\xcc\xca\x5f\x56\x1b\x80\x6d\xd3\xb5\xe5\xc0\x8f\xe4\xf6\x01\xaa" +
+	"\x2a\x49\x84\x23\xd3\x4d\xf1\x24\x70\xae\x80\x3e\xc1\x0d\x2e\x81\xda\x45\xda\x8a" +
+	"\x30\xca\xd0\x15\x5a\x27\x6a\x2a\x75\xc3\x3a\xd4\x1a\xb7\x47\x0f\x17\x82\xd6\x35" +
+	"\x3d\x9e\x24\xe9\x62\xb6\xce\x06\xb0\xf5\x7b\x33\x82\xb8\x0f\x8b\x25\x5c\x1c\x2e" +
+	"\x51\x2b\x60\x77\x6b\x03\x92\x50\x3e\x11\x65\x28\x3b\xb2\x14\xc2\xf7\xe1\xc3\x20" +
+	"\xce\xaa\x0c\x40\xbb\xea\xd6\x93\x9f\x3b\x85\x9a\xba\x4a\x4f\x4b\x69\x71\x28\xdf" +
+	"\x06\xd3\x8d\x2f\x6a\x26\xb4\x8e\x58\x4a\x71\x5b\xe0\xb5\x4e\xf5\x60\xed\xc4\xb1" +
+	"\x21\xdf\xb6\xab\x67\xf4\xbc\x9b\xae\x3d\x32\x0d\x6d\x0a\xa0\xfa\x68\xdd\xa6\x93" +
+	"\xce\x56\x18\x47\x0a\x19\x37\x87\x6a\x93\xc2\x52\xdc\x11\xe2\x8a\x67\xfb\x46\xa7" +
+	"\xc3\x51\xbd\xd3\xe0\xf2\x92\x66\xf5\xb7\x9d\x47\x2f\xa7\xcf\xa1\xbd\xdc\xf3\x6c" +
+	"\x2f\xe7\xdb\x76\x6a\x78\x12\xf6\x24\xce\x4b\x2c\x50\xbd\xb0\xdb\x67\xa8\x63\xac" +
+	"\x0e\xb7\xb9\x75\xc6\x21\x45\x56\x57\x0e\x01\xe9\x80\x03\xc0\x95\x0d\x86\xd2\x27" +
+	"\x19\xb0\x56\x86\x6c\x9e\x4d\x41\x9d\x7f\x1c\x59\x1b\xc2\x89\xa2\xee\x55\x2d\xc3" +
+	"\x02\xc7\x9b\x0e\x31\x99\x5b\x1f\xd3\x54\xb8\x3c\xac\xf8\x51\x67\x6b\x98\x0b\x56" +
+	"\xd0\x07\xf1\x3a\xd7\xa6\x40\x01\x00\x6a\x30\x1e\x6e\x34\x87\xeb\x08\xdc\x5c\x0b" +
+	"\xa1\x06\xcb\xbb\x8c\xc7\x3c\xf4\x0e\xe3\xf1\xdc\x8a\x9c\x43\x3c\x8c\x6f\xdb\xc6" +
+	"\x83\x09\x81\xa7\x61\x2f\x8e\xae\x96\x00\x3e\x1f\x1e\x04\x71\xdf\x00\xc6\xae\x30" +
+	"\x00\xbb\x0e\xc4\x9c\xab\x5f\xe1\x8f\x5b\xb9\x4e\xba\xc4\x78\x30\x64\x45\x2b\xfc" +
+	"\x5c\x9c\x55\xc8\xd1\x1d\x49\xec\xe0\xec\xe9\xcd\x8c\x1b\x48\xc3\x59\xb6\x28\x56" +
+	"\x2e\x80\xab\x75\xbc\x67\xe0\xd1\xe2\x47\xf5\xe8\xe6\xf1\x66\x3c\x74\xd4\x6b\xe3" +
+	"\xf1\x2e\x05\xf7\xb3\x6d\x3c\x7e\x31\xf1\xbb\xc3\x98\xb7\x31\x97\xd7\x08\x6d\xcc" +
+	"\xb4\xc8\xf3\x69\x6a\xc5\x6d\x6c\xcf\x9b\x6a\x12\x1f\xdb\x53\xb3\xc0\xc0\xc4\xf5" +
+	"\x06\x03\x8b\x92\x36\xb6\xce\x64\x80\x91\xfd\xa3\xbc\xa9\xd7\x89\xa9\xf0\x70\xc7" +
+	"\x19\x28\xa0\x18\xfa\x62\x48\x01\x76\x7a\x0d\x00\x26\x42\x68\xe3\x8e\x0d\xd0\x6e" +
+	"\x1e\x9f\x1d\x8b\xed\x65\xeb\xd3\x17\x05\xdc\x07\x59\x17\x7a\xc7\x07\x7f\xee\x73" +
+	"\x10\x96\xf8\x96\xbc\xc3\xb5\x9d\x43\x7b\xfb\x26\x0f\x6a\xe3\xea\xcd\xfe\x5a\xfd" +
+	"\xf6\x9d\x77\x69\xcf\xb4\xb7\x0f\xa5\xb7\xef\x52\xde\x26\x92\x6d\xef\x52\x98\x90" +
+	"\xde\x7c\x91\x6b\x22\xea\x68\xc2\xfd\x86\x18\xff\x72\xba\x11\x4f\xbe\xf8\x75\x94" +
+	"\x13\xe9\x4b\xc7\xe2\x78\x2a\x01\x1e\x42\x82\x9b\x22\x20\xa5\xbc\x1e\xef\x93\x63" +
+	"\xf7\x5d\x46\x8f\x13\xf2\x78\x5c\x76\x98\x72\x7d\x8f\xe9\xf5\x2e\x08\x0a\x3b\x21" +
+	"\x23\xf7\xc0\xb9\x55\x53\x47\x8f\xec\xc5\x7b\x24\xcc\x72\xe8\x18\xf1\x5a\x7e\xe6" +
+	"\xb2\x8e\xe6\x23\xfe\x3d\x5c\x3c\xd4\xe3\x99\xbc\xb8\xd3\x78\xef\x3d\x9e\xb9\x4c" +
+	"\x31\xf4\x58\xe3\x81\xbb\x0d\xd0\x1e\x27\x88\xf8\x53\x3d\xd6\xd2\xb9\xe2\xf0\x0a" +
+	"\xd9\x2e\x11\x7f\x4a\xc3\xc4\xaa\x7f\x64\xa8\xdd\x5c\x7e\x8a\xa8\x78\x76\xd7\x8a" +
+	"\x72\xd3\xd7\x94\xf0\xfe\xe1\xde\x45\x56\xbe\x6e\x0b\x9d\x98\x11\x96\x11\xc0\xf3" +
+	"\x56\x04\xa5\xe4\x60\x95\xa3\xc8\xd5\x37\x76\x7d\xcd\xd5\x64\xb6\x8e\x94\x56\xf4" +
+	"\xb7\x26\x63\xcb\x11\x9a\xd3\x6a\xb0\x56\x9f\x63\x48\x7a\x4b\xfa\x24\x44\xab\xac" +
+	"\xef\x58\xfd\xe7\x57\x43\xad\xb9\xe7\x58\x63\xe8\xf9\x91\xf9\x3b\x3f\x72\x87\xf8" +
+	"\x2b\x01\x16\xe8\xb2\xfa\x5b\x33\xeb\x55\x3c\x74\x2b\xed\x1d\x67\x6e\x8a\x67\x07" +
+	"\x27\x89\x0a\xc9\x97\x1a\x02\x90\x4e\xb8\xcc\xbc\x91\xc2\x62\x25\x82\xcf\x13\x09" +
+	"\x35\x20\x77\x7e\xb5\x63\x3e\xd8\x6f\xad\x5c\x53\x03\xe2\xed\xbf\x42\xcf\x67\x79" +
+	"\x52\x47\x49\x61\x7a\x3e\x7b\x49\xf6\xa3\xb3\x97\x33\x30\x61\x6e\x8d\xc1\x87\xe7" +
+	"\x3c\x56\xff\x1b\xc1\xb5\xc2\x0d\x14\x8a\xf5\x24\x9d\xf0\x22\x8d\x12\x30\x01\xa4" +
+	"\x12\xd1\x2f\x44\xa0\x47\x8c\x59\xde\x15\xd7\x29\x71\x26\x40\x2b\xe8\xa5\x9a\x56" +
+	"\x79\xf7\xe8\x1c\x47\xad\xd7\x29\x16\x22\xc5\xe5\xc7\xe8\x68\x10\x60\x17\x05\x3f" +
+	"\x0d\xaa\x7d\x82\x01\x15\x67\xac\x6d\x15\x63\xa6\x0a\xb7\xd3\x9e\x5e\x33\x33\xc9" +
+	"\xa6\x9f\xed\xe9\xce\xa4\x85\x18\x6a\xed\x5d\xcb\x4c\x74\x10\x4d\xfe\x56\xbf\xf2" +
+	"\xa6\xb9\x38\xe7\xd2\xa8\x58\x14\xbb\xef\x17\xd3\x63\x31\x60\x3d\x1d\xf3\x1d\xfe" +
+	"\x7a\xc7\x1f\xb8\x97\xa9\x78\x72\x79\x94\x27\x2f\x02\x60\xc6\xe9\xd8\x61\xf5\xd1" +
+	"\x47\xe8\xe3\x27\xf4\x37\x9c\xdb\xda\x00\xf5\x0c\xfd\x9d\x75\x30\x31\xaa\x3a\xbc" +
+	"\xf3\xfd\x50\x10\xf5\x85\x5e\xa1\x5f\xaf\x14\x86\x0e\x60\x88\xf4\x39\x26\x6d\x61" +
+	"\x1c\x8c\x7e\x64\x39\xe3\x88\xb3\x19\x84\x81\xbe\x73\x33\xf4\x34\x4f\x2d\x47\x94" +
+	"\xaa\xe0\x40\xd0\xb9\x78\xa6\xed\xf6\x16\x83\xfe\xa0\x23\x4e\xb7\x86\x70\x42\x6d" +
+	"\x48\x2c\xf7\x71\x3c\x22\x3b\x4d\x28\x56\xb6\x78\x96\x91\xd3\x5d\x91\xce\x15\x8b" +
+	"\xcb\x01\x90\xcb\x3b\x65\xa5\x69\xec\x47\x62\x05\x8f\xe3\x51\x36\x0e\x28\x80\x71" +
+	"\x67\x2a\x84\x0b\x19\x87\x7c\xca\x38\xca\xb4\xc9\x38\xb0\x40\x65\x57\x0b\x3a\xee" +
+	"\x24\x7f\x47\xd8\xfd\x85\xc7\x4d\xf4\x36\xf2\x88\xa9\x82\x23\x9b\x69\x8d\x59\x77" +
+	"\xd6\xa4\x87\x4e\x19\x8b\xef\x71\xc8\xf6\x50\x24\xef\xc9\xd1\xee\xbf\xc5\x5e\x58" +
+	"\x34\x1b\x41\x96\x26\xaa\x02\xd1\x64\xb7\x7c\xa8\xf5\x1a\xc8\x9b\x83\xcd\x1b\x6f" +
+	"\x2a\xf2\x9c\x4d\x52\x79\x39\xa8\x2a\xa5\xd4\x3a\xe4\x72\x67\x52\xf4\x4c\xbb\x25" +
+	"\x49\x8c\x40\x5f\x49\x90\xaf\x08\xd1\xb7\x2a\x2f\x33\x31\x65\x1d\x5e\xbe\xbb\xb1" +
+	"\x52\x8a\x3f\x7c\x50\xea\x1c\x61\xdd\x70\x3d\x7b\xed\xe6\x78\x3f\x67\xe6\xe4\x00" +
+	"\x41\x72\x69\xa8\xe2\x85\x45\x40\x0e\x25\x1d\x95\x97\xa1\x19\x11\x9b\xd0\xf3\xce" +
+	"\xaf\xb0\x35\x86\x60\x2c\x0b\x2d\xd5\xcb\x35\xe5\x07\xa5\x69\xca\x11\xda\xe1\x90" +
+	"\x0b\x49\xaa\xe9\x48\xef\x27\x74\x19\x10\x6f\x4d\xa8\x7f\x9e\xda\xcb\xdb\xc0\x68" +
+	"\x9e\x82\x96\xab\x50\xf3\x3e\x3c\x67\x54\xa8\xf5\x50\xed\x95\x84\x38\x66\x0b\xb8" +
+	"\x22\x34\xc8\x34\xb6\x71\x91\x24\xe3\xc0\x61\x7a\x37\xa4\x60\xfd\x22\x62\x43\x4d" +
+	"\x28\xd1\xec\xe9\xee\xf6\x62\xb2\x1b\x32\x16\xe4\x38\xd2\xb1\x78\x3b\xa7\xe3\x1b" +
+	"\xd6\xe6\x38\x52\x5f\x7d\x1c\xca\x42\xc2\xd2\x30\x91\x10\x7a\xc6\xec\x6d\x82\xf5" +
+	"\x82\xca\x7f\x46\x76\x80\xe3\x78\xa6\x74\xdf\xf2\x8e\xf4\xbc\x6b\xfb\x64\x20\xdd" +
+	"\xe0\xce\xf3\xe3\xf5\x7c\xe6\x9f\xdb\xc3\x13\xaa\x9b\xe3\x90\xf4\xc8\x50\xf5\xb1" +
+	"\xeb\xd2\x21\x40\xf1\x17\x7f\xd6\xf2\x6b\xcf\xbf\x87\x1d\x9a\xea\x0e\xca\xb7\x83" +
+	"\x41\x4b\x2c\x05\x86\x0e\x2a\xb5\xd1\x73\xa2\x1d\x6e\x08\x2f\xab\x8e\xbc\xdd\x57" +
+	"\x0f\xc4\x34\x0e\x17\x7b\x8f\x63\xcd\x9a\x55\x01\xe8\x75\x43\x2d\x6a\xf3\x40\xbb" +
+	"\x82\xa2\x57\xeb\x6d\x19\xb3\x43\x32\x20\x6b\xab\x50\x46\xa3\x32\xe6\xe3\xcb\x7b" +
+	"\x3d\x67\x50\x4e\x0e\xa0\x6f\xff\x36\xb9\xa5\x9f\xa1\x56\xcf\x88\xc2\x91\xda\x36" +
+	"\xa2\x01\x8c\x03\x2d\x52\xd5\xfe\x75\x14\x6f\xc5\xd7\x61\x67\x81\x71\xc0\xd7\x22" +
+	"\x65\xd3\x49\x62\x1c\x72\x5b\xa9\x84\x4f\xbf\xd0\xee\xd9\x90\xf3\x52\x06\xfa\x71" +
+	"\xde\xf8\x9b\x8f\xa1\x2d\x1f\x2f\x07\xde\xbe\xb7\x66\xe5\x38\x10\x7d\x31\x45\x1f" +
+	"\x9b\x0b\xae\x49\xb2\x06\x86\xaa\x72\x9b\x29\xc3\x8f\x03\x8f\xe8\x2c\x69\xc3\x74" +
+	"\x14\x21\x87\x77\xb1\xfd\x9e\xd6\xf6\xd8\x5e\x1f\x60\x3f\xbb\xc3\x60\x8f\xe3\x96" +
+	"\xa0\x81\xee\x2e\xb2\x7b\x17\x11\xeb\x44\x99\x0a\xc6\x47\xd0\xbb\x46\xbb\xd7\x68" +
+	"\xbf\x2b\x72\xc4\xdd\x6f\x1d\xc9\x3f\xc1\x91\xea\x77\x72\x6a\x99\x0a\x1c\x4e\x0a" +
+	"\x2c\xe7\xdd\xc3\xcf\x38\xcf\x0e\xb2\xcf\x38\xe7\xbd\xd4\xa0\xad\x23\x9a\xbc\x49" +
+	"\x51\xa3\x77\xb0\x15\xcf\xcc\x66\x4c\x49\x5b\xfc\x07\xf9\xcd\xed\xee\x78\xe7\x96" +
+	"\x14\x09\x9c\xb4\xf2\x76\x30\xa8\x13\x6a\x01\xea\x6c\x48\xb2\xa9\x2e\x6a\x1c\xca" +
+	"\x50\xbd\x3b\xb2\x0b\xb1\xe7\x23\x85\x5e\x6f\x64\xe4\x1e\x84\xf5\x33\x3f\x4a\x8c" +
+	"\x68\xa0\xfb\x8b\x20\x6b\xb2\xd7\xad\x59\x7c\x26\xc0\xa9\x87\x95\xe8\x1d\xa0\x96" +
+	"\x87\x53\xf5\x55\xdb\x41\x28\x61\xe8\xfb\x7e\x66\x73\x89\x4f\xf8\x6d\xb3\x99\x51" +
+	"\x9e\xbb\x0d\x7c\x7b\x86\x6f\x0c\xb5\xc6\x21\xdf\xd3\x96\x84\x0d\x33\x14\xfe\x85" +
+	"\x12\xee\xb1\x86\xa2\x51\x75\xa4\xcd\x9b\xc0\xae\x47\xba\x9c\x90\x14\xf3\x3b\x03" +
+	"\x92\x07\xb8\xb6\xe2\x34\x44\x95\x58\xe3\x4b\xcd\xd7\xd6\xfb\xf1\x00\x4b\x81\x81" +
+	"\x6d\x99\xb2\xf7\xc5\xc6\xa0\xb0\xc2\xef\x6d\x44\x83\xf9\x23\xb0\x17\xec\xbd\xc6" +
+	"\xd1\x8e\xc8\x1a\xda\x0a\x71\x19\x05\x7c\x86\x6b\xec\xa4\xb9\xde\xfc\x51\xa5\xfa" +
+	"\x5b\x9c\xf7\x6a\xda\x3a\x5e\xa4\x1c\xf9\xda\xcc\x26\x28\x6b\x20\xb5\x9e\xab\xbf" +
+	"\x6d\x17\x0f\x0f\x50\xee\xba\x75\x94\x46\x49\x19\x9f\x22\x74\x04\xe7\xde\xc0\x96" +
+	"\xf8\x06\xaa\xff\xf0\xb9\xa4\xbd\x71\xb4\x85\x2a\xaa\x7f\xda\xfe\xe9\xc9\xdd\xdb" +
+	"\xa6\xfb\xbc\xda\xdd\x61\x0f\xc8\x1f\xda\xa3\x8d\xb5\xbe\x4a\x93\x1e\xe0\x7d\xab" +
+	"\xfb\x1e\xa3\x7f\xba\x48\x57\xc8\x51\x47\xde\xdb\xd8\x11\xdf\x77\xf3\x4d\x77\xff" +
+	"\x65\x1d\x6a\x4f\x5c\xad\x5a\x02\x1b\x41\xa8\xd8\x3d\xe3\x70\xe9\xe9\x38\x14\xe6" +
+	"\x8c\x1c\xdf\xd1\x4b\xf9\x8e\xd5\x33\x7d\xdf\x9d\x48\x66\x89\xb4\x22\x56\xb6\x9e" +
+	"\xc4\x16\x39\x8c\xe3\x0d\x9b\x49\x9b\xbc\x37\x3b\x6e\x12\xbc\xb7\xbe\x3f\x33\xcd" +
+	"\x3b\x21\x51\x80\xea\x0a\xc6\xa7\x72\xc2\x64\x84\x54\x4f\xbf\x97\x2c\x45\xca\x55" +
+	"\x57\xb9\x43\x22\x92\xa6\xa6\x7e\x97\xea\xd5\x7c\x8b\xc9\xca\x0d\xeb\x73\x68\x2c" +
+	"\xda\x26\xf2\x97\x7d\xd8\x39\xad\xfa\xa6\xa7\xf6\x4d\xd4\xec\x34\x9f\x60\x43\x02" +
+	"\x11\x8a\x38\x91\x3b\x54\xa8\xb6\x26\x27\x3c\xb9\x64\xb4\x1b\xad\x70\xa0\xc6\xa9" +
+	"\xe3\x76\x18\x67\x47\xa4\x39\xce\xbb\x51\x4f\x7b\x81\x2a\xa3\xca\x14\x46\xbd\x03" +
+	"\xae\xdd\xda\xb6\xa3\x25\x6b\x37\x86\x53\x6b\xbc\x51\x47\x06\xb5\x85\xef\xb8\xc4" +
+	"\x07\xc1\xad\xbf\x23\xa6\x55\xd3\xe5\x80\x0e\xf6\x1d\xf1\xb6\xc8\xc1\x00\x0f\xcb" +
+	"\x63\x0f\xdf\xf1\x10\xd7\x30\x7c\xc7\x53\x47\xd5\x2a\x8f\x88\x92\x56\x7c\x3b\x37" +
+	"\xf7\x3b\x56\x79\x68\xfa\x8e\x8d\x79\x09\x6f\x8a\xae\x05\xfe\x9d\xe4\x79\xe7\x3b" +
+	"\x6d\x53\x2d\x72\x98\x68\xa7\x6c\x5c\x06\xe9\xcc\xcf\x57\xae\x99\xd5\x14\xb1\xb2" +
+	"\x69\x33\x7e\xa7\x1f\x3b\x15\x7e\x67\x77\xf5\x01\xe0\x25\x02\xd1\x81\xa5\x3d\xf1" +
+	"\x9d\xdb\x54\xec\xa1\x99\x87\xbe\x70\x51\xd8\xfd\xf7\x6c\xae\xec\xf1\xf9\x27\xe1" +
+	"\x9e\x70\xf2\x1b\x74\x1b\xea\xed\x6a\x22\xef\x0f\x9b\xe1\x1d\x61\xf1\xc0\x0f\xc1" +
+	"\xe7\x60\xdc\x90\x0b\xe0\x9b\xf0\x88\x0f\xae\x5b\x7c\x64\xc8\xaa\xda\x00\x1e\xe8" +
+	"\x2d\x2a\xc9\x98\x2c\x86\xde\x9b\x92\xcc\x80\x14\x84\x12\x3d\xd4\xc4\xcd\x20\xec" +
+	"\xa9\xa0\x70\x55\xed\x67\x7d\x3c\xc2\xfb\x36\x48\x7a\xaf\xce\xf0\x10\xb8\xc2\x7b" +
+	"\x8d\x7b\x78\x73\xe2\x07\xc3\xce\x7e\xaf\xb9\x79\x8e\xb2\xa3\x6a\x80\x77\xc4\x72" +
+	"\x26\x7c\x25\x16\x13\x82\x08\xb4\xf0\x46\x14\xa3\x0e\x6f\xd5\x06\x91\x3d\xbf\x32" +
+	"\xe6\xb1\x31\xbc\xf3\x36\x4b\x2c\x6b\xa0\x2b\xe1\xa9\xb7\xc8\x9b\x79\x50\x22\xc5" +
+	"\x5a\x28\x6f\xdb\xed\x6c\x71\xdb\x4e\x19\x05\xbc\x71\x44\xf1\xce\xa6\x98\xf8\xce" +
+	"\x30\xd2\x5f\x72\xcf\x78\x5e\xe1\x5d\x3c\x46\xc1\xbb\xbc\xc2\x1b\x6f\x84\x8a\x3c" +
+	"\xae\xd1\x85\x72\x8e\x21\x7d\x54\x43\xa8\x07\xdc\x63\x0f\x96\x5b\x78\x9b\xc2\xff" +
+	"\x5b\xaa\xd8\x36\xf4\xaa\x51\xf3\x02\x59\x2a\xf3\x3b\xd5\xf0\x60\x0b\xef\x8a\x50" +
+	"\x81\x6b\xe3\x7f\xbf\xdd\x33\xc6\xbb\x96\x63\x99\x8b\x72\x96\xc3\xe4\x14\x3f\x8f" +
+	"\xf2\x13\x2e\x9c\xf2\xe0\xe6\x31\x5c\x6e\x1a\x7b\xb9\x4a\xfc\x95\x98\x59\x00\x19" +
+	"\x1b\xb7\x35\x5c\xf9\x08\x17\xab\x49\x0a\x57\xb9\x4a\xf8\x8d\x67\x60\x63\xfa\x9b" +
+	"\xce\x93\x3e\x1f\x7e\xd3\x6d\x30\x02\xba\xc2\x6f\x5e\x7e\x6d\x66\xfe\xcd\xe7\x93" +
+	"\x65\xe0\xd7\xc5\x38\x50\xee\x9f\x2d\xfc\xe6\x1e\x7e\xcb\x3d\x72\x7f\x4b\xd9\xca" +
+	"\x72\x85\xff\x0d\x00\x50\x98\x43\x6e\x26\xff\x03\x00"
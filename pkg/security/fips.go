@@ -0,0 +1,127 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"flag"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// fipsDefault is whether FIPS mode is enabled unless SetFIPSMode says
+// otherwise. It is set in builds with the fips tag.
+var fipsDefault = false
+
+// The states of fipsState. Once decided, the state cannot change.
+const (
+	fipsUndecided int32 = iota
+	fipsDisabled
+	fipsEnabled
+)
+
+// fipsState is whether FIPS mode is enabled. It is accessed atomically.
+var fipsState int32
+
+// SetFIPSMode enables or disables FIPS mode, in which passwords are only
+// hashed and verified with methods based on FIPS-approved primitives (see
+// fipsApproved). HashPassword uses HashPBKDF2 unless the default method is
+// approved, SetDefaultHashMethod and HashPasswordWithMethod reject other
+// methods, and CompareHashAndPassword refuses to verify other hashes with a
+// HashMethodNotFIPSApprovedError.
+//
+// DetectHashMethod and ParsePasswordHash are not affected, so that the
+// stored hashes can still be audited.
+//
+// To avoid mixed behavior, the mode is decided once for the lifetime of the
+// process: SetFIPSMode must be called before passwords are first hashed or
+// verified, which otherwise decide the mode from the build (FIPS mode is the
+// default in builds with the fips tag). It returns an error if the mode was
+// already decided otherwise.
+func SetFIPSMode(enabled bool) error {
+	state := fipsDisabled
+	if enabled {
+		state = fipsEnabled
+	}
+	if atomic.CompareAndSwapInt32(&fipsState, fipsUndecided, state) ||
+		atomic.LoadInt32(&fipsState) == state {
+		return nil
+	}
+	return errors.Errorf("FIPS mode was already decided to be %t", !enabled)
+}
+
+// FIPSMode returns whether FIPS mode is enabled (see SetFIPSMode). Calling it
+// decides the mode if it was not yet.
+func FIPSMode() bool {
+	if state := atomic.LoadInt32(&fipsState); state != fipsUndecided {
+		return state == fipsEnabled
+	}
+	_ = SetFIPSMode(fipsDefault)
+	return atomic.LoadInt32(&fipsState) == fipsEnabled
+}
+
+// TestingSetFIPSMode overrides the FIPS mode, even if it was already
+// decided. It returns a function that restores the previous mode. It panics
+// if called outside of a test binary.
+func TestingSetFIPSMode(enabled bool) func() {
+	if flag.Lookup("test.v") == nil {
+		panic("TestingSetFIPSMode can only be used in tests")
+	}
+	state := fipsDisabled
+	if enabled {
+		state = fipsEnabled
+	}
+	prev := atomic.SwapInt32(&fipsState, state)
+	return func() {
+		atomic.StoreInt32(&fipsState, prev)
+	}
+}
+
+// fipsApproved returns whether a hash method only relies on FIPS-approved
+// primitives: PBKDF2, HMAC and SHA-256.
+func fipsApproved(method HashMethod) bool {
+	return method == HashPBKDF2 || method == HashSCRAMSHA256
+}
+
+// ErrHashMethodNotFIPSApproved is the cause of
+// HashMethodNotFIPSApprovedError.
+var ErrHashMethodNotFIPSApproved = errors.New("hash method is not FIPS-approved")
+
+// HashMethodNotFIPSApprovedError is returned in FIPS mode when hashing or
+// verifying a password with a method that is not FIPS-approved. Its cause is
+// ErrHashMethodNotFIPSApproved.
+type HashMethodNotFIPSApprovedError struct {
+	Method HashMethod
+}
+
+func (e *HashMethodNotFIPSApprovedError) Error() string {
+	return fmt.Sprintf("hash method %s is not FIPS-approved, and cannot be used in FIPS mode",
+		e.Method)
+}
+
+// Cause implements the causer interface.
+func (e *HashMethodNotFIPSApprovedError) Cause() error {
+	return ErrHashMethodNotFIPSApproved
+}
+
+// checkFIPSApproved returns a HashMethodNotFIPSApprovedError if FIPS mode is
+// enabled and the method is not approved.
+func checkFIPSApproved(method HashMethod) error {
+	if FIPSMode() && !fipsApproved(method) {
+		return &HashMethodNotFIPSApprovedError{Method: method}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// +build fips
+
+package security_test
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+// TestFIPSBuild runs with "make testfips".
+func TestFIPSBuild(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	if !security.FIPSMode() {
+		t.Fatal("expected FIPS mode to be the default with the fips tag")
+	}
+	if m := security.GetDefaultHashMethod(); m != security.HashPBKDF2 {
+		t.Errorf("expected %s to be the default hash method, got %s", security.HashPBKDF2, m)
+	}
+}
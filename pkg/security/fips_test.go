@@ -0,0 +1,103 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+// skipUnderFIPS skips tests relying on hash methods that are not
+// FIPS-approved when the tests run in FIPS mode, i.e. with the fips tag.
+func skipUnderFIPS(t testing.TB) {
+	if security.FIPSMode() {
+		t.Skip("relies on hash methods that are not FIPS-approved")
+	}
+}
+
+func TestFIPSMode(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	fips := security.FIPSMode()
+	if err := security.SetFIPSMode(fips); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.SetFIPSMode(!fips); !testutils.IsError(err, "already decided") {
+		t.Fatalf("expected the mode to be immutable, got %v", err)
+	}
+
+	for _, enabled := range []bool{false, true} {
+		func() {
+			defer security.TestingSetFIPSMode(enabled)()
+			if security.FIPSMode() != enabled {
+				t.Fatalf("expected FIPS mode %t", enabled)
+			}
+
+			hash, err := security.HashPassword("hunter2")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if enabled && !strings.HasPrefix(string(hash), "$pbkdf2-sha256$") {
+				t.Errorf("%t: unexpected hash %s", enabled, hash)
+			}
+			if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+				t.Errorf("%t: %v", enabled, err)
+			}
+
+			for _, hash := range []string{
+				legacyHash,
+				"$argon2id$v=19$m=64,t=1,p=4$hlj9fN3+tD/R/v1QGN14LA$" +
+					"wwTjBsMQauHfDMIioX9vxUfJnYtg6447p15pEOKvL4o",
+				"{SSHA}gK+fFFujqnweTpwCQ7Sp02gQxCJzYWx0",
+			} {
+				err := security.CompareHashAndPassword([]byte(hash), "hunter2")
+				if enabled != (errors.Cause(err) == security.ErrHashMethodNotFIPSApproved) {
+					t.Errorf("%t: %s: unexpected error %v", enabled, hash, err)
+				}
+				// Stored hashes can still be audited.
+				if _, err := security.DetectHashMethod([]byte(hash)); err != nil {
+					t.Errorf("%t: %s: %v", enabled, hash, err)
+				}
+			}
+			err = security.CompareHashAndPasswordWithUser(
+				[]byte("md5f1d6e2da5767fddc60c941cf0fa924cf"), "hunter2", "alice")
+			if enabled != (errors.Cause(err) == security.ErrHashMethodNotFIPSApproved) {
+				t.Errorf("%t: md5: unexpected error %v", enabled, err)
+			}
+
+			_, err = security.HashPasswordWithMethod(security.HashBCrypt, "hunter2")
+			if enabled != (errors.Cause(err) == security.ErrHashMethodNotFIPSApproved) {
+				t.Errorf("%t: unexpected error %v", enabled, err)
+			}
+			if _, err := security.HashPasswordWithMethod(
+				security.HashSCRAMSHA256, "hunter2",
+			); err != nil {
+				t.Errorf("%t: %v", enabled, err)
+			}
+			if enabled {
+				err := security.SetDefaultHashMethod(security.HashArgon2id)
+				if errors.Cause(err) != security.ErrHashMethodNotFIPSApproved {
+					t.Errorf("unexpected error %v", err)
+				}
+			}
+		}()
+	}
+}
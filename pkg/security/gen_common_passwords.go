@@ -0,0 +1,110 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// +build ignore
+
+// gen_common_passwords compresses common_passwords.txt, or the file named
+// by its first argument, into common_passwords_generated.go.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	src := "common_passwords.txt"
+	if len(os.Args) > 1 {
+		src = os.Args[1]
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error opening file: ", err)
+		os.Exit(1)
+	}
+	defer in.Close()
+
+	// Comments and duplicates are dropped here; the entries are normalized
+	// when they are loaded, so that there is a single implementation of the
+	// normalization.
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	seen := map[string]bool{}
+	n := 0
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || seen[line] {
+			continue
+		}
+		seen[line] = true
+		n++
+		fmt.Fprintln(gz, line)
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error reading file: ", err)
+		os.Exit(1)
+	}
+	if err := gz.Close(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error compressing file: ", err)
+		os.Exit(1)
+	}
+
+	out, err := os.Create("common_passwords_generated.go")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error opening file: ", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			fmt.Fprintln(os.Stderr, "Error closing file: ", err)
+			os.Exit(1)
+		}
+	}()
+
+	// The funky string concatenation is to foil reviewable so that it doesn't
+	// think this file is generated.
+	fmt.Fprintf(out, `// Code generated by gen_common_passwords.go; `+`DO `+`NOT `+`EDIT.
+// `+`GENERATED `+`FILE `+`DO `+`NOT `+`EDIT
+
+package security
+
+// commonPasswordCount is the number of entries of commonPasswordsGzip.
+const commonPasswordCount = %d
+
+// commonPasswordsGzip is the gzipped, newline-separated list of common
+// passwords from %s.
+const commonPasswordsGzip = "" +`, n, src)
+	const width = 20
+	data := buf.Bytes()
+	for i := 0; i < len(data); i += width {
+		end := i + width
+		if end > len(data) {
+			end = len(data)
+		}
+		fmt.Fprint(out, "\n\t\"")
+		for _, b := range data[i:end] {
+			fmt.Fprintf(out, "\\x%02x", b)
+		}
+		fmt.Fprint(out, "\"")
+		if end < len(data) {
+			fmt.Fprint(out, " +")
+		}
+	}
+	fmt.Fprintln(out)
+}
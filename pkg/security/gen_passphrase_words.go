@@ -0,0 +1,123 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// +build ignore
+
+// gen_passphrase_words compresses passphrase_words.txt, or the file named by
+// its first argument, into passphrase_words_generated.go.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	src := "passphrase_words.txt"
+	if len(os.Args) > 1 {
+		src = os.Args[1]
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error opening file: ", err)
+		os.Exit(1)
+	}
+	defer in.Close()
+
+	// Unlike common passwords, words are validated here: duplicates would
+	// bias the choice of words and make the entropy estimates wrong.
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	seen := map[string]bool{}
+	n := 0
+	scanner := bufio.NewScanner(in)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		// The word is the last field of the lines of the EFF lists.
+		fields := strings.Fields(text)
+		word := strings.ToLower(fields[len(fields)-1])
+		// A few words of the EFF lists are hyphenated, e.g. "t-shirt".
+		if strings.IndexFunc(word, func(r rune) bool {
+			return (r < 'a' || r > 'z') && r != '-'
+		}) >= 0 || strings.HasPrefix(word, "-") || strings.HasSuffix(word, "-") {
+			fmt.Fprintf(os.Stderr, "%s:%d: invalid word %q\n", src, line, word)
+			os.Exit(1)
+		}
+		if seen[word] {
+			fmt.Fprintf(os.Stderr, "%s:%d: duplicate word %q\n", src, line, word)
+			os.Exit(1)
+		}
+		seen[word] = true
+		n++
+		fmt.Fprintln(gz, word)
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error reading file: ", err)
+		os.Exit(1)
+	}
+	if err := gz.Close(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error compressing file: ", err)
+		os.Exit(1)
+	}
+
+	out, err := os.Create("passphrase_words_generated.go")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error opening file: ", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			fmt.Fprintln(os.Stderr, "Error closing file: ", err)
+			os.Exit(1)
+		}
+	}()
+
+	// The funky string concatenation is to foil reviewable so that it doesn't
+	// think this file is generated.
+	fmt.Fprintf(out, `// Code generated by gen_passphrase_words.go; `+`DO `+`NOT `+`EDIT.
+// `+`GENERATED `+`FILE `+`DO `+`NOT `+`EDIT
+
+package security
+
+// passphraseWordCount is the number of words of passphraseWordsGzip.
+const passphraseWordCount = %d
+
+// passphraseWordsGzip is the gzipped, newline-separated list of the words of
+// passphrases from %s.
+const passphraseWordsGzip = "" +`, n, src)
+	const width = 20
+	data := buf.Bytes()
+	for i := 0; i < len(data); i += width {
+		end := i + width
+		if end > len(data) {
+			end = len(data)
+		}
+		fmt.Fprint(out, "\n\t\"")
+		for _, b := range data[i:end] {
+			fmt.Fprintf(out, "\\x%02x", b)
+		}
+		fmt.Fprint(out, "\"")
+		if end < len(data) {
+			fmt.Fprint(out, " +")
+		}
+	}
+	fmt.Fprintln(out)
+}
@@ -0,0 +1,135 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"flag"
+	"strconv"
+	"sync/atomic"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcryptVersionNames name the versions of bcrypt hashes in the keys of
+// GetHashUsageSnapshot.
+var bcryptVersionNames = [...]string{
+	bcryptLegacy:       "legacy",
+	bcryptLegacyTagged: "legacy_tagged",
+	bcryptV2:           "v2",
+	bcryptPeppered:     "peppered",
+}
+
+// hashUsageCounters count the hashes verified, or created, by hash method
+// and, for bcrypt hashes, by version and by cost.
+type hashUsageCounters struct {
+	methods        [len(hashMethodNames)]int64
+	bcryptVersions [len(bcryptVersionNames)]int64
+	bcryptCosts    [bcrypt.MaxCost + 1]int64
+}
+
+// hashUsage counts the hashes verified by CompareHashAndPassword and
+// created by HashPassword, and their variants.
+var hashUsage struct {
+	verified, created hashUsageCounters
+}
+
+// record counts a hash. Its method is recognized from its prefix, as by
+// DetectHashMethod, but hashes are not decoded from hex or base64 first. The
+// hash is not parsed either: it is recorded before it is verified, and the
+// version and cost of bcrypt hashes are read from their prefix.
+func (c *hashUsageCounters) record(hashedPassword []byte) {
+	method := sniffHashMethod(hashedPassword)
+	atomic.AddInt64(&c.methods[method], 1)
+	if method != HashBCrypt {
+		return
+	}
+	_, hashedPassword = splitNormalizedPrefix(hashedPassword)
+	version, _, hash, err := splitBcryptVersion(hashedPassword)
+	if err != nil {
+		return
+	}
+	atomic.AddInt64(&c.bcryptVersions[version], 1)
+	// The cost is the two digits of "$2a$10$".
+	if len(hash) < 7 || hash[3] != '$' || hash[6] != '$' {
+		return
+	}
+	hi, lo := int(hash[4])-'0', int(hash[5])-'0'
+	if hi < 0 || hi > 9 || lo < 0 || lo > 9 {
+		return
+	}
+	if cost := 10*hi + lo; cost < len(c.bcryptCosts) {
+		atomic.AddInt64(&c.bcryptCosts[cost], 1)
+	}
+}
+
+// snapshot adds the non-zero counters to the snapshot, with the given
+// prefix.
+func (c *hashUsageCounters) snapshot(s map[string]int64, prefix string) {
+	for method := range c.methods {
+		if n := atomic.LoadInt64(&c.methods[method]); n > 0 {
+			s[prefix+".method."+HashMethod(method).String()] = n
+		}
+	}
+	for version := range c.bcryptVersions {
+		if n := atomic.LoadInt64(&c.bcryptVersions[version]); n > 0 {
+			s[prefix+".bcrypt_version."+bcryptVersionNames[version]] = n
+		}
+	}
+	for cost := range c.bcryptCosts {
+		if n := atomic.LoadInt64(&c.bcryptCosts[cost]); n > 0 {
+			s[prefix+".bcrypt_cost."+strconv.Itoa(cost)] = n
+		}
+	}
+}
+
+// GetHashUsageSnapshot returns the number of hashes verified by
+// CompareHashAndPassword and its variants, e.g. by VerifierPool, and created
+// by HashPassword and its variants, since the process started, e.g. to find
+// out whether hashes in legacy schemes are still in use. The counters are
+// keyed by operation, "verify" or "hash", and by hash method, e.g.
+// "verify.method.bcrypt" or "hash.method.argon2id", with
+// "verify.method.unknown" counting unrecognized and empty hashes. bcrypt
+// hashes are also counted by version, "legacy" for hashes without a prefix,
+// "legacy_tagged" for crdb-bcrypt, "v2" for crdb-bcrypt2 and "peppered" for
+// crdb-pepper, e.g. "verify.bcrypt_version.legacy", and by cost, e.g.
+// "verify.bcrypt_cost.10". Counters which are zero are omitted.
+//
+// The snapshot is a copy, which callers can keep and compare with later
+// ones. Checks of new passwords against the previous ones of the user (see
+// CheckPasswordNotReused) are not verifications.
+func GetHashUsageSnapshot() map[string]int64 {
+	s := make(map[string]int64)
+	hashUsage.verified.snapshot(s, "verify")
+	hashUsage.created.snapshot(s, "hash")
+	return s
+}
+
+// TestingResetHashUsage resets the counters of GetHashUsageSnapshot.
+func TestingResetHashUsage() {
+	if flag.Lookup("test.v") == nil {
+		panic("TestingResetHashUsage can only be used in tests")
+	}
+	for _, c := range []*hashUsageCounters{&hashUsage.verified, &hashUsage.created} {
+		for i := range c.methods {
+			atomic.StoreInt64(&c.methods[i], 0)
+		}
+		for i := range c.bcryptVersions {
+			atomic.StoreInt64(&c.bcryptVersions[i], 0)
+		}
+		for i := range c.bcryptCosts {
+			atomic.StoreInt64(&c.bcryptCosts[i], 0)
+		}
+	}
+}
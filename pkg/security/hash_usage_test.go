@@ -0,0 +1,112 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestHashUsageSnapshot(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+	defer security.TestingSetBcryptCost(4)()
+
+	security.TestingResetHashUsage()
+	if s := security.GetHashUsageSnapshot(); len(s) != 0 {
+		t.Fatalf("expected an empty snapshot, got %v", s)
+	}
+
+	hash, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pbkdf2, err := security.HashPasswordWithMethod(security.HashPBKDF2, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Rejected passwords create no hash.
+	if _, err := security.HashPassword(""); err != security.ErrEmptyPassword {
+		t.Fatalf("expected ErrEmptyPassword, got %v", err)
+	}
+	before := security.GetHashUsageSnapshot()
+	if expected := map[string]int64{
+		"hash.method.bcrypt":        1,
+		"hash.bcrypt_version.v2":    1,
+		"hash.bcrypt_cost.4":        1,
+		"hash.method.pbkdf2-sha256": 1,
+	}; !reflect.DeepEqual(before, expected) {
+		t.Fatalf("expected %v, got %v", expected, before)
+	}
+
+	// Every verification counts, whatever its outcome, including
+	// concurrent ones.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = security.CompareHashAndPassword(hash, "hunter2")
+		}()
+	}
+	wg.Wait()
+	_ = security.CompareHashAndPassword(hash, "hunter3")
+	// Legacy bcrypt hashes are counted by version, even if malformed.
+	_ = security.CompareHashAndPassword([]byte(legacyHash), "hunter2")
+	_ = security.CompareHashAndPassword([]byte("crdb-bcrypt"+legacyHash), "hunter2")
+	_ = security.CompareHashAndPassword([]byte("crdb-bcrypt$2a$1x$garbage"), "hunter2")
+	_ = security.CompareHashAndPassword(pbkdf2, "hunter2")
+	_ = security.CompareHashAndPassword([]byte("garbage"), "hunter2")
+	_ = security.CompareHashAndPassword(nil, "hunter2")
+	_ = security.CompareHashAndPasswordWithUser(
+		[]byte("md5a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0a0"), "hunter2", "alice")
+
+	after := security.GetHashUsageSnapshot()
+	if expected := map[string]int64{
+		"hash.method.bcrypt":                  1,
+		"hash.bcrypt_version.v2":              1,
+		"hash.bcrypt_cost.4":                  1,
+		"hash.method.pbkdf2-sha256":           1,
+		"verify.method.bcrypt":                8,
+		"verify.bcrypt_version.v2":            5,
+		"verify.bcrypt_version.legacy":        1,
+		"verify.bcrypt_version.legacy_tagged": 2,
+		"verify.bcrypt_cost.4":                5,
+		"verify.bcrypt_cost.10":               2,
+		"verify.method.pbkdf2-sha256":         1,
+		"verify.method.md5":                   1,
+		"verify.method.unknown":               2,
+	}; !reflect.DeepEqual(after, expected) {
+		t.Errorf("expected %v, got %v", expected, after)
+	}
+
+	// Snapshots are copies, which can be diffed.
+	after["verify.method.bcrypt"] = 0
+	if s := security.GetHashUsageSnapshot(); s["verify.method.bcrypt"] != 8 {
+		t.Errorf("expected the snapshot to be a copy, got %v", s)
+	}
+	if d := after["verify.bcrypt_cost.4"] - before["verify.bcrypt_cost.4"]; d != 5 {
+		t.Errorf("expected 5 verifications at cost 4, got %d", d)
+	}
+
+	security.TestingResetHashUsage()
+	if s := security.GetHashUsageSnapshot(); len(s) != 0 {
+		t.Errorf("expected an empty snapshot, got %v", s)
+	}
+}
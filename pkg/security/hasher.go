@@ -0,0 +1,236 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+// Hasher hashes passwords and verifies passwords against hashes.
+type Hasher interface {
+	// Hash returns a self-describing hash of the password.
+	Hash(password []byte) ([]byte, error)
+	// Compare tests that the hash was computed from the supplied password.
+	// If it was not, it returns ErrPasswordMismatch.
+	Compare(hashedPassword, password []byte) error
+}
+
+// hasherRegistry maps hash prefixes to the Hasher able to verify hashes
+// with that prefix.
+var hasherRegistry struct {
+	syncutil.RWMutex
+	byPrefix map[string]Hasher
+}
+
+// RegisterHasher registers a Hasher for hashes starting with the given
+// prefix. CompareHashAndPassword uses the Hasher with the longest prefix
+// matching a hash to verify it. It is an error to register a prefix twice.
+// RegisterHasher is typically called from an init function, and is safe for
+// concurrent use.
+func RegisterHasher(prefix string, h Hasher) error {
+	if prefix == "" {
+		return errors.New("hasher prefix must not be empty")
+	}
+	hasherRegistry.Lock()
+	defer hasherRegistry.Unlock()
+	if hasherRegistry.byPrefix == nil {
+		hasherRegistry.byPrefix = make(map[string]Hasher)
+	}
+	if _, ok := hasherRegistry.byPrefix[prefix]; ok {
+		return errors.Errorf("a hasher is already registered for prefix %q", prefix)
+	}
+	hasherRegistry.byPrefix[prefix] = h
+	return nil
+}
+
+// LookupHasher returns the registered Hasher with the longest prefix
+// matching the hash, if any.
+func LookupHasher(hashedPassword []byte) (Hasher, bool) {
+	hasherRegistry.RLock()
+	defer hasherRegistry.RUnlock()
+	var best string
+	var h Hasher
+	for prefix, ph := range hasherRegistry.byPrefix {
+		if len(prefix) > len(best) && bytes.HasPrefix(hashedPassword, []byte(prefix)) {
+			best, h = prefix, ph
+		}
+	}
+	return h, h != nil
+}
+
+// methodHasher is the Hasher for one of the built-in hash methods.
+type methodHasher HashMethod
+
+func (m methodHasher) Hash(password []byte) ([]byte, error) {
+	return hashPasswordWithMethod(HashMethod(m), password)
+}
+
+func (m methodHasher) Compare(hashedPassword, password []byte) error {
+	h, err := ParsePasswordHash(hashedPassword)
+	if err != nil {
+		return err
+	}
+	return h.verify(password)
+}
+
+// registryHasher is the default Hasher. It hashes passwords with the method
+// set by SetDefaultHashMethod, and verifies hashes with the registered
+// Hasher for their prefix.
+type registryHasher struct{}
+
+func (registryHasher) Hash(password []byte) ([]byte, error) {
+	return hashPasswordWithMethod(GetDefaultHashMethod(), password)
+}
+
+func (registryHasher) Compare(hashedPassword, password []byte) error {
+	if h, ok := LookupHasher(hashedPassword); ok {
+		return h.Compare(hashedPassword, password)
+	}
+	if _, err := DetectHashMethod(hashedPassword); err != nil {
+		return err
+	}
+	decoded, err := decodeEncodedHash(hashedPassword)
+	if err != nil {
+		return err
+	}
+	h, _ := LookupHasher(decoded)
+	return h.Compare(decoded, password)
+}
+
+// ErrMalformedHash is the cause of the errors returned when parsing a hash,
+// or comparing a password against one, which is in no recognized format or
+// is structurally invalid.
+var ErrMalformedHash = errors.New("unrecognized password hash format")
+
+// MalformedHashError is returned when parsing, or comparing a password
+// against, a hash that has no recognized prefix, neither as is nor once
+// decoded from hex or base64, or that has a recognized prefix but is
+// structurally invalid, e.g. a bcrypt hash with an out of range cost or a
+// truncated salt.
+type MalformedHashError struct {
+	// Encoding is the encoding the hash could be decoded from, "hex" or
+	// "base64", or empty if it could not be decoded or did not need to be.
+	Encoding string
+	// Err is the problem with the (decoded) hash, or nil if the hash has no
+	// recognized prefix and could not be decoded.
+	Err error
+}
+
+func (e *MalformedHashError) Error() string {
+	if e.Encoding == "" {
+		if e.Err != nil {
+			return fmt.Sprintf("%s: %v", ErrMalformedHash, e.Err)
+		}
+		return fmt.Sprintf("%s: not a hex- or base64-encoded hash either", ErrMalformedHash)
+	}
+	return fmt.Sprintf("%s: %s-decoded hash: %v", ErrMalformedHash, e.Encoding, e.Err)
+}
+
+// Cause implements the causer interface.
+func (e *MalformedHashError) Cause() error {
+	return ErrMalformedHash
+}
+
+// malformedHash wraps an error describing a malformed hash in a
+// *MalformedHashError, unless it already has a typed cause.
+func malformedHash(err error) error {
+	switch errors.Cause(err) {
+	case ErrMalformedHash, ErrHashMethodUnsupported:
+		return err
+	}
+	return &MalformedHashError{Err: err}
+}
+
+// hashEncodings are the encodings hashes are decoded from when they have no
+// recognized prefix, e.g. because they were exported to CSV or through the
+// admin UI.
+var hashEncodings = []struct {
+	name   string
+	decode func(string) ([]byte, error)
+}{
+	{"hex", hex.DecodeString},
+	{"base64", base64.StdEncoding.DecodeString},
+}
+
+// decodeEncodedHash decodes a hash without a recognized prefix from hex or
+// base64. Since passwords stored in plain text may happen to be valid hex or
+// base64, the decoded hash is only used if it has a recognized prefix and is
+// well-formed.
+func decodeEncodedHash(hashedPassword []byte) ([]byte, error) {
+	var firstErr *MalformedHashError
+	for _, enc := range hashEncodings {
+		decoded, err := enc.decode(string(hashedPassword))
+		if err != nil || len(decoded) == 0 {
+			continue
+		}
+		if _, ok := LookupHasher(decoded); !ok {
+			err = errors.New("no recognized prefix")
+		} else if _, err = DetectHashMethod(decoded); err == nil {
+			return decoded, nil
+		}
+		if m, ok := err.(*MalformedHashError); ok {
+			err = m.Err
+		}
+		if firstErr == nil {
+			firstErr = &MalformedHashError{Encoding: enc.name, Err: err}
+		}
+	}
+	if firstErr == nil {
+		return nil, &MalformedHashError{}
+	}
+	return nil, firstErr
+}
+
+// hasherBox wraps a Hasher so that Hashers of different types can be stored
+// in an atomic.Value.
+type hasherBox struct {
+	Hasher
+}
+
+var defaultHasher atomic.Value
+
+func init() {
+	for _, p := range hashPrefixes {
+		if err := RegisterHasher(p.prefix, methodHasher(p.method)); err != nil {
+			panic(err)
+		}
+	}
+	defaultHasher.Store(hasherBox{registryHasher{}})
+}
+
+// DefaultHasher returns the Hasher used by HashPassword and
+// CompareHashAndPassword.
+func DefaultHasher() Hasher {
+	return defaultHasher.Load().(hasherBox).Hasher
+}
+
+// SetDefaultHasher replaces the Hasher used by HashPassword and
+// CompareHashAndPassword, e.g. with a cheap fake in tests. It returns the
+// previous default, so that it can be restored. Cached verification outcomes
+// are dropped.
+func SetDefaultHasher(h Hasher) Hasher {
+	prev := DefaultHasher()
+	defaultHasher.Store(hasherBox{h})
+	clearVerifyCache()
+	return prev
+}
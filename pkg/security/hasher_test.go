@@ -0,0 +1,208 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/pkg/errors"
+)
+
+// fakeHasher "hashes" passwords by prepending its prefix.
+type fakeHasher struct {
+	prefix string
+}
+
+func (f fakeHasher) Hash(password []byte) ([]byte, error) {
+	return append([]byte(f.prefix), password...), nil
+}
+
+func (f fakeHasher) Compare(hashedPassword, password []byte) error {
+	if !bytes.Equal(hashedPassword[len(f.prefix):], password) {
+		return security.ErrPasswordMismatch
+	}
+	return nil
+}
+
+func TestRegisterHasher(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	fake := fakeHasher{prefix: "fake-register$"}
+	if err := security.RegisterHasher(fake.prefix, fake); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.RegisterHasher(
+		fake.prefix, fake,
+	); !testutils.IsError(err, "already registered") {
+		t.Errorf("expected duplicate prefix error, got %v", err)
+	}
+	if err := security.RegisterHasher("$2", fake); !testutils.IsError(err, "already registered") {
+		t.Errorf("expected duplicate prefix error, got %v", err)
+	}
+	if err := security.RegisterHasher("", fake); err == nil {
+		t.Error("expected error for empty prefix")
+	}
+
+	// The registry is used for verification alongside the built-in methods.
+	hash := []byte("fake-register$hunter2")
+	if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+		t.Error(err)
+	}
+	if err := security.CompareHashAndPassword(hash, "hunter3"); err != security.ErrPasswordMismatch {
+		t.Errorf("expected mismatch, got %v", err)
+	}
+	if err := security.CompareHashAndPassword([]byte(legacyHash), "hunter2"); err != nil {
+		t.Error(err)
+	}
+	if h, ok := security.LookupHasher([]byte("crdb-bcrypt2$2a$10$")); !ok || h == fake {
+		t.Errorf("expected built-in bcrypt hasher, got %v", h)
+	}
+
+	// Concurrent registrations of distinct prefixes all succeed.
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			prefix := fmt.Sprintf("fake-concurrent-%d$", i)
+			errs <- security.RegisterHasher(prefix, fakeHasher{prefix: prefix})
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestSetDefaultHasher(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	fake := fakeHasher{prefix: "fake-default$"}
+	prev := security.SetDefaultHasher(fake)
+	defer security.SetDefaultHasher(prev)
+
+	hash, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(hash) != "fake-default$hunter2" {
+		t.Errorf("unexpected hash %q", hash)
+	}
+	if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+		t.Error(err)
+	}
+
+	security.SetDefaultHasher(prev)
+	if err := security.CompareHashAndPassword(
+		hash, "hunter2",
+	); !testutils.IsError(err, "unrecognized password hash format") {
+		t.Errorf("expected unrecognized hash error, got %v", err)
+	}
+}
+
+func TestCompareEncodedHash(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	const hash = "crdb-bcrypt2$2a$04$7p.1MyeAc0YThjAv9pfrw.XpRKneZ/6Tx3hO4u1l3.Ko6HlVrlqgi"
+	for _, encoded := range []string{
+		hex.EncodeToString([]byte(hash)),
+		base64.StdEncoding.EncodeToString([]byte(hash)),
+	} {
+		if err := security.CompareHashAndPassword([]byte(encoded), "hunter2"); err != nil {
+			t.Errorf("%s: %v", encoded, err)
+		}
+		if err := security.CompareHashAndPassword(
+			[]byte(encoded), "hunter3",
+		); err != security.ErrPasswordMismatch {
+			t.Errorf("%s: expected mismatch, got %v", encoded, err)
+		}
+	}
+
+	testData := []struct {
+		hash     string
+		expected string
+	}{
+		{"hunter2", "not a hex- or base64-encoded hash"},
+		// Plain text passwords that happen to be valid hex or base64.
+		{"deadbeef", "hex-decoded hash: no recognized prefix"},
+		{"hunter22", "base64-decoded hash: no recognized prefix"},
+		// Hashes with a recognized prefix once decoded must still be valid.
+		{hex.EncodeToString([]byte(hash[:40])), "hex-decoded hash: .*truncated salt"},
+		{
+			base64.StdEncoding.EncodeToString([]byte("$argon2id$v=19$m=64,t=1$c2FsdA$aGFzaA")),
+			"base64-decoded hash: .*expected parameters m,t,p",
+		},
+	}
+	for _, d := range testData {
+		err := security.CompareHashAndPassword([]byte(d.hash), "hunter2")
+		if !testutils.IsError(err, d.expected) {
+			t.Errorf("%q: expected error %q, got %v", d.hash, d.expected, err)
+		}
+		if errors.Cause(err) != security.ErrMalformedHash {
+			t.Errorf("%q: expected ErrMalformedHash, got %v", d.hash, err)
+		}
+	}
+}
+
+func TestCompareMalformedHash(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	// The hashes use cost 31, so evaluating bcrypt on any of them would take
+	// days: they must be rejected before that.
+	const (
+		salt   = "7p.1MyeAc0YThjAv9pfrw."
+		digest = "XpRKneZ/6Tx3hO4u1l3.Ko6HlVrlqgi"
+	)
+	testData := []struct {
+		hash     string
+		expected string
+	}{
+		{"$2a$31$x", "truncated salt"},
+		{"$2a$31$" + salt[:21], "truncated salt"},
+		{"$2a$31$" + salt + digest[:30], "truncated hash"},
+		{"$2a$31$" + salt + digest + "x", "unexpected trailing characters"},
+		{"$2a$31x" + salt + digest, "invalid separators"},
+		{"$2a$3x$" + salt + digest, `invalid cost "3x"`},
+		{"$2a$03$" + salt + digest, `cost 3 outside of \[4, 31\]`},
+		{"$2a$32$" + salt + digest, `cost 32 outside of \[4, 31\]`},
+		{"$2a$31$" + salt[:21] + "!" + digest, "invalid salt encoding"},
+		{"$2a$31$" + salt + digest[:30] + "+", "invalid hash encoding"},
+	}
+	for _, d := range testData {
+		err := security.CompareHashAndPassword([]byte(d.hash), "hunter2")
+		if !testutils.IsError(err, "malformed bcrypt hash: "+d.expected) {
+			t.Errorf("%q: expected error %q, got %v", d.hash, d.expected, err)
+		}
+		if errors.Cause(err) != security.ErrMalformedHash {
+			t.Errorf("%q: expected ErrMalformedHash, got %v", d.hash, err)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"hash"
+
+	"github.com/pkg/errors"
+)
+
+// LDAP userPassword values are prefixed with the scheme in braces. The
+// salted SHA schemes are followed by the base64 encoding of the digest of
+// the password and salt, followed by the salt:
+//
+//	{SSHA}<base64(sha1(password || salt) || salt)>
+//	{SSHA512}<base64(sha512(password || salt) || salt)>
+const (
+	sshaID    = "SSHA"
+	ssha512ID = "SSHA512"
+)
+
+// ldapScheme returns the scheme of an LDAP userPassword value, e.g. "CRYPT"
+// for "{CRYPT}...", and whether the value has a scheme.
+func ldapScheme(hashedPassword []byte) (string, bool) {
+	if len(hashedPassword) == 0 || hashedPassword[0] != '{' {
+		return "", false
+	}
+	end := bytes.IndexByte(hashedPassword, '}')
+	if end <= 1 {
+		return "", false
+	}
+	return string(hashedPassword[1:end]), true
+}
+
+// sshaDigest returns the digest used by the given salted SHA scheme.
+func sshaDigest(method HashMethod) func() hash.Hash {
+	if method == HashSSHA512 {
+		return sha512.New
+	}
+	return sha1.New
+}
+
+// parseSSHAHash decodes an {SSHA} or {SSHA512} hash. Both padded and
+// unpadded base64 are accepted, and the salt may be of any non-zero length.
+func parseSSHAHash(method HashMethod, hashedPassword []byte) (PasswordHash, error) {
+	id := sshaID
+	if method == HashSSHA512 {
+		id = ssha512ID
+	}
+	encoded := string(hashedPassword[len(id)+2:])
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		decoded, err = base64.RawStdEncoding.DecodeString(encoded)
+		if err != nil {
+			return PasswordHash{}, errors.Errorf("malformed %s hash: invalid encoding", id)
+		}
+	}
+	size := sshaDigest(method)().Size()
+	if len(decoded) <= size {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: expected more than %d bytes, got %d",
+			id, size, len(decoded))
+	}
+	return PasswordHash{
+		method: method,
+		id:     id,
+		salt:   decoded[size:],
+		hash:   decoded[:size],
+	}, nil
+}
+
+// encodeSSHAHash is the inverse of parseSSHAHash. It always uses padded
+// base64, as OpenLDAP does.
+func encodeSSHAHash(h PasswordHash) []byte {
+	raw := append(append([]byte(nil), h.hash...), h.salt...)
+	return []byte("{" + h.id + "}" + base64.StdEncoding.EncodeToString(raw))
+}
+
+func verifySSHA(h PasswordHash, password []byte) error {
+	d := sshaDigest(h.method)()
+	_, _ = d.Write(password)
+	_, _ = d.Write(h.salt)
+	if subtle.ConstantTimeCompare(h.hash, d.Sum(nil)) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
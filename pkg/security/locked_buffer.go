@@ -0,0 +1,166 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+	"flag"
+	"os"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+// LockedBuffer is a buffer for secrets, e.g. pepper keys, in page-aligned
+// memory which, on Linux and macOS, is locked with mlock, so that it is
+// never written to swap. The pages of the buffer hold nothing else. Destroy
+// zeroes and unlocks them.
+//
+// If the memory cannot be locked, e.g. because the RLIMIT_MEMLOCK limit
+// (ulimit -l) is exhausted, or on other platforms, the buffer is in regular
+// memory, and a warning is logged, once. Memory locking is best effort: it
+// does not protect e.g. the copies made by callers.
+//
+// A LockedBuffer is not safe for concurrent use.
+type LockedBuffer struct {
+	buf []byte
+	// pages is the locked memory holding buf, whole pages, or nil if it is
+	// not locked.
+	pages []byte
+}
+
+// lockedBuffers are the buffers with locked memory, by the address of their
+// first byte, so that SecureStrings holding them unlock them when destroyed.
+var lockedBuffers struct {
+	syncutil.Mutex
+	m map[*byte]*LockedBuffer
+}
+
+// NewLockedBuffer allocates a zeroed LockedBuffer of size bytes.
+func NewLockedBuffer(size int) *LockedBuffer {
+	if size < 0 {
+		panic("negative LockedBuffer size")
+	}
+	b := &LockedBuffer{buf: make([]byte, 0)}
+	if size == 0 {
+		return b
+	}
+	// Go's garbage collector does not move heap memory, which can thus be
+	// locked. One more page is allocated to align the start of the buffer.
+	pageSize := os.Getpagesize()
+	n := (size + pageSize - 1) / pageSize * pageSize
+	mem := make([]byte, n+pageSize)
+	offset := pageSize - int(uintptr(unsafe.Pointer(&mem[0]))%uintptr(pageSize))
+	pages := mem[offset : offset+n : offset+n]
+	b.buf = pages[:size:size]
+	if err := memoryLock.lock(pages); err != nil {
+		warnMemoryNotLocked(err)
+		return b
+	}
+	b.pages = pages
+	lockedBuffers.Lock()
+	defer lockedBuffers.Unlock()
+	if lockedBuffers.m == nil {
+		lockedBuffers.m = make(map[*byte]*LockedBuffer)
+	}
+	lockedBuffers.m[&b.buf[0]] = b
+	return b
+}
+
+// Bytes returns the buffer, for the secret to be written to and read from.
+// Its capacity is its length, so that appending to it does not write past
+// the buffer. It is nil once the buffer is destroyed.
+func (b *LockedBuffer) Bytes() []byte {
+	return b.buf
+}
+
+// Locked returns whether the memory of the buffer is locked.
+func (b *LockedBuffer) Locked() bool {
+	return b.pages != nil
+}
+
+// Destroy zeroes the buffer, and unlocks its memory. The buffer must not be
+// used anymore.
+func (b *LockedBuffer) Destroy() {
+	ZeroBytes(b.buf)
+	if b.pages != nil {
+		lockedBuffers.Lock()
+		delete(lockedBuffers.m, &b.buf[0])
+		lockedBuffers.Unlock()
+		_ = memoryLock.unlock(b.pages)
+		b.pages = nil
+	}
+	b.buf = nil
+}
+
+// destroyLockedBuffer destroys the LockedBuffer of a secret, if it holds
+// one.
+func destroyLockedBuffer(secret []byte) {
+	if len(secret) == 0 {
+		return
+	}
+	lockedBuffers.Lock()
+	b, ok := lockedBuffers.m[&secret[0]]
+	lockedBuffers.Unlock()
+	if ok {
+		b.Destroy()
+	}
+}
+
+// memoryLock locks and unlocks memory: with mlock and munlock, unless
+// replaced by TestingSetMemoryLock.
+var memoryLock = struct {
+	lock, unlock func([]byte) error
+	warningf     func(format string, args ...interface{})
+}{
+	lock:   mlock,
+	unlock: munlock,
+	warningf: func(format string, args ...interface{}) {
+		log.Warningf(context.Background(), format, args...)
+	},
+}
+
+// memoryNotLockedWarned is set once the warning about memory which cannot
+// be locked has been logged.
+var memoryNotLockedWarned int32
+
+// warnMemoryNotLocked logs, once, that memory cannot be locked.
+func warnMemoryNotLocked(err error) {
+	if atomic.CompareAndSwapInt32(&memoryNotLockedWarned, 0, 1) {
+		memoryLock.warningf("secrets are kept in memory which is not locked, "+
+			"and may be written to swap: %v", err)
+	}
+}
+
+// TestingSetMemoryLock replaces the functions locking and unlocking the
+// memory of LockedBuffers, e.g. to simulate the exhaustion of the
+// RLIMIT_MEMLOCK limit, and the function logging that it cannot be locked,
+// whose warning can be logged again. The returned function restores them.
+func TestingSetMemoryLock(
+	lock, unlock func([]byte) error, warningf func(format string, args ...interface{}),
+) func() {
+	if flag.Lookup("test.v") == nil {
+		panic("TestingSetMemoryLock can only be used in tests")
+	}
+	prev := memoryLock
+	memoryLock.lock, memoryLock.unlock, memoryLock.warningf = lock, unlock, warningf
+	atomic.StoreInt32(&memoryNotLockedWarned, 0)
+	return func() {
+		memoryLock = prev
+		atomic.StoreInt32(&memoryNotLockedWarned, 0)
+	}
+}
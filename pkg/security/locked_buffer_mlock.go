@@ -0,0 +1,37 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// +build linux darwin
+
+package security
+
+import (
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// mlock locks memory, so that it is not written to swap.
+func mlock(b []byte) error {
+	err := syscall.Mlock(b)
+	if err == syscall.ENOMEM || err == syscall.EPERM {
+		return errors.Wrap(err, "the RLIMIT_MEMLOCK limit (ulimit -l) is exhausted")
+	}
+	return err
+}
+
+// munlock unlocks memory locked by mlock.
+func munlock(b []byte) error {
+	return syscall.Munlock(b)
+}
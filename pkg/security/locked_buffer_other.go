@@ -0,0 +1,33 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// +build !linux,!darwin
+
+package security
+
+import (
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+// mlock fails: memory is only locked on Linux and macOS.
+func mlock([]byte) error {
+	return errors.Errorf("memory locking is not supported on %s", runtime.GOOS)
+}
+
+// munlock does nothing.
+func munlock([]byte) error {
+	return nil
+}
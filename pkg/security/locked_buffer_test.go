@@ -0,0 +1,200 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+	"unsafe"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+// memoryLockRecorder records the memory locked and unlocked by
+// LockedBuffers, and the warnings logged, failing to lock memory if err is
+// set.
+type memoryLockRecorder struct {
+	err              error
+	locked, unlocked [][]byte
+	warnings         []string
+}
+
+func (r *memoryLockRecorder) set() func() {
+	return security.TestingSetMemoryLock(
+		func(b []byte) error {
+			if r.err != nil {
+				return r.err
+			}
+			r.locked = append(r.locked, b)
+			return nil
+		},
+		func(b []byte) error {
+			r.unlocked = append(r.unlocked, b)
+			return nil
+		},
+		func(format string, args ...interface{}) {
+			r.warnings = append(r.warnings, fmt.Sprintf(format, args...))
+		},
+	)
+}
+
+func TestLockedBuffer(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	pageSize := os.Getpagesize()
+	for _, size := range []int{0, 1, 32, pageSize, pageSize + 1} {
+		b := security.NewLockedBuffer(size)
+		buf := b.Bytes()
+		if len(buf) != size || cap(buf) != size {
+			t.Fatalf("%d: unexpected length %d and capacity %d", size, len(buf), cap(buf))
+		}
+		if !bytes.Equal(buf, make([]byte, size)) {
+			t.Errorf("%d: expected a zeroed buffer, got %x", size, buf)
+		}
+		if size > 0 && uintptr(unsafe.Pointer(&buf[0]))%uintptr(pageSize) != 0 {
+			t.Errorf("%d: buffer is not page-aligned", size)
+		}
+		if size > 0 && !b.Locked() {
+			// E.g. in containers with a low RLIMIT_MEMLOCK limit.
+			t.Logf("%d: memory is not locked", size)
+		}
+		for i := range buf {
+			buf[i] = 'x'
+		}
+		b.Destroy()
+		if !bytes.Equal(buf, make([]byte, size)) {
+			t.Errorf("%d: expected the buffer to be zeroed, got %x", size, buf)
+		}
+		if b.Bytes() != nil || b.Locked() {
+			t.Errorf("%d: expected a destroyed buffer", size)
+		}
+	}
+}
+
+func TestLockedBufferLocking(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	var r memoryLockRecorder
+	defer r.set()()
+
+	// Whole pages are locked, and unlocked on Destroy, including when the
+	// buffer is destroyed as a SecureString.
+	pageSize := os.Getpagesize()
+	b := security.NewLockedBuffer(pageSize + 1)
+	if !b.Locked() || len(r.locked) != 1 || len(r.locked[0]) != 2*pageSize {
+		t.Fatalf("expected 2 locked pages, got %d locks", len(r.locked))
+	}
+	if &r.locked[0][0] != &b.Bytes()[0] {
+		t.Errorf("expected the pages of the buffer to be locked")
+	}
+	b.Destroy()
+	b.Destroy()
+	if len(r.unlocked) != 1 || &r.unlocked[0][0] != &r.locked[0][0] {
+		t.Errorf("expected the pages to be unlocked once, got %d unlocks", len(r.unlocked))
+	}
+
+	b = security.NewLockedBuffer(8)
+	copy(b.Bytes(), "hunter2")
+	security.SecureString(b.Bytes()).Destroy()
+	if len(r.unlocked) != 2 || b.Locked() {
+		t.Errorf("expected the SecureString to unlock its pages, got %d unlocks", len(r.unlocked))
+	}
+	if len(r.warnings) > 0 {
+		t.Errorf("unexpected warnings: %q", r.warnings)
+	}
+
+	// Passwords read by Prompters given WithLockedMemory are locked.
+	for _, interactive := range []bool{true, false} {
+		r.locked, r.unlocked = nil, nil
+		opts := []security.PrompterOption{security.WithLockedMemory()}
+		p := security.NewTestPrompter([]string{"hunter2"}, opts...)
+		if !interactive {
+			p = security.NewPrompter(bytes.NewBufferString("hunter2\n"), nil, opts...)
+		}
+		password, err := p.ReadPasswordBytes()
+		if err != nil || string(password) != "hunter2" {
+			t.Fatalf("%t: unexpected password %q, %v", interactive, password.UnsafeBytes(), err)
+		}
+		if len(r.locked) != 1 || &r.locked[0][0] != &password[0] {
+			t.Errorf("%t: expected the password to be in locked memory", interactive)
+		}
+		password.Destroy()
+		if len(r.unlocked) != 1 {
+			t.Errorf("%t: expected the password to be unlocked, got %d unlocks",
+				interactive, len(r.unlocked))
+		}
+	}
+
+	r.locked, r.unlocked = nil, nil
+	password, err := security.NewTestPrompter(
+		[]string{" hunter2 ", " hunter2 "}, security.WithLockedMemory(),
+	).ReadNewPasswordBytes()
+	if err != nil || len(r.locked) != 1 || &r.locked[0][0] != &password[0] {
+		t.Fatalf("expected a new password in locked memory, got %v", err)
+	}
+	password.Destroy()
+	if len(r.unlocked) != 1 {
+		t.Errorf("expected the new password to be unlocked, got %d unlocks", len(r.unlocked))
+	}
+}
+
+func TestLockedBufferFallback(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// When RLIMIT_MEMLOCK is exhausted, buffers are in regular memory, and
+	// a warning is logged once.
+	r := memoryLockRecorder{err: errors.New("cannot allocate memory")}
+	defer r.set()()
+
+	for i := 0; i < 3; i++ {
+		b := security.NewLockedBuffer(16)
+		if b.Locked() {
+			t.Errorf("%d: expected the memory not to be locked", i)
+		}
+		buf := b.Bytes()
+		copy(buf, "hunter2")
+		b.Destroy()
+		if !bytes.Equal(buf, make([]byte, 16)) {
+			t.Errorf("%d: expected the buffer to be zeroed, got %x", i, buf)
+		}
+	}
+	if len(r.unlocked) != 0 {
+		t.Errorf("unexpected unlocks: %d", len(r.unlocked))
+	}
+	const expected = "secrets are kept in memory which is not locked, and may be written " +
+		"to swap: cannot allocate memory"
+	if len(r.warnings) != 1 || r.warnings[0] != expected {
+		t.Errorf("expected a single warning, got %q", r.warnings)
+	}
+
+	// Pepper keys keep working.
+	security.SetPepper([]byte("pepper"))
+	defer security.SetPepper(nil)
+	hash, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+		t.Error(err)
+	}
+	if len(r.warnings) != 1 {
+		t.Errorf("expected a single warning, got %q", r.warnings)
+	}
+}
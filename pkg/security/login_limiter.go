@@ -0,0 +1,161 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/util/cache"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+// The defaults of LoginAttemptLimiterConfig.
+const (
+	defaultLoginBaseDelay     = time.Second
+	defaultLoginMaxDelay      = 5 * time.Minute
+	defaultLoginMaxIdentities = 10000
+)
+
+// LoginAttemptLimiterConfig configures a LoginAttemptLimiter. Zero values
+// are replaced with defaults.
+type LoginAttemptLimiterConfig struct {
+	// BaseDelay is how long an identity is throttled after a failure. Each
+	// consecutive failure doubles it, up to MaxDelay. The defaults are one
+	// second and five minutes.
+	BaseDelay, MaxDelay time.Duration
+	// MaxIdentities is the number of identities whose failures are tracked.
+	// Beyond it, the least recently used identities are forgotten. The
+	// default is 10000.
+	MaxIdentities int
+	// Now returns the current time. It defaults to timeutil.Now, and can be
+	// replaced with a manual clock in tests.
+	Now func() time.Time
+}
+
+// loginAttempts tracks the consecutive failures of an identity.
+type loginAttempts struct {
+	failures int
+	// until is when the identity stops being throttled.
+	until time.Time
+}
+
+// LoginAttemptLimiter throttles brute-force attempts at guessing passwords
+// by delaying logins after failed verifications, with an exponential
+// backoff per identity, e.g. per user or per client address. It is safe for
+// concurrent use. Its zero value is not usable; use NewLoginAttemptLimiter.
+//
+// Callers check Allow (or RetryAfter) before verifying a password, and
+// report the outcome of the verification with RecordFailure or
+// RecordSuccess.
+type LoginAttemptLimiter struct {
+	cfg LoginAttemptLimiterConfig
+
+	mu struct {
+		syncutil.Mutex
+		identities *cache.UnorderedCache
+	}
+}
+
+// NewLoginAttemptLimiter creates a LoginAttemptLimiter.
+func NewLoginAttemptLimiter(cfg LoginAttemptLimiterConfig) *LoginAttemptLimiter {
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = defaultLoginBaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = defaultLoginMaxDelay
+	}
+	if cfg.MaxDelay < cfg.BaseDelay {
+		cfg.MaxDelay = cfg.BaseDelay
+	}
+	if cfg.MaxIdentities <= 0 {
+		cfg.MaxIdentities = defaultLoginMaxIdentities
+	}
+	if cfg.Now == nil {
+		cfg.Now = timeutil.Now
+	}
+	l := &LoginAttemptLimiter{cfg: cfg}
+	l.mu.identities = cache.NewUnorderedCache(cache.Config{
+		Policy: cache.CacheLRU,
+		ShouldEvict: func(size int, _, _ interface{}) bool {
+			return size > cfg.MaxIdentities
+		},
+	})
+	return l
+}
+
+// Allow reports whether a login of the identity can be attempted now.
+func (l *LoginAttemptLimiter) Allow(identity string) bool {
+	return l.RetryAfter(identity) == 0
+}
+
+// RetryAfter returns how long the identity remains throttled, or 0 if a
+// login can be attempted now. It can be reported to clients, e.g. as a
+// retry-after hint.
+func (l *LoginAttemptLimiter) RetryAfter(identity string) time.Duration {
+	now := l.cfg.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	v, ok := l.mu.identities.Get(identity)
+	if !ok {
+		return 0
+	}
+	if wait := v.(*loginAttempts).until.Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// RecordFailure records a failed verification for the identity, which is
+// throttled for twice as long as after its previous failure, up to the
+// maximum delay. Failures are forgotten if the identity did not fail again
+// for the maximum delay after its backoff ended.
+func (l *LoginAttemptLimiter) RecordFailure(identity string) {
+	now := l.cfg.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var a *loginAttempts
+	if v, ok := l.mu.identities.Get(identity); ok {
+		a = v.(*loginAttempts)
+		if now.Sub(a.until) > l.cfg.MaxDelay {
+			a.failures = 0
+		}
+	} else {
+		a = &loginAttempts{}
+		l.mu.identities.Add(identity, a)
+	}
+	a.failures++
+	a.until = now.Add(l.backoff(a.failures))
+}
+
+// RecordSuccess records a successful verification for the identity, which
+// resets its backoff.
+func (l *LoginAttemptLimiter) RecordSuccess(identity string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.mu.identities.Del(identity)
+}
+
+// backoff returns the delay after the given number of consecutive failures.
+func (l *LoginAttemptLimiter) backoff(failures int) time.Duration {
+	delay := l.cfg.BaseDelay
+	for i := 1; i < failures && delay < l.cfg.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > l.cfg.MaxDelay {
+		delay = l.cfg.MaxDelay
+	}
+	return delay
+}
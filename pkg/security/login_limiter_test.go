@@ -0,0 +1,112 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestLoginAttemptLimiter(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	now := time.Unix(0, 0)
+	newLimiter := func(maxIdentities int) *security.LoginAttemptLimiter {
+		return security.NewLoginAttemptLimiter(security.LoginAttemptLimiterConfig{
+			BaseDelay:     time.Second,
+			MaxDelay:      10 * time.Second,
+			MaxIdentities: maxIdentities,
+			Now:           func() time.Time { return now },
+		})
+	}
+	expectRetryAfter := func(l *security.LoginAttemptLimiter, identity string, d time.Duration) {
+		t.Helper()
+		if r := l.RetryAfter(identity); r != d {
+			t.Errorf("%s: expected to retry after %s, got %s", identity, d, r)
+		}
+		if a := l.Allow(identity); a != (d == 0) {
+			t.Errorf("%s: expected Allow to be %t, got %t", identity, d == 0, a)
+		}
+	}
+
+	t.Run("backoff", func(t *testing.T) {
+		l := newLimiter(10)
+		expectRetryAfter(l, "alice", 0)
+		for _, d := range []time.Duration{1, 2, 4, 8, 10, 10} {
+			l.RecordFailure("alice")
+			expectRetryAfter(l, "alice", d*time.Second)
+			now = now.Add(time.Second)
+			expectRetryAfter(l, "alice", (d-1)*time.Second)
+			now = now.Add((d - 1) * time.Second)
+			expectRetryAfter(l, "alice", 0)
+		}
+		// Other identities are not throttled.
+		expectRetryAfter(l, "bob", 0)
+
+		// Failures are forgotten after a quiet period.
+		now = now.Add(11 * time.Second)
+		l.RecordFailure("alice")
+		expectRetryAfter(l, "alice", time.Second)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		l := newLimiter(10)
+		for i := 0; i < 3; i++ {
+			l.RecordFailure("alice")
+		}
+		expectRetryAfter(l, "alice", 4*time.Second)
+		l.RecordSuccess("alice")
+		expectRetryAfter(l, "alice", 0)
+		l.RecordFailure("alice")
+		expectRetryAfter(l, "alice", time.Second)
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		l := newLimiter(2)
+		l.RecordFailure("alice")
+		l.RecordFailure("bob")
+		// Checking alice makes bob the least recently used identity.
+		expectRetryAfter(l, "alice", time.Second)
+		l.RecordFailure("carol")
+		expectRetryAfter(l, "bob", 0)
+		expectRetryAfter(l, "alice", time.Second)
+		expectRetryAfter(l, "carol", time.Second)
+	})
+
+	t.Run("concurrency", func(t *testing.T) {
+		l := newLimiter(100)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				identity := fmt.Sprintf("user%d", i%3)
+				for j := 0; j < 100; j++ {
+					l.Allow(identity)
+					l.RecordFailure(identity)
+					if j%10 == 0 {
+						l.RecordSuccess(identity)
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+	})
+}
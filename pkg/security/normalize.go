@@ -0,0 +1,163 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bytes"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// normalizedPrefix marks hashes of passwords prepared with SASLprep (see
+// SetPasswordNormalization). It is immediately followed by the hash itself,
+// e.g. "crdb-saslprep:crdb-bcrypt2$2a$10$..." or
+// "crdb-saslprep:$argon2id$v=19$...".
+const normalizedPrefix = "crdb-saslprep:"
+
+// normalizePasswords is 1 if passwords are prepared with SASLprep before
+// being hashed. It is accessed atomically.
+var normalizePasswords int32
+
+func init() {
+	// Normalized hashes are verified by the Hasher of the method of the hash
+	// they wrap, which methodHasher finds by parsing them.
+	if err := RegisterHasher(normalizedPrefix, methodHasher(HashMethodUnknown)); err != nil {
+		panic(err)
+	}
+}
+
+// SetPasswordNormalization configures whether HashPassword and the related
+// functions prepare passwords with SASLprep (RFC 4013) before hashing them.
+// SASLprep normalizes passwords with Unicode normalization form KC, so that
+// e.g. "café" verifies whether it was typed in its composed form (NFC), as on
+// Linux, or its decomposed form (NFD), as on macOS, and rejects passwords with
+// prohibited characters, such as control characters, or which mix
+// left-to-right and right-to-left characters.
+//
+// The hashes of prepared passwords are marked with a prefix, so that they are
+// always verified against prepared passwords, and other hashes against the
+// raw bytes of passwords, regardless of the configuration. While
+// normalization is enabled, hashes of raw passwords also verify prepared
+// passwords, to ease the transition, and NeedsRehash reports them. SCRAM
+// verifiers, whose passwords are always prepared, are not affected.
+// Changing the configuration drops the cached verification outcomes (see
+// SetVerifyCacheSize).
+func SetPasswordNormalization(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&normalizePasswords, v)
+	clearVerifyCache()
+}
+
+// passwordNormalizationEnabled returns whether passwords are prepared before
+// being hashed.
+func passwordNormalizationEnabled() bool {
+	return atomic.LoadInt32(&normalizePasswords) == 1
+}
+
+// normalizes returns whether hashes of the method are normalized.
+func normalizes(method HashMethod) bool {
+	switch method {
+	case HashBCrypt, HashArgon2id, HashScrypt, HashPBKDF2:
+		return true
+	default:
+		return false
+	}
+}
+
+// splitNormalizedPrefix strips normalizedPrefix, if any, from a hash.
+func splitNormalizedPrefix(hashedPassword []byte) (normalized bool, hash []byte) {
+	if bytes.HasPrefix(hashedPassword, []byte(normalizedPrefix)) {
+		return true, hashedPassword[len(normalizedPrefix):]
+	}
+	return false, hashedPassword
+}
+
+// normalizePassword prepares a password with SASLprep. Empty passwords, e.g.
+// made only of characters mapped to nothing, are rejected with
+// ErrEmptyPassword.
+func normalizePassword(password []byte) ([]byte, error) {
+	prepared, err := saslPrep(string(password))
+	if err != nil {
+		return nil, err
+	}
+	if prepared == "" {
+		return nil, ErrEmptyPassword
+	}
+	return []byte(prepared), nil
+}
+
+// parseNormalizedHash decodes a hash with normalizedPrefix.
+func parseNormalizedHash(hashedPassword []byte) (PasswordHash, error) {
+	_, inner := splitNormalizedPrefix(hashedPassword)
+	if normalized, _ := splitNormalizedPrefix(inner); normalized {
+		return PasswordHash{}, errors.New("malformed password hash: repeated normalization prefix")
+	}
+	h, err := parsePasswordHash(inner)
+	if err != nil {
+		return PasswordHash{}, err
+	}
+	if !normalizes(h.method) {
+		return PasswordHash{}, errors.Errorf("%s password hashes cannot be normalized", h.method)
+	}
+	h.normalized = true
+	return h, nil
+}
+
+// hashNormalized hashes a password prepared with SASLprep with a method, and
+// marks the hash with normalizedPrefix.
+func hashNormalized(method HashMethod, password []byte) ([]byte, error) {
+	prepared, err := normalizePassword(password)
+	if err != nil {
+		return nil, err
+	}
+	defer ZeroBytes(prepared)
+	hash, err := hashPasswordWithMethodRaw(method, prepared)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(normalizedPrefix), hash...), nil
+}
+
+// verify verifies a password against a hash, preparing it first if the hash
+// is normalized. While normalization is enabled, the prepared password is
+// also tried against hashes which are not.
+func (h PasswordHash) verify(password []byte) error {
+	if h.normalized {
+		prepared, err := normalizePassword(password)
+		if err != nil {
+			// No normalized hash can have been computed from the password;
+			// verify it anyway so that the mismatch takes as long as others.
+			if err := h.verifyRaw(password); err != nil && err != ErrPasswordMismatch {
+				return err
+			}
+			return ErrPasswordMismatch
+		}
+		defer ZeroBytes(prepared)
+		return h.verifyRaw(prepared)
+	}
+	err := h.verifyRaw(password)
+	if err == ErrPasswordMismatch && passwordNormalizationEnabled() && normalizes(h.method) {
+		prepared, perr := normalizePassword(password)
+		if perr == nil && !bytes.Equal(prepared, password) {
+			err = h.verifyRaw(prepared)
+		}
+		ZeroBytes(prepared)
+	}
+	return err
+}
@@ -0,0 +1,229 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+const normalizedPrefix = "crdb-saslprep:"
+
+func TestPasswordNormalization(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+	defaultMethod := security.GetDefaultHashMethod()
+	defer func() {
+		if err := security.SetDefaultHashMethod(defaultMethod); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if err := security.SetDefaultHashMethod(security.HashPBKDF2); err != nil {
+		t.Fatal(err)
+	}
+	defer security.SetPasswordNormalization(false)
+
+	hash := func(password string) []byte {
+		t.Helper()
+		h, err := security.HashPasswordWithMethod(security.HashPBKDF2, password)
+		if err != nil {
+			t.Fatalf("%q: %v", password, err)
+		}
+		return h
+	}
+	compare := func(h []byte, password string) error {
+		return security.CompareHashAndPassword(h, password)
+	}
+
+	// Hashes of raw passwords, computed before normalization is enabled.
+	nfc, nfd := "caf\u00e9", "cafe\u0301"
+	legacyNFC, legacyNFD := hash(nfc), hash(nfd)
+	if bytes.HasPrefix(legacyNFC, []byte(normalizedPrefix)) {
+		t.Fatalf("unexpected normalized hash %s", legacyNFC)
+	}
+	if err := compare(legacyNFC, nfd); err != security.ErrPasswordMismatch {
+		t.Fatalf("expected raw bytes to be compared, got %v", err)
+	}
+
+	security.SetPasswordNormalization(true)
+
+	// The test vectors of RFC 4013, section 3.
+	for _, tc := range []struct {
+		password string
+		// equivalent are passwords with the same SASLprep output.
+		equivalent []string
+		// different are passwords with another output.
+		different []string
+		err       string
+	}{
+		// SOFT HYPHEN mapped to nothing.
+		{password: "I\u00adX", equivalent: []string{"IX"}},
+		// No transformation.
+		{password: "user", equivalent: []string{"user"}, different: []string{"USER"}},
+		// Case preserved, will not match #2.
+		{password: "USER", different: []string{"user"}},
+		// Output is NFKC, input in ISO 8859-1.
+		{password: "\u00aa", equivalent: []string{"a"}},
+		// Output is NFKC, will match #1.
+		{password: "\u2168", equivalent: []string{"IX", "I\u00adX"}},
+		// Error - prohibited character. Control characters are rejected
+		// before the password is prepared (see CheckPasswordEncoding).
+		{password: "\u0007", err: "control characters"},
+		// The error does not reveal the character.
+		{password: "\ue000", err: "contains a prohibited character$"},
+		// Error - bidirectional check.
+		{password: "\u0627\u0031", err: "right-to-left"},
+	} {
+		h, err := security.HashPasswordWithMethod(security.HashPBKDF2, tc.password)
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%+q: expected %q, got %v", tc.password, tc.err, err)
+			}
+			// Neither does the error of HashPassword.
+			if _, err := security.HashPassword(tc.password); err == nil ||
+				strings.Contains(err.Error(), tc.password) || strings.Contains(err.Error(), "U+") {
+				t.Errorf("%+q: expected an error not revealing the password, got %v", tc.password, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%+q: %v", tc.password, err)
+			continue
+		}
+		if !bytes.HasPrefix(h, []byte(normalizedPrefix)) {
+			t.Errorf("%+q: expected a normalized hash, got %s", tc.password, h)
+		}
+		for _, p := range append([]string{tc.password}, tc.equivalent...) {
+			if err := compare(h, p); err != nil {
+				t.Errorf("%+q: %+q: %v", tc.password, p, err)
+			}
+		}
+		for _, p := range tc.different {
+			if err := compare(h, p); err != security.ErrPasswordMismatch {
+				t.Errorf("%+q: %+q: expected mismatch, got %v", tc.password, p, err)
+			}
+		}
+		// Passwords which cannot be prepared never match.
+		if err := compare(h, "\u0007"); err != security.ErrPasswordMismatch {
+			t.Errorf("%+q: expected mismatch, got %v", tc.password, err)
+		}
+	}
+	if _, err := security.HashPassword("\u00ad"); err != security.ErrEmptyPassword {
+		t.Errorf("expected ErrEmptyPassword, got %v", err)
+	}
+
+	// Normalized hashes match both forms of the password, and round-trip.
+	for _, password := range []string{nfc, nfd} {
+		h := hash(password)
+		for _, p := range []string{nfc, nfd} {
+			if err := compare(h, p); err != nil {
+				t.Errorf("%+q: %+q: %v", password, p, err)
+			}
+		}
+		parsed, err := security.ParsePasswordHash(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsed.Method() != security.HashPBKDF2 {
+			t.Errorf("expected %s, got %s", security.HashPBKDF2, parsed.Method())
+		}
+		if encoded := parsed.Encode(); !bytes.Equal(encoded, h) {
+			t.Errorf("expected %s, got %s", h, encoded)
+		}
+		if err := parsed.Verify(nfd); err != nil {
+			t.Error(err)
+		}
+		if security.NeedsRehash(h) {
+			t.Errorf("%s should not need a rehash", h)
+		}
+	}
+
+	// Legacy hashes still match the raw password, and fall back to the
+	// normalized one, but are rehashed.
+	for _, tc := range []struct {
+		hash     []byte
+		password string
+		match    bool
+	}{
+		{legacyNFC, nfc, true},
+		{legacyNFC, nfd, true},
+		{legacyNFD, nfd, true},
+		// The hash is not of the normalized form.
+		{legacyNFD, nfc, false},
+		{legacyNFD, "cafe", false},
+	} {
+		err := compare(tc.hash, tc.password)
+		if tc.match && err != nil {
+			t.Errorf("%s: %+q: %v", tc.hash, tc.password, err)
+		} else if !tc.match && err != security.ErrPasswordMismatch {
+			t.Errorf("%s: %+q: expected mismatch, got %v", tc.hash, tc.password, err)
+		}
+		if !security.NeedsRehash(tc.hash) {
+			t.Errorf("%s should need a rehash", tc.hash)
+		}
+	}
+
+	// The prefix, not the configuration, determines how hashes are verified.
+	normalized := hash(nfd)
+	security.SetPasswordNormalization(false)
+	if err := compare(normalized, nfc); err != nil {
+		t.Error(err)
+	}
+	if err := compare(legacyNFC, nfd); err != security.ErrPasswordMismatch {
+		t.Errorf("expected mismatch, got %v", err)
+	}
+	if !security.NeedsRehash(normalized) {
+		t.Errorf("%s should need a rehash", normalized)
+	}
+
+	// Cached outcomes do not outlive the configuration they were computed
+	// with: the hashes of raw passwords only match prepared passwords while
+	// normalization is enabled.
+	if err := security.SetVerifyCacheSize(10); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetVerifyCacheSize(0); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if err := compare(legacyNFC, nfd); err != security.ErrPasswordMismatch {
+		t.Errorf("expected mismatch, got %v", err)
+	}
+	security.SetPasswordNormalization(true)
+	if err := compare(legacyNFC, nfd); err != nil {
+		t.Errorf("expected a match once normalization is enabled, got %v", err)
+	}
+	security.SetPasswordNormalization(false)
+	if err := compare(legacyNFC, nfd); err != security.ErrPasswordMismatch {
+		t.Errorf("expected mismatch once normalization is disabled, got %v", err)
+	}
+
+	for _, h := range []string{
+		normalizedPrefix + normalizedPrefix + string(legacyNFC),
+		normalizedPrefix + "md5" + "0123456789abcdef0123456789abcdef",
+		normalizedPrefix + "garbage",
+	} {
+		if _, err := security.ParsePasswordHash([]byte(h)); err == nil {
+			t.Errorf("%s: expected error", h)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// The bcrypt-sha256 hashes of Python's passlib apply bcrypt to the base64
+// encoding of a SHA-256 pre-hash of the password, like HashBCrypt, but
+// encode the bcrypt variant and cost in a PHC-like header:
+//
+//	$bcrypt-sha256$v=2,t=<variant>,r=<cost>$<salt>$<hash>
+//	$bcrypt-sha256$<variant>,<cost>$<salt>$<hash>
+//
+// The pre-hash of version 2 is HMAC-SHA256 keyed with the encoded salt, and
+// that of version 1 (the second form) is plain SHA-256. The salt and hash
+// are encoded as in bcrypt hashes.
+const passlibBcryptSHA256ID = "bcrypt-sha256"
+
+// parsePasslibBcryptSHA256Hash decodes a passlib bcrypt-sha256 hash. Like
+// for bcrypt hashes, the id of the result is the bcrypt variant.
+func parsePasslibBcryptSHA256Hash(hashedPassword []byte) (PasswordHash, error) {
+	fields := strings.Split(string(hashedPassword), "$")
+	if len(fields) != 5 {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: expected 5 fields, got %d",
+			passlibBcryptSHA256ID, len(fields))
+	}
+	version := 1
+	header := fields[2]
+	if strings.HasPrefix(header, "v=2,t=") {
+		version = 2
+		header = strings.Replace(header[len("v=2,t="):], ",r=", ",", 1)
+	}
+	parts := strings.Split(header, ",")
+	if len(parts) != 2 {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: invalid header %q",
+			passlibBcryptSHA256ID, hashForError(fields[2]))
+	}
+	id := parts[0]
+	if id != "2a" && id != "2b" {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: invalid bcrypt variant %q",
+			passlibBcryptSHA256ID, hashForError(id))
+	}
+	cost, err := strconv.Atoi(parts[1])
+	if err != nil || cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: invalid cost %q",
+			passlibBcryptSHA256ID, hashForError(parts[1]))
+	}
+	if len(fields[3]) != bcryptEncodedSaltLen {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: invalid salt length",
+			passlibBcryptSHA256ID)
+	}
+	salt, err := bcryptB64.DecodeString(fields[3])
+	if err != nil {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: invalid salt encoding",
+			passlibBcryptSHA256ID)
+	}
+	hash, err := bcryptB64.DecodeString(fields[4])
+	if err != nil || len(fields[4]) != bcryptHashLen-7-bcryptEncodedSaltLen {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: invalid hash encoding",
+			passlibBcryptSHA256ID)
+	}
+	return PasswordHash{
+		method:  HashPasslibBcryptSHA256,
+		id:      id,
+		version: version,
+		params:  []phcParam{{"cost", cost}},
+		salt:    salt,
+		hash:    hash,
+	}, nil
+}
+
+// encodePasslibBcryptSHA256Hash is the inverse of
+// parsePasslibBcryptSHA256Hash.
+func encodePasslibBcryptSHA256Hash(h PasswordHash) []byte {
+	cost, _ := h.Param("cost")
+	header := fmt.Sprintf("%s,%d", h.id, cost)
+	if h.version == 2 {
+		header = fmt.Sprintf("v=2,t=%s,r=%d", h.id, cost)
+	}
+	return []byte("$" + passlibBcryptSHA256ID + "$" + header + "$" +
+		bcryptB64.EncodeToString(h.salt) + "$" + bcryptB64.EncodeToString(h.hash))
+}
+
+func verifyPasslibBcryptSHA256(h PasswordHash, password []byte) error {
+	var sum []byte
+	if h.version == 2 {
+		mac := hmac.New(sha256.New, []byte(bcryptB64.EncodeToString(h.salt)))
+		_, _ = mac.Write(password)
+		sum = mac.Sum(nil)
+	} else {
+		s := sha256.Sum256(password)
+		sum = s[:]
+	}
+	defer ZeroBytes(sum)
+	input := make([]byte, base64.StdEncoding.EncodedLen(len(sum)))
+	defer ZeroBytes(input)
+	base64.StdEncoding.Encode(input, sum)
+	// Like for bcrypt hashes, $2b$ hashes are verified as $2a$ hashes.
+	h.id = "2a"
+	return bcrypt.CompareHashAndPassword(encodeBcryptHash(h, false /* withPrefix */), input)
+}
@@ -0,0 +1,137 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+//go:generate go run gen_passphrase_words.go
+
+import (
+	"bufio"
+	"compress/gzip"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// MinPassphraseWords is the minimum number of words of generated
+// passphrases: four words have about 51.7 bits of entropy, while three
+// would have less than 40.
+const MinPassphraseWords = 4
+
+// passphraseWords holds the words of passphrases, loaded from
+// passphraseWordsGzip the first time they are used.
+var passphraseWords struct {
+	once  sync.Once
+	words []string
+}
+
+// loadedPassphraseWords returns the words of passphrases, loading them if
+// needed.
+func loadedPassphraseWords() []string {
+	w := &passphraseWords
+	w.once.Do(func() {
+		words := make([]string, 0, passphraseWordCount)
+		gz, err := gzip.NewReader(strings.NewReader(passphraseWordsGzip))
+		if err == nil {
+			scanner := bufio.NewScanner(gz)
+			for scanner.Scan() {
+				words = append(words, scanner.Text())
+			}
+			err = scanner.Err()
+		}
+		if err != nil {
+			// The list is generated and covered by tests.
+			panic(errors.Wrap(err, "loading the passphrase words"))
+		}
+		w.words = words
+	})
+	return w.words
+}
+
+// PassphraseGenerator generates diceware-style passphrases: words chosen
+// uniformly at random with crypto/rand from the 7776 words of the large
+// diceware list of the EFF, embedded from passphrase_words.txt, each of
+// which adds about 12.9 bits of entropy. Its zero value generates lowercase
+// words.
+type PassphraseGenerator struct {
+	// Capitalize capitalizes the first letter of each word, for policies
+	// requiring uppercase letters. It adds no entropy.
+	Capitalize bool
+	// AppendDigit appends a random digit to the passphrase, for policies
+	// requiring digits.
+	AppendDigit bool
+}
+
+// GeneratePassphrase returns a passphrase of random lowercase words joined
+// by the separator, e.g. "wombat-orbit-cradle-velvet". See
+// PassphraseGenerator.Generate.
+func GeneratePassphrase(words int, separator string) (string, error) {
+	passphrase, _, err := (&PassphraseGenerator{}).Generate(words, separator)
+	return passphrase, err
+}
+
+// Generate returns a passphrase of random words joined by the separator,
+// and its estimated entropy in bits (see EntropyBits). Passphrases of fewer
+// than MinPassphraseWords words are refused. The separator must be accepted
+// by CheckPasswordEncoding; a symbol such as "-" satisfies policies requiring
+// symbols, while without a separator, passphrases are harder to read.
+func (g *PassphraseGenerator) Generate(
+	words int, separator string,
+) (passphrase string, entropyBits float64, err error) {
+	if words < MinPassphraseWords {
+		return "", 0, errors.Errorf("passphrases must have at least %d words, got %d",
+			MinPassphraseWords, words)
+	}
+	if err := CheckPasswordEncoding(separator); err != nil {
+		return "", 0, errors.Wrap(err, "invalid passphrase separator")
+	}
+	list := loadedPassphraseWords()
+	chosen := make([]string, words)
+	for i := range chosen {
+		j, err := randomInt(len(list))
+		if err != nil {
+			return "", 0, err
+		}
+		chosen[i] = list[j]
+		if g.Capitalize {
+			chosen[i] = strings.ToUpper(chosen[i][:1]) + chosen[i][1:]
+		}
+	}
+	passphrase = strings.Join(chosen, separator)
+	if g.AppendDigit {
+		d, err := randomInt(10)
+		if err != nil {
+			return "", 0, err
+		}
+		passphrase += string('0' + rune(d))
+	}
+	if err := checkPasswordLength(len(passphrase)); err != nil {
+		return "", 0, err
+	}
+	return passphrase, g.EntropyBits(words), nil
+}
+
+// EntropyBits returns the entropy, in bits, of the passphrases of the given
+// number of words generated by g: that of the choice of each word, and of
+// the appended digit, if any. It assumes that the attacker knows the list
+// of words, the separator and the options.
+func (g *PassphraseGenerator) EntropyBits(words int) float64 {
+	bits := float64(words) * math.Log2(float64(len(loadedPassphraseWords())))
+	if g.AppendDigit {
+		bits += math.Log2(10)
+	}
+	return bits
+}
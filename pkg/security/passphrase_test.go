@@ -0,0 +1,137 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bufio"
+	"context"
+	"math"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestGeneratePassphrase(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	// The embedded words are those of the source file.
+	f, err := os.Open("passphrase_words.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	words := make(map[string]bool)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// The word is the last field of the lines, after the dice roll.
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 &&
+			!strings.HasPrefix(fields[0], "#") {
+			words[fields[len(fields)-1]] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The list is the large diceware list of the EFF, with one word per roll
+	// of five dice.
+	if len(words) != 7776 {
+		t.Fatalf("expected 7776 words, got %d", len(words))
+	}
+	// Make sure the embedded list was regenerated after the last change.
+	if bits, expected := (&security.PassphraseGenerator{}).EntropyBits(1), math.Log2(
+		float64(len(words))); math.Abs(bits-expected) > 1e-9 {
+		t.Fatalf("expected %d words, got 2^%g; run go generate", len(words), bits)
+	}
+
+	for _, n := range []int{-1, 0, 3} {
+		if _, err := security.GeneratePassphrase(
+			n, "-",
+		); !testutils.IsError(err, "passphrases must have at least 4 words") {
+			t.Errorf("%d: expected error, got %v", n, err)
+		}
+	}
+	if _, err := security.GeneratePassphrase(4, "\x00"); !testutils.IsError(
+		err, "invalid passphrase separator: password must not contain NUL bytes",
+	) {
+		t.Errorf("expected error, got %v", err)
+	}
+
+	// Consecutive passphrases never collide, and all pass the default
+	// policy.
+	const samples = 10000
+	defaultPolicy := &security.PasswordPolicy{}
+	seen := make(map[string]bool, samples)
+	var prev string
+	for i := 0; i < samples; i++ {
+		passphrase, err := security.GeneratePassphrase(4, " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if passphrase == prev || seen[passphrase] {
+			t.Fatalf("%q was generated twice", passphrase)
+		}
+		prev = passphrase
+		seen[passphrase] = true
+		parts := strings.Split(passphrase, " ")
+		if len(parts) != 4 {
+			t.Fatalf("%q: expected 4 words", passphrase)
+		}
+		for _, w := range parts {
+			if !words[w] {
+				t.Fatalf("%q: unexpected word %q", passphrase, w)
+			}
+		}
+		if err := defaultPolicy.Validate(ctx, passphrase, nil); err != nil {
+			t.Fatalf("%q: %v", passphrase, err)
+		}
+	}
+
+	// The options satisfy policies requiring character classes.
+	policy := security.NewPasswordPolicy(
+		security.WithMinLength(16),
+		security.WithRequiredClasses(security.UppercaseClass, security.LowercaseClass,
+			security.DigitClass, security.SymbolClass),
+	)
+	g := &security.PassphraseGenerator{Capitalize: true, AppendDigit: true}
+	re := regexp.MustCompile(`^([A-Z][a-z-]+-){5}[A-Z][a-z-]+[0-9]$`)
+	for i := 0; i < 100; i++ {
+		passphrase, bits, err := g.Generate(6, "-")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !re.MatchString(passphrase) {
+			t.Fatalf("unexpected passphrase %q", passphrase)
+		}
+		if err := policy.Validate(ctx, passphrase, nil); err != nil {
+			t.Fatalf("%q: %v", passphrase, err)
+		}
+		if expected := 6*math.Log2(float64(len(words))) + math.Log2(10); math.Abs(
+			bits-expected) > 1e-9 {
+			t.Fatalf("expected %g bits, got %g", expected, bits)
+		}
+	}
+
+	// Each word has about 12.9 bits of entropy, four words at least 51.
+	if bits := (&security.PassphraseGenerator{}).EntropyBits(4); bits < 51 {
+		t.Errorf("expected at least 51 bits, got %g", bits)
+	}
+}
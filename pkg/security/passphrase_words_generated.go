@@ -0,0 +1,1312 @@
+// Code generated by gen_passphrase_words.go; DO NOT EDIT.
+// GENERATED FILE DO NOT EDIT
+
+package security
+
+// passphraseWordCount is the number of words of passphraseWordsGzip.
+const passphraseWordCount = 7776
+
+// passphraseWordsGzip is the gzipped, newline-separated list of the words of
+// passphrases from passphrase_words.txt.
+const passphraseWordsGzip = "" +
+	"\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x9d\x69\x92\xfb\x2c\xaf\xc5\xbf\x7b" +
+	"\x2f\x77\x51\xc4\x26\x36\xff\x60\xf0\xc3\x90\x74\x7a\xf5\xb7\x7e\x47\x72\xbf\x55" +
+	"\xdd\xd1\x31\xc6\xcc\x83\x10\x42\x84\x47\x58\x67\x5f\xc2\x63\xab\x67\x2c\x46\x53" +
+	"\x09\x79\x09\x8f\xb4\x45\xfd\xa6\xb2\x43\x73\x1a\xdf\x25\x3c\x72\xf8\xc5\x39\xf3" +
+	"\x53\x6a\x3b\xe5\xb5\x85\x9e\x6a\x71\xf0\xe6\x55\x8b\xa1\x0f\x68\xda\x76\x3d\xd7" +
+	"\xb0\x41\xe6\x35\x32\xc1\xf4\x58\x56\xdc\x7b\x2c\x23\xde\xc0\xde\xa4\x32\x0e\xb9" +
+	"\xd4\x3c\x87\x03\x85\xd9\x47\x48\x44\xd2\x47\x0b\x2b\x81\xf7\xd9\xb6\x25\xac\x6b" +
+	"\x2c\x03\x92\x43\x3a\x45\xd3\x19\xf8\x70\x5d\xeb\x79\x85\xf2\x15\x9a\xe6\x67\xb6" +
+	"\xb0\x7e\x1d\x98\x9f\xd9\x47\xe5\xb3\x38\x6a\xc1\xe1\x48\x25\xf6\x6e\x60\x5f\xc2" +
+	"\x9a\x88\xa2\xb6\xb2\x84\xf5\xbf\x19\x92\x82\xf9\x6f\xa6\x86\x5f\xfb\xa9\x8f\x80" +
+	"\x63\xab\xe5\x4b\x40\xc3\xbe\x1b\x2a\x91\x75\xa4\xb7\x25\x46\xa0\x36\x79\x78\xbb" +
+	"\x43\xea\xe7\x0d\x86\x03\x0a\x79\x1d\xcd\x92\x30\xf8\x99\x23\x52\x30\xeb\x1c\xd1" +
+	"\x52\x16\x5b\xb0\xc0\x63\xab\x8f\xb4\x76\x81\x5e\xb3\xd1\x2b\x50\xb0\xcf\xd0\x96" +
+	"\xf0\x7c\x86\x24\x12\xd7\x11\x37\x07\x4a\x9d\x21\xc2\x78\x3e\xd3\x16\xde\x69\x08" +
+	"\xe5\xa4\xb4\x3e\x9f\xa9\x9d\x72\xf8\xe1\x37\xa7\xfb\xf3\x3c\x55\xd4\xcf\x67\xa5" +
+	"\xe0\x9f\xcf\x56\xf5\x98\xc3\xc9\x57\xb9\x52\x0e\xcf\x3c\xc7\x88\x44\x5b\x2b\x8f" +
+	"\x2d\x50\x82\xcf\x11\xdb\x9e\xeb\xc7\x50\x4e\xcf\x68\xe8\x0c\xe3\x70\x54\x69\x30" +
+	"\xa0\x52\x49\xd8\x4e\x94\x7b\xcc\xca\xf2\x1e\x0b\xd5\xb6\xc7\xb2\x05\x91\xb1\x84" +
+	"\x7d\x6f\x71\x57\x7a\xf7\x43\x8d\x6d\x4f\xb4\xca\xdd\x5b\xea\xae\x8c\xee\xa5\xf6" +
+	"\x91\xd6\x25\xec\xb5\x24\x5a\xae\xa8\xbd\xaa\x34\x8d\xbd\xc5\x68\xcd\xd9\x91\xbb" +
+	"\x11\x79\x8b\xd1\x7c\xb6\x18\x4f\xe5\x7c\x6f\x75\x96\x6d\x09\x47\xa4\x39\x1f\xf5" +
+	"\xbb\x04\x75\x93\xb4\xf5\x45\xad\xef\x1f\xe5\x9e\xc3\x23\x74\x95\x41\x0e\x14\x64" +
+	"\x7e\x84\xd1\x2a\xf9\xc8\x8f\xc9\xf3\x93\xbf\x25\xe4\x3d\x3e\x9a\x68\x6d\x69\x1c" +
+	"\xbc\x49\x01\x5f\xe9\x91\xf8\x8d\xc5\x52\x26\x34\x1c\xe8\xfd\x4b\x0f\x34\xa3\xfc" +
+	"\x0a\x39\x95\x1b\x90\xc3\x7c\x86\x12\x56\x68\xda\x0f\x9a\x53\xb6\xa2\xcd\x95\x97" +
+	"\xf5\x29\x78\x10\xad\x1a\x7c\xae\x65\xef\xca\x44\xae\xf5\xb9\x84\x7c\x1d\xe1\x11" +
+	"\xf1\xd4\xf8\x7e\x09\x79\x1c\x75\xee\x07\x20\x8d\x29\x8f\xa3\x2e\x21\xcf\x33\x15" +
+	"\xe5\x66\x9e\x85\xe4\x7e\xc2\xb7\x2f\xe1\x0c\x2d\x0e\xde\x9f\x1a\x28\xce\xf0\x9b" +
+	"\xca\x4e\xfb\x3d\x1f\x14\xc8\xf9\x48\x41\x3d\xff\x7c\xa4\x7d\xaa\xa2\x84\x2a\x83" +
+	"\xd0\xf9\x48\xd6\x28\x0d\x98\xd3\xcc\xf7\x07\xb3\x1f\x4b\x38\x69\x02\x2a\x15\x90" +
+	"\xd5\x0a\x08\xbf\xb1\x58\x80\xc9\x3d\xa4\xd5\xea\xf3\xa4\x05\x9e\x69\x27\x55\xa9" +
+	"\xe8\x97\xca\x38\xcf\x5a\x52\x70\x4a\x4e\xce\x12\xbb\x02\x28\xa9\xaa\xd1\x9c\x95" +
+	"\xea\x3f\x6d\xf0\x38\xaf\xd8\x02\x83\xd9\x79\x29\xf4\x2b\xa7\x67\x52\x9e\x40\x7c" +
+	"\x76\x29\xb2\xb9\xbe\xf8\xcd\x94\xe1\x39\xbb\xa7\x65\x76\xda\xf3\x39\xbb\xb7\x24" +
+	"\x10\xdf\xce\xae\x26\x56\xc2\x5a\xd5\xb6\x8b\xf7\xea\x25\x94\xb0\xb7\x70\x42\x47" +
+	"\x3d\x13\x35\x28\xf4\x5d\x42\x59\x0f\x06\x12\xc8\x5b\x8f\x49\x41\x96\xad\x55\x72" +
+	"\x5a\xe2\x49\xb6\x20\x2b\x64\x36\x8d\x33\x25\x7e\x96\x50\xf6\x98\x9f\x89\x82\x04" +
+	"\x91\xc7\xb2\x93\x87\xb2\x67\xd2\x07\xf1\xa7\x2e\x62\x89\xdb\x5b\x22\x6b\x65\x6f" +
+	"\x3e\x38\x96\x7d\xa6\x7e\xd8\x17\x33\xd3\xe6\x4b\xd2\x54\x50\x7c\xec\x15\xb5\x8f" +
+	"\x85\xa8\x55\xb9\x29\xe1\x89\xf1\xa2\xa4\xb3\x76\x55\x58\x79\x51\x44\xa5\xc4\x1f" +
+	"\x7e\xeb\x50\xa7\x2e\xa5\xce\xb2\x2a\x6d\xa5\x7e\x2d\xa8\x32\x43\x56\x42\x8a\x35" +
+	"\x9d\x52\x53\x51\x3f\x2b\x75\x1c\xa2\xfd\x13\x9b\xf9\x1d\x36\x74\x97\x11\xda\xaa" +
+	"\xca\x2c\x23\x06\xf3\x3c\x62\xae\x17\x51\x8c\x58\x4a\x10\x38\x22\x25\x34\x8e\x94" +
+	"\xc9\xc4\x38\x6a\xae\x3b\x11\x8d\xf4\xa8\x9b\x01\x06\xda\x32\xd2\x56\x95\xba\x91" +
+	"\x8e\xd8\xaa\xc0\x7f\x53\x03\xb4\xa1\xee\xc0\xd2\x37\x52\x9b\xaa\xb9\x91\x46\xfd" +
+	"\xb1\x54\xa4\xf1\xe7\xf6\x4e\x4d\x85\xc6\xc0\xdf\x68\xee\x65\x58\xf9\x0f\x9b\x4b" +
+	"\xca\xe8\x84\xf2\x4e\x78\xfa\x7a\x4a\xbe\x07\xc3\x68\xf9\x9e\x95\xe9\xa7\x7c\xd5" +
+	"\x8b\xcb\xf7\xca\x1a\xf8\xcb\x77\x1c\x56\x00\xdf\x61\xc5\xfc\xfd\x04\x02\xf9\x7e" +
+	"\x8e\xc8\x07\xb5\x8d\xb0\x84\x2b\xac\x4c\xb2\x57\xed\x83\xb2\xbf\xae\xc8\x30\xb2" +
+	"\x1b\x6a\x46\xba\xbf\xe8\xf7\x8b\xb2\xa9\xfd\x0b\xa5\x1f\x39\x8d\x44\x69\x08\xfc" +
+	"\x92\xf2\xeb\xca\x61\x6e\x4e\x2d\x00\x0b\x3f\x7b\xbf\x07\xad\x81\xe6\x0a\xa2\x05" +
+	"\x5d\xea\x36\xd7\xa5\xba\x94\x8f\x16\x52\xa7\x5c\x0c\x59\xb0\x2d\x1e\x91\x91\xf7" +
+	"\xba\x5a\x0d\xeb\x61\xe0\xed\xbe\x2a\x23\xe1\xd5\xd2\xca\x8c\x73\x35\x8a\xeb\x6a" +
+	"\x34\xb9\xeb\x1e\xb2\x8c\xed\xf8\x6f\x06\x7e\xe2\x36\xe1\x1f\xda\x23\x8d\x16\xda" +
+	"\xf7\x46\xe4\xa4\x6d\xce\x87\xb4\x18\x96\xd0\x62\xe1\x77\x9f\xd6\x89\x0d\x7c\x05" +
+	"\xf0\x9b\xc8\x60\x3b\xc3\x96\x72\xae\xa0\x47\x20\x89\xed\x5c\x0f\x4d\xbc\xed\x24" +
+	"\x7f\xed\x7c\xce\x0c\x39\xaa\x02\x39\x55\x9c\xed\xb4\x99\xad\x9d\x55\xbc\x44\xa3" +
+	"\x3a\xe5\xbb\x2a\x45\x67\x8b\x34\x92\x76\xf2\x50\x4f\x92\x51\x15\x9d\x4d\x40\xad" +
+	"\x4e\x15\x51\x6b\x74\xe9\x25\xb4\x46\x25\x37\xff\xa8\x25\x95\x4c\x6b\x89\x82\x69" +
+	"\xad\xee\x56\xf8\x86\x08\xb6\x53\x3a\x6d\x2c\xa1\xaf\x2a\x56\x88\xf1\x70\x20\x73" +
+	"\x6f\xc6\x6f\xf5\x23\x28\x1f\xfd\x80\x41\xec\x47\xec\xfc\x7e\x97\x60\xb3\x46\x7f" +
+	"\x31\xae\xf4\x1c\xe3\xb5\x84\x7e\x85\x16\x76\x5a\x72\xbf\x22\x65\xdc\xaf\x64\x05" +
+	"\x0b\x70\xa2\x40\x47\x2d\x1a\x86\xfa\xb0\x0c\xf5\xd1\x2c\xb8\xd1\xbc\xef\xf5\xd1" +
+	"\x6a\x09\x73\x38\xaa\x94\x44\x1f\x62\x09\x47\xa6\x6b\xad\x4b\x18\x99\xf9\x52\x0c" +
+	"\xdc\xa8\x3e\x5b\x8e\x7a\x2d\x61\x34\x8d\xe8\xa3\xd5\xd5\x26\x92\xd1\xea\x75\x7c" +
+	"\x97\x30\x86\x5a\xcf\xb0\xbc\x8d\x11\xcf\x6b\x88\x96\x4d\x25\x23\x04\x47\x0a\xb0" +
+	"\xd9\xc8\x10\x25\x39\x86\xca\x77\x58\xdc\x43\x39\x1a\x77\x13\x1b\x62\x4b\x19\xdf" +
+	"\xc6\x68\xe9\x61\x09\xfd\x5e\x69\xa5\x2a\xa6\xb3\x5b\x73\x0b\x9e\x20\x21\x06\x89" +
+	"\xb9\x25\xa5\x5b\xd4\x9e\x8d\x39\x9e\x5b\xaa\xfa\xf5\x4f\x77\x66\x0e\x6a\x62\xee" +
+	"\x1a\x40\xe6\x38\x48\xd8\xba\x84\x39\x34\x21\xcc\xa1\x81\x1e\xe2\xce\x75\x6f\xe1" +
+	"\x3a\xf0\x50\xcf\xf0\xa2\x27\x09\x89\x9d\x33\xe4\xfe\xae\x94\xe9\x3b\xef\x90\xb2" +
+	"\x95\xe2\x3b\x30\xef\x32\x46\xbc\xc3\x60\x80\x7f\x47\x35\x34\x88\x1a\xf0\x3b\x96" +
+	"\xa9\x67\x9b\x14\xdf\xb1\x59\xfb\x79\x47\x9a\xd5\x3b\xf9\xa0\x0f\x20\x6d\x6f\x06" +
+	"\xe3\xb7\xe6\xa7\x4f\x80\xdd\xfc\x84\x17\xed\xe9\x13\x60\x25\x3f\x81\xa2\xfc\x1c" +
+	"\xe2\xe0\x3e\x2f\x77\x2c\x8a\xe8\x53\xe1\x76\x3e\xf4\x8a\x9f\xd4\x97\x47\x78\x90" +
+	"\x40\x11\xde\x3f\xc2\x23\xc5\x0d\x67\xd8\xc6\x47\x58\x5f\x1a\xda\x00\x8f\x4a\x38" +
+	"\x86\x4a\x34\xb4\xb5\x7a\x09\xf8\x73\x6c\x22\xcf\x14\xf3\xe6\xa8\x11\xfa\xfa\x3a" +
+	"\xe8\xcc\x00\x8b\x65\x7d\xe5\x00\x9b\x61\xa8\x1f\xf2\xa3\x3e\x2c\x20\x5e\xc9\x90" +
+	"\xd3\x6a\x5f\x5d\x61\x7d\x19\x88\x5b\xc8\x42\xea\xa5\x02\xb5\x9e\x72\xe9\x47\x7a" +
+	"\xda\x67\xea\x54\x02\x39\x59\xf4\xc6\xd1\x1b\x4a\x96\xc3\x3e\xc2\xe3\x06\xbb\xa5" +
+	"\x76\x84\x6c\xd1\xd0\x0a\x0d\x4d\xcb\xe8\xe7\x2e\x84\xcf\x9d\xea\x8f\xe6\x41\xd0" +
+	"\xd7\xdf\x59\xc9\x8d\xd8\x52\xb8\xc1\x24\x65\x5b\x50\xfe\x58\xc3\x3d\xc2\x46\x01" +
+	"\xf0\x94\xbf\xfc\x8a\x13\x78\xb0\x3e\xe0\x25\xcb\x04\x95\xd3\x1e\xc9\xe4\xce\x98" +
+	"\xf7\x08\xfb\x4e\xe3\x80\xaa\xb8\xf7\x3d\xd9\x53\xf2\x8f\x41\xfa\x68\x27\xc8\xfd" +
+	"\x4a\x97\xde\xcf\x38\x06\xe0\xa5\xaf\x5e\xb1\xf1\xf6\x15\xfb\xa1\xba\xf3\x0a\xa1" +
+	"\x7d\x46\xa7\xee\xb2\xc2\xd2\x3f\x42\x3e\xf5\xdb\x03\x0c\xd0\x23\x9c\x8f\x5a\x97" +
+	"\x47\x28\xa1\x90\x39\x0d\x3b\x22\x56\x08\xe5\x9f\x5e\xbe\xd4\xec\x01\x8f\x5a\x5f" +
+	"\x02\x8a\xbc\xd0\x6b\x78\xb2\x28\xca\xab\x30\xff\x03\x5a\xcd\x64\xb1\x14\xf3\xd0" +
+	"\x8f\x28\x77\x0b\xb5\x3d\xe2\x3a\x79\x6e\x0f\x05\xd3\x1e\x51\xbe\xdb\x43\xbe\xdb" +
+	"\x5a\x55\xcd\x4d\xa5\xd3\xac\x97\x3e\x98\x51\x06\x69\x6c\x49\x8b\xd1\x47\x68\x39" +
+	"\x92\x95\x76\xb2\x88\x12\xa5\xa2\x9a\x7e\xea\x19\x3d\x2e\xaf\xf1\xd6\xc2\x3a\x37" +
+	"\x7d\xdf\x54\x09\xad\x79\x39\xb6\x96\xd6\xb0\x39\xb2\x6f\xfa\xa8\x55\x7e\x34\xde" +
+	"\xc9\x69\xc4\xa6\x77\x2a\x9f\xce\xb8\xa5\x8a\xee\x2c\x31\x1f\xa1\x27\xbc\x77\xb5" +
+	"\xc0\xae\x7e\xd8\x5f\x91\x36\x3b\x1e\x15\x6f\x63\xe5\xb3\xa1\x1f\x35\xa7\x81\x9f" +
+	"\x31\x42\x66\x1c\x00\x45\x26\x38\x03\x56\x98\x20\x7d\x3a\xee\x67\x55\xc1\xb4\x9a" +
+	"\xf8\xad\xf5\x15\x96\x47\x0e\x0f\x95\x59\x0e\xdb\xe6\x34\xf2\x7b\xf0\x73\x0a\x6a" +
+	"\x46\x7d\xd0\x0e\x8e\x1b\x79\xeb\xcc\xa1\xbc\x78\xee\xd7\x11\xcd\xaf\xd0\x57\x68" +
+	"\xf0\x3b\x02\x2b\x4b\x03\x4c\xfc\x12\x70\x28\x1a\x16\x2e\xcb\x23\x47\xe6\x0b\x08" +
+	"\x01\x31\xc9\x3d\x72\x3c\xd5\x86\x32\x33\xe7\xc3\x86\x00\xf5\x7f\xb2\x97\xd3\x89" +
+	"\x17\xfb\x36\x59\x23\x12\x6d\x46\xff\x5e\x90\xba\x24\xaf\xbd\xab\xb3\xe4\x34\x7e" +
+	"\x79\xfc\xfd\x55\xa7\x64\x35\x1d\x9d\xda\x47\xf5\xc1\x93\x50\x3d\x63\x23\x80\x5a" +
+	"\x3d\xef\xb5\x5e\x2a\x9c\x4a\xa6\xea\xec\xe4\x75\x7a\xc1\xcd\xe7\x93\x5f\x4b\xf3" +
+	"\xa4\xb6\xcd\xb9\xe0\x77\x36\x42\x9d\x4d\x75\x93\x67\xa3\xab\xe5\xd9\xf4\xca\x3e" +
+	"\x60\x25\xfb\x5d\x1e\xd5\x96\xb4\xa2\x4a\x2f\x40\x91\xb3\xec\x7f\xd4\x87\x1a\x7a" +
+	"\x7d\x3c\xcc\xcd\x06\xe9\xfa\x58\xed\x65\x67\x79\xf1\xa8\x8f\x11\x68\x46\xf5\x9e" +
+	"\x05\xc5\xd2\x3e\xaa\x0d\x0f\x75\xdf\xf5\x0d\xfc\xc3\xa3\xca\xdf\x6b\x79\xd4\xec" +
+	"\xf1\x66\x02\x2a\xa1\xfc\x06\xe8\xa6\x2f\xca\x66\xb1\x95\xcd\xaa\xc1\x86\xf9\x5a" +
+	"\xa2\x16\xe8\x8c\xfa\x7f\xee\x39\xbd\x08\xbc\xd0\xa1\x6a\xb1\x51\xbe\x96\x42\x13" +
+	"\xae\xa5\x87\x04\x51\xc4\x0c\x21\xb5\xee\xf1\x7b\x86\x02\x22\x0d\xb5\x6c\x75\x7d" +
+	"\xf1\xb6\x32\x77\x42\x0e\x3d\x30\x9e\x41\x94\x8a\x3a\xc4\x83\xe3\x9e\xa3\x39\xe8" +
+	"\x93\x5f\x42\x6c\xe1\x87\x5f\x4b\x6f\xd3\x8a\xfa\x51\x5b\xab\x1f\x65\x0e\x60\xaf" +
+	"\x94\xdc\x11\x0a\xfd\xcf\x11\xb3\x05\x80\x60\xd4\xcd\x2c\xf6\x01\xeb\x2e\x62\x5f" +
+	"\x0e\xf8\xa0\x87\x56\x4d\x46\xcc\x79\xaa\x81\xc3\x65\xdd\xcf\x77\x61\xcd\x32\x92" +
+	"\x55\xe5\x1b\x11\xc2\xa3\xfe\xac\x81\xc4\xfc\x28\x49\x3f\xe6\xfd\xc7\x0b\xee\xe7" +
+	"\xbb\x3c\x9a\x75\x86\x16\x91\xda\x3c\x5a\x8c\xeb\x11\xbb\x03\xf9\x46\x70\xc2\xc7" +
+	"\xd0\xdb\xe1\x37\xea\x99\x42\x68\x71\x1c\x2d\x16\xc0\x47\x8d\xaa\xc5\x8f\x79\x4b" +
+	"\x44\xdc\xd2\x03\xbf\x89\xd1\x4c\xdc\xa0\x89\x0f\x37\x9e\x76\x8d\x5f\x26\x92\x78" +
+	"\xb4\x94\x59\x4f\x08\x9d\xfc\x58\x18\xf4\xf5\x96\x6c\x58\x82\xd2\x9d\xa1\x36\x16" +
+	"\x30\xb8\x52\x60\x2d\x59\xc1\xb5\x1a\x36\x31\xec\x42\x2b\x22\x24\x21\xa5\xaf\xda" +
+	"\x2c\xc7\xb3\x7f\x5d\xc3\x66\x73\x33\x6e\x92\x75\x3c\x5a\x4d\x2c\xd7\x44\x2d\x1b" +
+	"\x15\x9e\x46\x44\xce\x0c\x48\xd4\x62\xab\x65\xad\x22\x2a\x8c\x5a\x7e\xdd\x3b\x33" +
+	"\x8d\x31\x01\x6a\x11\x4a\xc1\xe7\x11\xe9\x33\xad\x7e\x4a\x81\xe5\xc7\xe9\x26\xf6" +
+	"\xd9\x4c\x37\x28\xaa\x8e\x59\x6c\x9c\x6f\xc8\x29\x1e\x0d\x39\x09\xbf\x3d\x66\x2a" +
+	"\x07\x46\x94\xdf\xd4\x0f\xb2\x34\xad\x67\xce\x9b\x7d\x02\xe0\xbc\xae\xa1\x44\x72" +
+	"\x33\x57\x0d\x5a\x90\xa1\x27\x79\x5f\x5f\xfd\x60\x70\x99\xeb\xab\xbf\x98\x04\xe6" +
+	"\xfa\x1a\xd6\x0d\xe6\xfa\xfa\x1c\x4a\xf3\xdc\xb6\x03\x16\xd4\x81\xb9\x58\x2c\x1b" +
+	"\x1d\x7d\x6e\x3b\x75\x33\x9f\xcf\x90\xab\xa8\x62\x7a\x3e\x15\xef\xf3\x69\x5e\x9f" +
+	"\x4f\x71\x72\x53\x4c\xc1\xcc\x0f\x7e\x98\x27\x67\xbe\xf9\x86\x99\xf7\xc9\x17\xb0" +
+	"\x3c\x33\xe7\x0d\x56\x4b\x94\x35\xe8\x63\xe6\xfc\xb4\x76\x02\x6a\xfe\xf2\x40\x86" +
+	"\xcb\x3b\x4d\x47\x50\x8a\x69\xe6\x7c\x51\x65\x33\x67\x6b\x45\x33\xe7\x1e\xbf\x8a" +
+	"\x2d\x7f\x0e\x06\xe8\x89\xc8\xe1\x61\x45\xad\xbe\xb3\x3c\x66\xd9\x99\xec\x67\xb1" +
+	"\xb0\xca\x4b\x63\xdf\x2c\xaf\xc3\x86\xde\x59\x5e\x12\x83\x3c\x66\x61\x34\x51\xb5" +
+	"\xcc\xae\x79\x52\x95\x33\x3b\xf2\x94\xc7\xec\x19\xb1\xf8\x03\x6e\xfe\x31\xbb\x2d" +
+	"\xf0\x1f\xf3\xf7\x77\x59\x03\xfc\x0a\xe4\x01\x03\x05\x4d\x22\x1b\x4b\xba\x06\xca" +
+	"\x7a\xae\xb4\x90\x55\x1c\xef\x0a\xf3\xa9\x87\x91\xf4\x3b\xfb\xb2\x86\x6d\xd3\x87" +
+	"\x14\x3f\x2c\xc0\x00\xa7\x9c\xc3\x0a\x38\x59\x24\xad\x16\xc3\xa1\xd1\x6a\x0d\x2f" +
+	"\x1e\x32\x72\x3c\x42\xc9\xe1\x44\x92\xb1\x86\xbc\x9a\xdf\xbc\xce\x4c\xd6\x0c\x29" +
+	"\x8a\x9c\x98\x67\xd6\x90\xd3\xa3\xf9\x2b\xf9\xac\x2d\xad\x4e\xe5\xff\x17\x9e\x66" +
+	"\x0d\xe7\x5a\x1b\x33\xf9\x1a\xce\x58\xf5\xdb\x02\x24\x75\xd6\xc7\xab\xe4\x6c\x3c" +
+	"\x5f\x1a\xa3\x01\x54\x0c\xb4\x23\x6c\x00\x28\x5a\x76\x29\xd6\x50\x58\xc0\xaf\xb0" +
+	"\x82\x7a\xda\x58\x0b\x40\x15\x54\x51\xae\x15\x6b\x49\x4e\x34\x9b\xac\xa1\x94\xf0" +
+	"\x48\x0a\x86\x29\x03\x62\xb1\x94\x52\x8b\x5c\x2b\x45\x55\x6a\x26\x6d\xee\x56\xaf" +
+	"\x08\xb3\x27\xa4\x80\x47\x8c\x7a\xf1\xd5\xfb\xcb\x2b\x05\xfa\x15\x5d\xad\xec\x2e" +
+	"\x62\xbe\x28\x75\xa5\xf5\x4a\x43\x49\xbf\xd2\xa8\xa2\x97\x52\x20\xf9\x45\x53\x40" +
+	"\x1d\x51\x35\x74\x5a\x80\x5a\x4a\x89\xbe\xad\x80\x41\x37\xf5\x38\xc6\x54\x69\xb5" +
+	"\x70\x46\x02\x6d\x81\xf4\xb7\xf0\x0e\x84\xd8\x1e\x0a\xa0\x6d\xb6\x24\x5a\x43\x63" +
+	"\xfe\x84\x24\xb5\x84\xb6\xa5\xdd\x3c\x6e\xda\xfd\xe1\x4d\x1f\x75\x7d\xe1\x14\x9f" +
+	"\xea\x02\xa0\xdd\x5b\x5f\x33\xb9\x3c\x4e\x4e\x86\x96\x97\x6b\x68\x3b\x75\xaa\xbe" +
+	"\xb4\xc2\xbe\xda\x6b\xb5\xf3\x15\xfe\xd5\x7d\x15\x6b\x74\xad\x04\xcf\x5b\x2b\x92" +
+	"\x59\x18\x40\xb2\xb5\x86\xa6\xd2\x69\x17\xab\xde\x76\x23\x95\x60\xbb\xaa\xbf\xab" +
+	"\x4d\xd9\x6c\x56\xed\xad\xa9\xd6\x1a\xd2\x11\x2b\x05\xf3\x3e\xec\x61\xdc\xc9\x18" +
+	"\x56\x1a\xa3\x3a\xd5\x34\x23\x97\xcf\x11\xcd\xef\x5b\x29\x78\x7b\x3e\xb4\x74\x5a" +
+	"\x43\xa7\x0b\x2d\x6b\x50\x9f\x33\x17\xf3\xd0\x11\x2b\xaf\xa1\x33\x64\xae\xa1\x77" +
+	"\x8d\xc7\x6b\xe8\x26\xaf\x34\xa0\x6a\x1a\x61\xad\xe7\x43\x00\x56\x4e\xf4\xdb\x87" +
+	"\x03\xd5\xfa\x08\xd7\xcc\xe6\x82\x40\x41\x00\x69\x09\xf5\x34\x60\xca\x45\x0f\x6f" +
+	"\x6c\x63\x45\xf0\x29\x17\x4b\xca\x58\x0f\x45\x24\x2e\x4f\xd4\xdd\x6d\x4c\x14\x50" +
+	"\xd2\xc7\x11\x37\xc4\x8f\x6b\x40\xc0\xaf\x2c\x0d\xcd\xf5\x6b\x18\x25\x5c\x3c\x96" +
+	"\x24\xd2\xa7\x88\x78\x37\xa8\xcd\x24\x20\x4b\x82\xe5\xec\x13\x32\xcd\x65\x52\x1a" +
+	"\x6a\x4b\x93\x9d\xc5\x35\x48\x50\x25\xe2\x55\xcd\x10\xc9\xb3\xa5\x6b\x92\x42\x65" +
+	"\x7c\xde\xef\x87\x24\x24\x6b\x78\xb3\x07\x42\xe6\xde\x21\xab\x22\xdf\xb0\x09\x2b" +
+	"\xdb\x55\xdf\x65\x8d\x1b\x0f\x31\xc3\x48\xac\xb4\xc7\x91\x88\x28\xe6\xf4\x60\x8f" +
+	"\xcf\x00\xd5\x10\x33\xd2\x90\xd5\x04\xef\xc8\xb9\x08\x3c\x36\x56\x87\x02\xf1\xac" +
+	"\x05\xff\x92\x77\xe5\x3f\x34\x0c\x21\xe4\xdf\x1c\xe1\xd2\x43\x8b\x64\x2f\xf6\x6e" +
+	"\xad\xf0\x08\xcf\xa8\x5f\x4d\x5f\x88\xfe\x0a\x8f\xa9\xf1\x9b\xd3\xaa\x97\x39\x4b" +
+	"\x7c\xb2\x1e\xb6\x0f\x02\xad\x27\xd2\x0e\xd0\xa5\x7c\x1f\x0c\x62\xf8\x75\x8f\xa5" +
+	"\xd0\x12\x0f\xd8\x9c\xf5\x08\xb5\x83\xaf\xd8\x34\x88\x1e\xe1\xca\x1e\x8f\x8d\x1e" +
+	"\x47\xb8\xcc\x83\xba\xca\xa1\x96\xe3\x48\xec\x89\xd0\x5a\x29\xa0\x23\xb4\xdd\x5f" +
+	"\xed\x9e\xe2\x96\xe8\x37\x50\x32\x7d\xb0\x69\xc5\x6b\xd6\x04\xd0\x3b\xa4\xe1\xde" +
+	"\xa9\xbf\xc3\x9b\xfe\xc1\xb2\x40\x8f\x23\xb9\xfb\xf0\x50\x86\x38\x1b\x80\x07\x30" +
+	"\xee\x00\x86\x3c\xc4\xe0\xcf\x71\x53\x55\x1e\x31\xbe\x70\x96\xe7\x18\x15\x5a\x8c" +
+	"\x5d\x5e\x9f\xfc\x9c\xf0\xc4\x64\x33\x6a\x3f\x04\x5a\x79\x6a\xec\x45\xe8\xa3\x36" +
+	"\x1f\x10\x06\x9d\x03\x21\xcb\x7a\xc4\x37\x82\x60\xa8\xc2\xf9\x58\xb7\x39\x22\x6c" +
+	"\x37\xcf\x9e\x82\x0f\x6f\x93\xa2\x49\xc7\x0c\xc7\x0c\xa0\xbc\x31\xc2\x19\x3a\x6a" +
+	"\xdd\x0c\xb1\x90\x12\xb0\xd1\x4d\x48\xfd\xe6\x48\x39\xe9\x99\x52\x66\x1d\xb8\xc2" +
+	"\x40\xac\x47\x6a\x97\x45\x93\xda\xa5\x78\x06\x85\x01\xf0\x86\x7d\x24\x86\x9b\x83" +
+	"\x99\x72\xbb\x81\xaa\xb9\x5a\xf3\xa9\xaf\x78\xd4\x4c\xf4\x55\xc2\x8f\xf5\xa8\x0a" +
+	"\x9d\xd9\x9f\x6c\xd4\xea\x95\x51\xab\x8a\xab\xea\x65\x67\x72\x3a\xea\x47\x93\xed" +
+	"\x51\x3f\xda\x0f\x58\x8f\x56\x45\xe6\xe3\x81\x57\x76\xab\xd6\x63\x92\xba\x79\x9e" +
+	"\x72\x51\xd0\xb3\xc8\x9d\xf9\xe8\x80\x83\x5c\x93\x82\x49\x48\x5e\x5b\x5d\xd6\x04" +
+	"\x3f\xb4\xa6\x12\xcf\xc0\x43\x09\x27\xc5\x9c\xda\x4a\xf1\x42\x94\xa0\xd4\x60\x17" +
+	"\xda\x0d\x14\x4e\xd3\xb0\x90\x86\xd5\x44\x1a\x61\xa3\x9d\xa7\xe1\x33\x41\x1a\xe9" +
+	"\x97\x84\xa7\x21\xf6\x41\xbb\x25\x3c\x7d\x97\x35\xbd\xe5\xc2\x76\xc8\x9a\x19\xc9" +
+	"\xb5\xe3\xbf\xe6\x70\x22\x1d\xe2\x51\x39\xc8\xe1\xac\x4d\x4f\x97\x7e\xfb\x81\xec" +
+	"\x65\xcd\x81\x14\x65\x17\xe5\xac\xd9\xe7\x5d\xd1\x66\xd4\x5f\x34\xb6\xf3\x44\x59" +
+	"\x1e\x0a\xd0\x60\x25\xf5\x5b\x11\x21\x08\x77\x7e\x11\x61\x40\x35\xa6\xe5\xf0\x01" +
+	"\xe3\x55\x43\x44\x66\x2b\x05\x3c\x84\x35\x75\xe6\xf8\xd4\x93\x8a\x2f\xc7\xb6\x6b" +
+	"\x99\xb9\xe6\x88\x88\x86\x54\xc6\xf6\xe2\xd7\x3c\xa7\x55\xf3\x25\xfb\xbe\x7c\xc5" +
+	"\x06\x5a\x74\x4a\x51\x48\xe4\x46\x51\xfb\x83\xe7\x8c\x46\x97\xd9\x8c\x5a\xd6\x5c" +
+	"\x03\xc1\xb1\x3a\x27\x1c\xcd\xe8\x59\x43\x48\xae\x12\xaf\xae\xb9\xda\x16\x25\x40" +
+	"\x2c\x44\x66\x2f\x8d\xcc\x55\xdb\x49\x5a\x73\x9d\x94\x53\xb5\x24\x21\x55\xe0\x71" +
+	"\xda\x4a\x1f\xe0\xd3\x47\x56\xcb\xc9\xf3\x14\x77\x2b\x40\x51\xcc\xf2\x12\x81\xf1" +
+	"\x62\x40\xc9\x93\x35\xec\xea\x6a\x02\xab\x76\x73\xd6\x1a\x76\x36\x7a\xc7\xb2\x22" +
+	"\x56\x60\x98\x72\x79\xc3\x7a\x8b\x19\x04\x90\xec\xd8\x2b\x6d\x7a\xaf\x88\x1e\xd6" +
+	"\xea\xf2\xef\xb5\x3e\x02\x13\x67\x65\x85\xd3\xa0\x9f\xf8\x58\xd6\xba\xd6\xa0\xdf" +
+	"\x32\x79\xb9\x2d\x6b\x8d\x5b\x1a\xfa\x20\x36\xfa\x57\x8d\x3f\x1a\x4f\xea\xf3\x19" +
+	"\x79\x7c\xb2\x58\x56\xdc\x7b\x61\x23\xba\xdc\x88\x5e\x5a\x77\xe7\x11\x2a\xfb\x69" +
+	"\x1a\xb2\x0d\x11\xc0\x11\xa5\xff\xb2\x6a\xc4\x45\x6e\xbd\x8a\x81\x54\xdf\xad\x39" +
+	"\x76\xb5\x90\x9a\x53\x8f\x30\xd3\x35\x67\xb1\x41\xd0\xab\x3b\x20\xda\x9c\x4d\x63" +
+	"\xc3\x91\x92\x9a\x33\xab\x4f\x5c\x92\x13\xc9\xdb\xd7\x4a\x45\xaa\xc0\x00\xca\x06" +
+	"\x80\x19\x95\x57\x5f\x91\xae\x39\xb8\xaa\x74\xe8\xb0\x1a\x02\xea\xf9\x14\xfb\x54" +
+	"\x4f\x9a\x7b\x45\x58\xba\x9a\x3c\x69\xad\xa7\xf9\x3a\x3d\x83\x27\x5b\xec\x4e\x87" +
+	"\xd1\xe6\x2f\x10\x64\x1a\x55\x1f\x15\x12\xff\x06\xa2\xe4\xce\xb3\x7a\x19\x9e\xa7" +
+	"\x46\x12\x51\x8f\xe6\x0a\x9e\xd1\xf3\xf2\x19\x0b\x9d\x9d\x15\x49\x9c\xa3\xea\x6e" +
+	"\xc5\xc3\x90\x46\x8f\xde\x59\x24\x17\xa3\x47\x3d\x2f\x4d\xa6\xf5\xbc\xfc\xcb\x5a" +
+	"\x3c\xa5\x57\xed\x1e\x81\x0d\x96\x02\x5a\x48\x08\xdd\x7e\xa6\x87\x46\xd5\xcb\xc9" +
+	"\x38\x5b\x40\xba\x03\x98\x77\x02\xff\x92\xdf\x82\x72\x5f\xd6\xa0\x5a\x2f\x6b\x54" +
+	"\x39\x97\x35\x8a\xbf\xae\x65\x8d\x17\x31\xb0\x91\x5d\x6e\x97\x66\x2e\x87\x7e\x53" +
+	"\x44\x06\x2c\xa4\x16\x50\xd6\x3c\x3d\xcc\x16\x95\xca\xb2\x4e\x8a\xa0\x6c\xb1\x98" +
+	"\x8f\x2d\x79\x2d\x14\xdf\x1a\xe2\xa5\xfa\x74\x2d\xdb\x5c\xad\x01\x82\xac\x8d\x97" +
+	"\x6d\x26\xc5\x28\xe7\xa7\x65\xab\x3c\xe3\x60\x99\x88\x24\x4c\x1b\x5f\x38\xa5\xcd" +
+	"\xc3\x7d\xda\x60\x2f\x60\x39\x2d\xcf\xb4\x5b\x11\x95\x67\xf2\x9c\x3c\x53\xf9\x7b" +
+	"\x09\xc7\x50\xcb\x33\x27\x18\xd6\x5a\x9e\xd5\x1d\xbc\x38\x8b\xa9\x0a\xf1\x91\x14" +
+	"\x21\x0c\xdc\x5f\x4f\x6f\xc9\x65\x8f\xde\x94\xcb\x1e\xbb\xb5\x8b\xb2\xb7\xc0\xba" +
+	"\xb4\x96\xdd\x6b\xc4\x24\x63\x6b\x2d\xff\xea\x9d\x92\x7f\x9e\xb4\x7f\xb3\x59\x96" +
+	"\xcb\xdd\x7f\x4a\xb9\xfb\x4f\xe9\xce\xf9\x09\x29\x2d\xb6\xe0\xac\xa5\xd7\xec\x49" +
+	"\xe9\xb5\x78\x61\x74\x9f\x9f\x6a\xe9\xe3\xcf\xa9\x89\xe7\xc2\xa9\x79\x46\xfb\x68" +
+	"\xd3\x91\x98\xf6\x5a\xfa\x3c\xad\xec\xfa\xf4\xbe\x54\x86\xd8\xf8\x5a\xe0\x2a\xed" +
+	"\x9d\x6d\x3b\xf2\xca\xfa\x15\xd4\x13\x0c\x52\x1b\x96\x9b\xd2\xab\x5d\x47\xd1\x1f" +
+	"\xfb\xc8\xba\x6d\x19\xd5\x5a\xc6\x68\xd6\x9e\x99\x99\x55\x32\xe3\xaf\x44\xdf\xd1" +
+	"\xda\xc5\xdb\xb2\x7c\x85\x36\xb4\x84\x45\xff\x61\xad\x97\x58\x5b\x88\x9c\xb4\x07" +
+	"\x08\x55\x61\x5c\xc6\x85\x57\x9b\x1e\x2b\x9c\x4b\xd5\x7a\xa1\x32\x43\xd5\x56\x1e" +
+	"\x5a\x8b\x00\x1a\xd2\xd6\xb5\xb6\xb2\x56\x06\xdc\x56\x22\xa3\x87\xb7\xf8\x66\x11" +
+	"\xb6\x62\x7b\x6c\x42\x59\xf3\x75\x6d\xe5\x98\xdd\x02\x3b\xad\xe7\x34\x8a\x9b\x75" +
+	"\x44\x6d\xea\xe6\x6c\x01\x37\x07\x16\xcc\x75\x27\x02\xa0\x6c\x37\x77\x68\xec\x38" +
+	"\x43\xd3\xa6\x1a\x6f\xcd\xc6\x26\xa8\xd5\x44\x6b\xd5\xc7\xe7\xd6\x6d\xe4\x6d\x9d" +
+	"\x19\xbe\xb6\x11\x7f\x96\xb5\xf6\xb4\x5b\x62\xfb\x19\x87\x56\x07\xb5\xdb\xb0\xd8" +
+	"\x4f\x18\xf0\xda\x2f\xda\x88\x7c\xf0\xd9\xd0\xf6\x1b\x54\xed\x77\x6a\x26\x43\xb2" +
+	"\xbb\xd6\xa9\x21\x7f\x96\xbb\x19\xcd\x32\xb6\xfa\x91\xaf\xe2\xa3\xc7\x44\xdb\x43" +
+	"\x4d\x7a\x96\xa1\x4c\xce\xa2\x21\x74\x6a\x93\x66\xad\xef\xe8\xad\xf1\xed\x8f\xd6" +
+	"\x44\xde\xd1\x03\xf8\x4a\x1a\xb6\xd6\x5f\xcd\xb7\xf5\x37\xdd\xcf\xdf\x65\x6d\xc1" +
+	"\xe7\xe7\x16\x1e\x7b\x63\x1b\x0f\x27\x5b\xdf\xb5\xf0\x38\xc5\x94\xb4\x80\x38\x4b" +
+	"\xc4\xfd\xa2\x7f\x87\x87\xe7\x50\x98\x80\x2e\x06\x05\xf4\x51\xcd\x83\xf4\x8a\xd9" +
+	"\xbe\x85\xf2\x88\x6c\x1a\xac\x2d\xd0\xd2\x5a\xb0\x1e\x0c\x65\x8a\x6b\x01\x3e\xd2" +
+	"\x2a\xaa\x69\x90\x6c\xe1\xed\x71\x7d\xa4\x88\xb4\xb6\xf0\xc9\xec\x66\x08\xf8\xab" +
+	"\xef\xfd\x4a\x92\x95\x16\x7e\xc9\x78\x0b\xbf\x9e\xa8\x3b\xa7\x2d\xfc\x12\x73\x94" +
+	"\x82\x82\xd1\x66\xcf\x9e\x51\x29\xb1\xe0\x60\xfc\x31\x82\x6b\xab\x0f\x90\x13\xf5" +
+	"\x14\x9e\xd5\x32\x00\x1a\x4d\x5a\x64\x3f\xfe\x0f\x7c\x0d\x50\x68\xd1\x22\x63\x0a" +
+	"\x6d\x51\x43\x48\x8b\x74\xa7\xa6\xb1\xbe\x45\xd3\xa0\x58\x9b\x0f\x61\x50\x8f\xbd" +
+	"\x7b\x85\xb7\xf8\x16\x63\xdf\xe2\xe7\x76\xf8\x58\x29\xc7\x8f\xb1\x78\x2d\x3d\xf8" +
+	"\x91\x0c\x76\x6d\xea\xa6\xd6\x28\x9a\xd6\x15\x2d\x9d\x68\x70\xac\xc8\x59\x08\x27" +
+	"\xd9\xa6\x3c\x40\x32\x40\x51\x92\x9c\xba\x98\xdc\x96\xd8\x3b\x96\x87\xae\x19\x12" +
+	"\x07\x2f\xc3\xd4\xe9\xda\x0c\x21\xec\xfe\xae\x88\xc7\x15\x91\x38\xc7\x26\x8e\x51" +
+	"\x82\xea\xb5\x49\x5c\xc2\xfe\xf9\x2a\x6d\xc6\xb5\x59\x8b\x6f\x75\x4a\xa0\xd2\xea" +
+	"\xe7\x01\xc3\xdb\xea\x87\x28\xd5\xda\xdb\x5c\xad\x3d\xcc\x0d\x25\x29\x51\x8f\x77" +
+	"\x46\xc9\x46\xda\x8c\xf9\x7f\x2e\x43\x2e\x48\x48\xda\x3c\xcf\xa4\x81\x0f\x64\xce" +
+	"\x97\x4a\x63\xa2\x80\x47\x0c\xc8\x64\x95\xd4\xe9\x3b\x7f\xe6\x24\xbf\x3d\x68\x0a" +
+	"\x43\x18\xee\x35\x3e\xa5\xb0\xc6\x2b\xff\xa8\xdf\xdf\x58\x24\x3f\xcb\xda\xa4\x67" +
+	"\xb6\xb6\xef\x25\x89\x41\xfb\x32\x18\x2d\x2b\x4b\x25\x29\xef\xac\xf3\x11\x79\xb4" +
+	"\x19\x08\x2a\xc7\x75\xda\xa2\x7e\x6e\xea\x4e\x90\xef\xb2\x4e\x6d\x89\xbf\x96\x75" +
+	"\xe6\x64\x83\xd8\xcc\x67\x2a\xaa\xde\x99\x5d\x58\x38\xf3\xd5\x98\x9d\x67\xbe\x65" +
+	"\x7b\x33\x8f\xa9\x01\x4d\x00\x2f\xd7\x23\x06\x09\x73\xe6\x65\xe2\xd9\x79\xa5\x8d" +
+	"\x5f\x55\xed\xf4\x65\xcb\x6c\x1e\x62\x93\xc2\xc5\x3a\x9b\x25\x86\xb6\x3c\xdb\x33" +
+	"\x7e\x20\xee\x53\xc5\x37\x9b\xb8\xe4\xd9\xb2\xf7\x26\x21\x7b\x4f\xf2\xd5\xab\x67" +
+	"\xa3\x03\xcd\x66\xc3\xe6\x6c\x1a\xfc\xa6\x24\x25\x3c\x8a\x4f\x9b\x0d\x35\xb5\x75" +
+	"\xb6\xb7\x77\x9d\xd9\xcc\xf3\x1b\x57\x54\x85\xd6\xc9\x9a\x89\x1d\x09\xe2\xed\x43" +
+	"\x32\x48\x14\xaf\xb7\x44\xab\x17\x92\x2f\x54\x52\xda\x8d\x94\x3a\xb4\xb3\x25\x0d" +
+	"\x95\x53\x5f\x56\x98\xf8\xaf\x56\x99\x5f\xd6\x47\x7a\x50\xb6\xa0\xd4\xe4\x37\x27" +
+	"\xe3\xde\xbf\xe7\x83\x72\xfc\x8e\x7a\xe5\xd0\xcf\x3f\x34\x96\x2d\x3c\x96\x2d\x6c" +
+	"\xcb\x16\x9e\xcf\xba\xa5\xbc\x6c\x68\x28\xb4\x65\x0b\x0c\x30\x1b\x5a\xde\x7f\x40" +
+	"\xa4\xe9\xb7\xf3\x9b\xb3\x1a\xc9\x26\x69\xcd\xe6\xba\x07\x5b\x28\x5b\xd4\x46\xb7" +
+	"\x10\x21\x95\xad\xb1\xf1\x0a\xe0\x33\xa9\x59\x42\x72\xd4\x93\x8a\x7a\x0b\x2d\x6e" +
+	"\x91\xf5\x2c\xa8\x2f\x1b\x4b\x4e\xb4\x65\xb7\xd0\x5e\x91\xe9\xd3\x80\xfb\x7d\x31" +
+	"\x28\x42\x55\x5f\x00\x49\x58\xb6\xd0\xee\xd0\x0a\x41\x90\xbf\xf6\x49\x85\x9d\x98" +
+	"\x8d\x35\xeb\xc6\x92\xaa\xd1\x28\xb7\x30\xc2\xb2\x85\x11\xb5\xaf\xb9\x99\x00\x66" +
+	"\x0b\x6c\x3d\x29\x79\xec\x08\x2a\xae\xcf\x46\xe3\xd8\xc2\x87\x10\xbf\xac\xf8\x20" +
+	"\x8d\x5d\xf0\x2d\x7c\x25\x15\xd9\xc2\x77\x63\xa8\xc5\x83\xb6\xbf\x05\xaa\x3e\xff" +
+	"\x7a\xc2\xbe\x92\x39\x6c\xe1\xf7\xd7\x82\xfb\xfd\xb5\x94\x46\xd4\x5d\x21\x4f\xcf" +
+	"\x5c\x0c\x4f\xcb\x54\x0c\xf2\xe9\xea\x88\x5b\x0c\xd9\x64\xc6\xa0\xc1\xeb\xb2\x6c" +
+	"\xf1\xe1\xc3\xb8\x90\x13\x0b\xe6\x91\xf0\xf4\x68\x88\x72\xb6\xf8\xb0\xf1\x16\x50" +
+	"\x09\xe2\x31\xe5\x07\xd9\xc6\x16\x25\xcb\x85\xe0\x93\x0e\xcd\xef\x38\xb2\x12\xb6" +
+	"\x86\x2f\xbf\xcc\x1f\x9b\x80\xc2\x5d\xa3\xe4\xdf\x7a\xd6\x2c\x06\x52\xdf\x07\xb0" +
+	"\xcf\x2a\x6a\x5e\x2f\xf1\xe6\x8e\xde\xa4\x72\x4d\x8f\xa8\x68\xd2\xe6\xc9\x5f\xa5" +
+	"\x35\x2b\x2a\x65\x0e\xd0\xc5\xf0\xb4\xc5\x55\x69\xcc\xb4\x10\x01\xd6\xbb\x5b\x94" +
+	"\xde\x08\x84\x35\x8b\x39\x30\xb9\x6e\x37\x52\x36\xd7\xaa\x84\x30\xed\xc9\x4b\x8b" +
+	"\x90\x2d\x21\x0d\xbe\x81\x3c\x6e\x93\x36\x6c\xea\x90\x1b\xd3\xda\x16\xe3\xb9\x6c" +
+	"\x31\xb2\x27\x06\xa1\xc9\xc4\x78\x79\xd5\x3c\xd9\xdd\xde\xe2\x33\xac\x96\xf7\x27" +
+	"\x7a\xff\x10\x58\xdf\x2d\x3e\xe1\x29\x20\xab\xe7\x5c\x48\x39\x7f\xa2\xb5\x5d\x86" +
+	"\x23\xe5\xef\xa9\xe5\x8c\x1c\x34\xb8\x80\xc4\xc9\x19\x50\x62\x9e\xa6\x34\x6a\x40" +
+	"\x5f\xb3\xca\x13\xf1\x14\x58\xa9\xb0\x12\x51\xd6\x9e\xda\x9b\x32\xea\x69\xc8\x9e" +
+	"\xd7\xa7\xaf\xa9\x1d\x99\x5b\x55\x20\x95\x29\x1a\xd0\xd0\x5d\x85\xa2\x30\xbf\xc5" +
+	"\x27\xc3\xdb\x16\x9f\x48\x37\xb6\xf8\x04\xef\xac\xf8\x36\x07\x96\x86\xfd\x2e\xe7" +
+	"\xdd\xca\xf9\xf8\x6e\x54\xc9\xb2\x45\x16\xc4\x5b\xfc\x77\xc7\x8b\x64\x68\x8b\xd9" +
+	"\x0e\x2d\x38\x50\x32\x72\x74\x07\x4f\x33\x1a\xb2\x6a\x4b\xf9\xae\xb2\x9c\x4c\x5f" +
+	"\x82\x41\x86\xbe\xaa\xe0\x52\xfb\x73\x93\xba\x16\x4e\x6f\x89\xfd\x1d\x29\x08\xc9" +
+	"\x66\xb6\x98\xe9\xf8\x31\xcf\x5d\x31\xd9\xfa\x00\xf0\xc3\xf3\x19\x8a\x67\xe6\x8c" +
+	"\xc1\x3b\x24\x48\xa9\x3b\x91\xeb\x6e\xf1\xac\xab\xce\xce\x38\xa2\x7c\x4e\x54\xa1" +
+	"\x44\x2c\xb4\xf3\xdb\x25\x24\xdf\x38\xae\x30\xad\xe1\x96\xe4\x4d\x5d\x7c\x21\x84" +
+	"\x06\x56\xec\x4b\x6b\x01\x45\x5a\xe0\xac\x3d\xcd\xc7\x40\x18\xc3\x23\x93\xc9\x16" +
+	"\x0b\xd9\xa8\x5b\x6d\xd6\x04\x40\x4c\x08\x5b\x64\x31\xa3\x38\x00\xe6\xf7\x62\x59" +
+	"\xb6\xc5\xcb\x4b\xf4\xfa\x2b\xd2\x2b\x4b\xbd\x56\x80\xf0\xae\xda\xe4\xd1\x3a\xd1" +
+	"\x05\x6b\xba\x39\xb0\xc4\x5c\x2d\x7a\xd9\x5f\x5a\x77\x42\xad\x99\x5e\xe3\xc0\xe7" +
+	"\x1c\x9e\x8a\x29\xff\x8d\xcd\x91\x2d\x4a\x1d\x97\x90\xda\x43\xae\xc9\xc2\xed\x71" +
+	"\xf5\x36\xd1\x23\x93\xa3\x51\x2b\x68\x16\x1d\xfe\x8e\xe5\xc7\x76\x83\xe6\xc0\x7d" +
+	"\xbd\xd0\xd7\xd0\xcb\x17\x1a\xae\x50\xf1\xe3\x5b\xec\xd5\x5b\x7d\xbf\x50\x3b\xde" +
+	"\x62\xbf\xac\xca\xfa\xc5\xba\x70\x13\xff\x49\x6a\x3a\xaa\xaa\xee\xa2\x65\xeb\x16" +
+	"\xd1\x86\x55\x0a\xb5\xb9\xb3\xc5\xf1\xd7\x79\xc7\x5f\xe7\x1d\x77\x77\xb9\x35\x62" +
+	"\x71\x6a\x3a\x84\x03\x52\x65\xa1\x7d\xab\x90\x05\xcc\x77\xfd\xb1\xc6\x80\x46\x22" +
+	"\x7e\x6c\xb0\x79\x87\x3c\x45\xe9\xdb\x5f\x07\xbc\x46\x53\xd4\x5f\x78\x2c\xae\x3b" +
+	"\x8a\x93\x7d\xea\xed\xfd\x8d\x12\x8e\xba\x26\x48\x6f\xee\x26\xf8\xae\xd3\x3a\x00" +
+	"\xc0\x4a\xee\x5d\xa7\xf5\xe3\x1f\x38\xdc\x71\x23\x05\x95\x38\xea\x12\x6f\x90\x56" +
+	"\x81\x9a\x0d\x70\x76\xc8\xde\x81\x92\xa1\xc6\x84\xa7\xa6\x9c\x82\x29\xe0\x6d\x29" +
+	"\x5c\x4e\x8e\x16\x76\xbd\x86\x5f\x80\xc3\xdf\x92\x0d\x95\x69\xd5\xa1\x06\xa3\x4a" +
+	"\xa9\x10\x99\x4b\xcf\x67\x5a\x35\x80\xa6\x27\x23\xcd\x76\x83\xe6\xc0\xbc\x0b\xd1" +
+	"\x72\xd2\xbe\x6c\xe9\x1e\xde\x52\x4e\x1c\x8d\x8a\x37\x22\x94\x4c\xda\x74\x74\x0e" +
+	"\x41\xd1\xb2\xa5\x13\x56\xe0\x00\x50\x0c\xe9\x64\xb1\x24\x42\x0c\xa7\x8d\xed\x09" +
+	"\x9e\x7a\xd9\x24\x9f\x60\x28\xe0\x48\x1b\xf4\xe0\x8b\xe2\x7a\x17\xa0\xaa\x67\xb9" +
+	"\x5a\xce\x8a\x7d\x52\x57\x36\x6c\xb6\x54\x7f\x10\x53\x6e\xe9\xca\x28\xad\x6f\x49" +
+	"\x42\x76\x91\x26\x62\x1f\x35\x1f\x13\x53\xbb\xdb\x9b\x21\x65\x10\xa4\x94\x02\xd0" +
+	"\x86\xc7\xbf\x27\xa0\x8d\x3b\x29\x92\x5a\x93\x91\xae\xf3\x59\x02\x99\x13\x66\x80" +
+	"\x46\x2d\x74\xd3\x8a\x07\x48\x94\xbc\xa5\x2e\x7d\x1f\x68\xcc\xe2\x10\x52\x7f\x88" +
+	"\xc3\xdd\x52\x67\xcb\x9b\x8f\x90\xd4\x89\x6a\x9f\x4c\x28\x57\xf7\x52\xb3\x6a\xac" +
+	"\x6b\x11\xef\xe0\xfe\x5c\xe2\x71\xc0\x54\x42\x3b\xcc\x23\x34\x16\xe9\xc0\x6e\xa9" +
+	"\xbb\x08\x6d\x4b\x7d\x6f\x9a\x49\xad\x56\x7a\x2a\x4c\x94\xf8\x45\xa2\x05\x7d\xf1" +
+	"\xa3\x25\xac\x51\x2b\xb4\x9e\xab\x0d\x49\xa9\xe7\xca\x86\xb5\x9c\xbe\x6a\x8b\x2c" +
+	"\xd2\xd1\x6a\xda\x52\x3f\x99\x66\x52\x3f\x93\xa5\xe3\xbc\xd3\x5a\x1f\x51\x2f\x4c" +
+	"\xfb\x02\x00\x73\x97\xa4\xb2\x6f\xa1\x5e\xb6\xd1\x21\x84\x8c\x1e\x60\xb3\x34\xa0" +
+	"\x21\x43\x76\xa4\xba\x33\xa4\x02\xb1\xc3\x27\x06\x2c\x80\x6c\x93\x62\xea\x57\xbd" +
+	"\xbf\xf3\x52\xb4\xe3\x1a\x00\x6b\xa3\x9d\x63\x7c\x56\xf6\x6d\x5e\x34\xbb\xde\xa7" +
+	"\x78\x33\xb4\x61\xad\x71\x03\xf4\x66\xb0\x2b\x20\xc0\x49\x1d\xd1\x62\x65\x37\x34" +
+	"\xa2\x27\x3f\x1c\xca\x9b\xe6\x0d\x85\x8d\x24\x77\x41\xd3\x66\x4b\x96\x37\x4e\xa9" +
+	"\x6d\x69\xfc\x92\xde\xf7\xcd\x91\xa5\x77\xd2\xfc\x2e\x5a\x6e\xd0\xba\x01\xab\x87" +
+	"\x77\x2a\xac\x65\x05\xfc\xd9\x8a\xed\x9d\x7a\xba\x43\x01\xdd\x6e\xea\xa9\x00\xe5" +
+	"\xfb\x5d\xdb\x0a\xa3\x90\x7e\x5d\x8c\xb1\xa5\x5f\x12\x51\x2d\x05\x75\x15\x8f\xc3" +
+	"\xba\x7b\xab\xeb\x68\xe2\x70\xea\x3a\x25\xf3\xdd\xac\xde\xeb\xb6\xf3\x81\xd6\x28" +
+	"\x55\x89\x62\x75\xba\xd9\xf6\x01\xc4\x76\x64\x40\x4c\x15\x95\x85\xf6\x56\xf3\x75" +
+	"\xd0\xbc\xea\xa9\x96\x59\xcf\x68\x2d\xac\x9e\x4c\x10\x2b\x20\x49\xc8\x2e\x50\x19" +
+	"\xf9\x18\xcb\x29\x0d\xa8\xbd\xf1\xc1\xbd\x16\x3a\x82\x76\x54\xb6\x5a\x59\x68\x6e" +
+	"\xb5\x9a\x8e\x33\xe0\x89\x16\x8a\xd0\xab\xd4\x87\x00\xe2\x0d\xa3\xfa\xa4\x15\xcd" +
+	"\x37\x15\x31\x1e\x75\x52\x6b\xeb\x23\x5e\x0e\xaa\x01\x0e\x2e\x6d\xb5\xaa\x70\x98" +
+	"\xe9\x6a\x3b\xd9\xb7\xd1\xa3\x1a\x55\x95\x14\x6f\x53\xc3\xaa\x43\x6c\x51\x9d\xa6" +
+	"\xd7\xb6\xd5\x89\x52\xd4\xa6\xb6\x86\x28\x62\xab\x1c\x21\xd8\x2a\x93\x76\x63\xfd" +
+	"\xd7\x5c\xfb\x7c\x6b\x1c\x05\x7d\x52\x44\x42\xea\x95\x2d\xec\x36\x66\x20\xf2\xb5" +
+	"\x8a\x11\xda\x1d\x10\x95\xa8\x7b\x91\xe6\xfa\xd6\x82\x6d\xcc\x39\xb0\xa8\x58\x6c" +
+	"\x34\xa6\x08\x45\xd0\xfd\xfd\x67\x61\xf1\xa4\xb6\x06\x45\x0d\x53\x0e\x59\x15\xdf" +
+	"\x22\x5a\xeb\x8c\xc1\x20\xd5\x33\x40\xdb\x5f\x78\xb3\xc3\x43\xdb\x9f\xa4\x4b\xc8" +
+	"\x7d\x9b\xdf\x76\x7f\x44\xa6\xd9\xa7\x22\x53\xfe\x11\x71\x27\x36\xc9\xa0\x4a\x01" +
+	"\xa2\xa5\xad\x71\x06\x61\x43\xb7\xd2\x9e\xb2\x15\x24\xb2\x24\x2f\x01\xdf\x61\xdc" +
+	"\xda\x3d\x90\x03\x08\x3f\xbd\x6f\x1f\x6f\x56\x0e\x90\x66\x44\x75\xd8\xbc\xab\xb4" +
+	"\xc4\x42\xd0\x29\xee\xec\x5a\x6c\x0d\x35\x0a\x7e\x2f\x5c\xae\xff\x93\x9c\x14\xf4" +
+	"\xa8\x3f\x72\x79\xa1\x11\x0a\xe0\x4c\x25\xb4\x4e\xa3\x9a\x77\x6d\x38\x69\xfe\xd1" +
+	"\x47\x9b\x8f\x5b\x9b\xea\x28\x0d\xb6\x98\x12\xb0\x5d\xcb\x6d\x3e\x60\x21\xf0\x40" +
+	"\xeb\xa0\x34\xe6\xfa\x7a\x68\x28\x99\x76\xd0\x63\x43\xe1\xf1\x06\xc6\x14\xcd\xf5" +
+	"\xa5\x0f\xc6\xb2\xb1\x35\xb3\xa1\xb6\xc8\x07\xbb\x92\x31\x8f\x65\x9b\xf4\xa3\x69" +
+	"\xe5\x36\xb3\x89\xb3\xb6\xa9\x68\x4c\x7b\x6d\x43\x66\x75\x03\x6b\x58\xb3\x2e\xdb" +
+	"\xa4\xd1\xcc\x2b\xc7\x1f\x11\xe7\xf6\x85\x34\xa2\xb8\x4c\xc7\xa8\x3d\xdb\xbc\x3f" +
+	"\x6d\x6c\x73\x16\x07\x19\xfa\xa6\xa1\x6d\x9a\x42\xef\x26\x9e\x74\xbe\x29\xae\x4f" +
+	"\x68\xcf\x65\xfb\xc4\xf8\xe0\x37\x23\xfa\x31\xda\xec\x59\x21\x7c\x92\x34\xe6\x8c" +
+	"\xca\xe5\x5b\xd0\x8f\x71\xca\x90\xfb\x2d\x81\xc3\xb1\xdb\xb7\xe7\xf8\x93\xc2\x0d" +
+	"\xd6\x45\x3a\xc1\x31\xa0\xbb\x1d\xd1\x3b\x39\x44\x55\xf4\x31\xb4\x67\x0e\x17\xcf" +
+	"\x24\x2b\xa2\x08\xf6\xd0\xeb\xfc\xe5\xf7\x0c\xed\x25\x8a\xa4\x24\x86\x76\x1d\x34" +
+	"\x08\x40\x8a\xab\x81\x3c\xf7\x0e\x50\x4e\x63\x68\xd2\x42\x8d\xa1\x71\xf6\x09\xf7" +
+	"\x71\x68\x14\x73\x64\x7e\xc6\x61\xc1\x8f\xe3\xc3\x3e\x94\xde\xc9\xe1\x93\x78\xdf" +
+	"\xa3\x25\xa6\x47\xfd\x4a\xc8\x08\xd5\x47\xdd\xc6\x64\x97\x18\x63\xc5\xc0\xf8\x6e" +
+	"\x90\x36\x9e\xf1\x43\x05\x42\x74\xb4\xe6\x96\x29\x23\x51\x2e\xfa\x6d\x04\x24\x41" +
+	"\x4a\x64\xef\x2a\x3e\xc2\x77\x89\x0f\xf6\x62\x4d\xee\x12\xc5\x6b\x44\x19\x2e\x40" +
+	"\xc9\x21\xae\x47\x5d\xe2\x9a\xe1\xde\x59\xe9\xb3\x17\x1c\xd9\xb6\xdd\x59\x09\x19" +
+	"\xfa\x2e\x71\xad\x45\x9b\xb4\x0e\xec\x95\xce\xc5\xc5\xb5\x72\xae\xa1\xe9\xb3\xfe" +
+	"\xed\x23\x9e\x4b\xa4\x03\xc4\xcd\x59\x37\x81\x1d\xc7\xef\x12\x7d\xdb\xd0\x77\xc1" +
+	"\x59\xfe\x6b\x98\x37\x60\x6f\x40\xbc\xa3\x88\xcc\x58\xc0\x3b\x3a\xea\xd0\xb4\x4a" +
+	"\x25\x21\x62\x0a\x60\x2c\x71\xdf\x51\x5a\xa6\x54\x6c\x4c\x8d\xfb\x5e\xaa\xc8\xa5" +
+	"\x5d\xfd\xb8\xef\xa6\x85\x11\xf7\x7a\xb2\x45\xb0\x2e\x71\xaf\x3a\xae\x66\x94\x51" +
+	"\x31\xa6\x81\xe8\x43\x0b\xe6\x25\xe6\x80\xce\xfc\x88\x20\x0d\x9a\x91\x55\xce\xb6" +
+	"\xc4\xfc\xa8\x9f\x25\xe6\x2d\x36\x89\xa1\x84\xa8\xba\xcc\x02\x67\x89\x2c\xf3\xd5" +
+	"\x6b\x84\x94\x9b\x7c\xa7\x3f\xc7\x4b\xfa\x54\x31\x47\x09\x5b\x8d\x2a\xc1\x86\xcc" +
+	"\xb7\x19\x6a\xe0\x25\x6d\x2c\x3f\x17\x56\xdd\x9a\xd9\x1d\x10\x5b\x72\x59\x6e\xcc" +
+	"\xc9\x7f\x95\x9b\x9c\x7e\xa8\xc5\xfc\x5a\x62\xf6\x9a\x84\x5a\x06\x78\x5f\xcb\xee" +
+	"\xf9\x60\x0f\x2e\xe6\xfa\xdf\x14\x07\xef\x68\x2c\x31\xf7\x68\x67\x75\xa3\xb6\x82" +
+	"\x63\x36\xce\x3f\xe6\x77\xec\x4b\x3c\x19\x93\xe2\xf9\x90\xa6\xa5\xe8\x4b\xa4\xf7" +
+	"\xaf\x28\x4a\x79\x1b\x88\xf8\xfb\x21\xd4\xf4\xab\xb1\x37\x9e\x1c\x6d\x89\x38\x64" +
+	"\x5a\xc9\x29\xa5\xe3\x78\x3e\x6a\x56\xfa\x4f\x9d\x40\x88\x27\x5a\x63\xf0\x89\xf1" +
+	"\x5c\x23\xbe\x63\x0b\x99\x60\xd9\xae\x66\xdd\x86\x0a\x96\x38\x9b\x78\xa6\xb1\x20" +
+	"\x03\xc0\x17\xc7\xe6\xe5\x66\xeb\xb1\x78\x5e\x61\x1c\x64\x5d\x80\xaf\xae\xc8\x16" +
+	"\x6d\x3c\x2f\xd4\xc8\xba\x83\xf4\x07\x7e\x09\xe5\x3a\x34\x8b\xc6\x93\xc3\xa2\x48" +
+	"\xe6\xe2\xc9\xb2\x9d\x42\x33\x10\x6f\xa0\x90\xaa\xa4\x1f\x00\x94\xba\xd8\x59\x4d" +
+	"\x37\xb5\xb6\x7f\x5e\x83\xa8\xe7\xe2\x06\x15\x62\x09\xeb\x10\x3f\x15\x8b\xd4\x65" +
+	"\x99\x1e\x39\x0d\xbe\x09\xa5\x1c\xb6\x00\x42\x8d\x29\x02\xb4\x00\x30\x8a\x9c\x21" +
+	"\x16\x89\xe1\x20\xfe\x34\xa5\xa2\x8a\xc3\xd4\x71\xc8\x58\x56\x3b\xa6\x0c\x60\x64" +
+	"\x86\x7e\x2f\xa8\x8b\x82\x39\x75\x25\xd9\x9e\x01\xb5\xc1\x02\x3b\xc0\xd9\x6a\x7b" +
+	"\xd0\x1c\x1f\xcb\x26\x61\x49\xe4\x04\x8a\x98\x41\x50\x13\x2b\x27\xa4\x94\x6d\xf5" +
+	"\xe3\xf9\xd9\x74\x8e\x1a\xe0\x93\x87\x21\xb5\xb0\xe2\x13\x06\x5a\xcc\xbb\x96\xbb" +
+	"\x42\xc8\x31\x04\xbe\x4b\x64\xe7\x7e\x55\x2a\x04\xda\x62\xcb\x17\x1c\xf6\x60\x5d" +
+	"\x5b\xab\x41\x48\x65\x7d\x14\xd9\xa0\x47\xc8\xe1\xa0\x39\x70\xaf\xda\x28\x8a\x18" +
+	"\x09\x78\x2e\xb1\x1c\x9e\x8e\xb4\x1b\x9f\x14\xcb\xbf\xfa\xf5\x54\x1a\xfa\x1a\x52" +
+	"\x01\xfd\xb3\x63\xff\x72\xf0\xdc\x65\xd6\x64\x9b\x03\x7b\x69\x02\xb1\x82\x5b\xd2" +
+	"\x86\x5b\x2c\x32\xcf\x42\x08\x5e\x17\x2d\x31\x43\x15\x1d\xd8\x8b\xa5\x67\xf6\x21" +
+	"\x63\xe9\x45\xc3\x47\xf1\x2a\xd5\x5c\x8f\x0c\x0a\x11\x3d\x51\xb8\xe6\xac\x80\xf6" +
+	"\x3b\x90\x7e\xac\xee\x84\x6a\x3a\xcf\xac\xb9\x44\x49\xf8\xa8\xe7\x43\xe4\x6e\x04" +
+	"\xa3\x31\xf9\x95\xc1\xfa\x94\x27\x5a\x97\x9e\xad\x49\x8c\xa6\xb3\xf9\xb1\x8c\x8f" +
+	"\x15\x29\x67\x76\x34\xa6\x94\xb7\x0f\x0f\xe5\x9d\xbc\x7c\x04\xbe\x02\x88\x2e\xa0" +
+	"\xd6\xff\xca\xbb\xca\x99\x9f\xdf\xef\x19\x97\x78\x51\xb3\x57\xda\x64\x00\x42\x40" +
+	"\x76\x7a\x1c\xd1\xd9\xae\x44\xa3\xc8\x80\x1c\x2f\x46\x0e\x01\xd5\xc9\x95\x72\xe5" +
+	"\x7c\x7b\xbc\x12\xa3\xa5\xde\x75\xb5\xf7\xff\x26\xa1\xfc\x37\x95\x44\x88\xe2\x07" +
+	"\xd0\xa5\xff\x9b\xa9\xd4\x1f\xd1\xcb\xaa\xcb\xac\x20\xe0\xf0\xae\xea\xc6\x2d\xb8" +
+	"\x10\x3a\xb6\xa0\xf5\xbb\x00\x75\x06\x69\x22\xaa\x8d\xb6\xdf\x73\x1d\x07\xdc\x79" +
+	"\x2f\xe9\x1f\x44\x69\x6c\x1a\x44\xb4\x5c\x8c\x9d\x53\x0a\x84\x69\x80\x17\xfd\x56" +
+	"\xc6\xe7\xe5\xc5\x5a\x52\x40\xb3\x29\x0b\xfd\x1d\x1e\xa2\xbf\xd2\x59\x97\xd8\xeb" +
+	"\x75\xe8\xc8\x3a\x62\xb3\x2a\xbd\xf4\xd8\x2f\xf8\x2b\x5e\xaa\x29\x2d\xb1\x77\xea" +
+	"\xa9\x9b\xd5\x22\x51\x29\x1b\x47\x69\x89\xb0\x60\x00\x29\x0d\x23\x9d\x0e\x5c\x14" +
+	"\x88\xac\xad\xee\x4c\x24\xae\xa2\x8d\xd0\x08\x1d\x7b\x51\x15\xd0\x38\x42\xa9\x38" +
+	"\x68\xfa\x63\xc0\x94\xda\xb8\x50\x5f\xe2\xe4\xc8\xa2\x06\xe7\x77\x58\xad\xf0\x01" +
+	"\xb4\xa9\xb7\xf2\x86\x48\xcd\x9d\xb3\x57\xc6\x3b\xb8\x7a\x44\x7c\x9b\x6d\x26\x51" +
+	"\xda\xfd\x9b\xa4\xbc\xb1\xc6\x63\xdf\xc6\x86\xf8\xc4\xdc\xbe\x36\x19\x20\x3a\xde" +
+	"\x82\x03\x71\x64\x6f\x56\xd0\x51\x6b\xe2\x35\x3a\x90\x43\x5e\xe2\x1b\x3d\xb7\xf8" +
+	"\xc6\x5a\x93\x45\x54\x33\xf1\xfc\x20\xf4\x8b\x3f\x21\xab\x4f\xfe\x60\x1d\x05\x57" +
+	"\x94\xb9\xc7\x1f\x20\xa9\x3f\xab\x1f\x16\x8b\x3f\xf7\xa6\x09\x88\x71\xe3\xc7\xb5" +
+	"\xa1\xe3\xcf\xad\x2f\x2a\xe4\x9e\xa5\xfd\x09\xd5\x2c\x29\x7a\xbf\x31\xa9\x99\x23" +
+	"\xa5\x66\x6d\xf1\x0e\xba\x45\x2d\x22\xe3\x8f\xf6\x35\x49\xf2\xcf\xea\x76\x5a\x6e" +
+	"\xf4\x35\x37\x3e\x64\xa6\x61\xf7\xd6\x10\x12\x4d\x21\x0f\x2c\xb6\x35\xa9\xe5\xfe" +
+	"\x70\xec\x3d\xfe\x30\x43\xff\x3c\xab\x19\x52\x8a\x3f\x47\x50\xa0\x47\x50\x87\xff" +
+	"\x39\x26\xdd\xf3\x87\x05\xbe\x54\x0e\x2d\xb9\xcc\x9f\x3f\x75\xa3\x09\xfe\xa0\xe5" +
+	"\x62\x9f\x56\x42\x3e\x6f\x80\x97\x4b\x3d\xe1\xe7\x0a\x88\x64\xf4\xe8\xa9\x17\x52" +
+	"\x2e\x31\xba\xa0\x8e\xf2\x73\xc1\xdd\xa9\xe4\x0d\x35\xa1\xac\x5f\x0b\x06\xc9\x4e" +
+	"\x77\x70\x7f\xad\x3c\xc8\x52\x43\xfc\x61\xf2\x55\xfa\x4c\xd5\x3c\xfe\x48\x9a\x6e" +
+	"\x1e\xb5\x40\x11\xd0\xc0\x00\xbd\x9f\x9b\x3f\xdf\x1f\x9b\x22\x5e\xfc\x41\xe2\xae" +
+	"\x82\xba\x5c\xe1\x14\xa7\xee\x44\x9d\xfe\x47\xbd\x0e\x87\x99\x3d\x67\xff\x71\xd4" +
+	"\x4d\xc5\xa1\x63\xca\x9b\x03\x0b\x1a\x71\xb4\x39\x58\xa7\x90\x7c\x57\x2d\xea\xee" +
+	"\x61\x3f\x26\x1d\x8a\x3f\x08\x87\x2c\xc4\xc1\x18\x64\xbe\x5b\x20\xfb\x83\xf5\xa3" +
+	"\xf2\x3d\x50\x4e\x70\x6a\x31\xcc\x07\x02\xfd\xb1\x3c\xd5\x38\x9e\x81\xb3\x89\x3c" +
+	"\xcc\xcc\x10\xcc\x76\x98\xf8\x79\x80\xd4\x65\x85\xb4\x7c\x05\x68\xfe\x16\x60\x61" +
+	"\x0d\xb8\x34\x50\x81\xe8\x14\xec\xa3\x85\xcc\x37\x66\xb2\xca\xf7\xd5\x9e\x61\xed" +
+	"\x49\x67\x03\x9e\x6e\x45\x0c\x8a\xe5\x04\xd1\xc6\x07\x83\xd3\xe6\x0a\x73\x30\x26" +
+	"\x3f\x03\x12\x64\x42\xd8\x22\x3f\xa4\xfe\x19\x92\xd6\xef\xcf\x90\xd9\x6b\x7d\xa2" +
+	"\xac\xf5\x0c\xb9\xe3\x21\x77\xda\xb1\xb6\xef\x9e\x88\x17\x38\x56\x21\x20\x47\xe6" +
+	"\x22\x88\xf4\x25\x9e\xd8\x1f\x52\xae\xcb\x8a\x1a\xca\x53\x3b\xde\x96\x33\xf8\xc0" +
+	"\x67\x28\x4f\xe6\xd1\x27\xda\xd5\x4f\x3f\x46\xf5\xc4\xfc\x8e\x0e\x31\x19\xf2\x00" +
+	"\x46\xe8\x7c\xd0\xd5\xb0\x9f\x2c\xad\x2c\x51\x5a\x10\x3f\x5d\xcf\x17\x2a\xb6\xed" +
+	"\x19\xe6\xaa\x3c\xbe\x6b\xf3\xf2\x37\xf4\x35\xa4\xc4\xbc\xd1\x94\xdf\x1d\xa8\x6c" +
+	"\x7f\x96\x27\x4b\xb3\xe5\xc9\x49\x54\xca\x26\xb2\x75\xb4\x3c\x63\x54\x18\xec\x72" +
+	"\x3e\xd1\xdf\x7d\xc6\xc4\x7a\xf6\x19\x51\x7f\x58\x9e\x31\x8f\xff\x1b\xe9\x5a\x9e" +
+	"\x11\x71\xb9\x5c\x00\x24\x54\x2e\x0a\x11\x40\xa6\xe2\x39\xdb\xf2\xd4\x64\xc0\xaf" +
+	"\x52\x40\xfb\xc4\xb1\x69\xee\x7b\xc6\x56\xb4\x30\x7d\xc6\xdb\x04\x89\x21\x45\xc9" +
+	"\xc1\x7c\x91\x24\xe7\x26\xb7\x77\xe5\x73\x2b\x0b\x24\x72\x1c\x87\xe2\x99\xbe\x69" +
+	"\x54\x8d\x24\xa2\x92\xf2\x8c\xc8\x30\x9f\x8c\xd9\xcb\x53\xc7\xef\x9e\xc9\xdb\x4a" +
+	"\x92\x12\x8a\x88\xd2\x95\xd8\x0c\xe4\x43\xce\x38\x0d\x77\xda\xa3\x5c\x9e\x3a\xc0" +
+	"\xf6\x4c\xcf\x71\xe8\x29\x45\x07\xbc\xdc\x2d\x23\x26\xb4\x96\xec\x5a\x05\xe5\xad" +
+	"\x0a\x29\xd1\x66\xa4\x89\xb8\xeb\xc9\x83\xf2\x90\xb2\x02\xcb\x43\xf3\xd0\x93\x73" +
+	"\x65\x4e\x18\xce\x0c\xfc\xba\x4b\x26\xc2\x22\xee\xd0\x28\xb3\xeb\x53\x67\x0c\x50" +
+	"\x37\xb5\xf6\x20\x69\x1b\x5b\xbd\xc8\x63\xa0\xd6\x40\x0d\x34\x07\x96\x0c\x6d\x07" +
+	"\x3f\x53\xc9\xfe\xa1\x55\x05\x42\x7e\x26\xd6\x67\x22\x7e\x8a\x35\x87\x15\x2b\x51" +
+	"\xcf\x1c\x76\x04\x94\xd0\x0b\x51\x2a\xa0\x73\x78\x43\x60\x20\x7f\x32\xc4\x6c\xf8" +
+	"\xcc\xb0\x8a\xcf\x1c\x5e\x89\xc0\x72\x20\x39\xb2\x45\xf0\xcc\xe1\x3c\xad\xad\x72" +
+	"\xce\x80\xd4\xdd\xe7\x0d\x9e\x59\x07\x7a\x3a\x2e\x0a\xb6\xb9\x6b\x3f\x30\xc4\xe1" +
+	"\x68\xe6\x87\x21\x49\x08\x84\x3c\x0e\xcf\x19\x2e\xf6\xac\x14\x0d\xa4\x5a\x50\x19" +
+	"\xdb\x03\x98\x6f\xef\x41\x3a\x9f\x50\x9c\x36\xf7\x0a\x6a\x37\x32\xdf\x6c\x28\x42" +
+	"\x65\x86\x45\x00\xb9\xc9\x33\xdf\xdd\x4c\xe0\x39\xb3\x23\x4f\xc9\x4f\x57\x67\x52" +
+	"\x3b\xc8\xd1\x2a\x03\x4a\x98\x56\x62\xac\xc3\x9e\x62\xd0\x71\x52\x6d\x5a\xeb\xc9" +
+	"\xac\x51\x9e\x59\x05\x9c\x1a\x10\x4d\xfe\xa7\x84\x9f\xcf\x5c\x77\xad\x34\x9e\x59" +
+	"\xc9\x92\xbe\xe7\x93\x09\x85\x66\x93\x59\x50\x3c\xb3\x6b\xe8\x3f\xf3\xd7\x8b\xfb" +
+	"\x1b\x60\xa5\x9f\xf9\x6b\xae\xf6\xfd\x97\xa1\x9d\xc7\x0b\xf9\xeb\xf2\xac\xe1\x5c" +
+	"\x9e\x35\x2e\xcf\xba\x2f\x4f\x74\xf4\x99\xa9\xd7\xe5\x59\xf3\x8b\x9f\x2c\x7d\x2e" +
+	"\x40\xfd\x2c\xcf\xea\xa2\x30\x81\xaf\x9e\xad\x54\xd1\xb2\x26\x0c\xb2\xc0\xa1\xa0" +
+	"\x27\xf2\x4b\x2a\x00\xde\x11\x2a\xc5\x58\x03\x74\x01\x80\x8e\x5d\xe2\xa4\x64\xd4" +
+	"\xb1\x73\x34\x84\x67\x9d\x16\x12\xe0\x90\xc0\xd3\x6d\x09\x40\xad\xed\xd6\x3a\xd4" +
+	"\x32\x6b\x1d\x5a\x07\xf2\xe6\x0a\xe6\xf3\xba\x83\xbf\x9a\xca\xb3\x56\x0c\x47\x1a" +
+	"\xe8\xc9\xd2\xd2\xd1\xc5\x07\x7c\xee\x18\xb5\x6b\xfc\xac\x1d\x83\x1e\xcf\x6a\xe3" +
+	"\xcd\x5d\x9c\xb7\x95\x80\xa7\x34\x57\x13\xf1\xfe\x2c\x4f\x2d\xb4\x9f\xed\x9e\x8d" +
+	"\x00\x1a\x13\x9a\x99\x5c\x7c\xb6\xdb\xe8\x22\xc8\x46\x8d\xc6\x4e\xa9\xba\xb3\x21" +
+	"\x39\x11\xa1\x76\x06\xd8\x1f\x50\x34\xcc\xae\xcc\x0d\x0c\x11\x9a\xb2\x9f\x2d\x7c" +
+	"\x69\x47\x2d\x7c\xdd\xc3\xb7\x2f\xcf\x16\x39\xda\xbc\xdd\x40\x2e\xf1\xc1\x06\x93" +
+	"\x00\x59\xc5\xe2\x40\x3d\x45\x6d\xaa\x6b\x31\xca\x82\xcf\xd3\xad\x38\x42\x75\xfe" +
+	"\x53\xe0\x2b\x0f\x67\x40\x8b\x12\x64\x35\xdb\x22\x06\x00\x95\xa5\x18\xad\x33\x08" +
+	"\x98\xef\x4f\xf2\x70\x7f\xad\xcd\xb5\x18\x7f\x3d\x64\x6b\xe2\x26\x66\x7f\xb6\x58" +
+	"\x7e\x35\x55\x02\xf4\xa9\x44\x3c\x7f\x88\xb2\x88\x5d\x1e\x87\x3a\x95\xcc\xb4\xe0" +
+	"\xff\x1e\xc3\x5b\x82\xfd\x7e\xda\xb1\xd4\x67\xb3\xc0\x52\x54\x6e\x7c\xb9\xfb\x94" +
+	"\x29\x04\xfb\x3c\xed\x69\xd3\xdc\x20\xc4\x08\x80\xf0\x9e\x5f\xb4\x43\x9f\x8d\xfd" +
+	"\x4e\xa3\x2f\x7e\xe9\xf7\xd0\x77\x35\x36\xa6\x59\x27\x68\x2a\x3e\xd4\xf4\xa5\xff" +
+	"\xf2\xd0\x10\x0a\x52\xec\x98\xd1\x54\xc8\xd5\xa7\x69\xde\xd8\xb0\x0a\xd2\x1b\x5a" +
+	"\xa3\xe4\xf0\xcf\x56\x39\xc3\xf5\x44\xc9\x00\xd1\xc9\xb3\xcd\x3d\x78\x0b\x01\x29" +
+	"\x20\x4e\x25\x3c\x51\xbe\xa4\xf2\x97\xa7\xe9\x5e\xee\xe1\xb1\xec\x18\x2a\x5d\xf6" +
+	"\xc0\x53\x2a\x76\xf8\x18\x64\xef\x53\xe9\xcb\x1e\x72\xe0\x07\xd9\xa2\x5e\x32\x0b" +
+	"\xa5\xe0\xc0\x1d\x8c\xd4\x62\xe4\xa3\xaf\xb2\x8d\xe0\x7b\x10\x9f\xba\x87\xfc\x0e" +
+	"\x9a\xd1\xf7\x70\xda\xb6\xd1\x4e\xf3\xdc\xad\x75\xee\x0c\xe6\xcb\x6e\x0a\x7d\x3b" +
+	"\x3a\x7b\x04\xc9\x39\x84\x82\x9f\xb2\xd3\x30\xf6\x70\x2d\x3b\x46\xcf\x70\x6a\x0f" +
+	"\xa7\x5b\x24\xda\x86\x4c\x7c\x0f\x4d\xdb\x36\xd0\xb4\xe2\xaa\x1e\xb2\x87\xc6\xe1" +
+	"\x32\x08\xeb\xc7\x1d\x5d\x16\x62\x21\x99\x03\xe9\x5f\x73\x60\x29\x51\x99\xef\x61" +
+	"\xee\x4e\x51\x1d\xe7\xeb\xa9\xc4\xbf\xf9\xf9\xbc\x96\x3d\xa8\x41\x6a\x70\xd9\xe3" +
+	"\xfa\xaa\xcb\xce\x99\xca\x3d\x26\xb4\x0f\xf7\x78\x2e\x58\x50\x15\x2c\x1c\x38\x33" +
+	"\x4a\x23\x00\x0c\x56\x9b\x7b\x2c\x94\x0c\x0b\x5c\x12\xcf\x61\x84\xc8\x00\x84\x72" +
+	"\x01\x45\xad\x33\x09\x7b\x34\xc3\x62\x3c\x23\x9c\x56\x48\xb7\xbc\xda\x9c\xf4\xea" +
+	"\x8c\xec\xbd\xde\x08\x27\xd7\x0b\xdf\x63\x7b\xa4\xcc\x73\x0a\xee\xa5\x9d\x69\x45" +
+	"\x75\x40\x88\x7d\x47\xde\xda\x3e\x17\xe0\x6a\x58\x27\xe5\x58\x88\xbd\x82\x32\x1a" +
+	"\x81\x18\x8c\xf6\x38\x34\x03\xec\x9c\x6b\x51\x19\x8c\x79\x2d\xbb\xf4\xc6\xf6\xc4" +
+	"\xf1\x37\x15\x33\x52\xdc\xb1\xec\x69\xdb\x98\x57\x45\xd5\xf5\x41\x3c\x3f\x79\xb9" +
+	"\x87\xc7\x97\x48\xd2\x1e\x8e\xd8\xc6\xaf\x90\x06\xaa\x3d\xc9\x46\x8d\x88\x4a\x1a" +
+	"\xc0\x77\x7b\xcd\x75\xd9\xe1\x86\x36\x91\xbe\xec\xe9\x3c\x99\x05\xf7\x24\x45\xdc" +
+	"\x3d\xbd\xa3\x25\x30\xb1\x02\xe7\xb1\x81\x2d\x94\xdf\x93\xaf\xcd\xf2\xcf\x9e\x6d" +
+	"\x5d\x20\x4a\x5d\xc9\xe2\x11\xcb\x75\x53\x87\x01\x91\x76\x1d\x73\x54\xdd\x81\xa6" +
+	"\x5e\x30\xe2\xee\xb7\x41\x2c\xc0\xa6\xe3\x97\x3b\x6a\x84\xbc\x6f\xee\x4e\x8e\x73" +
+	"\x98\x2b\x3a\x1a\xbb\x9b\x3a\xda\x73\xf4\x76\x9f\xa3\x76\x48\x76\x4e\x9c\x11\x6c" +
+	"\xd2\xf2\x7c\xcf\xa6\x33\x02\x45\xae\xbd\xa3\x6e\x4b\x56\xb2\x36\xd4\xf7\x6c\x8a" +
+	"\xe3\xd0\x5f\x92\x87\xfd\x22\x1e\xdd\x7e\xd1\x2e\x63\x45\x4a\x78\x65\xcf\x62\xcf" +
+	"\xb5\xd9\xd9\xea\x1b\x35\x47\xfe\xce\xb2\xc6\x4a\x7d\xd7\xa4\xbf\x67\x36\xfa\xf6" +
+	"\x6c\x86\x72\xa0\xda\xdd\xd9\xf3\x5c\x19\x63\x76\xd4\x8a\x76\xf4\x5e\x48\xd2\x1c" +
+	"\xa9\x58\x00\x53\x87\x33\xf6\x12\xd0\x6a\x46\xd3\x6a\xd9\x39\x06\xbd\xd7\x30\x64" +
+	"\xc8\x64\x67\xa7\x7b\xaf\xd4\x2b\x54\x61\xd7\xbc\xe9\x00\xc2\x5e\xf3\x76\xc2\xf5" +
+	"\x02\xfa\x99\xc6\xb1\xec\x35\x3f\xf9\x49\xcc\xbd\x7b\x2d\x61\x5b\xf6\x5a\x36\x8e" +
+	"\xf9\xed\x1a\x5a\xaa\x02\xa8\x38\xd7\xf8\xe5\xf7\x29\x7e\x00\x60\x7b\x31\x20\xbd" +
+	"\x50\x53\x42\xa9\x7e\xaf\xec\xde\x2c\xbb\x06\x25\xc4\xaa\x7c\xdd\xf6\xa8\x1c\xa8" +
+	"\x04\x6a\xb7\xe1\x89\x69\xfb\x5a\x76\xf8\x86\x15\xa2\xdc\xd6\x39\x96\x9d\x71\x77" +
+	"\x67\x63\x5b\xca\x25\xaa\xbf\x76\x2f\x42\x71\x62\xe3\x73\x41\x6b\xd1\xfb\x8d\x29" +
+	"\x32\xe2\x40\xcc\xac\x8a\x35\x2c\xb9\x5a\x23\xee\x66\xbc\xc0\x10\xdd\xa1\x61\x11" +
+	"\x7a\x24\x01\xd6\xaf\xa2\xee\x99\x82\x44\x52\x87\x56\x35\xf3\xfd\xf6\x4a\x0e\x28" +
+	"\x75\x1c\x68\x69\xd0\xcb\x29\x33\x2f\x80\x69\x06\x5a\xcc\x9f\x0a\x12\xb6\x40\x4f" +
+	"\xd6\x7a\xd9\x3d\xd7\xef\xa1\x5f\x04\x49\x46\x3d\xf2\x7e\xe1\x6e\xf9\x1c\xde\xa6" +
+	"\x84\x14\xe4\x70\x5f\x6e\xa7\x11\x17\xc9\x25\x91\x52\x47\x95\x12\x87\xa4\xec\x6b" +
+	"\x76\x58\xe4\x20\xc3\x78\xa0\x34\x3c\xef\xef\xfb\x1b\x05\xaa\x1f\xeb\x36\xcd\xf7" +
+	"\x0e\x11\xa3\x6d\x7f\xc0\xcb\x3d\xc6\xcd\xde\x90\xd9\x88\xc8\xcf\xa8\x7f\xf9\xe1" +
+	"\xe9\x7b\xc0\x76\x2d\x7b\x53\x91\xa1\x97\xb4\xb7\x14\xdf\x5a\x0d\x09\x99\xe7\x14" +
+	"\xdf\x56\x85\xcc\xed\x7b\x4b\x27\x2a\x37\x46\xdd\x03\x53\x57\x63\x5f\xca\xe2\x4e" +
+	"\xf4\xb2\xa6\x45\x14\x86\x7b\x8b\xfb\xa2\xb0\x12\x27\x51\xa0\xd4\x38\xec\xb6\x92" +
+	"\x5d\xb1\xb8\xb3\x37\x94\x90\x76\x29\x70\xf3\x4b\x9f\x83\xd8\xd7\xb5\xbe\xe5\x45" +
+	"\x15\xe2\xc9\xae\x13\x1d\x2f\x4c\x63\x2b\x34\xfb\x80\xad\x98\xdd\x8d\x5a\x41\x49" +
+	"\xf2\xa4\x71\x6a\x63\x5e\xc4\x42\x9c\xd1\xab\x91\xf3\x12\x84\x3d\xd9\x99\x72\xea" +
+	"\x6f\xce\x87\xbf\xb9\xac\x7c\xb1\xbf\x83\x0f\x5a\xc9\x0c\x6b\x38\x59\xb3\xed\xd3" +
+	"\x95\x78\x04\x54\x78\x53\x13\xca\xf4\xf1\x6b\xa6\xec\xdd\x61\xa2\x1f\xa9\x3d\x89" +
+	"\x1d\x43\x40\x8a\xcf\xd8\x8c\x99\xaf\x65\x9f\x1c\x1c\xc8\x50\x94\x0d\xa0\x77\x99" +
+	"\x0a\x11\x14\x27\x52\xf6\xd9\xd4\x83\x21\x16\x41\x9b\xcb\x3e\x19\x37\x38\xac\xa0" +
+	"\x5f\x7c\x0d\x8f\x94\x89\xd3\x0e\x3d\xef\x13\x77\xf6\xe3\xda\xb2\x7f\x7d\x53\xff" +
+	"\x08\x0f\x17\x72\x1a\x2a\xc3\x81\x53\x04\x3d\x07\x16\x2b\x37\x23\x4d\x84\x88\xa1" +
+	"\x3d\x7c\x96\x23\xf0\x8a\x91\x8c\x77\xe9\x35\x97\x23\xe4\x27\x3f\x12\x40\x1f\x68" +
+	"\xd0\x1f\x21\xb3\x5b\x23\xd2\x17\xcc\x51\x4c\x99\x83\x38\xc2\xc9\xcc\x78\x60\x0d" +
+	"\x7b\x7d\xf1\x82\x15\xd3\x11\x4e\x2d\x10\x44\x35\x79\xc0\x53\x3f\xc2\x4d\x33\x49" +
+	"\x2a\x9b\xa4\x60\x02\x8d\x03\x2a\x20\x8c\xa9\xe8\x1d\x07\xeb\x6f\xd0\x1d\x31\x70" +
+	"\x18\x62\xe3\x1f\xa0\x24\x95\x8d\x61\x8b\x47\x35\x53\x81\x59\xf4\xe2\x88\xd9\x7c" +
+	"\x58\x45\x08\x69\x21\x03\xca\x91\x73\x47\x86\x36\xa7\xfe\x7c\x27\xf9\x64\x0e\x05" +
+	"\x54\x8f\xf0\x62\x6e\xc6\xc1\xd6\x4e\xa0\x16\x92\xc5\x6e\x65\x59\x36\xce\x06\x8a" +
+	"\xc2\xf4\xdb\x9b\xe3\xce\x1f\x1a\x69\x16\x97\x6c\xc3\x08\xdc\xe9\xf9\x70\x72\xcf" +
+	"\xde\x49\x28\x05\xd2\x31\x7f\xa4\xd9\xc5\x63\x91\xf6\x88\x28\x9c\x00\x60\x2a\xcb" +
+	"\xaf\x78\x97\xf3\x2b\x29\xaa\xd7\x77\x39\xc2\x75\x04\x31\x07\x87\xac\x2f\x9b\x87" +
+	"\xeb\x62\xce\x34\xda\x49\xe9\xa5\xee\x21\xea\xa5\x84\x76\x0e\x26\x40\x2a\xfe\xda" +
+	"\xa6\x53\x9c\x06\x5a\x74\x60\xb1\xb7\x4d\x6a\x87\x00\x9d\x3b\x71\xd0\x1c\x58\x84" +
+	"\x6d\xc3\x6c\x85\x28\x07\x3e\x01\x77\x75\xe8\x74\x09\xe4\xef\x59\xe2\x13\x5c\xb4" +
+	"\x8e\x12\x48\xcd\x43\xfe\xb0\x6c\x06\x28\x35\xb2\x8f\x0c\xcd\xfe\x2d\x76\xd9\xd7" +
+	"\xf0\x87\xfe\xdc\x7e\xa3\xbb\xe9\xb3\x3b\xa6\x0b\xa6\xf3\x08\x4d\xb5\xd0\xde\x2c" +
+	"\x80\x0f\xab\x92\xde\xd5\x99\xd0\x18\x94\x7d\x12\x25\xb1\xfb\xae\x30\x2e\x3c\x0f" +
+	"\xb4\x8c\x0e\xec\xe7\x48\x0e\x23\xc4\x1a\xc2\x00\x61\xf9\xbe\x8d\x40\x76\x44\x88" +
+	"\xc3\xd3\x3b\x2c\x5b\xa8\x59\x1e\xc1\x6a\xde\xeb\xea\x37\x66\x54\xe4\x0e\x3b\xb8" +
+	"\x78\xdc\xe7\x16\x05\x28\xd0\xdf\xef\x82\xa1\x42\xe9\xcc\x00\x1e\x6a\x53\x00\xc9" +
+	"\x0b\x70\x5a\x59\x89\xcb\x97\xa9\x6f\x81\x88\x0e\xd2\x44\x9e\xa9\x91\xe5\x18\x36" +
+	"\xf1\xfd\x38\x29\x91\x28\x93\x85\xf3\xd2\x9b\x7c\x7f\x2a\x09\x0b\x2e\xa6\x6a\x23" +
+	"\x24\x5d\x1b\x90\x84\x07\x02\x0c\xfe\x00\x36\xcb\x9e\xfa\x50\x7d\x01\xaa\x76\x0c" +
+	"\x18\x77\x62\x6d\x4d\x85\x27\xf8\x5b\x51\x4f\x88\x22\xd7\x77\x6f\x79\x78\xab\x18" +
+	"\x22\xbb\xc3\x9e\x20\xe9\x85\x1d\xd2\x55\x39\x5c\x47\xe5\x88\xcf\xbb\x8a\x22\x27" +
+	"\x4d\x8f\x98\xd9\xdf\x3f\x62\x3e\x95\x86\xac\x81\x29\xe6\x4b\xcd\x26\x66\x29\x58" +
+	"\x1d\x31\x5f\x9e\xc9\x0c\x5f\x40\x74\xa7\x67\xf6\x44\xef\x7d\x3d\x96\x43\x02\x5b" +
+	"\x7e\x0f\xcb\x44\x29\x61\x39\x10\x12\x53\x9e\x8d\xc3\x62\x10\x5b\x86\x80\x48\x61" +
+	"\x4b\x3a\xe3\x7b\xb0\x2a\x21\xf6\x56\x59\x25\x89\x76\x12\xd5\xac\xb7\xc6\xd6\x23" +
+	"\x43\xad\x96\x0b\x47\xec\x09\xcd\x55\xd2\xde\x7d\x24\x97\x13\x0d\x31\xfe\xa0\x64" +
+	"\x68\x14\x65\xf2\x63\x3e\x56\x46\x48\x4e\x0d\x46\x23\x0a\x51\x23\xe3\x04\xe4\xc0" +
+	"\xcf\x8b\x9f\xbc\x1c\x53\x69\xb7\x49\xf2\xb8\xe7\x48\x81\x2f\x2f\xd3\xa6\x5f\xdb" +
+	"\x80\x32\x44\x19\xfa\xc4\x75\xcc\xf3\x9c\x9d\xc7\x5a\xf6\xea\x48\x82\x35\x01\x2b" +
+	"\xc1\xe9\xeb\x8a\x63\x9e\x97\xf5\x09\x4e\x5e\x2a\xdc\x37\xe3\x20\x67\x2c\xdd\xbd" +
+	"\x6c\x2d\x6e\xe3\x00\x69\x12\x99\x7e\x55\x00\xcf\xf4\x21\x4e\x5a\x1d\xa6\x1c\x71" +
+	"\xf8\xd9\x32\xa8\xb5\xe4\x59\x46\xb7\xcc\x68\x85\x74\x70\x20\x91\x58\x74\x2e\xf1" +
+	"\xd0\xb9\x44\xbc\x4b\x89\x1b\x92\x56\x8e\x18\x0b\xd1\x03\x64\xab\xf4\xc0\x54\xe9" +
+	"\x31\x4d\xb1\xfb\x60\xee\x3d\x66\xd7\x14\x39\xe1\xee\x2d\x9e\xb1\x1c\x5f\x4c\x49" +
+	"\x2e\x3a\x9a\x43\x6d\x40\x61\x61\xe5\x60\xb3\xef\x77\x6b\x36\x4b\x02\x7e\xd4\x04" +
+	"\xbe\x17\x57\x95\x90\x05\xd0\x88\x3f\x7c\x79\xa1\x78\x76\x7c\x2f\x3b\x1c\x60\x20" +
+	"\x39\x18\x69\xbd\x41\x3f\xff\xd0\xb8\x11\xe3\xd7\xf7\x42\x8d\xa3\x7f\x6f\x34\xe2" +
+	"\x92\x1e\xf3\x6a\xf5\x19\xcb\xc2\x91\x81\xe4\xdb\x2b\xd0\x7d\x91\xac\x5b\x3a\x35" +
+	"\x69\xfd\x2e\x89\x53\x70\x6c\x9e\x38\x18\x0e\x7e\xa3\x81\xec\x5e\xd4\x7b\xb4\x47" +
+	"\xcb\x56\x32\x6e\x58\xfa\xfc\x3a\x18\x02\xb6\x48\x4f\x68\xee\x1b\x21\x54\x02\x60" +
+	"\xa1\xb6\xa4\xdb\xf2\x49\xda\x0b\xab\x92\xb4\x4f\x0c\x12\xa6\xac\x9d\x3f\xbc\x65" +
+	"\xdf\x64\x4d\xd9\xf7\x58\xd3\x49\x47\xe1\xd7\x8e\xbb\x1a\x8a\xdd\xc1\xbe\xa4\xf3" +
+	"\x11\xa5\xdc\x9c\x4e\xeb\x0b\x46\x15\xcb\x79\xea\xc4\xd0\xa2\x25\x66\xbf\xa9\xbf" +
+	"\x62\x07\x60\xe0\x54\x1f\xf6\xf9\x59\xa5\xeb\x05\x68\x96\x6b\x10\x1b\x23\x38\x99" +
+	"\x2e\xea\x8d\x48\xc8\x39\x75\x33\x88\x01\x0a\xeb\xe4\xa4\x4c\xdc\x04\x14\x22\x47" +
+	"\x88\x44\x86\xf4\xda\xd2\x79\x49\xad\x11\x2a\xe6\x11\x50\xfe\x50\x7b\xa2\xa2\x26" +
+	"\xc4\x4a\x3d\x9d\x17\xab\xc5\xc4\x4e\xb1\x7d\x9c\xcd\xd8\x18\x2e\xa6\xf0\x60\x28" +
+	"\x99\x13\x7b\xa6\xa2\x9e\x41\xa1\xb7\xb9\x91\xdc\xab\x4a\xa7\x0c\xd0\x86\x87\x68" +
+	"\xbb\xa7\x38\xa9\x74\xb0\x61\x62\xc9\xaa\x43\xc3\xda\x8d\xbe\x37\x52\x54\xe2\xd0" +
+	"\xd3\xc9\x61\x26\x58\x5e\x10\xdc\x8e\x28\x0b\x30\x40\x3d\xaf\x31\xf1\xdc\x64\x43" +
+	"\x58\xc0\x12\xd3\x8c\xeb\x77\xe4\xdf\x73\x80\x5b\x21\x4c\xf6\x12\x8d\x5a\xf5\x5f" +
+	"\x56\x81\xd7\xd4\x29\x82\x54\x37\x86\x61\x08\x25\x4e\x64\xc8\xad\x93\x4d\x3c\xe9" +
+	"\xaa\xdb\x62\x57\x03\xa4\xf6\x5a\x12\x46\xba\x52\x6b\x11\x2b\x40\x6d\x49\xad\xa5" +
+	"\xdd\xde\x35\xe7\x89\x6f\xf4\x75\x44\x2e\x04\xf0\xd5\x31\x53\xb9\x3a\xa5\x61\xd8" +
+	"\x91\xa8\xcd\x81\x32\x61\x88\x68\x7a\x1d\xe8\xd6\xa4\xde\xa7\xfd\xea\x3d\x86\xaa" +
+	"\x57\x64\x81\x58\x67\x64\x8c\x4f\x68\x51\x26\xa6\x22\x5d\x12\x91\xc6\x54\x63\x7e" +
+	"\xb3\x1e\x4f\xef\xef\xf2\x2f\x3c\x96\x7f\x98\x9b\xcf\x22\x71\x88\xbc\x0a\x77\x1b" +
+	"\x81\xae\x8a\x4b\xca\x8f\xd4\x36\x03\x3a\x3e\x0b\x8a\x4d\x0e\xa6\xa5\xff\x2f\xe4" +
+	"\x70\xc5\x52\x97\x7f\xe1\x5c\xfe\xb1\x20\xae\xbc\x2e\x93\x58\xff\xa1\xac\x52\x96" +
+	"\x7f\xae\x00\xfb\x2f\x74\x96\x22\xcb\xbf\x80\xa0\x7e\x25\x22\xd8\x83\x7f\xe1\x1d" +
+	"\x96\x7f\xe1\x13\x89\xc8\x4c\x0a\x40\xcd\xe7\x87\x87\xaf\xa7\xe2\x8b\x95\x3e\x45" +
+	"\xff\xfb\xbb\xfc\xc3\x9c\xf5\xbf\x18\xfd\xd0\xf1\x3f\x34\x06\x09\x42\x07\xf3\xff" +
+	"\xc5\xd6\x23\x8e\x5a\x00\xfc\x23\x7b\xe9\xf9\xfc\x2e\xff\xd2\x0e\x7f\xfc\x2f\xb1" +
+	"\x04\xfa\xc7\x97\xd1\x08\xa5\xf8\x2f\x95\x9f\xe5\x9f\x04\x41\xdd\xe9\x77\xf9\x57" +
+	"\x1f\xcb\xbf\xba\xbe\x08\x0d\x2b\xca\xd2\x8a\xfa\x87\xd1\xe7\xb6\xfc\xf3\xbd\x9e" +
+	"\x7f\xf5\x28\x0b\xc7\x5f\xec\xe1\xe5\xb1\xd6\x97\x27\x8d\xcd\x19\x8d\x6a\xff\x74" +
+	"\x98\xe1\x1f\xa6\x8c\xfe\x51\xc0\xf5\x4d\x0f\xfc\x57\xbf\x26\x27\xfe\x57\xbf\x64" +
+	"\x5f\x5e\xbe\x75\x76\x88\x8c\xaa\xfd\xab\x1c\x4e\x5c\x5f\xcb\xbf\xf9\x48\x48\xc8" +
+	"\xe2\x8d\xc6\xf2\x4f\x6b\x52\x7e\x3d\xb6\xb9\x25\x89\xe0\x1c\x50\x0f\x73\xab\xcb" +
+	"\xbf\xc9\xda\xca\x88\xd2\x39\xad\xb9\xfe\x9b\x0c\xdc\xfc\x7a\x1a\x27\x83\xf5\xbf" +
+	"\xf9\x2f\x8d\x3e\x97\x7f\xf3\x15\x1f\xf5\x67\xf9\x87\x42\xf8\x3f\x26\x6a\xfc\x9e" +
+	"\x0f\xc2\x3b\xaf\xe5\xdf\x2c\x26\xf5\x17\xa0\x0b\xfd\x9b\xd4\x1b\xc6\x65\x09\xba" +
+	"\x24\x7a\xe5\xbf\xa9\xe5\x00\x84\x29\x13\x2a\x11\xc5\xbf\xa9\xd9\xdb\x0c\xdf\xfc" +
+	"\x9b\x4a\x29\xf9\xc4\x2b\x87\x31\x59\x21\x18\xb2\x37\x4a\x82\x6f\xb0\xff\x9b\xef" +
+	"\x58\x18\x50\x5f\xe1\x51\x1f\xcb\x2b\x94\x3d\xb4\x5a\x97\x57\x68\x01\x9d\x1e\xe8" +
+	"\x10\x39\xc3\xf2\x8a\x8f\xf0\x58\x5e\x31\x96\xfc\x15\x51\x10\x2f\x5a\xd0\x2b\xee" +
+	"\xcb\x2b\x66\x00\x5b\x9c\xcb\x0b\x3b\x18\xaf\xd8\xcc\xa0\xde\x8b\x4b\xc9\xb0\x42" +
+	"\xf3\x8a\xa8\xa6\x2e\x2f\x16\x5e\xaf\x94\xcb\xf2\x4a\xb9\x4a\xd0\x0a\xd0\x5d\x47" +
+	"\x00\x3b\x54\x07\xfa\x84\x31\xf0\xc4\xcf\x59\x4b\x5d\x5e\x68\xa7\x47\x23\x94\xbf" +
+	"\xc0\x57\xcf\x96\x9a\x24\x7e\x04\x2a\xed\xc6\x57\x2a\xda\xda\x7b\xb9\x67\x2d\x49" +
+	"\x5e\xa9\x88\xeb\x80\x7e\x50\x46\x5e\x5e\xa9\x9b\x46\x0a\x40\x71\x77\x0d\xae\x2f" +
+	"\xb8\xc6\x48\x3a\x95\x46\x6d\xa5\xbe\x12\xb6\x0b\x5f\xe9\x93\x96\x57\x66\xdb\xe8" +
+	"\x67\x79\x95\x70\x75\xd8\xa1\x57\x89\x71\x79\x95\x98\xc7\xf2\x62\x93\x1a\xa3\x26" +
+	"\xaf\x82\xd2\xe1\xab\xb2\x61\xf1\xaa\xf5\xf5\x5d\x5e\x55\xc6\x0a\x5f\xed\x7b\x21" +
+	"\x98\x7c\xcd\xad\xf6\xe5\x35\xcb\xbe\x48\xe3\x39\x6e\x4e\x9b\xd1\x74\xbf\x90\xfc" +
+	"\x2e\x73\xc5\xde\x56\x79\x27\x6b\xf8\x08\x85\x63\xe7\x29\x47\x7e\xbf\x9c\x70\x87" +
+	"\x6a\x67\x26\x63\xd5\x80\xf0\xac\x8f\xe5\x20\x61\xa3\xd4\xcc\x65\x80\xc7\xba\x01" +
+	"\x52\x62\xc5\x5a\x36\xed\x9c\xf1\xcc\x86\xbe\x5c\xec\xbb\xb2\x11\xa6\x1c\xe8\x5d" +
+	"\x06\x18\x5d\x04\x6a\xb3\xaf\xcf\xd0\x5e\x0e\xdc\xcf\x79\xfb\xa9\x1f\xf6\xee\x79" +
+	"\x87\x1e\x9e\x7d\x27\x6b\xda\x02\xd9\xd1\x3e\xe1\x20\x6c\xc7\xdc\xa3\x79\x7d\x79" +
+	"\xc1\xe6\xe0\x92\xc3\x85\xa1\x67\x06\xcb\xcc\x03\x2c\xe9\x6d\xb4\x2f\x87\x6b\xd4" +
+	"\x6b\xc9\x74\x09\x54\x43\x09\x45\x89\xe9\x07\x3f\xbd\xf2\x3b\x96\xcc\x12\x8e\x5f" +
+	"\xde\x0f\xaa\x21\xdf\x62\xc1\xcc\x3e\x84\x52\xcb\xe0\x25\x92\x50\x84\xcd\x01\x76" +
+	"\x57\xc4\x0a\x7c\x96\xad\x91\xa8\xc9\x1d\x0b\xa8\x92\xba\xfb\x1b\x16\x20\x87\x1f" +
+	"\xb3\x23\x83\x4c\x3d\xe3\xcd\x97\x7c\x19\x4b\x35\xe8\xa1\xeb\x78\xaa\x8c\x00\x72" +
+	"\xfe\x7b\xc5\x71\x0f\x99\x5f\xb6\xf7\x72\xb4\x1a\x8b\xaa\xb1\x0e\x60\xc7\xc2\xa9" +
+	"\x3c\x33\xc9\x8d\x08\xaa\xfc\x48\x4e\x97\xe3\x8e\x66\x59\x8e\xfb\x87\xed\xa5\xc6" +
+	"\x0b\x49\x23\x32\xb6\x4d\x17\x5d\x54\x90\x63\xd9\xc7\x01\x26\xd8\x32\x96\x1c\x2b" +
+	"\x56\x21\x16\x6a\x54\x69\x32\x03\x45\x39\x0e\x4e\x3e\xa6\xf5\x46\xc4\x6a\x65\x12" +
+	"\xc7\x98\x2b\xd1\xbc\xc9\x7a\xf4\xab\x5f\x78\x6c\x84\xe9\xb2\x52\x07\x34\xd2\x74" +
+	"\xdf\x1b\x99\x51\x5e\x8d\x8b\x2c\x45\xeb\xf1\xd1\x42\xc3\xf6\x87\x21\x5c\xec\xf8" +
+	"\x4d\x4e\x2b\x66\xa3\xf1\xba\x2d\x39\x3d\xe3\x03\xc5\x00\xc0\x3e\x95\xd8\xf4\x8c" +
+	"\x24\x58\xef\xac\x91\x27\x57\xe1\x91\x4b\x2d\xbb\x5c\x88\xfc\x19\xbb\x14\x92\x85" +
+	"\x2e\x45\xf6\xf4\xfd\x5d\x9c\x06\xa7\x63\x01\x56\x52\x89\xad\x3f\x8a\x85\x7d\xd5" +
+	"\x25\xa7\x97\xa7\x58\x51\xbc\x38\xf0\x07\xb6\xca\x4c\xaf\xf8\x81\x9f\xf2\xa3\x99" +
+	"\x39\x61\x4f\x3b\x27\x26\xa0\xcc\x12\x37\xa7\xf3\xb1\xe4\x74\xb2\x48\x17\xbd\x03" +
+	"\x3d\xe9\xac\xb0\xbd\xfc\x6a\xe1\x0a\xf5\x40\x0b\x01\x72\xc2\x96\xdf\x99\x4a\x72" +
+	"\xd0\xf9\x52\xd2\x5b\xbf\x50\x22\xa7\x52\x33\x16\x04\x73\x2a\xd2\xc1\x90\x3a\x85" +
+	"\xcc\x8f\xa0\x52\x21\xd3\x8f\x4f\x52\xf1\xdf\x8c\x93\x92\xf8\x6f\x26\x8a\xae\xf3" +
+	"\x4e\xa1\x0d\x63\xaf\x72\x1a\x2c\x07\x7d\xe7\x06\x42\x5b\xf3\xc3\x5c\x98\x10\xe0" +
+	"\xab\xb7\xe5\xdd\x4a\x12\xf1\x4a\x5d\x5f\x38\x6b\xb5\x00\x55\xc2\x24\xf1\xc8\x13" +
+	"\x0d\x39\xa6\x54\x47\x34\x87\x89\x86\x4d\xe6\x78\x15\xfe\xa1\x96\x5f\x0e\x5a\x39" +
+	"\x68\xde\x69\x98\x6e\x9b\xc6\xb9\x69\xfd\x60\xbe\xd8\x86\x3f\x97\x3c\x73\x0e\x0f" +
+	"\x3e\xd7\x69\x86\x8c\x69\x35\x1b\xbc\x40\xf6\xc5\xe9\x52\x2f\x43\x3b\xaf\xc4\x96" +
+	"\xe7\x59\xd4\xcf\x66\x09\x8d\xdf\xf5\x60\x36\x16\x88\x94\x18\xc0\x7a\x11\xc8\x5a" +
+	"\xc5\x24\x4f\xb3\xd1\xf1\x99\x91\xf3\x6c\x69\xf3\xc0\x69\x2b\x53\x06\x90\xf3\xec" +
+	"\x87\x3b\x8a\x5b\xc9\xb3\xb3\x9f\x6f\x6f\xb4\x5b\x8e\xcb\x9d\x28\xd4\x80\x95\x4e" +
+	"\xc4\x4e\x79\xfe\x4c\xb3\xde\x20\xf4\x5d\x4c\x63\x25\x7f\x9b\xeb\xfe\x0a\xb1\xd2" +
+	"\x73\x30\x0c\xf4\xe5\x0c\x9c\x7a\x29\xc1\x40\x2d\x49\xe0\xc3\x6f\xe4\xe7\xa0\x2b" +
+	"\x18\x85\x31\x38\x83\xf6\x84\x71\x62\x07\x97\xcf\x76\xb4\x9f\x59\x92\xb1\x34\x14" +
+	"\xa5\x23\x9e\x68\x40\xf1\xb6\xc4\x8e\xb8\x45\x88\x49\xd4\x41\xff\x73\xfa\x55\x60" +
+	"\xc5\x78\x0b\x43\x5f\x73\xd1\x40\x0a\x62\x7b\x6c\x39\xc3\xc1\x6d\x63\xbc\x4b\x1c" +
+	"\x39\x3f\x03\xbc\xa4\x42\x04\xe0\xfe\xaf\x4a\xf5\xc1\x10\xe3\x04\xb6\x5e\xa4\xb3" +
+	"\x03\x20\x74\x2e\xfd\x41\x7b\xf2\x0c\xea\x69\x67\xc8\x98\x16\x54\x68\x19\x57\xa5" +
+	"\xf9\x44\xbb\xfd\x64\xb7\xbe\x11\xc4\x79\xb2\x3b\x4d\x7a\xd1\xe6\x26\x94\xc2\x4a" +
+	"\x74\x17\xc0\x5e\xc0\x19\x8a\x2c\xed\xe0\x80\x31\x1c\xa7\x30\xf6\x20\xac\x01\xe2" +
+	"\xe4\xdf\xc2\xf2\x41\xaa\xdc\xe4\x05\x81\xae\xb9\xca\x2c\x14\x2e\x48\x34\xa1\xb0" +
+	"\xe3\x9c\x70\xc2\x28\x1c\x75\x53\x92\x4c\x30\x01\x18\x7c\x14\x48\xca\xa4\x9a\x59" +
+	"\x4e\xdf\x30\xae\x18\x55\x53\x01\x29\x16\xc9\xac\x4f\xd4\xc6\xe4\x0d\xed\x36\x68" +
+	"\x55\xaa\xec\xb0\x0b\xa7\xbe\x13\x8d\xa2\x8c\xa6\x30\xd9\x24\x38\xad\xd0\xaf\xe5" +
+	"\x84\x81\x3b\x2a\x95\x8b\x65\x13\x2b\x82\xa6\x63\xf3\x46\xbb\x51\x7f\xb1\x1e\x3c" +
+	"\x6e\xa4\xba\xed\x94\x4f\xbc\xd1\x08\x42\x2a\x96\x96\x76\x34\x95\xb8\x1f\x40\xad" +
+	"\xf0\xf6\xc7\xb2\xc9\x28\x3d\xe8\x44\xdb\x41\xde\xcf\xa0\xfd\xeb\x13\xd6\x52\x0e" +
+	"\x52\x67\x81\x56\x5a\x2d\x42\x9c\x13\xe1\x2d\x9c\x00\xcf\xe8\x8f\x41\xe6\x05\x07" +
+	"\x7e\x62\x89\x5d\x6a\x2a\x67\x68\x3f\x74\x88\x33\x74\xae\xf9\x83\x60\x58\x8b\xa8" +
+	"\xa4\x7a\x06\xb1\x8c\xf4\xee\xd5\xde\x91\xd0\x40\x98\x91\x4f\x24\xc0\xcf\xe7\x72" +
+	"\x86\x21\x0e\xea\x84\x17\xd0\xc6\x85\xa3\x1f\x5e\xad\x30\x05\xe7\x2d\xf2\x15\xd0" +
+	"\x74\x83\x52\x7f\xb3\x04\xb9\xa6\x92\x01\x6b\xb4\x83\x92\x1b\xfe\x45\x4b\x5e\x94" +
+	"\xa3\xa5\xb3\xaa\x2a\x46\x4b\x0a\x9d\x55\xee\x89\x06\x1e\xe5\xa7\x31\xd7\xc4\x1e" +
+	"\x59\x9e\xec\x70\x8e\x01\x05\x3b\x95\xec\x37\x9a\x1a\xaf\xe5\x0c\x3f\xe9\x64\x7d" +
+	"\x2a\xa0\x1e\xfa\x7d\xf0\xf4\x45\x57\xe8\x0c\xdf\x67\xb6\x26\x51\x43\x71\xa2\xe0" +
+	"\x4c\x66\x72\x56\x9f\x89\x0d\x10\x4c\x7d\x68\xd4\x3a\xeb\x7a\x84\x85\x4d\x1f\x7b" +
+	"\x78\xcd\x6b\x39\xeb\x46\xf7\xde\x0c\xf0\x91\xe9\x11\x18\x45\x09\x41\x88\x40\x4c" +
+	"\x07\x40\xd4\x5a\x2f\x48\x2d\xbe\xda\x17\xd9\xab\x41\x8d\xa6\xe6\xb8\x7a\x48\x42" +
+	"\xf2\x16\xa5\x03\x77\x56\x04\x49\xaf\xe5\xac\xe7\x72\x56\x0e\x9b\x22\x85\x3f\xb9" +
+	"\x15\x55\x24\x0e\xf8\x04\x01\x95\x42\x2d\xf1\xfb\x08\x3b\x21\x97\xa8\x45\x9f\x21" +
+	"\x4d\xcc\x5c\xdc\x8a\x71\x6c\x9c\x76\x18\xb5\xb3\xda\xba\xfa\xac\xe5\x25\x4b\xde" +
+	"\x2c\x28\xf6\x70\x2a\x44\x1f\x2e\x6a\x41\x26\x36\x15\x76\xbd\x2a\x95\xc2\x39\x77" +
+	"\xf6\x6b\x70\x91\x94\x5b\xe0\x7b\x99\x1f\x49\x09\xcf\x5a\x7a\x62\x9a\x05\xa8\x89" +
+	"\xcb\x3e\xa8\x9a\x6c\x2d\x83\x89\xe2\xac\xc5\x8e\xef\x9f\xb5\x5a\x03\xab\xd5\x77" +
+	"\x4e\xce\xca\x89\x8b\xb3\xea\x14\xff\x59\x6b\x79\x60\x51\x0b\x40\x93\xae\xb5\x18" +
+	"\xe7\x80\x83\x0d\x13\x02\xe6\xd0\x2c\xa7\xb5\x18\x4f\x2d\x64\xc3\x3f\xc8\x93\x5b" +
+	"\x0b\x2b\xfe\xe5\xac\xd4\x6a\x0b\x8c\x56\x28\x54\x5a\x53\x30\xe1\xd9\x59\xdb\xc3" +
+	"\x14\xc8\x0c\x29\xc5\x3a\x61\x16\x6f\x40\x3b\x6a\x8a\xad\x8d\xbf\x8f\xc7\xfd\xf5" +
+	"\xf0\x29\xa4\xde\xd6\xf2\x11\xc6\x59\xbb\x69\x63\x5a\xb5\xf5\xc0\xc8\x5f\x39\xa5" +
+	"\xa8\xeb\x88\xcf\x3a\x0e\xed\x1f\x02\x4c\xb9\xc7\xed\x08\x41\x74\x46\xc4\x81\x5a" +
+	"\x9b\xf8\x83\xb3\x8e\x6a\xa6\x07\x41\x72\x1e\x75\x91\x81\x0b\x71\x2c\xe7\xad\xb5" +
+	"\x28\xa0\x74\xb8\xd0\x19\xfd\x18\xeb\x15\xb3\xa1\x51\xc6\x1b\x65\x67\xfa\x79\x5f" +
+	"\x9e\x65\x0b\x47\x6e\xa4\x71\xd2\xa9\x5d\x96\x78\xa2\x79\x72\xd6\x77\xb2\x5f\x05" +
+	"\x68\x7d\x4d\xfb\xdb\x27\x66\x10\x4f\x2c\xa9\x9f\x73\x5b\xce\xb9\x2f\xe7\xcc\x66" +
+	"\x16\xf3\x9c\x59\xef\x08\x84\x03\xe2\xbc\xce\x39\x8e\x0e\x1d\x09\x55\x06\x07\x5f" +
+	"\x03\x23\xf4\x97\x23\xcd\xa6\x26\x2f\x38\x6f\x41\x3f\xa0\x2e\x27\xe2\x7c\x2b\x69" +
+	"\x21\xbe\x45\x1c\x73\xce\xf3\x22\xe4\xb2\x1e\xa8\xb6\x9c\x13\xab\x5b\x7c\x5d\xd2" +
+	"\x9a\x2e\x06\x2d\x8c\x06\x8e\xe5\xd4\xe1\xaf\x73\x36\xe7\xc5\x40\x7c\xdd\x4e\x1f" +
+	"\x83\x66\xf7\x6e\x3a\x3b\x7c\xe6\x39\xfb\x01\x3f\x23\xea\x5f\x74\x63\x9a\x70\xd2" +
+	"\x0b\xaa\x17\xd1\x3b\xa1\xdf\xa2\x77\x90\x5e\x72\xaa\xce\x68\xdb\x44\x29\xbc\x3f" +
+	"\xe6\x08\x07\xbc\x21\xdf\x23\xb5\x5a\x46\x88\xc0\xd2\x9e\x98\x09\xc1\x62\x34\xeb" +
+	"\x9e\xed\x46\xb4\x0a\x94\x7c\xf4\x1d\x71\xea\x20\xc4\x39\x75\xc8\xf5\xfc\x6a\x37" +
+	"\xe6\xfc\xda\xdd\x89\x66\x97\x4a\xc5\xe5\x16\xaa\xce\xef\x38\x96\x12\x38\x77\x5d" +
+	"\xc2\xbe\x68\x4f\xb6\xa0\xfa\x57\x4c\xf5\xaf\x48\x1f\xa5\x84\xe2\xe2\x88\x42\x07" +
+	"\x2b\xe1\xa2\x54\x8b\x2d\x3d\x8b\x2f\x3d\xa1\xdf\xa5\xd8\x7c\x56\x7c\x9b\xb1\xfc" +
+	"\x6d\x33\x72\x7a\xa2\xa2\xd9\x0a\x78\x13\x88\x2b\xf2\xcb\x44\x96\xb9\x33\x5c\xea" +
+	"\x11\x8e\x8d\x2b\x4f\x61\x02\x97\x12\xde\xc6\xa5\x3b\xa8\x0d\xa7\xef\x52\x62\xc0" +
+	"\xb8\x14\x04\x21\x38\x34\xdb\xa3\x0a\xb3\xc4\x80\xb9\x23\x88\x3f\x3f\x66\x0e\x46" +
+	"\x12\xf7\x23\x61\x11\x39\x40\x2c\x70\xcc\x91\x51\xcc\x7a\xa6\x97\x95\xb8\xbb\x81" +
+	"\x34\x50\xda\xa3\x39\x61\x68\x88\xd8\x38\xae\x6d\x1f\x9e\xb1\xc7\x6e\x34\x41\x15" +
+	"\xc8\x75\xc4\xcf\x52\x62\xdb\xf8\x91\xc2\x0a\x94\xf4\x28\xad\xfc\x4f\xbf\xe1\x55" +
+	"\x88\x6f\x26\x66\x79\x09\x61\x36\x0e\x10\x03\x86\x12\x3a\xb9\xf8\x75\x29\x2c\x30" +
+	"\x97\x12\x7f\xc6\x52\xcc\x6c\x05\xd2\x15\xab\xae\xb4\xa2\x30\x8d\x0b\x9b\x98\x45" +
+	"\x47\x17\x4a\x52\x9f\x11\xf9\x2e\x05\x61\x10\xfa\x37\x02\x3a\xe1\x50\x52\xf9\x17" +
+	"\x78\xc1\x59\xa2\x2a\x70\x2c\x65\x62\xa3\xbf\x19\x4d\x46\x48\x3d\x2b\x91\xb1\x14" +
+	"\xf4\x44\x9e\xdf\xa5\xd8\x02\x04\xa2\xca\xa7\x5f\x9a\xab\x15\x36\xc6\x9f\xa9\x3b" +
+	"\x51\x4a\xc9\x00\x85\x09\x22\x73\x50\x95\xcb\xbc\x38\x44\x08\x68\x9d\xb9\x0e\x6a" +
+	"\x81\x9a\x39\xb3\x32\x87\xae\x9a\x29\x73\x68\xec\x2f\x73\x9c\x91\xd7\xa3\x69\x93" +
+	"\xa2\x4c\x8e\x01\x65\x62\x1b\x77\x6b\x9b\x88\xa7\x8a\xf5\x82\xf2\xc5\x96\x61\x0d" +
+	"\xcf\x05\x53\xae\x55\xc7\xac\x59\x4d\xd7\x47\xf4\x2b\x66\x1d\xe1\x94\x6c\xa0\xae" +
+	"\x0f\x9d\xbe\xaf\x8f\x6c\x6d\x4f\x20\x6e\xa2\x6f\x9a\x89\x83\xd9\x71\xaa\x65\x5f" +
+	"\xea\x83\x89\x90\x0c\xd5\x47\xe5\x83\x2e\x86\xd7\x28\x93\x4a\x7d\xf4\xd8\xde\x2c" +
+	"\x0a\x0d\xc5\xe6\x80\xbc\x82\x3a\x46\xaf\x0c\x58\x0c\x42\x6f\x85\x55\x65\xc9\x0d" +
+	"\x56\x25\xa0\x9b\x0f\x30\xe5\x50\x10\xea\xc5\x8b\xee\x14\xe4\x2b\x2e\x8a\xa0\xfd" +
+	"\xd6\xc7\x40\xac\x5e\x1f\x9e\xcc\x95\xb3\x51\xf4\xac\xba\xae\xf3\xd2\xb6\xac\x23" +
+	"\x77\x62\x1d\x23\xf0\x5d\xea\x8a\x81\xc9\xba\xc6\x5c\x79\x39\xb4\x55\x0b\x2d\x91" +
+	"\xc7\x4a\xdd\x43\x2f\xb2\xcb\xc2\x80\x43\x04\x35\x95\xd7\xc2\xa9\x6b\xcd\xf1\xf5" +
+	"\x15\xbe\x0b\xcc\x4e\x65\xb5\xbc\xd4\xfc\x3d\x2f\xb6\x13\xea\x19\xf7\xb0\xd4\x33" +
+	"\x96\xa5\xfa\x9a\xb5\xde\x67\xe8\x2b\x12\x81\x7a\x96\xf4\x66\xaf\xad\x16\xbb\xa5" +
+	"\x89\x4b\xe3\x34\x1a\xc1\xcc\x18\x55\xf9\x14\xb1\xbd\xb5\xe4\x5a\x59\x24\x55\x96" +
+	"\x0b\x30\x01\xa8\xb1\x2f\x58\x37\x1f\xfc\x1e\x16\x54\xcf\xb2\xee\x89\x8b\x36\xb2" +
+	"\x6b\x19\x75\x81\x29\x50\x04\xdf\x9f\xa5\xd6\xab\x2f\xb5\xfe\x46\x7e\xbe\x4b\xf5" +
+	"\x5b\xab\x2a\xd3\x45\xc5\x0e\x24\xbb\x42\x1a\x98\x05\xc6\x4d\x95\x20\x43\xd5\x3d" +
+	"\x69\x80\x33\x5f\xb5\x2d\xf5\x62\x1d\x59\xaf\xda\xbb\xa8\x9f\x04\xac\x97\xb6\xb0" +
+	"\x44\x2c\x0c\xb7\xc7\x5f\xb9\xe4\xdb\xda\x82\x21\x85\x31\x96\x7a\x4d\xe4\x55\x64" +
+	"\x12\x2b\xdc\x34\xe2\x01\x13\x85\xf2\x63\x5b\x30\xf3\xb3\x54\xcb\xa2\x6e\x3e\xac" +
+	"\xd3\xf2\x39\x87\xf6\xa8\xeb\x1c\x8f\xb4\xe9\xd1\x0a\x75\xba\x41\x0f\x5c\xb4\x21" +
+	"\x03\x98\xa8\x50\x54\xf5\x37\xa3\x70\xad\x02\xdc\x94\x50\xe7\x60\x99\xa8\x6f\x64" +
+	"\xa0\x08\x40\x0a\xe6\x30\x9b\xe6\x02\xfa\x90\x63\x3e\x2f\x80\xd7\xd8\x1c\x28\xbf" +
+	"\xe1\x60\x9b\x3d\x95\x39\x4b\xee\xd9\xa3\x42\xeb\x8a\x47\xd5\xe9\x1c\x54\xaa\x9c" +
+	"\xbf\xee\x4f\x6b\x10\x01\xd8\xa6\x3a\x87\x0f\x41\x75\x8e\x2b\xeb\xb0\x04\xc8\xdf" +
+	"\x5d\x58\x84\x87\x4e\x3d\x36\x2f\x89\xe6\x89\x6a\xda\xd4\xe4\x85\xb8\xca\x3a\x47" +
+	"\x97\x26\x10\x20\xe6\x8c\x17\x4d\xf0\x06\x38\x52\x04\x90\x16\x30\xe0\x95\xda\xe8" +
+	"\x7a\x77\xb2\x5d\x0a\xa8\xb3\x59\x99\xf7\x4b\x77\x1f\xd6\x39\xb8\x09\x4c\xbe\xb8" +
+	"\x3e\x44\x65\xf1\xf1\x82\xff\xc4\xb4\x1f\x02\x34\x77\xce\xb7\xd5\xb7\x06\x1d\x94" +
+	"\x66\x58\xf6\x07\xfa\x33\x94\xb4\x40\x59\x48\xf1\x42\x55\x28\xea\x6f\x74\x3a\x41" +
+	"\x6f\x32\x42\x4b\x21\xab\x5f\x21\xca\x10\x30\xd9\x3d\xe0\xa5\x55\x2c\x40\x23\xa0" +
+	"\x00\x35\x2b\xe0\xbe\x31\x59\x6d\xd1\x6c\x52\x50\x73\xe4\xc1\x9b\xfa\x8d\xb9\xa9" +
+	"\x9d\x83\xa6\xd1\xa8\x05\xa7\xa1\x71\x23\x9d\x08\x06\x3d\xa9\x23\xd1\x1b\xdc\xb9" +
+	"\x60\xc9\x66\x60\xba\x0b\xad\xc5\xe2\x43\xc6\x70\x83\xdd\xc1\x34\x4f\xd2\xbe\x72" +
+	"\xd0\x6e\x60\xb9\x42\xf1\x42\x00\x23\x0a\xe6\xe9\x75\xc7\xa6\x6d\x04\x47\x5e\x9e" +
+	"\xe8\xe6\x99\x83\x6a\xa1\xe9\x80\x8c\x01\x2f\x94\x5c\xbd\x50\x4d\x42\x05\x2a\xd6" +
+	"\x78\xde\xb1\x5d\xc1\x8b\xe4\xf2\xef\x6d\x03\xdc\x91\x3b\x69\x55\x2a\x24\xc1\x2e" +
+	"\x48\xfb\x4a\x02\xd6\x20\x1d\xd9\x97\xda\xae\x33\x70\x39\x98\xf9\x06\x56\x36\xde" +
+	"\x36\x91\x3b\x1f\x37\xf8\x4b\x54\x4f\xbf\x9e\x73\xbb\x94\x5e\x48\x03\x32\x80\x6d" +
+	"\x7c\xf3\x36\xee\x44\xf4\xe1\xa9\x97\x05\x32\x7b\x87\x8c\xd3\x10\x7a\x38\x42\x1f" +
+	"\x4e\xdc\x82\x68\xe0\xf2\x3f\x8e\xe6\xf5\x27\xa9\xa1\x00\xc3\x14\x94\xd5\x97\x1c" +
+	"\x34\x19\x1a\xb0\xc4\x6b\x18\x78\x33\x25\x66\x6f\x3e\xef\x14\x2d\x98\x8f\xf4\x41" +
+	"\x50\x96\xad\x3f\x4f\x15\xfc\x0f\xaa\xac\xc3\xa8\x0d\xb4\x3f\x49\x1b\xea\xb8\x24" +
+	"\xa9\x91\xd5\x9f\x2f\x7a\x2b\xf5\xe7\x8b\xde\x4e\x59\xd8\xe8\xa4\xc4\x7f\x49\x02" +
+	"\xfc\xef\xa6\x5f\x64\x69\x0d\x94\x9e\x69\x75\xfa\xe7\xd0\xcf\x1b\x0c\x03\xdf\xe5" +
+	"\xe2\xbe\x65\x3e\xe5\x88\xc4\x2e\x9a\x09\xcf\xf5\x93\x2e\xd7\x25\xbb\x02\x17\x07" +
+	"\x5e\xea\x00\x97\x89\xd9\xae\xf0\x2f\x9c\xa1\x2f\x57\x90\x55\x40\x88\x71\xf6\x17" +
+	"\x17\x43\x5e\xc1\x6d\x9f\x03\x92\x2a\xe1\x0a\x79\x34\x62\x44\xa1\x22\x6e\x37\x68" +
+	"\x0e\x08\xe9\xbc\x0e\x06\xca\x2b\x94\x70\x86\x85\x59\x9c\x4a\x80\xa2\x7e\x8d\xc3" +
+	"\x26\x67\xb3\x79\x71\xd1\x65\xae\x3f\x39\x1d\x97\xc7\xc8\x51\x42\xf4\x0b\x11\x9a" +
+	"\x87\x02\x50\x69\x14\x4d\x29\xf0\x04\xf5\xa4\x2e\x41\x4a\x12\xc7\x5d\x78\xf8\x1e" +
+	"\xcc\x5a\x57\xc0\x5a\xe2\xef\x6f\x12\xfa\x12\x06\xbb\xb3\x57\xb8\x5a\x7a\xe9\xe9" +
+	"\xcb\x75\x55\x78\x7a\xa0\xc9\x0e\xb0\xfb\xb3\x40\x9b\x93\xfa\x23\x6a\x1a\xed\xa0" +
+	"\x17\x4d\x0b\x60\x9b\x41\x42\x5f\x98\x6b\x47\xe9\x46\xbf\x16\xc2\x89\x0d\x67\x47" +
+	"\x8c\x37\x42\xac\x79\xe5\xd6\x59\xcd\x08\x24\x8f\xb8\x27\xd8\xea\x2b\x34\x6e\xc9" +
+	"\x84\x20\x30\x13\x15\xcb\x72\x85\xb6\x55\x2a\xb1\x21\x62\xbc\x42\x53\x56\x58\x1c" +
+	"\x52\x8a\xed\x85\x5a\xdf\x85\xed\x2e\xc5\x74\xc6\xae\x1a\x6f\x88\x3e\x2f\xd6\x3d" +
+	"\x4a\x7b\xe7\xaa\x72\x68\x49\x17\xcf\xea\x25\x6e\xa2\xf7\xf2\xbb\xe3\x44\xd5\x8c" +
+	"\x41\x59\xfe\x75\x1f\xc9\xf5\x77\x09\x23\x08\x77\xdf\x92\x75\xe0\x2e\x7a\xdf\xbb" +
+	"\xac\xef\x00\xb8\x53\x8f\x34\xb1\x81\xf5\x30\x3f\x16\x8d\xb1\x55\x97\x8b\xfe\x8c" +
+	"\x5a\x23\xef\x9d\x8e\x26\x80\x76\x8d\xc0\x87\xae\x76\x61\x24\x9d\x47\x26\x7a\x91" +
+	"\x2c\x62\xad\xa1\x8f\xaa\x88\x06\x0d\x46\x80\x7e\x0d\x25\xda\xb1\x62\x69\xec\x65" +
+	"\x48\x0e\x26\x23\xbc\xfe\x64\x84\x3a\xaa\x79\x85\x61\x7c\xf7\xad\x66\x04\xad\xcb" +
+	"\xf5\x27\x33\x14\xaa\x72\xe7\x26\x17\x11\x64\xd8\x8e\xe8\xf7\x57\x98\xd6\xe2\xde" +
+	"\xd1\xeb\xee\xed\x8f\xa6\x86\x79\x85\x77\xd2\x4e\x10\x40\x65\x21\x11\x05\x8d\xd5" +
+	"\xba\xe0\x57\x6c\xd1\x15\xbe\xeb\x81\x8d\xf1\xcb\xe4\x87\x57\xc0\xaa\x12\xbf\x04" +
+	"\xf6\xf5\x4f\x3c\x86\xaf\xe9\xec\x5c\xe1\x2b\x13\x3a\x57\xd4\x22\x4c\xe4\xbb\x5c" +
+	"\x71\xa5\x39\x60\x62\x4b\x64\xca\x9a\xc0\x15\xef\x71\x22\x6e\x7e\xc4\x0b\x4d\x2a" +
+	"\x2d\x0d\x00\x1c\x82\xe0\x9d\xaf\xaf\xaf\xb8\x1b\xcf\x7b\xc9\xe6\x35\x01\x65\xf5" +
+	"\x77\x36\xd3\xaf\x98\xdf\xf4\x80\xe8\x27\xc6\x05\x06\x31\x97\x95\x96\xee\xb6\xc5" +
+	"\x6f\x05\xad\x2b\x16\x2e\xcb\x53\xa8\xc5\xd4\x71\xae\x58\x8a\x7b\x41\xe1\xa1\x2b" +
+	"\xb0\xa2\x10\x60\x73\xaf\x58\xac\xbd\xb0\x43\xb2\xff\x01\x84\x82\x57\xbc\x96\x8b" +
+	"\x5b\xc2\xd4\x8e\x00\x0a\x06\xbe\x04\x67\x74\x8a\xe4\x4e\xc0\x0a\x49\xaa\x61\xb4" +
+	"\x20\xe6\xfb\x95\x95\x38\xea\x62\xec\xce\x5e\x1c\x41\x5b\xd1\x3c\x12\x52\x00\x5c" +
+	"\x5c\xd4\x1c\xc8\xa7\x0b\x4e\x40\x7a\x26\x05\xae\x8e\x78\xc5\x76\x45\x49\x39\x40" +
+	"\x39\xfe\x58\x2c\x3d\xae\x1a\x5f\x40\x30\x20\x72\xc3\x7e\xea\xf6\x87\x88\xa1\x2b" +
+	"\xbc\x5e\xf9\xc1\xbc\x44\x3f\xff\x10\x19\xd2\x94\x01\x31\xed\xd7\x4b\x56\x06\xae" +
+	"\x38\x34\x82\xc4\xbb\xd3\x72\x9c\x6f\xb9\x22\xd7\x0c\x21\x16\xba\xd8\xbc\x21\x9a" +
+	"\x81\xd9\xaf\x40\x38\xf7\x42\x14\x44\x84\x63\x96\x14\x16\xec\x08\x8d\x7a\x2e\xd7" +
+	"\x51\x1f\x7a\xac\xb1\xa4\x1f\x68\x31\x8b\x78\x20\x16\x49\xa2\x56\x02\x07\xa2\xf3" +
+	"\xeb\xc0\xb0\x9d\xca\xf8\xa8\xa3\x2e\xd7\x81\xa1\x20\x23\xaa\xeb\xcc\x2e\xd8\x66" +
+	"\x14\x5f\x9c\x99\x63\x5f\x12\x46\xe4\xc5\xa3\xcc\x13\x43\x07\x4f\x9d\x61\x1f\xce" +
+	"\xdb\x1c\xc9\x2e\x54\xb9\xd0\x29\xfa\x76\xea\xd9\xc3\x1e\xa9\x90\x4d\xc4\x4d\xc5" +
+	"\x7d\xfa\x97\xe3\xcb\x12\xf0\xca\x5c\x63\xfa\xc8\xf1\x0f\x29\x66\xef\x6a\xf9\xee" +
+	"\x6b\xb0\xe9\xfa\xea\x8b\xe8\x11\xca\x61\x99\x4b\x2e\xb6\x2c\x00\x79\x9c\x5f\x6d" +
+	"\xd1\x02\x7c\xc6\x16\xb2\xbc\x7d\x39\xbd\xc0\x2b\xd6\x65\x50\x89\xe0\x00\x9d\xce" +
+	"\x92\xc3\x17\x9e\xdb\x82\x11\x4b\x72\xe5\xf0\x4b\x8e\x5d\x27\x1c\x80\x3f\xe9\x5b" +
+	"\x5f\xac\xaa\x64\x2e\x52\x88\x94\x47\xee\xd1\x93\xff\x9f\xb4\x67\xe6\x55\xdf\xb8" +
+	"\x47\x67\x71\xb9\x72\x25\xcd\x8c\x4f\xf0\xad\x18\x60\x5b\x2e\x36\x76\xf9\x25\x52" +
+	"\x53\x91\x80\x1a\x1f\x90\x25\x66\xbc\xb2\x4a\x8a\x3b\x62\x58\x10\x5e\xf9\x2b\xf5" +
+	"\xff\x4b\xf6\xd1\x2e\xa1\x78\xf2\x33\x96\xab\xee\x75\x91\x01\x33\x2a\x44\xb4\x19" +
+	"\x55\x78\x00\xeb\xbd\xa0\x2f\x84\xc6\x50\xa5\xca\xc8\xfa\x03\xcf\x36\x6b\x69\xf3" +
+	"\x01\x3d\x4a\x18\x10\xc8\x57\x84\xd0\x65\x15\x0f\x32\xd8\xd8\xbe\x6a\x66\xb6\xe3" +
+	"\xf8\x26\x22\x7f\xef\x08\x35\x7f\x35\x0e\x40\x6d\x96\x66\x1d\x4f\xd5\x73\x71\x1b" +
+	"\x5e\x0b\x09\xa4\x85\xd6\x8b\x5b\xab\xa0\xc4\x84\xad\x20\x88\xc6\xe8\x8a\x64\xf0" +
+	"\xaa\x57\x97\x5d\x81\x8b\x85\xac\x18\x22\x81\xe6\x94\x9a\xad\x0d\x31\x04\xec\x9a" +
+	"\x66\x11\x53\xbf\xbe\x74\x93\x15\x15\x85\xa8\x5d\x2d\x0a\x90\xf5\xfc\xf4\xcc\xb4" +
+	"\xa1\x1d\x48\x80\x7a\x68\xb5\x69\xb5\x36\x2d\xdb\x16\x96\xda\x44\xa4\x1c\x4b\xa9" +
+	"\x89\xe5\xb8\x54\x4a\x1c\x7c\x05\xa8\x97\xda\xb5\x70\xc6\x1e\xaf\x22\xc1\xf2\xe5" +
+	"\x8f\xa8\x75\x31\x3b\x09\xce\xb3\xf2\xd6\xbd\x4e\xfa\x28\xa1\xfb\x07\x1a\xdd\x6b" +
+	"\x1f\x1f\x15\x02\x2b\xf3\xcb\x16\xe5\x22\xe6\x9f\xb5\xf7\xe5\xc6\xd9\xaf\x3a\xa9" +
+	"\xf4\xcf\x66\x6c\x9f\x80\xb9\x83\x48\x1a\x6b\x87\x8b\x93\x9e\x9f\xe5\x82\x6d\x6a" +
+	"\x21\x59\x36\xcc\xba\xc0\xd5\xfc\xac\x2b\x40\xf5\x0f\x55\x05\x03\x2c\xa9\x98\x14" +
+	"\xd0\x1b\x9b\xd6\xb4\x08\x96\x3b\xe0\x7f\x4e\x5f\xb9\x48\x74\x28\x7d\x59\x2c\x07" +
+	"\xdd\x8a\xb3\x46\x55\xc2\x2d\xae\x1a\xb1\x1a\xe3\x2e\x9f\x68\xe7\x58\x20\x3e\x59" +
+	"\x3b\x83\x92\x7d\x8b\xa5\x1f\x51\x06\xbb\x16\xdd\x14\x06\x0e\xb6\x89\x7d\x61\xfa" +
+	"\x9a\x6e\xda\xe2\x8e\xd5\x93\xef\x8d\xf4\x35\x8b\x3a\x8a\xa5\x45\x57\x42\x12\xfa" +
+	"\xc8\x81\xbd\x81\xab\xc5\x33\x69\xc4\x07\x88\x5d\x04\x50\x97\x68\x08\xa8\xa9\xb4" +
+	"\xe8\x92\xad\xab\x45\x33\xfc\x7d\xb5\x78\x85\xa4\x60\x59\xb5\x41\x32\x13\x78\x8b" +
+	"\x6a\xad\x5c\x5f\x75\x60\xd0\x02\x11\xcc\xda\xd2\x43\xc1\xf7\x28\x4b\x09\x42\x4a" +
+	"\x5b\x3f\x18\x01\x5a\xa4\x95\x31\x23\xb6\xd8\x11\x32\xf2\x46\xc9\xe0\x16\x3c\xa7" +
+	"\x5e\xc2\x32\x35\x23\x2a\x75\xb3\x1b\x35\x47\x74\x64\x9c\x7e\x14\x98\x26\x8f\x16" +
+	"\xc7\x6f\x54\x42\xde\x21\x39\xcd\x1e\xdb\xfb\xa6\x2c\x9d\xf0\x21\x59\xdf\xd5\x50" +
+	"\x17\xc1\x9d\x43\x59\x8a\x25\x71\x7f\x07\x1f\x23\xfe\xbf\x38\x2d\xa9\x07\x36\xaa" +
+	"\xe9\x28\x38\x34\xa7\xf4\xc4\x96\x50\x9f\xc2\xc3\xc5\x43\x91\x1d\x32\x00\xc9\x42" +
+	"\x21\xf3\x6a\xe2\x23\x9b\x8d\x39\x2d\xb1\xa1\x85\x1b\xf3\x1e\xcf\x6f\x74\x4d\x44" +
+	"\x87\x3f\xeb\x9e\x88\xab\xd9\x6f\xc5\x88\x06\xac\x44\xab\x0f\x1b\x59\x0d\x7c\x0d" +
+	"\x18\x6f\xdc\xaa\x4a\xbd\x3e\xac\xe8\xea\x23\x49\x2c\x0e\xc2\x66\xe7\xd5\xea\x1a" +
+	"\x37\xf5\x35\x90\x12\x58\xd7\x1c\x92\xbd\x6a\x34\x1b\x21\x63\x2e\xb8\xfd\x6e\x57" +
+	"\x5b\x00\x28\x22\xdd\xfb\x62\x94\x7c\xd5\x27\xbb\x44\x46\xc5\xac\xb6\xca\x35\x91" +
+	"\x2a\x30\x21\xe5\xbb\x3e\x93\xa5\x17\xe3\x20\xf6\x8a\x5b\xd6\xe5\x7d\xe7\x96\x0e" +
+	"\x68\x91\x80\x0e\x24\xee\x09\xea\x09\x44\xae\x6c\xe1\xd8\x26\xab\x40\x41\xcb\x07" +
+	"\x15\xf4\x58\x50\x12\x00\x99\xb2\x3f\x48\xc6\x52\x0c\x78\xb1\x9c\xd7\xed\x74\x69" +
+	"\x98\x6b\x62\x4b\x5b\xb5\x62\x2a\x3e\xd6\x70\xd5\x64\xc5\x81\x03\xd7\x7a\x53\x9f" +
+	"\x98\xd6\x36\xa4\xc4\x88\xbd\x54\x48\x1c\xf1\xf8\x3a\xb0\xac\xbb\x9c\x12\x27\x99" +
+	"\xae\x37\xa0\x70\x2f\x7d\xdc\xbc\x7c\xfd\x5e\x0c\x21\x76\x49\x00\x96\xce\x51\xc7" +
+	"\xf7\x72\x87\xdf\xaa\xde\x55\xcd\x2e\x3d\x7e\x64\x66\xec\x6a\xdc\xaa\x8b\x62\xfd" +
+	"\xdd\x0c\xd0\xb6\xe2\x59\xdd\xa4\x9a\x1d\x7a\x07\xcd\x81\x67\xe7\x9d\x3c\x9f\xef" +
+	"\xdb\x01\x75\x5d\x9e\x7d\xf6\x03\x65\x2f\x9b\x8f\x97\xff\xc7\x33\x8c\x5a\xb8\xbc" +
+	"\xa0\x4e\x60\x39\xfe\xd1\xef\x6f\x58\x97\xcb\xd3\x36\x37\xbb\xe3\xfd\x6a\x53\xa1" +
+	"\x4c\x5b\x39\xd3\x53\xfa\x77\xe5\xec\xf9\x85\x9d\x75\x27\x52\x68\xbd\xa6\x34\x08" +
+	"\xae\x19\x1f\xcc\xa5\x73\x5f\x2e\x84\x5a\xd7\xcc\xa7\xdd\xe1\x78\x71\xb6\x96\xd3" +
+	"\x02\x2a\xbe\x99\xe9\xf1\x33\xa3\xdd\x40\xcf\x98\x70\x66\xf3\x44\x42\x74\xcd\xf3" +
+	"\xa4\xdf\xdb\x98\x27\xad\x68\xaa\xc1\x80\xbe\x05\x69\xc6\xe0\xd8\x11\x75\x35\xdd" +
+	"\x06\xd4\xc5\x81\xa3\x6b\x5e\x0c\x17\xb6\xd5\x79\x4d\x0d\x6b\xe8\x72\x89\x73\x9c" +
+	"\x8d\xf4\xc1\x75\x80\x2c\x10\x29\x60\x40\x8c\xf3\x9c\x4d\xbb\x6d\xfa\x8a\x09\x71" +
+	"\xa2\xf9\x42\x76\xdc\x4a\x81\x80\xde\x8a\x53\x63\x97\x83\x2a\x86\xca\x95\x4d\x5d" +
+	"\x11\xcd\x43\x13\x0b\xf7\x1e\x41\xe3\xb5\xa5\xa2\x4f\xab\x77\x21\x65\xa1\xf5\x99" +
+	"\x44\xdf\xf1\x4b\xb3\x9a\xb2\x02\x85\x4b\x3f\xec\x3e\x79\x90\xe2\xbf\x77\x61\x71" +
+	"\xb0\xa4\xf5\xc3\x96\xb5\xb3\x1f\x57\x22\x31\xfd\x98\x94\x36\x9b\xb3\xd7\x34\xcd" +
+	"\xce\x6b\x0e\x42\xd3\xde\x90\x88\x3e\xfd\xb2\xa2\xdd\x96\xeb\xdb\x50\x86\x86\x3b" +
+	"\xff\x4a\x67\xe8\xbf\x19\xd6\xd7\xf2\xdf\x0c\x9b\x4e\xe3\xff\x37\x19\x83\xf9\x95" +
+	"\x28\xfe\xbf\xc9\xf2\x9e\x5f\x02\xc1\x30\x28\x65\xb3\xfd\xa1\xe6\xe8\x6b\x74\x18" +
+	"\x3d\xf9\x2d\x63\x8a\x36\xf4\x32\x44\xf5\x92\xf3\x2d\x71\xbb\x51\xfe\x73\xeb\x0e" +
+	"\xc6\x22\x2b\x3a\x07\xa4\xf1\x16\x0d\x6b\x92\x99\xd6\x57\xf6\x67\xd5\x9f\x5c\x3a" +
+	"\xd2\x53\x43\x08\xf5\xfe\x9b\x49\x01\x20\x0a\xe5\x57\xb8\x8c\xe8\x14\x13\xec\xa0" +
+	"\x46\x86\x93\x1c\x29\xcd\xff\x66\xfa\xfd\xd5\x5e\xec\x7f\xb3\x1a\x0b\x26\xa0\x51" +
+	"\x1a\x14\x97\x16\x10\x4d\x63\x68\x61\xc1\xc6\x42\xd9\x45\xfa\x09\x79\xf1\x83\x46" +
+	"\x08\x46\x17\xda\x82\x65\x85\x90\x8d\xd0\x7f\x0d\x50\x98\x42\x30\x57\x06\x08\xbd" +
+	"\xdd\xd6\x3e\x00\x55\x1f\xf5\x63\xc1\x02\x43\xe6\xcb\xe7\x58\x24\xdb\xe7\x0e\x03" +
+	"\x7d\xa7\x16\xda\xc2\xfe\x41\x1d\xb4\x05\x8d\x19\x2d\xa4\xbc\x2a\xe2\xa4\x3e\x0f" +
+	"\x6d\x08\x78\x01\xc8\x74\x8c\x99\x5a\x90\x3f\xf1\x63\x7e\xd0\xe9\x68\x98\xc3\x21" +
+	"\x1e\xd7\x3a\x68\x9c\x23\x6d\xe1\x6c\x95\x8f\xe9\x96\xfc\x9a\xce\x08\x36\x1d\x2a" +
+	"\xf9\xd5\x58\x2e\xd2\x44\x2c\x38\x29\xac\x36\x37\x82\xd6\x42\x91\xa6\x3c\x2d\xca" +
+	"\x9f\x47\x5f\x5a\x68\xc4\xa5\xee\xd3\x02\x36\xda\x96\xc6\x01\xde\x16\xec\xd2\xca" +
+	"\x16\xde\x96\x57\xb6\x09\x30\xc9\x50\xf4\xe0\xaf\x52\xcd\x49\x8f\xd6\x15\x5a\x0c" +
+	"\x8f\x5e\xdb\x63\x11\x9b\xa7\x5f\x2a\x36\x0a\xa9\xe2\x0c\xbc\xdd\x85\x22\x8e\xe1" +
+	"\xa9\x1b\x8c\x61\xff\x78\x2a\x26\x69\x6b\x31\x5c\x57\xa4\x00\x01\x14\x0b\x94\xa5" +
+	"\x87\x21\xc6\x6a\x10\x26\x6a\x49\x5f\x0c\x26\xc9\x45\x22\x69\xf1\xf4\x9e\x76\x07" +
+	"\x4c\xd7\xcc\xcc\xc3\x53\xf5\x51\xa1\xc7\x47\x30\x25\xfc\x66\x37\xd9\xb5\xf8\x88" +
+	"\x79\x69\xf1\x91\xda\x38\xa0\xc8\xb9\x21\xad\x88\x30\xf1\xb6\xf8\x40\x26\x0d\x49" +
+	"\x79\x73\x2a\x5f\x53\x1a\x6a\x80\x31\x04\xd0\x25\x15\x51\x92\xd7\x70\x31\x6c\x0a" +
+	"\x75\x39\xc4\x8d\x98\x25\xd3\x80\xf4\xce\x13\x3a\xdf\xb4\x1e\xae\xa2\x4b\xfe\x2a" +
+	"\x79\x78\x88\x03\xdc\x06\x97\xfc\x8a\xe9\x80\x72\x4a\x4a\x0e\x76\x82\x07\xc4\xba" +
+	"\xd6\x68\x52\x39\xe9\x0e\x75\x15\xab\xae\x47\x87\x8f\xce\x28\x1a\xc8\x01\xd9\x22" +
+	"\x14\x89\x8e\x79\x29\x5e\x25\xf7\x25\xc6\xb8\x5d\xd4\xc1\x8a\xb4\x0e\x82\xbc\x53" +
+	"\xf4\x92\xeb\x9b\x15\x43\x8b\xce\xff\x70\x77\x90\xa5\x19\xdd\x13\x3a\x0d\x12\x2a" +
+	"\x8d\x51\xbc\x42\xc2\xdd\x22\x77\x47\x9a\x03\xa0\x39\xb0\x0c\x98\xa9\xf3\x85\x4d" +
+	"\x55\x26\x6e\x28\xcc\x87\xa8\x22\x8a\x3f\xe8\x8a\x10\xf0\xd3\x37\x48\x85\xe2\x4d" +
+	"\xad\x5a\x75\x92\x02\x52\xbc\x9e\x59\x23\x6c\x4e\x15\x8e\x5f\xa3\x2d\x17\x66\x0c" +
+	"\xd6\x09\x26\x43\x72\xa4\xa2\x79\x72\x49\x04\x0e\x53\xa4\xae\x93\x0a\x78\xb2\xaf" +
+	"\xd1\xee\x1b\xec\x04\xb8\x60\xe5\x6f\x81\xe1\xa0\x39\x60\xbe\x6a\x5c\x73\xb7\x3a" +
+	"\xa5\xf7\x47\xac\x1b\xa8\x5e\xcc\x2c\x19\x1e\xac\x98\x9e\x6e\x9b\x03\xa4\x86\xf7" +
+	"\x9c\xed\xe1\x49\x9c\xad\xdc\x08\x26\x09\x17\xd5\xb7\x5f\xeb\xcd\xf3\xb8\x0b\x05" +
+	"\xf9\x14\x2b\x1d\xc5\x86\x21\xb0\x60\x54\x5d\x6a\xdf\x83\xde\x22\xae\x80\xa8\xe7" +
+	"\x70\xf1\x81\x0a\x1b\xd0\xc2\x1f\xd2\x07\x5a\x63\x88\xa2\x6e\x2d\x17\xa4\x29\xf7" +
+	"\xe1\x41\xa3\x16\xa5\x6b\x2b\xb6\x78\x04\x46\x04\x56\x54\x10\x1b\x0f\xfc\x14\xf0" +
+	"\xd2\x62\xc2\x0a\x86\x92\xcf\x71\x40\xd1\x11\xfd\xdd\xbf\x0a\x4f\x62\xd4\x5a\x06" +
+	"\xc7\xd4\x96\x16\xfd\x34\x12\x6b\x32\xcc\x21\x19\x35\x1f\xf7\x86\x47\xf3\x3b\x0b" +
+	"\x44\x6d\xec\x01\xa8\x3b\xe4\x40\x45\xb2\xf1\xd6\x22\x8a\x27\x7a\xc9\xa5\x14\xa2" +
+	"\x3e\x3e\x46\x97\xbf\x38\xf8\x1a\xb0\x86\x04\xa0\x1a\x61\xc3\xf8\x8d\x16\xac\xdb" +
+	"\x8b\xc1\x85\x1d\x31\xa8\xea\x29\x7b\xb4\x9a\x00\xa2\x5f\x25\x25\xf0\xc2\x79\xae" +
+	"\xc3\x83\x23\x12\x44\x4f\x22\xed\x65\x44\x2d\xd5\xb6\xa2\xb9\xf9\xd8\x66\x32\x80" +
+	"\xdc\xa3\x76\xa4\x59\x7c\x4a\x02\x64\xc0\xea\xe6\x56\x71\x00\x91\xe1\x53\xab\xdb" +
+	"\x16\x39\xc2\x6b\x5d\xee\xb4\x36\x6c\x0a\x86\x50\xa5\xeb\x56\xbd\xc3\x81\x9d\x62" +
+	"\x51\xb5\x4a\xa8\x7d\xe7\x19\x95\x26\x50\xd3\x62\x52\x01\x16\x97\x22\xe0\x94\x7c" +
+	"\xc8\x47\xbb\x49\x83\x5d\x89\x1f\x0f\xd7\xd0\xd7\x11\x31\x94\xf8\x51\x0c\xbe\x90" +
+	"\x00\x48\x11\xd1\x81\x1a\x92\xab\x1a\x36\x44\xc5\xfa\xc6\xea\xd7\xc7\x06\x5f\x6c" +
+	"\xdb\xaa\x1b\xef\xd2\xa0\xb8\x97\xde\xac\xbc\xd7\x97\xe6\x32\x21\x4b\xe4\x05\x4b" +
+	"\x65\xd4\xbc\xa8\x9e\x5c\x08\xcf\xb3\x89\xe1\xb9\x63\x5a\xe5\x70\x45\x6f\x53\x02" +
+	"\xfa\xc4\xdf\x23\x15\xd5\xb7\xda\x77\x13\x25\x7b\x9c\x3c\xa6\xc7\x5d\xea\x6f\x7e" +
+	"\x92\xb8\x45\xd8\x52\x3d\xa3\x9f\xcb\x60\x82\x04\x07\xe2\x5a\x18\xa0\x74\xc2\x47" +
+	"\x09\x59\x14\xd2\x39\xe5\x59\xd2\x3b\x01\x9f\x37\xee\x65\xa5\xdc\xb4\xfa\x6b\xf1" +
+	"\x52\xb0\xb2\x24\x67\x94\x93\x06\x2d\x5e\x73\xf7\x56\x70\x5b\x7a\x76\x64\x39\x37" +
+	"\x8e\x59\xe8\x2e\xec\x6b\x0e\xaf\xab\xff\x26\x4a\x6f\x50\xeb\xc4\xb7\x85\xe8\x86" +
+	"\x52\x95\xea\xaa\x4d\xc2\xeb\xa8\xba\x42\x38\x8c\xcd\xf3\x3a\x95\xeb\x6e\x85\xd8" +
+	"\xa3\x36\x5c\x90\x67\xf8\x1c\xd4\x6d\x49\x88\x8b\x31\x3f\xb1\x47\xe5\x1d\x63\xef" +
+	"\x22\x44\xdb\x0f\x34\x01\xa1\x36\x33\xf7\x03\xb3\x45\x72\x71\xe3\xe8\x37\xfa\xde" +
+	"\x48\xde\xd2\x86\xe0\xde\x80\xf9\xd6\x55\x8f\x80\xec\x93\x6c\xf7\x5b\xce\x70\xeb" +
+	"\xde\xfd\x3b\x52\x03\x64\x2b\xd8\x35\xc3\x0f\xb6\xd5\x95\xa8\xea\x25\xd8\xb9\x9b" +
+	"\xcb\xfc\x34\xc5\x64\x1a\x19\x2d\xf6\xcb\xf3\x35\x1f\x68\x17\x21\x8a\xc9\x46\xd4" +
+	"\x5b\xfa\x74\x96\xa6\xcf\x26\x31\x15\x6f\x1a\x53\xe3\xd2\xa2\xee\x42\x10\xb1\x69" +
+	"\x7d\xb8\xf5\x45\x90\x06\x06\x94\x80\x2d\xda\xfb\x4e\xc8\x16\x11\x3d\x33\x5a\x0c" +
+	"\x0c\xfa\x8a\xb2\xba\x32\x1a\x8d\x7a\x18\xd6\xe1\x07\x52\x26\x88\xb6\x08\x01\x08" +
+	"\x0c\x5b\x64\xa1\x1c\x9d\x0e\xa3\x1a\x6f\x87\xd6\xf0\x3c\xdb\x28\x3e\x9c\xdb\x19" +
+	"\x98\xc8\xfa\x1f\xb2\x04\x6b\xbc\xd2\x96\x7b\x8b\xc3\x7b\x92\xd6\xe4\x2d\x4e\x5d" +
+	"\x72\x26\xaa\x1c\xb8\xe9\xf7\x16\x8d\x61\x79\x5b\x03\x79\xfb\xe8\xf4\x66\x8f\xd1" +
+	"\xe8\xb4\xd7\xe2\x2d\xd9\x69\xd9\x9c\xda\x70\x2c\xe4\x4e\xdd\x83\x68\x1e\x62\xf3" +
+	"\x79\xe1\xed\x36\x0d\xe4\x46\x1e\xde\xe9\x8e\xfc\x6d\x1d\xcb\x2f\x75\x30\x60\x3e" +
+	"\xee\x71\x10\xa4\x60\x65\x3a\x50\xd4\x43\xad\xeb\xed\x05\x39\x00\x0e\xaa\xe9\x37" +
+	"\x8d\xa5\x39\x30\xaf\xd2\xc4\x81\x68\x4a\xf8\x70\x00\xa5\xc5\x8f\xf5\xa4\x8f\xf1" +
+	"\x55\xda\xc8\x6c\xf1\xd3\xc2\x85\x17\x69\x24\xb4\x83\x7b\x25\x5a\x7a\x3c\x48\x5b" +
+	"\x7a\xac\x1a\xc3\x34\x2f\x26\xee\xd1\x5a\xb8\x61\x83\xd6\x94\x56\x3b\x11\xc5\x89" +
+	"\x05\x4c\x4c\x37\x9d\x83\x09\x4b\x4b\x1b\x57\x65\xe3\x7f\xc3\x36\xa6\x74\x3c\x9a" +
+	"\x09\x34\x5a\xc2\x36\xb0\xe8\x58\x5a\xd2\xc9\x47\x68\xda\xf8\x65\xd0\x4d\x66\x06" +
+	"\xb2\x61\x14\x02\x47\x52\xad\xb6\xc6\xf1\x37\xa0\x15\x6f\xa2\x47\x26\xc4\xf1\xbc" +
+	"\xbf\xa2\xa7\xc4\xed\x25\xdd\x77\x96\x41\x29\xac\xe4\xf6\xe3\x1a\x97\xf3\x28\x35" +
+	"\x0a\xcb\x83\xea\x84\xde\x2b\x77\x04\xb2\xbc\xe7\x2c\x4b\x93\x5d\x43\x24\x7a\x79" +
+	"\x69\xd4\xc1\x83\x2d\x26\x43\x34\x60\x01\xb4\x8b\xe4\x44\xd7\x97\x9b\x26\x06\xa8" +
+	"\x02\xae\x01\xf9\x62\xab\xb0\x8e\x7a\x64\x30\xab\x0f\x49\xfa\x24\xf6\x6b\xf5\x41" +
+	"\x5c\xf5\x81\xc8\xaf\x03\xa6\xbc\xac\x2f\x99\x38\x69\x76\x4e\x43\xc4\x9c\x65\xa8" +
+	"\x10\x60\x22\x01\x43\x04\xcd\x3d\x66\x34\x07\x8e\xdc\xeb\x84\x2d\x4e\x5c\x04\x2e" +
+	"\xa7\xef\xd2\x24\x86\xd3\xaa\x7f\x69\x95\x05\x1e\x5b\x18\xad\x5a\x29\x99\x98\xbf" +
+	"\xd5\x8e\x47\x1d\x7d\x86\xd8\xab\x81\x5e\xf9\xd2\xea\xd4\xc1\x50\x80\xed\xbc\x1a" +
+	"\x50\x72\xa6\x1f\xc9\x96\x13\xcc\x19\x54\xf6\x1a\x19\xa6\xc5\x36\xbb\x2a\x1c\xab" +
+	"\x28\x22\xb2\xc9\x5c\xd7\x59\x36\xbb\xad\x4d\xa4\x89\xe8\xdd\xd4\xb6\x73\x9b\x0f" +
+	"\x49\x33\xda\x7c\x7c\x97\x36\xd7\x17\x8c\xf0\xdc\x34\xbf\x4e\x7c\x19\x7f\x8d\xca" +
+	"\x90\x9b\x92\xfb\xb3\x24\xd7\xe6\xc9\x31\xb8\xa5\x61\xe1\x66\x69\x1c\x08\x24\x59" +
+	"\x20\x0f\x52\xbb\x85\x6d\x16\x6b\x31\x13\x25\x70\x59\x99\x6b\xb3\x68\xc1\x3c\x7d" +
+	"\x05\xa5\x8d\xad\xa6\x01\x03\x9b\x33\x58\xb1\x5d\xda\x1c\x4b\x0f\x0f\xd9\x7c\xee" +
+	"\xe1\x61\xe6\xa0\x7b\x58\x51\xd7\x28\xbc\x5a\x19\x32\x20\xe9\x49\xd7\xe9\x28\xd8" +
+	"\x14\x91\x1c\x1f\x61\x77\x84\x17\xdf\x3b\xef\x32\xf4\xd9\x83\x15\x65\x0f\xcf\xd0" +
+	"\xd2\xd2\xc3\x7d\x16\x16\x64\xdb\x87\x20\xf9\x7c\xc6\xdb\xeb\x13\x55\xa0\x1e\xf6" +
+	"\xb0\x70\x1a\x8a\x1f\xf5\x2b\x28\x1e\x13\x9f\xc3\x77\x74\x38\xbd\xce\x19\x2d\xfd" +
+	"\x9e\x44\x80\x9d\x50\x25\x35\x23\xb7\xeb\x41\xfa\xa6\x3d\xa0\x4d\xcc\xaf\x91\x4e" +
+	"\xc8\x99\x00\xf2\x1c\xee\x8f\x49\xaa\x63\x54\x77\xbf\xa9\xc5\x99\x91\x82\xeb\xb3" +
+	"\x93\x17\x9a\x92\x45\xec\xb5\x1b\x40\x30\x30\xbe\x06\xa4\x8e\xdc\xd1\xeb\xc9\x38" +
+	"\x6c\x56\x42\x18\x9d\x2b\x2f\x07\xcd\x28\xfb\xb8\x86\xea\x8f\xa8\xd2\xce\x21\xf6" +
+	"\x7e\x08\x78\x2c\x1b\x4a\xbd\x50\x53\xda\x11\x4a\xe6\x62\xba\x13\x8e\xda\x29\x37" +
+	"\xb5\x56\x80\x12\x94\x3c\x8f\x98\xc5\x41\x8b\xbe\x7b\x32\x06\xc5\x70\x67\xe4\x36" +
+	"\xbc\xd6\x65\x78\xad\x87\xb6\x86\x7e\x3a\x45\x82\xdf\x39\x5e\xa7\xb2\x54\xca\x3a" +
+	"\x57\xe7\xac\x42\x1d\xef\x98\x1b\x20\xb3\x03\x5e\x1f\x5f\xa8\x54\xf0\xab\x53\xa1" +
+	"\x7a\xee\x5a\xc1\x1a\xd2\x27\x53\xcb\x14\x01\xf4\x38\x7a\x98\x6e\x84\x02\xa4\xe7" +
+	"\x42\x0a\xbd\x4a\xde\xa1\xd8\xa3\x02\xa1\xcb\x91\xd8\x37\x1b\x1b\x1d\xcb\xee\x4b" +
+	"\x0f\x3f\x95\x4d\x78\x62\xff\x2e\x7d\x0d\xea\x89\xa2\x7a\xcc\x9b\x3d\x66\x75\x78" +
+	"\x40\x94\xf3\xfd\x24\xd5\x13\x81\x7a\xe9\xc5\xe5\x6f\x4e\x7e\xac\x32\x57\x8e\x34" +
+	"\x36\xa3\xfe\x96\x86\xc8\x21\x26\xac\xb2\x82\x60\x61\x44\xd4\x1c\x38\xf1\x8a\x72" +
+	"\xa6\x21\xc5\xdf\x9e\xfa\x4d\xb4\x7a\xa8\x65\x79\x75\x33\x29\xbc\xd2\x0b\xcd\xde" +
+	"\x44\x15\xd9\xbf\xef\xdc\x75\xcd\xc0\x00\x38\x75\xad\x85\x90\x3b\x58\x5a\x0e\xbf" +
+	"\xcb\xa0\xaf\x07\x47\x3b\x3a\xef\xb4\x9b\xdc\xb9\xf2\x4e\xe9\x4a\x7e\x47\x79\x5f" +
+	"\x2d\xb7\xec\xca\x73\x39\xb0\x97\x89\x4a\x6f\xe5\x3a\xcb\xbe\x56\x6d\x62\x98\xda" +
+	"\x07\xba\xb6\xd4\x36\x2a\xb7\xd2\x7a\xc0\x21\x6a\x73\x55\xc8\x89\xe6\x3a\x21\x12" +
+	"\x5e\x3d\x43\xec\x31\xf3\x7b\x79\x94\xac\xc4\x74\x0f\xf1\x86\x18\x15\xe4\x01\xcc" +
+	"\xfb\x03\x1b\x5e\x05\xc8\x84\x0b\xfe\xfb\xda\x82\xc6\x51\x80\x8c\x17\x03\x18\x27" +
+	"\xf9\xda\x50\x13\x22\xf9\xcd\xda\xe7\xda\xc2\xa7\x50\xa0\x48\x3f\x20\xe9\x0e\x82" +
+	"\xfd\x41\x11\x0f\x3b\xd9\x08\x0b\xba\x28\x20\x29\x0c\x41\x2a\xed\x6f\x65\x3a\xd8" +
+	"\x55\x20\x3e\xc6\x3b\x30\x97\xe7\x53\x71\x48\xee\x0f\xd5\xf1\x9e\xbe\xce\x47\x58" +
+	"\xfa\x8a\xcc\x8b\x53\x49\x17\xbb\xad\x06\x18\x8a\xfb\x3a\xdb\x9b\xcf\xa6\x0e\xaf" +
+	"\x77\x04\x4e\x6a\xa3\xf1\xbe\x0f\x48\x4e\xe2\xaa\x3a\x02\x26\xbd\x42\xd0\x63\x74" +
+	"\x40\xfc\x88\x0e\x88\xc0\xfd\x8c\x22\x9a\x35\x5a\x0f\x0b\xa8\x25\xc6\x2d\x94\xa5" +
+	"\x47\xb4\xc1\x8d\x58\xb0\xe8\x66\xbe\xe5\x92\x6c\xac\x8f\xda\x61\x13\x51\xc1\xc4" +
+	"\xdd\xdd\xb9\x97\x79\x85\x4a\x75\xb3\xc7\xbc\xd5\x73\xb1\x35\x88\x12\x7d\xdf\x59" +
+	"\xe8\x48\x81\xba\x0c\x48\x47\x9a\x7a\xcc\x43\xc3\x0f\x46\xb9\xd5\x80\x39\x7e\xa3" +
+	"\x36\x86\xe6\x23\x5b\x6a\x4b\x8f\x1c\x0e\x83\x09\x07\x71\xea\x1d\xda\xeb\x93\xbc" +
+	"\x9e\xc9\x14\x59\x3b\x3b\xc4\x84\x6e\x37\xfa\x6a\xad\xd3\xa3\x6c\xce\xf4\x58\x2a" +
+	"\x4c\x0f\x2f\xfb\x9d\x45\x2e\xe9\x47\x82\xe0\xe8\xd7\x90\x99\x08\x16\x62\xff\xb5" +
+	"\xc7\x2b\x85\xa5\xc7\x6b\x98\x44\x00\xa4\x34\x5e\xc8\xfc\x3b\xd6\xef\x49\x94\xdf" +
+	"\x78\x28\xe0\x69\x6f\x29\xf2\x39\xc6\x1d\x21\x6c\xa4\x31\xf6\xa1\xf2\xa4\x72\xe3" +
+	"\xe2\x2f\x15\x50\x53\x1a\x9a\x6e\x84\xbf\x0f\x91\x74\x96\x79\x38\x77\xaa\x99\x70" +
+	"\x86\x14\x6f\xba\xdb\x02\x87\xaa\x6d\x0c\xd4\xec\xf1\x8f\x6d\x70\xd4\xa5\xb0\x0f" +
+	"\x43\xa8\x6c\x27\xab\x69\xe3\xc6\xfc\x2d\xfa\x15\x95\x5c\xba\x1f\x36\x02\x62\x6a" +
+	"\x95\x5f\x35\xb1\x23\x6c\x1a\x80\xa0\x36\x00\x09\xed\xa2\x0c\x57\x07\x56\x53\xfa" +
+	"\x81\x32\x79\x3f\x82\x59\x94\x00\xf8\x57\xce\xb2\x19\xd2\x57\x2f\xb9\x93\xd6\x83" +
+	"\x21\x74\x38\x55\x50\x4c\x9d\x98\x65\xad\x55\x14\x26\x0e\xc0\x34\x84\x7a\x95\xbe" +
+	"\xbc\xf4\xe5\xe5\xa1\xd1\x3d\x8e\x40\x11\x52\xc6\x42\x4e\x93\xbf\xb1\x74\xb4\xeb" +
+	"\x4e\xc7\x27\x2f\x1d\x21\xd9\x01\x21\x87\x28\x50\xf3\x4b\x4a\xd4\xfe\x74\xe0\x08" +
+	"\xac\x7a\x3b\xb8\x94\x52\xaf\x98\x4b\x00\x1c\x7a\xec\x87\x4e\x45\xe8\xe4\xbe\x7c" +
+	"\x71\xb5\x8c\x5e\xa7\xa7\x19\xcd\xd4\x2b\x79\xc4\xde\x9b\x51\x3d\x96\x2d\xc9\x1f" +
+	"\x43\x28\xf2\x7c\x15\x45\x72\x9d\x30\xdc\x3d\x1c\x06\x06\xac\xf9\xf4\x23\x35\xd2" +
+	"\x06\xcb\x6d\xaf\xac\x58\x34\x06\x1f\x95\x0b\xbb\x78\x6d\x0a\x39\xa2\xee\xeb\x1a" +
+	"\x1c\x8b\xb5\x53\x32\xfc\x1a\xb7\x06\x58\xc5\x11\x09\xc1\xd1\x01\x68\x19\xa2\xcd" +
+	"\x28\x7a\xc5\x86\xa4\xac\x65\x88\x14\xd5\x36\x3c\xa5\x68\xe0\x18\xf9\x70\xdd\xa0" +
+	"\x90\x7c\x58\x98\xe6\xf4\x79\xa4\x5f\x51\x9d\xf3\x02\x88\x0f\x05\xa8\x54\xea\x67" +
+	"\x4f\x8d\x2a\x31\x3b\xca\x50\xb8\x75\xa8\xfd\x6a\xe6\x39\xea\xc7\x6c\x78\xe2\x82" +
+	"\x26\x9c\x85\x2d\xdd\x30\x00\xd1\xb2\x03\x23\x72\x61\xa1\xa9\x1f\x2d\x8a\x63\x36" +
+	"\x60\x61\xb7\xf8\x11\xbb\x79\xb4\x14\x29\x99\x86\xe4\x1a\x72\x5e\x22\x2a\x51\x2e" +
+	"\x83\x16\xc1\x04\x0c\x47\x40\xa7\xf5\x04\x1b\xc5\xf5\xf5\x7c\x50\x53\x6d\x2a\x4c" +
+	"\xf6\x63\xfb\xe1\x77\x2b\x77\xac\x5b\x2a\x56\x13\x9a\x98\xf0\xc4\xdf\x90\x21\x78" +
+	"\xe9\x8e\xbd\x46\xb6\x2d\x7b\x0a\x9c\x30\x5c\x3a\x46\x63\x10\x1c\xa1\x69\x27\xcf" +
+	"\xb6\x6e\xec\x89\x83\x04\x4b\xc7\xf8\x6d\x58\xba\x2a\x3a\xed\x34\x9a\xa5\xa7\xcc" +
+	"\x08\xb3\xdd\xa0\x19\xc0\x83\x64\x61\x22\x8c\x34\x89\x36\x90\x6e\xdb\x62\x3d\x69" +
+	"\x34\x4b\xb9\xe2\x28\xdf\xac\x4d\x74\x1b\x97\x0e\x59\xfa\xbd\x5c\x9d\x85\xa8\x4d" +
+	"\xb3\x98\xec\x8b\x46\xd8\x52\x10\x80\x94\x15\xc5\x1c\xa7\xfa\xd2\x18\x91\x64\xfb" +
+	"\x62\xdd\x9b\x36\xf6\x5d\xc4\x5b\xc8\x5a\x87\x79\xb0\x0e\x92\x8c\x47\x4a\xc5\x06" +
+	"\x56\x9a\x7a\x6a\xb4\xc3\xdb\xdf\x58\xa9\x5e\xb3\xdc\xd2\x93\x0f\x74\x9c\xe2\x43" +
+	"\x9a\x62\x40\x13\x49\xfa\xb1\x31\x2e\xfd\x48\x03\xa7\xa7\x1f\xc6\xb7\xf4\x33\x34" +
+	"\xe2\x8a\xba\xc3\xd7\x3d\xda\xf5\x26\x46\x49\x39\x63\xbd\xcf\x57\x76\x7f\x77\x4f" +
+	"\xbe\x79\xdc\x5f\x92\x40\x42\xec\x31\x6e\x5a\xc3\x2c\xfd\x15\xb3\x54\x48\x0d\x90" +
+	"\x8e\x97\xcf\x05\xaf\x28\x96\xe2\x25\x59\xab\x48\x5b\x3a\xd6\xe2\xfb\x4b\xac\xed" +
+	"\x8b\xe3\x78\xe0\x8e\xb3\x05\x6b\xf7\x2a\x18\x1d\x46\x91\x2e\x73\x83\x23\x12\x01" +
+	"\xa3\xcd\xa8\x7f\x71\xca\x16\x32\x07\x91\xe1\x19\x79\x55\x0e\xe4\x47\x00\x2b\xe1" +
+	"\x97\x9b\x44\xc7\x85\xe1\xe4\x95\xca\x90\x7e\x56\x7f\xa5\xcb\x8e\x28\x08\x59\x72" +
+	"\xd8\x3c\x21\xd9\x1a\x6e\xb0\x02\x46\xf5\xbd\xbe\x1b\x03\x0f\x20\xfb\xa7\x5f\xda" +
+	"\x12\x0e\x0c\xc5\xaf\xaf\x2f\xd4\xfb\xeb\x2b\xd1\x4b\xcf\xe1\xb1\xf4\x8c\xb9\xc0" +
+	"\xcd\x69\x33\xaa\x64\x03\xac\x25\x82\x44\x98\x05\x73\x38\xf9\x41\xae\x2d\x2a\x9f" +
+	"\x3e\x92\xb1\xe9\x20\xbb\x76\x9d\xab\xa1\x28\x8c\xec\x86\x30\x3a\x7b\x0f\x3c\x0e" +
+	"\xd4\x33\x3a\x9a\x63\x5d\xc5\x98\xb9\x13\xc4\xce\xc6\xf0\x3b\xf4\xcb\x80\x94\x23" +
+	"\x3c\x1b\x3a\x93\x96\x7b\x10\xfe\x93\x7a\x10\xe6\x11\x95\x48\x8b\x4d\x5b\xcc\x22" +
+	"\x8c\x31\x38\x6f\xfe\x9a\x0b\x6a\x36\x07\xff\x73\xa2\x36\x72\x3a\x3d\x7b\xe9\xd4" +
+	"\xa3\xf7\x06\x66\x65\x5d\xec\x8d\x13\x73\x20\x66\x8c\x7a\x66\x71\x96\xad\x80\x73" +
+	"\xf5\xf1\x25\x63\x53\x86\x67\xce\x60\x43\x2c\x00\x06\x76\xc5\x20\x9d\xcb\xae\xa9" +
+	"\x33\x73\x36\xd0\x5f\x4f\xf4\xfb\x7a\x9e\x28\xbe\x76\xb4\x55\x7b\x86\x23\xc9\xb3" +
+	"\x11\x91\xc6\x1d\x3e\x3f\xd9\x30\xd5\xe9\x36\x7b\xba\x87\x73\x8c\x8b\x90\x8c\xdb" +
+	"\xc8\x88\x00\x3c\xc4\xa9\xe9\xf0\x8c\xd9\xfa\x9f\x0d\x0f\x18\xdd\x95\x21\xc1\x7e" +
+	"\xa6\xf6\xe2\x99\x8a\xd0\xf5\x11\x60\x84\x29\x1d\x83\x1b\x4b\x3f\x2b\x61\x55\xb5" +
+	"\x86\xb3\xd2\x57\x14\x5b\xbd\x39\x83\xb3\x1a\x67\x70\x62\x39\xae\xb3\x53\xa2\x54" +
+	"\x54\xee\x1a\xec\x58\x4e\xd0\xa3\xe5\xea\x9c\x5c\xee\xdd\x4f\xac\x13\x9a\xb3\x9b" +
+	"\x29\xc4\x49\x89\x99\xbb\xe5\xa6\x88\x89\x29\xa6\x3b\xd0\x8b\x73\x1f\x25\x5c\xbc" +
+	"\xa2\xb3\x70\x59\x06\xbf\xbf\xbf\xdf\xa5\x17\x0e\x49\xf6\x12\x09\xb3\x68\xa7\x90" +
+	"\x07\x1b\x0f\x0a\x72\xa5\x5e\x12\xd3\x50\xa1\xaf\x0c\xa3\xfe\x52\x3d\xbd\x54\x74" +
+	"\xab\x96\x5e\x6a\xd5\xb7\x9a\x71\x8b\xaf\x6b\x4a\x6d\x2f\x66\x92\x52\xe9\x57\x05" +
+	"\x7d\xd0\x5e\xea\xe7\x91\xe8\x2c\x00\x9d\x0a\x90\x13\x6b\x1d\xb9\xad\x4a\x68\xfd" +
+	"\x6c\x92\x18\xe2\x22\x33\xfd\x00\x19\xb0\x13\x30\x0e\x04\x24\x53\x77\xb8\x79\x99" +
+	"\x96\xfa\xc9\x37\xd0\x6c\x5a\x98\x35\x69\xc2\xa5\x7e\xfa\x51\x95\xba\x8f\xcb\x01" +
+	"\x70\x42\x2d\x07\x27\xca\x62\x3e\xf8\x51\x76\x55\xce\xa2\x14\x6e\xb9\x0b\xf7\x52" +
+	"\x71\xa1\x37\x60\xf2\x07\x90\x8d\x3c\x20\x45\x28\x20\xe1\xcb\x15\x57\x0d\x74\x50" +
+	"\x4e\x06\x23\xb4\x57\x63\xb8\xfc\xc6\x2b\x39\x98\x07\x3b\x01\x6d\x48\x2c\x3b\xa8" +
+	"\xd1\x8a\x75\xea\x42\x9d\xfd\x8a\x91\x22\xbf\xd0\x02\xe9\x28\xb9\x3d\x10\xf1\x76" +
+	"\x57\x77\x13\x55\xd5\x70\x56\xc2\xfa\x38\x88\xda\xb9\x4f\x4f\x74\x63\xb8\xaf\xf8" +
+	"\x59\xfc\x4a\x79\x11\x13\x40\x60\x13\xe4\x67\xe9\xba\x47\x18\xa2\x58\x64\x15\xb4" +
+	"\x5f\xe2\x16\x2e\x4d\x9b\x57\xc2\xb4\x02\xaf\xb4\x08\xb9\x6c\xa3\x94\x47\xcd\x69" +
+	"\xd7\x3d\xf0\x5e\x1c\x83\x36\xff\x36\xa9\x5d\x5a\xf0\x5d\x09\xe9\x5a\xe7\xfe\x51" +
+	"\x0d\x24\x02\x68\x5d\x9a\x53\x77\xca\x86\x4a\xbf\xee\x51\xef\xfa\x1b\xf6\x84\x08" +
+	"\xc6\xb5\xf5\xfb\x85\xa1\x46\x9e\xc9\xe2\xe6\x40\x05\xc8\x00\x67\xc4\xbf\x34\x8d" +
+	"\xf3\x8e\x92\xbb\x06\x8c\x2b\x73\x3b\xc2\xd2\xaf\x9a\x72\xb8\x81\xd2\x04\x6d\x46" +
+	"\xed\xdb\x9a\x32\x29\xd3\x29\x58\x91\x6e\x75\x5d\xd9\x3f\x10\xf9\x8a\x74\xc5\x8c" +
+	"\xbd\x93\x7e\xd5\x2a\xa3\x68\x02\x7a\x5b\xc9\x12\x2a\x43\xfd\x52\x37\x61\xb3\xce" +
+	"\x83\x17\x3f\xc9\xb3\x3c\x3a\x1b\x7d\xd5\xe1\x13\xcf\xc5\x71\xe8\xcd\x69\x33\xea" +
+	"\x5f\xa2\x4c\xdb\xaf\x3a\xd9\x95\x10\x55\xc0\xf4\xb4\x4b\x1a\x00\x1d\xe5\x69\x7c" +
+	"\x36\xf1\xff\x28\x4a\xf3\x1b\xf1\xd6\x60\xc7\xd9\xfd\x73\xe2\x8d\xd2\x50\x33\xa4" +
+	"\x80\xd8\x26\xe8\x28\x35\xea\x49\xab\x5f\x94\x07\xf5\x19\xd1\x4f\xd2\x36\xf9\x62" +
+	"\x7a\x02\xbf\x9c\x30\xe8\x0b\x42\x30\x93\x26\xa0\x6b\xa6\x5f\x5a\x12\x8a\x62\x6a" +
+	"\x65\xff\x4d\x74\x81\x70\xb6\x0c\x1a\x68\x0e\x94\xb0\xff\xa6\xfa\x1c\x44\x89\x12" +
+	"\xf8\x7b\x63\x13\xf7\x7f\x33\xc6\x9d\x2c\x01\x7e\x6f\xea\x9e\xe0\x3e\xfe\x9b\xdc" +
+	"\xe5\x14\x6f\xf0\x15\xa0\x2b\xd8\x4e\x23\x4f\xcd\x9e\x58\xde\x34\x47\xdf\xa5\x8f" +
+	"\xdb\xc8\xa1\x23\x02\x67\xb7\x52\x84\x81\x76\x84\x8d\x83\x0f\x7d\x04\xc6\x0d\x5b" +
+	"\x68\x0c\x17\x8d\x8e\xb0\x6b\x23\x0f\xdd\x7b\x5b\x90\xb3\xd7\x76\x7f\x7d\xb3\x2a" +
+	"\x23\xe4\x78\xfa\xdb\xec\x22\x5e\xac\xef\x78\x10\x2e\x75\x1b\x81\xd5\x3e\x7e\x60" +
+	"\x4f\x45\x2f\x7e\x19\x01\x86\xf8\xff\x11\xc4\xad\x8e\x5b\x60\x39\x82\x9f\x9a\x66" +
+	"\x5b\x80\x1e\x8b\x7d\x16\xd8\x4a\x28\x32\x6d\xdc\x6d\x18\x1b\xa1\xed\x41\x92\x87" +
+	"\x81\xb8\xcd\x3e\x56\x90\xed\x4e\x63\x13\x73\x06\xb5\x26\x29\x64\x2e\x7f\x5f\xb0" +
+	"\x3a\x1c\xa1\xc9\x12\x2b\x2f\x86\x87\x38\x6e\x0f\x12\xe2\x88\xfe\xb9\x30\xcf\x0e" +
+	"\xec\x80\x29\x1f\xbe\xe4\x1f\x6a\x15\xc3\x0b\x45\x92\x10\x48\xbf\xd1\x54\x30\x92" +
+	"\xd6\x8b\xaa\xc4\xc6\x74\xef\x53\xfa\x9d\x7d\x98\x5e\x3d\x07\x80\x79\xcf\x65\x0a" +
+	"\x92\x25\x0f\xce\xcb\x28\x65\xd1\x97\xf8\xb8\x68\x1b\x11\x5f\x14\x90\x46\xd8\x21" +
+	"\x1e\x6a\x44\xd7\xff\xc1\xd5\x73\x1a\x63\xb3\xfe\x3f\xe2\x5e\x7b\xa0\xe1\x8f\x98" +
+	"\xc5\xea\x63\x56\x1a\xa3\x59\x2a\xef\x88\xc2\x13\x54\xe1\xb4\x58\x45\xc4\x45\x88" +
+	"\x5a\xbb\x12\x52\xbb\x92\x41\x7a\xb2\x1f\x5b\xf1\x76\x10\x1b\x87\x94\xfa\x60\xc4" +
+	"\x36\x4e\x10\x3b\x67\x8c\x42\x50\x25\x3e\x3d\x9f\xee\x39\x69\xdd\x35\x7c\xcb\x0d" +
+	"\x63\x35\xf9\x7e\xc3\xc8\x3d\xd2\x39\x75\x56\xca\x91\x8a\x0b\x94\x9c\xb2\x16\xa1" +
+	"\xae\x54\x38\x2c\x66\x2c\x7c\xe7\xe3\x00\x1a\x3d\x00\x46\x5e\xf7\x0b\x18\x98\x91" +
+	"\x98\x66\x44\xff\x02\x57\x40\x62\x14\x86\x58\xa2\x51\x55\x83\xec\x64\xf6\xc1\xd9" +
+	"\x96\x3e\xd8\x9c\x92\x84\xfe\xa3\xe9\xdd\x90\x2a\x16\xc4\x7e\x25\x6f\x2d\xa2\xca" +
+	"\x0c\x32\x38\x60\xd4\x87\x09\x1d\xb5\xcf\xcc\x83\x02\xb9\xee\xc6\x59\x6f\x1e\x5f" +
+	"\x68\x77\xa0\x5a\x75\x69\xc1\xb8\xa5\x05\xa3\x5e\x9f\xe0\x49\xbc\x6b\xba\x36\xff" +
+	"\x06\xc5\x03\x75\x99\xca\x4e\x2a\xec\x90\x71\x0e\x43\x43\xe1\x40\x47\xb0\x8f\xfa" +
+	"\x09\xec\x1f\x01\x2c\xc4\xe6\xeb\xa4\xd1\xfc\xbe\x1e\x9c\xb4\x73\xe5\xe0\x76\xb9" +
+	"\xbd\x97\x5d\x92\xc8\xe1\x0a\x96\xe6\xe4\x21\x8c\xb8\xab\xcc\x90\x91\xc1\x02\xe2" +
+	"\xa4\x8a\x6a\xf0\xfc\xc3\x6a\x44\xb6\x2e\x44\x4e\x11\xf5\xd5\x66\x46\x61\x79\xf6" +
+	"\x85\xe6\x68\x6a\xd2\xe8\x80\x88\x58\xb6\x5b\x44\x2c\xc1\x31\x4e\x4d\x6f\x00\x7d" +
+	"\x2e\xf6\x6f\x34\xce\x58\xf2\xf4\xb2\x27\xaf\x73\x76\x3f\x8d\xf8\x33\xfb\x9b\x10" +
+	"\xfb\xa8\x66\xcf\x77\x2d\xbb\x76\x6e\x38\xec\x2a\xae\x59\xc0\x7a\x0f\x1b\x7c\xbc" +
+	"\x99\xab\x77\x65\x14\xd4\x69\x03\xcd\xd9\x2f\xe3\x80\x86\x4d\x35\x43\x7b\x70\xc3" +
+	"\x45\xcb\x50\x95\x10\xf4\x6b\x54\x62\xf4\x31\xd7\x95\x8e\x66\x61\x9b\x41\x7b\xa8" +
+	"\xd6\x9f\x50\xbd\xdc\xf4\xc9\x13\xeb\x0d\xa2\x96\x89\x29\x6e\x67\xcc\xd3\x83\xf6" +
+	"\xad\xc5\x3e\xa6\x1a\xa9\x25\x63\xca\x66\xa3\xd1\x66\xd4\x3d\xa9\x6b\xcd\x0b\x1e" +
+	"\x80\x1d\x1b\xcd\xfe\x00\xc8\xd7\x43\xfa\xa2\xb1\x95\xdd\x45\xc3\xd1\x37\xcb\xa6" +
+	"\x01\xaf\xa8\xd7\x29\x89\xd2\x7c\x84\xb6\x6a\xb8\x9b\x8f\x30\xaa\xe4\xca\xf3\xb1" +
+	"\x61\x98\x55\x6f\xb7\xc9\x27\xf3\xb1\x4d\x05\x3b\x1f\xcf\x5c\x89\x77\x3e\xec\xf4" +
+	"\x61\x9f\x0f\xbf\x45\xa6\xcf\x07\xa7\x33\x78\x65\x1a\x68\x02\xfe\x2c\x41\x0f\xca" +
+	"\xfe\xc8\x1f\xe7\xc3\x0d\x3e\xf6\xf9\x30\xfd\x48\x03\x58\x6d\x01\xb9\x38\x62\x3e" +
+	"\xae\x20\x39\x13\xc0\x9e\xb5\x22\x9b\x0f\x54\x8a\xf4\xd1\xbd\x77\x30\x1f\xf7\x5e" +
+	"\xc1\x7c\xdc\xf2\xf7\xf9\xe8\x9e\x87\xee\x8b\x4a\xb9\xa0\x0b\x63\x6e\x5f\xf9\x41" +
+	"\x8b\x54\x54\x7b\x3b\x13\x1b\x41\xa8\x18\xe0\x84\x09\x84\x13\x4f\x3a\x50\x04\x4d" +
+	"\x9a\x58\xe6\x63\x50\xb0\xf3\x31\xaa\xa4\x13\xf3\x61\x5a\x26\xf8\x40\x3b\xa4\xcf" +
+	"\xc7\x6c\x0f\x3c\xa8\xbb\xce\xc7\xa7\x56\x8c\x09\xf7\xf9\xf8\x8d\xad\x2e\x7d\x62" +
+	"\x4d\x48\xed\x05\x65\x95\x3e\x5d\x5a\x8f\x5c\x4b\xa4\xbe\x26\x84\x4a\x7a\xa2\x04" +
+	"\xda\x1c\x58\x2e\x9e\xb6\xf1\x4b\x63\xfa\x11\x31\x2d\x3c\x90\x0d\x27\x73\x67\x86" +
+	"\xc0\xe4\x14\xf5\xee\x15\xe7\x37\x20\x38\xf8\x0a\x98\xc0\x70\xca\xa6\x61\x9f\xf9" +
+	"\x69\xc1\xe4\xa7\x95\x5b\x7e\x26\x7f\x66\xe2\xe1\xee\x98\x8e\xf1\x39\x52\x98\xed" +
+	"\x38\xad\xc0\x6c\x2a\x38\x19\x5f\xe8\x1c\x2b\x7f\x60\x7a\x42\x48\xb7\x29\x0a\x71" +
+	"\xd5\x8d\x21\xed\x0d\x00\xfe\x31\x76\x00\xd4\x47\x05\x18\x01\x01\x28\xca\x19\x92" +
+	"\x42\x0b\x5a\x46\x2a\x3e\x94\x2d\x6e\x40\x0e\x2e\x38\x59\x28\xda\x85\xec\xc5\x08" +
+	"\x91\xae\x66\x0a\xc8\x20\x8d\x78\xf7\xb1\x8c\x5b\x4f\xc9\xa8\x95\x4c\x7b\x3a\xfb" +
+	"\x32\x9b\xd5\x12\x9a\xcb\xfa\x66\xb7\x05\x8b\x1f\xdd\xe8\xb3\x49\x49\xb0\x4f\x33" +
+	"\x59\x02\xe5\x54\x2a\x54\x1a\x6d\x52\x7d\xb2\x0f\xd0\xe2\xb1\xb0\x5a\x95\x09\x2c" +
+	"\xde\xd9\xda\x73\xb6\x77\x54\xe8\xa6\x8a\x63\x20\x3a\xf5\x78\xde\xd8\x70\x5a\x3a" +
+	"\x07\x33\xf8\x65\x8d\x66\x54\x01\xb0\xba\x52\x74\x7d\xf8\x68\x8f\x4d\x3c\x1b\xee" +
+	"\x3f\x88\x86\x3e\x7f\x7b\xff\x1f\x96\xe7\x0d\x17\x5d\x2b\xb2\x81\x0a\x3f\x3e\x29" +
+	"\xc9\x2e\x72\x57\x33\xd5\xd5\xce\xfd\x83\x5a\x14\x9b\x3b\xd7\xd2\x3f\x12\x4b\x7c" +
+	"\x24\xcf\xf9\xd8\x9e\xc9\xc7\x85\xf1\x50\xca\x15\x6a\x53\xff\x27\xf9\xa5\xe5\xfd" +
+	"\xe3\x72\xb4\xcf\x2d\x47\xfb\xa4\x93\xd6\x26\x17\x8f\xc5\x27\xff\xcf\x3d\xe7\x7f" +
+	"\x12\xfd\xe6\x23\x39\xf5\x27\x69\xba\xf8\x24\x0d\x1a\x1f\x97\x16\x7e\xcc\x9a\x65" +
+	"\xff\x68\x0a\xfe\xd4\x0a\xe7\xf6\xd1\xfa\xe6\xa3\xf1\xf8\xa3\x51\xf3\xbb\x72\xa1" +
+	"\x69\x5c\xfa\x97\x6b\x58\x58\x7d\x7d\x4f\xb6\xa2\x69\xa3\x86\xcc\x89\x83\xe4\xfd" +
+	"\x5b\xa4\x74\xdb\xbf\x65\xe3\xf4\xd8\xd2\xbf\x25\x36\xe6\xbe\x6f\xa9\x17\x47\x21" +
+	"\x0d\x24\x81\x71\xc4\xff\x21\x8d\x98\xdf\x06\x53\x69\xa3\xc4\xf8\x3f\xdb\x44\x18" +
+	"\xe1\x11\xfa\x5a\x97\xa1\x9d\x1e\xf5\x39\x84\x8c\x02\x1d\x57\xd8\x5b\x11\x31\x1f" +
+	"\xa0\x9a\xb6\x65\x84\x5b\xf5\x66\xb8\x18\x0f\x9a\xa3\x5e\xe4\xfb\xf9\xcb\x2f\x61" +
+	"\xaf\xd2\x59\x86\xaa\x85\x1a\x20\xf8\x75\xc0\xf5\x41\xc5\x4f\x8f\xb0\xe9\x26\xfa" +
+	"\x11\xe2\xeb\x53\x31\x53\x37\x02\x41\xb3\x42\x25\xd2\x17\x7e\x3d\xba\xbc\xce\x13" +
+	"\x92\xc4\x72\xb2\x30\xe0\xa9\x96\x65\x84\x93\x0d\xfa\x11\xce\xe8\x29\x64\x61\xc0" +
+	"\xba\x40\xa4\xbc\xf8\xa1\x6e\x44\x1a\x89\xb4\xa5\x39\x8a\x88\x4d\x81\x5e\xd1\x93" +
+	"\x83\x91\x03\x3c\x32\x10\x28\x56\xac\x05\xe8\x93\x2b\xd5\x35\x40\x2f\x7f\x81\xf7" +
+	"\x16\xca\xc0\xee\x20\xeb\x06\x95\x5b\x3b\xc3\x0a\x91\xda\xf8\x08\x58\x0d\x81\xcd" +
+	"\x0f\x24\x44\xe2\xb6\x71\x4b\xdb\x64\x02\x73\x84\xde\x23\xa5\xcf\xed\x6d\xe3\xcf" +
+	"\x9c\xa2\x21\xa2\xc7\xf2\xc6\xb8\xef\xb5\x07\x28\xa3\xbe\xc3\xc7\x73\xa5\xc8\x50" +
+	"\xde\x19\x58\x81\x2f\xcb\xd0\x5e\xd9\xe0\x0a\xbb\x71\x84\xcf\x32\xd8\xe8\xa2\x1c" +
+	"\xa0\xb2\xad\x3d\x8e\x18\x79\x7c\xe2\x23\x9e\xc2\x66\xaa\x10\xd0\x98\x7f\x90\xb8" +
+	"\x9d\xd4\x1b\xb4\x76\x28\xdc\xfd\x34\xa4\xf7\x34\x35\xc8\xc5\x9e\xc5\x38\x8c\x65" +
+	"\x32\x3a\x8c\x5a\x2e\x0f\xd7\xd4\x36\x40\xa1\x1c\x98\xc7\x1a\x07\x76\x0b\x09\xc9" +
+	"\x5f\x2a\xd1\x09\x23\x6f\x3c\x30\x54\x18\xb5\x30\xbc\xbe\x0e\x2e\x81\x83\x9b\x70" +
+	"\xf4\xe7\x66\x2e\x12\xfd\x0b\xf0\x5c\xf5\x96\xae\x37\x64\xd5\x66\xa0\x45\xc4\x96" +
+	"\xd6\x40\x83\x98\x24\x48\xd5\x52\x04\x61\x25\x20\x6a\x73\x60\x1c\x92\xb9\x41\x68" +
+	"\x64\xda\x07\x32\x62\xa9\x68\xe8\x6f\x40\x4c\x65\x0b\xe4\xc4\xea\x07\x83\x49\xe2" +
+	"\x84\x79\xf3\xd1\x26\xad\x90\x32\xe5\xb7\x85\x8e\x63\x92\x92\x79\x3e\xf4\x7b\xb9" +
+	"\x5b\xeb\xe8\xb7\x8c\x43\x45\xfd\xf1\x15\xe3\x38\xbe\xda\x16\x1f\x29\xb4\xb0\x8c" +
+	"\x84\x75\x89\x91\xd0\x32\x19\x69\x7b\xa4\xb1\x8c\xe4\x5b\xb3\x02\xbb\xe8\x77\x19" +
+	"\x89\x31\x4c\xd2\x7e\xb2\xe7\xe2\x69\x3d\xbb\xe7\xfd\x18\x5c\x87\x64\xe8\x43\x61" +
+	"\x24\x3b\xc9\x6a\xbd\x54\x02\x5e\x08\x11\x65\xa2\xe1\x0c\x1d\xbf\x24\x2a\x21\x99" +
+	"\x25\x16\x66\x12\x58\xdb\xc1\x45\x24\x09\xbf\xe2\xd9\x45\x2c\x00\x89\x89\x59\x1d" +
+	"\x29\x3d\xe5\xe5\xe1\x16\xb5\x7d\x6e\x2a\x41\x68\x3c\x90\x15\x8e\x54\xb4\xd4\x91" +
+	"\x52\xc4\x48\x57\x7d\x3e\x97\x81\xc8\x95\x78\x35\xcb\x0e\x17\xbc\x8e\x74\x8d\x1a" +
+	"\xff\xd0\xb5\xb8\xe6\xed\xb0\xc3\x0d\x70\x3c\xf6\x2b\x2f\x3a\xa8\x36\xee\xcb\xfd" +
+	"\x05\x6a\xe3\xf5\x26\x4f\x9b\x7b\x72\x4d\xfa\xc1\x11\xb4\xb4\xe2\xb2\xc7\xed\x0b" +
+	"\xf5\xb2\x00\x88\x6d\xc3\xa9\xe8\x05\xcc\x09\xeb\x94\x55\x21\x15\x0e\xde\x09\x74" +
+	"\x26\x6b\x03\x2c\x95\x84\xb4\x1c\x14\xba\x10\xb7\x18\x82\x47\x70\x44\x33\x6d\xdc" +
+	"\x00\x62\x61\x5e\xc8\xa1\xb9\x48\x28\xfe\x6a\x10\xe6\x3a\xe0\x78\x53\xf3\x71\x79" +
+	"\xb2\xae\xce\x2f\xfd\x8b\x13\x64\xd9\x08\x9a\xb9\x02\x1a\x46\x58\x21\x0d\xb7\x0b" +
+	"\x60\xc8\xb3\x1d\xc3\x76\xaa\xa5\xfb\x99\x2e\x5e\x91\xa4\xa8\x1e\x8a\x5e\xf3\x68" +
+	"\xf1\x85\x46\xe6\x68\xf1\x74\x47\x5f\x12\xe0\xa2\xc4\x6a\xa9\xcf\x0a\x8b\xf4\xc5" +
+	"\x2e\x0e\x64\xb4\x04\xc3\x07\x21\xf4\x64\x67\x94\x06\x3c\xb1\x0d\xe2\x2d\x3d\x26" +
+	"\xd2\x53\x9c\x38\x83\x2b\x2a\x1d\x34\x43\x72\x59\xd1\xb0\x87\xbe\x2c\xdd\xc9\x2e" +
+	"\x59\x30\xa0\x24\x70\x38\xd2\xbc\x72\x10\x92\xd4\xe8\x46\x39\x48\xcd\x4a\x5d\xe2" +
+	"\xc8\x93\xbc\x68\x1d\x84\x36\x35\xe9\x94\xa0\x00\x52\x57\x6c\x94\x0e\x3a\x3c\xac" +
+	"\x2e\x40\x23\x62\x4b\xae\x78\x32\xa4\x97\xeb\xd4\x63\xb5\x6d\x9e\xd1\x92\xee\x5d" +
+	"\xc3\xa8\x11\xaf\x31\xf6\x31\x6e\x3d\xdc\xd1\xd2\x3c\x2f\x6a\x25\xe9\x0a\x27\xd6" +
+	"\x85\x30\xd1\x5a\x18\x5a\x30\xf5\x7c\xb0\x8f\x4f\x4f\x3c\x88\xb1\x5e\x36\x67\xa2" +
+	"\xa3\xca\x70\xdd\x2a\xeb\x14\xa7\xfe\xc9\x64\x08\xc5\x7e\x06\x27\x47\x79\xa6\xba" +
+	"\xea\x87\xee\x24\xa9\x24\x4b\xc9\x17\xf8\x69\x19\x64\xd9\xc6\xea\xf1\x85\xef\xe9" +
+	"\x82\x3b\xa1\xe8\x54\xf3\x35\xc0\x62\x98\xdd\x27\xe8\x86\x85\x68\x58\x67\xd6\x99" +
+	"\xfa\xf5\xa9\x72\x62\x70\xb7\x2d\xc3\x77\xbd\xc7\x8c\x36\x74\xcd\x7d\x19\xd3\xbb" +
+	"\xd1\x64\x87\xcb\x57\x91\x7f\x8b\xc8\x31\xd1\x82\x18\xb3\x3d\x68\x01\x9c\xb5\x22" +
+	"\xf7\xb3\x3d\x38\x98\x6f\xe0\x1f\x73\xe9\x6c\x0f\x5b\x84\x8c\xd9\x9e\x3c\x72\xb5" +
+	"\xd6\x98\xed\x64\x45\x34\x60\x61\xf5\x4a\x23\x2e\x26\xd5\x25\xee\x5a\xc6\x1c\x73" +
+	"\x19\xf3\x67\x19\x1f\x44\x01\x43\x47\x45\xf9\x1d\xb8\xc4\x5f\x15\xd8\x47\x0a\x1d" +
+	"\xe3\x83\x86\x1b\xa6\x72\x85\xbe\x78\x68\xd7\x32\x3e\x2c\x5f\xc6\x27\x49\x76\x61" +
+	"\x54\x85\xf2\x49\xfa\x31\xd1\xca\xf8\xb0\x03\xca\x6f\xc7\xb1\x65\x7e\xef\x82\x05" +
+	"\x29\x5a\x68\x33\xea\x41\xa8\x27\x1a\x07\x39\x3e\xb6\x90\x1c\xdf\x15\x61\xb8\x29" +
+	"\xf0\x8f\xef\x2b\x2e\x2c\xb4\xda\x82\x41\x57\xc9\x43\x1d\xcc\x13\xa7\x16\x96\x79" +
+	"\x22\x8b\xa5\x91\xcc\xf3\xd1\x10\xb8\x2d\xdc\x02\xd2\xe2\x32\x0b\xec\x1d\x5b\x05" +
+	"\xa0\x2c\x87\xad\xb6\x62\x0e\x1b\xab\x15\xa1\x27\x87\x67\x01\xba\x1f\x90\x2e\x98" +
+	"\x76\xf7\x94\x8d\xd7\x40\x6d\xf8\xac\x8e\xd0\xc4\x31\x34\xb7\x34\x0c\xe9\x68\xa7" +
+	"\xa8\xe2\x7d\x04\x76\x74\x66\xb9\x8f\x7a\x4e\x6c\xbc\x33\xad\x02\x8a\xde\x50\x97" +
+	"\xb3\x3c\x52\xb0\x44\x3c\xc8\xbd\xde\xb3\x53\x61\x4e\x34\x2d\x47\x88\xc9\x66\x79" +
+	"\xd4\x6c\xf1\xe9\x54\xa8\x23\x6c\x42\xcd\xf2\x20\x0f\x8e\x12\x55\x85\x93\x36\x26" +
+	"\x66\x79\xe8\xda\x15\xbd\x9b\xc5\xdf\x4d\x2f\x86\x87\xdd\x95\x3f\x0b\x9a\x99\x5f" +
+	"\x51\x8d\xaa\x00\xcd\x20\x1c\x8b\x6a\xf0\xa0\xbc\x3b\xfe\xa8\x4e\xfe\x0a\xe9\x28" +
+	"\x07\x08\xd3\x62\x98\x7c\x79\xa7\x8c\x5f\xd4\x8b\x45\xd2\x19\x1d\x9d\x1e\xb2\x6e" +
+	"\x85\xe6\x1d\xc9\x5c\xe9\x16\x90\x1a\xf4\x7d\xae\xbb\x91\x61\x35\x87\x95\x29\x07" +
+	"\x29\x3b\xc8\x5e\x17\x6b\x3d\x1f\x37\x40\x8d\x0c\xa0\x9d\x55\x40\x53\x70\xb5\xb5" +
+	"\x79\x51\xd4\x28\x4e\xde\x01\xe9\xf0\xb7\xc0\x38\xf4\x8a\x0d\xe7\x59\xcc\xd0\xbc" +
+	"\xe8\x47\x81\xb5\xe9\xcd\x07\xad\x40\xa7\xb2\x3a\x22\xe4\xa9\x99\x84\xbe\x05\x94" +
+	"\x20\x79\xde\x3c\xb9\x5b\xb8\xe3\xdb\xe0\xac\x20\xab\x57\xd1\xe6\x27\x42\x41\x4c" +
+	"\xaa\x7c\x85\xe5\xd3\xd3\x00\x39\xbe\x51\x7d\x39\x9a\xee\xc4\x7d\x58\x0e\x8a\x7f" +
+	"\xf8\xbc\x83\x7a\xc6\x3f\xc4\x51\x25\xa1\xbd\x3a\x6d\x9e\x08\x8c\x8b\x3a\xca\xe9" +
+	"\x0e\x42\x63\x91\xd0\xf9\xe7\x76\x72\x36\x4c\x6e\x32\x1e\xe3\xa8\xf7\x1b\xd1\x5a" +
+	"\x36\x37\xec\x29\xa4\x15\x8a\xa1\x7a\x07\x32\xfe\x72\x30\xea\xc7\x3e\x98\xdd\xdf" +
+	"\x69\x75\xe9\xa8\x78\x54\x3a\x98\x82\x5b\xf7\xce\xb8\x25\xce\x41\x29\xc1\x12\x73" +
+	"\x09\xa1\x61\x61\xc0\xd3\xed\xf1\xb5\xf0\x74\xbf\x62\xdc\xa0\xe8\x8f\xc8\x85\xe1" +
+	"\x5d\x40\x23\xcb\x34\xe3\xe9\x38\xc4\xc0\xc1\x6b\xa8\x12\xe6\x57\xfb\xeb\xf9\xab" +
+	"\xe7\xe1\x83\xc7\xdd\x85\xe3\xdd\xed\x6f\x1d\xce\x59\x7c\x27\x15\xb0\x7b\x3b\x88" +
+	"\xe5\xcd\xb4\x3a\x4b\x64\x7b\x0b\x07\x16\x02\x86\x38\xd5\x3e\x4b\xfc\xb9\x3c\x93" +
+	"\xf1\x87\xe3\x6f\xa0\xa7\xf3\x56\x42\xb4\xc9\x27\x96\xcb\xf0\xfd\x0c\xc8\x03\x67" +
+	"\x79\xc6\x78\xfb\xf0\xfe\xf0\xbc\xf3\xf8\x64\x10\xb9\xc1\xed\xe7\xc7\x53\xff\x4c" +
+	"\x36\x52\x3c\xb3\x2e\x8d\x9c\x45\x07\x8a\x1d\x65\x23\xf7\xa8\xf2\x6c\x3e\xc4\xf9" +
+	"\x39\x61\x40\xf5\xf2\x7b\xb6\xfa\xab\xe4\x3f\x6f\xdf\x7b\xf6\xa4\xed\xb9\xbe\x1d" +
+	"\x4c\x0a\x6c\xaf\x68\x97\x71\x2f\x70\x70\x9f\xf0\x54\x86\xb8\xda\xcb\x91\xd7\xaa" +
+	"\x2e\x31\x97\x7f\x90\x51\x9d\x68\x9e\xe5\x88\x21\xc3\x5e\x0b\x35\x77\xf1\xe1\x89" +
+	"\xa5\x9c\x7b\xb2\xfb\xa1\x67\x39\x92\x64\x73\x00\x76\x62\xa1\x03\x3d\x70\x3c\x71" +
+	"\xc7\x07\x44\xcd\x92\x9b\xc7\xc8\x8a\x33\x44\xb3\x98\x45\x8b\xe9\x37\x1d\x41\xab" +
+	"\x27\x41\x48\xdf\xa2\xf4\x35\x0b\x37\xc1\x5a\xba\x53\xf9\xe7\xa3\x03\x7b\xda\xac" +
+	"\xc4\x85\xfe\xdc\xde\xde\x5a\x60\x02\x66\xe1\xa2\x2e\x0b\xa6\xc7\x1f\x6b\x18\xb2" +
+	"\x2b\x04\xe9\x88\x6f\x39\xa3\xc6\x6b\x3f\x53\xe6\x88\x5c\xf8\x65\x8e\xb3\xbc\xe2" +
+	"\xa9\x21\x8d\xe3\xc8\x90\x52\xbd\xde\x5f\xc5\xd6\x53\x86\x88\x0e\xd5\x40\xde\xd8" +
+	"\x35\x78\xd0\x8f\x95\x51\x8e\x5e\x27\xf9\xaf\x23\x20\x08\x96\x1f\xeb\x3b\x5c\xf2" +
+	"\x66\x0d\xec\xd6\xef\x01\xbd\xbc\x39\x65\x4c\xc9\xb8\x9b\x7f\xce\x92\xc6\x80\x37" +
+	"\x15\xb4\x79\x78\x7c\xdf\xdf\xd4\x3b\x4e\x40\x13\xf0\x9e\x9c\xfd\x0c\x13\x4e\xff" +
+	"\xf3\xfe\xf6\x77\xba\x8f\x4c\xc0\xfd\xf8\xd5\x62\x86\x28\x4d\x5d\x9c\x04\x29\xde" +
+	"\xfd\xfc\x12\x25\x80\x4f\x68\x67\x68\x2f\x07\xfd\x7f\xc0\x02\xd4\x31\x67\x73\xf3" +
+	"\x63\xcc\xb3\x9c\x7f\x5d\xe7\xf4\xae\x23\x65\x1c\x79\xe2\x3e\x15\xd1\x3b\xad\xa7" +
+	"\xa7\xd5\x4f\x28\xcf\x52\xc2\xe9\xaf\x8a\xf7\xa5\xfb\xf6\x07\x76\x08\xbc\xe9\x14" +
+	"\xc9\xe9\x70\x68\xf7\x67\x9c\x01\xd3\xbb\x8a\x0e\xaf\x81\x7b\x70\xb8\x58\xb7\x41" +
+	"\xb6\xcd\x9f\x93\x13\x9f\x69\xfc\x8a\x63\xde\x58\x72\xae\xe8\x55\x78\x25\x2f\xe8" +
+	"\x2b\xe5\xea\x9e\x93\x97\x10\xb2\xc6\xff\x21\x7f\x99\xef\x9e\x6a\xa6\x03\xcd\xad" +
+	"\x7e\x1c\x4c\xd2\x6a\xc6\xec\xa8\x46\xb7\x61\x34\x8b\x59\x48\x99\xc5\x8d\x80\x00" +
+	"\x86\xd3\xbb\x31\xd9\x9a\xcb\x91\x93\x2c\x72\x8f\x6b\xb7\xc1\x04\x50\xbe\xbf\x8f" +
+	"\x9e\x30\x59\x3d\x80\x0c\xcf\x2a\xa7\x22\xad\x78\x38\x48\xe8\xe0\x52\x22\x10\xd9" +
+	"\x9a\x43\x0d\xde\x24\x39\x70\x67\x54\xfd\xd4\xd6\x04\x72\xe0\xaa\xd6\x59\xfe\x66" +
+	"\x7c\x9d\xc8\x22\x14\x0e\x5a\x89\xa8\xac\x39\xff\xe4\xef\xdf\x37\x65\x5f\x79\x72" +
+	"\x23\x8f\x73\x2c\x1c\x3d\x71\x4f\x6b\x8b\xcc\x7a\x9c\x40\x36\x87\x7b\xb0\xd2\xf9" +
+	"\x02\x47\x5a\xb7\xe3\x14\x4f\xa5\xa1\x23\xb9\xe1\x99\xe9\x45\xcf\x59\x1b\xf2\x20" +
+	"\xcd\x93\xa6\xdc\xae\x8f\x51\x4e\x57\x1b\x45\x93\xdc\x3e\x3b\xbc\xee\x05\xcc\x05" +
+	"\xf5\x6e\xf3\x94\xbc\x33\x74\x17\x80\x08\x59\x61\xdf\x3a\x97\xb3\xb8\x16\xe0\x2c" +
+	"\xae\x76\x36\x8b\x34\xc5\x66\xe9\x55\x77\xe4\x02\x7c\xee\xe9\x88\x88\x78\xce\x1e" +
+	"\x69\x75\x7e\xf0\x56\x9b\x11\xf2\x94\xdd\x13\xa9\xd4\x22\xcc\xfb\xb8\x99\x42\xdb" +
+	"\x6e\x37\xd0\x92\x7b\x4b\xad\xb9\x37\xad\x11\x00\xf5\xce\x00\x6b\x2d\x79\x9f\x7f" +
+	"\x61\x69\x17\x0d\xc4\x9e\x4f\xbc\x81\x8a\x74\xfa\x60\xc5\x16\x03\x44\x52\x68\xae" +
+	"\x0e\xf2\x26\x73\x4b\x59\x71\xb2\xd4\x0e\xef\xb6\x66\x4b\xc4\x90\xc7\x3d\x82\x8d" +
+	"\x07\xc8\x14\x1d\x78\x5b\x96\xa8\x89\x0b\x88\x88\x7d\x88\x11\x1e\xce\xff\x42\x55" +
+	"\xe0\x23\x79\xe5\x8d\xbf\x56\x39\xac\x14\x75\x1c\xda\x1c\x10\xd1\xdc\xc8\x3b\x83" +
+	"\x04\x11\x8e\xd2\x4d\x4f\x0f\xbc\x69\xc2\x65\x33\x94\x11\x7c\xdc\xec\xbd\xd6\x58" +
+	"\x50\x67\x7a\xfc\xe0\xf3\x2c\x3e\xf3\xcf\x6e\x53\xd0\x3b\x64\x9b\x7b\xde\x76\x0e" +
+	"\x50\xc0\xbf\x79\x47\xaf\x48\xc0\xed\xe4\x85\xf5\x4e\x1e\xde\x3b\x75\x2f\xe1\x37" +
+	"\xd2\x83\x85\xc3\x93\xee\xe7\x83\x4e\x88\x38\xc3\x0f\xb2\x0c\xde\x78\x1f\xfb\xfc" +
+	"\x8d\xba\x9f\xc8\xfe\x26\xd4\x9e\xf2\x8a\x84\x5f\x88\xe4\x7d\x50\xed\xd3\xa7\x7e" +
+	"\x52\x0b\xa4\xb9\xef\xe3\xa3\xc0\xe7\x8f\xe3\xd1\x05\xbf\x2a\xe8\x4f\x6d\x79\xbb" +
+	"\x11\x55\xfa\xc1\xbc\xa6\xc5\x5c\x9b\xf1\x13\x1f\x56\x52\x72\xb0\xbe\xf2\x71\xa9" +
+	"\x12\xe8\x5e\x92\xfd\xb2\x40\xb9\x58\xc1\x2d\xf3\x5a\x0f\xb5\xbb\xcb\xef\xe9\x98" +
+	"\x97\xd6\x15\xe4\xeb\x82\xf3\x5f\xe6\xc5\x31\xcc\xb1\xcc\x4b\x5c\xcf\x32\xaf\x23" +
+	"\x06\xb6\x8e\x00\xd4\xf2\xc5\x69\x33\x88\xea\x1c\x1d\x72\x15\x93\x80\x72\x70\xc9" +
+	"\x22\xff\xbc\x60\x0a\x24\xd9\x9a\x57\xa3\xb7\x8a\xa2\x45\x2e\xa0\x15\x0b\x36\x19" +
+	"\x8c\xc0\xee\x5f\x1c\xbe\x8b\x50\x64\x72\xf3\x42\xc1\xc8\x69\x6a\xdd\x40\x93\xbf" +
+	"\x61\x29\x75\xd5\x00\x01\x94\x2f\xe6\xd5\x8d\x7d\xb8\xe8\x04\x10\x8f\x77\x88\x99" +
+	"\xb8\xee\x66\x75\x49\xb1\x79\x5e\x56\x07\x2d\x14\x74\x9d\x4c\x84\x81\xd1\x35\xd6" +
+	"\x88\xd8\x06\xc0\xc2\x20\xba\x74\x18\x46\x10\x1d\x8b\x6f\xce\x4d\x0c\x01\x62\xe7" +
+	"\x65\xb9\x2f\x06\xba\x1b\xa6\x4e\xae\x5a\xe3\x64\xfc\xd3\xb5\xde\x08\x76\x16\x56" +
+	"\x62\x6d\xf1\xf6\x3a\x38\x91\x04\x35\x2d\x2c\x51\xd8\xd4\x61\x6b\x96\x51\x39\x93" +
+	"\x64\x4a\x67\xef\xb0\xca\xca\xa6\xe8\x10\x19\xd1\x08\x0c\xd9\x3b\xec\xe1\xc1\x09" +
+	"\xb1\x37\xc6\x9f\xcd\x23\x80\xdd\x89\x77\xd8\xc5\xab\x89\x4a\xba\xf5\xc6\x06\x82" +
+	"\x42\xe1\xae\x5a\x7e\xe7\xc9\x03\x76\xd0\xe9\x42\xe4\xa1\xe3\x30\x89\xa2\x24\x84" +
+	"\x11\xd0\x7e\xe8\x71\xe0\xab\x48\x9d\x0c\xa0\xea\x79\x87\x4b\xdb\x0c\xa4\xb4\x59" +
+	"\x6f\x72\xf0\x15\x88\xc4\xd3\x52\xd4\xb7\x76\x7f\xe8\xdb\x55\x49\xdf\xbe\xb3\xf2" +
+	"\x0e\xad\x53\x0c\x77\x97\x7d\x07\xce\xca\x05\x42\xec\xcc\xa9\x84\x28\xce\x11\x62" +
+	"\xf9\xc0\xe0\xc1\x3b\xa2\x56\xfd\x8e\xfb\x9e\xe2\xf2\x8e\x47\xf2\x8f\x62\x5e\x5b" +
+	"\x5d\xb8\x82\x71\x55\xa8\x88\x87\xc6\xa2\x4b\x8f\x39\xd7\xff\xf6\xb5\x0e\xb4\xe2" +
+	"\xbd\xb8\x06\x94\x0e\x5e\xc2\x5c\xbe\x63\xa9\x5c\xa9\xc6\x3b\xa4\x8e\x99\xe0\x8b" +
+	"\x94\x39\xde\xb1\x50\x34\xb1\xe8\x6d\x7b\x98\x75\x6f\x4e\xd1\xeb\x54\x19\x80\xdd" +
+	"\x89\x77\x6c\x1b\xea\x26\x9c\xf0\x79\x92\x04\x64\xb7\xfc\xaa\xca\x30\x76\xad\xcf" +
+	"\x47\x7c\xb4\xa0\x17\x43\x2b\x2b\x81\x9d\xb4\x37\x3e\xd2\x6e\x12\x46\xd2\x97\x37" +
+	"\x16\xc7\x95\xd9\xc1\xdb\x1f\x84\xf1\xdf\x85\xc1\xcb\x1a\x90\x0f\x63\x6f\x44\xb1" +
+	"\x8b\xce\x99\xbd\xd3\x6a\x82\xcc\x77\x5a\xa5\x38\xc6\xda\xb3\x2e\x18\xfb\x72\xaf" +
+	"\x3a\xc5\xc0\xb3\xf2\x9e\xa2\x69\x18\xe3\x20\xeb\xc5\xcb\x1b\x43\x06\x2a\x05\x9a" +
+	"\x01\x35\x0d\x4d\x65\x79\x27\xee\xf1\xa7\x07\x62\xd6\x4c\x57\xba\xbf\x93\x37\x86" +
+	"\x64\xd6\xc5\x8d\x2a\xb3\x42\x94\x73\xe2\x5e\x24\x11\x85\xc1\xc0\xf0\x96\xd6\xec" +
+	"\x3b\x71\xe3\x9e\x53\x8b\xb0\xe9\xb7\x07\x7e\xd6\x6a\x8d\x03\x64\xce\x51\xc3\x31" +
+	"\x23\xb6\xe5\x04\xfa\x15\x55\x84\x9c\xfa\x53\x96\x7a\x42\x86\xf8\x4e\xa8\xaf\xbe" +
+	"\xb1\x54\xb2\x70\xcb\xb3\x17\xd8\xf0\xaa\xc3\x85\x9a\x4b\x52\x45\x24\xfc\x77\x58" +
+	"\xad\x99\xfa\x7d\xc1\x18\xad\xb0\xe3\xec\xef\xf4\x13\xcb\xa2\x09\x82\xbe\x6f\x80" +
+	"\x40\x00\xf8\xac\x77\xaf\x94\x01\xa6\xb7\x9b\x5f\x7a\x23\xee\x7f\x53\x0e\xf0\x05" +
+	"\x6f\x94\x88\xf0\x9b\xad\xc8\x6a\x9e\x5c\xb1\xfc\xd6\xa9\xdb\x37\x57\x83\xed\xcb" +
+	"\x5b\x13\x69\x5b\xde\x62\x58\xf9\xcd\xcb\xbb\x7e\xf1\xff\xf9\xdb\xbb\x65\x8b\xe7" +
+	"\x13\xd8\x97\xf8\x98\x8d\xbd\x8f\x96\x0e\xfc\x36\xfd\x76\x7e\x91\xd1\x7f\x02\x96" +
+	"\xa1\x25\xe3\xfb\x98\xe6\xfc\x87\x93\x64\x9f\x90\x39\x2e\xb0\x7c\x42\x2e\x53\x84" +
+	"\x72\xff\x84\x3c\x7e\x97\x0f\x42\x96\x4f\x28\x25\x3c\xf8\x5e\x53\x21\xc4\x3e\xee" +
+	"\xe1\x91\x16\xa6\x41\xb5\x25\xc0\x03\xe3\xe6\x72\x32\xf5\x06\x43\x9f\x2c\x27\x09" +
+	"\xc3\x84\x10\x2a\xfb\xf4\x29\xd2\x44\xd8\xcb\x23\x16\x3b\xa8\x00\x45\x06\x0e\x95" +
+	"\x9e\x1a\x00\x45\x19\x0b\x75\xcc\x07\xf4\xe2\xc7\x93\x03\x6f\xf5\xd1\xc6\xe8\x47" +
+	"\xa6\xf6\x54\x38\x5a\x90\x7c\xb8\x7d\xee\x73\xf8\x2e\xb7\x80\x9e\x4f\x7e\xda\x73" +
+	"\xf9\xb0\xfa\xe6\x17\x99\x46\x5b\x3e\x47\x7a\xe2\x98\xce\xae\x0e\xf9\x61\xb3\x92" +
+	"\x0f\xd8\xc0\xfa\x1c\xa9\x7b\x30\xd5\x7d\xb3\xdb\xfd\x39\xea\x79\x3f\xd6\x2b\xca" +
+	"\xc1\x8e\x78\x08\xf4\xe5\x73\x7c\x97\x0f\x4a\x8e\xa8\xda\x65\x30\x8b\xfb\x4f\xda" +
+	"\xf6\x38\x78\xa8\x1f\x7e\x29\x20\xb8\x04\x2b\x51\x10\x41\xa2\xa5\xf6\x49\xcf\xb4" +
+	"\x7c\xd2\x2b\x71\x09\x41\x58\x3e\x29\x6f\x3a\x80\x6d\x80\x20\xdc\x6f\xde\x9e\x48" +
+	"\x9f\x78\x7e\xaa\xe8\x53\xde\xa4\x86\x81\x0b\xb7\x86\x9b\x0b\x29\xc8\xd6\xa0\x3f" +
+	"\xc6\x31\x43\xec\x16\xe8\x9b\x3f\x81\x2a\x5d\x39\x9b\x41\xeb\x0f\x5a\xf2\x1f\x4c" +
+	"\x0c\x7f\x38\x20\xa6\x5f\xf3\xa8\xf0\x0d\xbd\xdc\xa5\x28\x39\xda\xe6\x25\x0e\x29" +
+	"\xa8\x4b\xe7\xc2\xb8\x1d\x7e\x35\xd8\x7c\x6c\x93\xef\x93\x1a\x31\x77\xce\x15\x7f" +
+	"\x50\x6d\xf9\x30\xc1\x7c\x52\xbf\xd4\xd1\x01\x7a\x2f\x35\x84\x4f\xfa\x55\xe6\xab" +
+	"\x14\xe5\x44\x14\x06\xe0\xbb\x7c\xea\x6b\xf9\xd4\xfc\xe4\x87\xc1\xb7\xc4\x45\xfc" +
+	"\x94\xee\x4f\x15\x92\xcc\x41\xc8\x92\x50\xef\xdb\x8c\x6f\xbe\xeb\x53\xcf\xc7\x82" +
+	"\x0e\x15\x3f\x16\x74\xcd\xe0\x5f\xde\x29\xea\x46\x24\xc6\x8a\x19\x6d\xf6\xdc\x61" +
+	"\xfa\x40\xf2\xc9\xd1\x64\x7d\x2e\xd5\x61\x8a\xa0\x72\x65\x97\x71\x6c\x1f\x2d\x38" +
+	"\xb1\x11\xfe\x71\xad\xc8\x4f\xe3\x0c\xeb\xa7\x45\x27\x66\x75\x4b\x80\x6f\xa1\x4a" +
+	"\x0d\xc6\x7b\xf0\x67\xaa\xdf\x46\xbf\x3c\xa3\xde\xee\x54\xcf\xc4\xc5\x29\x04\x7d" +
+	"\x64\xbc\xe0\x87\xbd\xed\xad\x2a\x3c\x94\xfc\x37\xa3\x2a\x57\xd7\x64\x94\x83\x35" +
+	"\x8e\x66\x77\x94\xfd\xb0\x1d\xfc\x13\x5b\xfd\x59\xbe\xe1\xa8\x75\xf9\x86\x73\xf9" +
+	"\xba\x79\xcc\xaf\x6f\x5a\x6a\x1a\xf8\x62\x12\xee\x1b\xc3\xb1\x7c\xb9\xf0\x11\xd1" +
+	"\x29\x40\x1c\xaf\xc0\x57\x2f\x8a\x3f\xf7\xb1\x7c\xfd\x74\xc7\x37\xe6\x6b\xf9\x46" +
+	"\xbe\x66\x93\x85\x61\xe2\x9b\x36\x19\x66\xf9\xd2\x17\x96\x6f\x2a\xcb\x97\xc5\x5f" +
+	"\x5c\xbe\xf5\xff\xbe\x75\xf9\x62\x8c\x6d\xf9\xd6\x3d\x2c\xdf\xba\xcf\x36\x96\x6f" +
+	"\x45\x00\xbb\x7c\x2b\x6f\xb5\x2d\xf5\x1b\xae\xe5\x37\x06\xdd\xc5\xfc\xcb\x5c\xbb" +
+	"\x20\xf9\xfb\x8d\x17\x57\x76\x00\x5a\x5d\x7e\xe3\x7d\x03\x3a\xe8\xbb\xfc\xa6\xfd" +
+	"\xd7\x16\x55\xbf\xe9\x92\xf9\xe8\x5f\xdf\xfd\x83\xf2\xfe\xea\xcb\x6f\x1a\xcb\x6f" +
+	"\xdd\x52\x58\x97\xdf\x7a\x3e\x52\x5c\x7e\x91\xe0\xfe\x9a\x46\xef\x2f\x97\xdc\x45" +
+	"\x26\xb8\xdf\x7a\xf3\x89\x86\xbe\xcb\x6f\xad\xe7\xf2\xff\x03\x00\x41\x2b\x40\x24" +
+	"\xc2\xf2\x00\x00"
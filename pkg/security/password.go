@@ -15,88 +15,960 @@
 package security
 
 import (
-	"bytes"
+	"context"
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/hex"
+	"flag"
 	"fmt"
-	"os"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"golang.org/x/crypto/bcrypt"
-	"golang.org/x/crypto/ssh/terminal"
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
 )
 
-// BcryptCost is the cost to use when hashing passwords. It is exposed for
-// testing.
+// BcryptCost is the cost used to hash passwords unless another one was set
+// with SetBcryptCost, SetCostProvider or TestingSetBcryptCost.
 //
-// BcryptCost should increase along with computation power.
-// For estimates, see: http://security.stackexchange.com/questions/17207/recommended-of-rounds-for-bcrypt
+// BcryptCost should increase along with computation power. BenchmarkHashCosts
+// measures the latency of each cost on the local machine, and
+// RecommendedBcryptCost picks one for a target latency.
 // For now, we use the library's default cost.
+//
+// Deprecated: BcryptCost is only read once, the first time the cost is
+// needed, so that reading the cost never races with hashing. Writing it
+// afterwards, or once a cost was set with one of the functions above, has
+// no effect. Use them instead, and GetBcryptCost to read the cost.
 var BcryptCost = bcrypt.DefaultCost
 
+// bcryptCost is the cost set with SetBcryptCost or TestingSetBcryptCost, or
+// BcryptCost if none was. It is accessed atomically, once initialized with
+// initBcryptCost.
+var bcryptCost int32
+
+var bcryptCostInit sync.Once
+
+// initBcryptCost initializes bcryptCost from BcryptCost, the first time it
+// is called. It must be called before bcryptCost is accessed.
+func initBcryptCost() {
+	bcryptCostInit.Do(func() {
+		atomic.StoreInt32(&bcryptCost, int32(BcryptCost))
+	})
+}
+
+// costProviderBox wraps the function set with SetCostProvider so that it can
+// be stored in an atomic.Value, including when it is nil.
+type costProviderBox struct {
+	provider func() int
+}
+
+var costProvider atomic.Value
+
+func init() {
+	costProvider.Store(costProviderBox{})
+}
+
+// GetBcryptCost returns the cost used to hash passwords with bcrypt. It is
+// safe for concurrent use with the functions setting the cost.
+func GetBcryptCost() int {
+	if p := costProvider.Load().(costProviderBox).provider; p != nil {
+		cost := p()
+		if cost < MinAcceptedBcryptCost {
+			cost = MinAcceptedBcryptCost
+		} else if cost > bcrypt.MaxCost {
+			cost = bcrypt.MaxCost
+		}
+		return cost
+	}
+	initBcryptCost()
+	return int(atomic.LoadInt32(&bcryptCost))
+}
+
+// SetCostProvider makes the bcrypt cost resolved by calling the provider
+// every time a password is hashed, e.g. to read a cluster setting. The
+// provider takes precedence over the cost set with SetBcryptCost, and must
+// be cheap and safe for concurrent use. Like SetBcryptCost, it cannot lower
+// the cost below MinAcceptedBcryptCost: costs outside of
+// [MinAcceptedBcryptCost, 31] are clamped to that range. A nil provider
+// restores the cost set with SetBcryptCost.
+func SetCostProvider(provider func() int) {
+	costProvider.Store(costProviderBox{provider})
+}
+
+// MinAcceptedBcryptCost is the lowest cost accepted by SetBcryptCost. Hashes
+// with a lower cost are cheap enough to brute-force that they provide little
+// protection if leaked.
+const MinAcceptedBcryptCost = bcrypt.DefaultCost
+
+// SetBcryptCost sets the cost used to hash passwords with bcrypt, refusing
+// costs outside of [MinAcceptedBcryptCost, 31].
+func SetBcryptCost(cost int) error {
+	if cost < MinAcceptedBcryptCost || cost > bcrypt.MaxCost {
+		return errors.Errorf("bcrypt cost %d outside of [%d, %d]",
+			cost, MinAcceptedBcryptCost, bcrypt.MaxCost)
+	}
+	initBcryptCost()
+	atomic.StoreInt32(&bcryptCost, int32(cost))
+	return nil
+}
+
+// TestingSetBcryptCost sets the cost used to hash passwords with bcrypt,
+// including costs below MinAcceptedBcryptCost, so that tests can produce
+// cheap hashes. The returned function restores the previous cost.
+func TestingSetBcryptCost(cost int) func() {
+	if flag.Lookup("test.v") == nil {
+		panic("TestingSetBcryptCost can only be used in tests")
+	}
+	initBcryptCost()
+	prev := atomic.SwapInt32(&bcryptCost, int32(cost))
+	return func() {
+		atomic.StoreInt32(&bcryptCost, prev)
+	}
+}
+
+// DefaultMaxPasswordLength is the default maximum length of passwords, in
+// bytes (see SetMaxPasswordLength).
+const DefaultMaxPasswordLength = 512
+
+// maxPasswordLength is the length set by SetMaxPasswordLength. It is
+// accessed atomically.
+var maxPasswordLength int32 = DefaultMaxPasswordLength
+
+// SetMaxPasswordLength sets the maximum length of passwords, in bytes, which
+// is DefaultMaxPasswordLength by default. Longer passwords are rejected with
+// a *PasswordTooLongError before any work is spent on them, both when they
+// are set and when they are verified, so that clients cannot make the server
+// hash arbitrarily large inputs. Passwords longer than a lowered limit can no
+// longer be verified.
+func SetMaxPasswordLength(n int) error {
+	if n <= 0 || n > math.MaxInt32 {
+		return errors.Errorf("invalid maximum password length %d", n)
+	}
+	atomic.StoreInt32(&maxPasswordLength, int32(n))
+	return nil
+}
+
+// checkPasswordLength returns a *PasswordTooLongError if the length of a
+// password, in bytes, is above the maximum set by SetMaxPasswordLength.
+func checkPasswordLength(length int) error {
+	if max := int(atomic.LoadInt32(&maxPasswordLength)); length > max {
+		return &PasswordTooLongError{Max: max, Actual: length}
+	}
+	return nil
+}
+
+// minVerifyCost is the cost set by SetMinVerifyCost. It is accessed
+// atomically.
+var minVerifyCost int32
+
+// SetMinVerifyCost sets the lowest bcrypt cost stored hashes are expected to
+// have. Hashes with a lower cost still verify, but NeedsRehash reports them
+// regardless of the configured cost, and the successful verifications against them
+// are counted in PasswordMetrics.VerifyWeakHash. A cost of 0, the default,
+// disables the check.
+func SetMinVerifyCost(cost int) error {
+	if cost < 0 || cost > bcrypt.MaxCost {
+		return errors.Errorf("invalid minimum bcrypt cost %d", cost)
+	}
+	atomic.StoreInt32(&minVerifyCost, int32(cost))
+	return nil
+}
+
+// isWeakHash reports whether a bcrypt hash has a cost below the one set with
+// SetMinVerifyCost.
+func isWeakHash(h PasswordHash) bool {
+	cost := h.Cost()
+	return cost > 0 && cost < int(atomic.LoadInt32(&minVerifyCost))
+}
+
+// HashMethod identifies the algorithm used to hash a password.
+type HashMethod int
+
+const (
+	// HashMethodUnknown is the zero value and does not identify any
+	// algorithm.
+	HashMethodUnknown HashMethod = iota
+	// HashBCrypt is bcrypt applied to a SHA-256 pre-hash of the password. It
+	// is the default method and the only one used before other methods were
+	// introduced. See bcryptV2Prefix for the legacy and current variants.
+	HashBCrypt
+	// HashArgon2id is Argon2id, using the parameters Argon2Memory,
+	// Argon2Time and Argon2Threads.
+	HashArgon2id
+	// HashScrypt is scrypt, using the parameters ScryptN, ScryptR and
+	// ScryptP.
+	HashScrypt
+	// HashPBKDF2 is PBKDF2-HMAC-SHA256, using PBKDF2Iterations. Unlike the
+	// other methods it only relies on NIST-approved primitives.
+	HashPBKDF2
+	// HashSCRAMSHA256 identifies SCRAM-SHA-256 verifiers (see
+	// GenerateSCRAMVerifier). When used to hash passwords, it uses
+	// SCRAMMinIterations.
+	HashSCRAMSHA256
+	// HashPGMD5 identifies the md5 hashes used by PostgreSQL before SCRAM,
+	// which can be found in PostgreSQL dumps. Passwords are never hashed
+	// with it.
+	HashPGMD5
+	// HashSSHA and HashSSHA512 identify the salted SHA-1 and SHA-512
+	// userPassword values of LDAP directories. Like HashPGMD5, they are only
+	// supported for verification of imported hashes.
+	HashSSHA
+	HashSSHA512
+	// HashDjangoSHA1 and HashDjangoMD5 identify the legacy salted SHA-1 and
+	// MD5 hashes of Django. They are only supported for verification of
+	// imported hashes, once enabled with AllowLegacyHashVerification.
+	HashDjangoSHA1
+	HashDjangoMD5
+	// HashPasslibBcryptSHA256 identifies the bcrypt-sha256 hashes of Python's
+	// passlib. They are only supported for verification, so that users shared
+	// with Python services can log in before being rehashed.
+	HashPasslibBcryptSHA256
+)
+
+// hashMethodNames are the names of the hash methods, as used by String and
+// ParseHashMethod.
+var hashMethodNames = [...]string{
+	HashMethodUnknown:       "unknown",
+	HashBCrypt:              "bcrypt",
+	HashArgon2id:            "argon2id",
+	HashScrypt:              "scrypt",
+	HashPBKDF2:              "pbkdf2-sha256",
+	HashSCRAMSHA256:         "scram-sha-256",
+	HashPGMD5:               "md5",
+	HashSSHA:                "ssha",
+	HashSSHA512:             "ssha512",
+	HashDjangoSHA1:          "django-sha1",
+	HashDjangoMD5:           "django-md5",
+	HashPasslibBcryptSHA256: "passlib-bcrypt-sha256",
+}
+
+func (m HashMethod) String() string {
+	if m < 0 || int(m) >= len(hashMethodNames) {
+		return fmt.Sprintf("HashMethod(%d)", int(m))
+	}
+	return hashMethodNames[m]
+}
+
+// ParseHashMethod returns the hash method with the given name, e.g.
+// "bcrypt" or "scram-sha-256", ignoring case. It is the inverse of String,
+// so that the default hash method can be driven by a cluster setting or an
+// environment variable.
+func ParseHashMethod(name string) (HashMethod, error) {
+	for m, n := range hashMethodNames {
+		if HashMethod(m) != HashMethodUnknown && strings.EqualFold(n, name) {
+			return HashMethod(m), nil
+		}
+	}
+	return HashMethodUnknown, errors.Errorf("unknown hash method %q", name)
+}
+
+// defaultHashMethod is the HashMethod used by HashPassword. It is accessed
+// atomically.
+var defaultHashMethod = int32(HashBCrypt)
+
+// GetDefaultHashMethod returns the method HashPassword uses for new
+// passwords. It is safe for concurrent use with SetDefaultHashMethod. In
+// FIPS mode, it is HashPBKDF2 unless an approved method was set.
+func GetDefaultHashMethod() HashMethod {
+	method := HashMethod(atomic.LoadInt32(&defaultHashMethod))
+	if FIPSMode() && !fipsApproved(method) {
+		return HashPBKDF2
+	}
+	return method
+}
+
+// SetDefaultHashMethod sets the method HashPassword uses for new passwords.
+// Hashes produced by any other method keep verifying, since
+// CompareHashAndPassword infers the method from the hash. Methods that are
+// only supported for verification of imported hashes are rejected, as are
+// methods that are not FIPS-approved in FIPS mode (see SetFIPSMode).
+func SetDefaultHashMethod(method HashMethod) error {
+	switch method {
+	case HashBCrypt, HashArgon2id, HashScrypt, HashPBKDF2, HashSCRAMSHA256:
+	default:
+		return &HashMethodUnsupportedError{Method: method}
+	}
+	if err := checkFIPSApproved(method); err != nil {
+		return err
+	}
+	atomic.StoreInt32(&defaultHashMethod, int32(method))
+	return nil
+}
+
 // ErrEmptyPassword indicates that an empty password was attempted to be set.
 var ErrEmptyPassword = errors.New("empty passwords are not permitted")
 
+// ErrPasswordMismatch indicates that a password does not match the hash it
+// was compared against. It is the same error bcrypt returns, so that callers
+// can check for a mismatch regardless of the hashing method.
+var ErrPasswordMismatch = bcrypt.ErrMismatchedHashAndPassword
+
+// ErrPasswordLoginDisabled indicates that a password was compared against
+// the hash of a user that cannot log in with a password, because it was
+// created without one.
+var ErrPasswordLoginDisabled = errors.New("password login is disabled for this user")
+
+// missingPasswordHash is the hash stored for users without a password. It
+// cannot be produced by any hash method, and no password verifies against
+// it.
+const missingPasswordHash = "crdb-nologin"
+
+// MissingPasswordHash returns the hash to store for users that cannot log in
+// with a password.
+func MissingPasswordHash() []byte {
+	return []byte(missingPasswordHash)
+}
+
+// missingUserHashParams are the parameters a MissingUserHashedPassword hash
+// was generated with.
+type missingUserHashParams struct {
+	method           HashMethod
+	bcryptCost       int
+	argon2Memory     uint32
+	argon2Time       uint32
+	argon2Threads    uint8
+	scryptN          int
+	scryptR          int
+	scryptP          int
+	pbkdf2Iterations int
+	pepperKeyID      string
+	normalized       bool
+}
+
+// missingUserHash caches the hash returned by MissingUserHashedPassword.
+var missingUserHash struct {
+	syncutil.Mutex
+	params missingUserHashParams
+	hash   []byte
+}
+
+// MissingUserHashedPassword returns the hash of a random password, generated
+// once per process with the default hash method and cost, to compare the
+// supplied password against when a login targets a user that does not
+// exist:
+//
+//	err := CompareHashAndPassword(MissingUserHashedPassword(), password)
+//
+// The comparison takes as long as against the hash of an existing user, so
+// that the time taken to reject the login does not reveal whether the user
+// exists, and fails with ErrPasswordMismatch. The hash is generated again
+// when the default hash method or its parameters, e.g. the bcrypt cost or
+// Argon2Memory, the active pepper key or password normalization change, so
+// that it keeps tracking the hashes of new passwords.
+func MissingUserHashedPassword() []byte {
+	id, _ := activePepperID()
+	params := missingUserHashParams{
+		method:           GetDefaultHashMethod(),
+		bcryptCost:       GetBcryptCost(),
+		argon2Memory:     Argon2Memory,
+		argon2Time:       Argon2Time,
+		argon2Threads:    Argon2Threads,
+		scryptN:          ScryptN,
+		scryptR:          ScryptR,
+		scryptP:          ScryptP,
+		pbkdf2Iterations: PBKDF2Iterations,
+		pepperKeyID:      id,
+		normalized:       passwordNormalizationEnabled(),
+	}
+	missingUserHash.Lock()
+	defer missingUserHash.Unlock()
+	if missingUserHash.hash == nil || missingUserHash.params != params {
+		var random [32]byte
+		if _, err := rand.Read(random[:]); err != nil {
+			// Without randomness no hash can be generated either. The login
+			// is rejected all the same.
+			return MissingPasswordHash()
+		}
+		// The password is hex encoded so that it is valid UTF-8, which
+		// password normalization requires.
+		password := make([]byte, hex.EncodedLen(len(random)))
+		hex.Encode(password, random[:])
+		ZeroBytes(random[:])
+		hash, err := hashPasswordWithMethod(params.method, password)
+		ZeroBytes(password)
+		if err != nil {
+			return MissingPasswordHash()
+		}
+		// The hash is cached with the parameters it records rather than the
+		// ones read above, which could have changed in the meantime, e.g. a
+		// bcrypt cost set by a cost provider.
+		if h, err := ParsePasswordHash(hash); err == nil {
+			param := func(name string) int {
+				v, _ := h.Param(name)
+				return v
+			}
+			switch h.Method() {
+			case HashBCrypt:
+				params.bcryptCost = h.Cost()
+			case HashArgon2id:
+				params.argon2Memory, params.argon2Time = uint32(param("m")), uint32(param("t"))
+				params.argon2Threads = uint8(param("p"))
+			case HashScrypt:
+				params.scryptN = 1 << uint(param("ln"))
+				params.scryptR, params.scryptP = param("r"), param("p")
+			case HashPBKDF2:
+				params.pbkdf2Iterations = h.Iterations()
+			}
+		}
+		missingUserHash.params, missingUserHash.hash = params, hash
+	}
+	return append([]byte(nil), missingUserHash.hash...)
+}
+
+// IsPasswordLoginDisabled returns whether the hash is that of a user that
+// cannot log in with a password: either MissingPasswordHash, or the empty
+// hash stored for such users by previous versions.
+func IsPasswordLoginDisabled(hashedPassword []byte) bool {
+	return len(hashedPassword) == 0 || string(hashedPassword) == missingPasswordHash
+}
+
+// burnPasswordWork compares the password against the hash returned by
+// MissingUserHashedPassword, which uses the configured method and cost, so
+// that users without a password, or with a malformed or unsupported hash,
+// cannot be told apart from users with a valid hash by the time taken to
+// reject their login. If that hash cannot be generated, it performs as much
+// work as comparing against a bcrypt hash or, in FIPS mode, where bcrypt
+// cannot be used, against a PBKDF2 hash.
+func burnPasswordWork(password []byte) {
+	if h, err := ParsePasswordHash(MissingUserHashedPassword()); err == nil {
+		_ = h.verify(password)
+		return
+	}
+	if FIPSMode() {
+		_ = pbkdf2.Key(password, make([]byte, pbkdf2SaltLen), PBKDF2Iterations, pbkdf2KeyLen,
+			sha256.New)
+		return
+	}
+	input := bcryptPreHash(password)
+	defer ZeroBytes(input)
+	_, _ = bcrypt.GenerateFromPassword(input, GetBcryptCost())
+}
+
 // CompareHashAndPassword tests that the provided bytes are equivalent to the
 // hash of the supplied password. If they are not equivalent, returns an
-// error.
+// error. Unless replaced with SetDefaultHasher, the default Hasher infers the
+// hashing method from the prefix of the hash (see RegisterHasher). Hashes
+// without a recognized prefix are decoded from hex or base64 if that yields a
+// hash with a recognized prefix, and otherwise rejected with a
+// MalformedHashError. So are structurally invalid hashes, e.g. bcrypt hashes
+// with an invalid cost, salt or digest. If password login is disabled for
+// the user the hash belongs to (see IsPasswordLoginDisabled), it returns
+// ErrPasswordLoginDisabled.
+//
+// Rejecting a hash, whether it is empty, malformed, unsupported or not
+// permitted in FIPS mode, takes as long as comparing against a valid hash
+// of the configured method and cost, so that the time taken to reject a
+// login does not reveal whether the user has a usable password.
 func CompareHashAndPassword(hashedPassword []byte, password string) error {
-	h := sha256.New()
-	// TODO(benesch): properly apply SHA-256 to the password. The current code
-	// erroneously appends the SHA-256 of the empty hash to the unhashed password
-	// instead of actually hashing the password. Fixing this requires a somewhat
-	// complicated backwards compatibility dance. This is not a security issue
-	// because the round of SHA-256 was only intended to achieve a fixed-length
-	// input to bcrypt; it is bcrypt that provides the cryptographic security, and
-	// bcrypt is correctly applied.
-	//
-	//lint:ignore HC1000 backwards compatibility
-	return bcrypt.CompareHashAndPassword(hashedPassword, h.Sum([]byte(password)))
-}
-
-// HashPassword takes a raw password and returns a bcrypt hashed password.
-func HashPassword(password string) ([]byte, error) {
-	h := sha256.New()
-	//lint:ignore HC1000 backwards compatibility (see CompareHashAndPassword)
-	return bcrypt.GenerateFromPassword(h.Sum([]byte(password)), BcryptCost)
+	return CompareHashAndPasswordBytes(hashedPassword, []byte(password))
+}
+
+// CompareHashAndPasswordBytes is like CompareHashAndPassword, but takes the
+// password as a byte slice, e.g. a SecureString, which the caller can zero
+// once it is done with it. Intermediate buffers derived from the password
+// are zeroed before returning.
+func CompareHashAndPasswordBytes(hashedPassword, password []byte) error {
+	return compareHashAndPassword(context.Background(), hashedPassword, password)
+}
+
+// CompareHashAndPasswordSecure is like CompareHashAndPasswordBytes, but takes
+// the password as a SecureString, e.g. as read by a Prompter. The password is
+// not destroyed.
+func CompareHashAndPasswordSecure(hashedPassword []byte, password SecureString) error {
+	return CompareHashAndPasswordBytes(hashedPassword, password.UnsafeBytes())
+}
+
+// CompareHashAndPasswordTimed is like CompareHashAndPassword, but also
+// returns how long evaluating the hash took, excluding the time spent
+// waiting for the limit set by SetMaxVerifyConcurrency. The duration is 0 if
+// no hash was evaluated, e.g. because the outcome was cached or the hash is
+// malformed. See also EstimatedVerifyCost.
+func CompareHashAndPasswordTimed(hashedPassword []byte, password string) (time.Duration, error) {
+	return compareHashAndPasswordTimed(
+		context.Background(), hashedPassword, []byte(password), "" /* username */)
+}
+
+// compareHashAndPassword implements CompareHashAndPasswordBytes, waiting
+// for the limit set by SetMaxVerifyConcurrency unless the context is
+// canceled.
+func compareHashAndPassword(ctx context.Context, hashedPassword, password []byte) error {
+	_, err := compareHashAndPasswordTimed(ctx, hashedPassword, password, "" /* username */)
+	return err
+}
+
+// compareHashAndPasswordTimed implements CompareHashAndPasswordTimed. The
+// username is only used to verify PostgreSQL md5 hashes, and is empty if
+// it is not known.
+func compareHashAndPasswordTimed(
+	ctx context.Context, hashedPassword, password []byte, username string,
+) (time.Duration, error) {
+	hook := activeVerificationAuditHook()
+	if hook == nil {
+		return compareHashAndPasswordMetered(ctx, hashedPassword, password, username)
+	}
+	start := hook.start()
+	d, err := compareHashAndPasswordMetered(ctx, hashedPassword, password, username)
+	hook.record(ctx, start, hashedPassword, err)
+	return d, err
 }
 
-// PromptForPassword prompts for a password.
-// This is meant to be used when using a password.
-func PromptForPassword() (string, error) {
-	fmt.Print("Enter password: ")
-	password, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+func compareHashAndPasswordMetered(
+	ctx context.Context, hashedPassword, password []byte, username string,
+) (time.Duration, error) {
+	hashUsage.verified.record(hashedPassword)
+	m := activePasswordMetrics()
+	if m == nil {
+		return compareHashAndPasswordImpl(ctx, hashedPassword, password, username)
+	}
+	start := m.start()
+	d, err := compareHashAndPasswordImpl(ctx, hashedPassword, password, username)
+	m.recordVerify(start, err)
+	if err == nil && atomic.LoadInt32(&minVerifyCost) > 0 {
+		if h, err := ParsePasswordHash(hashedPassword); err == nil && isWeakHash(h) {
+			m.VerifyWeakHash.Inc(1)
+		}
+	}
+	return d, err
+}
+
+func compareHashAndPasswordImpl(
+	ctx context.Context, hashedPassword, password []byte, username string,
+) (time.Duration, error) {
+	// Overlong passwords are rejected before anything is derived from them.
+	if err := checkPasswordLength(len(password)); err != nil {
+		return 0, err
+	}
+	var cacheKey verifyCacheKey
+	var cacheGeneration uint64
+	var cacheEnabled bool
+	if !IsPasswordLoginDisabled(hashedPassword) {
+		var match, ok bool
+		cacheKey, cacheGeneration, match, ok, cacheEnabled = verifyCacheLookup(
+			hashedPassword, password, username)
+		if ok {
+			if match {
+				return 0, nil
+			}
+			return 0, ErrPasswordMismatch
+		}
+	}
+	release, err := acquireVerifySlot(ctx)
 	if err != nil {
-		return "", err
+		return 0, err
+	}
+	defer release()
+	if IsPasswordLoginDisabled(hashedPassword) {
+		burnPasswordWork(password)
+		return 0, ErrPasswordLoginDisabled
+	}
+	// In FIPS mode, hashes without a recognized prefix are not decoded, and
+	// cannot be verified by registered Hashers.
+	if err := checkFIPSApproved(sniffHashMethod(hashedPassword)); err != nil {
+		burnPasswordWork(password)
+		return 0, err
+	}
+	start := timeutil.Now()
+	if username != "" && sniffHashMethod(hashedPassword) == HashPGMD5 {
+		err = comparePGMD5HashAndPassword(hashedPassword, password, username)
+	} else {
+		err = DefaultHasher().Compare(hashedPassword, password)
+	}
+	if err != nil && err != ErrPasswordMismatch {
+		// The hash was rejected before evaluating the hash function.
+		burnPasswordWork(password)
+		return 0, err
+	}
+	d := timeutil.Since(start)
+	recordVerifyCost(d)
+	if cacheEnabled {
+		verifyCacheAdd(cacheKey, cacheGeneration, hashedPassword, err == nil)
 	}
-	// Make sure stdout moves on to the next line.
-	fmt.Print("\n")
+	return d, err
+}
 
-	return string(password), nil
+// CompareHashAndPasswordCtx is like CompareHashAndPassword, but stops
+// waiting for the comparison when the context is canceled, e.g. because the
+// client disconnected. The comparison itself runs to completion in the
+// background, unless it was still waiting for the limit set by
+// SetMaxVerifyConcurrency. On cancellation, the returned error wraps
+// ctx.Err(), and never is ErrPasswordMismatch.
+func CompareHashAndPasswordCtx(ctx context.Context, hashedPassword []byte, password string) error {
+	_, err := runWithContext(ctx, "comparing password", password, func(pw []byte) ([]byte, error) {
+		return nil, compareHashAndPassword(ctx, hashedPassword, pw)
+	})
+	return err
 }
 
-// PromptForPasswordTwice prompts for a password twice, returning the read string if
-// they match, or an error.
-// This is meant to be used when setting a password.
-func PromptForPasswordTwice() (string, error) {
-	fmt.Print("Enter password: ")
-	one, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+// runWithContext runs f on a copy of the password in a goroutine, and waits
+// for it to finish or for the context to be canceled. The copy is zeroed once
+// f returns, as is its result if it is discarded because of a cancellation.
+func runWithContext(
+	ctx context.Context, op string, password string, f func(password []byte) ([]byte, error),
+) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, op)
+	}
+	type result struct {
+		b   []byte
+		err error
+	}
+	done := make(chan result)
+	pw := []byte(password)
+	go func() {
+		b, err := f(pw)
+		ZeroBytes(pw)
+		select {
+		case done <- result{b, err}:
+		case <-ctx.Done():
+			ZeroBytes(b)
+		}
+	}()
+	select {
+	case r := <-done:
+		return r.b, r.err
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), op)
+	}
+}
+
+// CompareHashAndPasswordWithUser is like CompareHashAndPassword, but also
+// accepts the name of the user the hash belongs to. This is required to
+// verify PostgreSQL md5 hashes, e.g. of users imported from a PostgreSQL
+// dump. Other hashes are verified as by CompareHashAndPassword. md5 hashes
+// too are subject to SetMaxVerifyConcurrency and the verification cache, and
+// rejecting them takes as long as rejecting other hashes.
+func CompareHashAndPasswordWithUser(hashedPassword []byte, password, username string) error {
+	if sniffHashMethod(hashedPassword) != HashPGMD5 {
+		return CompareHashAndPassword(hashedPassword, password)
+	}
+	_, err := compareHashAndPasswordTimed(
+		context.Background(), hashedPassword, []byte(password), username)
+	return err
+}
+
+// comparePGMD5HashAndPassword verifies a password against a PostgreSQL md5
+// hash of the given user.
+func comparePGMD5HashAndPassword(hashedPassword, password []byte, username string) error {
+	h, err := ParsePasswordHash(hashedPassword)
 	if err != nil {
-		return "", err
+		return err
 	}
-	if len(one) == 0 {
-		return "", ErrEmptyPassword
+	return verifyPGMD5(h, password, username)
+}
+
+// ErrNoPasswordHashes indicates that CompareAnyHashAndPassword was given no
+// non-empty hash to compare against.
+var ErrNoPasswordHashes = errors.New("no password hashes to compare against")
+
+// CompareAnyHashAndPassword compares a password against several candidate
+// hashes, e.g. the old and new hashes of a user during a migration between
+// hash methods. It returns the index of the first hash the password matches.
+// The remaining hashes are still compared against, so that the time taken
+// does not reveal which hash matched.
+//
+// Empty and malformed hashes are skipped, but keep their index. If no hash
+// matches, it returns -1 and ErrPasswordMismatch, or if no hash could be
+// compared against at all, the error for the first malformed hash, or
+// ErrNoPasswordHashes if all hashes are empty.
+func CompareAnyHashAndPassword(hashes [][]byte, password string) (matchedIndex int, err error) {
+	matchedIndex = -1
+	var malformedErr error
+	compared := false
+	for i, h := range hashes {
+		if len(h) == 0 {
+			continue
+		}
+		err := CompareHashAndPassword(h, password)
+		switch {
+		case err == nil:
+			if matchedIndex == -1 {
+				matchedIndex = i
+			}
+			compared = true
+		case err == ErrPasswordMismatch:
+			compared = true
+		case malformedErr == nil:
+			malformedErr = err
+		}
+	}
+	switch {
+	case matchedIndex != -1:
+		return matchedIndex, nil
+	case compared:
+		return -1, ErrPasswordMismatch
+	case malformedErr != nil:
+		return -1, malformedErr
+	default:
+		return -1, ErrNoPasswordHashes
 	}
-	fmt.Print("\nConfirm password: ")
-	two, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+}
+
+// NeedsRehash reports whether a stored password hash should be replaced
+// by a fresh one computed with HashPassword the next time the password is
+// available, e.g. after a successful login. It returns false only if the
+// hash exactly matches the current policy: it was produced with the default
+// hash method (see SetDefaultHashMethod) using the currently configured
+// parameters, e.g. GetBcryptCost, and for bcrypt, with the current pre-hash and
+// pepper key (see bcryptV2Prefix and AddPepperKey). In particular, it returns
+// true for imported PostgreSQL md5 and LDAP hashes, for hashes that cannot
+// be decoded, so that callers can force a password reset, and for bcrypt
+// hashes below the cost set with SetMinVerifyCost.
+func NeedsRehash(hashedPassword []byte) bool {
+	h, err := ParsePasswordHash(hashedPassword)
 	if err != nil {
-		return "", err
+		return true
 	}
-	// Make sure stdout moves on to the next line.
-	fmt.Print("\n")
-	if !bytes.Equal(one, two) {
-		return "", errors.New("password mismatch")
+	return isWeakHash(h) || !matchesPolicy(h)
+}
+
+// matchesPolicy reports whether a hash was produced with the default
+// method and the parameters currently configured for it.
+func matchesPolicy(h PasswordHash) bool {
+	if h.method != GetDefaultHashMethod() {
+		return false
+	}
+	if h.normalized != (passwordNormalizationEnabled() && normalizes(h.method)) {
+		return false
+	}
+	param := func(name string) int {
+		v, _ := h.Param(name)
+		return v
+	}
+	switch h.method {
+	case HashBCrypt:
+		if id, ok := activePepperID(); ok {
+			if h.version != bcryptPeppered || h.keyID != id {
+				return false
+			}
+		} else if h.version != bcryptV2 {
+			return false
+		}
+		return h.Cost() == GetBcryptCost()
+	case HashArgon2id:
+		return param("m") == int(Argon2Memory) && param("t") == int(Argon2Time) &&
+			param("p") == int(Argon2Threads)
+	case HashScrypt:
+		return 1<<uint(param("ln")) == ScryptN && param("r") == ScryptR && param("p") == ScryptP
+	case HashPBKDF2:
+		return param("i") == PBKDF2Iterations
+	case HashSCRAMSHA256:
+		return param("i") == SCRAMMinIterations
+	default:
+		return false
 	}
+}
 
-	return string(one), nil
+// UpgradeHashIfNeeded verifies a password against its stored hash and, if
+// verification succeeds and the hash needs a rehash (see NeedsRehash),
+// returns a replacement hash for the caller to persist. If no
+// upgrade is needed, it returns a nil hash and false. No hash is returned if
+// verification fails.
+func UpgradeHashIfNeeded(
+	storedHash []byte, password string,
+) (newHash []byte, upgraded bool, err error) {
+	if err := CompareHashAndPassword(storedHash, password); err != nil {
+		return nil, false, err
+	}
+	return upgradeVerifiedHash(storedHash, password)
+}
+
+// UpgradeHashIfNeededWithUser is like UpgradeHashIfNeeded, but verifies
+// the password with CompareHashAndPasswordWithUser, so that imported
+// PostgreSQL md5 hashes can be upgraded.
+func UpgradeHashIfNeededWithUser(
+	storedHash []byte, password, username string,
+) (newHash []byte, upgraded bool, err error) {
+	if err := CompareHashAndPasswordWithUser(storedHash, password, username); err != nil {
+		return nil, false, err
+	}
+	return upgradeVerifiedHash(storedHash, password)
+}
+
+// upgradeVerifiedHash implements UpgradeHashIfNeeded once the password has
+// been verified.
+func upgradeVerifiedHash(storedHash []byte, password string) ([]byte, bool, error) {
+	if _, err := ParsePasswordHash(storedHash); err != nil {
+		// The hash was verified by a registered Hasher whose format we
+		// cannot inspect; leave it alone.
+		return nil, false, nil
+	}
+	if !NeedsRehash(storedHash) {
+		return nil, false, nil
+	}
+	newHash, err := rehashPassword([]byte(password))
+	if err != nil {
+		return nil, false, err
+	}
+	return newHash, true, nil
+}
+
+// HashPassword takes a raw password and returns a hashed password using the
+// default Hasher, which uses bcrypt unless a different method was configured
+// with SetDefaultHashMethod. Empty passwords are rejected with
+// ErrEmptyPassword, passwords with NUL bytes, control characters or invalid
+// UTF-8 as described by CheckPasswordEncoding, and passwords which do not
+// follow the policy installed with SetPasswordPolicy with a
+// *PolicyViolations (see PasswordPolicy.Validate).
+func HashPassword(password string) ([]byte, error) {
+	return HashPasswordBytes([]byte(password))
+}
+
+// HashPasswordBytes is like HashPassword, but takes the password as a byte
+// slice, e.g. a SecureString, which the caller can zero once it is done with
+// it. Intermediate buffers derived from the password are zeroed before
+// returning.
+func HashPasswordBytes(password []byte) ([]byte, error) {
+	return instrumentHash(password, activePasswordPolicy(), "",
+		func(password []byte) ([]byte, error) {
+			return DefaultHasher().Hash(password)
+		})
+}
+
+// HashPasswordSecure is like HashPasswordBytes, but takes the password as a
+// SecureString, e.g. as read by a Prompter. The password is not destroyed.
+func HashPasswordSecure(password SecureString) ([]byte, error) {
+	return HashPasswordBytes(password.UnsafeBytes())
+}
+
+// HashPasswordWithUser is like HashPassword, but also enforces the rules of
+// the password policy which depend on the user setting the password, such as
+// PasswordPolicy.RejectUsername.
+func HashPasswordWithUser(password, username string) ([]byte, error) {
+	return instrumentHash([]byte(password), activePasswordPolicy(), username,
+		func(password []byte) ([]byte, error) {
+			return DefaultHasher().Hash(password)
+		})
+}
+
+// rehashPassword is like HashPasswordBytes, but does not enforce the
+// password policy. It is used to upgrade the hash of a password which was
+// just verified, and which must keep working even if it does not follow a
+// policy installed after it was set.
+func rehashPassword(password []byte) ([]byte, error) {
+	return instrumentHash(password, nil, "", func(password []byte) ([]byte, error) {
+		return DefaultHasher().Hash(password)
+	})
+}
+
+// HashPasswordCtx is like HashPassword, but stops waiting for the hash when
+// the context is canceled. The hash itself is computed to completion in the
+// background, and then discarded. On cancellation, the returned error wraps
+// ctx.Err().
+func HashPasswordCtx(ctx context.Context, password string) ([]byte, error) {
+	return runWithContext(ctx, "hashing password", password, HashPasswordBytes)
+}
+
+// HashPasswordWithMethod takes a raw password and returns a hashed password
+// using the given method. The result is self-describing: it can be passed
+// to CompareHashAndPassword without knowing which method produced it. In
+// FIPS mode, methods that are not FIPS-approved are rejected.
+func HashPasswordWithMethod(method HashMethod, password string) ([]byte, error) {
+	return instrumentHash([]byte(password), activePasswordPolicy(), "",
+		func(password []byte) ([]byte, error) {
+			return hashPasswordWithMethod(method, password)
+		})
+}
+
+// instrumentHash hashes a password with the hash function, after rejecting
+// overlong and empty passwords. Unless the policy is nil, it first trims the
+// password according to the whitespace mode of the policy, and rejects
+// passwords which cannot be set (see CheckPasswordEncoding) or do not follow
+// the policy. The username, if known, is that of the user setting the
+// password. Hashes which would disable password login are rejected too. The
+// outcome is recorded in the PasswordMetrics, and the hash created in the
+// counters of GetHashUsageSnapshot.
+func instrumentHash(
+	password []byte, policy *PasswordPolicy, username string, hash func([]byte) ([]byte, error),
+) ([]byte, error) {
+	m := activePasswordMetrics()
+	var start time.Time
+	if m != nil {
+		start = m.start()
+	}
+	var hashedPassword []byte
+	err := checkPasswordLength(len(password))
+	if err == nil && policy != nil {
+		password = policy.trimWhitespace(password)
+	}
+	switch {
+	case err != nil:
+	case len(password) == 0:
+		err = ErrEmptyPassword
+	case policy != nil:
+		if err = checkPasswordEncoding(password); err == nil {
+			var userInputs []string
+			if username != "" {
+				userInputs = []string{username}
+			}
+			// The request to the Pwned Passwords service, if any, is bounded
+			// by the timeout of the checker.
+			err = policy.validate(context.Background(), password, userInputs)
+		}
+	}
+	if err == nil {
+		hashedPassword, err = checkNotMissingPasswordHash(hash(password))
+	}
+	if err == nil {
+		hashUsage.created.record(hashedPassword)
+	}
+	if m != nil {
+		m.recordHash(start, err)
+	}
+	return hashedPassword, err
+}
+
+// checkNotMissingPasswordHash rejects hashes that would disable password
+// login, which a Hasher registered with SetDefaultHasher might produce.
+func checkNotMissingPasswordHash(hashedPassword []byte, err error) ([]byte, error) {
+	if err == nil && IsPasswordLoginDisabled(hashedPassword) {
+		return nil, errors.New("refusing to produce a password hash that disables password login")
+	}
+	return hashedPassword, err
+}
+
+func hashPasswordWithMethod(method HashMethod, password []byte) ([]byte, error) {
+	if passwordNormalizationEnabled() && normalizes(method) {
+		return hashNormalized(method, password)
+	}
+	return hashPasswordWithMethodRaw(method, password)
+}
+
+// hashPasswordWithMethodRaw is like hashPasswordWithMethod, without preparing
+// the password (see SetPasswordNormalization).
+func hashPasswordWithMethodRaw(method HashMethod, password []byte) ([]byte, error) {
+	if err := checkFIPSApproved(method); err != nil {
+		return nil, err
+	}
+	switch method {
+	case HashBCrypt:
+		return hashBcrypt(password)
+	case HashArgon2id:
+		return hashArgon2id(password)
+	case HashScrypt:
+		return hashScrypt(password)
+	case HashPBKDF2:
+		return hashPBKDF2(password)
+	case HashSCRAMSHA256:
+		return generateSCRAMVerifier(password, SCRAMMinIterations)
+	default:
+		return nil, &HashMethodUnsupportedError{Method: method}
+	}
+}
+
+// ZeroBytes overwrites b with zeros, e.g. to clear a password once it has
+// been hashed or sent, rather than leaving it in memory until it is garbage
+// collected. See also SecureString.Destroy.
+func ZeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
 }
@@ -0,0 +1,213 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+// VerificationOutcome is the outcome of a password verification, reported
+// to the hook set with SetVerificationAuditHook.
+type VerificationOutcome int
+
+const (
+	// VerificationSuccess is the outcome of verifications of the right
+	// password.
+	VerificationSuccess VerificationOutcome = iota
+	// VerificationMismatch is the outcome of verifications of a wrong
+	// password, i.e. which failed with ErrPasswordMismatch.
+	VerificationMismatch
+	// VerificationMalformedHash is the outcome of verifications against a
+	// hash which cannot be decoded (see ErrMalformedHash).
+	VerificationMalformedHash
+	// VerificationExpired is the outcome of verifications of expired
+	// credentials (see PasswordCredential).
+	VerificationExpired
+	// VerificationThrottled is the outcome of verifications which were
+	// given up on before the password was verified, because of the limit
+	// set by SetMaxVerifyConcurrency or because the queue of a VerifierPool
+	// was full.
+	VerificationThrottled
+	// VerificationError is the outcome of the verifications which failed
+	// otherwise, e.g. because password login is disabled for the user, or
+	// the hash method is unsupported.
+	VerificationError
+)
+
+var verificationOutcomeNames = [...]string{
+	VerificationSuccess:       "success",
+	VerificationMismatch:      "mismatch",
+	VerificationMalformedHash: "malformed hash",
+	VerificationExpired:       "expired",
+	VerificationThrottled:     "throttled",
+	VerificationError:         "error",
+}
+
+func (o VerificationOutcome) String() string {
+	if o < 0 || int(o) >= len(verificationOutcomeNames) {
+		return fmt.Sprintf("VerificationOutcome(%d)", int(o))
+	}
+	return verificationOutcomeNames[o]
+}
+
+// AuditEvent describes a password verification, for the hook set with
+// SetVerificationAuditHook. It never carries the password, nor the hash.
+type AuditEvent struct {
+	Outcome VerificationOutcome
+	// Method is the hash method of the hash, as recognized from its prefix,
+	// or HashMethodUnknown.
+	Method HashMethod
+	// Duration is how long the verification took, including any wait for
+	// the limit set by SetMaxVerifyConcurrency.
+	Duration time.Duration
+	// Identity is the identity set with WithAuditIdentity on the context of
+	// the verification, e.g. the name of the user logging in, or nil.
+	Identity interface{}
+}
+
+// auditIdentityKey is the context key of the identity of WithAuditIdentity.
+type auditIdentityKey struct{}
+
+// WithAuditIdentity returns a context carrying an identity, e.g. a user name
+// and client address, which is reported to the verification audit hook for
+// the verifications done with the context, e.g. by CompareHashAndPasswordCtx.
+// The identity is opaque to this package. Verifications without a context,
+// e.g. by CompareHashAndPassword, are reported without an identity.
+func WithAuditIdentity(ctx context.Context, identity interface{}) context.Context {
+	return context.WithValue(ctx, auditIdentityKey{}, identity)
+}
+
+// verificationAuditHook is a hook set with SetVerificationAuditHook. Its
+// methods do nothing if it is nil.
+type verificationAuditHook func(AuditEvent)
+
+// verificationAudit holds the verificationAuditHook, which can be nil.
+var verificationAudit atomic.Value
+
+func init() {
+	verificationAudit.Store(verificationAuditHook(nil))
+}
+
+// SetVerificationAuditHook sets a hook called with an AuditEvent for each
+// password verification, e.g. to keep an append-only record of the
+// authentication attempts. The hook is called synchronously, once the
+// outcome is known, and must be safe for concurrent use; if it panics, the
+// panic is logged, and the verification returns as if the hook had not been
+// called. Nil removes the hook, in which case verifications only pay for a
+// nil check.
+//
+// The verifications reported are those of CompareHashAndPassword and its
+// variants, of VerifierPool and of PasswordCredential, but not the checks of
+// new passwords against the previous ones of the user (see
+// CheckPasswordNotReused).
+func SetVerificationAuditHook(hook func(AuditEvent)) {
+	verificationAudit.Store(verificationAuditHook(hook))
+}
+
+// activeVerificationAuditHook returns the hook set with
+// SetVerificationAuditHook, or nil.
+func activeVerificationAuditHook() verificationAuditHook {
+	return verificationAudit.Load().(verificationAuditHook)
+}
+
+// start returns the time at which a verification started, if the hook is
+// set.
+func (h verificationAuditHook) start() time.Time {
+	if h == nil {
+		return time.Time{}
+	}
+	return timeutil.Now()
+}
+
+// record reports the outcome of a verification against the hash, started at
+// start, to the hook.
+func (h verificationAuditHook) record(
+	ctx context.Context, start time.Time, hashedPassword []byte, err error,
+) {
+	if h == nil {
+		return
+	}
+	event := AuditEvent{
+		Outcome:  verificationOutcome(err),
+		Method:   sniffHashMethod(hashedPassword),
+		Duration: timeutil.Since(start),
+		Identity: ctx.Value(auditIdentityKey{}),
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			log.Warningf(ctx, "verification audit hook panicked: %v", r)
+		}
+	}()
+	h(event)
+}
+
+// verificationOutcome classifies the error of a verification.
+func verificationOutcome(err error) VerificationOutcome {
+	switch cause := errors.Cause(err); {
+	case err == nil:
+		return VerificationSuccess
+	case err == ErrPasswordMismatch:
+		return VerificationMismatch
+	case cause == ErrMalformedHash:
+		return VerificationMalformedHash
+	case cause == ErrPasswordExpired:
+		return VerificationExpired
+	case cause == ErrVerifyQueueFull, cause == context.Canceled,
+		cause == context.DeadlineExceeded:
+		// The context of a verification can only interrupt it while it
+		// waits for the limit set by SetMaxVerifyConcurrency.
+		return VerificationThrottled
+	default:
+		return VerificationError
+	}
+}
+
+// TestingRecordVerificationAudit replaces the hook set with
+// SetVerificationAuditHook with one recording the events, which events
+// returns, in order. The returned restore function puts the previous hook
+// back.
+func TestingRecordVerificationAudit() (events func() []AuditEvent, restore func()) {
+	if flag.Lookup("test.v") == nil {
+		panic("TestingRecordVerificationAudit can only be used in tests")
+	}
+	var recorded struct {
+		syncutil.Mutex
+		events []AuditEvent
+	}
+	prev := activeVerificationAuditHook()
+	SetVerificationAuditHook(func(e AuditEvent) {
+		recorded.Lock()
+		defer recorded.Unlock()
+		recorded.events = append(recorded.events, e)
+	})
+	events = func() []AuditEvent {
+		recorded.Lock()
+		defer recorded.Unlock()
+		return append([]AuditEvent(nil), recorded.events...)
+	}
+	return events, func() {
+		verificationAudit.Store(prev)
+	}
+}
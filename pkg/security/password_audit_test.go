@@ -0,0 +1,190 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestVerificationAuditHook(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+	defer security.TestingSetBcryptCost(4)()
+
+	events, restore := security.TestingRecordVerificationAudit()
+	defer restore()
+
+	hash, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	md5Sum := md5.Sum([]byte("hunter2" + "alice"))
+	md5Hash := []byte("md5" + hex.EncodeToString(md5Sum[:]))
+	ctx := security.WithAuditIdentity(context.Background(), "alice")
+	now := time.Now()
+	expired := security.PasswordCredential{Hash: hash, ValidUntil: now.Add(-time.Hour)}
+
+	testCases := []struct {
+		name     string
+		verify   func() error
+		outcome  security.VerificationOutcome
+		method   security.HashMethod
+		identity interface{}
+	}{
+		{"success", func() error {
+			return security.CompareHashAndPasswordCtx(ctx, hash, "hunter2")
+		}, security.VerificationSuccess, security.HashBCrypt, "alice"},
+		{"mismatch", func() error {
+			return security.CompareHashAndPassword(hash, "hunter3")
+		}, security.VerificationMismatch, security.HashBCrypt, nil},
+		{"malformed hash", func() error {
+			return security.CompareHashAndPassword([]byte("$2a$99$malformed"), "hunter2")
+		}, security.VerificationMalformedHash, security.HashBCrypt, nil},
+		{"login disabled", func() error {
+			return security.CompareHashAndPasswordBytes(nil, []byte("hunter2"))
+		}, security.VerificationError, security.HashMethodUnknown, nil},
+		{"md5", func() error {
+			return security.CompareHashAndPasswordWithUser(md5Hash, "hunter2", "alice")
+		}, security.VerificationSuccess, security.HashPGMD5, nil},
+		{"valid", func() error {
+			return security.PasswordCredential{Hash: hash}.VerifyCtx(ctx, "hunter2", now)
+		}, security.VerificationSuccess, security.HashBCrypt, "alice"},
+		{"expired", func() error {
+			return expired.VerifyCtx(ctx, "hunter2", now)
+		}, security.VerificationExpired, security.HashBCrypt, "alice"},
+		{"expired mismatch", func() error {
+			c := expired
+			c.CheckEvenIfExpired = true
+			return c.VerifyCtx(ctx, "hunter3", now)
+		}, security.VerificationMismatch, security.HashBCrypt, "alice"},
+		{"expired match", func() error {
+			c := expired
+			c.CheckEvenIfExpired = true
+			return c.Verify("hunter2", now)
+		}, security.VerificationExpired, security.HashBCrypt, nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			before := len(events())
+			_ = tc.verify()
+			e := events()[before:]
+			if len(e) != 1 {
+				t.Fatalf("expected an event, got %+v", e)
+			}
+			if e[0].Outcome != tc.outcome || e[0].Method != tc.method ||
+				e[0].Identity != tc.identity || e[0].Duration < 0 {
+				t.Errorf("expected a %s event for %s and %v, got %+v",
+					tc.outcome, tc.method, tc.identity, e[0])
+			}
+		})
+	}
+	if d := events()[0].Duration; d <= 0 {
+		t.Errorf("expected the duration of the verification, got %s", d)
+	}
+
+	t.Run("throttled", func(t *testing.T) {
+		b := blockingHasher{started: make(chan struct{}), unblock: make(chan struct{})}
+		prev := security.SetDefaultHasher(b)
+		defer security.SetDefaultHasher(prev)
+		if err := security.SetMaxVerifyConcurrency(1); err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			if err := security.SetMaxVerifyConcurrency(0); err != nil {
+				t.Fatal(err)
+			}
+		}()
+
+		// The verification running on the worker of the pool holds the only
+		// slot, and the next one gives up waiting for it.
+		p := security.NewVerifierPool(1, 1)
+		defer p.Close()
+		compareAsync := func() chan error {
+			errCh := make(chan error, 1)
+			go func() { errCh <- p.Compare(ctx, []byte(pbkdf2Hash), "hunter2") }()
+			return errCh
+		}
+		running := compareAsync()
+		<-b.started
+		before := len(events())
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		c := security.PasswordCredential{Hash: []byte(pbkdf2Hash)}
+		if err := c.VerifyCtx(timeoutCtx, "hunter2", now); errors.Cause(err) !=
+			context.DeadlineExceeded {
+			t.Errorf("expected a timeout, got %v", err)
+		}
+		e := events()[before:]
+		if len(e) != 1 || e[0].Outcome != security.VerificationThrottled ||
+			e[0].Method != security.HashPBKDF2 || e[0].Identity != "alice" {
+			t.Errorf("expected a throttled event, got %+v", e)
+		}
+
+		// So do verifications rejected by VerifierPools.
+		queued := compareAsync()
+		testutils.SucceedsSoon(t, func() error {
+			if s := p.Stats(); s.Queued != 1 {
+				return errors.Errorf("expected a queued verification, got %+v", s)
+			}
+			return nil
+		})
+		if err := p.Compare(ctx, []byte(pbkdf2Hash), "hunter2"); errors.Cause(err) !=
+			security.ErrVerifyQueueFull {
+			t.Errorf("expected ErrVerifyQueueFull, got %v", err)
+		}
+		if e := events()[before+1:]; len(e) != 1 || e[0].Outcome != security.VerificationThrottled {
+			t.Errorf("expected a throttled event, got %+v", e)
+		}
+
+		for _, errCh := range []chan error{running, queued} {
+			if errCh == queued {
+				<-b.started
+			}
+			b.unblock <- struct{}{}
+			if err := <-errCh; err != nil {
+				t.Error(err)
+			}
+		}
+	})
+
+	// A panicking hook does not break verification.
+	security.SetVerificationAuditHook(func(security.AuditEvent) { panic("boom") })
+	if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+		t.Errorf("expected a match, got %v", err)
+	}
+	if err := security.CompareHashAndPassword(hash, "hunter3"); err != security.ErrPasswordMismatch {
+		t.Errorf("expected ErrPasswordMismatch, got %v", err)
+	}
+
+	// Nor does removing the hook.
+	before := len(events())
+	security.SetVerificationAuditHook(nil)
+	if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+		t.Errorf("expected a match, got %v", err)
+	}
+	if len(events()) != before {
+		t.Errorf("unexpected events %+v", events()[before:])
+	}
+}
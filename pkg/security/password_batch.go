@@ -0,0 +1,177 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// HashPasswordsError is returned by HashPasswords when some of the passwords
+// could not be hashed.
+type HashPasswordsError struct {
+	// Errors maps the index of each password that could not be hashed to the
+	// error encountered hashing it.
+	Errors map[int]error
+}
+
+// Indexes returns the indexes of the passwords that could not be hashed, in
+// increasing order.
+func (e *HashPasswordsError) Indexes() []int {
+	indexes := make([]int, 0, len(e.Errors))
+	for i := range e.Errors {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+func (e *HashPasswordsError) Error() string {
+	first := e.Indexes()[0]
+	return fmt.Sprintf("failed to hash %d password(s); password %d: %v",
+		len(e.Errors), first, e.Errors[first])
+}
+
+// HashPasswords hashes the passwords like HashPassword, using up to
+// parallelism goroutines, or GOMAXPROCS if parallelism is 0. The hash of
+// each password is at the same index of the result as the password.
+//
+// Failing to hash a password, e.g. because it is empty, does not prevent the
+// others from being hashed: the hashes of the passwords that could not be
+// hashed are nil, and the error is a *HashPasswordsError describing each
+// failure. Once the context is canceled, the remaining passwords fail with
+// the error of the context.
+func HashPasswords(ctx context.Context, passwords []string, parallelism int) ([][]byte, error) {
+	if parallelism < 0 {
+		return nil, errors.Errorf("invalid parallelism %d", parallelism)
+	}
+	if parallelism == 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	if parallelism > len(passwords) {
+		parallelism = len(passwords)
+	}
+
+	hashes := make([][]byte, len(passwords))
+	errs := make([]error, len(passwords))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(parallelism)
+	for w := 0; w < parallelism; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				switch {
+				case ctx.Err() != nil:
+					errs[i] = errors.Wrap(ctx.Err(), "hashing password")
+				default:
+					hashes[i], errs[i] = HashPassword(passwords[i])
+				}
+			}
+		}()
+	}
+	for i := range passwords {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	var batchErr *HashPasswordsError
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if batchErr == nil {
+			batchErr = &HashPasswordsError{Errors: make(map[int]error)}
+		}
+		hashes[i] = nil
+		batchErr.Errors[i] = err
+	}
+	if batchErr != nil {
+		return hashes, batchErr
+	}
+	return hashes, nil
+}
+
+// HashPasswordPair is a hash and a password to verify against it with
+// CompareHashesAndPasswords.
+type HashPasswordPair struct {
+	HashedPassword []byte
+	Password       string
+}
+
+// CompareHashesAndPasswords verifies each password against its hash like
+// CompareHashAndPassword, using up to parallelism goroutines, or GOMAXPROCS
+// if parallelism is 0. The outcome of each verification is at the same
+// index of the result as the pair: nil if the password matches,
+// ErrPasswordMismatch if it does not, or the error encountered verifying
+// it. The result is empty, but not nil, if there are no pairs.
+//
+// Once the context is canceled, no further verification is started, and the
+// pairs not verified yet fail with the error of the context.
+func CompareHashesAndPasswords(
+	ctx context.Context, pairs []HashPasswordPair, parallelism int,
+) []error {
+	errs := make([]error, len(pairs))
+	if parallelism < 0 {
+		for i := range errs {
+			errs[i] = errors.Errorf("invalid parallelism %d", parallelism)
+		}
+		return errs
+	}
+	if parallelism == 0 {
+		parallelism = runtime.GOMAXPROCS(0)
+	}
+	if parallelism > len(pairs) {
+		parallelism = len(pairs)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(parallelism)
+	for w := 0; w < parallelism; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if ctx.Err() != nil {
+					errs[i] = errors.Wrap(ctx.Err(), "comparing password")
+					continue
+				}
+				errs[i] = compareHashAndPassword(ctx, pairs[i].HashedPassword,
+					[]byte(pairs[i].Password))
+			}
+		}()
+	}
+	i := 0
+feed:
+	for ; i < len(pairs); i++ {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	for ; i < len(pairs); i++ {
+		errs[i] = errors.Wrap(ctx.Err(), "comparing password")
+	}
+	return errs
+}
@@ -0,0 +1,157 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrPasswordExpired is the cause of the errors returned when verifying
+// expired credentials.
+var ErrPasswordExpired = errors.New("password has expired")
+
+// PasswordExpiredError is returned when verifying expired credentials.
+type PasswordExpiredError struct {
+	// ValidUntil is the expiration of the credentials.
+	ValidUntil time.Time
+}
+
+func (e *PasswordExpiredError) Error() string {
+	if e.ValidUntil.Equal(validUntilNegativeInfinity) {
+		return ErrPasswordExpired.Error()
+	}
+	return fmt.Sprintf("%s: it was valid until %s",
+		ErrPasswordExpired, e.ValidUntil.UTC().Format(time.RFC3339))
+}
+
+// Cause implements the causer interface.
+func (e *PasswordExpiredError) Cause() error {
+	return ErrPasswordExpired
+}
+
+// PasswordCredential is the stored password of a user, with its optional
+// expiration, as set with the VALID UNTIL clause of CREATE USER and ALTER
+// USER.
+type PasswordCredential struct {
+	// Hash is the hash of the password.
+	Hash []byte
+	// ValidUntil is the instant from which the password is no longer valid,
+	// or the zero time if it never expires.
+	ValidUntil time.Time
+	// CheckEvenIfExpired makes Verify verify the password of expired
+	// credentials, and report that they expired only if it matches, so that
+	// their expiration is not revealed to clients which do not know the
+	// password. By default, expired credentials are rejected without
+	// spending the cost of verifying the password.
+	CheckEvenIfExpired bool
+}
+
+// Expired returns whether the credential expired at the given instant, i.e.
+// whether now is at or past its expiration. The comparison is of instants,
+// regardless of the locations of the times.
+func (c PasswordCredential) Expired(now time.Time) bool {
+	return !c.ValidUntil.IsZero() && !now.Before(c.ValidUntil)
+}
+
+// Verify tests that the hash of the credential was computed from the
+// supplied password, as by CompareHashAndPassword, and that the credential
+// has not expired at the given instant, returning a *PasswordExpiredError
+// otherwise. Unless CheckEvenIfExpired is set, the password of expired
+// credentials is not verified.
+func (c PasswordCredential) Verify(password string, now time.Time) error {
+	return c.VerifyCtx(context.Background(), password, now)
+}
+
+// VerifyCtx is like Verify, but waits for the limit set by
+// SetMaxVerifyConcurrency unless the context is canceled, and reports the
+// verification to the audit hook with the identity of the context (see
+// WithAuditIdentity). The verification of expired credentials is reported
+// as expired if the password matches, or is not verified.
+func (c PasswordCredential) VerifyCtx(ctx context.Context, password string, now time.Time) error {
+	if !c.Expired(now) {
+		return compareHashAndPassword(ctx, c.Hash, []byte(password))
+	}
+	hook := activeVerificationAuditHook()
+	start := hook.start()
+	var err error
+	if c.CheckEvenIfExpired {
+		_, err = compareHashAndPasswordMetered(ctx, c.Hash, []byte(password), "" /* username */)
+	}
+	if err == nil {
+		err = &PasswordExpiredError{ValidUntil: c.ValidUntil}
+	}
+	hook.record(ctx, start, c.Hash, err)
+	return err
+}
+
+// validUntilNegativeInfinity is the expiration of credentials which are
+// always expired, the instant right after the zero time.
+var validUntilNegativeInfinity = time.Time{}.Add(time.Nanosecond)
+
+// validUntilLayouts are the layouts of the timestamps accepted by
+// ParseValidUntil, with and then without a time zone.
+var validUntilLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// ParseValidUntil parses the expiration of a credential as accepted by
+// PostgreSQL in VALID UNTIL clauses. 'infinity', like a NULL expiration,
+// means that the credential never expires and is returned as the zero time,
+// and '-infinity' that it is always expired. Timestamps without a time zone,
+// such as '2018-09-01 12:00:00', are interpreted in loc, which should be the
+// time zone of the session setting the expiration, or UTC if it is nil.
+func ParseValidUntil(s string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	s = strings.TrimSpace(s)
+	switch strings.ToLower(s) {
+	case "infinity", "+infinity":
+		return time.Time{}, nil
+	case "-infinity":
+		return validUntilNegativeInfinity, nil
+	}
+	for _, layout := range validUntilLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.Errorf("invalid VALID UNTIL timestamp %q", s)
+}
+
+// FormatValidUntil formats the expiration of a credential so that
+// ParseValidUntil returns it: 'infinity' for the zero time, and timestamps in
+// UTC otherwise.
+func FormatValidUntil(t time.Time) string {
+	switch {
+	case t.IsZero():
+		return "infinity"
+	case t.Equal(validUntilNegativeInfinity):
+		return "-infinity"
+	default:
+		return t.UTC().Format("2006-01-02 15:04:05.999999999Z07:00")
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+func TestPasswordCredential(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+
+	hash, err := security.HashPasswordWithMethod(security.HashPBKDF2, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validUntil := time.Date(2018, 9, 1, 12, 0, 0, 0, time.UTC)
+	// The same instant as validUntil, in another location.
+	tokyo := time.FixedZone("JST", 9*3600)
+	atExpiration := validUntil.In(tokyo)
+
+	for _, tc := range []struct {
+		validUntil time.Time
+		now        time.Time
+		password   string
+		checkEven  bool
+		expected   error
+	}{
+		{time.Time{}, validUntil, "hunter2", false, nil},
+		{time.Time{}, validUntil, "hunter3", false, security.ErrPasswordMismatch},
+		{validUntil, validUntil.Add(-time.Nanosecond), "hunter2", false, nil},
+		{validUntil, validUntil.Add(-time.Nanosecond), "hunter3", false, security.ErrPasswordMismatch},
+		{validUntil, atExpiration, "hunter2", false, security.ErrPasswordExpired},
+		{validUntil, atExpiration, "hunter3", false, security.ErrPasswordExpired},
+		{validUntil, validUntil.Add(time.Hour), "hunter2", true, security.ErrPasswordExpired},
+		{validUntil, validUntil.Add(time.Hour), "hunter3", true, security.ErrPasswordMismatch},
+		// A local wall clock earlier than the expiration is still after it.
+		{validUntil, time.Date(2018, 9, 1, 8, 0, 0, 0, time.FixedZone("", -5*3600)),
+			"hunter2", false, security.ErrPasswordExpired},
+	} {
+		c := security.PasswordCredential{
+			Hash:               hash,
+			ValidUntil:         tc.validUntil,
+			CheckEvenIfExpired: tc.checkEven,
+		}
+		if err := c.Verify(tc.password, tc.now); errors.Cause(err) != tc.expected {
+			t.Errorf("%s at %s (%t): expected %v, got %v",
+				tc.password, tc.now, tc.checkEven, tc.expected, err)
+		}
+	}
+
+	// The password of expired credentials is not verified: a malformed hash
+	// would fail otherwise.
+	c := security.PasswordCredential{Hash: []byte("$2a$malformed"), ValidUntil: validUntil}
+	if err := c.Verify("hunter2", atExpiration); errors.Cause(err) != security.ErrPasswordExpired {
+		t.Errorf("expected ErrPasswordExpired, got %v", err)
+	}
+	c.CheckEvenIfExpired = true
+	if err := c.Verify("hunter2", atExpiration); errors.Cause(err) == security.ErrPasswordExpired {
+		t.Error("expected the hash to be verified")
+	}
+}
+
+func TestParseValidUntil(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	newYork := time.FixedZone("EDT", -4*3600)
+	expected := time.Date(2018, 9, 1, 16, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		s   string
+		loc *time.Location
+	}{
+		{"2018-09-01T16:00:00Z", nil},
+		{"2018-09-01 16:00:00+00:00", nil},
+		{"2018-09-01 12:00:00-04", nil},
+		{"2018-09-01 12:00:00-04:00", time.UTC},
+		{"2018-09-01 16:00:00", nil},
+		{"2018-09-01 12:00:00", newYork},
+		{"2018-09-01T12:00:00.000", newYork},
+		{" 2018-09-01 12:00 ", newYork},
+	} {
+		v, err := security.ParseValidUntil(tc.s, tc.loc)
+		if err != nil {
+			t.Errorf("%q: %v", tc.s, err)
+			continue
+		}
+		if !v.Equal(expected) {
+			t.Errorf("%q: expected %s, got %s", tc.s, expected, v)
+		}
+		if s := security.FormatValidUntil(v); s != "2018-09-01 16:00:00Z" {
+			t.Errorf("%q: unexpected formatting %q", tc.s, s)
+		}
+	}
+	if v, err := security.ParseValidUntil("2018-09-01", newYork); err != nil ||
+		!v.Equal(time.Date(2018, 9, 1, 4, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected %s, %v", v, err)
+	}
+
+	for _, s := range []string{"infinity", "Infinity", "+infinity"} {
+		v, err := security.ParseValidUntil(s, nil)
+		if err != nil || !v.IsZero() {
+			t.Errorf("%q: expected the zero time, got %s, %v", s, v, err)
+		}
+		if c := (security.PasswordCredential{ValidUntil: v}); c.Expired(timeutil.Now()) {
+			t.Errorf("%q: unexpected expiration", s)
+		}
+	}
+	if s := security.FormatValidUntil(time.Time{}); s != "infinity" {
+		t.Errorf("expected infinity, got %q", s)
+	}
+	v, err := security.ParseValidUntil("-infinity", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := security.PasswordCredential{ValidUntil: v}
+	if !c.Expired(time.Time{}.Add(time.Nanosecond)) || !c.Expired(timeutil.Now()) {
+		t.Error("expected -infinity to be expired")
+	}
+	if s := security.FormatValidUntil(v); s != "-infinity" {
+		t.Errorf("expected -infinity, got %q", s)
+	}
+
+	for _, s := range []string{"", "tomorrow", "2018-13-01", "2018-09-01 25:00:00"} {
+		if _, err := security.ParseValidUntil(s, nil); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// ErrPasswordContainsNUL is the cause of the errors returned for passwords
+// with a NUL byte, which some client drivers truncate passwords at, so that
+// users could never log in with them.
+var ErrPasswordContainsNUL = errors.New("password must not contain NUL bytes")
+
+// ErrPasswordContainsControl is the cause of the errors returned for
+// passwords with C0 or C1 control characters, such as tabs or escapes.
+var ErrPasswordContainsControl = errors.New("password must not contain control characters")
+
+// ErrPasswordInvalidUTF8 is the cause of the errors returned for passwords
+// which are not valid UTF-8.
+var ErrPasswordInvalidUTF8 = errors.New("password must be valid UTF-8")
+
+// PasswordNULError is returned for passwords with a NUL byte.
+type PasswordNULError struct {
+	// Offset is the offset of the first NUL byte in the password.
+	Offset int
+}
+
+func (e *PasswordNULError) Error() string {
+	return fmt.Sprintf("%s: found one at byte %d", ErrPasswordContainsNUL, e.Offset)
+}
+
+// Cause implements the causer interface.
+func (e *PasswordNULError) Cause() error {
+	return ErrPasswordContainsNUL
+}
+
+// PasswordControlCharacterError is returned for passwords with a control
+// character other than NUL.
+type PasswordControlCharacterError struct {
+	// Offset is the byte offset of the first control character in the
+	// password. The character itself is not reported, as it is part of the
+	// password.
+	Offset int
+}
+
+func (e *PasswordControlCharacterError) Error() string {
+	return fmt.Sprintf("%s: found one at byte %d", ErrPasswordContainsControl, e.Offset)
+}
+
+// Cause implements the causer interface.
+func (e *PasswordControlCharacterError) Cause() error {
+	return ErrPasswordContainsControl
+}
+
+// PasswordInvalidUTF8Error is returned for passwords which are not valid
+// UTF-8.
+type PasswordInvalidUTF8Error struct {
+	// Offset is the offset of the first invalid byte in the password.
+	Offset int
+}
+
+func (e *PasswordInvalidUTF8Error) Error() string {
+	return fmt.Sprintf("%s: invalid sequence at byte %d", ErrPasswordInvalidUTF8, e.Offset)
+}
+
+// Cause implements the causer interface.
+func (e *PasswordInvalidUTF8Error) Cause() error {
+	return ErrPasswordInvalidUTF8
+}
+
+// CheckPasswordEncoding rejects passwords which cannot be set: those with a
+// NUL byte, with a *PasswordNULError, those with another C0 or C1 control
+// character, including DEL, with a *PasswordControlCharacterError, and
+// those which are not valid UTF-8, with a *PasswordInvalidUTF8Error. Spaces
+// and other printable characters are accepted.
+//
+// HashPassword and the related functions enforce it, but not
+// CompareHashAndPassword, so that passwords set before it was enforced keep
+// working.
+func CheckPasswordEncoding(password string) error {
+	return checkPasswordEncoding([]byte(password))
+}
+
+func checkPasswordEncoding(password []byte) error {
+	for i := 0; i < len(password); {
+		r, size := utf8.DecodeRune(password[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			return &PasswordInvalidUTF8Error{Offset: i}
+		case r == 0:
+			return &PasswordNULError{Offset: i}
+		case unicode.IsControl(r):
+			return &PasswordControlCharacterError{Offset: i}
+		}
+		i += size
+	}
+	return nil
+}
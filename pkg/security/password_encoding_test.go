@@ -0,0 +1,106 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestCheckPasswordEncoding(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+
+	for _, tc := range []struct {
+		password string
+		cause    error
+		offset   int
+	}{
+		{"correct horse battery staple", nil, 0},
+		{" leading and trailing spaces ", nil, 0},
+		{"пароль密码パスワード🔑", nil, 0},
+		{" no-break space", nil, 0},
+		{"replacement � character", nil, 0},
+		{"pass\x00word", security.ErrPasswordContainsNUL, 4},
+		{"\x00", security.ErrPasswordContainsNUL, 0},
+		{"pass\tword", security.ErrPasswordContainsControl, 4},
+		{"password\n", security.ErrPasswordContainsControl, 8},
+		{"\x1b[31mred", security.ErrPasswordContainsControl, 0},
+		{"del\x7f", security.ErrPasswordContainsControl, 3},
+		{"c1 \u0085 next line", security.ErrPasswordContainsControl, 3},
+		{"latin1 caf\xe9", security.ErrPasswordInvalidUTF8, 10},
+		{"truncated \xe2\x82", security.ErrPasswordInvalidUTF8, 10},
+		{"surrogate \xed\xa0\x80", security.ErrPasswordInvalidUTF8, 10},
+	} {
+		err := security.CheckPasswordEncoding(tc.password)
+		if errors.Cause(err) != tc.cause {
+			t.Errorf("%+q: expected %v, got %v", tc.password, tc.cause, err)
+			continue
+		}
+		var offset int
+		switch e := err.(type) {
+		case nil:
+		case *security.PasswordNULError:
+			offset = e.Offset
+		case *security.PasswordControlCharacterError:
+			offset = e.Offset
+		case *security.PasswordInvalidUTF8Error:
+			offset = e.Offset
+		default:
+			t.Errorf("%+q: unexpected error type %T", tc.password, err)
+		}
+		if offset != tc.offset {
+			t.Errorf("%+q: expected offset %d, got %d", tc.password, tc.offset, offset)
+		}
+
+		// Such passwords cannot be set.
+		hash, err := security.HashPasswordWithMethod(security.HashPBKDF2, tc.password)
+		if errors.Cause(err) != tc.cause {
+			t.Errorf("%+q: expected %v, got %v", tc.password, tc.cause, err)
+		}
+		if tc.cause == nil {
+			if err := security.CompareHashAndPassword(hash, tc.password); err != nil {
+				t.Errorf("%+q: %v", tc.password, err)
+			}
+		}
+	}
+
+	// Passwords set before the check was enforced keep working, and their
+	// hashes can still be upgraded.
+	const legacy = "$pbkdf2-sha256$i=1000$MDEyMzQ1Njc4OWFiY2RlZg$" +
+		"tMECn4gms2gmvixjGQCSH1Sq72EoXumvT+w48cuT2ec"
+	if err := security.CompareHashAndPassword([]byte(legacy), "pass\x00word"); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPassword(
+		[]byte(legacy), "pass",
+	); err != security.ErrPasswordMismatch {
+		t.Fatalf("expected mismatch, got %v", err)
+	}
+	newHash, _, err := security.UpgradeHashIfNeeded([]byte(legacy), "pass\x00word")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newHash != nil {
+		if err := security.CompareHashAndPassword(newHash, "pass\x00word"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// The failures of hashing, verifying and validating passwords are reported
+// with errors whose cause is one of a few sentinels, so that callers, e.g.
+// translating them to pgcodes, do not depend on their messages:
+//
+//   - ErrEmptyPassword
+//   - ErrPasswordTooShort (*PasswordTooShortError)
+//   - ErrPasswordTooLong (*PasswordTooLongError, *PasswordAboveMaxLengthError)
+//   - ErrPasswordTooCommon (*CommonPasswordError)
+//   - ErrPasswordMatchesUsername (*PasswordMatchesUsernameError)
+//   - ErrPasswordExpired (*PasswordExpiredError)
+//   - ErrMalformedHash (*MalformedHashError)
+//   - ErrHashMethodUnsupported (*HashMethodUnsupportedError)
+//   - ErrHashCostOutOfRange (*HashCostOutOfRangeError)
+//
+// The typed errors carry the details of the failure, e.g. the limits of
+// the policy, and their Cause is the sentinel, so that errors.Cause returns
+// the sentinel even once they are wrapped with errors.Wrap. ErrorIs and
+// ErrorAs also look into the violations of *PolicyViolations.
+//
+// The messages of the errors never include passwords, nor hashes: the
+// parts of hashes they quote, e.g. an unsupported scheme, are truncated
+// with hashForError.
+
+// maxHashLenInErrors is the number of characters of the parts of hashes
+// quoted by error messages.
+const maxHashLenInErrors = 8
+
+// hashForError truncates a part of a hash, e.g. its scheme, to be quoted by
+// an error message, so that a malformed hash, e.g. a password stored in
+// place of its hash, is not revealed by the error.
+func hashForError(s string) string {
+	if len(s) <= maxHashLenInErrors {
+		return s
+	}
+	return s[:maxHashLenInErrors] + "…"
+}
+
+// ErrHashMethodUnsupported is the cause of the errors returned for hash
+// methods, and hashes in schemes, which are not supported.
+var ErrHashMethodUnsupported = errors.New("unsupported hash method")
+
+// HashFormat is the format of a hash in an unsupported scheme.
+type HashFormat string
+
+const (
+	// HashFormatPHC is the PHC string format, e.g. "$md5$...".
+	HashFormatPHC HashFormat = "phc"
+	// HashFormatLDAP is the format of LDAP userPassword values, e.g.
+	// "{CRYPT}...".
+	HashFormatLDAP HashFormat = "ldap"
+	// HashFormatDjango is the format of legacy Django hashes, e.g.
+	// "crypt$...".
+	HashFormatDjango HashFormat = "django"
+)
+
+// HashMethodUnsupportedError is returned for hash methods which cannot be
+// used, e.g. by SetDefaultHashMethod, and for hashes in unsupported schemes.
+// Its cause is ErrHashMethodUnsupported.
+type HashMethodUnsupportedError struct {
+	// Method is the unsupported method, if the error is not about a hash.
+	Method HashMethod
+	// Scheme is the unsupported scheme of the hash, e.g. "md5" or "CRYPT",
+	// and Format the format in which it was found.
+	Scheme string
+	Format HashFormat
+}
+
+func (e *HashMethodUnsupportedError) Error() string {
+	switch e.Format {
+	case HashFormatPHC:
+		return fmt.Sprintf("%s %q", ErrHashMethodUnsupported, hashForError(e.Scheme))
+	case HashFormatLDAP:
+		return fmt.Sprintf("unsupported scheme {%s}", hashForError(e.Scheme))
+	case HashFormatDjango:
+		return fmt.Sprintf("unsupported legacy scheme %s$", hashForError(e.Scheme))
+	default:
+		return fmt.Sprintf("%s %s", ErrHashMethodUnsupported, e.Method)
+	}
+}
+
+// Cause implements the causer interface.
+func (e *HashMethodUnsupportedError) Cause() error {
+	return ErrHashMethodUnsupported
+}
+
+// ErrorIs returns whether err, or one of its causes, is target, e.g.
+// ErrPasswordTooShort. For *PolicyViolations, the errors of all the
+// violations are tested.
+func ErrorIs(err, target error) bool {
+	return walkErrors(err, func(err error) bool { return err == target })
+}
+
+// ErrorAs sets target, a non-nil pointer to an error type such as
+// **PasswordTooShortError, to the first of err and its causes assignable to
+// it, and returns whether there was one. For *PolicyViolations, the errors
+// of all the violations are tested.
+func ErrorAs(err error, target interface{}) bool {
+	val := reflect.ValueOf(target)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		panic("security: target of ErrorAs must be a non-nil pointer")
+	}
+	typ := val.Type().Elem()
+	return walkErrors(err, func(err error) bool {
+		if !reflect.TypeOf(err).AssignableTo(typ) {
+			return false
+		}
+		val.Elem().Set(reflect.ValueOf(err))
+		return true
+	})
+}
+
+// walkErrors calls fn with err and each of its causes, or for
+// *PolicyViolations, the errors of its violations and their causes, until
+// fn returns true, and returns whether it did.
+func walkErrors(err error, fn func(error) bool) bool {
+	for err != nil {
+		if fn(err) {
+			return true
+		}
+		if v, ok := err.(*PolicyViolations); ok {
+			for _, violation := range v.Violations {
+				if walkErrors(violation.Err, fn) {
+					return true
+				}
+			}
+			return false
+		}
+		c, ok := err.(interface{ Cause() error })
+		if !ok {
+			return false
+		}
+		err = c.Cause()
+	}
+	return false
+}
@@ -0,0 +1,213 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+// TestErrorCategories checks that the errors of each failure category keep
+// their cause and details once wrapped.
+func TestErrorCategories(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+	hash, err := security.HashPasswordWithMethod(security.HashPBKDF2, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := security.NewPasswordPolicy(
+		security.WithMinLength(8),
+		security.WithMaxLength(16),
+		security.WithCommonPasswordCheck(true),
+		security.WithUsernameCheck(true),
+	)
+	validate := func(password string, userInputs ...string) error {
+		return policy.Validate(ctx, password, userInputs)
+	}
+	credential := security.PasswordCredential{
+		Hash:       hash,
+		ValidUntil: time.Date(2018, 9, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	for _, tc := range []struct {
+		name  string
+		err   error
+		cause error
+		// check extracts the typed error with ErrorAs and checks its fields;
+		// it is nil for bare sentinels.
+		check func(err error) bool
+	}{
+		{"empty", func() error {
+			_, err := security.HashPassword("")
+			return err
+		}(), security.ErrEmptyPassword, nil},
+		{"too short", validate("x7#kq!"), security.ErrPasswordTooShort, func(err error) bool {
+			var e *security.PasswordTooShortError
+			return security.ErrorAs(err, &e) && e.Required == 8 && e.Actual == 6
+		}},
+		{"too long to hash", func() error {
+			_, err := security.HashPassword(strings.Repeat("x", security.DefaultMaxPasswordLength+1))
+			return err
+		}(), security.ErrPasswordTooLong, func(err error) bool {
+			var e *security.PasswordTooLongError
+			return security.ErrorAs(err, &e) && e.Max == security.DefaultMaxPasswordLength
+		}},
+		{"above the policy maximum", validate("x7#kq!9vx7#kq!9vx"), security.ErrPasswordTooLong,
+			func(err error) bool {
+				var e *security.PasswordAboveMaxLengthError
+				return security.ErrorAs(err, &e) && e.Max == 16 && e.Actual == 17
+			}},
+		{"too common", validate("password"), security.ErrPasswordTooCommon, func(err error) bool {
+			var e *security.CommonPasswordError
+			return security.ErrorAs(err, &e) && e.Rank > 0
+		}},
+		{"matches username", validate("marc-1984-x7", "jane", "MARC-1984"),
+			security.ErrPasswordMatchesUsername, func(err error) bool {
+				var e *security.PasswordMatchesUsernameError
+				return security.ErrorAs(err, &e) && e.Input == "MARC-1984"
+			}},
+		{"expired", credential.Verify("hunter2", credential.ValidUntil.Add(time.Hour)),
+			security.ErrPasswordExpired, func(err error) bool {
+				var e *security.PasswordExpiredError
+				return security.ErrorAs(err, &e) && e.ValidUntil.Equal(credential.ValidUntil)
+			}},
+		{"malformed", func() error {
+			_, err := security.ParsePasswordHash([]byte("$2a$99$malformed"))
+			return err
+		}(), security.ErrMalformedHash, func(err error) bool {
+			var e *security.MalformedHashError
+			return security.ErrorAs(err, &e) && e.Err != nil
+		}},
+		{"malformed when comparing", security.CompareHashAndPassword(
+			[]byte("$pbkdf2-sha256$i=0$c2FsdA$c2FsdA"), "hunter2",
+		), security.ErrMalformedHash, func(err error) bool {
+			var e *security.MalformedHashError
+			return security.ErrorAs(err, &e)
+		}},
+		{"unrecognized", func() error {
+			_, err := security.ParsePasswordHash([]byte("hunter2"))
+			return err
+		}(), security.ErrMalformedHash, nil},
+		{"unsupported scheme", func() error {
+			_, err := security.ParsePasswordHash([]byte("{CRYPT}ab1Hv2Lg7ltQo"))
+			return err
+		}(), security.ErrHashMethodUnsupported, func(err error) bool {
+			var e *security.HashMethodUnsupportedError
+			return security.ErrorAs(err, &e) && e.Scheme == "CRYPT" &&
+				e.Format == security.HashFormatLDAP
+		}},
+		{"unsupported PHC identifier", func() error {
+			_, err := security.ParsePasswordHash([]byte("$md5$c2FsdA$c2FsdA"))
+			return err
+		}(), security.ErrHashMethodUnsupported, func(err error) bool {
+			var e *security.HashMethodUnsupportedError
+			return security.ErrorAs(err, &e) && e.Scheme == "md5" &&
+				e.Format == security.HashFormatPHC
+		}},
+		{"unsupported method", security.SetDefaultHashMethod(security.HashPGMD5),
+			security.ErrHashMethodUnsupported, func(err error) bool {
+				var e *security.HashMethodUnsupportedError
+				return security.ErrorAs(err, &e) && e.Method == security.HashPGMD5 &&
+					e.Format == ""
+			}},
+	} {
+		if tc.err == nil {
+			t.Errorf("%s: expected an error", tc.name)
+			continue
+		}
+		for _, err := range []error{
+			tc.err,
+			errors.Wrap(tc.err, "wrapped"),
+			errors.Wrapf(errors.WithMessage(tc.err, "with message"), "wrapped %d", 2),
+		} {
+			if errors.Cause(err) != tc.cause {
+				t.Errorf("%s: expected cause %v, got %v", tc.name, tc.cause, err)
+			}
+			if !security.ErrorIs(err, tc.cause) {
+				t.Errorf("%s: expected ErrorIs(%v), got %v", tc.name, tc.cause, err)
+			}
+			if tc.check != nil && !tc.check(err) {
+				t.Errorf("%s: unexpected details: %v", tc.name, err)
+			}
+		}
+	}
+
+	// ErrorIs and ErrorAs look into all the violations.
+	err = errors.Wrap(validate("password", "password"), "wrapped")
+	if !security.ErrorIs(err, security.ErrPasswordTooCommon) ||
+		!security.ErrorIs(err, security.ErrPasswordMatchesUsername) ||
+		security.ErrorIs(err, security.ErrPasswordTooShort) {
+		t.Errorf("unexpected violations: %v", err)
+	}
+	var e *security.PasswordMatchesUsernameError
+	if !security.ErrorAs(err, &e) || e.Input != "password" {
+		t.Errorf("expected the matching input, got %v", err)
+	}
+	var tse *security.PasswordTooShortError
+	if security.ErrorAs(err, &tse) {
+		t.Errorf("unexpected %v", tse)
+	}
+	if security.ErrorIs(nil, security.ErrMalformedHash) || security.ErrorAs(nil, &tse) {
+		t.Error("nil is no error")
+	}
+	if security.ErrCommonPassword != security.ErrPasswordTooCommon {
+		t.Error("expected ErrCommonPassword to be an alias")
+	}
+}
+
+// TestErrorsDoNotQuoteHashes checks that the errors for malformed and
+// unsupported hashes, e.g. passwords stored in place of their hashes, quote
+// at most 8 characters of them.
+func TestErrorsDoNotQuoteHashes(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	const secret = "hunter2hunter2"
+	for _, hash := range []string{
+		"{" + secret + "}c2FsdA",
+		secret + "$c2FsdA$c2FsdA",
+		"$" + secret + "$c2FsdA$c2FsdA",
+		"$pbkdf2-sha256$" + secret + "$c2FsdA$c2FsdA",
+		"$pbkdf2-sha256$" + secret + "=x$c2FsdA$c2FsdA",
+		"$bcrypt-sha256$" + secret + "$c2FsdA$c2FsdA",
+		"$bcrypt-sha256$v=2,t=" + secret + ",r=12$c2FsdA$c2FsdA",
+		"$bcrypt-sha256$v=2,t=2b,r=" + secret + "$c2FsdA$c2FsdA",
+	} {
+		for _, err := range []error{
+			func() error {
+				_, err := security.ParsePasswordHash([]byte(hash))
+				return err
+			}(),
+			security.CompareHashAndPassword([]byte(hash), "hunter2"),
+		} {
+			if err == nil {
+				t.Fatalf("%s: expected an error", hash)
+			}
+			if msg := err.Error(); strings.Contains(msg, secret[:9]) {
+				t.Errorf("%s: the error quotes the hash: %s", hash, msg)
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// ErrPasswordFilePermissions is the cause of PasswordFilePermissionsError.
+var ErrPasswordFilePermissions = errors.New("password file is accessible by other users")
+
+// PasswordFilePermissionsError is returned by ReadPasswordFromFile for files
+// which other users than their owner can access. Its cause is
+// ErrPasswordFilePermissions.
+type PasswordFilePermissionsError struct {
+	Path string
+	Perm os.FileMode
+}
+
+func (e *PasswordFilePermissionsError) Error() string {
+	return fmt.Sprintf("password file %s has permissions %s, exceeds %s",
+		e.Path, e.Perm, maxKeyPermissions)
+}
+
+// Cause implements the causer interface.
+func (e *PasswordFilePermissionsError) Cause() error {
+	return ErrPasswordFilePermissions
+}
+
+// PasswordFileOption configures ReadPasswordFromFile.
+type PasswordFileOption func(*passwordFileOptions)
+
+type passwordFileOptions struct {
+	raw bool
+}
+
+// RawPasswordFile makes ReadPasswordFromFile read the whole file as the
+// password, as is: no line ending is stripped, and no byte order mark is
+// recognized. This is the only way to read passwords which end with a
+// newline.
+func RawPasswordFile() PasswordFileOption {
+	return func(o *passwordFileOptions) {
+		o.raw = true
+	}
+}
+
+// ReadPasswordFromFile reads a password from the first line of a file,
+// without its trailing "\n" or "\r\n", e.g. for a --password-file flag, like
+// passwords piped into a Prompter: exactly one line ending is stripped, and
+// no other whitespace. The file is in UTF-8, or in UTF-16LE if it starts
+// with a byte order mark. The other lines are ignored, and at most the
+// maximum password length set by SetMaxPasswordLength is read. Empty
+// passwords are rejected with ErrEmptyPassword.
+//
+// Like keys, the file must not be accessible by other users than its owner,
+// unless permission checks are disabled with
+// COCKROACH_SKIP_KEY_PERMISSION_CHECK, and they are not checked on Windows.
+func ReadPasswordFromFile(path string, opts ...PasswordFileOption) (string, error) {
+	var o passwordFileOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	// Stat the opened file, rather than the path, which could have been
+	// replaced since.
+	info, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+	if perm := info.Mode().Perm(); !skipPermissionChecks &&
+		exceedsPermissions(perm, maxKeyPermissions) {
+		return "", &PasswordFilePermissionsError{Path: path, Perm: perm}
+	}
+
+	var password []byte
+	if o.raw {
+		// Read at most one byte more than the longest valid password.
+		limit := int64(atomic.LoadInt32(&maxPasswordLength)) + 1
+		password, err = ioutil.ReadAll(io.LimitReader(f, limit))
+	} else {
+		// Read at most the longest valid line, followed by "\r\n", in UTF-16
+		// after its byte order mark.
+		limit := 2*(int64(atomic.LoadInt32(&maxPasswordLength))+2) + 2
+		password, err = readPasswordLine(bufio.NewReader(io.LimitReader(f, limit)))
+	}
+	if err == io.EOF {
+		return "", ErrEmptyPassword
+	}
+	if err != nil {
+		return "", err
+	}
+	defer ZeroBytes(password)
+	if len(password) == 0 {
+		return "", ErrEmptyPassword
+	}
+	if err := checkPasswordLength(len(password)); err != nil {
+		return "", err
+	}
+	return string(password), nil
+}
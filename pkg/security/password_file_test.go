@@ -0,0 +1,142 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestReadPasswordFromFile(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	dir, err := ioutil.TempDir("", "password_file_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	for i, tc := range []struct {
+		contents string
+		mode     os.FileMode
+		raw      bool
+		expected string
+		err      string
+	}{
+		// Exactly one line ending is stripped.
+		{contents: "hunter2\n", mode: 0600, expected: "hunter2"},
+		{contents: "hunter2\r\n", mode: 0600, expected: "hunter2"},
+		{contents: "hunter2", mode: 0400, expected: "hunter2"},
+		{contents: "hunter2\r", mode: 0600, expected: "hunter2\r"},
+		{contents: "hunter2\r\r\n", mode: 0600, expected: "hunter2\r"},
+		{contents: "hunter2\n\n", mode: 0600, expected: "hunter2"},
+		{contents: "hunter2 \t\n", mode: 0600, expected: "hunter2 \t"},
+		// Raw files are read as is.
+		{contents: "hunter2\n", mode: 0600, raw: true, expected: "hunter2\n"},
+		{contents: "hunter2\r\n", mode: 0600, raw: true, expected: "hunter2\r\n"},
+		{contents: "hunter2", mode: 0600, raw: true, expected: "hunter2"},
+		{contents: "hunter2\n\nhunter3\n", mode: 0600, raw: true, expected: "hunter2\n\nhunter3\n"},
+		{contents: "\xef\xbb\xbfhunter2", mode: 0600, raw: true, expected: "\xef\xbb\xbfhunter2"},
+		{contents: "", mode: 0600, raw: true, err: "^empty passwords are not permitted$"},
+		{contents: strings.Repeat("x", security.DefaultMaxPasswordLength), mode: 0600, raw: true,
+			expected: strings.Repeat("x", security.DefaultMaxPasswordLength)},
+		{contents: strings.Repeat("x", security.DefaultMaxPasswordLength) + "\n", mode: 0600,
+			raw: true, err: "password is too long"},
+		{contents: "hunter2\n", mode: 0640, raw: true, err: "has permissions -rw-r-----"},
+		{contents: "hunter2\nhunter3\n", mode: 0600, expected: "hunter2"},
+		{contents: " hunter2 \n", mode: 0700, expected: " hunter2 "},
+		{contents: "\xff\xfe" + utf16LE("hünter2\r\nhunter3"), mode: 0600, expected: "hünter2"},
+		{contents: "\xff\xfe" + utf16LE(strings.Repeat("x", security.DefaultMaxPasswordLength)),
+			mode: 0600, expected: strings.Repeat("x", security.DefaultMaxPasswordLength)},
+		{contents: "\xef\xbb\xbfhunter2\n", mode: 0600, expected: "hunter2"},
+		{contents: "", mode: 0600, err: "^empty passwords are not permitted$"},
+		{contents: "\nhunter2\n", mode: 0600, err: "^empty passwords are not permitted$"},
+		{contents: "\r\n", mode: 0600, err: "^empty passwords are not permitted$"},
+		{contents: strings.Repeat("x", security.DefaultMaxPasswordLength) + "\r\nhunter2",
+			mode: 0600, expected: strings.Repeat("x", security.DefaultMaxPasswordLength)},
+		{contents: strings.Repeat("x", security.DefaultMaxPasswordLength+1), mode: 0600,
+			err: "password is too long"},
+		{contents: strings.Repeat("x", 100*security.DefaultMaxPasswordLength) + "\n", mode: 0600,
+			err: "password is too long"},
+		{contents: "hunter2\n", mode: 0640, err: "has permissions -rw-r-----, exceeds -rwx------"},
+		{contents: "hunter2\n", mode: 0604, err: "has permissions -rw----r--, exceeds -rwx------"},
+		{contents: "hunter2\n", mode: 0620, err: "has permissions -rw--w----, exceeds -rwx------"},
+	} {
+		path := filepath.Join(dir, "password")
+		if err := ioutil.WriteFile(path, []byte(tc.contents), 0600); err != nil {
+			t.Fatal(err)
+		}
+		// Set the mode explicitly, regardless of the umask.
+		if err := os.Chmod(path, tc.mode); err != nil {
+			t.Fatal(err)
+		}
+		var opts []security.PasswordFileOption
+		if tc.raw {
+			opts = append(opts, security.RawPasswordFile())
+		}
+		password, err := security.ReadPasswordFromFile(path, opts...)
+		if err := os.Remove(path); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(tc.err, "permissions") && runtime.GOOS == "windows" {
+			// Permissions are not checked on Windows.
+			continue
+		}
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%d: expected %q, got %v", i, tc.err, err)
+			}
+		} else if err != nil || password != tc.expected {
+			t.Errorf("%d: expected %q, got %q, %v", i, tc.expected, password, err)
+		}
+	}
+
+	// The errors are typed.
+	path := filepath.Join(dir, "password")
+	if err := ioutil.WriteFile(path, []byte("\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := security.ReadPasswordFromFile(path); err != security.ErrEmptyPassword {
+		t.Errorf("expected ErrEmptyPassword, got %v", err)
+	}
+	if runtime.GOOS != "windows" {
+		if err := os.Chmod(path, 0644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := security.ReadPasswordFromFile(path)
+		var e *security.PasswordFilePermissionsError
+		if !security.ErrorAs(err, &e) || e.Path != path || e.Perm != 0644 ||
+			errors.Cause(err) != security.ErrPasswordFilePermissions {
+			t.Errorf("expected a PasswordFilePermissionsError, got %v", err)
+		}
+	}
+	if _, err := security.ReadPasswordFromFile(filepath.Join(dir, "missing")); !os.IsNotExist(err) {
+		t.Errorf("expected a missing file, got %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultPasswordAlphabet are the characters of generated passwords by
+// default: the ASCII letters and digits, and symbols which need no quoting in
+// shells, SQL strings or connection URLs.
+const DefaultPasswordAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ" +
+	"abcdefghijklmnopqrstuvwxyz" +
+	"0123456789" +
+	"-_.~!*+=,:@^"
+
+// AmbiguousPasswordCharacters are the characters which are easily confused
+// with others in many fonts, excluded from generated passwords by
+// PasswordGenerator.ExcludeAmbiguous.
+const AmbiguousPasswordCharacters = "O0oIl1|"
+
+// maxGenerateAttempts bounds the number of passwords generated by
+// PasswordGenerator.Generate before it gives up on those which do not follow
+// the rules of the policy other than the character classes, which generated
+// passwords satisfy by construction.
+const maxGenerateAttempts = 100
+
+// PasswordGenerator generates random passwords with crypto/rand. Its zero
+// value draws from DefaultPasswordAlphabet.
+type PasswordGenerator struct {
+	// Alphabet are the characters passwords are drawn from, with the same
+	// probability, or DefaultPasswordAlphabet if empty. Repeated characters
+	// are only drawn once. Whitespace and characters rejected by
+	// CheckPasswordEncoding are not allowed.
+	Alphabet string
+	// ExcludeAmbiguous removes AmbiguousPasswordCharacters from the
+	// alphabet, for passwords which must be read by humans.
+	ExcludeAmbiguous bool
+}
+
+// GeneratePassword returns a random password of length characters drawn
+// from DefaultPasswordAlphabet which follows the policy, or the policy
+// installed with SetPasswordPolicy if nil. See PasswordGenerator.Generate.
+func GeneratePassword(policy *PasswordPolicy, length int) (string, error) {
+	return (&PasswordGenerator{}).Generate(policy, length)
+}
+
+// Generate returns a random password of length characters drawn from the
+// alphabet of the generator which follows the policy, or the policy
+// installed with SetPasswordPolicy if nil.
+//
+// Passwords include a random character of each class required by the
+// policy, and are then shuffled, so that their characters are uniformly
+// distributed over the alphabet when no class is required. Generate fails if
+// the alphabet has no character of a required class, and with a
+// *PasswordTooShortError if the length is below the minimum of the policy.
+// The Pwned Passwords service is not queried: the odds that a random
+// password was breached are negligible.
+func (g *PasswordGenerator) Generate(policy *PasswordPolicy, length int) (string, error) {
+	if policy == nil {
+		policy = activePasswordPolicy()
+	}
+	if length <= 0 {
+		return "", errors.Errorf("invalid password length %d", length)
+	}
+	if err := policy.checkMinLength(length); err != nil {
+		return "", err
+	}
+	alphabet, err := g.alphabet()
+	if err != nil {
+		return "", err
+	}
+	var byClass [4][]rune
+	for _, r := range alphabet {
+		if c, ok := characterClassOf(r); ok {
+			byClass[c] = append(byClass[c], r)
+		}
+	}
+	var required [][]rune
+	for c, req := range policy.requiredClasses() {
+		if !req {
+			continue
+		}
+		if len(byClass[c]) == 0 {
+			return "", errors.Errorf("the password alphabet has no %s, which the policy requires",
+				CharacterClass(c))
+		}
+		required = append(required, byClass[c])
+	}
+	if length < len(required) {
+		return "", errors.Errorf("cannot generate a password of %d characters with %d classes",
+			length, len(required))
+	}
+
+	// The remaining rules are checked by rejection, which practically only
+	// fails for lengths too short for them.
+	rules := *policy
+	rules.PwnedPasswords = nil
+	for attempt := 0; ; attempt++ {
+		password := make([]rune, length)
+		for i := range password {
+			set := alphabet
+			if i < len(required) {
+				set = required[i]
+			}
+			if password[i], err = randomRune(set); err != nil {
+				return "", err
+			}
+		}
+		for i := len(password) - 1; i > 0; i-- {
+			j, err := randomInt(i + 1)
+			if err != nil {
+				return "", err
+			}
+			password[i], password[j] = password[j], password[i]
+		}
+		s := string(password)
+		if err := checkPasswordLength(len(s)); err != nil {
+			return "", err
+		}
+		err := rules.validate(context.Background(), []byte(s), nil)
+		if err == nil {
+			return s, nil
+		}
+		if attempt == maxGenerateAttempts-1 {
+			return "", errors.Wrapf(err, "could not generate a password of %d characters", length)
+		}
+	}
+}
+
+// alphabet returns the distinct characters of the alphabet of the
+// generator.
+func (g *PasswordGenerator) alphabet() ([]rune, error) {
+	alphabet := g.Alphabet
+	if alphabet == "" {
+		alphabet = DefaultPasswordAlphabet
+	}
+	if err := checkPasswordEncoding([]byte(alphabet)); err != nil {
+		return nil, errors.Wrap(err, "invalid password alphabet")
+	}
+	var runes []rune
+	seen := make(map[rune]bool)
+	for _, r := range alphabet {
+		if unicode.IsSpace(r) {
+			return nil, errors.Errorf("invalid password alphabet: it contains whitespace %q", r)
+		}
+		if seen[r] || g.ExcludeAmbiguous && strings.ContainsRune(AmbiguousPasswordCharacters, r) {
+			continue
+		}
+		seen[r] = true
+		runes = append(runes, r)
+	}
+	if len(runes) < 2 {
+		return nil, errors.New("invalid password alphabet: it must have at least 2 characters")
+	}
+	return runes, nil
+}
+
+// randomRune returns a rune of the set chosen uniformly with crypto/rand.
+func randomRune(set []rune) (rune, error) {
+	i, err := randomInt(len(set))
+	if err != nil {
+		return 0, err
+	}
+	return set[i], nil
+}
+
+// randomInt returns an integer in [0, n) chosen uniformly with crypto/rand.
+func randomInt(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not read random bytes")
+	}
+	return int(i.Int64()), nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	policy := security.NewPasswordPolicy(
+		security.WithMinLength(12),
+		security.WithRequiredClasses(security.UppercaseClass, security.LowercaseClass,
+			security.DigitClass, security.SymbolClass),
+		security.WithCommonPasswordCheck(true),
+		security.WithMinStrengthScore(3),
+	)
+	seen := make(map[string]bool)
+	for i := 0; i < 200; i++ {
+		password, err := security.GeneratePassword(policy, 12)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := utf8.RuneCountInString(password); n != 12 {
+			t.Fatalf("%q: expected 12 characters, got %d", password, n)
+		}
+		if err := policy.Validate(ctx, password, nil); err != nil {
+			t.Fatalf("%q: %v", password, err)
+		}
+		if seen[password] {
+			t.Fatalf("%q was generated twice", password)
+		}
+		seen[password] = true
+	}
+
+	// The installed policy is used by default.
+	if err := security.SetPasswordPolicy(policy); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetPasswordPolicy(nil); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if _, err := security.GeneratePassword(
+		nil, 8,
+	); errors.Cause(err) != security.ErrPasswordTooShort {
+		t.Errorf("expected ErrPasswordTooShort, got %v", err)
+	}
+
+	g := &security.PasswordGenerator{ExcludeAmbiguous: true}
+	for i := 0; i < 100; i++ {
+		password, err := g.Generate(nil, 40)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.ContainsAny(password, security.AmbiguousPasswordCharacters) {
+			t.Fatalf("%q contains ambiguous characters", password)
+		}
+	}
+
+	for _, tc := range []struct {
+		alphabet string
+		length   int
+		err      string
+	}{
+		{"abcdefghijkl", 12, "the password alphabet has no uppercase letter"},
+		{"Aa1!", 3, "password is too short"},
+		{"Aa1!", 0, "invalid password length 0"},
+		{"a", 12, "at least 2 characters"},
+		{"Aa1! ", 12, "it contains whitespace"},
+		{"Aa1!\x00", 12, "must not contain NUL bytes"},
+	} {
+		g := &security.PasswordGenerator{Alphabet: tc.alphabet}
+		if _, err := g.Generate(policy, tc.length); !testutils.IsError(err, tc.err) {
+			t.Errorf("%q, %d: expected %q, got %v", tc.alphabet, tc.length, tc.err, err)
+		}
+	}
+
+	// Short passwords cannot meet high strength requirements.
+	if _, err := security.GeneratePassword(
+		security.NewPasswordPolicy(security.WithMinStrengthScore(4)), 4,
+	); !testutils.IsError(err, "could not generate a password of 4 characters: .*too weak") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// Passwords with more required classes than characters cannot be
+	// generated.
+	if _, err := security.GeneratePassword(
+		security.NewPasswordPolicy(security.WithRequiredClasses(
+			security.UppercaseClass, security.DigitClass)), 1,
+	); !testutils.IsError(err, "cannot generate a password of 1 characters with 2 classes") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestGeneratePasswordUniform(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	const passwords, length = 2000, 32
+	alphabet := security.DefaultPasswordAlphabet
+	counts := make(map[rune]int)
+	for i := 0; i < passwords; i++ {
+		password, err := security.GeneratePassword(&security.PasswordPolicy{}, length)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, r := range password {
+			if !strings.ContainsRune(alphabet, r) {
+				t.Fatalf("%q: unexpected character %q", password, r)
+			}
+			counts[r]++
+		}
+	}
+
+	// Each of the 74 characters is expected about 865 times, with a standard
+	// deviation of about 29; the bounds are more than 7 deviations away.
+	expected := float64(passwords*length) / float64(len(alphabet))
+	for _, r := range alphabet {
+		if n := float64(counts[r]); n < expected*0.75 || n > expected*1.25 {
+			t.Errorf("%q: expected about %.0f occurrences, got %.0f", r, expected, n)
+		}
+	}
+
+	// The chi-squared statistic, with 73 degrees of freedom, is below 140
+	// with an overwhelming probability.
+	var chi2 float64
+	for _, r := range alphabet {
+		d := float64(counts[r]) - expected
+		chi2 += d * d / expected
+	}
+	if chi2 > 140 {
+		t.Errorf("characters are not uniformly distributed: chi-squared is %.1f", chi2)
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// phcParam is a named integer parameter of a hash in the PHC string format.
+type phcParam struct {
+	name  string
+	value int
+}
+
+// PasswordHash is a decoded password hash. It describes the method and
+// parameters that produced the hash, and can verify passwords against it.
+//
+// Hashes other than bcrypt hashes and SCRAM verifiers use the PHC string
+// format (https://github.com/P-H-C/phc-string-format):
+//
+//	$<id>[$v=<version>][$<param>=<value>(,<param>=<value>)*][$<salt>[$<hash>]]
+//
+// bcrypt hashes keep their traditional encoding, which the PHC format was
+// modeled on, possibly preceded by a version prefix (see bcryptV2Prefix):
+//
+//	[crdb-bcrypt[2]|crdb-pepper$<key ID>]$2a$<cost>$<salt><hash>
+//
+// SCRAM verifiers and md5 hashes keep the encodings used by PostgreSQL (see
+// scramSHA256Prefix and pgMD5Prefix), salted SHA hashes the encoding used by
+// LDAP (see sshaID), and legacy salted SHA-1 and MD5 hashes the encoding used
+// by Django (see djangoSHA1ID).
+type PasswordHash struct {
+	method HashMethod
+	// id is the algorithm identifier, e.g. "argon2id", or the variant, e.g.
+	// "2a", for bcrypt and passlib bcrypt-sha256 hashes.
+	id string
+	// version is the value of the PHC "v" field, or 0 if there is none. For
+	// bcrypt hashes, it is the version of the prefix (see splitBcryptVersion).
+	version int
+	// keyID identifies the pepper of peppered bcrypt hashes.
+	keyID  string
+	params []phcParam
+	salt   []byte
+	// hash is the digest. For SCRAM verifiers it is the StoredKey.
+	hash []byte
+	// serverKey is the ServerKey of SCRAM verifiers.
+	serverKey []byte
+	// normalized is set for hashes of passwords prepared with SASLprep (see
+	// SetPasswordNormalization).
+	normalized bool
+}
+
+// hashPrefixes maps the prefix of each supported hash format to the method
+// producing it.
+var hashPrefixes = []struct {
+	prefix string
+	method HashMethod
+}{
+	{scramSHA256Prefix, HashSCRAMSHA256},
+	// Must come before the prefix of PostgreSQL md5 hashes.
+	{djangoMD5ID + "$", HashDjangoMD5},
+	{pgMD5Prefix, HashPGMD5},
+	{bcryptV2Prefix + "$2", HashBCrypt},
+	{bcryptV1Prefix + "$2", HashBCrypt},
+	{pepperPrefix + "$", HashBCrypt},
+	{"$2", HashBCrypt},
+	{"$" + argon2idID + "$", HashArgon2id},
+	{"$" + scryptID + "$", HashScrypt},
+	{"$" + pbkdf2SHA256ID + "$", HashPBKDF2},
+	{"$" + passlibBcryptSHA256ID + "$", HashPasslibBcryptSHA256},
+	{"{" + sshaID + "}", HashSSHA},
+	{"{" + ssha512ID + "}", HashSSHA512},
+	{djangoSHA1ID + "$", HashDjangoSHA1},
+}
+
+// sniffHashMethod returns the method whose prefix the hash starts with,
+// without validating the rest of the hash. The normalization prefix, if
+// any, is skipped.
+func sniffHashMethod(hashedPassword []byte) HashMethod {
+	_, hashedPassword = splitNormalizedPrefix(hashedPassword)
+	for _, p := range hashPrefixes {
+		if bytes.HasPrefix(hashedPassword, []byte(p.prefix)) {
+			return p.method
+		}
+	}
+	return HashMethodUnknown
+}
+
+// DetectHashMethod classifies a stored password hash. Hashes that do not
+// look like any supported format are reported as HashMethodUnknown, without
+// an error. An error is returned if the hash looks like a supported format
+// but is malformed, or if it uses an unsupported LDAP scheme such as
+// {CRYPT} or an unsupported legacy Django scheme such as crypt$.
+func DetectHashMethod(hashedPassword []byte) (HashMethod, error) {
+	if sniffHashMethod(hashedPassword) == HashMethodUnknown {
+		if err := checkUnsupportedScheme(hashedPassword); err != nil {
+			return HashMethodUnknown, err
+		}
+		return HashMethodUnknown, nil
+	}
+	h, err := ParsePasswordHash(hashedPassword)
+	if err != nil {
+		return HashMethodUnknown, err
+	}
+	return h.method, nil
+}
+
+// ParsePasswordHash decodes a stored password hash. Hashes in an unsupported
+// format, e.g. with the LDAP {CRYPT} scheme, are rejected with a
+// *HashMethodUnsupportedError, and other invalid hashes with a
+// *MalformedHashError.
+func ParsePasswordHash(hashedPassword []byte) (PasswordHash, error) {
+	h, err := parsePasswordHash(hashedPassword)
+	if err != nil {
+		return PasswordHash{}, malformedHash(err)
+	}
+	return h, nil
+}
+
+// checkUnsupportedScheme returns a *HashMethodUnsupportedError if the hash
+// uses an unsupported LDAP or legacy Django scheme.
+func checkUnsupportedScheme(hashedPassword []byte) error {
+	if scheme, ok := ldapScheme(hashedPassword); ok {
+		return &HashMethodUnsupportedError{Scheme: scheme, Format: HashFormatLDAP}
+	}
+	if scheme, ok := legacyScheme(hashedPassword); ok {
+		return &HashMethodUnsupportedError{Scheme: scheme, Format: HashFormatDjango}
+	}
+	return nil
+}
+
+// parsePasswordHash implements ParsePasswordHash, without wrapping the
+// errors describing malformed hashes.
+func parsePasswordHash(hashedPassword []byte) (PasswordHash, error) {
+	if normalized, _ := splitNormalizedPrefix(hashedPassword); normalized {
+		return parseNormalizedHash(hashedPassword)
+	}
+	method := sniffHashMethod(hashedPassword)
+	switch method {
+	case HashSCRAMSHA256:
+		iterations, salt, storedKey, serverKey, err := decodeSCRAMVerifier(hashedPassword)
+		if err != nil {
+			return PasswordHash{}, err
+		}
+		return PasswordHash{
+			method:    HashSCRAMSHA256,
+			id:        "SCRAM-SHA-256",
+			params:    []phcParam{{"i", iterations}},
+			salt:      salt,
+			hash:      storedKey,
+			serverKey: serverKey,
+		}, nil
+	case HashPGMD5:
+		return parsePGMD5Hash(hashedPassword)
+	case HashBCrypt:
+		return parseBcryptHash(hashedPassword)
+	case HashSSHA, HashSSHA512:
+		return parseSSHAHash(method, hashedPassword)
+	case HashDjangoSHA1, HashDjangoMD5:
+		return parseDjangoHash(method, hashedPassword)
+	case HashPasslibBcryptSHA256:
+		return parsePasslibBcryptSHA256Hash(hashedPassword)
+	case HashMethodUnknown:
+		if err := checkUnsupportedScheme(hashedPassword); err != nil {
+			return PasswordHash{}, err
+		}
+		if !bytes.HasPrefix(hashedPassword, []byte("$")) {
+			return PasswordHash{}, ErrMalformedHash
+		}
+	}
+
+	h, err := parsePHC(string(hashedPassword))
+	if err != nil {
+		return PasswordHash{}, err
+	}
+	h.method = method
+	switch method {
+	case HashArgon2id:
+		_, _, _, err = argon2idParams(h)
+	case HashScrypt:
+		_, _, _, err = scryptParams(h)
+	case HashPBKDF2:
+		_, err = pbkdf2Params(h)
+	default:
+		err = &HashMethodUnsupportedError{Scheme: h.id, Format: HashFormatPHC}
+	}
+	if err != nil {
+		return PasswordHash{}, err
+	}
+	return h, nil
+}
+
+// Method returns the method that produced the hash.
+func (h PasswordHash) Method() HashMethod {
+	return h.method
+}
+
+// Cost returns the cost of a bcrypt or passlib bcrypt-sha256 hash. It returns
+// 0 for other methods.
+func (h PasswordHash) Cost() int {
+	if h.method != HashBCrypt && h.method != HashPasslibBcryptSHA256 {
+		return 0
+	}
+	cost, _ := h.Param("cost")
+	return cost
+}
+
+// Iterations returns the iteration count of a SCRAM-SHA-256 verifier or a
+// PBKDF2 hash. It returns 0 for other methods.
+func (h PasswordHash) Iterations() int {
+	switch h.method {
+	case HashSCRAMSHA256, HashPBKDF2:
+		iterations, _ := h.Param("i")
+		return iterations
+	default:
+		return 0
+	}
+}
+
+// Salt returns a copy of the salt of the hash. It returns nil for methods
+// without a salt, such as HashPGMD5 (which is salted with the user name).
+func (h PasswordHash) Salt() []byte {
+	if h.salt == nil {
+		return nil
+	}
+	return append([]byte(nil), h.salt...)
+}
+
+// Size returns the size of the digest, in bytes. For bcrypt hashes, this is
+// the 23 bytes of the encoded digest.
+func (h PasswordHash) Size() int {
+	return len(h.hash)
+}
+
+// Param returns the value of the named parameter, e.g. "m" for the memory
+// parameter of an Argon2id hash, and whether the hash has that parameter.
+func (h PasswordHash) Param(name string) (int, bool) {
+	for _, p := range h.params {
+		if p.name == name {
+			return p.value, true
+		}
+	}
+	return 0, false
+}
+
+// Verify tests that the hash was computed from the supplied password. If it
+// was not, returns ErrPasswordMismatch. Passwords longer than the maximum
+// length (see SetMaxPasswordLength) are rejected with a
+// *PasswordTooLongError.
+func (h PasswordHash) Verify(password string) error {
+	if err := checkPasswordLength(len(password)); err != nil {
+		return err
+	}
+	return h.verify([]byte(password))
+}
+
+// verifyRaw is like verify, without preparing the password (see
+// SetPasswordNormalization).
+func (h PasswordHash) verifyRaw(password []byte) error {
+	switch h.method {
+	case HashBCrypt:
+		return verifyBcrypt(h, password)
+	case HashArgon2id:
+		return verifyArgon2id(h, password)
+	case HashScrypt:
+		return verifyScrypt(h, password)
+	case HashPBKDF2:
+		return verifyPBKDF2(h, password)
+	case HashSCRAMSHA256:
+		return verifySCRAM(h, password)
+	case HashSSHA, HashSSHA512:
+		return verifySSHA(h, password)
+	case HashDjangoSHA1, HashDjangoMD5:
+		return verifyDjango(h, password)
+	case HashPasslibBcryptSHA256:
+		return verifyPasslibBcryptSHA256(h, password)
+	case HashPGMD5:
+		return errors.New("md5 password hashes cannot be verified without the user name " +
+			"(see CompareHashAndPasswordWithUser)")
+	default:
+		return &HashMethodUnsupportedError{Method: h.method}
+	}
+}
+
+// Encode returns the textual encoding of the hash, suitable for storage.
+func (h PasswordHash) Encode() []byte {
+	if h.normalized {
+		inner := h
+		inner.normalized = false
+		return append([]byte(normalizedPrefix), inner.Encode()...)
+	}
+	var buf bytes.Buffer
+	switch h.method {
+	case HashPGMD5:
+		return []byte(pgMD5Prefix + hex.EncodeToString(h.hash))
+	case HashBCrypt:
+		return encodeBcryptHash(h, true /* withPrefix */)
+	case HashSCRAMSHA256:
+		iterations, _ := h.Param("i")
+		return encodeSCRAMVerifier(iterations, h.salt, h.hash, h.serverKey)
+	case HashSSHA, HashSSHA512:
+		return encodeSSHAHash(h)
+	case HashDjangoSHA1, HashDjangoMD5:
+		return encodeDjangoHash(h)
+	case HashPasslibBcryptSHA256:
+		return encodePasslibBcryptSHA256Hash(h)
+	}
+
+	buf.WriteByte('$')
+	buf.WriteString(h.id)
+	if h.version != 0 {
+		fmt.Fprintf(&buf, "$v=%d", h.version)
+	}
+	for i, p := range h.params {
+		if i == 0 {
+			buf.WriteByte('$')
+		} else {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(&buf, "%s=%d", p.name, p.value)
+	}
+	if h.salt != nil {
+		buf.WriteByte('$')
+		buf.WriteString(b64Raw.EncodeToString(h.salt))
+		if h.hash != nil {
+			buf.WriteByte('$')
+			buf.WriteString(b64Raw.EncodeToString(h.hash))
+		}
+	}
+	return buf.Bytes()
+}
+
+// parsePHC decodes a hash in the PHC string format. The method of the
+// returned hash is not set.
+func parsePHC(s string) (PasswordHash, error) {
+	fields := strings.Split(s, "$")
+	// The leading '$' produces an empty first field.
+	if len(fields) < 2 || fields[0] != "" || fields[1] == "" {
+		return PasswordHash{}, errors.New(
+			"malformed password hash: missing algorithm identifier")
+	}
+	h := PasswordHash{id: fields[1]}
+	fields = fields[2:]
+
+	if len(fields) > 0 && strings.HasPrefix(fields[0], "v=") {
+		v, err := strconv.Atoi(fields[0][2:])
+		if err != nil || v <= 0 {
+			return PasswordHash{}, errors.Errorf("malformed %s hash: invalid version", h.id)
+		}
+		h.version = v
+		fields = fields[1:]
+	}
+	if len(fields) > 0 && strings.Contains(fields[0], "=") {
+		for _, kv := range strings.Split(fields[0], ",") {
+			eq := strings.IndexByte(kv, '=')
+			if eq <= 0 {
+				return PasswordHash{}, errors.Errorf("malformed %s hash: invalid parameter %q",
+					h.id, hashForError(kv))
+			}
+			value, err := strconv.Atoi(kv[eq+1:])
+			if err != nil || value < 0 {
+				return PasswordHash{}, errors.Errorf(
+					"malformed %s hash: invalid value for parameter %s", h.id, hashForError(kv[:eq]))
+			}
+			h.params = append(h.params, phcParam{name: kv[:eq], value: value})
+		}
+		fields = fields[1:]
+	}
+	if len(fields) > 0 {
+		salt, err := b64Raw.DecodeString(fields[0])
+		if err != nil {
+			return PasswordHash{}, errors.Errorf("malformed %s hash: invalid salt encoding",
+				h.id)
+		}
+		h.salt = salt
+		fields = fields[1:]
+	}
+	if len(fields) > 0 {
+		hash, err := b64Raw.DecodeString(fields[0])
+		if err != nil {
+			return PasswordHash{}, errors.Errorf("malformed %s hash: invalid hash encoding",
+				h.id)
+		}
+		h.hash = hash
+		fields = fields[1:]
+	}
+	if len(fields) > 0 {
+		return PasswordHash{}, errors.Errorf("malformed %s hash: unexpected trailing fields",
+			h.id)
+	}
+	return h, nil
+}
+
+// requireParams returns the values of the named parameters of h, which must
+// be exactly the parameters of h, in order. It also checks that h has a salt
+// and a non-empty hash.
+func requireParams(h PasswordHash, names ...string) ([]int, error) {
+	if len(h.params) != len(names) {
+		return nil, errors.Errorf("malformed %s hash: expected parameters %s",
+			h.id, strings.Join(names, ","))
+	}
+	values := make([]int, len(names))
+	for i, name := range names {
+		if h.params[i].name != name {
+			return nil, errors.Errorf("malformed %s hash: expected parameters %s",
+				h.id, strings.Join(names, ","))
+		}
+		values[i] = h.params[i].value
+	}
+	if h.salt == nil {
+		return nil, errors.Errorf("malformed %s hash: missing salt", h.id)
+	}
+	if len(h.hash) == 0 {
+		return nil, errors.Errorf("malformed %s hash: missing hash", h.id)
+	}
+	return values, nil
+}
@@ -0,0 +1,379 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestParsePasswordHash(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		hash     string
+		password string
+		method   security.HashMethod
+		params   map[string]int
+	}{
+		{legacyHash, "hunter2", security.HashBCrypt, map[string]int{"cost": 10}},
+		{"$argon2id$v=19$m=64,t=1,p=4$hlj9fN3+tD/R/v1QGN14LA$" +
+			"wwTjBsMQauHfDMIioX9vxUfJnYtg6447p15pEOKvL4o",
+			"hunter2", security.HashArgon2id, map[string]int{"m": 64, "t": 1, "p": 4}},
+		{"$scrypt$ln=4,r=8,p=1$VVw/onhIvrfeLJ+toR+noQ$" +
+			"rpVCBNkzdhUiffPFbrZgdvEIfawJsktFaSYvZrAG+x4",
+			"hunter2", security.HashScrypt, map[string]int{"ln": 4, "r": 8, "p": 1}},
+		{"$pbkdf2-sha256$i=4096$c2FsdA$xeR41ZKIyEGqUw22hFxMjZYok6ABzk4RpJY4c6qYE0o",
+			"password", security.HashPBKDF2, map[string]int{"i": 4096}},
+		{pencilVerifier, "pencil", security.HashSCRAMSHA256, map[string]int{"i": 4096}},
+		{"{SSHA}gK+fFFujqnweTpwCQ7Sp02gQxCJzYWx0", "hunter2", security.HashSSHA, nil},
+		{"{SSHA512}GyU2EsF9+E4B0BGlXHMIpcC8ymBeVNNZCq6vehzMQb1dQrX5jIlEu3PDhxPGz7gJ/nvhdCWTC2l" +
+			"vcFKFX0i0qXNhbHRzYWx0", "hunter2", security.HashSSHA512, nil},
+	} {
+		h, err := security.ParsePasswordHash([]byte(tc.hash))
+		if err != nil {
+			t.Fatalf("%s: %v", tc.hash, err)
+		}
+		if m := h.Method(); m != tc.method {
+			t.Errorf("%s: expected method %s, got %s", tc.hash, tc.method, m)
+		}
+		for name, expected := range tc.params {
+			if v, ok := h.Param(name); !ok || v != expected {
+				t.Errorf("%s: expected %s=%d, got %d (%t)", tc.hash, name, expected, v, ok)
+			}
+		}
+		if _, ok := h.Param("bogus"); ok {
+			t.Errorf("%s: unexpected parameter", tc.hash)
+		}
+		if e := string(h.Encode()); e != tc.hash {
+			t.Errorf("expected %s to round-trip, got %s", tc.hash, e)
+		}
+		if err := h.Verify(tc.password); err != nil {
+			t.Errorf("%s: %v", tc.hash, err)
+		}
+		if err := h.Verify(tc.password + "x"); err != security.ErrPasswordMismatch {
+			t.Errorf("%s: expected mismatch, got %v", tc.hash, err)
+		}
+	}
+}
+
+func TestPasswordHashAccessors(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		hash       string
+		password   string
+		cost       int
+		iterations int
+		saltLen    int
+		size       int
+	}{
+		{legacyHash, "hunter2", 10, 0, 16, 23},
+		{"$argon2id$v=19$m=64,t=1,p=4$hlj9fN3+tD/R/v1QGN14LA$" +
+			"wwTjBsMQauHfDMIioX9vxUfJnYtg6447p15pEOKvL4o", "hunter2", 0, 0, 16, 32},
+		{"$pbkdf2-sha256$i=4096$c2FsdA$xeR41ZKIyEGqUw22hFxMjZYok6ABzk4RpJY4c6qYE0o",
+			"password", 0, 4096, 4, 32},
+		{pencilVerifier, "pencil", 0, 4096, 16, 32},
+		{"{SSHA}gK+fFFujqnweTpwCQ7Sp02gQxCJzYWx0", "hunter2", 0, 0, 4, 20},
+		// md5 hashes are salted with the user name.
+		{"md5f1d6e2da5767fddc60c941cf0fa924cf", "", 0, 0, 0, 16},
+	} {
+		h, err := security.ParsePasswordHash([]byte(tc.hash))
+		if err != nil {
+			t.Fatalf("%s: %v", tc.hash, err)
+		}
+		if c := h.Cost(); c != tc.cost {
+			t.Errorf("%s: expected cost %d, got %d", tc.hash, tc.cost, c)
+		}
+		if i := h.Iterations(); i != tc.iterations {
+			t.Errorf("%s: expected %d iterations, got %d", tc.hash, tc.iterations, i)
+		}
+		salt := h.Salt()
+		if len(salt) != tc.saltLen {
+			t.Errorf("%s: expected %d bytes of salt, got %d", tc.hash, tc.saltLen, len(salt))
+		}
+		if s := h.Size(); s != tc.size {
+			t.Errorf("%s: expected size %d, got %d", tc.hash, tc.size, s)
+		}
+		if tc.password == "" {
+			continue
+		}
+		// Modifying the returned salt does not affect the hash.
+		for i := range salt {
+			salt[i] ^= 0xff
+		}
+		if err := h.Verify(tc.password); err != nil {
+			t.Errorf("%s: %v", tc.hash, err)
+		}
+	}
+}
+
+func TestParsePasswordHashMalformed(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		hash string
+		err  string
+	}{
+		{"", "unrecognized password hash format"},
+		{"hunter2", "unrecognized password hash format"},
+		{"$", "missing algorithm identifier"},
+		{"$md5$c2FsdA$c2FsdA", `unsupported hash method "md5"`},
+		{"$2a$10$DWxIJOeQOrcQBiJdFGAtR.eHP0snDOB.FY0s1XMKgv8R9bBT4A4v", "truncated hash"},
+		{"$2a$10$DWxIJOeQOrcQBiJdFGAtR", "truncated salt: expected 60 bytes, got 28"},
+		{"$2a$1", "truncated version or cost"},
+		{legacyHash + "x", "unexpected trailing characters"},
+		{"$2a$1x$DWxIJOeQOrcQBiJdFGAtR.eHP0snDOB.FY0s1XMKgv8R9bBT4A4vW", "invalid cost"},
+		{"$2a$10$DWxIJOeQOrcQBiJdFGAtR!eHP0snDOB.FY0s1XMKgv8R9bBT4A4vW", "invalid salt encoding"},
+		{"$argon2id$v=x$m=64,t=1,p=4$c2FsdA$c2FsdA", "invalid version"},
+		{"$argon2id$m=64,t=1,p=4$c2FsdA$c2FsdA", "unsupported argon2id version 0"},
+		{"$argon2id$v=19$m=64,t=1$c2FsdA$c2FsdA", "expected parameters m,t,p"},
+		{"$argon2id$v=19$m=64,t=1,p=x$c2FsdA$c2FsdA", "invalid value for parameter p"},
+		{"$argon2id$v=19$m=64,t=1,p=4$c2FsdA", "missing hash"},
+		{"$argon2id$v=19$m=64,t=1,p=4$!!$c2FsdA", "invalid salt encoding"},
+		{"$scrypt$ln=4,r=8,p=1$c2FsdA$c2FsdA$c2FsdA", "unexpected trailing fields"},
+		{"$pbkdf2-sha256$i=0$c2FsdA$c2FsdA", "invalid iteration count"},
+		{"$pbkdf2-sha256$i=2147483647$c2FsdA$xeR41ZKIyEGqUw22hFxMjZYok6ABzk4RpJY4c6qYE0o",
+			"iteration count 2147483647 above the maximum"},
+		{"$pbkdf2-sha256$i=1$c2FsdA$c2FsdA", "4-byte key outside of"},
+		{"$pbkdf2-sha256$i=1$c2FsdA$" + strings.Repeat("c2FsdA", 20), "90-byte key outside of"},
+		{"SCRAM-SHA-256$4096:c2FsdA==", "malformed SCRAM-SHA-256 verifier"},
+	} {
+		if _, err := security.ParsePasswordHash([]byte(tc.hash)); !testutils.IsError(err, tc.err) {
+			t.Errorf("%q: expected error %q, got %v", tc.hash, tc.err, err)
+		}
+	}
+}
+
+func TestDetectHashMethod(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		hash   string
+		method security.HashMethod
+		err    string
+	}{
+		{legacyHash, security.HashBCrypt, ""},
+		{"$2b$10$DWxIJOeQOrcQBiJdFGAtR.eHP0snDOB.FY0s1XMKgv8R9bBT4A4vW", security.HashBCrypt, ""},
+		{"crdb-bcrypt2" + legacyHash, security.HashBCrypt, ""},
+		{"md5f1d6e2da5767fddc60c941cf0fa924cf", security.HashPGMD5, ""},
+		{pencilVerifier, security.HashSCRAMSHA256, ""},
+		{"$pbkdf2-sha256$i=1$c2FsdA$Eg+2z/z4syxD5yJSVsT4N6hlSMkszDVICAWYfLcL4Xs", security.HashPBKDF2, ""},
+
+		// Unknown formats.
+		{"", security.HashMethodUnknown, ""},
+		{"hunter2", security.HashMethodUnknown, ""},
+		{"$1$saltsalt$hashhashhashhashhash", security.HashMethodUnknown, ""},
+		{"{SSHA", security.HashMethodUnknown, ""},
+		{"SCRAM-SHA-1$4096:c2FsdA==$c2FsdA==:c2FsdA==", security.HashMethodUnknown, ""},
+
+		// Truncated or malformed hashes of a known format.
+		{legacyHash[:len(legacyHash)-1], security.HashMethodUnknown, "malformed bcrypt hash"},
+		{"$2a$", security.HashMethodUnknown, "malformed bcrypt hash"},
+		{"crdb-bcrypt2$2a$", security.HashMethodUnknown, "malformed bcrypt hash"},
+		{legacyHash[:len(legacyHash)-1] + "!", security.HashMethodUnknown, "malformed bcrypt hash"},
+		{"md5", security.HashMethodUnknown, "malformed md5 hash"},
+		{"md5f1d6e2da5767fddc60c941cf0fa924c", security.HashMethodUnknown, "malformed md5 hash"},
+		{"md5F1D6E2DA5767FDDC60C941CF0FA924CF", security.HashMethodUnknown, "malformed md5 hash"},
+		{"md5f1d6e2da5767fddc60c941cf0fa924zz", security.HashMethodUnknown, "malformed md5 hash"},
+		{pencilVerifier[:40], security.HashMethodUnknown, "malformed SCRAM-SHA-256 verifier"},
+		{"SCRAM-SHA-256$", security.HashMethodUnknown, "malformed SCRAM-SHA-256 verifier"},
+		{"$pbkdf2-sha256$i=1$c2FsdA", security.HashMethodUnknown, "malformed pbkdf2-sha256 hash"},
+		{"{SSHA}c2FsdA==", security.HashMethodUnknown, "malformed SSHA hash"},
+		{"{SSHA512}!!", security.HashMethodUnknown, "malformed SSHA512 hash"},
+
+		// Unsupported LDAP schemes.
+		{"{CRYPT}$1$saltsalt$hashhashhashhashhash", security.HashMethodUnknown,
+			`unsupported scheme \{CRYPT\}`},
+		{"{CLEARTEXT}hunter2", security.HashMethodUnknown, `unsupported scheme \{CLEARTEX…\}`},
+		{"{SHA}xJPYk9fPVBwMvtO2YEBdue0VDZU=", security.HashMethodUnknown,
+			`unsupported scheme \{SHA\}`},
+	} {
+		method, err := security.DetectHashMethod([]byte(tc.hash))
+		if !testutils.IsError(err, tc.err) {
+			t.Errorf("%q: expected error %q, got %v", tc.hash, tc.err, err)
+		}
+		if method != tc.method {
+			t.Errorf("%q: expected method %s, got %s", tc.hash, tc.method, method)
+		}
+		if tc.method == security.HashMethodUnknown {
+			if err := security.CompareHashAndPassword([]byte(tc.hash), "hunter2"); err == nil ||
+				err == security.ErrPasswordMismatch {
+				t.Errorf("%q: expected verification error, got %v", tc.hash, err)
+			}
+		}
+	}
+}
+
+func TestCompareHashAndPasswordMixed(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	// Verifiers of different formats, as found in a single users table
+	// after an import.
+	for _, tc := range []struct {
+		hash     string
+		password string
+	}{
+		{legacyHash, "hunter2"},
+		{pencilVerifier, "pencil"},
+		{"$pbkdf2-sha256$i=4096$c2FsdA$xeR41ZKIyEGqUw22hFxMjZYok6ABzk4RpJY4c6qYE0o", "password"},
+		{"{SSHA}gK+fFFujqnweTpwCQ7Sp02gQxCJzYWx0", "hunter2"},
+		// Unpadded base64, with an 8-byte salt.
+		{"{SSHA}EwvBq0sMQSLLD3eOSZNmhQqOOkRzYWx0c2FsdA", "hunter2"},
+		{"{SSHA512}s8D2eF/LzYbyRbbfwmqL/Cl1E8amhob0364if6EazgvLZPsRj1IbQPngE1bLg/Gls+0ogKaGhLW" +
+			"k0iZikQdXIHNhbHQ=", "hunter2"},
+	} {
+		if err := security.CompareHashAndPassword([]byte(tc.hash), tc.password); err != nil {
+			t.Errorf("%s: %v", tc.hash, err)
+		}
+	}
+}
+
+func TestLegacyDjangoHashes(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	// These fixtures are from the test suite of Django's password hashers.
+	testData := []struct {
+		hash     string
+		password string
+		method   security.HashMethod
+	}{
+		{"sha1$seasalt$cff36ea83f5706ce9aa7454e63e431fc726b2dc8", "lètmein", security.HashDjangoSHA1},
+		{"md5$seasalt$3f86d0d3d465b7b458c231bf3555c0e3", "lètmein", security.HashDjangoMD5},
+		// Django's unsalted SHA-1 hasher.
+		{"sha1$$6d138ca3ae545631b3abd71a4f076ce759c5700b", "lètmein", security.HashDjangoSHA1},
+	}
+
+	for _, d := range testData {
+		if err := security.CompareHashAndPassword(
+			[]byte(d.hash), d.password,
+		); err != security.ErrLegacyHashVerificationDisabled {
+			t.Errorf("%s: expected verification to be disabled, got %v", d.hash, err)
+		}
+	}
+
+	security.AllowLegacyHashVerification(true)
+	defer security.AllowLegacyHashVerification(false)
+	for _, d := range testData {
+		h, err := security.ParsePasswordHash([]byte(d.hash))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if h.Method() != d.method {
+			t.Errorf("%s: expected method %s, got %s", d.hash, d.method, h.Method())
+		}
+		if string(h.Encode()) != d.hash {
+			t.Errorf("%s: expected to round-trip, got %s", d.hash, h.Encode())
+		}
+		if err := security.CompareHashAndPassword([]byte(d.hash), d.password); err != nil {
+			t.Errorf("%s: %v", d.hash, err)
+		}
+		if err := security.CompareHashAndPassword(
+			[]byte(d.hash), "letmein",
+		); err != security.ErrPasswordMismatch {
+			t.Errorf("%s: expected mismatch, got %v", d.hash, err)
+		}
+		if !security.NeedsRehash([]byte(d.hash)) {
+			t.Errorf("%s: expected to need a rehash", d.hash)
+		}
+	}
+
+	for _, d := range []struct {
+		hash     string
+		expected string
+	}{
+		// Other schemes fail closed.
+		{"crypt$$ab1Hv2Lg7ltQo", "unsupported legacy scheme crypt\\$"},
+		{"unsalted_md5$$3f86d0d3d465b7b458c231bf3555c0e3", "unsupported legacy scheme unsalted…\\$"},
+		{"sha256$seasalt$cff36ea83f5706ce9aa7454e63e431fc726b2dc8", "unsupported legacy scheme"},
+		{"sha1$seasalt", "malformed sha1 hash: expected 3 fields, got 2"},
+		{"sha1$seasalt$cff36ea83f5706ce9aa7454e63e431fc726b2dcx", "malformed sha1 hash: invalid"},
+		{"md5$seasalt$cff36ea83f5706ce9aa7454e63e431fc726b2dc8", "malformed md5 hash: expected 16"},
+	} {
+		if err := security.CompareHashAndPassword(
+			[]byte(d.hash), "lètmein",
+		); !testutils.IsError(err, d.expected) {
+			t.Errorf("%s: expected error %q, got %v", d.hash, d.expected, err)
+		}
+	}
+}
+
+func TestPasslibBcryptSHA256(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	// These hashes of "password" are from the documentation of passlib.
+	for _, d := range []struct {
+		hash    string
+		version int
+	}{
+		{"$bcrypt-sha256$v=2,t=2b,r=12$n79VH.0Q2TMWmt3Oqt9uku$Kq4Noyk3094Y2QlB8NdRT8SvGiI4ft2", 2},
+		{"$bcrypt-sha256$2a,12$LrmaIX5x4TRtAwEfwJZa1.$2ehnw6LvuIUTM0iz4iz9hTxv21B6KFO", 1},
+	} {
+		h, err := security.ParsePasswordHash([]byte(d.hash))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m := h.Method(); m != security.HashPasslibBcryptSHA256 {
+			t.Errorf("%s: expected method %s, got %s", d.hash, security.HashPasslibBcryptSHA256, m)
+		}
+		if c := h.Cost(); c != 12 {
+			t.Errorf("%s: expected cost 12, got %d", d.hash, c)
+		}
+		if string(h.Encode()) != d.hash {
+			t.Errorf("%s: expected to round-trip, got %s", d.hash, h.Encode())
+		}
+		if err := security.CompareHashAndPassword([]byte(d.hash), "password"); err != nil {
+			t.Errorf("%s: %v", d.hash, err)
+		}
+		if err := security.CompareHashAndPassword(
+			[]byte(d.hash), "Password",
+		); err != security.ErrPasswordMismatch {
+			t.Errorf("%s: expected mismatch, got %v", d.hash, err)
+		}
+		if !security.NeedsRehash([]byte(d.hash)) {
+			t.Errorf("%s: expected to need a rehash", d.hash)
+		}
+	}
+
+	for _, d := range []struct {
+		hash     string
+		expected string
+	}{
+		{"$bcrypt-sha256$v=2,t=2b,r=12$n79VH.0Q2TMWmt3Oqt9uku", "expected 5 fields, got 4"},
+		{"$bcrypt-sha256$v=2,t=2b$n79VH.0Q2TMWmt3Oqt9uku$Kq4Noyk3094Y2QlB8NdRT8SvGiI4ft2",
+			"invalid header"},
+		{"$bcrypt-sha256$v=2,t=2y,r=12$n79VH.0Q2TMWmt3Oqt9uku$Kq4Noyk3094Y2QlB8NdRT8SvGiI4ft2",
+			"invalid bcrypt variant \"2y\""},
+		{"$bcrypt-sha256$v=2,t=2b,r=32$n79VH.0Q2TMWmt3Oqt9uku$Kq4Noyk3094Y2QlB8NdRT8SvGiI4ft2",
+			"invalid cost \"32\""},
+		{"$bcrypt-sha256$v=2,t=2b,r=12$n79VH.0Q2TMWmt3Oqt9uk$Kq4Noyk3094Y2QlB8NdRT8SvGiI4ft2",
+			"invalid salt length"},
+		{"$bcrypt-sha256$v=2,t=2b,r=12$n79VH.0Q2TMWmt3Oqt9uku$Kq4Noyk3094Y2QlB8NdRT8SvGiI4ft",
+			"invalid hash encoding"},
+	} {
+		if _, err := security.ParsePasswordHash(
+			[]byte(d.hash),
+		); !testutils.IsError(err, "malformed bcrypt-sha256 hash: "+d.expected) {
+			t.Errorf("%s: expected error %q, got %v", d.hash, d.expected, err)
+		}
+	}
+}
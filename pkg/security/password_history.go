@@ -0,0 +1,100 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// ErrPasswordReused is the cause of the errors returned by
+// CheckPasswordNotReused for passwords matching a prior hash.
+var ErrPasswordReused = errors.New("password was used recently")
+
+// PasswordReusedError is returned by CheckPasswordNotReused for passwords
+// matching a prior hash.
+type PasswordReusedError struct {
+	// Index is the index of the matching hash in the prior hashes.
+	Index int
+}
+
+func (e *PasswordReusedError) Error() string {
+	return fmt.Sprintf("%s: it must differ from the previous passwords", ErrPasswordReused)
+}
+
+// Cause implements the causer interface.
+func (e *PasswordReusedError) Cause() error {
+	return ErrPasswordReused
+}
+
+// CheckPasswordNotReused returns a *PasswordReusedError if a new password
+// matches one of the last limit hashes of a password history, ordered from
+// the oldest to the newest as maintained by AppendPasswordHistory. The hashes
+// are verified from the newest, and the first match is reported. Hashes
+// which cannot be verified, e.g. because their format is unknown, are
+// skipped.
+//
+// Each hash is fully verified, and like logins, the verifications wait for
+// the limit set by SetMaxVerifyConcurrency. The context bounds the total
+// work: when it is canceled, the remaining hashes are not verified and the
+// returned error wraps ctx.Err(). The verifications are not recorded in the
+// PasswordMetrics.
+func CheckPasswordNotReused(
+	ctx context.Context, newPassword string, priorHashes [][]byte, limit int,
+) error {
+	const op = "checking password history"
+	_, err := runWithContext(ctx, op, newPassword, func(password []byte) ([]byte, error) {
+		start := len(priorHashes) - limit
+		if start < 0 {
+			start = 0
+		}
+		for i := len(priorHashes) - 1; i >= start; i-- {
+			if err := ctx.Err(); err != nil {
+				return nil, errors.Wrap(err, op)
+			}
+			_, err := compareHashAndPasswordImpl(ctx, priorHashes[i], password, "" /* username */)
+			switch err {
+			case nil:
+				return nil, &PasswordReusedError{Index: i}
+			case ErrPasswordMismatch:
+			default:
+				if ctx.Err() != nil {
+					return nil, errors.Wrap(ctx.Err(), op)
+				}
+			}
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// AppendPasswordHistory returns a password history, ordered from the oldest
+// to the newest hash, with a new hash appended and only its last limit
+// entries kept, or nil if limit is not positive. The returned slice never
+// shares its backing array with history.
+func AppendPasswordHistory(history [][]byte, newHash []byte, limit int) [][]byte {
+	if limit <= 0 {
+		return nil
+	}
+	kept := len(history)
+	if kept > limit-1 {
+		kept = limit - 1
+	}
+	result := make([][]byte, 0, kept+1)
+	result = append(result, history[len(history)-kept:]...)
+	return append(result, newHash)
+}
@@ -0,0 +1,103 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestPasswordHistory(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+
+	const limit = 3
+	var history [][]byte
+	for i := 0; i < 5; i++ {
+		method := security.HashPBKDF2
+		if i == 4 {
+			method = security.HashSCRAMSHA256
+		}
+		hash, err := security.HashPasswordWithMethod(method, fmt.Sprintf("password%d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		history = security.AppendPasswordHistory(history, hash, limit)
+		if expected := i + 1; expected <= limit && len(history) != expected {
+			t.Fatalf("expected %d hashes, got %d", expected, len(history))
+		} else if expected > limit && len(history) != limit {
+			t.Fatalf("expected %d hashes, got %d", limit, len(history))
+		}
+	}
+	// Hashes in unknown formats are skipped.
+	history = append([][]byte{[]byte("garbage"), nil}, history...)
+
+	ctx := context.Background()
+	for _, tc := range []struct {
+		password string
+		limit    int
+		index    int
+	}{
+		// Dropped from the history.
+		{"password0", limit, -1},
+		{"password1", limit, -1},
+		{"password2", limit, 2},
+		{"password3", limit, 3},
+		{"password4", limit, 4},
+		{"password3", 1, -1},
+		{"password2", 100, 2},
+		{"password4", 0, -1},
+		{"password5", limit, -1},
+	} {
+		err := security.CheckPasswordNotReused(ctx, tc.password, history, tc.limit)
+		if tc.index < 0 {
+			if err != nil {
+				t.Errorf("%s/%d: %v", tc.password, tc.limit, err)
+			}
+			continue
+		}
+		if e, ok := err.(*security.PasswordReusedError); !ok || e.Index != tc.index ||
+			errors.Cause(err) != security.ErrPasswordReused {
+			t.Errorf("%s/%d: expected reuse of %d, got %v", tc.password, tc.limit, tc.index, err)
+		}
+	}
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := security.CheckPasswordNotReused(
+		cancelCtx, "password4", history, limit,
+	); errors.Cause(err) != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	// The returned history does not alias the previous one.
+	a := security.AppendPasswordHistory(history[:2], []byte("a"), 10)
+	if len(history[2]) == 0 || string(history[2]) == "a" {
+		t.Error("history was overwritten")
+	}
+	if len(a) != 3 || string(a[2]) != "a" {
+		t.Errorf("unexpected history %q", a)
+	}
+	if h := security.AppendPasswordHistory(history, []byte("a"), 0); h != nil {
+		t.Errorf("expected no history, got %q", h)
+	}
+}
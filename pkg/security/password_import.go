@@ -0,0 +1,106 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"fmt"
+	"math/bits"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// importedCostMargin is how much higher than the configured parameters the
+// parameters of imported hashes may be: 2 more for bcrypt costs and for the
+// log2(N) of scrypt, i.e. 4 times the work, and 4 times more for the other
+// parameters.
+const importedCostMargin = 2
+
+// ErrHashCostOutOfRange is the cause of HashCostOutOfRangeError.
+var ErrHashCostOutOfRange = errors.New("hash cost outside of the accepted range")
+
+// HashCostOutOfRangeError is returned for imported hashes, e.g. pre-hashed
+// passwords given to CREATE USER ... WITH PASSWORD, whose parameters are
+// outside of the range accepted for imported hashes. Its cause is
+// ErrHashCostOutOfRange.
+type HashCostOutOfRangeError struct {
+	Method HashMethod
+	// Param is the name of the parameter, e.g. "cost" for bcrypt hashes, or
+	// "i" for SCRAM-SHA-256 verifiers.
+	Param    string
+	Value    int
+	Min, Max int
+}
+
+func (e *HashCostOutOfRangeError) Error() string {
+	return fmt.Sprintf("%s parameter %s=%d is outside of the range [%d, %d] accepted for "+
+		"imported hashes", e.Method, e.Param, e.Value, e.Min, e.Max)
+}
+
+// Cause implements the causer interface.
+func (e *HashCostOutOfRangeError) Cause() error {
+	return ErrHashCostOutOfRange
+}
+
+// checkImportedHashParams bounds the parameters of an imported hash, so that
+// a client cannot make every verification of the password take hours, nor
+// store a hash cheaper to brute-force than the server accepts to produce.
+// The lower bound is MinAcceptedBcryptCost or the cost set with
+// SetMinVerifyCost for bcrypt costs, SCRAMMinIterations for SCRAM-SHA-256
+// iteration counts, and a quarter of the configured parameters otherwise.
+// The upper bound is the configured parameters, raised by
+// importedCostMargin.
+func checkImportedHashParams(h PasswordHash) error {
+	check := func(param string, min, max int) error {
+		if v, _ := h.Param(param); v < min || v > max {
+			return &HashCostOutOfRangeError{Method: h.method, Param: param, Value: v, Min: min, Max: max}
+		}
+		return nil
+	}
+	linear := func(param string, configured int) error {
+		return check(param, configured>>importedCostMargin, configured<<importedCostMargin)
+	}
+	var err error
+	switch h.method {
+	case HashBCrypt, HashPasslibBcryptSHA256:
+		min := MinAcceptedBcryptCost
+		if c := int(atomic.LoadInt32(&minVerifyCost)); c > min {
+			min = c
+		}
+		max := GetBcryptCost()
+		if max < MinAcceptedBcryptCost {
+			max = MinAcceptedBcryptCost
+		}
+		err = check("cost", min, max+importedCostMargin)
+	case HashSCRAMSHA256:
+		err = check("i", SCRAMMinIterations, SCRAMMinIterations<<importedCostMargin)
+	case HashPBKDF2:
+		err = linear("i", PBKDF2Iterations)
+	case HashArgon2id:
+		if err = linear("m", int(Argon2Memory)); err == nil {
+			if err = check("t", 1, int(Argon2Time)<<importedCostMargin); err == nil {
+				err = check("p", 1, int(Argon2Threads)<<importedCostMargin)
+			}
+		}
+	case HashScrypt:
+		ln := bits.TrailingZeros(uint(ScryptN))
+		if err = check("ln", ln-importedCostMargin, ln+importedCostMargin); err == nil {
+			if err = check("r", 1, ScryptR<<importedCostMargin); err == nil {
+				err = check("p", 1, ScryptP<<importedCostMargin)
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,199 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/util/metric"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+var (
+	metaPasswordOpsInFlight = metric.Metadata{
+		Name:        "security.password.ops.inflight",
+		Help:        "Number of password hashing and verification operations in progress",
+		Measurement: "Operations",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaHashLatency = metric.Metadata{
+		Name:        "security.password.hash.latency",
+		Help:        "Latency of password hashing operations",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	metaHashSuccess = metric.Metadata{
+		Name:        "security.password.hash.success",
+		Help:        "Number of passwords successfully hashed",
+		Measurement: "Operations",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaHashEmpty = metric.Metadata{
+		Name:        "security.password.hash.empty",
+		Help:        "Number of empty passwords refused for hashing",
+		Measurement: "Operations",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaHashError = metric.Metadata{
+		Name:        "security.password.hash.error",
+		Help:        "Number of password hashing operations which failed for other reasons",
+		Measurement: "Operations",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaVerifyLatency = metric.Metadata{
+		Name:        "security.password.verify.latency",
+		Help:        "Latency of password verifications",
+		Measurement: "Latency",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	metaVerifySuccess = metric.Metadata{
+		Name:        "security.password.verify.success",
+		Help:        "Number of password verifications which succeeded",
+		Measurement: "Verifications",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaVerifyMismatch = metric.Metadata{
+		Name:        "security.password.verify.mismatch",
+		Help:        "Number of password verifications which failed because of a wrong password",
+		Measurement: "Verifications",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaVerifyMalformed = metric.Metadata{
+		Name:        "security.password.verify.malformed",
+		Help:        "Number of password verifications against a malformed stored hash",
+		Measurement: "Verifications",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaVerifyWeakHash = metric.Metadata{
+		Name:        "security.password.verify.weak_hash",
+		Help:        "Number of successful password verifications against low-cost hashes",
+		Measurement: "Verifications",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaVerifyError = metric.Metadata{
+		Name:        "security.password.verify.error",
+		Help:        "Number of password verifications which failed for other reasons",
+		Measurement: "Verifications",
+		Unit:        metric.Unit_COUNT,
+	}
+)
+
+// PasswordMetrics holds metrics about the latency and outcome of password
+// hashing and verification operations. They are only maintained once
+// SetMetricsRegistry was called.
+//
+// Verifications which fail because password login is disabled for the user,
+// a method is not FIPS-approved, or the context was canceled, count as
+// errors. VerifyWeakHash counts the successful verifications against hashes
+// whose cost is below the one set with SetMinVerifyCost.
+type PasswordMetrics struct {
+	InFlight *metric.Gauge
+
+	HashLatency *metric.Histogram
+	HashSuccess *metric.Counter
+	HashEmpty   *metric.Counter
+	HashError   *metric.Counter
+
+	VerifyLatency   *metric.Histogram
+	VerifySuccess   *metric.Counter
+	VerifyMismatch  *metric.Counter
+	VerifyMalformed *metric.Counter
+	VerifyError     *metric.Counter
+	VerifyWeakHash  *metric.Counter
+}
+
+// MetricsRegistry is the part of *metric.Registry used by
+// SetMetricsRegistry.
+type MetricsRegistry interface {
+	AddMetricStruct(metricStruct interface{})
+}
+
+var passwordMetrics struct {
+	once sync.Once
+	m    *PasswordMetrics
+	// active holds m once it can be used. It is an atomic.Value, rather
+	// than m itself, so that enabling the metrics does not race with
+	// operations in progress.
+	active atomic.Value
+}
+
+// SetMetricsRegistry adds the metrics of this package to the registry, and
+// starts maintaining the PasswordMetrics. Until it is called, operations only
+// pay for a nil check. The metrics are created by the first call, with the
+// given histogram window, and shared by all the registries of a process.
+func SetMetricsRegistry(registry MetricsRegistry, histogramWindow time.Duration) {
+	passwordMetrics.once.Do(func() {
+		passwordMetrics.m = &PasswordMetrics{
+			InFlight:        metric.NewGauge(metaPasswordOpsInFlight),
+			HashLatency:     metric.NewLatency(metaHashLatency, histogramWindow),
+			HashSuccess:     metric.NewCounter(metaHashSuccess),
+			HashEmpty:       metric.NewCounter(metaHashEmpty),
+			HashError:       metric.NewCounter(metaHashError),
+			VerifyLatency:   metric.NewLatency(metaVerifyLatency, histogramWindow),
+			VerifySuccess:   metric.NewCounter(metaVerifySuccess),
+			VerifyMismatch:  metric.NewCounter(metaVerifyMismatch),
+			VerifyMalformed: metric.NewCounter(metaVerifyMalformed),
+			VerifyError:     metric.NewCounter(metaVerifyError),
+			VerifyWeakHash:  metric.NewCounter(metaVerifyWeakHash),
+		}
+		passwordMetrics.active.Store(passwordMetrics.m)
+	})
+	registry.AddMetricStruct(verifyMetrics)
+	registry.AddMetricStruct(passwordMetrics.m)
+}
+
+func activePasswordMetrics() *PasswordMetrics {
+	m, _ := passwordMetrics.active.Load().(*PasswordMetrics)
+	return m
+}
+
+// start records the start of an operation, which must be followed by a call
+// to recordHash or recordVerify.
+func (m *PasswordMetrics) start() time.Time {
+	m.InFlight.Inc(1)
+	return timeutil.Now()
+}
+
+func (m *PasswordMetrics) recordHash(start time.Time, err error) {
+	m.InFlight.Dec(1)
+	m.HashLatency.RecordValue(timeutil.Since(start).Nanoseconds())
+	switch {
+	case err == nil:
+		m.HashSuccess.Inc(1)
+	case err == ErrEmptyPassword:
+		m.HashEmpty.Inc(1)
+	default:
+		m.HashError.Inc(1)
+	}
+}
+
+func (m *PasswordMetrics) recordVerify(start time.Time, err error) {
+	m.InFlight.Dec(1)
+	m.VerifyLatency.RecordValue(timeutil.Since(start).Nanoseconds())
+	switch {
+	case err == nil:
+		m.VerifySuccess.Inc(1)
+	case err == ErrPasswordMismatch:
+		m.VerifyMismatch.Inc(1)
+	case errors.Cause(err) == ErrMalformedHash:
+		m.VerifyMalformed.Inc(1)
+	default:
+		m.VerifyError.Inc(1)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/metric"
+)
+
+// fakeRegistry records the metric structs added to it.
+type fakeRegistry []interface{}
+
+func (r *fakeRegistry) AddMetricStruct(metricStruct interface{}) {
+	*r = append(*r, metricStruct)
+}
+
+func TestPasswordMetrics(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+
+	var registry fakeRegistry
+	security.SetMetricsRegistry(&registry, time.Minute)
+	var m *security.PasswordMetrics
+	for _, s := range registry {
+		if pm, ok := s.(*security.PasswordMetrics); ok {
+			m = pm
+		}
+	}
+	if m == nil {
+		t.Fatalf("expected PasswordMetrics to be registered, got %v", registry)
+	}
+
+	hash, err := security.HashPasswordWithMethod(security.HashPBKDF2, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// In FIPS mode, hashes without a recognized prefix are refused before
+	// being parsed.
+	malformed := m.VerifyMalformed
+	if security.FIPSMode() {
+		malformed = m.VerifyError
+	}
+
+	for _, tc := range []struct {
+		name    string
+		fn      func() error
+		counter *metric.Counter
+		latency *metric.Histogram
+	}{
+		{"hash success", func() error {
+			_, err := security.HashPasswordWithMethod(security.HashPBKDF2, "hunter2")
+			return err
+		}, m.HashSuccess, m.HashLatency},
+		{"hash empty", func() error {
+			if _, err := security.HashPassword(""); err != security.ErrEmptyPassword {
+				t.Errorf("expected ErrEmptyPassword, got %v", err)
+			}
+			return nil
+		}, m.HashEmpty, m.HashLatency},
+		{"hash error", func() error {
+			if _, err := security.HashPasswordWithMethod(
+				security.HashMethodUnknown, "hunter2",
+			); err == nil {
+				t.Error("expected an error")
+			}
+			return nil
+		}, m.HashError, m.HashLatency},
+		{"verify success", func() error {
+			return security.CompareHashAndPassword(hash, "hunter2")
+		}, m.VerifySuccess, m.VerifyLatency},
+		{"verify mismatch", func() error {
+			if err := security.CompareHashAndPassword(
+				hash, "hunter3",
+			); err != security.ErrPasswordMismatch {
+				t.Errorf("expected ErrPasswordMismatch, got %v", err)
+			}
+			return nil
+		}, m.VerifyMismatch, m.VerifyLatency},
+		{"verify malformed", func() error {
+			if err := security.CompareHashAndPassword(
+				[]byte("not a hash"), "hunter2",
+			); err == nil {
+				t.Error("expected an error")
+			}
+			return nil
+		}, malformed, m.VerifyLatency},
+		{"verify error", func() error {
+			if err := security.CompareHashAndPassword(
+				security.MissingPasswordHash(), "hunter2",
+			); err != security.ErrPasswordLoginDisabled {
+				t.Errorf("expected ErrPasswordLoginDisabled, got %v", err)
+			}
+			return nil
+		}, m.VerifyError, m.VerifyLatency},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			count, samples := tc.counter.Count(), tc.latency.Snapshot().TotalCount()
+			if err := tc.fn(); err != nil {
+				t.Fatal(err)
+			}
+			if c := tc.counter.Count(); c != count+1 {
+				t.Errorf("expected %s to be %d, got %d", tc.counter.Name, count+1, c)
+			}
+			if s := tc.latency.Snapshot().TotalCount(); s != samples+1 {
+				t.Errorf("expected %d samples in %s, got %d", samples+1, tc.latency.Name, s)
+			}
+			if v := m.InFlight.Value(); v != 0 {
+				t.Errorf("expected no operations in flight, got %d", v)
+			}
+		})
+	}
+}
@@ -0,0 +1,661 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrPasswordTooShort is the cause of the errors returned for passwords
+// shorter than the minimum length of the password policy.
+var ErrPasswordTooShort = errors.New("password is too short")
+
+// PasswordTooShortError is returned for passwords shorter than the minimum
+// length of the password policy.
+type PasswordTooShortError struct {
+	// Required is the minimum length, and Actual the length of the password,
+	// in runes.
+	Required, Actual int
+}
+
+func (e *PasswordTooShortError) Error() string {
+	return fmt.Sprintf("%s: it must have at least %d characters, got %d",
+		ErrPasswordTooShort, e.Required, e.Actual)
+}
+
+// Cause implements the causer interface.
+func (e *PasswordTooShortError) Cause() error {
+	return ErrPasswordTooShort
+}
+
+// ErrPasswordTooLong is the cause of the errors returned for passwords which
+// are too long to be hashed.
+var ErrPasswordTooLong = errors.New("password is too long")
+
+// PasswordTooLongError is returned for passwords which are too long to be
+// hashed.
+type PasswordTooLongError struct {
+	// Max is the maximum length, and Actual the length of the password, in
+	// bytes.
+	Max, Actual int
+}
+
+func (e *PasswordTooLongError) Error() string {
+	return fmt.Sprintf("%s: it must have at most %d bytes, got %d",
+		ErrPasswordTooLong, e.Max, e.Actual)
+}
+
+// Cause implements the causer interface.
+func (e *PasswordTooLongError) Cause() error {
+	return ErrPasswordTooLong
+}
+
+// PasswordAboveMaxLengthError is returned for passwords longer than the
+// maximum length of the password policy.
+type PasswordAboveMaxLengthError struct {
+	// Max is the maximum length, and Actual the length of the password, in
+	// runes.
+	Max, Actual int
+}
+
+func (e *PasswordAboveMaxLengthError) Error() string {
+	return fmt.Sprintf("%s: it must have at most %d characters, got %d",
+		ErrPasswordTooLong, e.Max, e.Actual)
+}
+
+// Cause implements the causer interface.
+func (e *PasswordAboveMaxLengthError) Cause() error {
+	return ErrPasswordTooLong
+}
+
+// ErrTooFewDistinctRunes is the cause of the errors returned for passwords
+// with fewer distinct characters than required by the password policy.
+var ErrTooFewDistinctRunes = errors.New("password has too few distinct characters")
+
+// TooFewDistinctRunesError is returned for passwords with fewer distinct
+// characters than required by the password policy.
+type TooFewDistinctRunesError struct {
+	// Required is the minimum number of distinct runes, and Actual the
+	// number of distinct runes of the normalized password.
+	Required, Actual int
+}
+
+func (e *TooFewDistinctRunesError) Error() string {
+	return fmt.Sprintf("%s: it must have at least %d different characters, got %d",
+		ErrTooFewDistinctRunes, e.Required, e.Actual)
+}
+
+// Cause implements the causer interface.
+func (e *TooFewDistinctRunesError) Cause() error {
+	return ErrTooFewDistinctRunes
+}
+
+// ErrPasswordTooSimple is the cause of the errors returned for passwords
+// missing a character class required by the password policy.
+var ErrPasswordTooSimple = errors.New("password is too simple")
+
+// CharacterClass is a class of characters a password policy can require.
+// Classes are defined in terms of Unicode categories, so that e.g. "É" is an
+// uppercase letter and "٣" a digit.
+type CharacterClass int
+
+const (
+	// UppercaseClass are the uppercase letters (unicode.IsUpper).
+	UppercaseClass CharacterClass = iota
+	// LowercaseClass are the lowercase letters (unicode.IsLower).
+	LowercaseClass
+	// DigitClass are the decimal digits (unicode.IsDigit).
+	DigitClass
+	// SymbolClass are the punctuation and symbol characters (unicode.IsPunct
+	// and unicode.IsSymbol).
+	SymbolClass
+)
+
+func (c CharacterClass) String() string {
+	switch c {
+	case UppercaseClass:
+		return "uppercase letter"
+	case LowercaseClass:
+		return "lowercase letter"
+	case DigitClass:
+		return "digit"
+	case SymbolClass:
+		return "symbol"
+	default:
+		return fmt.Sprintf("CharacterClass(%d)", int(c))
+	}
+}
+
+// MissingCharacterClassesError is returned for passwords missing character
+// classes required by the password policy.
+type MissingCharacterClassesError struct {
+	// Missing are the required classes of which the password has no
+	// character, in the order of the CharacterClass constants.
+	Missing []CharacterClass
+}
+
+func (e *MissingCharacterClassesError) Error() string {
+	missing := make([]string, len(e.Missing))
+	for i, c := range e.Missing {
+		missing[i] = c.String()
+	}
+	return fmt.Sprintf("%s: it must contain at least one %s",
+		ErrPasswordTooSimple, strings.Join(missing, ", one "))
+}
+
+// Cause implements the causer interface.
+func (e *MissingCharacterClassesError) Cause() error {
+	return ErrPasswordTooSimple
+}
+
+// PasswordPolicy are the rules new passwords must follow. The policy
+// installed with SetPasswordPolicy is enforced when passwords are hashed with
+// HashPassword and the related functions, never when they are verified, so
+// that existing passwords keep working when the policy is tightened.
+//
+// The zero value accepts any password but the empty one, which is always
+// rejected with ErrEmptyPassword, and is the policy installed by default.
+// Policies can also be built with NewPasswordPolicy. A policy is safe for
+// concurrent use as long as its fields are not modified.
+type PasswordPolicy struct {
+	// MinLength is the minimum length of passwords, in runes rather than
+	// bytes, so that passwords with multibyte characters are not penalized.
+	// The default, 1, accepts any non-empty password.
+	MinLength int
+	// MaxLength, if positive, is the maximum length of passwords, in runes.
+	// Passwords longer than the limit set by SetMaxPasswordLength, in bytes,
+	// are always rejected.
+	MaxLength int
+	// RequireUppercase, RequireLowercase, RequireDigit and RequireSymbol
+	// require passwords to contain at least one character of the
+	// corresponding CharacterClass.
+	RequireUppercase, RequireLowercase, RequireDigit, RequireSymbol bool
+	// MinDistinctRunes, if positive, rejects passwords with fewer distinct
+	// runes, once normalized to NFKC, with a *TooFewDistinctRunesError, so
+	// that e.g. "aaaaaaaA1!" is rejected despite having all the character
+	// classes. Uppercase and lowercase letters are distinct.
+	MinDistinctRunes int
+	// RejectCommon rejects passwords found in the list of common passwords
+	// (see IsCommonPassword) with a *CommonPasswordError.
+	RejectCommon bool
+	// MinStrengthScore rejects passwords whose score, as estimated by
+	// EstimatePasswordStrength, is below it with a *PasswordTooWeakError.
+	MinStrengthScore int
+	// MaxTrivialPatternFraction, if positive, rejects passwords of which a
+	// larger fraction of the runes is part of trivial patterns, as detected
+	// by DetectTrivialPatterns, with a *TrivialPatternsError. For example,
+	// with 0.8, "abcd1234" and "qwertyx" are rejected but not "qwerty-Lp9".
+	MaxTrivialPatternFraction float64
+	// ForbiddenSubstrings rejects passwords which contain one of them,
+	// ignoring case, with a *ForbiddenSubstringError, e.g. the name of the
+	// organization or of the cluster, or the hostnames of the nodes.
+	// Substrings of fewer than 4 characters are ignored, so that e.g. a
+	// cluster named "db" does not forbid half of the dictionary.
+	ForbiddenSubstrings []string
+	// SensitiveForbiddenSubstrings are like ForbiddenSubstrings, but are
+	// secret: the errors do not say which one the password contains, and
+	// MarshalJSON does not encode them.
+	SensitiveForbiddenSubstrings []string
+	// RejectUsername rejects passwords which are, reverse or contain the
+	// username of the user setting them, or another of the user inputs
+	// passed to Validate (see ValidatePasswordAgainstUsername). It is only
+	// enforced when they are known, e.g. by HashPasswordWithUser.
+	RejectUsername bool
+	// PwnedPasswords, if set, rejects passwords found in the Pwned Passwords
+	// corpus with a *PwnedPasswordError. The service is only queried when a
+	// password is set, never when one is verified.
+	PwnedPasswords *PwnedPasswordChecker
+	// PwnedPasswordsFailOpen accepts passwords when the Pwned Passwords
+	// service is unavailable. By default, they are rejected with a
+	// *PwnedPasswordsUnavailableError.
+	PwnedPasswordsFailOpen bool
+	// WarnOnExpensiveViolations makes the prompts for new passwords, e.g.
+	// PromptForNewPassword, report the violations of the rules which are
+	// expensive to check, currently those of the Pwned Passwords check, as
+	// warnings, and accept the password if the user types it again. Validate
+	// still rejects such passwords, and so do the prompts when the password
+	// is piped.
+	WarnOnExpensiveViolations bool
+	// Whitespace controls the handling of leading and trailing whitespace,
+	// which users pasting passwords often include by mistake. By default, it
+	// is kept.
+	Whitespace WhitespaceMode
+}
+
+// ErrPasswordSurroundingWhitespace is returned for passwords with leading or
+// trailing whitespace by policies configured with WhitespaceReject.
+var ErrPasswordSurroundingWhitespace = errors.New(
+	"password must not begin or end with whitespace")
+
+// WhitespaceMode is the handling of the leading and trailing whitespace of
+// passwords by a PasswordPolicy. It only applies when passwords are set:
+// they are always verified byte for byte, so passwords set with surrounding
+// whitespace must be typed with it.
+type WhitespaceMode int
+
+const (
+	// WhitespaceKeep keeps leading and trailing whitespace as part of the
+	// password.
+	WhitespaceKeep WhitespaceMode = iota
+	// WhitespaceTrim strips leading and trailing whitespace before the
+	// password is validated and hashed.
+	WhitespaceTrim
+	// WhitespaceReject rejects passwords with leading or trailing whitespace
+	// with ErrPasswordSurroundingWhitespace.
+	WhitespaceReject
+)
+
+var whitespaceModeNames = [...]string{
+	WhitespaceKeep:   "keep",
+	WhitespaceTrim:   "trim",
+	WhitespaceReject: "reject",
+}
+
+func (m WhitespaceMode) String() string {
+	if m < 0 || int(m) >= len(whitespaceModeNames) {
+		return fmt.Sprintf("WhitespaceMode(%d)", int(m))
+	}
+	return whitespaceModeNames[m]
+}
+
+// MarshalText implements encoding.TextMarshaler, so that the mode is
+// reported by name in the JSON form of policies.
+func (m WhitespaceMode) MarshalText() ([]byte, error) {
+	if m < 0 || int(m) >= len(whitespaceModeNames) {
+		return nil, errors.Errorf("invalid whitespace mode %d", int(m))
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (m *WhitespaceMode) UnmarshalText(text []byte) error {
+	for i, name := range whitespaceModeNames {
+		if string(text) == name {
+			*m = WhitespaceMode(i)
+			return nil
+		}
+	}
+	return errors.Errorf("invalid whitespace mode %q", text)
+}
+
+// trimWhitespace returns the password, trimmed of its leading and trailing
+// whitespace if the policy is configured to.
+func (p *PasswordPolicy) trimWhitespace(password []byte) []byte {
+	if p.Whitespace == WhitespaceTrim {
+		return bytes.TrimFunc(password, unicode.IsSpace)
+	}
+	return password
+}
+
+// checkWhitespace returns ErrPasswordSurroundingWhitespace if the policy
+// rejects the password for its leading or trailing whitespace.
+func (p *PasswordPolicy) checkWhitespace(password []byte) error {
+	if p.Whitespace == WhitespaceReject &&
+		len(bytes.TrimFunc(password, unicode.IsSpace)) != len(password) {
+		return ErrPasswordSurroundingWhitespace
+	}
+	return nil
+}
+
+// passwordPolicy holds the *PasswordPolicy installed with
+// SetPasswordPolicy.
+var passwordPolicy atomic.Value
+
+func init() {
+	passwordPolicy.Store(&PasswordPolicy{})
+}
+
+// SetPasswordPolicy installs the policy enforced when hashing passwords. A
+// nil policy restores the default one, which accepts any non-empty
+// password. The policy is copied, so later changes to it have no effect.
+func SetPasswordPolicy(p *PasswordPolicy) error {
+	if p == nil {
+		p = &PasswordPolicy{}
+	}
+	if err := p.check(); err != nil {
+		return err
+	}
+	c := *p
+	passwordPolicy.Store(&c)
+	return nil
+}
+
+// check returns an error if the fields of the policy are invalid.
+func (p *PasswordPolicy) check() error {
+	if p.MinLength < 0 {
+		return errors.Errorf("invalid minimum password length %d", p.MinLength)
+	}
+	if p.MaxLength < 0 {
+		return errors.Errorf("invalid maximum password length %d", p.MaxLength)
+	}
+	if p.MaxLength > 0 && p.MinLength > p.MaxLength {
+		return errors.Errorf("minimum password length %d is greater than the maximum %d",
+			p.MinLength, p.MaxLength)
+	}
+	if p.MinDistinctRunes < 0 {
+		return errors.Errorf("invalid minimum number of distinct characters %d", p.MinDistinctRunes)
+	}
+	if p.MaxLength > 0 && p.MinDistinctRunes > p.MaxLength {
+		return errors.Errorf(
+			"minimum number of distinct characters %d is greater than the maximum password length %d",
+			p.MinDistinctRunes, p.MaxLength)
+	}
+	if p.MinStrengthScore < 0 || p.MinStrengthScore > 4 {
+		return errors.Errorf("invalid minimum password strength score %d", p.MinStrengthScore)
+	}
+	if p.MaxTrivialPatternFraction < 0 || p.MaxTrivialPatternFraction > 1 {
+		return errors.Errorf("invalid maximum trivial pattern fraction %g",
+			p.MaxTrivialPatternFraction)
+	}
+	_, err := p.Whitespace.MarshalText()
+	return err
+}
+
+// activePasswordPolicy returns the policy installed with SetPasswordPolicy.
+func activePasswordPolicy() *PasswordPolicy {
+	return passwordPolicy.Load().(*PasswordPolicy)
+}
+
+// CheckPasswordMinLength returns a *PasswordTooShortError if the password is
+// shorter than the minimum length of the installed policy, so that callers
+// can report it before hashing the password.
+func CheckPasswordMinLength(password string) error {
+	return activePasswordPolicy().checkMinLength(utf8.RuneCountInString(password))
+}
+
+func (p *PasswordPolicy) checkMinLength(length int) error {
+	if length < p.MinLength {
+		return &PasswordTooShortError{Required: p.MinLength, Actual: length}
+	}
+	return nil
+}
+
+func (p *PasswordPolicy) checkMaxLength(length int) error {
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return &PasswordAboveMaxLengthError{Max: p.MaxLength, Actual: length}
+	}
+	return nil
+}
+
+// checkDistinctRunes returns a *TooFewDistinctRunesError if the password,
+// normalized to NFKC, has fewer distinct runes than the minimum of the
+// policy.
+func (p *PasswordPolicy) checkDistinctRunes(password []byte) error {
+	if p.MinDistinctRunes <= 0 {
+		return nil
+	}
+	normalized := norm.NFKC.Append(nil, password...)
+	distinct := make(map[rune]struct{}, p.MinDistinctRunes)
+	for _, r := range string(normalized) {
+		distinct[r] = struct{}{}
+	}
+	ZeroBytes(normalized)
+	if len(distinct) < p.MinDistinctRunes {
+		return &TooFewDistinctRunesError{Required: p.MinDistinctRunes, Actual: len(distinct)}
+	}
+	return nil
+}
+
+// Validate checks a password against all the rules of the policy, and
+// rejects passwords which do not follow it with a *PolicyViolations
+// reporting the violation of each rule, with its ViolationCode and an error
+// such as a *PasswordTooShortError, a *PasswordAboveMaxLengthError, a
+// *MissingCharacterClassesError for each missing class, a
+// *TooFewDistinctRunesError, a *CommonPasswordError, a
+// *ForbiddenSubstringError, a *TrivialPatternsError, a
+// *PasswordMatchesUsernameError, a *PasswordTooWeakError or a
+// *PwnedPasswordError. The Pwned Passwords service is only queried if the
+// password follows the other rules. Passwords are first trimmed according
+// to the whitespace mode of the policy. Validate does not reject empty
+// passwords, which HashPassword always does.
+//
+// The user inputs are strings specific to the user setting the password,
+// such as their username, which the password must not match (see
+// RejectUsername) and which the strength estimate considers. The context
+// bounds the query of the Pwned Passwords service, if any.
+func (p *PasswordPolicy) Validate(
+	ctx context.Context, password string, userInputs []string,
+) error {
+	return p.validate(ctx, p.trimWhitespace([]byte(password)), userInputs)
+}
+
+// missingCharacterClasses returns the classes required by the policy of
+// which the password has no character, in the order of the CharacterClass
+// constants.
+func (p *PasswordPolicy) missingCharacterClasses(password []byte) []CharacterClass {
+	required := p.requiredClasses()
+	var found [len(required)]bool
+	for i := 0; i < len(password); {
+		r, size := utf8.DecodeRune(password[i:])
+		i += size
+		if c, ok := characterClassOf(r); ok {
+			found[c] = true
+		}
+	}
+	var missing []CharacterClass
+	for c := range required {
+		if required[c] && !found[c] {
+			missing = append(missing, CharacterClass(c))
+		}
+	}
+	return missing
+}
+
+// requiredClasses returns whether the policy requires each CharacterClass.
+func (p *PasswordPolicy) requiredClasses() [4]bool {
+	return [...]bool{
+		UppercaseClass: p.RequireUppercase,
+		LowercaseClass: p.RequireLowercase,
+		DigitClass:     p.RequireDigit,
+		SymbolClass:    p.RequireSymbol,
+	}
+}
+
+// characterClassOf returns the class of a rune, if it belongs to one.
+func characterClassOf(r rune) (CharacterClass, bool) {
+	switch {
+	case unicode.IsUpper(r):
+		return UppercaseClass, true
+	case unicode.IsLower(r):
+		return LowercaseClass, true
+	case unicode.IsDigit(r):
+		return DigitClass, true
+	case unicode.IsPunct(r) || unicode.IsSymbol(r):
+		return SymbolClass, true
+	}
+	return 0, false
+}
+
+// ErrForbiddenSubstring is the cause of the errors returned for passwords
+// containing one of the forbidden substrings of the password policy.
+var ErrForbiddenSubstring = errors.New("password contains a forbidden word")
+
+// ForbiddenSubstringError is returned for passwords containing one of the
+// forbidden substrings of the password policy.
+type ForbiddenSubstringError struct {
+	// Substring is the forbidden substring found in the password, unless it
+	// is Sensitive, i.e. one of the SensitiveForbiddenSubstrings.
+	Substring string
+	Sensitive bool
+}
+
+func (e *ForbiddenSubstringError) Error() string {
+	if e.Sensitive {
+		return fmt.Sprintf("%s: it must not contain a confidential value", ErrForbiddenSubstring)
+	}
+	return fmt.Sprintf("%s: it must not contain %q", ErrForbiddenSubstring, e.Substring)
+}
+
+// Cause implements the causer interface.
+func (e *ForbiddenSubstringError) Cause() error {
+	return ErrForbiddenSubstring
+}
+
+// minForbiddenSubstringRunes is the minimum length of the forbidden
+// substrings which are enforced.
+const minForbiddenSubstringRunes = 4
+
+// checkForbiddenSubstrings returns a *ForbiddenSubstringError for each of
+// the forbidden substrings the password contains, and one for the first of
+// the sensitive ones, since their errors are indistinguishable.
+func (p *PasswordPolicy) checkForbiddenSubstrings(password []byte) []error {
+	if len(p.ForbiddenSubstrings) == 0 && len(p.SensitiveForbiddenSubstrings) == 0 {
+		return nil
+	}
+	folded := string(foldRunes(string(password)))
+	contains := func(sub string) bool {
+		f := foldRunes(sub)
+		return len(f) >= minForbiddenSubstringRunes && strings.Contains(folded, string(f))
+	}
+	var errs []error
+	for _, sub := range p.ForbiddenSubstrings {
+		if contains(sub) {
+			errs = append(errs, &ForbiddenSubstringError{Substring: sub})
+		}
+	}
+	for _, sub := range p.SensitiveForbiddenSubstrings {
+		if contains(sub) {
+			errs = append(errs, &ForbiddenSubstringError{Sensitive: true})
+			break
+		}
+	}
+	return errs
+}
+
+// ErrPasswordTrivialPatterns is the cause of the errors returned for
+// passwords mostly made of trivial patterns.
+var ErrPasswordTrivialPatterns = errors.New("password is made of trivial patterns")
+
+// TrivialPatternsError is returned for passwords of which a larger fraction
+// than allowed by the password policy is part of trivial patterns.
+type TrivialPatternsError struct {
+	// Patterns are the trivial patterns of the password, as returned by
+	// DetectTrivialPatterns.
+	Patterns []PatternMatch
+	// Covered is the number of runes of the password part of the patterns,
+	// of Length.
+	Covered, Length int
+}
+
+func (e *TrivialPatternsError) Error() string {
+	kinds := make([]string, 0, len(e.Patterns))
+	seen := make(map[PatternKind]bool)
+	for _, m := range e.Patterns {
+		if !seen[m.Kind] {
+			seen[m.Kind] = true
+			kinds = append(kinds, m.Kind.String())
+		}
+	}
+	return fmt.Sprintf("%s: %d of its %d characters are part of %s patterns",
+		ErrPasswordTrivialPatterns, e.Covered, e.Length, strings.Join(kinds, ", "))
+}
+
+// Cause implements the causer interface.
+func (e *TrivialPatternsError) Cause() error {
+	return ErrPasswordTrivialPatterns
+}
+
+func (p *PasswordPolicy) checkTrivialPatterns(password []byte) error {
+	if p.MaxTrivialPatternFraction <= 0 || len(password) == 0 {
+		return nil
+	}
+	patterns := DetectTrivialPatterns(string(password))
+	var covered int
+	for _, m := range patterns {
+		covered += m.End - m.Start
+	}
+	length := utf8.RuneCount(password)
+	if float64(covered) > p.MaxTrivialPatternFraction*float64(length) {
+		return &TrivialPatternsError{Patterns: patterns, Covered: covered, Length: length}
+	}
+	return nil
+}
+
+// ErrPasswordMatchesUsername is returned for passwords which are, reverse or
+// contain the username.
+var ErrPasswordMatchesUsername = errors.New("password must not be or contain the username")
+
+// PasswordMatchesUsernameError is returned by PasswordPolicy.Validate for
+// passwords which are, reverse or contain one of the user inputs. Its cause
+// is ErrPasswordMatchesUsername.
+type PasswordMatchesUsernameError struct {
+	// Input is the matching user input, e.g. the username.
+	Input string
+}
+
+func (e *PasswordMatchesUsernameError) Error() string {
+	return ErrPasswordMatchesUsername.Error()
+}
+
+// Cause implements the causer interface.
+func (e *PasswordMatchesUsernameError) Cause() error {
+	return ErrPasswordMatchesUsername
+}
+
+// minUsernameSubstringRunes is the minimum length of the usernames which
+// passwords must not contain, so that e.g. the user "a" can choose a password
+// containing the letter a.
+const minUsernameSubstringRunes = 3
+
+// ValidatePasswordAgainstUsername returns ErrPasswordMatchesUsername if the
+// password is the username, the username reversed, or, for usernames of at
+// least 3 characters, contains the username. The comparison is case
+// insensitive, using Unicode case folding.
+func ValidatePasswordAgainstUsername(password, username string) error {
+	if username == "" {
+		return nil
+	}
+	p, u := foldRunes(password), foldRunes(username)
+	reversed := make([]rune, len(u))
+	for i, r := range u {
+		reversed[len(u)-1-i] = r
+	}
+	ps, us, rs := string(p), string(u), string(reversed)
+	if ps == us || ps == rs {
+		return ErrPasswordMatchesUsername
+	}
+	if len(u) >= minUsernameSubstringRunes &&
+		(strings.Contains(ps, us) || strings.Contains(ps, rs)) {
+		return ErrPasswordMatchesUsername
+	}
+	return nil
+}
+
+// foldRunes returns the runes of a string mapped to a canonical member of
+// their case folding orbit, so that strings equal under Unicode case folding
+// map to the same runes.
+func foldRunes(s string) []rune {
+	runes := []rune(s)
+	for i, r := range runes {
+		// The orbit of a rune is cyclic; its smallest member is canonical.
+		min := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if f < min {
+				min = f
+			}
+		}
+		runes[i] = min
+	}
+	return runes
+}
@@ -0,0 +1,163 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// characterClassNames are the names of the character classes in the JSON
+// form of policies.
+var characterClassNames = [...]string{
+	UppercaseClass: "uppercase",
+	LowercaseClass: "lowercase",
+	DigitClass:     "digit",
+	SymbolClass:    "symbol",
+}
+
+// MarshalText implements encoding.TextMarshaler, so that classes are
+// reported by name in the JSON form of policies.
+func (c CharacterClass) MarshalText() ([]byte, error) {
+	if c < 0 || int(c) >= len(characterClassNames) {
+		return nil, errors.Errorf("invalid character class %d", int(c))
+	}
+	return []byte(characterClassNames[c]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *CharacterClass) UnmarshalText(text []byte) error {
+	for i, name := range characterClassNames {
+		if string(text) == name {
+			*c = CharacterClass(i)
+			return nil
+		}
+	}
+	return errors.Errorf("invalid character class %q", text)
+}
+
+// passwordPolicyJSON is the JSON form of a PasswordPolicy. The names of its
+// fields are part of the format of the cluster setting holding the policy,
+// and must not change.
+type passwordPolicyJSON struct {
+	MinLength       int              `json:"min_length"`
+	MaxLength       int              `json:"max_length"`
+	RequiredClasses []CharacterClass `json:"required_classes"`
+	MinScore        int              `json:"min_score"`
+	CheckCommon     bool             `json:"check_common"`
+	ForbidUsername  bool             `json:"forbid_username"`
+
+	MinDistinctRunes          int            `json:"min_distinct_characters,omitempty"`
+	ForbiddenSubstrings       []string       `json:"forbidden_substrings,omitempty"`
+	MaxTrivialPatternFraction float64        `json:"max_trivial_pattern_fraction,omitempty"`
+	Whitespace                WhitespaceMode `json:"whitespace,omitempty"`
+	CheckPwned                bool           `json:"check_pwned,omitempty"`
+	PwnedFailOpen             bool           `json:"pwned_fail_open,omitempty"`
+	ExpensiveWarnings         bool           `json:"warn_on_expensive_violations,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. The policy is encoded as an object
+// with the fields min_length, max_length, required_classes (a list of
+// "uppercase", "lowercase", "digit" and "symbol"), min_score, check_common
+// and forbid_username, and, unless they are unset, min_distinct_characters,
+// forbidden_substrings, max_trivial_pattern_fraction, whitespace ("keep",
+// "trim" or "reject"), check_pwned, pwned_fail_open and
+// warn_on_expensive_violations. Only whether the Pwned Passwords service is
+// queried is encoded, not the configuration of the checker, and the
+// sensitive forbidden substrings are not encoded.
+func (p PasswordPolicy) MarshalJSON() ([]byte, error) {
+	j := passwordPolicyJSON{
+		MinLength:                 p.MinLength,
+		MaxLength:                 p.MaxLength,
+		RequiredClasses:           []CharacterClass{},
+		MinScore:                  p.MinStrengthScore,
+		CheckCommon:               p.RejectCommon,
+		ForbidUsername:            p.RejectUsername,
+		MinDistinctRunes:          p.MinDistinctRunes,
+		ForbiddenSubstrings:       p.ForbiddenSubstrings,
+		MaxTrivialPatternFraction: p.MaxTrivialPatternFraction,
+		Whitespace:                p.Whitespace,
+		CheckPwned:                p.PwnedPasswords != nil,
+		PwnedFailOpen:             p.PwnedPasswords != nil && p.PwnedPasswordsFailOpen,
+		ExpensiveWarnings:         p.WarnOnExpensiveViolations,
+	}
+	for c, required := range p.requiredClasses() {
+		if required {
+			j.RequiredClasses = append(j.RequiredClasses, CharacterClass(c))
+		}
+	}
+	return json.Marshal(&j)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding the form produced by
+// MarshalJSON. Omitted fields are unset. Unknown fields, repeated classes and
+// invalid policies, e.g. whose minimum length exceeds the maximum one, are
+// rejected with a descriptive error, in which case the policy is not
+// modified, as it is by null. If check_pwned is set, the Pwned Passwords
+// service is queried with the zero PwnedPasswordChecker.
+func (p *PasswordPolicy) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var j passwordPolicyJSON
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&j); err != nil {
+		return errors.Wrap(err, "invalid password policy")
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errors.New("invalid password policy: unexpected data after the policy")
+	}
+	policy := PasswordPolicy{
+		MinLength:                 j.MinLength,
+		MaxLength:                 j.MaxLength,
+		MinStrengthScore:          j.MinScore,
+		RejectCommon:              j.CheckCommon,
+		RejectUsername:            j.ForbidUsername,
+		MinDistinctRunes:          j.MinDistinctRunes,
+		MaxTrivialPatternFraction: j.MaxTrivialPatternFraction,
+		Whitespace:                j.Whitespace,
+		PwnedPasswordsFailOpen:    j.PwnedFailOpen,
+		WarnOnExpensiveViolations: j.ExpensiveWarnings,
+	}
+	if len(j.ForbiddenSubstrings) > 0 {
+		policy.ForbiddenSubstrings = j.ForbiddenSubstrings
+	}
+	var required [len(characterClassNames)]bool
+	for _, c := range j.RequiredClasses {
+		if required[c] {
+			return errors.Errorf("invalid password policy: character class %q is repeated",
+				characterClassNames[c])
+		}
+		required[c] = true
+	}
+	policy.RequireUppercase = required[UppercaseClass]
+	policy.RequireLowercase = required[LowercaseClass]
+	policy.RequireDigit = required[DigitClass]
+	policy.RequireSymbol = required[SymbolClass]
+	if j.CheckPwned {
+		policy.PwnedPasswords = &PwnedPasswordChecker{}
+	} else if j.PwnedFailOpen {
+		return errors.New("invalid password policy: pwned_fail_open requires check_pwned")
+	}
+	if err := policy.check(); err != nil {
+		return errors.Wrap(err, "invalid password policy")
+	}
+	*p = policy
+	return nil
+}
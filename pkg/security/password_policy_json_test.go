@@ -0,0 +1,202 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/randutil"
+)
+
+func TestPasswordPolicyJSON(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// The encoding of the default policy is stable.
+	const defaultJSON = `{"min_length":0,"max_length":0,"required_classes":[],` +
+		`"min_score":0,"check_common":false,"forbid_username":false}`
+	if b, err := json.Marshal(&security.PasswordPolicy{}); err != nil || string(b) != defaultJSON {
+		t.Errorf("expected %s, got %s, %v", defaultJSON, b, err)
+	}
+
+	// Each option round-trips, alone and with the others.
+	options := []security.PolicyOption{
+		security.WithMinLength(12),
+		security.WithMaxLength(64),
+		security.WithRequiredClasses(security.UppercaseClass, security.DigitClass,
+			security.SymbolClass),
+		security.WithMinStrengthScore(3),
+		security.WithMinDistinctRunes(6),
+		security.WithCommonPasswordCheck(true),
+		security.WithUsernameCheck(true),
+		security.WithForbiddenSubstrings("cockroach", "crdb"),
+		security.WithMaxTrivialPatternFraction(0.75),
+		security.WithWhitespaceMode(security.WhitespaceReject),
+		security.WithPwnedPasswordCheck(&security.PwnedPasswordChecker{}, true),
+		security.WithExpensiveViolationWarnings(true),
+	}
+	policies := []*security.PasswordPolicy{security.NewPasswordPolicy(options...)}
+	for _, opt := range options {
+		policies = append(policies, security.NewPasswordPolicy(opt))
+	}
+	for _, p := range policies {
+		b, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded security.PasswordPolicy
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatalf("%s: %v", b, err)
+		}
+		if !reflect.DeepEqual(&decoded, p) {
+			t.Errorf("%s: expected %+v, got %+v", b, p, decoded)
+		}
+	}
+	b, err := json.Marshal(policies[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	const allJSON = `{"min_length":12,"max_length":64,` +
+		`"required_classes":["uppercase","digit","symbol"],"min_score":3,` +
+		`"check_common":true,"forbid_username":true,"min_distinct_characters":6,` +
+		`"forbidden_substrings":["cockroach","crdb"],"max_trivial_pattern_fraction":0.75,` +
+		`"whitespace":"reject","check_pwned":true,"pwned_fail_open":true,` +
+		`"warn_on_expensive_violations":true}`
+	if string(b) != allJSON {
+		t.Errorf("expected %s, got %s", allJSON, b)
+	}
+
+	// Omitted fields are unset, and null leaves the policy unchanged.
+	p := security.NewPasswordPolicy(options...)
+	if err := json.Unmarshal([]byte(`{"min_length": 8}`), p); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(p, security.NewPasswordPolicy(security.WithMinLength(8))) {
+		t.Errorf("unexpected policy %+v", p)
+	}
+	if err := json.Unmarshal([]byte(`null`), p); err != nil || p.MinLength != 8 {
+		t.Errorf("expected the policy to be unchanged, got %+v, %v", p, err)
+	}
+
+	for _, tc := range []struct {
+		json string
+		err  string
+	}{
+		{`{"min_length": 8, "max_len": 7}`, `unknown field "max_len"`},
+		{`{"min_length": 20, "max_length": 10}`,
+			"minimum password length 20 is greater than the maximum 10"},
+		{`{"min_length": -1}`, "invalid minimum password length -1"},
+		{`{"max_length": -1}`, "invalid maximum password length -1"},
+		{`{"min_score": 5}`, "invalid minimum password strength score 5"},
+		{`{"min_distinct_characters": -1}`, "invalid minimum number of distinct characters -1"},
+		{`{"max_length": 8, "min_distinct_characters": 9}`,
+			"minimum number of distinct characters 9 is greater than the maximum password length 8"},
+		{`{"max_trivial_pattern_fraction": 1.5}`, "invalid maximum trivial pattern fraction 1.5"},
+		{`{"required_classes": ["digit", "emoji"]}`, `invalid character class "emoji"`},
+		{`{"required_classes": ["digit", "digit"]}`, `character class "digit" is repeated`},
+		{`{"whitespace": "strip"}`, `invalid whitespace mode "strip"`},
+		{`{"pwned_fail_open": true}`, "pwned_fail_open requires check_pwned"},
+		{`{"min_length": "8"}`, "cannot unmarshal string"},
+		{`{"min_length": 8.5}`, "cannot unmarshal number 8.5"},
+		{`[]`, "cannot unmarshal array"},
+		{`{"min_length": 8} {}`, "invalid character"},
+	} {
+		p := security.NewPasswordPolicy(security.WithMinLength(4))
+		err := json.Unmarshal([]byte(tc.json), p)
+		if !testutils.IsError(err, tc.err) {
+			t.Errorf("%s: expected %q, got %v", tc.json, tc.err, err)
+		}
+		if p.MinLength != 4 {
+			t.Errorf("%s: the policy was modified: %+v", tc.json, p)
+		}
+	}
+	// Trailing data is also rejected when UnmarshalJSON is called directly.
+	if err := p.UnmarshalJSON([]byte(`{} {}`)); !testutils.IsError(err, "unexpected data") {
+		t.Errorf("expected error, got %v", err)
+	}
+}
+
+// TestPasswordPolicyJSONFuzz decodes random mutations of valid policies, which
+// must either fail or produce a valid policy which round-trips.
+func TestPasswordPolicyJSONFuzz(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	defer func() {
+		if err := security.SetPasswordPolicy(nil); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	rng, _ := randutil.NewPseudoRand()
+	seeds := []string{
+		`{"min_length":12,"max_length":64,"required_classes":["uppercase","digit"],` +
+			`"min_score":3,"check_common":true,"forbid_username":true}`,
+		`{"forbidden_substrings":["crdb"],"max_trivial_pattern_fraction":0.5,` +
+			`"whitespace":"trim","check_pwned":true,"pwned_fail_open":false,` +
+			`"min_distinct_characters":5}`,
+	}
+	// tokens are spliced into the documents to reach more of the decoder.
+	tokens := []string{`"`, `{`, `}`, `[`, `]`, `,`, `:`, `null`, `true`, `-1`, `1e9`,
+		`"symbol"`, `"reject"`, `"min_length"`, `\u0000`, "\xff", ` `}
+	for i := 0; i < 20000; i++ {
+		doc := []byte(seeds[rng.Intn(len(seeds))])
+		for n := rng.Intn(4) + 1; n > 0; n-- {
+			pos := rng.Intn(len(doc) + 1)
+			switch rng.Intn(4) {
+			case 0:
+				// Delete a span.
+				end := pos + rng.Intn(8)
+				if end > len(doc) {
+					end = len(doc)
+				}
+				doc = append(doc[:pos:pos], doc[end:]...)
+			case 1:
+				// Insert a token.
+				tok := tokens[rng.Intn(len(tokens))]
+				doc = append(doc[:pos:pos], append([]byte(tok), doc[pos:]...)...)
+			case 2:
+				// Replace a byte with a random one.
+				if pos < len(doc) {
+					doc[pos] = byte(rng.Intn(256))
+				}
+			case 3:
+				// Truncate.
+				doc = doc[:pos]
+			}
+		}
+
+		var p security.PasswordPolicy
+		if err := json.Unmarshal(doc, &p); err != nil {
+			continue
+		}
+		if err := security.SetPasswordPolicy(&p); err != nil {
+			t.Fatalf("%q: decoded an invalid policy: %v", doc, err)
+		}
+		b, err := json.Marshal(&p)
+		if err != nil {
+			t.Fatalf("%q: %v", doc, err)
+		}
+		var decoded security.PasswordPolicy
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatalf("%q: re-encoded as %s: %v", doc, b, err)
+		}
+		if !reflect.DeepEqual(&decoded, &p) {
+			t.Fatalf("%q: expected %+v, got %+v", doc, p, decoded)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+// PolicyOption configures a PasswordPolicy built with NewPasswordPolicy.
+type PolicyOption func(*PasswordPolicy)
+
+// NewPasswordPolicy returns a policy configured by the options, applied in
+// order so that later options override earlier ones. Without options, the
+// policy accepts any password, like the zero PasswordPolicy. The values of
+// the options are validated by SetPasswordPolicy.
+func NewPasswordPolicy(opts ...PolicyOption) *PasswordPolicy {
+	p := &PasswordPolicy{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// WithMinLength sets the minimum length of passwords, in runes.
+func WithMinLength(n int) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.MinLength = n
+	}
+}
+
+// WithMaxLength sets the maximum length of passwords, in runes, or removes
+// it if n is zero.
+func WithMaxLength(n int) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.MaxLength = n
+	}
+}
+
+// WithRequiredClasses sets the character classes of which passwords must
+// contain at least one character, replacing those required by earlier
+// options. Without classes, no class is required.
+func WithRequiredClasses(classes ...CharacterClass) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.RequireUppercase, p.RequireLowercase = false, false
+		p.RequireDigit, p.RequireSymbol = false, false
+		for _, c := range classes {
+			switch c {
+			case UppercaseClass:
+				p.RequireUppercase = true
+			case LowercaseClass:
+				p.RequireLowercase = true
+			case DigitClass:
+				p.RequireDigit = true
+			case SymbolClass:
+				p.RequireSymbol = true
+			}
+		}
+	}
+}
+
+// WithMinDistinctRunes sets the minimum number of distinct characters of
+// passwords (see PasswordPolicy.MinDistinctRunes), or disables the check if
+// it is zero.
+func WithMinDistinctRunes(n int) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.MinDistinctRunes = n
+	}
+}
+
+// WithCommonPasswordCheck sets whether common passwords are rejected (see
+// PasswordPolicy.RejectCommon).
+func WithCommonPasswordCheck(enabled bool) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.RejectCommon = enabled
+	}
+}
+
+// WithMinStrengthScore sets the minimum strength score of passwords, from 0
+// to 4 (see EstimatePasswordStrength).
+func WithMinStrengthScore(score int) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.MinStrengthScore = score
+	}
+}
+
+// WithMaxTrivialPatternFraction sets the maximum fraction of the runes of
+// passwords which may be part of trivial patterns (see
+// PasswordPolicy.MaxTrivialPatternFraction), or disables the check if it is
+// zero.
+func WithMaxTrivialPatternFraction(fraction float64) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.MaxTrivialPatternFraction = fraction
+	}
+}
+
+// WithForbiddenSubstrings sets the substrings passwords must not contain,
+// ignoring case, replacing those set by earlier options, e.g. the name of the
+// cluster (see PasswordPolicy.ForbiddenSubstrings). The slice is copied.
+func WithForbiddenSubstrings(substrings ...string) PolicyOption {
+	substrings = append([]string(nil), substrings...)
+	return func(p *PasswordPolicy) {
+		p.ForbiddenSubstrings = substrings
+	}
+}
+
+// WithSensitiveForbiddenSubstrings is like WithForbiddenSubstrings, for
+// values which must not be revealed to the users setting passwords (see
+// PasswordPolicy.SensitiveForbiddenSubstrings).
+func WithSensitiveForbiddenSubstrings(substrings ...string) PolicyOption {
+	substrings = append([]string(nil), substrings...)
+	return func(p *PasswordPolicy) {
+		p.SensitiveForbiddenSubstrings = substrings
+	}
+}
+
+// WithUsernameCheck sets whether passwords matching the user inputs, such as
+// the username, are rejected (see PasswordPolicy.RejectUsername).
+func WithUsernameCheck(enabled bool) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.RejectUsername = enabled
+	}
+}
+
+// WithPwnedPasswordCheck sets the checker querying the Pwned Passwords
+// service, or disables the check if it is nil, and whether passwords are
+// accepted when the service is unavailable.
+func WithPwnedPasswordCheck(checker *PwnedPasswordChecker, failOpen bool) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.PwnedPasswords = checker
+		p.PwnedPasswordsFailOpen = failOpen
+	}
+}
+
+// WithExpensiveViolationWarnings sets whether the prompts for new passwords
+// accept the passwords violating only expensive rules, such as the Pwned
+// Passwords check, once typed again (see
+// PasswordPolicy.WarnOnExpensiveViolations).
+func WithExpensiveViolationWarnings(warn bool) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.WarnOnExpensiveViolations = warn
+	}
+}
+
+// WithWhitespaceMode sets the handling of the leading and trailing
+// whitespace of passwords.
+func WithWhitespaceMode(mode WhitespaceMode) PolicyOption {
+	return func(p *PasswordPolicy) {
+		p.Whitespace = mode
+	}
+}
@@ -0,0 +1,662 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestPasswordMinLength(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	defer security.TestingSetBcryptCost(4)()
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+
+	// The default policy accepts any non-empty password.
+	if err := security.CheckPasswordMinLength("a"); err != nil {
+		t.Error(err)
+	}
+	if _, err := security.HashPassword("a"); err != nil {
+		t.Error(err)
+	}
+
+	if err := security.SetPasswordPolicy(
+		&security.PasswordPolicy{MinLength: -1},
+	); !testutils.IsError(err, "invalid minimum password length") {
+		t.Errorf("expected error, got %v", err)
+	}
+	if err := security.SetPasswordPolicy(&security.PasswordPolicy{MinLength: 4}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetPasswordPolicy(nil); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	for _, tc := range []struct {
+		password string
+		length   int
+	}{
+		{"abc", 3},
+		{"abcd", 0},
+		// The length is counted in runes rather than bytes.
+		{"日本語", 3},
+		{"日本語!", 0},
+		{"ü", 1},
+	} {
+		err := security.CheckPasswordMinLength(tc.password)
+		if tc.length == 0 {
+			if err != nil {
+				t.Errorf("%q: %v", tc.password, err)
+			}
+			continue
+		}
+		if e, ok := err.(*security.PasswordTooShortError); !ok || e.Required != 4 ||
+			e.Actual != tc.length {
+			t.Errorf("%q: expected a PasswordTooShortError with lengths 4 and %d, got %v",
+				tc.password, tc.length, err)
+		}
+		if errors.Cause(err) != security.ErrPasswordTooShort {
+			t.Errorf("%q: expected ErrPasswordTooShort, got %v", tc.password, err)
+		}
+	}
+
+	// The policy is enforced when hashing, but not when verifying.
+	if _, err := security.HashPasswordWithMethod(
+		security.HashPBKDF2, "abc",
+	); !testutils.IsError(err, "must have at least 4 characters, got 3") {
+		t.Errorf("expected error, got %v", err)
+	}
+	if _, err := security.HashPassword("abc"); errors.Cause(err) != security.ErrPasswordTooShort {
+		t.Errorf("expected ErrPasswordTooShort, got %v", err)
+	}
+	if err := security.SetPasswordPolicy(nil); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := security.HashPasswordWithMethod(security.HashPBKDF2, "abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := security.SetPasswordPolicy(&security.PasswordPolicy{MinLength: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPassword(hash, "abc"); err != nil {
+		t.Error(err)
+	}
+
+	// Nor when upgrading the hash of a verified password.
+	defaultMethod := security.GetDefaultHashMethod()
+	if err := security.SetDefaultHashMethod(security.HashPBKDF2); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetDefaultHashMethod(defaultMethod); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	security.PBKDF2Iterations = 2000
+	if _, upgraded, err := security.UpgradeHashIfNeeded(hash, "abc"); err != nil || !upgraded {
+		t.Errorf("expected the hash to be upgraded, got %t, %v", upgraded, err)
+	}
+}
+
+func TestPasswordPolicyCharacterClasses(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	p := &security.PasswordPolicy{
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+	for _, tc := range []struct {
+		password string
+		expected string
+	}{
+		{"Hunter2!", ""},
+		{"hunter2!", "it must contain at least one uppercase letter$"},
+		{"HUNTER2!", "it must contain at least one lowercase letter$"},
+		{"Hunter!", "it must contain at least one digit$"},
+		{"Hunter2", "it must contain at least one symbol$"},
+		{"hunter", "violates 3 rules.*uppercase letter; .*digit; .*symbol$"},
+		{"   ", "violates 4 rules.*uppercase letter; .*lowercase letter; .*digit; .*symbol$"},
+		// Classes are Unicode-aware.
+		{"Éé٣€", ""},
+		{"ÉÉ٣€", "it must contain at least one lowercase letter$"},
+		{"Éé٣«", ""},
+	} {
+		err := p.Validate(ctx, tc.password, nil)
+		if !testutils.IsError(err, tc.expected) {
+			t.Errorf("%q: expected %q, got %v", tc.password, tc.expected, err)
+		}
+		if tc.expected != "" && errors.Cause(err) != security.ErrPasswordTooSimple {
+			t.Errorf("%q: expected ErrPasswordTooSimple, got %v", tc.password, err)
+		}
+	}
+	err := p.Validate(ctx, "hunter", nil)
+	var e *security.MissingCharacterClassesError
+	if v, ok := err.(*security.PolicyViolations); !ok || !reflect.DeepEqual(v.Codes(),
+		[]security.ViolationCode{"MISSING_UPPERCASE", "MISSING_DIGIT", "MISSING_SYMBOL"},
+	) || !v.As(&e) || len(e.Missing) != 1 || e.Missing[0] != security.UppercaseClass {
+		t.Errorf("unexpected error %#v", err)
+	}
+
+	// The default policy requires no character class.
+	if err := (&security.PasswordPolicy{}).Validate(ctx, "hunter", nil); err != nil {
+		t.Error(err)
+	}
+	if _, err := security.HashPasswordWithMethod(security.HashPBKDF2, "hunter"); err != nil {
+		t.Error(err)
+	}
+
+	// Once installed, the policy is enforced by HashPassword.
+	if err := security.SetPasswordPolicy(p); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetPasswordPolicy(nil); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if _, err := security.HashPasswordWithMethod(
+		security.HashPBKDF2, "hunter",
+	); errors.Cause(err) != security.ErrPasswordTooSimple {
+		t.Errorf("expected ErrPasswordTooSimple, got %v", err)
+	}
+}
+
+func TestValidatePasswordAgainstUsername(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		password, username string
+		matches            bool
+	}{
+		{"roach_admin", "roach_admin", true},
+		{"ROACH_ADMIN", "roach_admin", true},
+		{"nimda_hcaor", "roach_admin", true},
+		{"my-roach_admin-pw", "Roach_Admin", true},
+		{"my-NIMDA_hcaor-pw", "roach_admin", true},
+		{"roach-admin", "roach_admin", false},
+		// Unicode case folding, e.g. of the Kelvin sign and of the long s.
+		{"Kſurt!", "ksurt", true},
+		{"ΣΊΣΥΦΟΣ", "σίσυφος", true},
+		// Short usernames are only compared to the whole password.
+		{"a", "a", true},
+		{"ab", "BA", true},
+		{"banana", "a", false},
+		{"cabbage", "ab", false},
+		{"anything", "", false},
+	} {
+		err := security.ValidatePasswordAgainstUsername(tc.password, tc.username)
+		if matches := err == security.ErrPasswordMatchesUsername; matches != tc.matches ||
+			!matches && err != nil {
+			t.Errorf("%q, %q: expected a match to be %t, got %v",
+				tc.password, tc.username, tc.matches, err)
+		}
+	}
+
+	defer security.TestingSetBcryptCost(4)()
+	p := &security.PasswordPolicy{RejectUsername: true}
+	if err := p.Validate(
+		ctx, "roach_admin1", []string{"roach_admin"},
+	); errors.Cause(err) != security.ErrPasswordMatchesUsername {
+		t.Errorf("expected ErrPasswordMatchesUsername, got %v", err)
+	}
+	// The rule is only enforced when the username is known.
+	if err := p.Validate(ctx, "roach_admin1", nil); err != nil {
+		t.Error(err)
+	}
+	if err := security.SetPasswordPolicy(p); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetPasswordPolicy(nil); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if _, err := security.HashPasswordWithUser(
+		"roach_admin1", "roach_admin",
+	); errors.Cause(err) != security.ErrPasswordMatchesUsername {
+		t.Errorf("expected ErrPasswordMatchesUsername, got %v", err)
+	}
+	if _, err := security.HashPasswordWithMethod(security.HashPBKDF2, "roach_admin1"); err != nil {
+		t.Error(err)
+	}
+
+	// The username is also taken into account by the strength estimate.
+	p = &security.PasswordPolicy{MinStrengthScore: 2}
+	if err := p.Validate(ctx, "Roach_Admin2018", []string{"roach_admin"}); err == nil {
+		t.Error("expected a weak password")
+	}
+}
+
+func TestPasswordPolicyWhitespace(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+	defer func() {
+		if err := security.SetPasswordPolicy(nil); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	if err := security.SetPasswordPolicy(
+		&security.PasswordPolicy{Whitespace: 3},
+	); !testutils.IsError(err, "invalid whitespace mode") {
+		t.Errorf("expected error, got %v", err)
+	}
+
+	for _, tc := range []struct {
+		mode     security.WhitespaceMode
+		password string
+		// hashed is the password which was hashed, or empty if it is
+		// rejected with err.
+		hashed string
+		err    error
+	}{
+		{security.WhitespaceKeep, " hunter2 ", " hunter2 ", nil},
+		// Newlines are control characters.
+		{security.WhitespaceKeep, "hunter2\n", "", security.ErrPasswordContainsControl},
+		{security.WhitespaceTrim, " hunter2 ", "hunter2", nil},
+		{security.WhitespaceTrim, "\thunter2\r\n", "hunter2", nil},
+		{security.WhitespaceTrim, "hunter2 ", "hunter2", nil},
+		{security.WhitespaceTrim, "hunter 2", "hunter 2", nil},
+		{security.WhitespaceTrim, " \n ", "", security.ErrEmptyPassword},
+		{security.WhitespaceReject, "hunter 2", "hunter 2", nil},
+		{security.WhitespaceReject, "hunter2 ", "", security.ErrPasswordSurroundingWhitespace},
+		{security.WhitespaceReject, "\u00a0hunter2", "", security.ErrPasswordSurroundingWhitespace},
+	} {
+		p := &security.PasswordPolicy{Whitespace: tc.mode}
+		if err := security.SetPasswordPolicy(p); err != nil {
+			t.Fatal(err)
+		}
+		// Validate does not reject empty passwords, nor check their encoding.
+		validateErr := tc.err
+		if validateErr == security.ErrEmptyPassword ||
+			validateErr == security.ErrPasswordContainsControl {
+			validateErr = nil
+		}
+		if err := p.Validate(ctx, tc.password, nil); errors.Cause(err) != validateErr {
+			t.Errorf("%s %q: expected %v, got %v", tc.mode, tc.password, validateErr, err)
+		}
+		hash, err := security.HashPasswordWithMethod(security.HashPBKDF2, tc.password)
+		if errors.Cause(err) != tc.err {
+			t.Errorf("%s %q: expected %v, got %v", tc.mode, tc.password, tc.err, err)
+		}
+		if tc.err != nil {
+			continue
+		}
+		// Verification is byte for byte.
+		if err := security.CompareHashAndPassword(hash, tc.hashed); err != nil {
+			t.Errorf("%s %q: %v", tc.mode, tc.password, err)
+		}
+		if tc.hashed != tc.password {
+			if err := security.CompareHashAndPassword(
+				hash, tc.password,
+			); err != security.ErrPasswordMismatch {
+				t.Errorf("%s %q: expected mismatch, got %v", tc.mode, tc.password, err)
+			}
+		}
+	}
+
+	// The mode is reported by name in the JSON form of the policy.
+	b, err := json.Marshal(security.PasswordPolicy{Whitespace: security.WhitespaceTrim})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"whitespace":"trim"`) {
+		t.Errorf("unexpected JSON %s", b)
+	}
+	var p security.PasswordPolicy
+	if err := json.Unmarshal(b, &p); err != nil || p.Whitespace != security.WhitespaceTrim {
+		t.Errorf("expected %s, got %s, %v", security.WhitespaceTrim, p.Whitespace, err)
+	}
+	if err := json.Unmarshal(
+		[]byte(`{"whitespace":"strip"}`), &p,
+	); !testutils.IsError(err, "invalid whitespace mode") {
+		t.Errorf("expected error, got %v", err)
+	}
+}
+
+func TestNewPasswordPolicy(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	// Without options, the policy accepts everything, like the zero policy.
+	if p := security.NewPasswordPolicy(); !reflect.DeepEqual(p, &security.PasswordPolicy{}) {
+		t.Errorf("unexpected policy %+v", p)
+	}
+	for _, password := range []string{"", "a", "password", " x "} {
+		if err := security.NewPasswordPolicy().Validate(ctx, password, []string{"x"}); err != nil {
+			t.Errorf("%q: %v", password, err)
+		}
+	}
+
+	p := security.NewPasswordPolicy(
+		security.WithMinLength(12),
+		security.WithMinLength(8),
+		security.WithRequiredClasses(security.UppercaseClass, security.SymbolClass),
+		security.WithRequiredClasses(security.DigitClass),
+		security.WithCommonPasswordCheck(true),
+		security.WithForbiddenSubstrings("cockroach", "crdb"),
+		security.WithUsernameCheck(true),
+		security.WithMinStrengthScore(2),
+	)
+	expected := &security.PasswordPolicy{
+		MinLength:           8,
+		RequireDigit:        true,
+		RejectCommon:        true,
+		ForbiddenSubstrings: []string{"cockroach", "crdb"},
+		RejectUsername:      true,
+		MinStrengthScore:    2,
+	}
+	if !reflect.DeepEqual(p, expected) {
+		t.Errorf("expected %+v, got %+v", expected, p)
+	}
+	if err := security.SetPasswordPolicy(p); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetPasswordPolicy(nil); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	for _, tc := range []struct {
+		password   string
+		userInputs []string
+		cause      error
+	}{
+		{"x7#kq!9v", nil, nil},
+		{"x7#kq!", nil, security.ErrPasswordTooShort},
+		{"x&#kq!Zv", nil, security.ErrPasswordTooSimple},
+		{"password1", nil, security.ErrCommonPassword},
+		{"my-CockRoach-42", nil, security.ErrForbiddenSubstring},
+		{"x7crdb9vkq", nil, security.ErrForbiddenSubstring},
+		{"marc-1984-x7", []string{"jane", "MARC-1984"}, security.ErrPasswordMatchesUsername},
+		{"aaaaaaaa1", nil, security.ErrPasswordTooWeak},
+	} {
+		err := p.Validate(ctx, tc.password, tc.userInputs)
+		if errors.Cause(err) != tc.cause {
+			t.Errorf("%q: expected %v, got %v", tc.password, tc.cause, err)
+		}
+	}
+	err := p.Validate(ctx, "my-CockRoach-42", nil)
+	var e *security.ForbiddenSubstringError
+	if v, ok := err.(*security.PolicyViolations); !ok || !v.As(&e) || e.Substring != "cockroach" {
+		t.Errorf("expected a ForbiddenSubstringError, got %v", err)
+	}
+
+	// Later options override earlier ones.
+	p = security.NewPasswordPolicy(
+		security.WithCommonPasswordCheck(true),
+		security.WithForbiddenSubstrings("crdb"),
+		security.WithCommonPasswordCheck(false),
+		security.WithForbiddenSubstrings(),
+		security.WithRequiredClasses(security.DigitClass),
+		security.WithRequiredClasses(),
+	)
+	if err := p.Validate(ctx, "crdbpassword", nil); err != nil {
+		t.Error(err)
+	}
+
+	// The policy is safe for concurrent use.
+	p = security.NewPasswordPolicy(security.WithMinStrengthScore(3), security.WithUsernameCheck(true))
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				user := fmt.Sprintf("user%d", i)
+				if err := p.Validate(
+					ctx, user+"password", []string{user},
+				); errors.Cause(err) != security.ErrPasswordMatchesUsername {
+					t.Errorf("expected ErrPasswordMatchesUsername, got %v", err)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestPasswordPolicyForbiddenSubstrings(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	p := security.NewPasswordPolicy(
+		security.WithForbiddenSubstrings("acme", "db", "Kube"),
+		security.WithSensitiveForbiddenSubstrings("prod-east", "node-17.internal", "ab"),
+	)
+	for _, tc := range []struct {
+		password  string
+		forbidden []string
+		sensitive bool
+	}{
+		{"x7#kq!9vTz", nil, false},
+		// Substrings of fewer than 4 characters are ignored.
+		{"db-x7#kq!ab", nil, false},
+		// The comparison uses Unicode case folding: U+212A is the Kelvin sign.
+		{"my-ACME-\u212aube", []string{"acme", "Kube"}, false},
+		{"PROD-East-2018", nil, true},
+		{"acme@node-17.internal/prod-east", []string{"acme"}, true},
+	} {
+		err := p.Validate(ctx, tc.password, nil)
+		var forbidden []string
+		var sensitive bool
+		if v, ok := err.(*security.PolicyViolations); ok {
+			for _, violation := range v.Violations {
+				e, ok := violation.Err.(*security.ForbiddenSubstringError)
+				if !ok {
+					t.Fatalf("%q: unexpected violation %v", tc.password, violation.Err)
+				}
+				if e.Sensitive {
+					if sensitive || e.Substring != "" {
+						t.Errorf("%q: unexpected %+v", tc.password, e)
+					}
+					sensitive = true
+				} else {
+					forbidden = append(forbidden, e.Substring)
+				}
+			}
+		} else if err != nil {
+			t.Fatalf("%q: %v", tc.password, err)
+		}
+		if !reflect.DeepEqual(forbidden, tc.forbidden) || sensitive != tc.sensitive {
+			t.Errorf("%q: expected %q (sensitive: %t), got %v", tc.password, tc.forbidden,
+				tc.sensitive, err)
+		}
+		// Sensitive values are never revealed.
+		if err != nil && (strings.Contains(strings.ToLower(err.Error()), "prod") ||
+			strings.Contains(err.Error(), "internal")) {
+			t.Errorf("%q: the error reveals a sensitive value: %v", tc.password, err)
+		}
+	}
+	if err := p.Validate(ctx, "prod-east", nil); !testutils.IsError(err,
+		"^password contains a forbidden word: it must not contain a confidential value$") {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// Nor encoded.
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "prod") {
+		t.Errorf("the encoding reveals a sensitive value: %s", b)
+	}
+}
+
+func TestPasswordPolicyMinDistinctRunes(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		min      int
+		password string
+		distinct int
+	}{
+		{0, "aaaa", 0},
+		// Uppercase and lowercase letters are distinct.
+		{5, "aaaaaaaA1!", 4},
+		{5, "aaaaaaaAb1!", 0},
+		{4, "aAbB", 0},
+		{5, "aAbB", 4},
+		// Multibyte runes are counted once each, after NFKC normalization:
+		// "e\u0301" composes to "é", and the fullwidth "ｅ" is "e".
+		{3, "日本語日本", 0},
+		{4, "日本語日本", 3},
+		{2, "ééééé", 1},
+		{2, "e\u0301\u00e9", 1},
+		{3, "eｅEＥe", 2},
+		{3, "\u00e9e\u0301ñ", 2},
+	} {
+		p := security.NewPasswordPolicy(security.WithMinDistinctRunes(tc.min))
+		err := p.Validate(ctx, tc.password, nil)
+		if tc.distinct == 0 {
+			if err != nil {
+				t.Errorf("%d, %q: %v", tc.min, tc.password, err)
+			}
+			continue
+		}
+		var e *security.TooFewDistinctRunesError
+		if !security.ErrorAs(err, &e) || e.Required != tc.min || e.Actual != tc.distinct {
+			t.Errorf("%d, %q: expected %d distinct runes, got %v", tc.min, tc.password,
+				tc.distinct, err)
+		}
+	}
+
+	// The rule is reported along with the others.
+	p := security.NewPasswordPolicy(
+		security.WithMinLength(12),
+		security.WithRequiredClasses(security.DigitClass),
+		security.WithMinDistinctRunes(5),
+	)
+	err := p.Validate(ctx, "aaaaaaaA!", nil)
+	v, ok := err.(*security.PolicyViolations)
+	if !ok || !reflect.DeepEqual(v.Codes(), []security.ViolationCode{
+		security.ViolationTooShort, security.ViolationMissingDigit,
+		security.ViolationTooFewDistinctRunes,
+	}) {
+		t.Fatalf("unexpected violations %v", err)
+	}
+	if !testutils.IsError(err, "password has too few distinct characters: "+
+		"it must have at least 5 different characters, got 3$") {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := p.Validate(ctx, "aaaaaaaaA!b1", nil); err != nil {
+		t.Error(err)
+	}
+
+	if err := security.SetPasswordPolicy(security.NewPasswordPolicy(
+		security.WithMinDistinctRunes(-1),
+	)); !testutils.IsError(err, "invalid minimum number of distinct characters -1") {
+		t.Errorf("expected error, got %v", err)
+	}
+}
+
+func TestPolicyViolations(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	p := security.NewPasswordPolicy(
+		security.WithMinLength(8),
+		security.WithRequiredClasses(security.DigitClass, security.SymbolClass),
+		security.WithCommonPasswordCheck(true),
+		security.WithForbiddenSubstrings("roach", "crdb"),
+		security.WithUsernameCheck(true),
+		security.WithWhitespaceMode(security.WhitespaceReject),
+	)
+	if err := p.Validate(ctx, "x7#kq!9vTz", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// All the rules are checked, in order.
+	err := p.Validate(ctx, " crdbRoach", []string{"Roach"})
+	v, ok := err.(*security.PolicyViolations)
+	if !ok {
+		t.Fatalf("expected a PolicyViolations, got %v", err)
+	}
+	expected := []security.ViolationCode{
+		"SURROUNDING_WHITESPACE",
+		"MISSING_DIGIT",
+		"MISSING_SYMBOL",
+		"FORBIDDEN_SUBSTRING",
+		"FORBIDDEN_SUBSTRING",
+		"MATCHES_USERNAME",
+	}
+	if codes := v.Codes(); !reflect.DeepEqual(codes, expected) {
+		t.Errorf("expected %v, got %v", expected, codes)
+	}
+	if !testutils.IsError(err, "^password violates 6 rules of the password policy: "+
+		"password must not begin or end with whitespace; .*one digit; .*one symbol; "+
+		`.*"roach"; .*"crdb"; password must not be or contain the username$`) {
+		t.Errorf("unexpected message %q", err)
+	}
+	for _, v := range v.Violations {
+		if v.Message() != v.Err.Error() {
+			t.Errorf("%s: expected %q, got %q", v.Code, v.Err, v.Message())
+		}
+	}
+
+	// Callers can test for specific violations.
+	if errors.Cause(err) != security.ErrPasswordSurroundingWhitespace {
+		t.Errorf("expected the first violation to be the cause, got %v", errors.Cause(err))
+	}
+	if !v.Has(security.ViolationMissingDigit) || v.Has(security.ViolationTooShort) {
+		t.Error("unexpected codes")
+	}
+	if !v.Is(security.ErrPasswordTooSimple) || !v.Is(security.ErrPasswordMatchesUsername) ||
+		v.Is(security.ErrCommonPassword) {
+		t.Error("unexpected causes")
+	}
+	var fse *security.ForbiddenSubstringError
+	if !v.As(&fse) || fse.Substring != "roach" {
+		t.Errorf("expected the first forbidden substring, got %v", fse)
+	}
+	var tse *security.PasswordTooShortError
+	if v.As(&tse) {
+		t.Errorf("unexpected %v", tse)
+	}
+	var target error
+	if !v.As(&target) || target != security.ErrPasswordSurroundingWhitespace {
+		t.Errorf("expected the first error, got %v", target)
+	}
+
+	// A single violation is reported by its own message.
+	err = p.Validate(ctx, "x7#kq!9", nil)
+	if !testutils.IsError(err, "^password is too short: it must have at least 8 characters, got 7$") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// EnvPasswordOption configures ReadPasswordFromEnv.
+type EnvPasswordOption func(*envPasswordOptions)
+
+type envPasswordOptions struct {
+	clear bool
+}
+
+// ClearAfterRead makes ReadPasswordFromEnv unset the variable once it has
+// been read, so that child processes do not inherit it.
+func ClearAfterRead() EnvPasswordOption {
+	return func(o *envPasswordOptions) {
+		o.clear = true
+	}
+}
+
+// ReadPasswordFromEnv reads a password from an environment variable, e.g.
+// injected by a Kubernetes secret. found is whether the variable is set:
+// if it is set but empty, ErrEmptyPassword is returned, and passwords longer
+// than the maximum length set by SetMaxPasswordLength are rejected.
+//
+// With ClearAfterRead, the variable is unset, even if the password is
+// rejected. Note that this does not change the initial environment of the
+// process, e.g. as shown by /proc/self/environ.
+func ReadPasswordFromEnv(
+	varName string, opts ...EnvPasswordOption,
+) (password string, found bool, err error) {
+	var o envPasswordOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	password, found = os.LookupEnv(varName)
+	if !found {
+		return "", false, nil
+	}
+	if o.clear {
+		if err := os.Unsetenv(varName); err != nil {
+			return "", true, err
+		}
+	}
+	if password == "" {
+		return "", true, ErrEmptyPassword
+	}
+	if err := checkPasswordLength(len(password)); err != nil {
+		return "", true, err
+	}
+	return password, true, nil
+}
+
+// PasswordSource provides a password to ResolvePassword. found is whether
+// the source is configured, e.g. whether an environment variable is set,
+// and err the failure to read the password if it is.
+type PasswordSource func() (password string, found bool, err error)
+
+// PasswordFromEnv returns a PasswordSource reading the password from an
+// environment variable with ReadPasswordFromEnv. It is not found if the
+// variable is not set.
+func PasswordFromEnv(varName string, opts ...EnvPasswordOption) PasswordSource {
+	return func() (string, bool, error) {
+		return ReadPasswordFromEnv(varName, opts...)
+	}
+}
+
+// PasswordFromFile returns a PasswordSource reading the password from a
+// file with ReadPasswordFromFile. It is not found if the path is empty,
+// e.g. when a --password-file flag is not given.
+func PasswordFromFile(path string, opts ...PasswordFileOption) PasswordSource {
+	return func() (string, bool, error) {
+		if path == "" {
+			return "", false, nil
+		}
+		password, err := ReadPasswordFromFile(path, opts...)
+		return password, true, err
+	}
+}
+
+// PasswordFromPrompt returns a PasswordSource prompting for the password
+// with p, or if p is nil, with PromptForPassword. It is always found.
+func PasswordFromPrompt(p *Prompter) PasswordSource {
+	return func() (string, bool, error) {
+		prompter := p
+		if prompter == nil {
+			prompter = defaultPrompter()
+		}
+		password, err := prompter.ReadPassword()
+		return password, true, err
+	}
+}
+
+// ErrNoPasswordSource is returned by ResolvePassword when none of its
+// sources is found.
+var ErrNoPasswordSource = errors.New("no password was provided")
+
+// ResolvePassword returns the password of the first of the sources which is
+// found, or its error, e.g. so that a CLI command reads the password from an
+// environment variable, then a file, then prompts for it:
+//
+//   password, err := security.ResolvePassword(
+//     security.PasswordFromEnv("COCKROACH_PASSWORD", security.ClearAfterRead()),
+//     security.PasswordFromFile(passwordFile),
+//     security.PasswordFromPrompt(nil),
+//   )
+//
+// ErrNoPasswordSource is returned if none is found.
+func ResolvePassword(sources ...PasswordSource) (string, error) {
+	for _, source := range sources {
+		password, found, err := source()
+		if found {
+			return password, err
+		}
+	}
+	return "", ErrNoPasswordSource
+}
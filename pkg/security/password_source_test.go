@@ -0,0 +1,159 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestReadPasswordFromEnv(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	const varName = "COCKROACH_TEST_PASSWORD"
+	defer func() {
+		if err := os.Unsetenv(varName); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	for _, tc := range []struct {
+		value    *string
+		clear    bool
+		expected string
+		found    bool
+		err      string
+	}{
+		{value: nil},
+		{value: nil, clear: true},
+		{value: strPtr("hunter2"), expected: "hunter2", found: true},
+		{value: strPtr(" hunter2\n"), expected: " hunter2\n", found: true},
+		{value: strPtr("hunter2"), clear: true, expected: "hunter2", found: true},
+		{value: strPtr(""), found: true, err: "^empty passwords are not permitted$"},
+		{value: strPtr(""), clear: true, found: true, err: "^empty passwords are not permitted$"},
+		{value: strPtr(strings.Repeat("x", security.DefaultMaxPasswordLength+1)), found: true,
+			err: "password is too long"},
+	} {
+		if err := os.Unsetenv(varName); err != nil {
+			t.Fatal(err)
+		}
+		if tc.value != nil {
+			if err := os.Setenv(varName, *tc.value); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var opts []security.EnvPasswordOption
+		if tc.clear {
+			opts = append(opts, security.ClearAfterRead())
+		}
+		password, found, err := security.ReadPasswordFromEnv(varName, opts...)
+		if !testutils.IsError(err, tc.err) {
+			t.Errorf("%+v: expected %q, got %v", tc, tc.err, err)
+		}
+		if password != tc.expected || found != tc.found {
+			t.Errorf("%+v: expected %q, %t, got %q, %t", tc, tc.expected, tc.found, password, found)
+		}
+		// The variable is only unset with ClearAfterRead.
+		if _, set := os.LookupEnv(varName); set != (tc.value != nil && !tc.clear) {
+			t.Errorf("%+v: unexpected %s set: %t", tc, varName, set)
+		}
+	}
+}
+
+// strPtr returns a pointer to s.
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestResolvePassword(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	const varName = "COCKROACH_TEST_PASSWORD"
+	defer func() {
+		if err := os.Unsetenv(varName); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	dir, err := ioutil.TempDir("", "password_source_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	path := filepath.Join(dir, "password")
+	if err := ioutil.WriteFile(path, []byte("from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	resolve := func(passwordFile string, prompted ...string) (string, error) {
+		return security.ResolvePassword(
+			security.PasswordFromEnv(varName),
+			security.PasswordFromFile(passwordFile),
+			security.PasswordFromPrompt(security.NewTestPrompter(prompted)),
+		)
+	}
+	// The prompt is the last resort.
+	if password, err := resolve("", "from-prompt"); err != nil || password != "from-prompt" {
+		t.Errorf("expected from-prompt, got %q, %v", password, err)
+	}
+	if password, err := resolve(path, "from-prompt"); err != nil || password != "from-file" {
+		t.Errorf("expected from-file, got %q, %v", password, err)
+	}
+	if err := os.Setenv(varName, "from-env"); err != nil {
+		t.Fatal(err)
+	}
+	if password, err := resolve(path, "from-prompt"); err != nil || password != "from-env" {
+		t.Errorf("expected from-env, got %q, %v", password, err)
+	}
+	// The error of the first source found is returned, without trying the
+	// others.
+	if err := os.Setenv(varName, ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resolve(path, "from-prompt"); err != security.ErrEmptyPassword {
+		t.Errorf("expected ErrEmptyPassword, got %v", err)
+	}
+	if err := os.Unsetenv(varName); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resolve(filepath.Join(dir, "missing")); !os.IsNotExist(err) {
+		t.Errorf("expected a missing file, got %v", err)
+	}
+	if _, err := resolve(""); !testutils.IsError(err, "EOF") {
+		t.Errorf("expected EOF, got %v", err)
+	}
+
+	// PasswordFromPrompt(nil) prompts with the default prompter.
+	defer security.TestingSetDefaultPrompter(security.NewTestPrompter([]string{"hunter2"}))()
+	password, err := security.ResolvePassword(security.PasswordFromPrompt(nil))
+	if err != nil || password != "hunter2" {
+		t.Errorf("expected hunter2, got %q, %v", password, err)
+	}
+
+	if _, err := security.ResolvePassword(
+		security.PasswordFromEnv(varName), security.PasswordFromFile(""),
+	); err != security.ErrNoPasswordSource {
+		t.Errorf("expected ErrNoPasswordSource, got %v", err)
+	}
+}
@@ -0,0 +1,1642 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/metric"
+	"github.com/cockroachdb/cockroach/pkg/util/randutil"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+// legacyHash is a bcrypt hash of "hunter2" produced by HashPassword before
+// any hash method other than bcrypt existed.
+const legacyHash = "$2a$10$DWxIJOeQOrcQBiJdFGAtR.eHP0snDOB.FY0s1XMKgv8R9bBT4A4vW"
+
+func TestHashPasswordWithMethod(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	// Use cheap parameters to keep the test fast.
+	defer security.TestingSetBcryptCost(4)()
+	defer func(m, tm uint32, n, i int) {
+		security.Argon2Memory, security.Argon2Time = m, tm
+		security.ScryptN, security.PBKDF2Iterations = n, i
+	}(security.Argon2Memory, security.Argon2Time, security.ScryptN, security.PBKDF2Iterations)
+	security.Argon2Memory, security.Argon2Time = 64, 1
+	security.ScryptN, security.PBKDF2Iterations = 16, 1000
+
+	for _, tc := range []struct {
+		method security.HashMethod
+		prefix string
+	}{
+		{security.HashBCrypt, "crdb-bcrypt2$2a$04$"},
+		{security.HashArgon2id, "$argon2id$v=19$m=64,t=1,p=4$"},
+		{security.HashScrypt, "$scrypt$ln=4,r=8,p=1$"},
+		{security.HashPBKDF2, "$pbkdf2-sha256$i=1000$"},
+		{security.HashSCRAMSHA256, "SCRAM-SHA-256$4096:"},
+	} {
+		hash, err := security.HashPasswordWithMethod(tc.method, "hunter2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasPrefix(hash, []byte(tc.prefix)) {
+			t.Errorf("%s: expected prefix %q, got %q", tc.method, tc.prefix, hash)
+		}
+		if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+			t.Errorf("%s: %v", tc.method, err)
+		}
+		if err := security.CompareHashAndPassword(
+			hash, "hunter3",
+		); err != security.ErrPasswordMismatch {
+			t.Errorf("%s: expected mismatch, got %v", tc.method, err)
+		}
+	}
+
+	if _, err := security.HashPasswordWithMethod(security.HashMethodUnknown, "hunter2"); err == nil {
+		t.Error("expected error for unknown hash method")
+	}
+}
+
+func TestCompareLegacyHash(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	if err := security.CompareHashAndPassword([]byte(legacyHash), "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPassword(
+		[]byte(legacyHash), "hunter3",
+	); err != security.ErrPasswordMismatch {
+		t.Fatalf("expected mismatch, got %v", err)
+	}
+}
+
+func TestHashPasswordBytes(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer security.TestingSetBcryptCost(4)()
+
+	// Leave spare capacity after the password to check that it is not
+	// written to.
+	buf := bytes.Repeat([]byte("x"), 64)
+	password := buf[:0]
+	password = append(password, "hunter2"...)
+
+	hash, err := security.HashPasswordBytes(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range [][]byte{hash, []byte(legacyHash)} {
+		if err := security.CompareHashAndPasswordBytes(h, password); err != nil {
+			t.Errorf("%s: %v", h, err)
+		}
+		if err := security.CompareHashAndPassword(h, "hunter2"); err != nil {
+			t.Errorf("%s: %v", h, err)
+		}
+		if err := security.CompareHashAndPasswordBytes(
+			h, []byte("hunter3"),
+		); err != security.ErrPasswordMismatch {
+			t.Errorf("%s: expected mismatch, got %v", h, err)
+		}
+	}
+	if expected := "hunter2" + strings.Repeat("x", 57); string(buf) != expected {
+		t.Errorf("password buffer was modified: %q", buf)
+	}
+}
+
+func TestBcryptV2PreHash(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer security.TestingSetBcryptCost(4)()
+
+	hash, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if security.NeedsRehash(hash) {
+		t.Errorf("%s: unexpected rehash", hash)
+	}
+	if !security.NeedsRehash([]byte(legacyHash)) {
+		t.Errorf("%s: expected rehash", legacyHash)
+	}
+
+	// The prefix selects the pre-hash: a hash moved into the other scheme
+	// must not verify.
+	stripped := bytes.TrimPrefix(hash, []byte("crdb-bcrypt2"))
+	if err := security.CompareHashAndPassword(
+		stripped, "hunter2",
+	); err != security.ErrPasswordMismatch {
+		t.Errorf("%s: expected mismatch, got %v", stripped, err)
+	}
+	prefixed := []byte("crdb-bcrypt2" + legacyHash)
+	if err := security.CompareHashAndPassword(
+		prefixed, "hunter2",
+	); err != security.ErrPasswordMismatch {
+		t.Errorf("%s: expected mismatch, got %v", prefixed, err)
+	}
+
+	h, err := security.ParsePasswordHash(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := h.Encode(); !bytes.Equal(e, hash) {
+		t.Errorf("expected %s to round-trip, got %s", hash, e)
+	}
+	if err := h.Verify("hunter2"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBcryptLongPasswords(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer security.TestingSetBcryptCost(4)()
+
+	// bcrypt ignores its input past the 72nd byte, but the pre-hash makes
+	// every byte of the password count: passwords differing only in their
+	// 73rd or 200th byte do not verify against each other's hash.
+	for _, n := range []int{72, 200} {
+		prefix := strings.Repeat("x", n)
+		hash, err := security.HashPassword(prefix + "a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := security.CompareHashAndPassword(hash, prefix+"a"); err != nil {
+			t.Errorf("%d: %v", n, err)
+		}
+		for _, other := range []string{prefix + "b", prefix, prefix + "ab"} {
+			if err := security.CompareHashAndPassword(
+				hash, other,
+			); err != security.ErrPasswordMismatch {
+				t.Errorf("%d: expected mismatch for %d bytes, got %v", n, len(other), err)
+			}
+		}
+	}
+
+	// Legacy hashes, which did not actually hash the password, are truncated:
+	// a legacy hash of a long password verifies any password with the same
+	// first 72 bytes, which is why they are upgraded.
+	prefix := strings.Repeat("x", 72)
+	emptySHA256 := sha256.Sum256(nil)
+	// Newer versions of the bcrypt package refuse to truncate the input.
+	input := append([]byte(prefix+"a"), emptySHA256[:]...)[:72]
+	legacy, err := bcrypt.GenerateFromPassword(input, bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPassword(legacy, prefix+"b"); err != nil {
+		t.Errorf("expected the legacy hash to be truncated, got %v", err)
+	}
+	newHash, upgraded, err := security.UpgradeHashIfNeeded(legacy, prefix+"a")
+	if err != nil || !upgraded {
+		t.Fatalf("expected the legacy hash to be upgraded, got %t, %v", upgraded, err)
+	}
+	if err := security.CompareHashAndPassword(
+		newHash, prefix+"b",
+	); err != security.ErrPasswordMismatch {
+		t.Errorf("expected mismatch, got %v", err)
+	}
+}
+
+func TestMaxPasswordLength(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+	defer func() {
+		if err := security.SetMaxPasswordLength(security.DefaultMaxPasswordLength); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if err := security.SetMaxPasswordLength(0); !testutils.IsError(err, "invalid maximum") {
+		t.Errorf("expected error, got %v", err)
+	}
+
+	const max = security.DefaultMaxPasswordLength
+	atCap := strings.Repeat("x", max)
+	hash, err := security.HashPasswordWithMethod(security.HashPBKDF2, atCap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPassword(hash, atCap); err != nil {
+		t.Fatal(err)
+	}
+	md5Sum := md5.Sum([]byte(atCap + "roach"))
+	md5Hash := []byte("md5" + hex.EncodeToString(md5Sum[:]))
+
+	expectTooLong := func(what string, n int, err error) {
+		t.Helper()
+		if e, ok := err.(*security.PasswordTooLongError); !ok || e.Max != max || e.Actual != n ||
+			errors.Cause(err) != security.ErrPasswordTooLong {
+			t.Errorf("%s %d: expected a PasswordTooLongError, got %v", what, n, err)
+		}
+	}
+	for _, n := range []int{max + 1, 8 << 20} {
+		password := strings.Repeat("x", n)
+		_, err := security.HashPasswordWithMethod(security.HashPBKDF2, password)
+		expectTooLong("hash", n, err)
+		_, err = security.HashPassword(password)
+		expectTooLong("hash", n, err)
+		expectTooLong("compare", n, security.CompareHashAndPassword(hash, password))
+		expectTooLong("compare", n,
+			security.CompareHashAndPasswordCtx(context.Background(), hash, password))
+		expectTooLong("compare", n,
+			security.CompareHashAndPasswordWithUser(md5Hash, password, "roach"))
+		h, err := security.ParsePasswordHash(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectTooLong("verify", n, h.Verify(password))
+	}
+
+	// Passwords longer than a lowered limit can no longer be verified.
+	if err := security.SetMaxPasswordLength(8); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPassword(
+		hash, atCap,
+	); errors.Cause(err) != security.ErrPasswordTooLong {
+		t.Errorf("expected ErrPasswordTooLong, got %v", err)
+	}
+}
+
+func TestBcryptVariants(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	// Tools such as htpasswd and PHP's password_hash emit $2y$, and OpenBSD
+	// emits $2b$. They are wire-compatible with the $2a$ hashes produced by
+	// Go, so relabeling a hash must not change whether it verifies.
+	body := legacyHash[len("$2a$"):]
+	for _, variant := range []string{"2a", "2b", "2y"} {
+		for _, prefix := range []string{"", "crdb-bcrypt"} {
+			hash := []byte(prefix + "$" + variant + "$" + body)
+			if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+				t.Errorf("%s: %v", hash, err)
+			}
+			if err := security.CompareHashAndPassword(
+				hash, "hunter3",
+			); err != security.ErrPasswordMismatch {
+				t.Errorf("%s: expected mismatch, got %v", hash, err)
+			}
+			h, err := security.ParsePasswordHash(hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e := h.Encode(); !bytes.Equal(e, hash) {
+				t.Errorf("expected %s to round-trip, got %s", hash, e)
+			}
+		}
+	}
+
+	hash := []byte("$2x$" + body)
+	err := security.CompareHashAndPassword(hash, "hunter2")
+	if e, ok := err.(*security.UnsupportedBcryptVariantError); !ok || e.Variant != "2x" {
+		t.Errorf("expected unsupported variant error, got %v", err)
+	}
+	if errors.Cause(err) != security.ErrUnsupportedBcryptVariant {
+		t.Errorf("unexpected cause %v", errors.Cause(err))
+	}
+	if !testutils.IsError(err, "must be reset") {
+		t.Errorf("unexpected error %v", err)
+	}
+	if _, err := security.CheckPasswordHashValidity(
+		[]byte("crdb-bcrypt$2x$" + body),
+	); !testutils.IsError(err, "not supported") {
+		t.Errorf("expected unsupported variant error, got %v", err)
+	}
+
+	for _, variant := range []string{"2c", "2z", "2A"} {
+		hash := []byte("$" + variant + "$" + body)
+		if _, err := security.ParsePasswordHash(hash); !testutils.IsError(err, "invalid version") {
+			t.Errorf("%s: expected invalid version, got %v", hash, err)
+		}
+	}
+}
+
+func TestCheckPasswordHashValidity(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	const v2 = "crdb-bcrypt2$2a$10$T22RIze0P1bN2wilf.zYJOkpYLX378aJF23SZEV2Aq01epDqJxCfq"
+	legacyBody := legacyHash[len("$2a$10$"):]
+
+	for _, tc := range []struct {
+		input    string
+		isHashed bool
+		err      string
+	}{
+		{"hunter2", false, ""},
+		{"$2a$10$" + legacyBody, false, ""},
+		{strings.Repeat("x", 72), false, ""},
+		{strings.Repeat("x", 73), false, ""},
+		{"crdb-bcrypt" + legacyHash, true, ""},
+		// bcrypt itself only considers the first 72 bytes of its input, but
+		// hashes may be of any length: a crdb-bcrypt2 hash is exactly 72 bytes.
+		{v2, true, ""},
+		{v2 + "x", true, "expected 60 bytes, got 61"},
+		{v2[:len(v2)-1], true, "expected 60 bytes, got 59"},
+		{"crdb-bcrypt$2a$10$DWxIJOeQ", true, "expected 60 bytes"},
+		{"crdb-bcrypt", true, "expected 60 bytes"},
+		{"crdb-bcrypt$2a$1x$" + legacyBody, true, "invalid cost"},
+		{"crdb-bcrypt$2a$03$" + legacyBody, true, `cost 3 outside of \[4, 31\]`},
+		{"crdb-bcrypt$2a$32$" + legacyBody, true, `cost 32 outside of \[4, 31\]`},
+		// Costs below MinAcceptedBcryptCost, or more than 2 above the
+		// configured cost, are rejected.
+		{"crdb-bcrypt$2a$12$" + legacyBody, true, ""},
+		{"crdb-bcrypt$2a$04$" + legacyBody, true, `cost=4 is outside of the range \[10, 12\]`},
+		{"crdb-bcrypt$2a$13$" + legacyBody, true, `cost=13 is outside of the range \[10, 12\]`},
+		{"crdb-bcrypt$2a$31$" + legacyBody, true, `cost=31 is outside of the range \[10, 12\]`},
+		{"crdb-bcrypt$2a$10$" + strings.Replace(legacyBody, ".", "+", 1), true,
+			"invalid salt encoding"},
+		{"crdb-bcrypt$1a$10$" + legacyBody, true, "invalid version"},
+	} {
+		isHashed, err := security.CheckPasswordHashValidity([]byte(tc.input))
+		if isHashed != tc.isHashed {
+			t.Errorf("%q: expected isHashed=%t, got %t", tc.input, tc.isHashed, isHashed)
+		}
+		if !testutils.IsError(err, tc.err) {
+			t.Errorf("%q: expected error %q, got %v", tc.input, tc.err, err)
+		}
+		loaded, err := security.LoadPasswordHash([]byte(tc.input))
+		if tc.isHashed && tc.err == "" {
+			if err != nil {
+				t.Errorf("%q: %v", tc.input, err)
+			} else if string(loaded) != tc.input {
+				t.Errorf("%q: expected hash to be stored verbatim, got %q", tc.input, loaded)
+			}
+		} else if err == nil {
+			t.Errorf("%q: expected error", tc.input)
+		}
+	}
+
+	if err := security.CompareHashAndPassword(
+		[]byte("crdb-bcrypt"+legacyHash), "hunter2",
+	); err != nil {
+		t.Error(err)
+	}
+	if err := security.CompareHashAndPassword([]byte(v2), "hunter2"); err != nil {
+		t.Error(err)
+	}
+
+	// The bounds follow the configured costs.
+	_, err := security.CheckPasswordHashValidity([]byte("crdb-bcrypt$2a$04$" + legacyBody))
+	if errors.Cause(err) != security.ErrHashCostOutOfRange {
+		t.Errorf("expected ErrHashCostOutOfRange, got %v", err)
+	}
+	defer security.TestingSetBcryptCost(14)()
+	if _, err := security.CheckPasswordHashValidity(
+		[]byte("crdb-bcrypt$2a$16$" + legacyBody),
+	); err != nil {
+		t.Error(err)
+	}
+	if err := security.SetMinVerifyCost(12); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = security.SetMinVerifyCost(0) }()
+	if _, err := security.CheckPasswordHashValidity(
+		[]byte("crdb-bcrypt" + legacyHash),
+	); !testutils.IsError(err, `cost=10 is outside of the range \[12, 16\]`) {
+		t.Errorf("expected the cost to be below the minimum, got %v", err)
+	}
+}
+
+func TestCompareHashAndPasswordWithUser(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	// md5("hunter2" || "alice"), as stored by PostgreSQL.
+	const md5Hash = "md5f1d6e2da5767fddc60c941cf0fa924cf"
+
+	for _, tc := range []struct {
+		hash     string
+		password string
+		username string
+		err      error
+	}{
+		{md5Hash, "hunter2", "alice", nil},
+		{md5Hash, "hunter3", "alice", security.ErrPasswordMismatch},
+		{md5Hash, "hunter2", "bob", security.ErrPasswordMismatch},
+		{md5Hash, "hunter2a", "lice", nil},
+		{legacyHash, "hunter2", "alice", nil},
+		{legacyHash, "hunter3", "alice", security.ErrPasswordMismatch},
+	} {
+		if err := security.CompareHashAndPasswordWithUser(
+			[]byte(tc.hash), tc.password, tc.username,
+		); err != tc.err {
+			t.Errorf("%s (%s, %s): expected %v, got %v",
+				tc.hash, tc.password, tc.username, tc.err, err)
+		}
+	}
+
+	if err := security.CompareHashAndPassword([]byte(md5Hash), "hunter2"); !testutils.IsError(
+		err, "cannot be verified without the user name",
+	) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestPasswordLoginDisabled(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, hash := range [][]byte{nil, {}, security.MissingPasswordHash()} {
+		if !security.IsPasswordLoginDisabled(hash) {
+			t.Errorf("%q: expected password login to be disabled", hash)
+		}
+		for _, password := range []string{"", "crdb-nologin", "hunter2"} {
+			if err := security.CompareHashAndPassword(
+				hash, password,
+			); err != security.ErrPasswordLoginDisabled {
+				t.Errorf("%q, %q: expected ErrPasswordLoginDisabled, got %v", hash, password, err)
+			}
+		}
+	}
+
+	hash, err := security.HashPassword("crdb-nologin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if security.IsPasswordLoginDisabled(hash) {
+		t.Errorf("%q: expected password login to be enabled", hash)
+	}
+
+	prev := security.SetDefaultHasher(fakeHasher{prefix: "crdb-"})
+	defer security.SetDefaultHasher(prev)
+	if _, err := security.HashPassword(
+		"nologin",
+	); !testutils.IsError(err, "disables password login") {
+		t.Errorf("expected error, got %v", err)
+	}
+}
+
+func TestMissingUserHashedPassword(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	defer security.TestingSetBcryptCost(6)()
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 20000
+
+	hash := security.MissingUserHashedPassword()
+	if h := security.MissingUserHashedPassword(); !bytes.Equal(h, hash) {
+		t.Errorf("expected the hash to be reused, got %q and %q", hash, h)
+	}
+	if err := security.CompareHashAndPassword(hash, "hunter2"); err != security.ErrPasswordMismatch {
+		t.Errorf("expected ErrPasswordMismatch, got %v", err)
+	}
+
+	// The time taken to reject a login of a user that does not exist, and of
+	// an existing user with the wrong password, are about the same.
+	known, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const samples = 15
+	var knownTimes, missingTimes []time.Duration
+	for i := 0; i < samples; i++ {
+		for _, tc := range []struct {
+			hash  []byte
+			times *[]time.Duration
+		}{{known, &knownTimes}, {hash, &missingTimes}} {
+			start := timeutil.Now()
+			if err := security.CompareHashAndPassword(
+				tc.hash, "hunter3",
+			); err != security.ErrPasswordMismatch {
+				t.Fatalf("expected ErrPasswordMismatch, got %v", err)
+			}
+			*tc.times = append(*tc.times, timeutil.Since(start))
+		}
+	}
+	median := func(times []time.Duration) time.Duration {
+		sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+		return times[len(times)/2]
+	}
+	k, m := median(knownTimes), median(missingTimes)
+	if ratio := float64(m) / float64(k); ratio < 0.67 || ratio > 1.5 {
+		t.Errorf("expected similar verification times, got a median of %s for an existing user "+
+			"and %s for a missing one", k, m)
+	}
+
+	// The hash follows changes of the cost.
+	defer security.TestingSetBcryptCost(7)()
+	security.PBKDF2Iterations = 40000
+	if h := security.MissingUserHashedPassword(); bytes.Equal(h, hash) {
+		t.Errorf("expected a new hash after changing the cost, got %q", h)
+	} else if !security.FIPSMode() && !bytes.Contains(h, []byte("$07$")) {
+		t.Errorf("expected a hash with cost 7, got %q", h)
+	}
+
+	// The hash follows changes of the parameters of the other methods.
+	if !security.FIPSMode() {
+		defer func(m uint32) { security.Argon2Memory = m }(security.Argon2Memory)
+		security.Argon2Memory = 1024
+		defer func(m security.HashMethod) {
+			if err := security.SetDefaultHashMethod(m); err != nil {
+				t.Error(err)
+			}
+		}(security.GetDefaultHashMethod())
+		if err := security.SetDefaultHashMethod(security.HashArgon2id); err != nil {
+			t.Fatal(err)
+		}
+		if h := security.MissingUserHashedPassword(); !bytes.Contains(h, []byte("m=1024,")) {
+			t.Errorf("expected a hash with 1024 KiB of memory, got %q", h)
+		}
+		security.Argon2Memory = 2048
+		if h := security.MissingUserHashedPassword(); !bytes.Contains(h, []byte("m=2048,")) {
+			t.Errorf("expected a new hash after changing Argon2Memory, got %q", h)
+		}
+	}
+
+	// The random password is a valid input for password normalization.
+	security.SetPasswordNormalization(true)
+	defer security.SetPasswordNormalization(false)
+	h := security.MissingUserHashedPassword()
+	if security.IsPasswordLoginDisabled(h) {
+		t.Errorf("expected the hash of a random password with normalization, got %q", h)
+	}
+	if err := security.CompareHashAndPassword(h, "hunter2"); err != security.ErrPasswordMismatch {
+		t.Errorf("expected ErrPasswordMismatch with normalization, got %v", err)
+	}
+}
+
+func TestCompareHashAndPasswordErrorTiming(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	if util.RaceEnabled {
+		t.Skip("timings are unreliable under race")
+	}
+
+	defer security.TestingSetBcryptCost(6)()
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 20000
+
+	// Rejecting an empty, malformed or unsupported hash takes about as long
+	// as rejecting the wrong password for a valid hash.
+	known, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hashes := [][]byte{known, nil, []byte("$2a$99$malformed"), []byte("{CRYPT}ab1Hv2Lg7ltQo")}
+	const samples = 15
+	times := make([][]time.Duration, len(hashes))
+	for i := 0; i < samples; i++ {
+		for j, hash := range hashes {
+			start := timeutil.Now()
+			err := security.CompareHashAndPassword(hash, "hunter3")
+			times[j] = append(times[j], timeutil.Since(start))
+			if (err == security.ErrPasswordMismatch) != (j == 0) || err == nil {
+				t.Fatalf("%q: unexpected error %v", hash, err)
+			}
+		}
+	}
+	median := func(times []time.Duration) time.Duration {
+		sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+		return times[len(times)/2]
+	}
+	mismatch := median(times[0])
+	for j, hash := range hashes[1:] {
+		d := median(times[j+1])
+		if ratio := float64(d) / float64(mismatch); ratio < 0.5 || ratio > 2 {
+			t.Errorf("%q: expected a time similar to a mismatch, got a median of %s, and %s for "+
+				"a mismatch", hash, d, mismatch)
+		}
+	}
+}
+
+func TestCompareAnyHashAndPassword(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	const (
+		pbkdf2    = "$pbkdf2-sha256$i=4096$c2FsdA$xeR41ZKIyEGqUw22hFxMjZYok6ABzk4RpJY4c6qYE0o"
+		malformed = "$2a$10$DWxIJOeQ"
+	)
+	for _, tc := range []struct {
+		hashes   []string
+		password string
+		index    int
+		err      string
+	}{
+		{[]string{legacyHash, pencilVerifier}, "hunter2", 0, ""},
+		{[]string{legacyHash, pencilVerifier}, "pencil", 1, ""},
+		{[]string{"", malformed, pencilVerifier}, "pencil", 2, ""},
+		{[]string{pbkdf2, pencilVerifier, legacyHash}, "password", 0, ""},
+		{[]string{legacyHash, pencilVerifier}, "hunter3", -1, "is not the hash"},
+		{[]string{malformed, legacyHash}, "hunter3", -1, "is not the hash"},
+		{[]string{"", malformed}, "hunter2", -1, "malformed bcrypt hash"},
+		{[]string{"", ""}, "hunter2", -1, "no password hashes"},
+		{nil, "hunter2", -1, "no password hashes"},
+	} {
+		hashes := make([][]byte, len(tc.hashes))
+		for i, h := range tc.hashes {
+			hashes[i] = []byte(h)
+		}
+		index, err := security.CompareAnyHashAndPassword(hashes, tc.password)
+		if index != tc.index {
+			t.Errorf("%v: expected index %d, got %d", tc.hashes, tc.index, index)
+		}
+		if !testutils.IsError(err, tc.err) {
+			t.Errorf("%v: expected error %q, got %v", tc.hashes, tc.err, err)
+		}
+	}
+}
+
+func TestNeedsRehash(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer security.TestingSetBcryptCost(4)()
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 4096
+
+	const (
+		v2Cost4  = "crdb-bcrypt2$2a$04$7p.1MyeAc0YThjAv9pfrw.XpRKneZ/6Tx3hO4u1l3.Ko6HlVrlqgi"
+		pbkdf2   = "$pbkdf2-sha256$i=4096$c2FsdA$xeR41ZKIyEGqUw22hFxMjZYok6ABzk4RpJY4c6qYE0o"
+		v2Cost10 = "crdb-bcrypt2" + legacyHash
+	)
+	for _, tc := range []struct {
+		hash     string
+		expected bool
+	}{
+		{v2Cost4, false},
+		// Legacy pre-hash.
+		{legacyHash, true},
+		{"crdb-bcrypt" + legacyHash, true},
+		// Cost differs from the configured one, in either direction.
+		{v2Cost10, true},
+		{"crdb-bcrypt2$2a$03$7p.1MyeAc0YThjAv9pfrw.XpRKneZ/6Tx3hO4u1l3.Ko6HlVrlqgi", true},
+		// Method differs from the default.
+		{pbkdf2, true},
+		{"md5f1d6e2da5767fddc60c941cf0fa924cf", true},
+		{"{SSHA}gK+fFFujqnweTpwCQ7Sp02gQxCJzYWx0", true},
+		{"{SSHA512}s8D2eF/LzYbyRbbfwmqL/Cl1E8amhob0364if6EazgvLZPsRj1IbQPngE1bLg/Gls+0ogKaGhLW" +
+			"k0iZikQdXIHNhbHQ=", true},
+		{"sha1$seasalt$cff36ea83f5706ce9aa7454e63e431fc726b2dc8", true},
+		// Unknown or undecodable.
+		{"{CRYPT}$1$saltsalt$hashhashhashhashhash", true},
+		{"hunter2", true},
+		{"", true},
+	} {
+		if r := security.NeedsRehash([]byte(tc.hash)); r != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tc.hash, tc.expected, r)
+		}
+	}
+
+	// Once PBKDF2 becomes the default, only hashes with the configured
+	// iteration count match the policy.
+	if err := security.SetDefaultHashMethod(security.HashPBKDF2); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetDefaultHashMethod(security.HashBCrypt); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if security.NeedsRehash([]byte(pbkdf2)) {
+		t.Errorf("%s: unexpected rehash", pbkdf2)
+	}
+	if !security.NeedsRehash([]byte(v2Cost4)) {
+		t.Errorf("%s: expected rehash", v2Cost4)
+	}
+	security.PBKDF2Iterations = 10000
+	if !security.NeedsRehash([]byte(pbkdf2)) {
+		t.Errorf("%s: expected rehash", pbkdf2)
+	}
+}
+
+func TestCostProvider(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer security.TestingSetBcryptCost(4)()
+	defer security.SetCostProvider(nil)
+
+	cost := int32(security.MinAcceptedBcryptCost + 1)
+	security.SetCostProvider(func() int { return int(atomic.LoadInt32(&cost)) })
+	if c := security.GetBcryptCost(); c != security.MinAcceptedBcryptCost+1 {
+		t.Errorf("expected the provider to take precedence, got cost %d", c)
+	}
+
+	// The costs of the provider are clamped to the range accepted by
+	// SetBcryptCost.
+	for _, tc := range []struct {
+		cost, expected int
+	}{
+		{4, security.MinAcceptedBcryptCost},
+		{security.MinAcceptedBcryptCost - 1, security.MinAcceptedBcryptCost},
+		{32, 31},
+		{1000, 31},
+	} {
+		atomic.StoreInt32(&cost, int32(tc.cost))
+		if c := security.GetBcryptCost(); c != tc.expected {
+			t.Errorf("provider cost %d: expected cost %d, got %d", tc.cost, tc.expected, c)
+		}
+	}
+	atomic.StoreInt32(&cost, 4)
+	if hash, err := security.HashPassword("hunter2"); err != nil {
+		t.Error(err)
+	} else if h, err := security.ParsePasswordHash(hash); err != nil {
+		t.Error(err)
+	} else if h.Cost() != security.MinAcceptedBcryptCost {
+		t.Errorf("expected a hash with cost %d, got %s", security.MinAcceptedBcryptCost, hash)
+	}
+	security.SetCostProvider(nil)
+	if c := security.GetBcryptCost(); c != 4 {
+		t.Errorf("expected cost 4 without a provider, got %d", c)
+	}
+
+	// Changing the cost while hashing concurrently is safe; run with -race.
+	// The costs of the provider are clamped to MinAcceptedBcryptCost.
+	floor := []byte(fmt.Sprintf("$%02d$", security.MinAcceptedBcryptCost))
+	security.SetCostProvider(func() int { return int(atomic.LoadInt32(&cost)) })
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			atomic.StoreInt32(&cost, int32(4+i%2))
+			if i%10 == 0 {
+				security.SetCostProvider(nil)
+				security.TestingSetBcryptCost(4 + i%2)
+				security.SetCostProvider(func() int { return int(atomic.LoadInt32(&cost)) })
+			}
+		}
+	}()
+	errCh := make(chan error, 4)
+	for i := 0; i < cap(errCh); i++ {
+		go func() {
+			for j := 0; j < 3; j++ {
+				hash, err := security.HashPassword("hunter2")
+				if err == nil {
+					err = security.CompareHashAndPassword(hash, "hunter2")
+				}
+				if err == nil && !bytes.Contains(hash, []byte("$04$")) &&
+					!bytes.Contains(hash, []byte("$05$")) &&
+					!bytes.Contains(hash, floor) {
+					err = errors.Errorf("unexpected cost in %s", hash)
+				}
+				if err != nil {
+					errCh <- err
+					return
+				}
+			}
+			errCh <- nil
+		}()
+	}
+	for i := 0; i < cap(errCh); i++ {
+		if err := <-errCh; err != nil {
+			t.Error(err)
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
+
+func TestBcryptCostFloor(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	defer security.TestingSetBcryptCost(12)()
+	for _, tc := range []struct {
+		cost int
+		ok   bool
+	}{
+		{4, false},
+		{security.MinAcceptedBcryptCost - 1, false},
+		{security.MinAcceptedBcryptCost, true},
+		{31, true},
+		{32, false},
+	} {
+		if err := security.SetBcryptCost(12); err != nil {
+			t.Fatal(err)
+		}
+		err := security.SetBcryptCost(tc.cost)
+		if tc.ok != (err == nil) {
+			t.Errorf("%d: expected success %t, got %v", tc.cost, tc.ok, err)
+		}
+		expected := 12
+		if tc.ok {
+			expected = tc.cost
+		}
+		if c := security.GetBcryptCost(); c != expected {
+			t.Errorf("%d: expected cost %d, got %d", tc.cost, expected, c)
+		}
+	}
+
+	for _, cost := range []int{-1, 32} {
+		if err := security.SetMinVerifyCost(cost); !testutils.IsError(err, "invalid") {
+			t.Errorf("%d: expected error, got %v", cost, err)
+		}
+	}
+
+	skipUnderFIPS(t)
+
+	// Tests may still use cheap hashes, which verify under a minimum
+	// verification cost, but need a rehash even though they match the
+	// configured cost.
+	defer security.TestingSetBcryptCost(4)()
+	hash, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if security.NeedsRehash(hash) {
+		t.Errorf("%s: unexpected rehash", hash)
+	}
+
+	var registry fakeRegistry
+	security.SetMetricsRegistry(&registry, time.Minute)
+	var weak *metric.Counter
+	for _, s := range registry {
+		if pm, ok := s.(*security.PasswordMetrics); ok {
+			weak = pm.VerifyWeakHash
+		}
+	}
+
+	if err := security.SetMinVerifyCost(5); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetMinVerifyCost(0); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	count := weak.Count()
+	if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+		t.Error(err)
+	}
+	if err := security.CompareHashAndPassword(
+		hash, "hunter3",
+	); err != security.ErrPasswordMismatch {
+		t.Errorf("expected ErrPasswordMismatch, got %v", err)
+	}
+	if c := weak.Count(); c != count+1 {
+		t.Errorf("expected 1 verification against a weak hash, got %d", c-count)
+	}
+	if !security.NeedsRehash(hash) {
+		t.Errorf("%s: expected rehash", hash)
+	}
+
+	// Hashes at the minimum cost are not weak.
+	if err := security.SetMinVerifyCost(4); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+		t.Error(err)
+	}
+	if c := weak.Count(); c != count+1 {
+		t.Errorf("expected 1 verification against a weak hash, got %d", c-count)
+	}
+	if security.NeedsRehash(hash) {
+		t.Errorf("%s: unexpected rehash", hash)
+	}
+}
+
+// Example_rehashOnLogin shows how to upgrade stored hashes to the current
+// policy when users log in.
+func Example_rehashOnLogin() {
+	defer security.TestingSetBcryptCost(4)()
+
+	// A PBKDF2 hash of "hunter2" with fewer iterations than the policy, so
+	// that this example also runs in FIPS mode.
+	stored := []byte("$pbkdf2-sha256$i=4096$c2FsdA$g2tfxtuFNB9nROQSUxQQGp0HAqV9Abs1Gcpe+y2spXA")
+	login := func(password string) {
+		if err := security.CompareHashAndPassword(stored, password); err != nil {
+			fmt.Println("login failed:", err)
+			return
+		}
+		fmt.Println("login succeeded")
+		if security.NeedsRehash(stored) {
+			newHash, err := security.HashPassword(password)
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+			// A real caller would persist newHash in system.users.
+			stored = newHash
+			fmt.Println("hash upgraded")
+		}
+	}
+	login("hunter2")
+	login("hunter2")
+	login("hunter3")
+
+	// Output:
+	// login succeeded
+	// hash upgraded
+	// login succeeded
+	// login failed: crypto/bcrypt: hashedPassword is not the hash of the given password
+}
+
+func TestUpgradeHashIfNeeded(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer security.TestingSetBcryptCost(4)()
+	lowCost, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer security.TestingSetBcryptCost(5)()
+	current, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name     string
+		hash     []byte
+		username string
+		upgraded bool
+	}{
+		{"legacy scheme", []byte(legacyHash), "", true},
+		{"low cost", lowCost, "", true},
+		{"md5 import", []byte("md5f1d6e2da5767fddc60c941cf0fa924cf"), "alice", true},
+		{"current", current, "", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			upgrade := func(password string) ([]byte, bool, error) {
+				return security.UpgradeHashIfNeededWithUser(tc.hash, password, tc.username)
+			}
+			if tc.username == "" {
+				upgrade = func(password string) ([]byte, bool, error) {
+					return security.UpgradeHashIfNeeded(tc.hash, password)
+				}
+			}
+
+			newHash, upgraded, err := upgrade("hunter3")
+			if err != security.ErrPasswordMismatch || upgraded || newHash != nil {
+				t.Fatalf("expected mismatch without upgrade, got %q, %t, %v", newHash, upgraded, err)
+			}
+
+			newHash, upgraded, err = upgrade("hunter2")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if upgraded != tc.upgraded || (newHash != nil) != tc.upgraded {
+				t.Fatalf("expected upgraded=%t, got %q, %t", tc.upgraded, newHash, upgraded)
+			}
+			if !upgraded {
+				return
+			}
+			if !bytes.HasPrefix(newHash, []byte("crdb-bcrypt2$2a$05$")) {
+				t.Errorf("unexpected hash %s", newHash)
+			}
+			if err := security.CompareHashAndPassword(newHash, "hunter2"); err != nil {
+				t.Error(err)
+			}
+			_, upgraded, err = security.UpgradeHashIfNeeded(newHash, "hunter2")
+			if err != nil || upgraded {
+				t.Errorf("expected upgraded hash to be current, got %t, %v", upgraded, err)
+			}
+		})
+	}
+}
+
+func TestCompareArgon2idMalformed(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, h := range []string{
+		"$argon2id$",
+		"$argon2id$v=19$m=64,t=1,p=4$c2FsdHNhbHQ",
+		"$argon2id$v=16$m=64,t=1,p=4$c2FsdHNhbHQ$c2FsdHNhbHQ",
+		"$argon2id$v=19$m=64,t=0,p=4$c2FsdHNhbHQ$c2FsdHNhbHQ",
+		"$argon2id$v=19$m=64,t=1,p=4$!!$c2FsdHNhbHQ",
+		"$argon2id$v=19$m=64,t=1,p=4$c2FsdHNhbHQ$",
+	} {
+		if err := security.CompareHashAndPassword([]byte(h), "hunter2"); err == nil ||
+			err == security.ErrPasswordMismatch {
+			t.Errorf("%q: expected malformed hash error, got %v", h, err)
+		}
+	}
+}
+
+func TestArgon2idParamsCap(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	// Stored parameters exceeding the caps are rejected as malformed when
+	// the hash is parsed, before running Argon2id.
+	for _, h := range []string{
+		"$argon2id$v=19$m=4294967295,t=4294967295,p=4$c2FsdHNhbHQ$c2FsdHNhbHQ",
+		"$argon2id$v=19$m=2097152,t=1,p=4$c2FsdHNhbHQ$c2FsdHNhbHQ",
+		"$argon2id$v=19$m=64,t=17,p=4$c2FsdHNhbHQ$c2FsdHNhbHQ",
+		"$argon2id$v=19$m=64,t=1,p=255$c2FsdHNhbHQ$c2FsdHNhbHQ",
+	} {
+		if _, err := security.ParsePasswordHash([]byte(h)); !testutils.IsError(
+			err, "exceed the maximum",
+		) {
+			t.Errorf("%q: expected the parameters to exceed the maximum, got %v", h, err)
+		}
+		if err := security.CompareHashAndPassword(
+			[]byte(h), "hunter2",
+		); errors.Cause(err) != security.ErrMalformedHash {
+			t.Errorf("%q: expected ErrMalformedHash, got %v", h, err)
+		}
+	}
+
+	defer func(m uint32) { security.Argon2MaxMemory = m }(security.Argon2MaxMemory)
+	security.Argon2MaxMemory = security.Argon2Memory - 1
+	if _, err := security.HashPasswordWithMethod(
+		security.HashArgon2id, "hunter2",
+	); !testutils.IsError(err, "exceed the maximum") {
+		t.Errorf("expected the parameters to exceed the maximum, got %v", err)
+	}
+}
+
+func TestScryptParams(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer func(n int) { security.ScryptN = n }(security.ScryptN)
+	security.ScryptN = 16
+
+	hash, err := security.HashPasswordWithMethod(security.HashScrypt, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Stored parameters exceeding the memory cap must be rejected before
+	// running scrypt.
+	defer func(m int) { security.ScryptMaxMemory = m }(security.ScryptMaxMemory)
+	security.ScryptMaxMemory = 128 * 8 * 16
+	if err := security.CompareHashAndPassword(hash, "hunter2"); !testutils.IsError(err, "require") {
+		t.Fatalf("expected memory cap error, got %v", err)
+	}
+	if _, err := security.HashPasswordWithMethod(
+		security.HashScrypt, "hunter2",
+	); !testutils.IsError(err, "require") {
+		t.Fatalf("expected memory cap error, got %v", err)
+	}
+
+	for _, n := range []int{0, 1, 15} {
+		security.ScryptN = n
+		if _, err := security.HashPasswordWithMethod(
+			security.HashScrypt, "hunter2",
+		); !testutils.IsError(err, "power of 2") {
+			t.Errorf("N=%d: expected power of 2 error, got %v", n, err)
+		}
+	}
+
+	for _, h := range []string{
+		"$scrypt$",
+		"$scrypt$ln=4,r=8$c2FsdHNhbHQ$c2FsdHNhbHQ",
+		"$scrypt$ln=99,r=8,p=1$c2FsdHNhbHQ$c2FsdHNhbHQ",
+		"$scrypt$ln=4,r=8,p=1$!!$c2FsdHNhbHQ",
+	} {
+		if err := security.CompareHashAndPassword([]byte(h), "hunter2"); err == nil ||
+			err == security.ErrPasswordMismatch {
+			t.Errorf("%q: expected malformed hash error, got %v", h, err)
+		}
+	}
+}
+
+func TestPBKDF2KnownAnswers(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// PBKDF2-HMAC-SHA256 counterparts of the RFC 6070 test vectors.
+	for _, tc := range []struct {
+		password string
+		hash     string
+	}{
+		{"password", "$pbkdf2-sha256$i=1$c2FsdA$Eg+2z/z4syxD5yJSVsT4N6hlSMkszDVICAWYfLcL4Xs"},
+		{"password", "$pbkdf2-sha256$i=2$c2FsdA$rk0Mla9rRtMtCt/5KPBt0CowP47zwlHf1uLYWpVHTEM"},
+		{"password", "$pbkdf2-sha256$i=4096$c2FsdA$xeR41ZKIyEGqUw22hFxMjZYok6ABzk4RpJY4c6qYE0o"},
+		{"passwordPASSWORDpassword", "$pbkdf2-sha256$i=4096$" +
+			"c2FsdFNBTFRzYWx0U0FMVHNhbHRTQUxUc2FsdFNBTFRzYWx0$" +
+			"NIyJ28vTKy8y2BS4EW6EzysXNH68GAAYHE4qH7jdU+HGNVGMfaxH6Q"},
+	} {
+		if err := security.CompareHashAndPassword([]byte(tc.hash), tc.password); err != nil {
+			t.Errorf("%s: %v", tc.hash, err)
+		}
+		if err := security.CompareHashAndPassword(
+			[]byte(tc.hash), tc.password+"x",
+		); err != security.ErrPasswordMismatch {
+			t.Errorf("%s: expected mismatch, got %v", tc.hash, err)
+		}
+	}
+}
+
+func TestSetDefaultHashMethod(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+
+	if err := security.SetDefaultHashMethod(security.HashPBKDF2); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetDefaultHashMethod(security.HashBCrypt); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	hash, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(hash, []byte("$pbkdf2-sha256$")) {
+		t.Fatalf("expected a PBKDF2 hash, got %q", hash)
+	}
+	if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+	// Existing bcrypt hashes keep verifying.
+	if err := security.CompareHashAndPassword([]byte(legacyHash), "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if m := security.GetDefaultHashMethod(); m != security.HashPBKDF2 {
+		t.Fatalf("expected default %s, got %s", security.HashPBKDF2, m)
+	}
+	for _, m := range []security.HashMethod{
+		security.HashMethodUnknown, security.HashPGMD5, security.HashSSHA, security.HashMethod(100),
+	} {
+		if err := security.SetDefaultHashMethod(m); err == nil {
+			t.Errorf("%s: expected error", m)
+		}
+	}
+	if m := security.GetDefaultHashMethod(); m != security.HashPBKDF2 {
+		t.Fatalf("expected default %s to be unchanged, got %s", security.HashPBKDF2, m)
+	}
+}
+
+func TestParseHashMethod(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, m := range []security.HashMethod{
+		security.HashBCrypt, security.HashArgon2id, security.HashScrypt, security.HashPBKDF2,
+		security.HashSCRAMSHA256, security.HashPGMD5, security.HashSSHA, security.HashSSHA512,
+		security.HashDjangoSHA1, security.HashDjangoMD5, security.HashPasslibBcryptSHA256,
+	} {
+		parsed, err := security.ParseHashMethod(m.String())
+		if err != nil || parsed != m {
+			t.Errorf("%s: expected to round-trip, got %s (%v)", m, parsed, err)
+		}
+	}
+	if m, err := security.ParseHashMethod("SCRAM-SHA-256"); err != nil ||
+		m != security.HashSCRAMSHA256 {
+		t.Errorf("expected %s, got %s (%v)", security.HashSCRAMSHA256, m, err)
+	}
+	for _, name := range []string{"", "unknown", "sha1", "HashMethod(100)"} {
+		if _, err := security.ParseHashMethod(name); !testutils.IsError(err, "unknown hash method") {
+			t.Errorf("%q: expected error, got %v", name, err)
+		}
+	}
+	if s := security.HashMethod(100).String(); s != "HashMethod(100)" {
+		t.Errorf("unexpected name %q", s)
+	}
+}
+
+// blockingHasher is a Hasher whose operations block until unblock is closed.
+// Its Compare always succeeds.
+type blockingHasher struct {
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (b blockingHasher) Hash(password []byte) ([]byte, error) {
+	b.started <- struct{}{}
+	<-b.unblock
+	return []byte("blocking$"), nil
+}
+
+func (b blockingHasher) Compare(hashedPassword, password []byte) error {
+	b.started <- struct{}{}
+	<-b.unblock
+	return nil
+}
+
+func TestPasswordCtx(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := security.HashPasswordCtx(
+		canceled, "hunter2",
+	); errors.Cause(err) != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if err := security.CompareHashAndPasswordCtx(
+		canceled, []byte(legacyHash), "hunter2",
+	); errors.Cause(err) != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	ctx := context.Background()
+	hash, err := security.HashPasswordCtx(ctx, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPasswordCtx(ctx, hash, "hunter2"); err != nil {
+		t.Error(err)
+	}
+	if err := security.CompareHashAndPasswordCtx(
+		ctx, hash, "hunter3",
+	); err != security.ErrPasswordMismatch {
+		t.Errorf("expected mismatch, got %v", err)
+	}
+
+	b := blockingHasher{started: make(chan struct{}), unblock: make(chan struct{})}
+	prev := security.SetDefaultHasher(b)
+	defer security.SetDefaultHasher(prev)
+	defer close(b.unblock)
+
+	for _, tc := range []struct {
+		name string
+		fn   func(context.Context) error
+	}{
+		{"hash", func(ctx context.Context) error {
+			_, err := security.HashPasswordCtx(ctx, "hunter2")
+			return err
+		}},
+		{"compare", func(ctx context.Context) error {
+			return security.CompareHashAndPasswordCtx(ctx, []byte("blocking$"), "hunter2")
+		}},
+	} {
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() { errCh <- tc.fn(ctx) }()
+		<-b.started
+		cancel()
+		if err := <-errCh; errors.Cause(err) != context.Canceled {
+			t.Errorf("%s: expected context.Canceled, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestHashPasswords(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	defer security.TestingSetBcryptCost(4)()
+
+	passwords := []string{"hunter2", "", "hunter3", "hunter4", ""}
+	for _, parallelism := range []int{0, 1, 2, 10} {
+		hashes, err := security.HashPasswords(context.Background(), passwords, parallelism)
+		batchErr, ok := err.(*security.HashPasswordsError)
+		if !ok {
+			t.Fatalf("%d: expected a HashPasswordsError, got %v", parallelism, err)
+		}
+		if indexes := batchErr.Indexes(); fmt.Sprint(indexes) != "[1 4]" {
+			t.Errorf("%d: expected failures at indexes [1 4], got %v", parallelism, indexes)
+		}
+		if len(hashes) != len(passwords) {
+			t.Fatalf("%d: expected %d hashes, got %d", parallelism, len(passwords), len(hashes))
+		}
+		for i, password := range passwords {
+			if password == "" {
+				if hashes[i] != nil || batchErr.Errors[i] != security.ErrEmptyPassword {
+					t.Errorf("%d: %d: expected ErrEmptyPassword, got %q, %v",
+						parallelism, i, hashes[i], batchErr.Errors[i])
+				}
+				continue
+			}
+			if err := security.CompareHashAndPassword(hashes[i], password); err != nil {
+				t.Errorf("%d: %d: %v", parallelism, i, err)
+			}
+		}
+	}
+
+	if hashes, err := security.HashPasswords(context.Background(), nil, 0); err != nil ||
+		len(hashes) != 0 {
+		t.Errorf("expected no hashes, got %q, %v", hashes, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := security.HashPasswords(ctx, passwords[:1], 1)
+	if batchErr, ok := err.(*security.HashPasswordsError); !ok ||
+		errors.Cause(batchErr.Errors[0]) != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func BenchmarkHashPasswords(b *testing.B) {
+	defer security.TestingSetBcryptCost(8)()
+
+	passwords := make([]string, 16)
+	for i := range passwords {
+		passwords[i] = fmt.Sprintf("password%d", i)
+	}
+	for parallelism := 1; parallelism <= runtime.GOMAXPROCS(0); parallelism *= 2 {
+		b.Run(fmt.Sprintf("parallelism=%d", parallelism), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := security.HashPasswords(
+					context.Background(), passwords, parallelism,
+				); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestCompareHashesAndPasswords(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+
+	hash, err := security.HashPasswordWithMethod(security.HashPBKDF2, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pairs := []security.HashPasswordPair{
+		{hash, "hunter2"},
+		{hash, "hunter3"},
+		{[]byte("$pbkdf2-sha256$"), "hunter2"},
+		{security.MissingPasswordHash(), "hunter2"},
+		{hash, "hunter2"},
+	}
+	for _, parallelism := range []int{0, 1, 2, 10} {
+		errs := security.CompareHashesAndPasswords(context.Background(), pairs, parallelism)
+		if len(errs) != len(pairs) {
+			t.Fatalf("%d: expected %d errors, got %d", parallelism, len(pairs), len(errs))
+		}
+		if errs[0] != nil || errs[4] != nil {
+			t.Errorf("%d: expected matches, got %v and %v", parallelism, errs[0], errs[4])
+		}
+		if errs[1] != security.ErrPasswordMismatch {
+			t.Errorf("%d: expected ErrPasswordMismatch, got %v", parallelism, errs[1])
+		}
+		if errors.Cause(errs[2]) != security.ErrMalformedHash {
+			t.Errorf("%d: expected ErrMalformedHash, got %v", parallelism, errs[2])
+		}
+		if errs[3] != security.ErrPasswordLoginDisabled {
+			t.Errorf("%d: expected ErrPasswordLoginDisabled, got %v", parallelism, errs[3])
+		}
+	}
+
+	if errs := security.CompareHashesAndPasswords(context.Background(), nil, 0); errs == nil ||
+		len(errs) != 0 {
+		t.Errorf("expected an empty result, got %#v", errs)
+	}
+	if errs := security.CompareHashesAndPasswords(
+		context.Background(), pairs[:1], -1,
+	); !testutils.IsError(errs[0], "invalid parallelism") {
+		t.Errorf("expected error, got %v", errs[0])
+	}
+
+	// Canceling the context stops the batch once the verification in
+	// progress completes. A blockingHasher blocks until another verification
+	// is started, so the batch would hang if one was.
+	b := blockingHasher{started: make(chan struct{}), unblock: make(chan struct{})}
+	prev := security.SetDefaultHasher(b)
+	defer security.SetDefaultHasher(prev)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	blocking := make([]security.HashPasswordPair, 10)
+	for i := range blocking {
+		blocking[i] = security.HashPasswordPair{HashedPassword: []byte(pbkdf2Hash), Password: "x"}
+	}
+	errCh := make(chan []error, 1)
+	go func() { errCh <- security.CompareHashesAndPasswords(ctx, blocking, 1) }()
+	<-b.started
+	cancel()
+	b.unblock <- struct{}{}
+	errs := <-errCh
+	if errs[0] != nil {
+		t.Errorf("expected the verification in progress to complete, got %v", errs[0])
+	}
+	for i, err := range errs[1:] {
+		if errors.Cause(err) != context.Canceled {
+			t.Errorf("%d: expected context.Canceled, got %v", i+1, err)
+		}
+	}
+}
+
+func BenchmarkCompareHashesAndPasswords(b *testing.B) {
+	defer security.TestingSetBcryptCost(8)()
+
+	pairs := make([]security.HashPasswordPair, 16)
+	for i := range pairs {
+		password := fmt.Sprintf("password%d", i)
+		hash, err := security.HashPassword(password)
+		if err != nil {
+			b.Fatal(err)
+		}
+		pairs[i] = security.HashPasswordPair{HashedPassword: hash, Password: password}
+	}
+	for parallelism := 1; parallelism <= runtime.GOMAXPROCS(0); parallelism *= 2 {
+		b.Run(fmt.Sprintf("parallelism=%d", parallelism), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for _, err := range security.CompareHashesAndPasswords(
+					context.Background(), pairs, parallelism,
+				) {
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMaxVerifyConcurrency(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	if err := security.SetMaxVerifyConcurrency(-1); !testutils.IsError(err, "invalid") {
+		t.Errorf("expected error, got %v", err)
+	}
+	if err := security.SetMaxVerifyConcurrency(1); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetMaxVerifyConcurrency(0); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	b := blockingHasher{started: make(chan struct{}), unblock: make(chan struct{})}
+	prev := security.SetDefaultHasher(b)
+	defer security.SetDefaultHasher(prev)
+	metrics := security.PasswordVerifyMetrics()
+	expectGauges := func(inFlight, queued int64) {
+		testutils.SucceedsSoon(t, func() error {
+			if i, q := metrics.InFlight.Value(), metrics.Queued.Value(); i != inFlight || q != queued {
+				return errors.Errorf("expected %d in flight and %d queued, got %d and %d",
+					inFlight, queued, i, q)
+			}
+			return nil
+		})
+	}
+	compare := func(ctx context.Context) <-chan error {
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- security.CompareHashAndPasswordCtx(ctx, []byte("blocking$"), "hunter2")
+		}()
+		return errCh
+	}
+
+	first := compare(context.Background())
+	<-b.started
+	expectGauges(1, 0)
+
+	// Verifications beyond the limit are queued, but give up when their
+	// context is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := compare(ctx)
+	expectGauges(1, 1)
+	cancel()
+	if err := <-canceled; errors.Cause(err) != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	expectGauges(1, 0)
+
+	queued := compare(context.Background())
+	expectGauges(1, 1)
+	close(b.unblock)
+	if err := <-first; err != nil {
+		t.Error(err)
+	}
+	<-b.started
+	if err := <-queued; err != nil {
+		t.Error(err)
+	}
+	expectGauges(0, 0)
+}
+
+// TestBcryptPreHashCompatibility checks that bcrypt hashes computed with the
+// original implementations of the pre-hashes, which allocated a SHA-256
+// hasher and intermediate buffers, keep verifying, and conversely.
+func TestBcryptPreHashCompatibility(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer security.TestingSetBcryptCost(bcrypt.MinCost)()
+
+	// The original implementations of the legacy and the corrected
+	// pre-hashes.
+	legacyPreHash := func(password []byte) []byte {
+		input := make([]byte, len(password), len(password)+sha256.Size)
+		copy(input, password)
+		return sha256.New().Sum(input)
+	}
+	v2PreHash := func(password []byte) []byte {
+		sum := sha256.Sum256(password)
+		return []byte(base64.StdEncoding.EncodeToString(sum[:]))
+	}
+
+	rng, seed := randutil.NewPseudoRand()
+	t.Logf("seed: %d", seed)
+	for i := 0; i < 20; i++ {
+		// Include passwords longer than the buffers of the verification.
+		password := randutil.RandBytes(rng, rng.Intn(200))
+		// The legacy pre-hash does not fix the length of the input, so that
+		// bcrypt ignores a suffix of long passwords.
+		wrong := append([]byte("x"), password...)
+		for _, tc := range []struct {
+			prefix  string
+			preHash func([]byte) []byte
+		}{
+			{"", legacyPreHash},
+			{"crdb-bcrypt2", v2PreHash},
+		} {
+			hash, err := bcrypt.GenerateFromPassword(tc.preHash(password), bcrypt.MinCost)
+			if err != nil {
+				t.Fatal(err)
+			}
+			hash = append([]byte(tc.prefix), hash...)
+			if err := security.CompareHashAndPasswordBytes(hash, password); err != nil {
+				t.Errorf("%q: %q: %v", hash, password, err)
+			}
+			if err := security.CompareHashAndPasswordBytes(
+				hash, wrong,
+			); err != security.ErrPasswordMismatch {
+				t.Errorf("%q: %q: expected ErrPasswordMismatch, got %v", hash, wrong, err)
+			}
+		}
+
+		if len(password) == 0 {
+			continue
+		}
+		hash, err := security.HashPasswordBytes(password)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasPrefix(hash, []byte("crdb-bcrypt2$")) {
+			t.Fatalf("unexpected hash %q", hash)
+		}
+		if err := bcrypt.CompareHashAndPassword(
+			hash[len("crdb-bcrypt2"):], v2PreHash(password),
+		); err != nil {
+			t.Errorf("%q: %q: %v", hash, password, err)
+		}
+	}
+}
+
+// BenchmarkCompareHashAndPassword measures the cost, and in particular the
+// allocations, of verifying passwords against bcrypt hashes of each pre-hash
+// variant, at the minimum cost so that the overhead around bcrypt stands out.
+func BenchmarkCompareHashAndPassword(b *testing.B) {
+	input := []byte("hunter2")
+	legacy, err := bcrypt.GenerateFromPassword(sha256.New().Sum(input), bcrypt.MinCost)
+	if err != nil {
+		b.Fatal(err)
+	}
+	sum := sha256.Sum256(input)
+	v2Hash, err := bcrypt.GenerateFromPassword(
+		[]byte(base64.StdEncoding.EncodeToString(sum[:])), bcrypt.MinCost)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name string
+		hash []byte
+	}{
+		{"legacy", legacy},
+		{"v2", append([]byte("crdb-bcrypt2"), v2Hash...)},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := security.CompareHashAndPassword(tc.hash, "hunter2"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
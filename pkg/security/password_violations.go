@@ -0,0 +1,279 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ViolationCode is the stable identifier of a rule of a PasswordPolicy,
+// reported with the violations of the rule so that clients can recognize
+// them without parsing messages.
+type ViolationCode string
+
+// The codes of the rules of PasswordPolicy.
+const (
+	ViolationSurroundingWhitespace    ViolationCode = "SURROUNDING_WHITESPACE"
+	ViolationTooShort                 ViolationCode = "TOO_SHORT"
+	ViolationTooLong                  ViolationCode = "TOO_LONG"
+	ViolationMissingUppercase         ViolationCode = "MISSING_UPPERCASE"
+	ViolationMissingLowercase         ViolationCode = "MISSING_LOWERCASE"
+	ViolationMissingDigit             ViolationCode = "MISSING_DIGIT"
+	ViolationMissingSymbol            ViolationCode = "MISSING_SYMBOL"
+	ViolationTooFewDistinctRunes      ViolationCode = "TOO_FEW_DISTINCT_CHARACTERS"
+	ViolationCommonPassword           ViolationCode = "COMMON_PASSWORD"
+	ViolationForbiddenSubstring       ViolationCode = "FORBIDDEN_SUBSTRING"
+	ViolationTrivialPatterns          ViolationCode = "TRIVIAL_PATTERNS"
+	ViolationMatchesUsername          ViolationCode = "MATCHES_USERNAME"
+	ViolationTooWeak                  ViolationCode = "TOO_WEAK"
+	ViolationPwnedPassword            ViolationCode = "PWNED_PASSWORD"
+	ViolationPwnedPasswordUnavailable ViolationCode = "PWNED_PASSWORDS_UNAVAILABLE"
+)
+
+// missingClassCodes are the codes of the violations of the required
+// character classes, indexed by CharacterClass.
+var missingClassCodes = [...]ViolationCode{
+	UppercaseClass: ViolationMissingUppercase,
+	LowercaseClass: ViolationMissingLowercase,
+	DigitClass:     ViolationMissingDigit,
+	SymbolClass:    ViolationMissingSymbol,
+}
+
+// PolicyViolation is the violation of a rule of a PasswordPolicy.
+type PolicyViolation struct {
+	// Code identifies the rule.
+	Code ViolationCode
+	// Err describes the violation, e.g. a *PasswordTooShortError for
+	// TOO_SHORT.
+	Err error
+}
+
+// Message returns the human-readable description of the violation.
+func (v PolicyViolation) Message() string {
+	return v.Err.Error()
+}
+
+// PolicyViolations is returned for passwords which do not follow a
+// PasswordPolicy, with the violations of all of its rules, so that users can
+// fix them at once.
+//
+// Its Cause is the error of the first violation, so that errors.Cause
+// returns the cause of a single violation, e.g. ErrPasswordTooShort. Has,
+// Is and As test for any of the violations; Is and As implement the
+// interfaces of the errors.Is and errors.As functions of newer versions of
+// Go.
+type PolicyViolations struct {
+	// Violations are the violations, in the order of the rules of the
+	// policy.
+	Violations []PolicyViolation
+}
+
+func (e *PolicyViolations) Error() string {
+	if len(e.Violations) == 1 {
+		return e.Violations[0].Message()
+	}
+	messages := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		messages[i] = v.Message()
+	}
+	return fmt.Sprintf("password violates %d rules of the password policy: %s",
+		len(e.Violations), strings.Join(messages, "; "))
+}
+
+// Cause implements the causer interface.
+func (e *PolicyViolations) Cause() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e.Violations[0].Err
+}
+
+// Codes returns the codes of the violations, in order.
+func (e *PolicyViolations) Codes() []ViolationCode {
+	codes := make([]ViolationCode, len(e.Violations))
+	for i, v := range e.Violations {
+		codes[i] = v.Code
+	}
+	return codes
+}
+
+// Has returns whether a rule with the code was violated.
+func (e *PolicyViolations) Has(code ViolationCode) bool {
+	for _, v := range e.Violations {
+		if v.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Is returns whether the error of a violation, or one of its causes, is
+// target, e.g. ErrPasswordTooCommon. See ErrorIs.
+func (e *PolicyViolations) Is(target error) bool {
+	for _, v := range e.Violations {
+		if ErrorIs(v.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As sets target, a non-nil pointer to an error type such as
+// **PasswordTooShortError, to the first error of a violation, or of its
+// causes, assignable to it, and returns whether there was one. See ErrorAs.
+func (e *PolicyViolations) As(target interface{}) bool {
+	for _, v := range e.Violations {
+		if ErrorAs(v.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// expensive returns whether all the violations are of rules which are
+// expensive to check, e.g. query the Pwned Passwords service.
+func (e *PolicyViolations) expensive() bool {
+	for _, v := range e.Violations {
+		if v.Code != ViolationPwnedPassword && v.Code != ViolationPwnedPasswordUnavailable {
+			return false
+		}
+	}
+	return len(e.Violations) > 0
+}
+
+func (e *PolicyViolations) add(code ViolationCode, err error) {
+	e.Violations = append(e.Violations, PolicyViolation{Code: code, Err: err})
+}
+
+// policyRule is a rule of PasswordPolicy, which adds its violations, if any,
+// to v.
+type policyRule struct {
+	// shortCircuit rules only run if the password follows all the rules
+	// before them, because they are expensive, e.g. query a remote service.
+	shortCircuit bool
+	check        func(
+		p *PasswordPolicy, ctx context.Context, password []byte, userInputs []string,
+		v *PolicyViolations,
+	)
+}
+
+// policyRules are the rules of PasswordPolicy, in the order in which they
+// are checked and their violations reported.
+var policyRules = []policyRule{
+	{check: func(p *PasswordPolicy, _ context.Context, pw []byte, _ []string, v *PolicyViolations) {
+		if err := p.checkWhitespace(pw); err != nil {
+			v.add(ViolationSurroundingWhitespace, err)
+		}
+	}},
+	{check: func(p *PasswordPolicy, _ context.Context, pw []byte, _ []string, v *PolicyViolations) {
+		if err := p.checkMinLength(utf8.RuneCount(pw)); err != nil {
+			v.add(ViolationTooShort, err)
+		}
+	}},
+	{check: func(p *PasswordPolicy, _ context.Context, pw []byte, _ []string, v *PolicyViolations) {
+		if err := p.checkMaxLength(utf8.RuneCount(pw)); err != nil {
+			v.add(ViolationTooLong, err)
+		}
+	}},
+	{check: func(p *PasswordPolicy, _ context.Context, pw []byte, _ []string, v *PolicyViolations) {
+		for _, c := range p.missingCharacterClasses(pw) {
+			v.add(missingClassCodes[c], &MissingCharacterClassesError{Missing: []CharacterClass{c}})
+		}
+	}},
+	{check: func(p *PasswordPolicy, _ context.Context, pw []byte, _ []string, v *PolicyViolations) {
+		if err := p.checkDistinctRunes(pw); err != nil {
+			v.add(ViolationTooFewDistinctRunes, err)
+		}
+	}},
+	{check: func(p *PasswordPolicy, _ context.Context, pw []byte, _ []string, v *PolicyViolations) {
+		if !p.RejectCommon {
+			return
+		}
+		if rank := commonPasswordRankOf(pw); rank > 0 {
+			v.add(ViolationCommonPassword, &CommonPasswordError{Rank: rank})
+		}
+	}},
+	{check: func(p *PasswordPolicy, _ context.Context, pw []byte, _ []string, v *PolicyViolations) {
+		for _, err := range p.checkForbiddenSubstrings(pw) {
+			v.add(ViolationForbiddenSubstring, err)
+		}
+	}},
+	{check: func(p *PasswordPolicy, _ context.Context, pw []byte, _ []string, v *PolicyViolations) {
+		if err := p.checkTrivialPatterns(pw); err != nil {
+			v.add(ViolationTrivialPatterns, err)
+		}
+	}},
+	{check: func(
+		p *PasswordPolicy, _ context.Context, pw []byte, inputs []string, v *PolicyViolations,
+	) {
+		if !p.RejectUsername {
+			return
+		}
+		for _, input := range inputs {
+			if err := ValidatePasswordAgainstUsername(string(pw), input); err != nil {
+				v.add(ViolationMatchesUsername, &PasswordMatchesUsernameError{Input: input})
+				return
+			}
+		}
+	}},
+	{check: func(
+		p *PasswordPolicy, _ context.Context, pw []byte, inputs []string, v *PolicyViolations,
+	) {
+		if p.MinStrengthScore <= 0 {
+			return
+		}
+		score := EstimatePasswordStrength(string(pw), inputs).Score
+		if score < p.MinStrengthScore {
+			v.add(ViolationTooWeak, &PasswordTooWeakError{Required: p.MinStrengthScore, Score: score})
+		}
+	}},
+	{shortCircuit: true, check: func(
+		p *PasswordPolicy, ctx context.Context, pw []byte, _ []string, v *PolicyViolations,
+	) {
+		if p.PwnedPasswords == nil {
+			return
+		}
+		// The request is also bounded by the timeout of the checker.
+		count, err := p.PwnedPasswords.check(ctx, pw)
+		if err != nil && !p.PwnedPasswordsFailOpen {
+			v.add(ViolationPwnedPasswordUnavailable, err)
+		}
+		if count > 0 {
+			v.add(ViolationPwnedPassword, &PwnedPasswordError{Count: count})
+		}
+	}},
+}
+
+// validate implements Validate, once the password was trimmed according to
+// the whitespace mode. It takes the password as a byte slice so that
+// HashPasswordBytes can zero it.
+func (p *PasswordPolicy) validate(
+	ctx context.Context, password []byte, userInputs []string,
+) error {
+	var v PolicyViolations
+	for _, rule := range policyRules {
+		if rule.shortCircuit && len(v.Violations) > 0 {
+			continue
+		}
+		rule.check(p, ctx, password, userInputs, &v)
+	}
+	if len(v.Violations) > 0 {
+		return &v
+	}
+	return nil
+}
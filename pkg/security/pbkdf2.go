@@ -0,0 +1,105 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// PBKDF2-HMAC-SHA256 hashes are encoded in the PHC string format:
+//
+//	$pbkdf2-sha256$i=<iterations>$<salt>$<hash>
+const pbkdf2SHA256ID = "pbkdf2-sha256"
+
+// PBKDF2Iterations is the iteration count to use when hashing passwords with
+// HashPBKDF2. Like BcryptCost, it is exposed for testing. Verification
+// always uses the iteration count recorded in the stored hash.
+//
+// The default is the OWASP recommendation for PBKDF2-HMAC-SHA256.
+var PBKDF2Iterations = 310000
+
+// PBKDF2MaxIterations is the highest iteration count accepted. Like
+// ScryptMaxMemory, it is enforced both when hashing and when parsing a
+// stored hash, so that a crafted hash cannot pin a CPU of the server on
+// every login attempt.
+var PBKDF2MaxIterations = 10 * 1000 * 1000
+
+const (
+	pbkdf2SaltLen = 16
+	pbkdf2KeyLen  = sha256.Size
+	// pbkdf2MinKeyLen and pbkdf2MaxKeyLen bound the length of the derived
+	// key of stored hashes, which verification recomputes: the work grows
+	// with the number of SHA-256 blocks of the key, and a short key would
+	// match many passwords.
+	pbkdf2MinKeyLen = sha256.Size / 2
+	pbkdf2MaxKeyLen = 2 * sha256.Size
+)
+
+func hashPBKDF2(password []byte) ([]byte, error) {
+	iterations := PBKDF2Iterations
+	if iterations <= 0 || iterations > PBKDF2MaxIterations {
+		return nil, errors.Errorf("PBKDF2 iteration count %d outside of [1, %d]",
+			iterations, PBKDF2MaxIterations)
+	}
+	salt, err := generateSalt(pbkdf2SaltLen)
+	if err != nil {
+		return nil, err
+	}
+	h := PasswordHash{
+		method: HashPBKDF2,
+		id:     pbkdf2SHA256ID,
+		params: []phcParam{{"i", iterations}},
+		salt:   salt,
+		hash:   pbkdf2.Key(password, salt, iterations, pbkdf2KeyLen, sha256.New),
+	}
+	return h.Encode(), nil
+}
+
+// pbkdf2Params validates and returns the iteration count of a PBKDF2 hash.
+func pbkdf2Params(h PasswordHash) (iterations int, err error) {
+	values, err := requireParams(h, "i")
+	if err != nil {
+		return 0, err
+	}
+	if values[0] == 0 {
+		return 0, errors.New("malformed pbkdf2-sha256 hash: invalid iteration count")
+	}
+	if values[0] > PBKDF2MaxIterations {
+		return 0, errors.Errorf(
+			"malformed pbkdf2-sha256 hash: iteration count %d above the maximum of %d",
+			values[0], PBKDF2MaxIterations)
+	}
+	if n := len(h.hash); n < pbkdf2MinKeyLen || n > pbkdf2MaxKeyLen {
+		return 0, errors.Errorf("malformed pbkdf2-sha256 hash: %d-byte key outside of [%d, %d]",
+			n, pbkdf2MinKeyLen, pbkdf2MaxKeyLen)
+	}
+	return values[0], nil
+}
+
+func verifyPBKDF2(h PasswordHash, password []byte) error {
+	iterations, err := pbkdf2Params(h)
+	if err != nil {
+		return err
+	}
+	got := pbkdf2.Key(password, h.salt, iterations, len(h.hash), sha256.New)
+	if subtle.ConstantTimeCompare(h.hash, got) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+// pepperPrefix marks bcrypt hashes of passwords that were run through
+// HMAC-SHA-256, keyed with a site-wide secret (the pepper), before bcrypt:
+//
+//	crdb-pepper$<key ID>$2a$<cost>$<salt><hash>
+//
+// If the users table leaks but the pepper does not, such hashes cannot be
+// cracked offline. The key ID identifies the pepper without revealing it
+// (see AddPepperKey).
+const pepperPrefix = "crdb-pepper"
+
+// ErrPepperKeyUnavailable is the cause of PepperKeyUnavailableError.
+var ErrPepperKeyUnavailable = errors.New("pepper key unavailable")
+
+// PepperKeyUnavailableError is returned when verifying a hash created with a
+// pepper key that is not registered, e.g. because it was removed with
+// RemovePepperKey while hashes using it were still stored. Its cause is
+// ErrPepperKeyUnavailable.
+type PepperKeyUnavailableError struct {
+	KeyID string
+}
+
+func (e *PepperKeyUnavailableError) Error() string {
+	return fmt.Sprintf("password hash was created with pepper key %q, which is not available",
+		e.KeyID)
+}
+
+// Cause implements the causer interface.
+func (e *PepperKeyUnavailableError) Cause() error {
+	return ErrPepperKeyUnavailable
+}
+
+// peppers holds the registered pepper keys, by key ID, in locked memory.
+// The keys are only used while holding the lock, so that they can be
+// destroyed once unregistered.
+var peppers struct {
+	syncutil.RWMutex
+	keys     map[string]*LockedBuffer
+	activeID string
+}
+
+// newPepperKey copies a pepper key into locked memory.
+func newPepperKey(key []byte) *LockedBuffer {
+	b := NewLockedBuffer(len(key))
+	copy(b.Bytes(), key)
+	return b
+}
+
+// AddPepperKey registers a pepper key. Hashes created with it record its
+// ID, so that keys can be rotated: once a new key is made active with
+// SetActivePepperKey, hashes created with other keys keep verifying, and
+// NeedsRehash reports them so that they can be upgraded. Key IDs may only
+// contain letters, digits, '-' and '_'. The key is copied into a
+// LockedBuffer, which is destroyed when the key is unregistered.
+func AddPepperKey(id string, key []byte) error {
+	if id == "" {
+		return errors.New("pepper key ID must not be empty")
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+			c == '-' || c == '_') {
+			return errors.Errorf("invalid character %q in pepper key ID %q", c, id)
+		}
+	}
+	if len(key) == 0 {
+		return errors.Errorf("pepper key %q must not be empty", id)
+	}
+	peppers.Lock()
+	defer peppers.Unlock()
+	if _, ok := peppers.keys[id]; ok {
+		return errors.Errorf("pepper key %q is already registered", id)
+	}
+	if peppers.keys == nil {
+		peppers.keys = make(map[string]*LockedBuffer)
+	}
+	peppers.keys[id] = newPepperKey(key)
+	return nil
+}
+
+// SetActivePepperKey sets the registered pepper key used to hash new
+// passwords with bcrypt. An empty ID disables peppering of new hashes.
+func SetActivePepperKey(id string) error {
+	peppers.Lock()
+	defer peppers.Unlock()
+	if _, ok := peppers.keys[id]; id != "" && !ok {
+		return errors.Errorf("pepper key %q is not registered", id)
+	}
+	peppers.activeID = id
+	return nil
+}
+
+// RemovePepperKey unregisters a pepper key. Hashes created with it can no
+// longer be verified, and fail with a PepperKeyUnavailableError. The active
+// key cannot be removed. Cached verification outcomes are dropped.
+func RemovePepperKey(id string) error {
+	peppers.Lock()
+	defer peppers.Unlock()
+	if id == peppers.activeID {
+		return errors.Errorf("pepper key %q is active", id)
+	}
+	if key, ok := peppers.keys[id]; ok {
+		key.Destroy()
+		delete(peppers.keys, id)
+	}
+	clearVerifyCache()
+	return nil
+}
+
+// SetPepper configures a single pepper, replacing any registered pepper
+// keys, and makes it active. Its key ID is derived from the key (see
+// pepperKeyID). An empty key removes all pepper keys.
+func SetPepper(key []byte) {
+	peppers.Lock()
+	defer peppers.Unlock()
+	for _, key := range peppers.keys {
+		key.Destroy()
+	}
+	peppers.keys, peppers.activeID = nil, ""
+	clearVerifyCache()
+	if len(key) == 0 {
+		return
+	}
+	id := pepperKeyID(key)
+	peppers.keys = map[string]*LockedBuffer{id: newPepperKey(key)}
+	peppers.activeID = id
+}
+
+// activePepperID returns the ID of the active pepper key, if any.
+func activePepperID() (id string, ok bool) {
+	peppers.RLock()
+	defer peppers.RUnlock()
+	return peppers.activeID, peppers.activeID != ""
+}
+
+// activePepperedPreHash computes the pepperedPreHash of a password with the
+// active pepper key, if any, and returns the ID of the key.
+func activePepperedPreHash(password []byte) (id string, input []byte, ok bool) {
+	peppers.RLock()
+	defer peppers.RUnlock()
+	if peppers.activeID == "" {
+		return "", nil, false
+	}
+	key := peppers.keys[peppers.activeID]
+	return peppers.activeID, pepperedPreHash(key.Bytes(), password), true
+}
+
+// pepperedPreHashWithKey computes the pepperedPreHash of a password with the
+// pepper key with the given ID.
+func pepperedPreHashWithKey(id string, password []byte) ([]byte, error) {
+	peppers.RLock()
+	defer peppers.RUnlock()
+	key, ok := peppers.keys[id]
+	if !ok {
+		return nil, &PepperKeyUnavailableError{KeyID: id}
+	}
+	return pepperedPreHash(key.Bytes(), password), nil
+}
+
+// pepperKeyID derives a short identifier for a pepper configured with
+// SetPepper, so that verification can tell a wrong pepper apart from a wrong
+// password.
+func pepperKeyID(key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("crdb-pepper-id"))
+	return hex.EncodeToString(mac.Sum(nil)[:4])
+}
+
+// pepperedPreHash computes the input to bcrypt for a password and pepper:
+// the base64 encoding of HMAC-SHA-256(pepper, password), which, like
+// bcryptPreHash, is free of NUL bytes and shorter than 72 bytes.
+func pepperedPreHash(key, password []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(password)
+	sum := mac.Sum(nil)
+	encoded := make([]byte, base64.StdEncoding.EncodedLen(len(sum)))
+	base64.StdEncoding.Encode(encoded, sum)
+	ZeroBytes(sum)
+	return encoded
+}
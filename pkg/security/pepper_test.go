@@ -0,0 +1,167 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestPepper(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer security.TestingSetBcryptCost(4)()
+	defer security.SetPepper(nil)
+
+	unpeppered, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	security.SetPepper([]byte("pepper A"))
+	peppered, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(peppered, []byte("crdb-pepper$")) {
+		t.Fatalf("expected a peppered hash, got %s", peppered)
+	}
+	if security.NeedsRehash(peppered) {
+		t.Errorf("%s: unexpected rehash", peppered)
+	}
+	for _, h := range [][]byte{peppered, unpeppered, []byte(legacyHash)} {
+		if err := security.CompareHashAndPassword(h, "hunter2"); err != nil {
+			t.Errorf("%s: %v", h, err)
+		}
+		if err := security.CompareHashAndPassword(h, "hunter3"); err != security.ErrPasswordMismatch {
+			t.Errorf("%s: expected mismatch, got %v", h, err)
+		}
+	}
+	h, err := security.ParsePasswordHash(peppered)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := h.Encode(); !bytes.Equal(e, peppered) {
+		t.Errorf("expected %s to round-trip, got %s", peppered, e)
+	}
+
+	// Unpeppered hashes are upgraded once a pepper is configured.
+	newHash, upgraded, err := security.UpgradeHashIfNeeded(unpeppered, "hunter2")
+	if err != nil || !upgraded || !bytes.HasPrefix(newHash, []byte("crdb-pepper$")) {
+		t.Errorf("expected upgrade to a peppered hash, got %s, %t, %v", newHash, upgraded, err)
+	}
+
+	// The peppered hash cannot be verified with another pepper, or without
+	// one, even with the right password.
+	for _, p := range []string{"pepper B", ""} {
+		security.SetPepper([]byte(p))
+		if err := security.CompareHashAndPassword(
+			peppered, "hunter2",
+		); errors.Cause(err) != security.ErrPepperKeyUnavailable {
+			t.Errorf("%q: expected unavailable pepper key, got %v", p, err)
+		}
+		if err := security.CompareHashAndPassword(unpeppered, "hunter2"); err != nil {
+			t.Errorf("%q: %v", p, err)
+		}
+	}
+
+	// Stripping the tag yields an unpeppered hash, which does not verify.
+	stripped := peppered[bytes.IndexByte(peppered[len("crdb-pepper$"):], '$')+len("crdb-pepper$"):]
+	if err := security.CompareHashAndPassword(
+		stripped, "hunter2",
+	); err != security.ErrPasswordMismatch {
+		t.Errorf("%s: expected mismatch, got %v", stripped, err)
+	}
+}
+
+func TestPepperKeyRotation(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	defer security.TestingSetBcryptCost(4)()
+	defer security.SetPepper(nil)
+
+	for _, id := range []string{"k1", "k2"} {
+		if err := security.AddPepperKey(id, []byte("secret "+id)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := security.AddPepperKey("k1", []byte("other")); !testutils.IsError(
+		err, "already registered",
+	) {
+		t.Errorf("expected duplicate key error, got %v", err)
+	}
+	for _, id := range []string{"", "k$3", "k 3"} {
+		if err := security.AddPepperKey(id, []byte("secret")); err == nil {
+			t.Errorf("%q: expected invalid key ID error", id)
+		}
+	}
+	if err := security.SetActivePepperKey("k3"); !testutils.IsError(err, "not registered") {
+		t.Errorf("expected unregistered key error, got %v", err)
+	}
+
+	if err := security.SetActivePepperKey("k1"); err != nil {
+		t.Fatal(err)
+	}
+	k1Hash, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(k1Hash, []byte("crdb-pepper$k1$2a$")) {
+		t.Fatalf("expected the key ID in the hash, got %s", k1Hash)
+	}
+	if security.NeedsRehash(k1Hash) {
+		t.Errorf("%s: unexpected rehash", k1Hash)
+	}
+
+	// After rotating to k2, k1 hashes keep verifying but need a rehash.
+	if err := security.SetActivePepperKey("k2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPassword(k1Hash, "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+	if !security.NeedsRehash(k1Hash) {
+		t.Errorf("%s: expected rehash", k1Hash)
+	}
+	k2Hash, upgraded, err := security.UpgradeHashIfNeeded(k1Hash, "hunter2")
+	if err != nil || !upgraded || !bytes.HasPrefix(k2Hash, []byte("crdb-pepper$k2$2a$")) {
+		t.Fatalf("expected upgrade to k2, got %s, %t, %v", k2Hash, upgraded, err)
+	}
+
+	if err := security.RemovePepperKey("k2"); !testutils.IsError(err, "is active") {
+		t.Errorf("expected active key error, got %v", err)
+	}
+	if err := security.RemovePepperKey("k1"); err != nil {
+		t.Fatal(err)
+	}
+	err = security.CompareHashAndPassword(k1Hash, "hunter2")
+	if e, ok := err.(*security.PepperKeyUnavailableError); !ok || e.KeyID != "k1" {
+		t.Errorf("expected unavailable key k1, got %v", err)
+	}
+	if errors.Cause(err) != security.ErrPepperKeyUnavailable {
+		t.Errorf("unexpected cause %v", errors.Cause(err))
+	}
+	if err := security.CompareHashAndPassword(k2Hash, "hunter2"); err != nil {
+		t.Error(err)
+	}
+}
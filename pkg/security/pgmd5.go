@@ -0,0 +1,59 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+)
+
+// PostgreSQL md5 hashes are the string "md5" followed by the lowercase hex
+// encoding of md5(password || username).
+const pgMD5Prefix = "md5"
+
+// parsePGMD5Hash decodes a PostgreSQL md5 hash.
+func parsePGMD5Hash(hashedPassword []byte) (PasswordHash, error) {
+	encoded := hashedPassword[len(pgMD5Prefix):]
+	if len(encoded) != hex.EncodedLen(md5.Size) {
+		return PasswordHash{}, errors.Errorf("malformed md5 hash: expected %d hex digits, got %d",
+			hex.EncodedLen(md5.Size), len(encoded))
+	}
+	for _, c := range encoded {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return PasswordHash{}, errors.New("malformed md5 hash: invalid hex digit")
+		}
+	}
+	hash := make([]byte, md5.Size)
+	if _, err := hex.Decode(hash, encoded); err != nil {
+		return PasswordHash{}, errors.New("malformed md5 hash: invalid hex digit")
+	}
+	return PasswordHash{method: HashPGMD5, id: "md5", hash: hash}, nil
+}
+
+// verifyPGMD5 verifies a password against a PostgreSQL md5 hash, which is
+// salted with the name of the user it belongs to.
+func verifyPGMD5(h PasswordHash, password []byte, username string) error {
+	input := make([]byte, 0, len(password)+len(username))
+	input = append(append(input, password...), username...)
+	sum := md5.Sum(input)
+	ZeroBytes(input)
+	if subtle.ConstantTimeCompare(h.hash, sum[:]) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
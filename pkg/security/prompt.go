@@ -0,0 +1,993 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+	"crypto/subtle"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// newPasswordAttempts is the default number of attempts of
+// PromptForNewPassword.
+const newPasswordAttempts = 3
+
+// Prompter prompts for passwords. If its input is a terminal, passwords are
+// read from it without echo, after writing a prompt to its output;
+// otherwise, e.g. when passwords are piped into stdin and there is no
+// controlling terminal to prompt on (see NewPrompter), they are read from
+// the first line of the input, without prompts.
+type Prompter struct {
+	// lines reads passwords from the input, if they are not read from a
+	// terminal.
+	lines *passwordLineReader
+	out   io.Writer
+	// readTerminal reads a password from the terminal without echo, or
+	// echoing mask, and saveTerminalState returns a function restoring the
+	// current state of the terminal. They are nil if passwords are not read
+	// from a terminal.
+	readTerminal      func(p *Prompter) ([]byte, error)
+	saveTerminalState func() func()
+	// readTerminalEchoed reads a line from the terminal with echo, for
+	// ReadLineEchoed. It is never used for passwords.
+	readTerminalEchoed func(p *Prompter) ([]byte, error)
+	// prompt and confirmPrompt, if set by WithPrompts, replace the prompts of
+	// the messages for the password and its confirmation; see prompts.
+	prompt, confirmPrompt string
+	// newPassword selects the prompts of the messages for new passwords.
+	newPassword bool
+	// msgs, if set by WithPromptMessages, replaces the messages installed
+	// with SetPromptMessages.
+	msgs *PromptMessages
+	// mask, if set, is echoed for each character typed.
+	mask rune
+	// minScore and strengthMode are set by WithStrengthCheck.
+	minScore     int
+	strengthMode StrengthCheckMode
+	// policy, if set by WithPasswordPolicy, validates new passwords.
+	policy *PasswordPolicy
+	// noTerminalFallback is set by WithoutTerminalFallback.
+	noTerminalFallback bool
+	// noConfirm is set by WithoutConfirmation.
+	noConfirm bool
+	// maxLength is set by WithMaxInputLength.
+	maxLength int
+	// current, if set, is the current password, which new passwords must
+	// differ from; see PromptPasswordChange.
+	current []byte
+	// keepCurrent is set by WithAllowEmptyMeaningKeep.
+	keepCurrent bool
+	// lockedMemory is set by WithLockedMemory.
+	lockedMemory bool
+}
+
+// PrompterOption configures a Prompter.
+type PrompterOption func(*Prompter)
+
+// WithPrompts sets the prompts for the password and its confirmation, e.g.
+// "Enter password for user alice: ". The defaults are the PasswordPrompt and
+// ConfirmPasswordPrompt messages, "Enter password: " and "Confirm password: "
+// in English, which empty prompts leave in place.
+func WithPrompts(prompt, confirmPrompt string) PrompterOption {
+	return func(p *Prompter) {
+		p.prompt, p.confirmPrompt = prompt, confirmPrompt
+	}
+}
+
+// WithMaskRune makes the Prompter echo mask, e.g. '*', for each character
+// typed on a terminal, so that users can see how many they typed. Backspace
+// and Delete erase the last one, and Ctrl-U all of them. If the terminal
+// cannot be put in raw mode, nothing is echoed, as without this option.
+func WithMaskRune(mask rune) PrompterOption {
+	return func(p *Prompter) {
+		p.mask = mask
+	}
+}
+
+// StrengthCheckMode is what a Prompter does with weak new passwords; see
+// WithStrengthCheck.
+type StrengthCheckMode int
+
+const (
+	// StrengthWarn warns about weak passwords, and accepts them.
+	StrengthWarn StrengthCheckMode = iota
+	// StrengthEnforce warns about weak passwords, and rejects them with a
+	// *PasswordTooWeakError.
+	StrengthEnforce
+)
+
+// WithStrengthCheck makes ReadNewPassword check the strength of the
+// password, once entered and before asking for its confirmation: if its
+// score, as returned by ScorePassword, is below minScore, a warning with the
+// main suggestion to strengthen it is printed, e.g. "warning: this is a very
+// common password", and the password is accepted or rejected according to
+// the mode. The score must be between 1 and 4. Piped passwords are not
+// checked.
+//
+// Without this option, the score set by SetPasswordStrengthWarning is
+// checked in StrengthWarn mode.
+func WithStrengthCheck(minScore int, mode StrengthCheckMode) PrompterOption {
+	return func(p *Prompter) {
+		p.minScore, p.strengthMode = minScore, mode
+	}
+}
+
+// WithPasswordPolicy makes ReadNewPassword validate the password with the
+// policy, once entered and before asking for its confirmation, and apply its
+// whitespace mode instead of the one of the policy installed with
+// SetPasswordPolicy. A password which violates the policy is rejected with
+// its *PolicyViolations; ReadNewPasswordWithRetries prompts again. If the
+// policy has WarnOnExpensiveViolations set, the violations of its expensive
+// rules are printed as warnings instead, and the password is accepted if it
+// is typed again, which also confirms it.
+func WithPasswordPolicy(policy *PasswordPolicy) PrompterOption {
+	return func(p *Prompter) {
+		p.policy = policy
+	}
+}
+
+// WithoutConfirmation makes ReadNewPassword prompt for the password once,
+// without asking for its confirmation, e.g. when the caller already
+// validated it. Empty passwords are still rejected.
+func WithoutConfirmation() PrompterOption {
+	return func(p *Prompter) {
+		p.noConfirm = true
+	}
+}
+
+// ErrKeepCurrentPassword is returned by ReadNewPassword for an empty password
+// when the Prompter was given WithAllowEmptyMeaningKeep.
+var ErrKeepCurrentPassword = errors.New("the current password is kept")
+
+// errKeepCurrentWithPolicy is returned by ReadNewPassword when the Prompter
+// was given both WithAllowEmptyMeaningKeep and WithPasswordPolicy.
+var errKeepCurrentWithPolicy = errors.New(
+	"WithAllowEmptyMeaningKeep cannot be combined with WithPasswordPolicy")
+
+// WithAllowEmptyMeaningKeep makes ReadNewPassword return
+// ErrKeepCurrentPassword, instead of ErrEmptyPassword, when the password is
+// empty, e.g. when the user presses Enter to keep the current password,
+// without asking for its confirmation. It has no effect if current, the
+// current password, is empty, since there is no password to keep.
+//
+// Since policies reject empty passwords, ReadNewPassword fails if the
+// Prompter was also given WithPasswordPolicy. PromptForPasswordTwice and
+// its variants ignore the option.
+func WithAllowEmptyMeaningKeep(current []byte) PrompterOption {
+	return func(p *Prompter) {
+		p.keepCurrent = len(current) > 0
+	}
+}
+
+// withoutKeepingCurrent reverts WithAllowEmptyMeaningKeep.
+func withoutKeepingCurrent() PrompterOption {
+	return func(p *Prompter) {
+		p.keepCurrent = false
+	}
+}
+
+// WithMaxInputLength sets the maximum length, in bytes, of the passwords,
+// and of the lines read by ReadLineEchoed. The rest of longer lines is read
+// and discarded, without keeping it in memory, and they are rejected with a
+// *PasswordTooLongError, rather than truncated, so that a hostile or broken
+// input cannot make the Prompter buffer arbitrary amounts of data. The
+// default is the maximum length set by SetMaxPasswordLength.
+//
+// Terminals which cannot be put in raw mode are read in canonical mode, in
+// which they bound the length of lines themselves, e.g. to 4095 bytes on
+// Linux.
+func WithMaxInputLength(n int) PrompterOption {
+	return func(p *Prompter) {
+		p.maxLength = n
+	}
+}
+
+// WithLockedMemory makes ReadPasswordBytes, ReadNewPasswordBytes and the
+// other methods returning SecureStrings return the passwords in a
+// LockedBuffer, whose memory is not written to swap, and is unlocked by
+// SecureString.Destroy. The buffers the passwords are read into are zeroed
+// once copied.
+func WithLockedMemory() PrompterOption {
+	return func(p *Prompter) {
+		p.lockedMemory = true
+	}
+}
+
+// lockPassword returns the password in a LockedBuffer if WithLockedMemory
+// was given, zeroing the buffer it was read into, up to its capacity.
+func (p *Prompter) lockPassword(password []byte) []byte {
+	if !p.lockedMemory || len(password) == 0 {
+		return password
+	}
+	b := NewLockedBuffer(len(password))
+	copy(b.Bytes(), password)
+	ZeroBytes(password[:cap(password)])
+	return b.Bytes()
+}
+
+// WithPromptOutput sets the writer the prompts, and the newlines following
+// the passwords, are written to, e.g. os.Stdout for callers which expect
+// them there. The package-level functions, e.g. PromptForPassword, write
+// them to stderr.
+func WithPromptOutput(out io.Writer) PrompterOption {
+	return func(p *Prompter) {
+		p.out = out
+	}
+}
+
+// WithoutTerminalFallback stops NewPrompter from prompting on the
+// controlling terminal of the process when stdin is redirected, so that
+// passwords are always read from stdin, e.g. for tools which must never
+// wait for an operator.
+func WithoutTerminalFallback() PrompterOption {
+	return func(p *Prompter) {
+		p.noTerminalFallback = true
+	}
+}
+
+// NewPrompter returns a Prompter reading passwords from in, e.g. os.Stdin,
+// and writing prompts to out. The input is a terminal if it is an *os.File
+// referring to one.
+//
+// If in is os.Stdin and is redirected, e.g. in "cockroach sql < dump.sql",
+// passwords are prompted for on the controlling terminal of the process,
+// i.e. /dev/tty, or CONIN$ on Windows, which is opened for each read, unless
+// WithoutTerminalFallback is given. They are read from stdin only if there
+// is no such terminal.
+func NewPrompter(in io.Reader, out io.Writer, opts ...PrompterOption) *Prompter {
+	p := (&Prompter{out: out}).apply(opts)
+	f, ok := in.(*os.File)
+	// On Windows, Fd returns the handle of the console, which
+	// terminal.ReadPassword expects. Redirected inputs are not consoles.
+	switch {
+	case ok && terminal.IsTerminal(int(f.Fd())):
+		p.setTerminal(func() (*os.File, func(), error) {
+			return f, func() {}, nil
+		})
+	case ok && f == os.Stdin && !p.noTerminalFallback && hasControllingTerminal():
+		p.setTerminal(openControllingTerminal)
+	default:
+		p.lines = &passwordLineReader{r: in}
+	}
+	return p
+}
+
+// terminalOpener returns the terminal to read from, and a function releasing
+// it once the read is done.
+type terminalOpener func() (f *os.File, release func(), _ error)
+
+// openControllingTerminal opens the controlling terminal of the process. It
+// is closed when released.
+func openControllingTerminal() (*os.File, func(), error) {
+	f, err := os.OpenFile(controllingTerminalPath, os.O_RDWR, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, func() { _ = f.Close() }, nil
+}
+
+// hasControllingTerminal returns whether the process has a controlling
+// terminal.
+func hasControllingTerminal() bool {
+	f, release, err := openControllingTerminal()
+	if err != nil {
+		return false
+	}
+	defer release()
+	return terminal.IsTerminal(int(f.Fd()))
+}
+
+// setTerminal makes the Prompter read from the terminal returned by open.
+func (p *Prompter) setTerminal(open terminalOpener) {
+	p.readTerminal = func(p *Prompter) ([]byte, error) {
+		f, release, err := open()
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return readTerminalPassword(f, p.mask, p.out, p.maxInputLength())
+	}
+	p.readTerminalEchoed = func(p *Prompter) ([]byte, error) {
+		f, release, err := open()
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		// The line is read from the terminal in its current mode, i.e. with
+		// echo. Terminals do not write byte order marks, and lines are read
+		// one byte at a time, so that nothing is left buffered.
+		echoed := passwordLineReader{r: f, started: true}
+		return echoed.readLine(p.maxInputLength())
+	}
+	p.saveTerminalState = func() func() {
+		f, release, err := open()
+		if err != nil {
+			return func() {}
+		}
+		state, err := terminal.GetState(int(f.Fd()))
+		release()
+		if err != nil {
+			return func() {}
+		}
+		return func() {
+			if f, release, err := open(); err == nil {
+				_ = terminal.Restore(int(f.Fd()), state)
+				release()
+			}
+		}
+	}
+}
+
+// readTerminalPassword reads a password of at most limit bytes from the
+// terminal without echo, or echoing mask to out for each character if it is
+// set. If the read is interrupted by one of interruptSignals, e.g. by
+// Ctrl-C, the state of the terminal is restored before the signal is
+// reraised, so that the shell is not left with echo turned off.
+func readTerminalPassword(f *os.File, mask rune, out io.Writer, limit int) ([]byte, error) {
+	fd := int(f.Fd())
+	state, err := terminal.GetState(fd)
+	if err != nil {
+		return nil, err
+	}
+	restore := func() {
+		_ = terminal.Restore(fd, state)
+	}
+	defer restore()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, interruptSignals...)
+	done := make(chan struct{})
+	defer func() {
+		signal.Stop(sigCh)
+		close(done)
+	}()
+	go func() {
+		select {
+		case sig := <-sigCh:
+			restore()
+			signal.Stop(sigCh)
+			reraiseInterrupt(sig)
+		case <-done:
+		}
+	}()
+	if password, ok, err := readRawPassword(f, mask, out, limit); ok {
+		if err == errPasswordPromptInterrupted {
+			// Ctrl-C does not raise SIGINT in raw mode.
+			restore()
+			signal.Stop(sigCh)
+			reraiseInterrupt(interruptSignals[0])
+		}
+		return password, err
+	}
+	// The length of the password is checked once read: in canonical mode,
+	// the terminal bounds the length of lines. The copies of the password
+	// made by terminal.ReadPassword while reading it cannot be zeroed.
+	return terminal.ReadPassword(fd)
+}
+
+// apply applies the options.
+func (p *Prompter) apply(opts []PrompterOption) *Prompter {
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// testingDefaultPrompter, if set by TestingSetDefaultPrompter, replaces the
+// Prompter of stdin and stderr.
+var testingDefaultPrompter *Prompter
+
+// TestingSetDefaultPrompter makes the package-level password prompts, e.g.
+// PromptForPassword, use p, e.g. a Prompter returned by NewTestPrompter,
+// instead of stdin and stderr. The returned function restores the default.
+func TestingSetDefaultPrompter(p *Prompter) func() {
+	if flag.Lookup("test.v") == nil {
+		panic("TestingSetDefaultPrompter can only be used in tests")
+	}
+	prev := testingDefaultPrompter
+	testingDefaultPrompter = p
+	return func() {
+		testingDefaultPrompter = prev
+	}
+}
+
+// defaultPrompter returns the Prompter of the package-level functions, which
+// prompts on stdin and stderr, configured with the options.
+func defaultPrompter(opts ...PrompterOption) *Prompter {
+	if testingDefaultPrompter != nil {
+		p := *testingDefaultPrompter
+		return p.apply(opts)
+	}
+	return NewPrompter(os.Stdin, os.Stderr, opts...)
+}
+
+// isTerminal returns whether passwords are read from a terminal, rather
+// than e.g. piped into stdin.
+func (p *Prompter) isTerminal() bool {
+	return p.readTerminal != nil
+}
+
+// print writes a prompt to the output.
+func (p *Prompter) print(s string) {
+	fmt.Fprint(p.out, s)
+}
+
+// readPassword reads a password from the terminal, or a line of a
+// non-terminal input, and rejects it if it is longer than the maximum length
+// of the input (see WithMaxInputLength).
+func (p *Prompter) readPassword() ([]byte, error) {
+	var password []byte
+	var err error
+	if p.isTerminal() {
+		// Passwords are always read with readTerminal, which turns echo off,
+		// whatever the options.
+		password, err = p.readTerminal(p)
+	} else {
+		password, err = p.readLine()
+	}
+	return p.checkLineLength(password, err)
+}
+
+// maxInputLength returns the maximum length of the input set by
+// WithMaxInputLength, or by default by SetMaxPasswordLength.
+func (p *Prompter) maxInputLength() int {
+	if p.maxLength > 0 {
+		return p.maxLength
+	}
+	return int(atomic.LoadInt32(&maxPasswordLength))
+}
+
+// checkLineLength returns the line read, or the error reading it, and
+// rejects it if it is longer than the maximum length of the input.
+func (p *Prompter) checkLineLength(line []byte, err error) ([]byte, error) {
+	if err != nil {
+		ZeroBytes(line)
+		return nil, err
+	}
+	if max := p.maxInputLength(); len(line) > max {
+		ZeroBytes(line)
+		return nil, &PasswordTooLongError{Max: max, Actual: len(line)}
+	}
+	return line, nil
+}
+
+// ReadLineEchoed prompts for a line which is not secret, e.g. a username,
+// and reads it with echo, as typed. Like passwords, it is read without its
+// trailing newline from the first line of non-terminal inputs, without a
+// prompt, and it is rejected if it is longer than the maximum length of the
+// input (see WithMaxInputLength). It must not be used to read passwords.
+func (p *Prompter) ReadLineEchoed(prompt string) (string, error) {
+	var line []byte
+	var err error
+	if p.isTerminal() {
+		p.print(prompt)
+		// The newline typed was echoed.
+		line, err = p.readTerminalEchoed(p)
+	} else {
+		line, err = p.readLine()
+	}
+	line, err = p.checkLineLength(line, err)
+	return string(line), err
+}
+
+// ErrNonInteractive is the cause of NonInteractiveError.
+var ErrNonInteractive = errors.New("cannot prompt: the input is not a terminal")
+
+// NonInteractiveError is returned when a password, or a line read by
+// ReadLineEchoed, is required but the input is not a terminal, and it is
+// empty or cannot be read, e.g. because stdin is closed or redirected from
+// /dev/null. Its cause is ErrNonInteractive.
+type NonInteractiveError struct {
+	// Err is the error reading the input, e.g. io.EOF.
+	Err error
+}
+
+func (e *NonInteractiveError) Error() string {
+	reason := "it is empty"
+	if e.Err != io.EOF {
+		reason = fmt.Sprintf("it cannot be read: %v", e.Err)
+	}
+	return fmt.Sprintf("%s, and %s; provide the password with --password-file "+
+		"or an environment variable instead", ErrNonInteractive, reason)
+}
+
+// Cause implements the causer interface.
+func (e *NonInteractiveError) Cause() error {
+	return ErrNonInteractive
+}
+
+// readLine reads a line of a non-terminal input. If the input ends, or fails,
+// before its first byte, there is nothing to read a password from, and a
+// *NonInteractiveError is returned; no byte of inputs with data is consumed
+// to detect it.
+func (p *Prompter) readLine() ([]byte, error) {
+	line, err := p.lines.readLine(p.maxInputLength())
+	if err != nil && !p.lines.consumed {
+		return nil, &NonInteractiveError{Err: err}
+	}
+	return line, err
+}
+
+// PasswordPromptError is returned when a password prompt is aborted because
+// its context was canceled or timed out. Its cause is the error of the
+// context, e.g. context.DeadlineExceeded.
+type PasswordPromptError struct {
+	Err error
+}
+
+func (e *PasswordPromptError) Error() string {
+	return fmt.Sprintf("password prompt aborted: %v", e.Err)
+}
+
+// Cause implements the causer interface.
+func (e *PasswordPromptError) Cause() error {
+	return e.Err
+}
+
+// ReadPassword prompts for a password.
+// This is meant to be used when using a password.
+//
+// If the input is not a terminal, e.g. when the password is piped into it,
+// the password is read from its first line instead, without a prompt.
+func (p *Prompter) ReadPassword() (string, error) {
+	return p.ReadPasswordCtx(context.Background())
+}
+
+// ReadPasswordBytes is like ReadPassword, but returns the password as a
+// SecureString, which the caller should clear with Destroy once it has been
+// hashed or sent. The buffers used to read it are zeroed.
+func (p *Prompter) ReadPasswordBytes() (SecureString, error) {
+	return p.ReadPasswordBytesCtx(context.Background())
+}
+
+// ReadPasswordCtx is like ReadPassword, but gives up with a
+// *PasswordPromptError when the context is canceled or times out before the
+// password is entered, e.g. so that unattended invocations do not hang. In
+// that case, the echo of the terminal is restored, but the abandoned read
+// still consumes the next line of input. A password entered as the context
+// expires is returned.
+func (p *Prompter) ReadPasswordCtx(ctx context.Context) (string, error) {
+	password, err := p.ReadPasswordBytesCtx(ctx)
+	defer password.Destroy()
+	return string(password), err
+}
+
+// ReadPasswordBytesCtx is like ReadPasswordCtx, but returns the password as
+// a SecureString, like ReadPasswordBytes. The password of an abandoned read
+// is zeroed once read.
+func (p *Prompter) ReadPasswordBytesCtx(ctx context.Context) (SecureString, error) {
+	if !p.isTerminal() {
+		password, err := p.readPasswordCtx(ctx)
+		return p.lockPassword(password), err
+	}
+	prompt, _ := p.prompts()
+	p.print(prompt)
+	password, err := p.readPasswordCtx(ctx)
+	if _, ok := err.(*PasswordPromptError); ok {
+		p.print("\n")
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Make sure the output moves on to the next line.
+	p.print("\n")
+
+	return p.lockPassword(password), nil
+}
+
+// readPasswordCtx is like readPassword, but gives up with a
+// *PasswordPromptError when the context is done. The read then continues in
+// the background, since reads from the terminal cannot be interrupted.
+func (p *Prompter) readPasswordCtx(ctx context.Context) ([]byte, error) {
+	if ctx.Done() == nil {
+		return p.readPassword()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, &PasswordPromptError{Err: err}
+	}
+	type result struct {
+		password []byte
+		err      error
+	}
+	// Save the state of the terminal, so that e.g. echo can be turned back
+	// on if the read, which turned it off, is abandoned.
+	restore := func() {}
+	if p.saveTerminalState != nil {
+		restore = p.saveTerminalState()
+	}
+	ch := make(chan result, 1)
+	go func() {
+		password, err := p.readPassword()
+		ch <- result{password, err}
+	}()
+	select {
+	case r := <-ch:
+		return r.password, r.err
+	case <-ctx.Done():
+		// Prefer a password read concurrently.
+		select {
+		case r := <-ch:
+			return r.password, r.err
+		default:
+		}
+		restore()
+		go func() {
+			ZeroBytes((<-ch).password)
+		}()
+		return nil, &PasswordPromptError{Err: ctx.Err()}
+	}
+}
+
+// ReadNewPassword prompts for a password twice, returning the read string if
+// they match, or ErrPasswordConfirmationMismatch.
+// This is meant to be used when setting a password: the whitespace mode of the
+// password policy installed with SetPasswordPolicy is applied to it, and a
+// warning is printed if it is weak (see WithStrengthCheck).
+//
+// If the input is not a terminal, the password is read from its first line
+// instead, once and without prompts.
+func (p *Prompter) ReadNewPassword() (string, error) {
+	return p.ReadNewPasswordWithRetries(1)
+}
+
+// ReadNewPasswordBytes is like ReadNewPassword, but returns the password as
+// a SecureString, like ReadPasswordBytes. The confirmation, and rejected
+// passwords, are zeroed.
+func (p *Prompter) ReadNewPasswordBytes() (SecureString, error) {
+	return p.ReadNewPasswordBytesWithRetries(1)
+}
+
+// ErrTooManyPasswordAttempts is the cause of TooManyPasswordAttemptsError.
+var ErrTooManyPasswordAttempts = errors.New("too many password attempts")
+
+// TooManyPasswordAttemptsError is returned by ReadNewPasswordWithRetries
+// when no attempt produced a password. Its cause is
+// ErrTooManyPasswordAttempts.
+type TooManyPasswordAttemptsError struct {
+	// Attempts is the number of attempts, and Err the failure of the last
+	// one, e.g. ErrEmptyPassword.
+	Attempts int
+	Err      error
+	// message, if set, is the TooManyAttempts message of the Prompter.
+	message string
+}
+
+func (e *TooManyPasswordAttemptsError) Error() string {
+	message := e.message
+	if message == "" {
+		message = englishPromptMessages.tooManyAttempts(e.Attempts)
+	}
+	return fmt.Sprintf("%s: %v", message, e.Err)
+}
+
+// Cause implements the causer interface.
+func (e *TooManyPasswordAttemptsError) Cause() error {
+	return ErrTooManyPasswordAttempts
+}
+
+// ErrPasswordConfirmationMismatch is returned by ReadNewPassword when the
+// confirmation of the password differs from it.
+var ErrPasswordConfirmationMismatch = errors.New("password mismatch")
+
+// ReadNewPasswordWithRetries is like ReadNewPassword, but when the
+// confirmation does not match the password, the password is empty, or it is
+// rejected by WithStrengthCheck in StrengthEnforce mode or by the policy of
+// WithPasswordPolicy, it prompts for both again, up to maxAttempts times in
+// total, before giving up with a *TooManyPasswordAttemptsError. All the
+// violations of the policy are printed first, a line each. With
+// maxAttempts of 1 or less, it behaves like ReadNewPassword. Other errors,
+// e.g. when the input is closed, are returned immediately, and piped
+// passwords, which can only be read once, are never retried.
+func (p *Prompter) ReadNewPasswordWithRetries(maxAttempts int) (string, error) {
+	password, err := p.ReadNewPasswordBytesWithRetries(maxAttempts)
+	defer password.Destroy()
+	return string(password), err
+}
+
+// ReadNewPasswordBytesWithRetries is like ReadNewPasswordWithRetries, but
+// returns the password as a SecureString, like ReadNewPasswordBytes.
+func (p *Prompter) ReadNewPasswordBytesWithRetries(maxAttempts int) (SecureString, error) {
+	if p.keepCurrent && p.policy != nil {
+		return nil, errKeepCurrentWithPolicy
+	}
+	var one []byte
+	for attempt := 1; ; attempt++ {
+		var err error
+		one, err = p.readPasswordTwice()
+		if err == nil {
+			break
+		}
+		_, weak := err.(*PasswordTooWeakError)
+		_, violation := err.(*PolicyViolations)
+		violation = violation || err == ErrPasswordUnchanged
+		if maxAttempts <= 1 || !p.isTerminal() ||
+			(err != ErrEmptyPassword && err != ErrPasswordConfirmationMismatch && !weak && !violation) {
+			return nil, err
+		}
+		m := p.messages()
+		if attempt >= maxAttempts {
+			return nil, &TooManyPasswordAttemptsError{
+				Attempts: attempt, Err: err, message: m.tooManyAttempts(attempt),
+			}
+		}
+		switch {
+		case err == ErrEmptyPassword || violation:
+			// The password was not followed by a newline.
+			p.print("\n" + m.retry(err) + "\n")
+		case weak:
+			p.print(m.TooWeak + "\n")
+		default:
+			p.print(m.Mismatch + "\n")
+		}
+	}
+	policy := p.newPasswordPolicy()
+	password := policy.trimWhitespace(one)
+	if err := policy.checkWhitespace(password); err != nil {
+		ZeroBytes(one)
+		return nil, err
+	}
+	if len(password) == 0 {
+		ZeroBytes(one)
+		return nil, ErrEmptyPassword
+	}
+	if len(password) < len(one) {
+		// Zero the whitespace trimmed, and the excess capacity of the
+		// password.
+		password = append([]byte(nil), password...)
+		ZeroBytes(one)
+	}
+	return p.lockPassword(password), nil
+}
+
+// readPasswordTwice reads a non-empty password and its confirmation from the
+// terminal, unless WithoutConfirmation was given, or reads it once from a
+// non-terminal input, and validates it with the policy of
+// WithPasswordPolicy, if any.
+func (p *Prompter) readPasswordTwice() ([]byte, error) {
+	interactive := p.isTerminal()
+	prompt, confirmPrompt := p.prompts()
+	if interactive {
+		p.print(prompt)
+	}
+	one, err := p.readPassword()
+	if err != nil {
+		return nil, err
+	}
+	if len(one) == 0 {
+		// The line ending may remain past the end of the password.
+		ZeroBytes(one[:cap(one)])
+		if p.keepCurrent {
+			if interactive {
+				// Make sure the output moves on to the next line.
+				p.print("\n")
+			}
+			return nil, ErrKeepCurrentPassword
+		}
+		return nil, ErrEmptyPassword
+	}
+	var warnings *PolicyViolations
+	if p.policy != nil {
+		// The request to the Pwned Passwords service, if any, is bounded by
+		// the timeout of the checker.
+		err := p.policy.validate(context.Background(), p.policy.trimWhitespace(one), nil)
+		if v, ok := err.(*PolicyViolations); ok && interactive &&
+			p.policy.WarnOnExpensiveViolations && v.expensive() {
+			warnings = v
+		} else if err != nil {
+			ZeroBytes(one)
+			return nil, err
+		}
+	}
+	if p.current != nil &&
+		subtle.ConstantTimeCompare(p.newPasswordPolicy().trimWhitespace(one), p.current) == 1 {
+		ZeroBytes(one)
+		return nil, ErrPasswordUnchanged
+	}
+	if !interactive {
+		return one, nil
+	}
+	if err := p.checkStrength(one); err != nil {
+		ZeroBytes(one)
+		return nil, err
+	}
+	if warnings != nil {
+		// Typing the password again accepts the warnings, and confirms it.
+		m := p.messages()
+		p.print("\n" + m.warning(warnings.Error()) + "\n" + m.AcceptWarningsPrompt)
+	} else if p.noConfirm {
+		// Make sure the output moves on to the next line.
+		p.print("\n")
+		return one, nil
+	} else {
+		p.print("\n" + confirmPrompt)
+	}
+	two, err := p.readPassword()
+	if err != nil {
+		ZeroBytes(one)
+		return nil, err
+	}
+	// Make sure the output moves on to the next line.
+	p.print("\n")
+	// Both passwords are secret: compare them without leaking the length of
+	// their common prefix.
+	match := subtle.ConstantTimeCompare(one, two) == 1
+	ZeroBytes(two)
+	if !match {
+		ZeroBytes(one)
+		return nil, ErrPasswordConfirmationMismatch
+	}
+	return one, nil
+}
+
+// newPasswordPolicy returns the policy whose whitespace mode applies to new
+// passwords: the policy of WithPasswordPolicy, or the one installed with
+// SetPasswordPolicy.
+func (p *Prompter) newPasswordPolicy() *PasswordPolicy {
+	if p.policy != nil {
+		return p.policy
+	}
+	return activePasswordPolicy()
+}
+
+// checkStrength warns about a weak new password, which is rejected with a
+// *PasswordTooWeakError in StrengthEnforce mode.
+func (p *Prompter) checkStrength(password []byte) error {
+	minScore, mode := p.minScore, p.strengthMode
+	if minScore == 0 {
+		minScore, mode = int(atomic.LoadInt32(&passwordWarningScore)), StrengthWarn
+	}
+	if minScore == 0 {
+		return nil
+	}
+	score, feedback := ScorePassword(string(password), nil)
+	if score >= minScore {
+		return nil
+	}
+	// The first suggestion is the most specific one. Passwords with a good
+	// score have none.
+	m := p.messages()
+	id := FeedbackAddAnotherWord
+	if len(feedback) > 0 {
+		id, _ = SplitFeedback(feedback[0])
+	}
+	p.print("\n" + m.warning(m.feedback(id)))
+	if mode == StrengthEnforce {
+		p.print("\n")
+		return &PasswordTooWeakError{Required: minScore, Score: score}
+	}
+	return nil
+}
+
+// PromptForExistingPassword prompts for an existing password, e.g. to log
+// in, on stdin, writing the prompt to stderr, so that it does not mix with
+// the output of the command, e.g. redirected to a file. An empty password is
+// returned as is, for the server to accept or reject. See
+// Prompter.ReadPassword.
+func PromptForExistingPassword() (string, error) {
+	return defaultPrompter().ReadPassword()
+}
+
+// PromptForNewPassword prompts for a new password twice on stdin, writing
+// "Enter new password: " and "Confirm new password: " to stderr. Empty
+// passwords, and passwords which violate the policy, are rejected before
+// asking for their confirmation, and prompted for again, up to 3 times in
+// total. If policy is nil, the policy installed with SetPasswordPolicy is
+// used. See Prompter.ReadNewPasswordWithRetries.
+func PromptForNewPassword(policy *PasswordPolicy) (string, error) {
+	return PromptForNewPasswordWithOpts(PromptOpts{Confirm: true, Policy: policy})
+}
+
+// PromptOpts configures PromptForNewPasswordWithOpts.
+type PromptOpts struct {
+	// Confirm makes the prompt ask for the password a second time, for
+	// confirmation. Piped passwords are read once regardless.
+	Confirm bool
+	// MinLength, if greater than the minimum length of the policy, is the
+	// minimum length of the password, in characters.
+	MinLength int
+	// Policy validates the password. If nil, the policy installed with
+	// SetPasswordPolicy is used.
+	Policy *PasswordPolicy
+	// Prompt and ConfirmPrompt, if set, replace the NewPasswordPrompt and
+	// ConfirmNewPasswordPrompt messages, "Enter new password: " and "Confirm
+	// new password: " in English.
+	Prompt, ConfirmPrompt string
+	// MaxAttempts is the number of times the password is prompted for,
+	// when it is rejected, before giving up. The default is 3.
+	MaxAttempts int
+}
+
+// PromptForNewPasswordWithOpts is like PromptForNewPassword, configured with
+// opts, e.g. to prompt for the password once, without confirmation, when it
+// is scripted. Empty passwords, and passwords which violate the policy, are
+// rejected whether or not they are confirmed.
+func PromptForNewPasswordWithOpts(opts PromptOpts) (string, error) {
+	policy := opts.Policy
+	if policy == nil {
+		policy = activePasswordPolicy()
+	}
+	if opts.MinLength > policy.MinLength {
+		withMinLength := *policy
+		withMinLength.MinLength = opts.MinLength
+		policy = &withMinLength
+		if err := policy.check(); err != nil {
+			return "", err
+		}
+	}
+	prompterOpts := []PrompterOption{withNewPasswordPrompts(),
+		WithPrompts(opts.Prompt, opts.ConfirmPrompt), WithPasswordPolicy(policy)}
+	if !opts.Confirm {
+		prompterOpts = append(prompterOpts, WithoutConfirmation())
+	}
+	maxAttempts := opts.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = newPasswordAttempts
+	}
+	return defaultPrompter(prompterOpts...).ReadNewPasswordWithRetries(maxAttempts)
+}
+
+// PromptForPassword prompts for a password on stdin, writing the prompt to
+// stderr.
+//
+// Deprecated: use PromptForExistingPassword.
+func PromptForPassword() (string, error) {
+	return PromptForExistingPassword()
+}
+
+// PromptForPasswordWithPrompt is like PromptForPassword, displaying the
+// given prompt, e.g. "Enter password for user alice: ", instead of the
+// default one.
+func PromptForPasswordWithPrompt(prompt string) (string, error) {
+	return defaultPrompter(WithPrompts(prompt, "")).ReadPassword()
+}
+
+// PromptForPasswordCtx is like PromptForPasswordWithPrompt, but gives up when
+// the context is done. See Prompter.ReadPasswordCtx.
+func PromptForPasswordCtx(ctx context.Context, prompt string) (string, error) {
+	p := defaultPrompter(WithPrompts(prompt, ""))
+	return p.ReadPasswordCtx(ctx)
+}
+
+// PromptForPasswordTwice prompts for a password twice on stdin, writing the
+// prompts to stderr. See Prompter.ReadNewPassword.
+//
+// Deprecated: use PromptForNewPassword, which also validates the password.
+func PromptForPasswordTwice() (string, error) {
+	return defaultPrompter(withoutKeepingCurrent()).ReadNewPassword()
+}
+
+// PromptForPasswordTwiceWithPrompt is like PromptForPasswordTwice,
+// displaying the given prompts for the password and its confirmation
+// instead of the default ones.
+func PromptForPasswordTwiceWithPrompt(prompt, confirmPrompt string) (string, error) {
+	p := defaultPrompter(WithPrompts(prompt, confirmPrompt), withoutKeepingCurrent())
+	return p.ReadNewPassword()
+}
+
+// PromptForPasswordTwiceWithRetries is like PromptForPasswordTwice, but
+// prompts again, up to maxAttempts times in total, when the confirmation
+// does not match the password or the password is empty. See
+// Prompter.ReadNewPasswordWithRetries.
+func PromptForPasswordTwiceWithRetries(maxAttempts int) (string, error) {
+	return defaultPrompter(withoutKeepingCurrent()).ReadNewPasswordWithRetries(maxAttempts)
+}
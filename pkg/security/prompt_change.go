@@ -0,0 +1,93 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import "github.com/pkg/errors"
+
+// passwordChangeAttempts is the number of attempts of PromptPasswordChange
+// at each of the current and new passwords.
+const passwordChangeAttempts = 3
+
+// ErrPasswordUnchanged is returned by PromptPasswordChange when the new
+// password is the current one.
+var ErrPasswordUnchanged = errors.New("the new password must differ from the current one")
+
+// PromptPasswordChange prompts for the current password, which verifyCurrent
+// checks, e.g. by trying to log in with it, then for a new password and its
+// confirmation, and returns the new password.
+//
+// The current password is prompted for again when verifyCurrent rejects it,
+// and the new one when it does not match its confirmation, violates the
+// policy, or is the current password, in which case ErrPasswordUnchanged is
+// printed, up to 3 times each before giving up with a
+// *TooManyPasswordAttemptsError. If policy is nil, the policy installed
+// with SetPasswordPolicy is used. If prompter is nil, the prompts are on
+// stdin and stderr.
+//
+// If the input is not a terminal, the current and new passwords are read
+// from its first two lines, without confirmation nor retries.
+func PromptPasswordChange(
+	prompter *Prompter, policy *PasswordPolicy, verifyCurrent func(string) error,
+) (newPassword string, err error) {
+	if prompter == nil {
+		prompter = defaultPrompter()
+	}
+	current, err := promptCurrentPassword(prompter, verifyCurrent)
+	defer ZeroBytes(current)
+	if err != nil {
+		return "", err
+	}
+
+	if policy == nil {
+		policy = activePasswordPolicy()
+	}
+	p := *prompter
+	p.prompt, p.confirmPrompt, p.newPassword = "", "", true
+	p.policy = policy
+	p.current = current
+	password, err := p.ReadNewPasswordBytesWithRetries(passwordChangeAttempts)
+	defer password.Destroy()
+	return string(password), err
+}
+
+// promptCurrentPassword prompts for the current password until verifyCurrent
+// accepts it.
+func promptCurrentPassword(
+	prompter *Prompter, verifyCurrent func(string) error,
+) ([]byte, error) {
+	p := *prompter
+	m := p.messages()
+	p.prompt = m.CurrentPasswordPrompt
+	for attempt := 1; ; attempt++ {
+		current, err := p.ReadPasswordBytes()
+		if err != nil {
+			return nil, err
+		}
+		err = verifyCurrent(string(current))
+		if err == nil {
+			return current, nil
+		}
+		ZeroBytes(current)
+		if !p.isTerminal() {
+			return nil, err
+		}
+		if attempt >= passwordChangeAttempts {
+			return nil, &TooManyPasswordAttemptsError{
+				Attempts: attempt, Err: err, message: m.tooManyAttempts(attempt),
+			}
+		}
+		p.print(m.retry(err) + "\n")
+	}
+}
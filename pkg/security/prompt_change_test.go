@@ -0,0 +1,123 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestPromptPasswordChange(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	var (
+		current  = messages.CurrentPasswordPrompt + "\n"
+		enter    = messages.NewPasswordPrompt
+		confirm  = "\n" + messages.ConfirmNewPasswordPrompt + "\n"
+		failed   = retryMessage("authentication failed") + "\n"
+		same     = "\n" + retryMessage(security.ErrPasswordUnchanged.Error()) + "\n"
+		mismatch = messages.Mismatch + "\n"
+		short    = "\n" + retryMessage(
+			"password is too short: it must have at least 8 characters, got 7") + "\n"
+	)
+	verify := func(password string) error {
+		if password != "x7#kq!9v" {
+			return errors.New("authentication failed")
+		}
+		return nil
+	}
+	policy := security.NewPasswordPolicy(security.WithMinLength(8))
+	for _, tc := range []struct {
+		passwords []string
+		expected  string
+		err       string
+		out       string
+	}{
+		{[]string{"x7#kq!9v", "x7#kq!9vZp", "x7#kq!9vZp"}, "x7#kq!9vZp", "",
+			current + enter + confirm},
+		{[]string{"x7#kq!9v", "x7#kq!9vZp", "x7#kq!9vZ", "x7#kq!9vZp", "x7#kq!9vZp"}, "x7#kq!9vZp",
+			"", current + enter + confirm + mismatch + enter + confirm},
+		{[]string{"hunter2", "", "x7#kq!9v", "hunter2", "x7#kq!9v", "x7#kq!9vZp", "x7#kq!9vZp"},
+			"x7#kq!9vZp", "",
+			current + failed + current + failed + current + enter + short + enter + same + enter +
+				confirm},
+		{[]string{"hunter2", "hunter3", "hunter4"}, "",
+			"giving up after 3 attempts: authentication failed",
+			current + failed + current + failed + current},
+		{[]string{"x7#kq!9v", "x7#kq!9v", "x7#kq!9v", "x7#kq!9v"}, "",
+			"giving up after 3 attempts: the new password must differ from the current one",
+			current + enter + same + enter + same + enter},
+		{[]string{"x7#kq!9v"}, "", "EOF", current + enter},
+	} {
+		var out bytes.Buffer
+		p := security.NewTestPrompter(tc.passwords, security.WithPromptOutput(&out))
+		password, err := security.PromptPasswordChange(p, policy, verify)
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%q: expected %q, got %v", tc.passwords, tc.err, err)
+			}
+		} else if err != nil || password != tc.expected {
+			t.Errorf("%q: expected %q, got %q, %v", tc.passwords, tc.expected, password, err)
+		}
+		if out.String() != tc.out {
+			t.Errorf("%q: expected output %q, got %q", tc.passwords, tc.out, out.String())
+		}
+	}
+
+	// The error is typed, and the new password is compared once trimmed.
+	p := security.NewPrompter(strings.NewReader("x7#kq!9v\n x7#kq!9v \n"), ioutil.Discard)
+	trim := security.NewPasswordPolicy(security.WithWhitespaceMode(security.WhitespaceTrim))
+	_, err := security.PromptPasswordChange(p, trim, verify)
+	if err != security.ErrPasswordUnchanged {
+		t.Errorf("expected ErrPasswordUnchanged, got %v", err)
+	}
+
+	// Piped passwords are read from two lines, without confirmation nor
+	// retries.
+	for _, tc := range []struct {
+		in       string
+		expected string
+		err      string
+		rest     string
+	}{
+		{in: "x7#kq!9v\nx7#kq!9vZp\nSELECT 1;\n", expected: "x7#kq!9vZp", rest: "SELECT 1;\n"},
+		{in: "hunter2\nx7#kq!9vZp\n", err: "^authentication failed$", rest: "x7#kq!9vZp\n"},
+		{in: "x7#kq!9v\nx7#kq!9v\nx7#kq!9vZp\n", err: "^the new password must differ",
+			rest: "x7#kq!9vZp\n"},
+		{in: "x7#kq!9v\nhunter2\n", err: "^password is too short"},
+	} {
+		in := strings.NewReader(tc.in)
+		p := security.NewPrompter(in, ioutil.Discard)
+		password, err := security.PromptPasswordChange(p, policy, verify)
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%q: expected %q, got %v", tc.in, tc.err, err)
+			}
+		} else if err != nil || password != tc.expected {
+			t.Errorf("%q: expected %q, got %q, %v", tc.in, tc.expected, password, err)
+		}
+		if rest, _ := ioutil.ReadAll(in); string(rest) != tc.rest {
+			t.Errorf("%q: expected %q to remain, got %q", tc.in, tc.rest, rest)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bytes"
+	"io"
+	"sync/atomic"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// errMalformedUTF16 is returned for UTF-16 inputs which cannot be decoded.
+var errMalformedUTF16 = errors.New("malformed UTF-16 password input")
+
+// passwordLineReader reads passwords from the lines of a non-terminal input,
+// e.g. piped into stdin. The input is UTF-8, unless it starts with the byte
+// order mark of UTF-16LE, as written by e.g. PowerShell, in which case it is
+// transcoded to UTF-8. A UTF-8 byte order mark is skipped.
+//
+// It reads one byte at a time, so that the rest of the input, e.g. SQL
+// statements, can still be read by the caller.
+type passwordLineReader struct {
+	r io.Reader
+	// started is set once the byte order mark, if any, has been read, and
+	// pending holds the bytes read while looking for it.
+	started bool
+	pending []byte
+	utf16   bool
+	// consumed is set once a byte has been read from the input.
+	consumed bool
+	// char holds the last character read.
+	char [utf8.UTFMax]byte
+}
+
+// readPasswordLine reads a password from the first line of a non-terminal
+// input, of at most the maximum length set by SetMaxPasswordLength; see
+// passwordLineReader.readLine.
+func readPasswordLine(r io.Reader) ([]byte, error) {
+	l := passwordLineReader{r: r}
+	return l.readLine(int(atomic.LoadInt32(&maxPasswordLength)))
+}
+
+// readLine reads a password from a line, without its line ending; see
+// trimLineEnding. The last line need not end with a newline. Lines longer
+// than limit bytes are rejected with a *PasswordTooLongError: the rest of
+// the line is then read and discarded, so that at most limit bytes, plus the
+// line ending, are ever kept in memory, and the next line can still be read.
+func (l *passwordLineReader) readLine(limit int) ([]byte, error) {
+	if !l.started {
+		if err := l.readByteOrderMark(); err != nil {
+			return nil, err
+		}
+	}
+	var line []byte
+	for {
+		c, err := l.readChar()
+		if err == io.EOF && len(line) > 0 {
+			break
+		}
+		if err != nil {
+			ZeroBytes(line)
+			return nil, err
+		}
+		newline := len(c) == 1 && c[0] == '\n'
+		// The line can end with "\r\n".
+		if !newline && len(line)+len(c) > limit+1 {
+			length := l.discardLine(len(line)+len(c), c[len(c)-1])
+			ZeroBytes(line)
+			ZeroBytes(c)
+			return nil, &PasswordTooLongError{Max: limit, Actual: length}
+		}
+		line = appendSecret(line, c)
+		ZeroBytes(c)
+		if newline {
+			break
+		}
+	}
+	line = trimLineEnding(line)
+	if len(line) > limit {
+		ZeroBytes(line)
+		return nil, &PasswordTooLongError{Max: limit, Actual: len(line)}
+	}
+	return line, nil
+}
+
+// discardLine reads and discards the rest of a line, one character at a
+// time, and returns the length the line would have had, without its line
+// ending, given the length read so far and its last byte. It stops at the
+// end, or on any error, of the input.
+func (l *passwordLineReader) discardLine(length int, last byte) int {
+	for {
+		c, err := l.readChar()
+		if err != nil {
+			return length
+		}
+		if len(c) == 1 && c[0] == '\n' {
+			if last == '\r' {
+				length--
+			}
+			return length
+		}
+		length += len(c)
+		last = c[len(c)-1]
+		ZeroBytes(c)
+	}
+}
+
+// trimLineEnding strips exactly one trailing "\n" or "\r\n" from a line of
+// a non-terminal input, if present, and nothing else: neither other
+// whitespace, e.g. a "\r" which is not followed by "\n", nor the newlines
+// before it. Passwords which end with a newline can only be read from files,
+// with RawPasswordFile.
+func trimLineEnding(line []byte) []byte {
+	n := len(line)
+	if n == 0 || line[n-1] != '\n' {
+		return line
+	}
+	n--
+	if n > 0 && line[n-1] == '\r' {
+		n--
+	}
+	return line[:n]
+}
+
+// The byte order marks of UTF-16LE and UTF-8.
+var (
+	utf16LEByteOrderMark = []byte{0xff, 0xfe}
+	utf8ByteOrderMark    = []byte{0xef, 0xbb, 0xbf}
+)
+
+// readByteOrderMark reads the byte order mark at the start of the input, if
+// any, which determines its encoding.
+func (l *passwordLineReader) readByteOrderMark() error {
+	l.started = true
+	if ok, err := l.skipPrefix(utf16LEByteOrderMark); err != nil || ok {
+		l.utf16 = ok
+		return err
+	}
+	_, err := l.skipPrefix(utf8ByteOrderMark)
+	return err
+}
+
+// skipPrefix reads the input while it matches prefix, and skips it if it
+// does. Otherwise, the bytes read are kept in l.pending.
+func (l *passwordLineReader) skipPrefix(prefix []byte) (bool, error) {
+	for len(l.pending) < len(prefix) {
+		if !bytes.Equal(l.pending, prefix[:len(l.pending)]) {
+			return false, nil
+		}
+		b, err := l.readInputByte()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		l.pending = append(l.pending, b)
+	}
+	if !bytes.Equal(l.pending, prefix) {
+		return false, nil
+	}
+	l.pending = l.pending[:0]
+	return true, nil
+}
+
+// readChar reads a character, returned in UTF-8.
+func (l *passwordLineReader) readChar() ([]byte, error) {
+	if !l.utf16 {
+		b, err := l.readByte()
+		if err != nil {
+			return nil, err
+		}
+		l.char[0] = b
+		return l.char[:1], nil
+	}
+	unit, err := l.readUTF16Unit()
+	if err != nil {
+		return nil, err
+	}
+	r := rune(unit)
+	if utf16.IsSurrogate(r) {
+		unit2, err := l.readUTF16Unit()
+		if err == io.EOF {
+			return nil, errMalformedUTF16
+		}
+		if err != nil {
+			return nil, err
+		}
+		if r = utf16.DecodeRune(r, rune(unit2)); r == utf8.RuneError {
+			return nil, errMalformedUTF16
+		}
+	}
+	n := utf8.EncodeRune(l.char[:], r)
+	return l.char[:n], nil
+}
+
+// readUTF16Unit reads a little-endian UTF-16 code unit.
+func (l *passwordLineReader) readUTF16Unit() (uint16, error) {
+	lo, err := l.readByte()
+	if err != nil {
+		return 0, err
+	}
+	hi, err := l.readByte()
+	if err == io.EOF {
+		return 0, errMalformedUTF16
+	}
+	if err != nil {
+		return 0, err
+	}
+	return uint16(lo) | uint16(hi)<<8, nil
+}
+
+// readByte reads a byte, starting with the pending ones.
+func (l *passwordLineReader) readByte() (byte, error) {
+	if len(l.pending) > 0 {
+		b := l.pending[0]
+		l.pending = l.pending[1:]
+		return b, nil
+	}
+	return l.readInputByte()
+}
+
+// readInputByte reads a byte from the input.
+func (l *passwordLineReader) readInputByte() (byte, error) {
+	var b [1]byte
+	for {
+		n, err := l.r.Read(b[:])
+		if n == 1 {
+			l.consumed = true
+			return b[0], nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+// openPty opens a pseudo-terminal, returning its master and slave ends.
+func openPty() (master, slave *os.File, _ error) {
+	m, err := unix.Open("/dev/ptmx", unix.O_RDWR|unix.O_NOCTTY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	master = os.NewFile(uintptr(m), "/dev/ptmx")
+	if err := unix.IoctlSetPointerInt(m, unix.TIOCSPTLCK, 0); err != nil {
+		_ = master.Close()
+		return nil, nil, err
+	}
+	n, err := unix.IoctlGetInt(m, unix.TIOCGPTN)
+	if err != nil {
+		_ = master.Close()
+		return nil, nil, err
+	}
+	name := fmt.Sprintf("/dev/pts/%d", n)
+	s, err := unix.Open(name, unix.O_RDWR|unix.O_NOCTTY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		_ = master.Close()
+		return nil, nil, err
+	}
+	return master, os.NewFile(uintptr(s), name), nil
+}
+
+// promptProcessEnv is set when a test runs itself to prompt for a password
+// on a pseudo-terminal. Its value is the kind of the prompt.
+const promptProcessEnv = "COCKROACH_TEST_PROMPT_PROCESS"
+
+// maybeRunPromptProcess prompts for a password and exits, if the test was
+// started by startPromptProcess.
+func maybeRunPromptProcess() {
+	var password string
+	var err error
+	switch os.Getenv(promptProcessEnv) {
+	case "":
+		return
+	case "masked":
+		password, err = security.NewPrompter(os.Stdin, os.Stderr,
+			security.WithMaskRune('*')).ReadPassword()
+	case "echoed":
+		p := security.NewPrompter(os.Stdin, os.Stderr)
+		var username string
+		if username, err = p.ReadLineEchoed("Enter username: "); err == nil {
+			password, err = p.ReadPassword()
+		}
+		password = username + "/" + password
+	case "redirected":
+		password, err = security.PromptForExistingPassword()
+	case "redirected without fallback":
+		password, err = security.NewPrompter(os.Stdin, os.Stderr,
+			security.WithoutTerminalFallback()).ReadPassword()
+	default:
+		password, err = security.PromptForPassword()
+	}
+	fmt.Fprintf(os.Stderr, "\nreturned %q, %v\n", password, err)
+	if strings.HasPrefix(os.Getenv(promptProcessEnv), "redirected") {
+		// What remains of the redirected stdin.
+		rest, err := ioutil.ReadAll(os.Stdin)
+		fmt.Fprintf(os.Stderr, "stdin %q, %v\n", rest, err)
+	}
+	os.Exit(0)
+}
+
+// promptProcess is a test process prompting for a password on a
+// pseudo-terminal. The prompts are written to its stderr, and nothing to its
+// stdout.
+type promptProcess struct {
+	cmd            *exec.Cmd
+	stdout, stderr io.Reader
+	master, slave  *os.File
+}
+
+// startPromptProcess runs the test in a process prompting for a password on
+// a pseudo-terminal, and waits for the prompt to be written.
+func startPromptProcess(t *testing.T, kind string, prompt string) *promptProcess {
+	return startRedirectedPromptProcess(t, kind, prompt, nil)
+}
+
+// startRedirectedPromptProcess is like startPromptProcess, but if stdin is
+// not nil, it is the stdin of the process, and the pseudo-terminal is only
+// its controlling terminal.
+func startRedirectedPromptProcess(
+	t *testing.T, kind string, prompt string, stdin io.Reader,
+) *promptProcess {
+	master, slave, err := openPty()
+	if err != nil {
+		t.Skipf("cannot open a pseudo-terminal: %v", err)
+	}
+	p := &promptProcess{master: master, slave: slave}
+	if lflag := p.lflag(t); lflag&unix.ECHO == 0 || lflag&unix.ICANON == 0 {
+		t.Fatalf("expected echo and canonical mode to be on, got %x", lflag)
+	}
+	p.cmd = exec.Command(os.Args[0], "-test.run=^"+t.Name()+"$")
+	p.cmd.Env = append(os.Environ(), promptProcessEnv+"="+kind)
+	p.cmd.Stdin = slave
+	if stdin != nil {
+		p.cmd.Stdin = stdin
+		// The first of the extra files is the descriptor 3 of the process.
+		p.cmd.ExtraFiles = []*os.File{slave}
+		p.cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true, Ctty: 3}
+	}
+	if p.stdout, err = p.cmd.StdoutPipe(); err != nil {
+		t.Fatal(err)
+	}
+	if p.stderr, err = p.cmd.StderrPipe(); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if prompt != "" {
+		p.waitForOutput(t, prompt)
+	}
+	return p
+}
+
+// waitForOutput reads the prompts of the process, which must be expected.
+func (p *promptProcess) waitForOutput(t *testing.T, expected string) {
+	out := make([]byte, len(expected))
+	if _, err := io.ReadFull(p.stderr, out); err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != expected {
+		t.Fatalf("expected output %q, got %q", expected, out)
+	}
+}
+
+// lflag returns the local modes of the terminal, e.g. unix.ECHO.
+func (p *promptProcess) lflag(t *testing.T) uint32 {
+	termios, err := unix.IoctlGetTermios(int(p.slave.Fd()), unix.TCGETS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return termios.Lflag
+}
+
+// waitForLflagOff waits for the local modes in mask to be unset.
+func (p *promptProcess) waitForLflagOff(t *testing.T, mask uint32) {
+	testutils.SucceedsSoon(t, func() error {
+		if lflag := p.lflag(t); lflag&mask != 0 {
+			return errors.Errorf("expected %x to be off, got %x", mask, lflag)
+		}
+		return nil
+	})
+}
+
+// wait waits for the process to exit, and returns the rest of its prompts
+// and the signal which terminated it, if any. It checks that the terminal
+// was restored, and that nothing was written to stdout.
+func (p *promptProcess) wait(t *testing.T) (string, syscall.Signal) {
+	defer p.master.Close()
+	defer p.slave.Close()
+	out, err := ioutil.ReadAll(p.stderr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stdout, err := ioutil.ReadAll(p.stdout); err != nil || len(stdout) != 0 {
+		t.Errorf("expected no output on stdout, got %q, %v", stdout, err)
+	}
+	err = p.cmd.Wait()
+	var sig syscall.Signal
+	if status, ok := p.cmd.ProcessState.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		sig = status.Signal()
+	} else if err != nil {
+		t.Fatal(err)
+	}
+	if lflag := p.lflag(t); lflag&unix.ECHO == 0 || lflag&unix.ICANON == 0 {
+		t.Errorf("expected the terminal to be restored, got %x", lflag)
+	}
+	return string(out), sig
+}
+
+// TestPromptForPasswordInterrupted checks that the echo of the terminal,
+// turned off while a password is read, is restored when the prompt is
+// interrupted by SIGINT, which still terminates the process.
+func TestPromptForPasswordInterrupted(t *testing.T) {
+	maybeRunPromptProcess()
+	defer leaktest.AfterTest(t)()
+
+	p := startPromptProcess(t, "plain", "Enter password: ")
+	// Once the echo is off, the signal is handled.
+	p.waitForLflagOff(t, unix.ECHO)
+	if err := p.cmd.Process.Signal(os.Interrupt); err != nil {
+		t.Fatal(err)
+	}
+	if out, sig := p.wait(t); sig != syscall.SIGINT || out != "" {
+		t.Errorf("expected the prompt to be terminated by SIGINT, got %v and output %q", sig, out)
+	}
+}
+
+func TestPromptForPasswordMasked(t *testing.T) {
+	maybeRunPromptProcess()
+	defer leaktest.AfterTest(t)()
+
+	const erase = "\b \b"
+	for _, tc := range []struct {
+		// input is typed in chunks, each of which is read at once.
+		input []string
+		out   string
+		sig   syscall.Signal
+	}{
+		{
+			input: []string{"hunter2\r"},
+			out:   "*******\n\nreturned \"hunter2\", <nil>\n",
+		},
+		{
+			// Backspace and Delete erase a character, Ctrl-U all of them, and
+			// escape sequences are ignored. Pasted characters are masked
+			// once each, even when split across reads.
+			input: []string{"a", "b", "\x7f", "c", "\x1b[D", "\x1b", "OA", "dé\xe2", "\x82\xacf",
+				"\b", "\x15", "\x15", "\x7f", "x", "yéz\x01", "\r", "ignored\r"},
+			out: "**" + erase + "*" + "**" + "**" + erase +
+				erase + erase + erase + erase + erase + "*" + "***" +
+				"\n\nreturned \"xyéz\", <nil>\n",
+		},
+		{
+			// Ctrl-D on an empty line is the end of the input.
+			input: []string{"a", "\x7f", "\x04"},
+			out:   "*" + erase + "\nreturned \"\", EOF\n",
+		},
+		{
+			input: []string{"hunt", "\x03"},
+			out:   "****",
+			sig:   syscall.SIGINT,
+		},
+		{
+			// Too long passwords are discarded, and the rest of their line
+			// is not echoed.
+			input: []string{strings.Repeat("x", security.DefaultMaxPasswordLength-1) + "é",
+				"\x7fxx", "\r"},
+			out: strings.Repeat("*", security.DefaultMaxPasswordLength-1) +
+				"\nreturned \"\", password is too long: it must have at most 512 bytes, got 515\n",
+		},
+	} {
+		p := startPromptProcess(t, "masked", "Enter password: ")
+		// Once canonical mode is off, the input is no longer edited by the
+		// terminal.
+		p.waitForLflagOff(t, unix.ECHO|unix.ICANON)
+		for _, input := range tc.input {
+			if _, err := p.master.Write([]byte(input)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if out, sig := p.wait(t); out != tc.out || sig != tc.sig {
+			t.Errorf("%q: expected %q and %v, got %q and %v", tc.input, tc.out, tc.sig, out, sig)
+		}
+	}
+}
+
+// TestPrompterReadLineEchoedTerminal checks that lines read by ReadLineEchoed are
+// echoed by the terminal, unlike the passwords read next.
+func TestPrompterReadLineEchoedTerminal(t *testing.T) {
+	maybeRunPromptProcess()
+	defer leaktest.AfterTest(t)()
+
+	p := startPromptProcess(t, "echoed", "Enter username: ")
+	// The echo of the terminal is written to its master end.
+	echoed := make(chan []byte)
+	go func() {
+		b, _ := ioutil.ReadAll(p.master)
+		echoed <- b
+	}()
+	if lflag := p.lflag(t); lflag&unix.ECHO == 0 {
+		t.Fatalf("expected echo to be on, got %x", lflag)
+	}
+	if _, err := p.master.Write([]byte("alice\n")); err != nil {
+		t.Fatal(err)
+	}
+	p.waitForOutput(t, "Enter password: ")
+	p.waitForLflagOff(t, unix.ECHO)
+	if _, err := p.master.Write([]byte("hunter2\n")); err != nil {
+		t.Fatal(err)
+	}
+	out, sig := p.wait(t)
+	if expected := "\n\nreturned \"alice/hunter2\", <nil>\n"; out != expected || sig != 0 {
+		t.Errorf("expected %q, got %q and %v", expected, out, sig)
+	}
+	if b := <-echoed; string(b) != "alice\r\n" {
+		t.Errorf("expected the username to be echoed, got %q", b)
+	}
+}
+
+// TestPromptForPasswordRedirected checks that passwords are prompted for on
+// the controlling terminal when stdin is redirected, leaving stdin unread.
+func TestPromptForPasswordRedirected(t *testing.T) {
+	maybeRunPromptProcess()
+	defer leaktest.AfterTest(t)()
+
+	p := startRedirectedPromptProcess(t, "redirected", "Enter password: ",
+		strings.NewReader("SELECT 1;\n"))
+	p.waitForLflagOff(t, unix.ECHO)
+	if _, err := p.master.Write([]byte("hunter2\n")); err != nil {
+		t.Fatal(err)
+	}
+	const rest = "stdin \"SELECT 1;\\n\", <nil>\n"
+	out, sig := p.wait(t)
+	if expected := "\n\nreturned \"hunter2\", <nil>\n" + rest; out != expected || sig != 0 {
+		t.Errorf("expected %q, got %q and %v", expected, out, sig)
+	}
+
+	// The controlling terminal is not used with WithoutTerminalFallback.
+	p = startRedirectedPromptProcess(t, "redirected without fallback", "",
+		strings.NewReader("hunter2\nSELECT 1;\n"))
+	out, sig = p.wait(t)
+	if expected := "\nreturned \"hunter2\", <nil>\n" + rest; out != expected || sig != 0 {
+		t.Errorf("expected %q, got %q and %v", expected, out, sig)
+	}
+}
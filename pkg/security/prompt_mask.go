@@ -0,0 +1,212 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"io"
+	"os"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// errPasswordPromptInterrupted is returned by readRawPassword when Ctrl-C is
+// typed.
+var errPasswordPromptInterrupted = errors.New("password prompt interrupted")
+
+// The keys readRawPassword handles.
+const (
+	keyCtrlC     = 0x03
+	keyCtrlD     = 0x04
+	keyBackspace = 0x08
+	keyLineFeed  = '\n'
+	keyEnter     = '\r'
+	keyCtrlU     = 0x15
+	keyEscape    = 0x1b
+	keyDelete    = 0x7f
+)
+
+// readRawPassword reads a password from the terminal in raw mode, so that it
+// is read without echo, into buffers which are zeroed. If mask is set, it
+// is echoed to out for each character, and erased when they are deleted
+// with Backspace, Delete or Ctrl-U. Escape sequences, e.g. of arrow keys,
+// and other control characters are ignored. ok is false if the terminal
+// cannot be put in raw mode, in which case nothing was read. The caller
+// restores the state of the terminal.
+//
+// Ctrl-C, which does not raise SIGINT in raw mode, returns
+// errPasswordPromptInterrupted, and Ctrl-D on an empty line io.EOF. Once
+// the password is longer than limit bytes, the characters typed are no
+// longer kept, nor echoed, and a *PasswordTooLongError is returned when
+// Enter is pressed.
+func readRawPassword(
+	f *os.File, mask rune, out io.Writer, limit int,
+) (_ []byte, ok bool, _ error) {
+	if _, err := terminal.MakeRaw(int(f.Fd())); err != nil {
+		return nil, false, nil
+	}
+	m := maskedLine{limit: limit}
+	if mask != 0 {
+		m.mask = []byte(string(mask))
+	}
+	// Pasted input arrives in a single read, and is echoed in a single
+	// write.
+	buf := make([]byte, 256)
+	defer ZeroBytes(buf)
+	for {
+		n, err := f.Read(buf)
+		done, interrupted := m.feed(buf[:n])
+		if len(m.echo) > 0 {
+			if _, err := out.Write(m.echo); err != nil {
+				m.zero()
+				return nil, true, err
+			}
+			m.echo = m.echo[:0]
+		}
+		switch {
+		case interrupted:
+			m.zero()
+			return nil, true, errPasswordPromptInterrupted
+		case done && m.eof:
+			return nil, true, io.EOF
+		case done && m.tooLong > 0:
+			return nil, true, &PasswordTooLongError{Max: m.limit, Actual: m.tooLong}
+		case done:
+			return m.line, true, nil
+		case err != nil:
+			m.zero()
+			return nil, true, err
+		}
+	}
+}
+
+// maskedLine is the state of readRawPassword: the characters typed so
+// far, and the echo of the last input.
+type maskedLine struct {
+	mask []byte
+	// line is the password typed so far, and runes the lengths of its
+	// characters.
+	line  []byte
+	runes []int
+	// pending is the start of a character split across reads.
+	pending []byte
+	// limit is the maximum length of the password. Once it is exceeded,
+	// tooLong is the length of the password typed, which is discarded.
+	limit, tooLong int
+	// escape is set in an escape sequence: it is 1 after Escape, and 2 after
+	// the "[" or "O" introducing the rest of the sequence.
+	escape int
+	echo   []byte
+	// eof is set when Ctrl-D is typed on an empty line.
+	eof bool
+}
+
+// feed processes input, to be echoed with m.echo, and returns whether the
+// password was entered, or Ctrl-C typed.
+func (m *maskedLine) feed(input []byte) (done, interrupted bool) {
+	for _, b := range input {
+		if m.escape > 0 {
+			switch {
+			case m.escape == 1 && (b == '[' || b == 'O'):
+				m.escape = 2
+			case m.escape == 2 && (b < 0x40 || b > 0x7e):
+				// A parameter of the sequence.
+			default:
+				m.escape = 0
+			}
+			continue
+		}
+		if len(m.pending) > 0 || b >= utf8.RuneSelf {
+			m.pending = append(m.pending, b)
+			if !utf8.FullRune(m.pending) {
+				continue
+			}
+			m.add(m.pending)
+			ZeroBytes(m.pending)
+			m.pending = m.pending[:0]
+			continue
+		}
+		switch b {
+		case keyEnter, keyLineFeed:
+			return true, false
+		case keyCtrlC:
+			return false, true
+		case keyCtrlD:
+			if len(m.line) == 0 && m.tooLong == 0 {
+				m.eof = true
+				return true, false
+			}
+		case keyBackspace, keyDelete:
+			m.erase(1)
+		case keyCtrlU:
+			m.erase(len(m.runes))
+		case keyEscape:
+			m.escape = 1
+		default:
+			if b >= ' ' {
+				m.add([]byte{b})
+			}
+		}
+	}
+	return false, false
+}
+
+// add appends a character to the password, and echoes the mask for it, or
+// discards the password if it becomes too long.
+func (m *maskedLine) add(r []byte) {
+	if m.tooLong == 0 && len(m.line)+len(r) > m.limit {
+		m.tooLong = len(m.line)
+		m.zero()
+		m.line, m.runes = m.line[:0], m.runes[:0]
+	}
+	if m.tooLong > 0 {
+		m.tooLong += len(r)
+		return
+	}
+	m.line = appendSecret(m.line, r)
+	m.runes = append(m.runes, len(r))
+	m.echo = append(m.echo, m.mask...)
+}
+
+// erase removes the last n characters of the password, and their masks. The
+// characters of a discarded password cannot be erased.
+func (m *maskedLine) erase(n int) {
+	for ; n > 0 && len(m.runes) > 0; n-- {
+		size := m.runes[len(m.runes)-1]
+		m.runes = m.runes[:len(m.runes)-1]
+		ZeroBytes(m.line[len(m.line)-size:])
+		m.line = m.line[:len(m.line)-size]
+		m.echo = append(m.echo, "\b \b"...)
+	}
+}
+
+// zero clears the password typed.
+func (m *maskedLine) zero() {
+	ZeroBytes(m.line)
+	ZeroBytes(m.pending)
+}
+
+// appendSecret appends b to secret, zeroing the previous array of secret if
+// it has to be reallocated.
+func appendSecret(secret, b []byte) []byte {
+	if len(secret)+len(b) <= cap(secret) {
+		return append(secret, b...)
+	}
+	grown := make([]byte, len(secret), 2*cap(secret)+len(b))
+	copy(grown, secret)
+	ZeroBytes(secret)
+	return append(grown, b...)
+}
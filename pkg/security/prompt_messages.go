@@ -0,0 +1,234 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// PromptMessages are the messages written by a Prompter, e.g. translated
+// for operators who do not read English. The messages are plain strings,
+// but for the placeholders documented for some of them, e.g. {error}, which
+// are replaced as they are written; other braces are written as is. The
+// prompts and messages are written without newlines, which the Prompter
+// adds as needed.
+//
+// Empty fields default to the English messages of DefaultPromptMessages.
+// The descriptions of the errors themselves, e.g. of the violations of the
+// password policy, are not part of the messages: they are those of the
+// errors, e.g. "password is too short: ...".
+type PromptMessages struct {
+	// PasswordPrompt and ConfirmPasswordPrompt are the prompts for a
+	// password and its confirmation, unless set with WithPrompts; for
+	// example "Enter password: ".
+	PasswordPrompt, ConfirmPasswordPrompt string
+	// NewPasswordPrompt and ConfirmNewPasswordPrompt are the prompts of
+	// PromptForNewPassword and PromptPasswordChange for the new password and
+	// its confirmation.
+	NewPasswordPrompt, ConfirmNewPasswordPrompt string
+	// CurrentPasswordPrompt is the prompt of PromptPasswordChange for the
+	// current password.
+	CurrentPasswordPrompt string
+	// AcceptWarningsPrompt asks for a password again to accept the warnings
+	// about it (see PasswordPolicy.WarnOnExpensiveViolations).
+	AcceptWarningsPrompt string
+	// Mismatch is written when the confirmation differs from the password.
+	Mismatch string
+	// TooWeak is written when the password is rejected for being too weak
+	// (see WithStrengthCheck).
+	TooWeak string
+	// Retry is written when the password is rejected and prompted for
+	// again, with {error} replaced with the reason, e.g. "empty passwords
+	// are not permitted".
+	Retry string
+	// RetryViolations replaces Retry when the password violates several
+	// rules of the policy, with {count} replaced with their number and
+	// {violations} with their descriptions, a line each.
+	RetryViolations string
+	// Warning is written when the password is accepted despite a problem,
+	// with {warning} replaced with the problem, e.g. the main suggestion to
+	// strengthen a weak password.
+	Warning string
+	// StrengthFeedback are the suggestions to strengthen weak passwords, by
+	// the identifiers of ScorePassword, e.g. FeedbackAvoidSequences. Missing
+	// ones are in English.
+	StrengthFeedback map[string]string
+	// TooManyAttempts is the message of the *TooManyPasswordAttemptsError
+	// returned when giving up, with {attempts} replaced with their number.
+	TooManyAttempts string
+}
+
+// englishPromptMessages are the default messages.
+var englishPromptMessages = PromptMessages{
+	PasswordPrompt:           "Enter password: ",
+	ConfirmPasswordPrompt:    "Confirm password: ",
+	NewPasswordPrompt:        "Enter new password: ",
+	ConfirmNewPasswordPrompt: "Confirm new password: ",
+	CurrentPasswordPrompt:    "Enter current password: ",
+	AcceptWarningsPrompt:     "Enter the password again to use it anyway: ",
+	Mismatch:                 "passwords didn't match, try again",
+	TooWeak:                  "password is too weak, try again",
+	Retry:                    "{error}, try again",
+	RetryViolations: "password violates {count} rules of the password policy:\n" +
+		"{violations}\ntry again",
+	Warning:         "warning: {warning}",
+	TooManyAttempts: "too many password attempts: giving up after {attempts} attempts",
+}
+
+// DefaultPromptMessages returns the English messages of Prompters, e.g. to
+// translate some of them.
+func DefaultPromptMessages() PromptMessages {
+	m := englishPromptMessages
+	m.StrengthFeedback = make(map[string]string, len(feedbackTexts))
+	for id, text := range feedbackTexts {
+		m.StrengthFeedback[id] = text
+	}
+	return m
+}
+
+// promptMessages holds the messages installed with SetPromptMessages.
+var promptMessages atomic.Value
+
+func init() {
+	promptMessages.Store(&englishPromptMessages)
+}
+
+// SetPromptMessages installs the messages of the Prompters not given
+// WithPromptMessages, including the prompters of PromptForPassword and the
+// other prompt functions. Nil restores the English ones. The messages are
+// copied, so later changes to them have no effect.
+func SetPromptMessages(m *PromptMessages) {
+	if m == nil {
+		m = &englishPromptMessages
+	}
+	promptMessages.Store(m.withDefaults())
+}
+
+// WithPromptMessages sets the messages of the Prompter, instead of the ones
+// installed with SetPromptMessages.
+func WithPromptMessages(m PromptMessages) PrompterOption {
+	return func(p *Prompter) {
+		p.msgs = m.withDefaults()
+	}
+}
+
+// withDefaults returns a copy of the messages, with the English messages in
+// place of the empty ones.
+func (m *PromptMessages) withDefaults() *PromptMessages {
+	c := *m
+	for _, f := range []struct {
+		field *string
+		value string
+	}{
+		{&c.PasswordPrompt, englishPromptMessages.PasswordPrompt},
+		{&c.ConfirmPasswordPrompt, englishPromptMessages.ConfirmPasswordPrompt},
+		{&c.NewPasswordPrompt, englishPromptMessages.NewPasswordPrompt},
+		{&c.ConfirmNewPasswordPrompt, englishPromptMessages.ConfirmNewPasswordPrompt},
+		{&c.CurrentPasswordPrompt, englishPromptMessages.CurrentPasswordPrompt},
+		{&c.AcceptWarningsPrompt, englishPromptMessages.AcceptWarningsPrompt},
+		{&c.Mismatch, englishPromptMessages.Mismatch},
+		{&c.TooWeak, englishPromptMessages.TooWeak},
+		{&c.Retry, englishPromptMessages.Retry},
+		{&c.RetryViolations, englishPromptMessages.RetryViolations},
+		{&c.Warning, englishPromptMessages.Warning},
+		{&c.TooManyAttempts, englishPromptMessages.TooManyAttempts},
+	} {
+		if *f.field == "" {
+			*f.field = f.value
+		}
+	}
+	if m.StrengthFeedback != nil {
+		c.StrengthFeedback = make(map[string]string, len(m.StrengthFeedback))
+		for id, text := range m.StrengthFeedback {
+			c.StrengthFeedback[id] = text
+		}
+	}
+	return &c
+}
+
+// feedback returns the text of the strength suggestion with the identifier.
+func (m *PromptMessages) feedback(id string) string {
+	if text, ok := m.StrengthFeedback[id]; ok {
+		return text
+	}
+	return feedbackTexts[id]
+}
+
+// tooManyAttempts returns the message of a *TooManyPasswordAttemptsError.
+func (m *PromptMessages) tooManyAttempts(attempts int) string {
+	return formatPromptMessage(m.TooManyAttempts, "{attempts}", strconv.Itoa(attempts))
+}
+
+// retry returns the Retry message for the rejection of a password, or the
+// RetryViolations message for several policy violations, listed so that
+// they can all be fixed at once.
+func (m *PromptMessages) retry(err error) string {
+	v, ok := err.(*PolicyViolations)
+	if !ok || len(v.Violations) == 1 {
+		return formatPromptMessage(m.Retry, "{error}", err.Error())
+	}
+	violations := make([]string, len(v.Violations))
+	for i, violation := range v.Violations {
+		violations[i] = "  - " + violation.Message()
+	}
+	return formatPromptMessage(m.RetryViolations,
+		"{count}", strconv.Itoa(len(v.Violations)), "{violations}", strings.Join(violations, "\n"))
+}
+
+// warning returns the Warning message for the problem.
+func (m *PromptMessages) warning(problem string) string {
+	return formatPromptMessage(m.Warning, "{warning}", problem)
+}
+
+// formatPromptMessage replaces the placeholders of a message, given as
+// pairs of placeholders and values.
+func formatPromptMessage(message string, placeholdersAndValues ...string) string {
+	return strings.NewReplacer(placeholdersAndValues...).Replace(message)
+}
+
+// messages returns the messages of the Prompter.
+func (p *Prompter) messages() *PromptMessages {
+	if p.msgs != nil {
+		return p.msgs
+	}
+	return promptMessages.Load().(*PromptMessages)
+}
+
+// withNewPasswordPrompts selects the prompts of the messages for new
+// passwords, e.g. "Enter new password: ".
+func withNewPasswordPrompts() PrompterOption {
+	return func(p *Prompter) {
+		p.newPassword = true
+	}
+}
+
+// prompts returns the prompts for the password and its confirmation: the
+// ones set with WithPrompts, or the ones of the messages.
+func (p *Prompter) prompts() (prompt, confirmPrompt string) {
+	m := p.messages()
+	prompt, confirmPrompt = m.PasswordPrompt, m.ConfirmPasswordPrompt
+	if p.newPassword {
+		prompt, confirmPrompt = m.NewPasswordPrompt, m.ConfirmNewPasswordPrompt
+	}
+	if p.prompt != "" {
+		prompt = p.prompt
+	}
+	if p.confirmPrompt != "" {
+		confirmPrompt = p.confirmPrompt
+	}
+	return prompt, confirmPrompt
+}
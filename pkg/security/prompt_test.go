@@ -0,0 +1,1173 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unicode/utf16"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+// messages are the default messages, which the output of the prompts is
+// checked against.
+var messages = security.DefaultPromptMessages()
+
+// retryMessage returns the message written when a password is rejected with
+// the error, to prompt for it again.
+func retryMessage(err string) string {
+	return strings.Replace(messages.Retry, "{error}", err, -1)
+}
+
+// warningMessage returns the message written for the warning.
+func warningMessage(warning string) string {
+	return strings.Replace(messages.Warning, "{warning}", warning, -1)
+}
+
+// fakeTerminal is a terminal on which passwords are typed. It records the
+// output written before each read, i.e. while echo was still on.
+type fakeTerminal struct {
+	passwords []string
+	out       bytes.Buffer
+	// beforeReads are the output written before each read.
+	beforeReads []string
+}
+
+func (f *fakeTerminal) read() ([]byte, error) {
+	f.beforeReads = append(f.beforeReads, f.out.String())
+	if len(f.passwords) == 0 {
+		return nil, io.EOF
+	}
+	password := f.passwords[0]
+	f.passwords = f.passwords[1:]
+	return []byte(password), nil
+}
+
+// install makes the password prompts use the terminal, and returns a
+// function restoring the real one.
+func (f *fakeTerminal) install(passwords ...string) func() {
+	f.passwords = passwords
+	return security.TestingSetDefaultPrompter(
+		security.NewTestTerminalPrompter(f.read, security.WithPromptOutput(&f.out)))
+}
+
+func TestPrompter(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	var out bytes.Buffer
+	p := security.NewTestPrompter([]string{"hunter2", "hunter2", "hunter3", "hunter4"},
+		security.WithPrompts("Password: ", "Again: "), security.WithPromptOutput(&out))
+	if password, err := p.ReadPassword(); err != nil || password != "hunter2" {
+		t.Errorf("expected hunter2, got %q, %v", password, err)
+	}
+	if out.String() != "Password: \n" {
+		t.Errorf("unexpected output %q", out.String())
+	}
+	out.Reset()
+	if _, err := p.ReadNewPassword(); !testutils.IsError(err, "^password mismatch$") {
+		t.Errorf("expected a mismatch, got %v", err)
+	}
+	if out.String() != "Password: \nAgain: \n" {
+		t.Errorf("unexpected output %q", out.String())
+	}
+	out.Reset()
+	if _, err := p.ReadNewPassword(); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+	if out.String() != "Password: \nAgain: " {
+		t.Errorf("unexpected output %q", out.String())
+	}
+
+	// Without WithPromptOutput, the prompts are discarded.
+	p = security.NewTestPrompter([]string{"hunter2", "hunter2"})
+	if password, err := p.ReadNewPassword(); err != nil || password != "hunter2" {
+		t.Errorf("expected hunter2, got %q, %v", password, err)
+	}
+
+	// Piped passwords are read from lines of the input, without prompts.
+	in := strings.NewReader("hunter2\nhunter3\n")
+	out.Reset()
+	p = security.NewPrompter(in, &out)
+	for _, expected := range []string{"hunter2", "hunter3"} {
+		if password, err := p.ReadNewPassword(); err != nil || password != expected {
+			t.Errorf("expected %s, got %q, %v", expected, password, err)
+		}
+	}
+	if out.Len() != 0 {
+		t.Errorf("unexpected output %q", out.String())
+	}
+	// The encoding is detected once, at the start of the input.
+	p = security.NewPrompter(strings.NewReader("\xff\xfe"+utf16LE("hunter2\r\nhunter3\r\n")), &out)
+	for _, expected := range []string{"hunter2", "hunter3"} {
+		if password, err := p.ReadPassword(); err != nil || password != expected {
+			t.Errorf("expected %s, got %q, %v", expected, password, err)
+		}
+	}
+
+	// Redirected files are read as piped inputs, rather than as terminals.
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if _, err := w.Write([]byte("hunter2\r\n")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	p = security.NewPrompter(r, &out)
+	if password, err := p.ReadPassword(); err != nil || password != "hunter2" || out.Len() != 0 {
+		t.Errorf("expected hunter2, got %q, %v and output %q", password, err, out.String())
+	}
+}
+
+func TestPrompterNonInteractive(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	closed, _, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := closed.Close(); err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	for _, tc := range []struct {
+		name string
+		in   io.Reader
+		err  string
+	}{
+		{"empty", strings.NewReader(""), "it is empty; provide the password with --password-file"},
+		{"empty pipe", r, "it is empty"},
+		{"closed", closed, "it cannot be read: .*file already closed"},
+	} {
+		p := security.NewPrompter(tc.in, ioutil.Discard)
+		for _, read := range []func() (string, error){
+			p.ReadPassword, p.ReadNewPassword, func() (string, error) {
+				return p.ReadLineEchoed("Enter username: ")
+			},
+		} {
+			_, err := read()
+			var e *security.NonInteractiveError
+			if !security.ErrorAs(err, &e) || errors.Cause(err) != security.ErrNonInteractive ||
+				!testutils.IsError(err, tc.err) {
+				t.Errorf("%s: expected a NonInteractiveError, got %v", tc.name, err)
+			}
+		}
+	}
+
+	// Once a line was read, the end of the input is not reported as such.
+	p := security.NewPrompter(strings.NewReader("hunter2\n"), ioutil.Discard)
+	if password, err := p.ReadPassword(); err != nil || password != "hunter2" {
+		t.Errorf("expected hunter2, got %q, %v", password, err)
+	}
+	if _, err := p.ReadPassword(); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+}
+
+func TestPrompterMaxInputLength(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// A multi-megabyte line is discarded without being buffered, and the
+	// next line can still be read.
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	const length = 2 << 20
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := w.Write([]byte(strings.Repeat("x", length) + "\r\nhunter2\n"))
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+		errCh <- err
+	}()
+	p := security.NewPrompter(r, ioutil.Discard)
+	_, err = p.ReadPassword()
+	var e *security.PasswordTooLongError
+	if !security.ErrorAs(err, &e) || e.Max != security.DefaultMaxPasswordLength ||
+		e.Actual != length || errors.Cause(err) != security.ErrPasswordTooLong {
+		t.Errorf("expected a PasswordTooLongError, got %v", err)
+	}
+	if password, err := p.ReadPassword(); err != nil || password != "hunter2" {
+		t.Errorf("expected hunter2, got %q, %v", password, err)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+
+	// The maximum length can be set.
+	in := strings.NewReader("12345678\n123456789\r\n12345678\r\n1234567é")
+	p = security.NewPrompter(in, ioutil.Discard, security.WithMaxInputLength(8))
+	for _, expected := range []string{"12345678", "", "12345678", ""} {
+		password, err := p.ReadPassword()
+		if expected == "" {
+			if !testutils.IsError(err, "^password is too long: it must have at most 8 bytes, got 9$") {
+				t.Errorf("expected too long, got %q, %v", password, err)
+			}
+		} else if err != nil || password != expected {
+			t.Errorf("expected %q, got %q, %v", expected, password, err)
+		}
+	}
+	p = security.NewTestPrompter([]string{"alice", "bob"}, security.WithMaxInputLength(4))
+	if _, err := p.ReadLineEchoed("Enter username: "); !testutils.IsError(err, "got 5$") {
+		t.Errorf("expected too long, got %v", err)
+	}
+	if username, err := p.ReadLineEchoed("Enter username: "); err != nil || username != "bob" {
+		t.Errorf("expected bob, got %q, %v", username, err)
+	}
+}
+
+func TestPromptForPassword(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		prompt    func() (string, error)
+		passwords []string
+		expected  string
+		err       string
+		// out is the output, and beforeReads the output written before each
+		// read.
+		out         string
+		beforeReads []string
+	}{
+		{
+			prompt:      security.PromptForPassword,
+			passwords:   []string{"hunter2"},
+			expected:    "hunter2",
+			out:         messages.PasswordPrompt + "\n",
+			beforeReads: []string{messages.PasswordPrompt},
+		},
+		{
+			prompt: func() (string, error) {
+				return security.PromptForPasswordWithPrompt("Enter password for user alice: ")
+			},
+			passwords:   []string{"hunter2"},
+			expected:    "hunter2",
+			out:         "Enter password for user alice: \n",
+			beforeReads: []string{"Enter password for user alice: "},
+		},
+		{
+			prompt:      security.PromptForPassword,
+			err:         "EOF",
+			out:         messages.PasswordPrompt,
+			beforeReads: []string{messages.PasswordPrompt},
+		},
+		{
+			prompt:    security.PromptForPasswordTwice,
+			passwords: []string{"hunter2", "hunter2"},
+			expected:  "hunter2",
+			out:       messages.PasswordPrompt + "\n" + messages.ConfirmPasswordPrompt + "\n",
+			beforeReads: []string{
+				messages.PasswordPrompt, messages.PasswordPrompt + "\n" + messages.ConfirmPasswordPrompt,
+			},
+		},
+		{
+			prompt: func() (string, error) {
+				return security.PromptForPasswordTwiceWithPrompt(
+					"New passphrase: ", "Repeat the passphrase: ")
+			},
+			passwords: []string{"hunter2", "hunter2"},
+			expected:  "hunter2",
+			out:       "New passphrase: \nRepeat the passphrase: \n",
+			beforeReads: []string{
+				"New passphrase: ", "New passphrase: \nRepeat the passphrase: ",
+			},
+		},
+		{
+			prompt:    security.PromptForPasswordTwice,
+			passwords: []string{"hunter2", "hunter3"},
+			err:       "password mismatch",
+			out:       messages.PasswordPrompt + "\n" + messages.ConfirmPasswordPrompt + "\n",
+			beforeReads: []string{
+				messages.PasswordPrompt, messages.PasswordPrompt + "\n" + messages.ConfirmPasswordPrompt,
+			},
+		},
+		{
+			prompt:      security.PromptForPasswordTwice,
+			passwords:   []string{""},
+			err:         "empty passwords are not permitted",
+			out:         messages.PasswordPrompt,
+			beforeReads: []string{messages.PasswordPrompt},
+		},
+	} {
+		var f fakeTerminal
+		restore := f.install(tc.passwords...)
+		password, err := tc.prompt()
+		restore()
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%q: expected %q, got %v", tc.out, tc.err, err)
+			}
+		} else if err != nil || password != tc.expected {
+			t.Errorf("%q: expected %q, got %q, %v", tc.out, tc.expected, password, err)
+		}
+		if f.out.String() != tc.out {
+			t.Errorf("expected output %q, got %q", tc.out, f.out.String())
+		}
+		if len(f.beforeReads) != len(tc.beforeReads) {
+			t.Errorf("%q: expected %d reads, got %d", tc.out, len(tc.beforeReads), len(f.beforeReads))
+			continue
+		}
+		for i, out := range f.beforeReads {
+			if out != tc.beforeReads[i] {
+				t.Errorf("%q: expected %q before read %d, got %q", tc.out, tc.beforeReads[i], i, out)
+			}
+		}
+	}
+}
+
+func TestPromptForPasswordPiped(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		in       string
+		twice    bool
+		expected string
+		err      string
+		// rest is what remains to be read from the input.
+		rest string
+	}{
+		// Exactly one trailing "\n" or "\r\n" is stripped, and nothing else.
+		{in: "hunter2\n", expected: "hunter2"},
+		{in: "hunter2\r\n", expected: "hunter2"},
+		{in: "hunter2", expected: "hunter2"},
+		{in: "hunter2\r", expected: "hunter2\r"},
+		{in: "hunter2\r\r\n", expected: "hunter2\r"},
+		{in: "hunter2\n\n", expected: "hunter2", rest: "\n"},
+		{in: "hunter2\t \n", expected: "hunter2\t "},
+		{in: "\r\n", expected: ""},
+		{in: "hunter2\nSELECT 1;\n", expected: "hunter2", rest: "SELECT 1;\n"},
+		{in: " hunter2 \n", expected: " hunter2 "},
+		{in: "hunter\r2\n", expected: "hunter\r2"},
+		{in: "\n", expected: ""},
+		{in: "", err: "the input is not a terminal, and it is empty"},
+		{in: strings.Repeat("x", security.DefaultMaxPasswordLength) + "\r\n",
+			expected: strings.Repeat("x", security.DefaultMaxPasswordLength)},
+		{in: strings.Repeat("x", security.DefaultMaxPasswordLength+1) + "\n",
+			err: "password is too long"},
+		{in: strings.Repeat("x", 10*security.DefaultMaxPasswordLength),
+			err: "password is too long"},
+		// A single line is read, without confirmation.
+		{in: "hunter2\nhunter3\n", twice: true, expected: "hunter2", rest: "hunter3\n"},
+		{in: "hunter2\r\n", twice: true, expected: "hunter2"},
+		{in: "\nhunter2\n", twice: true, err: "empty passwords are not permitted"},
+		// UTF-16LE with a byte order mark, e.g. from PowerShell, is transcoded,
+		// and a UTF-8 byte order mark is skipped.
+		{in: "\xff\xfe" + utf16LE("hunter2\r\nSELECT 1;\n"), expected: "hunter2",
+			rest: utf16LE("SELECT 1;\n")},
+		{in: "\xff\xfe" + utf16LE("hünter😀2"), expected: "hünter😀2"},
+		{in: "\xff\xfe" + utf16LE("\r\n"), expected: ""},
+		{in: "\xff\xfe", err: "EOF"},
+		{in: "\xff\xfe" + utf16LE("hunter2")[1:], err: "malformed UTF-16 password input"},
+		{in: "\xff\xfe\x3d\xd8h\x00\n\x00", err: "malformed UTF-16 password input"},
+		{in: "\xff\xfe" + utf16LE(strings.Repeat("é", security.DefaultMaxPasswordLength/2)),
+			expected: strings.Repeat("é", security.DefaultMaxPasswordLength/2)},
+		{in: "\xff\xfe" + utf16LE(strings.Repeat("é", security.DefaultMaxPasswordLength/2+1)),
+			err: "password is too long"},
+		{in: "\xef\xbb\xbfhunter2\r\n", expected: "hunter2"},
+		{in: "\xef\xbb\xbf\n", expected: ""},
+		// Other inputs are read as is.
+		{in: "\xfe\xffhunter2\n", expected: "\xfe\xffhunter2"},
+		{in: "\xffhunter2\n", expected: "\xffhunter2"},
+		{in: "\xef\xbb\n", expected: "\xef\xbb"},
+		{in: "\xff", expected: "\xff"},
+	} {
+		in := strings.NewReader(tc.in)
+		var out bytes.Buffer
+		restore := security.TestingSetDefaultPrompter(security.NewPrompter(in, &out))
+		prompt := security.PromptForPassword
+		if tc.twice {
+			prompt = security.PromptForPasswordTwice
+		}
+		password, err := prompt()
+		restore()
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%q: expected %q, got %v", tc.in, tc.err, err)
+			}
+			continue
+		} else if err != nil || password != tc.expected {
+			t.Errorf("%q: expected %q, got %q, %v", tc.in, tc.expected, password, err)
+		}
+		// No prompt is written.
+		if out.Len() != 0 {
+			t.Errorf("%q: unexpected output %q", tc.in, out.String())
+		}
+		if rest, _ := ioutil.ReadAll(in); string(rest) != tc.rest {
+			t.Errorf("%q: expected %q to remain, got %q", tc.in, tc.rest, rest)
+		}
+	}
+}
+
+// utf16LE encodes s in UTF-16LE.
+func utf16LE(s string) string {
+	var b []byte
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = append(b, byte(u), byte(u>>8))
+	}
+	return string(b)
+}
+
+func TestPromptForPasswordTwiceWithRetries(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	var (
+		enter    = messages.PasswordPrompt
+		confirm  = "\n" + messages.ConfirmPasswordPrompt + "\n"
+		mismatch = messages.Mismatch + "\n"
+		empty    = "\n" + retryMessage(security.ErrEmptyPassword.Error()) + "\n"
+	)
+	for _, tc := range []struct {
+		maxAttempts int
+		passwords   []string
+		expected    string
+		err         string
+		out         string
+	}{
+		{3, []string{"hunter2", "hunter3", "hunter2", "hunter2"}, "hunter2", "",
+			enter + confirm + mismatch + enter + confirm},
+		{2, []string{"", "hunter2", "hunter2"}, "hunter2", "",
+			enter + empty + enter + confirm},
+		{2, []string{"hunter2", "hunter3", "", "hunter2"},
+			"", "too many password attempts: giving up after 2 attempts: " +
+				"empty passwords are not permitted",
+			enter + confirm + mismatch + enter},
+		{3, []string{"hunter2", "hunter3", "hunter2"}, "", "EOF",
+			enter + confirm + mismatch + enter + "\n" + messages.ConfirmPasswordPrompt},
+		// Without retries, the errors are returned as is.
+		{0, []string{"hunter2", "hunter3"}, "", "^password mismatch$", enter + confirm},
+		{1, []string{"hunter2", "hunter3"}, "", "^password mismatch$", enter + confirm},
+		{1, []string{""}, "", "^empty passwords are not permitted$", enter},
+	} {
+		var f fakeTerminal
+		restore := f.install(tc.passwords...)
+		password, err := security.PromptForPasswordTwiceWithRetries(tc.maxAttempts)
+		restore()
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%d, %q: expected %q, got %v", tc.maxAttempts, tc.passwords, tc.err, err)
+			}
+		} else if err != nil || password != tc.expected {
+			t.Errorf("%d, %q: expected %q, got %q, %v", tc.maxAttempts, tc.passwords, tc.expected,
+				password, err)
+		}
+		if f.out.String() != tc.out {
+			t.Errorf("%d, %q: expected output %q, got %q", tc.maxAttempts, tc.passwords, tc.out,
+				f.out.String())
+		}
+	}
+
+	// The error is typed.
+	var f fakeTerminal
+	restore := f.install("a", "b", "c", "d", "e", "f")
+	_, err := security.PromptForPasswordTwiceWithRetries(3)
+	restore()
+	var e *security.TooManyPasswordAttemptsError
+	if !security.ErrorAs(errors.Wrap(err, "setting password"), &e) || e.Attempts != 3 ||
+		errors.Cause(err) != security.ErrTooManyPasswordAttempts {
+		t.Errorf("expected a TooManyPasswordAttemptsError, got %v", err)
+	}
+
+	// Piped passwords are not retried.
+	var out bytes.Buffer
+	restore = security.TestingSetDefaultPrompter(
+		security.NewPrompter(strings.NewReader("\nhunter2\n"), &out))
+	_, err = security.PromptForPasswordTwiceWithRetries(3)
+	restore()
+	if err != security.ErrEmptyPassword || out.Len() != 0 {
+		t.Errorf("expected ErrEmptyPassword, got %v and output %q", err, out.String())
+	}
+}
+
+func TestPromptForPasswordCtx(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// The password is returned if it is entered in time.
+	var f fakeTerminal
+	restore := f.install("hunter2")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	password, err := security.PromptForPasswordCtx(ctx, "Password for alice: ")
+	cancel()
+	restore()
+	if err != nil || password != "hunter2" || f.out.String() != "Password for alice: \n" {
+		t.Errorf("expected hunter2, got %q, %v and output %q", password, err, f.out.String())
+	}
+
+	// Otherwise, the prompt is aborted.
+	for _, timeout := range []time.Duration{time.Millisecond, 0} {
+		var out bytes.Buffer
+		typed := make(chan string)
+		var reads int32
+		restore := security.TestingSetDefaultPrompter(security.NewTestTerminalPrompter(
+			func() ([]byte, error) {
+				atomic.AddInt32(&reads, 1)
+				return []byte(<-typed), nil
+			}, security.WithPromptOutput(&out)))
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		_, err := security.PromptForPasswordCtx(ctx, "Enter password: ")
+		cancel()
+		if timeout > 0 {
+			// Let the abandoned read finish.
+			typed <- "hunter2"
+		}
+		restore()
+		if _, ok := err.(*security.PasswordPromptError); !ok ||
+			errors.Cause(err) != context.DeadlineExceeded {
+			t.Errorf("%s: expected a PasswordPromptError, got %v", timeout, err)
+		}
+		if !testutils.IsError(err, "password prompt aborted: context deadline exceeded") {
+			t.Errorf("%s: unexpected error %v", timeout, err)
+		}
+		// The output moves on to the next line.
+		if out.String() != "Enter password: \n" {
+			t.Errorf("%s: unexpected output %q", timeout, out.String())
+		}
+		if timeout == 0 && atomic.LoadInt32(&reads) != 0 {
+			t.Errorf("expected no read once the context expired")
+		}
+	}
+
+	// Piped input is also abandoned.
+	r, w := io.Pipe()
+	var out bytes.Buffer
+	restore = security.TestingSetDefaultPrompter(security.NewPrompter(r, &out))
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(time.Millisecond, cancel)
+	_, err = security.PromptForPasswordCtx(ctx, "Enter password: ")
+	if _, werr := w.Write([]byte("hunter2\n")); werr != nil {
+		t.Fatal(werr)
+	}
+	restore()
+	if errors.Cause(err) != context.Canceled || out.Len() != 0 {
+		t.Errorf("expected context.Canceled, got %v and output %q", err, out.String())
+	}
+}
+
+func TestPrompterStrengthCheck(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	var (
+		enter   = messages.PasswordPrompt
+		confirm = "\n" + messages.ConfirmPasswordPrompt + "\n"
+		weak    = "\n" + warningMessage(messages.StrengthFeedback[security.FeedbackTop10Password])
+		retry   = "\n" + messages.TooWeak + "\n"
+	)
+	for _, tc := range []struct {
+		mode        security.StrengthCheckMode
+		maxAttempts int
+		passwords   []string
+		expected    string
+		err         string
+		out         string
+	}{
+		{security.StrengthWarn, 1, []string{"password", "password"}, "password", "",
+			enter + weak + confirm},
+		{security.StrengthWarn, 1, []string{"x7#kq!9vZp2$", "x7#kq!9vZp2$"}, "x7#kq!9vZp2$", "",
+			enter + confirm},
+		// The confirmation of rejected passwords is not asked for.
+		{security.StrengthEnforce, 3, []string{"password", "x7#kq!9vZp2$", "x7#kq!9vZp2$"},
+			"x7#kq!9vZp2$", "", enter + weak + retry + enter + confirm},
+		{security.StrengthEnforce, 1, []string{"password", "password"}, "",
+			"^password is too weak: its strength score is 0, the minimum is 3$", enter + weak + "\n"},
+		{security.StrengthEnforce, 2, []string{"password", "dragon"}, "",
+			"giving up after 2 attempts: password is too weak",
+			enter + weak + retry + enter + weak + "\n"},
+	} {
+		var out bytes.Buffer
+		p := security.NewTestPrompter(tc.passwords, security.WithPromptOutput(&out),
+			security.WithStrengthCheck(3, tc.mode))
+		password, err := p.ReadNewPasswordWithRetries(tc.maxAttempts)
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%q: expected %q, got %v", tc.passwords, tc.err, err)
+			}
+		} else if err != nil || password != tc.expected {
+			t.Errorf("%q: expected %q, got %q, %v", tc.passwords, tc.expected, password, err)
+		}
+		if out.String() != tc.out {
+			t.Errorf("%q: expected output %q, got %q", tc.passwords, tc.out, out.String())
+		}
+	}
+
+	// The error is typed.
+	p := security.NewTestPrompter([]string{"password"},
+		security.WithStrengthCheck(3, security.StrengthEnforce))
+	_, err := p.ReadNewPassword()
+	var e *security.PasswordTooWeakError
+	if !security.ErrorAs(err, &e) || e.Required != 3 || e.Score != 0 {
+		t.Errorf("expected a PasswordTooWeakError, got %v", err)
+	}
+
+	// SetPasswordStrengthWarning sets the default check of
+	// PromptForPasswordTwice.
+	if err := security.SetPasswordStrengthWarning(3); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetPasswordStrengthWarning(0); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	var f fakeTerminal
+	restore := f.install("password", "password")
+	password, err := security.PromptForPasswordTwice()
+	restore()
+	if err != nil || password != "password" || f.out.String() != enter+weak+confirm {
+		t.Errorf("expected a warning, got %q, %v and output %q", password, err, f.out.String())
+	}
+
+	// Piped passwords are not checked.
+	var out bytes.Buffer
+	p = security.NewPrompter(strings.NewReader("password\n"), &out,
+		security.WithStrengthCheck(3, security.StrengthEnforce))
+	if password, err := p.ReadNewPassword(); err != nil || password != "password" || out.Len() != 0 {
+		t.Errorf("expected password, got %q, %v and output %q", password, err, out.String())
+	}
+}
+
+func TestPromptForExistingAndNewPassword(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// Empty existing passwords are returned, for the server to reject.
+	var f fakeTerminal
+	restore := f.install("")
+	password, err := security.PromptForExistingPassword()
+	restore()
+	if err != nil || password != "" || f.out.String() != messages.PasswordPrompt+"\n" {
+		t.Errorf("expected an empty password, got %q, %v and output %q", password, err,
+			f.out.String())
+	}
+
+	var (
+		enter   = messages.NewPasswordPrompt
+		confirm = "\n" + messages.ConfirmNewPasswordPrompt + "\n"
+		short   = "\n" + retryMessage(
+			"password is too short: it must have at least 8 characters, got 7") + "\n"
+		empty = "\n" + retryMessage(security.ErrEmptyPassword.Error()) + "\n"
+	)
+	policy := security.NewPasswordPolicy(security.WithMinLength(8))
+	for _, tc := range []struct {
+		passwords []string
+		expected  string
+		err       string
+		out       string
+	}{
+		{[]string{"x7#kq!9v", "x7#kq!9v"}, "x7#kq!9v", "", enter + confirm},
+		// The confirmation of rejected passwords is not asked for.
+		{[]string{"hunter2", "", "x7#kq!9v", "x7#kq!9v"}, "x7#kq!9v", "",
+			enter + short + enter + empty + enter + confirm},
+		{[]string{"hunter2", "hunter2", "hunter2"}, "",
+			"giving up after 3 attempts: password is too short",
+			enter + short + enter + short + enter},
+	} {
+		var f fakeTerminal
+		restore := f.install(tc.passwords...)
+		password, err := security.PromptForNewPassword(policy)
+		restore()
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%q: expected %q, got %v", tc.passwords, tc.err, err)
+			}
+		} else if err != nil || password != tc.expected {
+			t.Errorf("%q: expected %q, got %q, %v", tc.passwords, tc.expected, password, err)
+		}
+		if f.out.String() != tc.out {
+			t.Errorf("%q: expected output %q, got %q", tc.passwords, tc.out, f.out.String())
+		}
+	}
+
+	// Without a policy, the installed one is used.
+	if err := security.SetPasswordPolicy(policy); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetPasswordPolicy(nil); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	restore = f.install("hunter2", "hunter2", "hunter2")
+	_, err = security.PromptForNewPassword(nil)
+	restore()
+	var tme *security.TooManyPasswordAttemptsError
+	if !security.ErrorAs(err, &tme) || !security.ErrorIs(tme.Err, security.ErrPasswordTooShort) {
+		t.Errorf("expected ErrPasswordTooShort, got %v", err)
+	}
+
+	// Piped passwords are validated, and not retried.
+	restore = security.TestingSetDefaultPrompter(
+		security.NewPrompter(strings.NewReader("hunter2\nx7#kq!9v\n"), ioutil.Discard))
+	_, err = security.PromptForNewPassword(policy)
+	restore()
+	var e *security.PolicyViolations
+	if !security.ErrorAs(err, &e) || !e.Has(security.ViolationTooShort) {
+		t.Errorf("expected a violation, got %v", err)
+	}
+}
+
+func TestPromptForNewPasswordWithOpts(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	var (
+		enter   = messages.NewPasswordPrompt
+		confirm = "\n" + messages.ConfirmNewPasswordPrompt + "\n"
+		short   = "\n" + retryMessage(
+			"password is too short: it must have at least 10 characters, got 8") + "\n"
+		empty = "\n" + retryMessage(security.ErrEmptyPassword.Error()) + "\n"
+	)
+	policy := security.NewPasswordPolicy(security.WithMinLength(8))
+	for _, tc := range []struct {
+		opts      security.PromptOpts
+		passwords []string
+		expected  string
+		err       string
+		out       string
+	}{
+		// A single prompt, still rejecting empty passwords.
+		{security.PromptOpts{}, []string{"", "hunter2"}, "hunter2", "", enter + empty + enter + "\n"},
+		{security.PromptOpts{MaxAttempts: 1}, []string{""}, "", "^empty passwords are not permitted$",
+			enter},
+		{security.PromptOpts{Prompt: "Enter password for alice: "}, []string{"hunter2"}, "hunter2", "",
+			"Enter password for alice: \n"},
+		// The minimum length is added to the policy.
+		{security.PromptOpts{MinLength: 10, Policy: policy}, []string{"x7#kq!9v", "x7#kq!9vZp"},
+			"x7#kq!9vZp", "", enter + short + enter + "\n"},
+		{security.PromptOpts{MinLength: 4, Policy: policy, MaxAttempts: 2},
+			[]string{"hunter2", "hunter2"}, "", "giving up after 2 attempts: password is too short",
+			enter + "\n" + retryMessage(
+				"password is too short: it must have at least 8 characters, got 7") + "\n" + enter},
+		{security.PromptOpts{MinLength: 20, Policy: security.NewPasswordPolicy(
+			security.WithMaxLength(16))}, []string{"hunter2"}, "",
+			"^minimum password length 20 is greater than the maximum 16$", ""},
+		{security.PromptOpts{Confirm: true, MaxAttempts: 2},
+			[]string{"hunter2", "hunter3", "hunter2", "hunter3"}, "", "giving up after 2 attempts",
+			enter + confirm + messages.Mismatch + "\n" + enter + confirm},
+	} {
+		var f fakeTerminal
+		restore := f.install(tc.passwords...)
+		password, err := security.PromptForNewPasswordWithOpts(tc.opts)
+		restore()
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%+v: expected %q, got %v", tc.opts, tc.err, err)
+			}
+		} else if err != nil || password != tc.expected {
+			t.Errorf("%+v: expected %q, got %q, %v", tc.opts, tc.expected, password, err)
+		}
+		if f.out.String() != tc.out {
+			t.Errorf("%+v: expected output %q, got %q", tc.opts, tc.out, f.out.String())
+		}
+	}
+
+	// The confirmation of piped passwords is skipped, but they are validated.
+	in := strings.NewReader("x7#kq!9v\nx7#kq!9v\nhunter2\n")
+	restore := security.TestingSetDefaultPrompter(security.NewPrompter(in, ioutil.Discard))
+	defer restore()
+	opts := security.PromptOpts{Confirm: true, Policy: policy}
+	if password, err := security.PromptForNewPasswordWithOpts(opts); err != nil ||
+		password != "x7#kq!9v" {
+		t.Errorf("expected x7#kq!9v, got %q, %v", password, err)
+	}
+	opts.MinLength = 10
+	if _, err := security.PromptForNewPasswordWithOpts(opts); !security.ErrorIs(err,
+		security.ErrPasswordTooShort) {
+		t.Errorf("expected ErrPasswordTooShort, got %v", err)
+	}
+	if rest, _ := ioutil.ReadAll(in); string(rest) != "hunter2\n" {
+		t.Errorf("unexpected rest %q", rest)
+	}
+}
+
+func TestPrompterConfirmation(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	var (
+		enter    = messages.PasswordPrompt
+		confirm  = "\n" + messages.ConfirmPasswordPrompt + "\n"
+		mismatch = messages.Mismatch + "\n"
+		empty    = "\n" + retryMessage(security.ErrEmptyPassword.Error()) + "\n"
+	)
+	for _, tc := range []struct {
+		passwords   []string
+		maxAttempts int
+		expected    string
+		err         error
+		out         string
+	}{
+		{[]string{"hunter2", "hunter2"}, 1, "hunter2", nil, enter + confirm},
+		{[]string{"hunter2", "hunter3"}, 1, "", security.ErrPasswordConfirmationMismatch,
+			enter + confirm},
+		// A prefix of the password does not confirm it.
+		{[]string{"hunter2", "hunter"}, 1, "", security.ErrPasswordConfirmationMismatch,
+			enter + confirm},
+		{[]string{"", "hunter2", "hunter2"}, 2, "hunter2", nil, enter + empty + enter + confirm},
+		{[]string{"hunter2", "hunter3", "hunter2", "hunter2"}, 2, "hunter2", nil,
+			enter + confirm + mismatch + enter + confirm},
+		{[]string{"hunter2"}, 1, "", io.EOF, enter + "\n" + messages.ConfirmPasswordPrompt},
+	} {
+		// The buffers of the passwords returned by the fake terminal are kept,
+		// to check that they are zeroed.
+		var buffers [][]byte
+		passwords := tc.passwords
+		var out bytes.Buffer
+		p := security.NewTestTerminalPrompter(func() ([]byte, error) {
+			if len(passwords) == 0 {
+				return nil, io.EOF
+			}
+			b := append(make([]byte, 0, 16), passwords[0]...)
+			passwords = passwords[1:]
+			buffers = append(buffers, b)
+			return b, nil
+		}, security.WithPromptOutput(&out))
+		password, err := p.ReadNewPasswordBytesWithRetries(tc.maxAttempts)
+		if err != tc.err || string(password) != tc.expected {
+			t.Errorf("%q: expected %q, %v, got %q, %v", tc.passwords, tc.expected, tc.err,
+				password, err)
+		}
+		if out.String() != tc.out {
+			t.Errorf("%q: expected output %q, got %q", tc.passwords, tc.out, out.String())
+		}
+		for i, b := range buffers {
+			// The password returned is the first entry of its attempt.
+			if tc.err == nil && i == len(buffers)-2 {
+				continue
+			}
+			if !bytes.Equal(b[:cap(b)], make([]byte, cap(b))) {
+				t.Errorf("%q: entry %d was not zeroed: %q", tc.passwords, i, b)
+			}
+		}
+	}
+}
+
+func TestPrompterAllowEmptyMeaningKeep(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	current := []byte("hunter2")
+	var out bytes.Buffer
+	p := security.NewTestPrompter([]string{"", "hunter3", "hunter3"},
+		security.WithPromptOutput(&out), security.WithAllowEmptyMeaningKeep(current))
+	// The confirmation of an empty password is not asked for.
+	if _, err := p.ReadNewPasswordWithRetries(3); err != security.ErrKeepCurrentPassword {
+		t.Errorf("expected ErrKeepCurrentPassword, got %v", err)
+	}
+	if expected := messages.PasswordPrompt + "\n"; out.String() != expected {
+		t.Errorf("expected output %q, got %q", expected, out.String())
+	}
+	if password, err := p.ReadNewPassword(); err != nil || password != "hunter3" {
+		t.Errorf("expected hunter3, got %q, %v", password, err)
+	}
+
+	// Piped passwords can also be kept.
+	p = security.NewPrompter(strings.NewReader("\n"), ioutil.Discard,
+		security.WithAllowEmptyMeaningKeep(current))
+	if _, err := p.ReadNewPassword(); err != security.ErrKeepCurrentPassword {
+		t.Errorf("expected ErrKeepCurrentPassword, got %v", err)
+	}
+
+	// Without a current password, empty passwords are rejected.
+	p = security.NewTestPrompter([]string{""}, security.WithAllowEmptyMeaningKeep(nil))
+	if _, err := p.ReadNewPassword(); err != security.ErrEmptyPassword {
+		t.Errorf("expected ErrEmptyPassword, got %v", err)
+	}
+
+	// The option cannot be combined with a policy.
+	p = security.NewTestPrompter([]string{""}, security.WithAllowEmptyMeaningKeep(current),
+		security.WithPasswordPolicy(security.NewPasswordPolicy()))
+	if _, err := p.ReadNewPassword(); !testutils.IsError(err, "cannot be combined") {
+		t.Errorf("expected an error, got %v", err)
+	}
+
+	// PromptForPasswordTwice ignores the option.
+	restore := security.TestingSetDefaultPrompter(security.NewTestPrompter([]string{""},
+		security.WithAllowEmptyMeaningKeep(current)))
+	defer restore()
+	if _, err := security.PromptForPasswordTwice(); err != security.ErrEmptyPassword {
+		t.Errorf("expected ErrEmptyPassword, got %v", err)
+	}
+}
+
+func TestPromptForNewPasswordViolations(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// The Pwned Passwords service is unavailable.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	checker := &security.PwnedPasswordChecker{
+		Client:  server.Client(),
+		BaseURL: server.URL + "/range/",
+		Timeout: time.Second,
+	}
+	options := []security.PolicyOption{
+		security.WithMinLength(8),
+		security.WithRequiredClasses(security.DigitClass),
+		security.WithPwnedPasswordCheck(checker, false),
+	}
+	policy := security.NewPasswordPolicy(options...)
+	warnPolicy := security.NewPasswordPolicy(
+		append(options, security.WithExpensiveViolationWarnings(true))...)
+	message := func(password string) string {
+		err := policy.Validate(context.Background(), password, nil)
+		if err == nil {
+			t.Fatalf("expected %q to be rejected", password)
+		}
+		return err.Error()
+	}
+	unavailable := message("x7#kq!9v")
+	if !strings.Contains(unavailable, "unavailable") {
+		t.Fatalf("unexpected error %q", unavailable)
+	}
+
+	var (
+		enter    = messages.PasswordPrompt
+		warning  = "\n" + warningMessage(unavailable) + "\n"
+		accept   = messages.AcceptWarningsPrompt + "\n"
+		mismatch = messages.Mismatch + "\n"
+		all      = "\n" + strings.NewReplacer("{count}", "2", "{violations}",
+			"  - password is too short: it must have at least 8 characters, got 7\n"+
+				"  - password is too simple: it must contain at least one digit",
+		).Replace(messages.RetryViolations) + "\n"
+	)
+	for _, tc := range []struct {
+		policy    *security.PasswordPolicy
+		opts      []security.PrompterOption
+		passwords []string
+		expected  string
+		err       string
+		out       string
+	}{
+		// All the violations are listed.
+		{policy, nil, []string{"hunter!", "x7#kq!9v"}, "",
+			"giving up after 2 attempts: .*unavailable", enter + all + enter},
+		// Expensive violations are warnings, accepted by typing the password
+		// again, which also confirms it.
+		{warnPolicy, nil, []string{"hunter!", "x7#kq!9v", "x7#kq!9v"}, "x7#kq!9v", "",
+			enter + all + enter + warning + accept},
+		{warnPolicy, []security.PrompterOption{security.WithoutConfirmation()},
+			[]string{"x7#kq!9v", "x7#kq!9v"}, "x7#kq!9v", "",
+			enter + warning + accept},
+		{warnPolicy, nil, []string{"x7#kq!9v", "x7#kq!9w", "x7#kq!9v", "x7#kq!9w"}, "",
+			"giving up after 2 attempts: password mismatch",
+			enter + warning + accept + mismatch + enter +
+				warning + accept},
+		// Other violations are still errors.
+		{warnPolicy, nil, []string{"hunter!", "hunter!"}, "",
+			"giving up after 2 attempts: password violates 2 rules", enter + all + enter},
+	} {
+		var out bytes.Buffer
+		opts := append([]security.PrompterOption{security.WithPromptOutput(&out),
+			security.WithPasswordPolicy(tc.policy)}, tc.opts...)
+		p := security.NewTestPrompter(tc.passwords, opts...)
+		password, err := p.ReadNewPasswordWithRetries(2)
+		if tc.err != "" {
+			if !testutils.IsError(err, tc.err) {
+				t.Errorf("%q: expected %q, got %v", tc.passwords, tc.err, err)
+			}
+		} else if err != nil || password != tc.expected {
+			t.Errorf("%q: expected %q, got %q, %v", tc.passwords, tc.expected, password, err)
+		}
+		if out.String() != tc.out {
+			t.Errorf("%q: expected output %q, got %q", tc.passwords, tc.out, out.String())
+		}
+	}
+
+	// Piped passwords cannot be typed again, and are rejected.
+	p := security.NewPrompter(strings.NewReader("x7#kq!9v\n"), ioutil.Discard,
+		security.WithPasswordPolicy(warnPolicy))
+	if _, err := p.ReadNewPassword(); !security.ErrorIs(err,
+		security.ErrPwnedPasswordsUnavailable) {
+		t.Errorf("expected ErrPwnedPasswordsUnavailable, got %v", err)
+	}
+}
+
+func TestPromptMessages(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	french := security.PromptMessages{
+		PasswordPrompt:        "Mot de passe : ",
+		ConfirmPasswordPrompt: "Confirmez le mot de passe : ",
+		Mismatch:              "les mots de passe diffèrent, réessayez",
+		Retry:                 "{error}, réessayez",
+		Warning:               "attention : {warning} {unknown}",
+		StrengthFeedback: map[string]string{
+			security.FeedbackTop10Password: "ce mot de passe est très courant",
+		},
+		TooManyAttempts: "abandon après {attempts} essais",
+	}
+	var out bytes.Buffer
+	p := security.NewTestPrompter([]string{"hunter2", "hunter3", "", "hunter2", "hunter3"},
+		security.WithPromptOutput(&out), security.WithPromptMessages(french))
+	_, err := p.ReadNewPasswordWithRetries(3)
+	if !testutils.IsError(err, "^abandon après 3 essais: password mismatch$") ||
+		!security.ErrorIs(err, security.ErrTooManyPasswordAttempts) {
+		t.Errorf("expected too many attempts, got %v", err)
+	}
+	const (
+		enter   = "Mot de passe : \n"
+		confirm = "Confirmez le mot de passe : \n"
+	)
+	expected := enter + confirm + "les mots de passe diffèrent, réessayez\n" + enter +
+		"empty passwords are not permitted, réessayez\n" + enter + confirm
+	if out.String() != expected {
+		t.Errorf("expected output %q, got %q", expected, out.String())
+	}
+
+	// The suggestions of the strength check are translated, and unknown
+	// placeholders are written as is.
+	out.Reset()
+	p = security.NewTestPrompter([]string{"password", "password"},
+		security.WithPromptOutput(&out), security.WithPromptMessages(french),
+		security.WithStrengthCheck(3, security.StrengthWarn))
+	if _, err := p.ReadNewPassword(); err != nil {
+		t.Fatal(err)
+	}
+	expected = "Mot de passe : \nattention : ce mot de passe est très courant {unknown}\n" + confirm
+	if out.String() != expected {
+		t.Errorf("expected output %q, got %q", expected, out.String())
+	}
+
+	// The installed messages are used by the prompt functions, with the
+	// English messages in place of the missing ones.
+	security.SetPromptMessages(&french)
+	french.NewPasswordPrompt = "Nouveau mot de passe : "
+	var f fakeTerminal
+	restore := f.install("x7#kq!9vZp", "x7#kq!9vZp")
+	_, err = security.PromptForNewPassword(nil)
+	restore()
+	security.SetPromptMessages(nil)
+	expected = messages.NewPasswordPrompt + "\n" + messages.ConfirmNewPasswordPrompt + "\n"
+	if err != nil || f.out.String() != expected {
+		t.Errorf("expected output %q, got %q, %v", expected, f.out.String(), err)
+	}
+	restore = f.install("hunter2")
+	_, err = security.PromptForExistingPassword()
+	restore()
+	if err != nil || !strings.HasSuffix(f.out.String(), messages.PasswordPrompt+"\n") {
+		t.Errorf("expected the English prompt, got %q, %v", f.out.String(), err)
+	}
+}
+
+func TestPrompterReadPasswordBytes(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	var out bytes.Buffer
+	p := security.NewTestPrompter([]string{"hunter2", "hunter3", "hunter3", ""},
+		security.WithPromptOutput(&out))
+	password, err := p.ReadPasswordBytes()
+	if err != nil || string(password) != "hunter2" {
+		t.Errorf("expected hunter2, got %q, %v", password, err)
+	}
+	security.ZeroBytes(password)
+	if !bytes.Equal(password, make([]byte, len("hunter2"))) {
+		t.Errorf("expected the password to be zeroed, got %q", password)
+	}
+	if password, err := p.ReadNewPasswordBytes(); err != nil || string(password) != "hunter3" {
+		t.Errorf("expected hunter3, got %q, %v", password, err)
+	}
+	if _, err := p.ReadNewPasswordBytesWithRetries(3); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+	expected := messages.PasswordPrompt + "\n" + messages.PasswordPrompt + "\n" +
+		messages.ConfirmPasswordPrompt + "\n" + messages.PasswordPrompt + "\n" +
+		retryMessage(security.ErrEmptyPassword.Error()) + "\n" + messages.PasswordPrompt
+	if out.String() != expected {
+		t.Errorf("expected output %q, got %q", expected, out.String())
+	}
+
+	// The whitespace mode of the policy is applied to the bytes.
+	p = security.NewPrompter(strings.NewReader(" hunter2 \n"), ioutil.Discard,
+		security.WithPasswordPolicy(security.NewPasswordPolicy(
+			security.WithWhitespaceMode(security.WhitespaceTrim))))
+	if password, err := p.ReadNewPasswordBytes(); err != nil || string(password) != "hunter2" {
+		t.Errorf("expected hunter2, got %q, %v", password, err)
+	}
+
+	// Aborted prompts return no password.
+	p = security.NewTestTerminalPrompter(func() ([]byte, error) {
+		return nil, errors.New("unexpected read")
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if password, err := p.ReadPasswordBytesCtx(ctx); password != nil ||
+		errors.Cause(err) != context.Canceled {
+		t.Errorf("expected context.Canceled, got %q, %v", password, err)
+	}
+}
+
+func TestPrompterReadLineEchoed(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	var out bytes.Buffer
+	p := security.NewTestPrompter([]string{"alice", "hunter2"}, security.WithPromptOutput(&out),
+		security.WithMaskRune('*'))
+	if username, err := p.ReadLineEchoed("Enter username: "); err != nil || username != "alice" {
+		t.Errorf("expected alice, got %q, %v", username, err)
+	}
+	// The newline was echoed.
+	if out.String() != "Enter username: " {
+		t.Errorf("unexpected output %q", out.String())
+	}
+	if password, err := p.ReadPassword(); err != nil || password != "hunter2" {
+		t.Errorf("expected hunter2, got %q, %v", password, err)
+	}
+	if _, err := p.ReadLineEchoed("Enter username: "); err != io.EOF {
+		t.Errorf("expected EOF, got %v", err)
+	}
+
+	// Piped lines are read like passwords.
+	out.Reset()
+	in := strings.NewReader("alice\r\nhunter2\n" +
+		strings.Repeat("x", security.DefaultMaxPasswordLength+1))
+	p = security.NewPrompter(in, &out)
+	if username, err := p.ReadLineEchoed("Enter username: "); err != nil || username != "alice" {
+		t.Errorf("expected alice, got %q, %v", username, err)
+	}
+	if password, err := p.ReadPassword(); err != nil || password != "hunter2" {
+		t.Errorf("expected hunter2, got %q, %v", password, err)
+	}
+	_, err := p.ReadLineEchoed("Enter username: ")
+	if !testutils.IsError(err, "password is too long") {
+		t.Errorf("expected a long line to be rejected, got %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("unexpected output %q", out.String())
+	}
+}
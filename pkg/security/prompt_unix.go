@@ -0,0 +1,47 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// +build !windows
+
+package security
+
+import (
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// controllingTerminalPath is the path of the controlling terminal of the
+// process, on which passwords are prompted for when stdin is redirected.
+const controllingTerminalPath = "/dev/tty"
+
+// interruptSignals are the signals after which the state of the terminal is
+// restored if they interrupt a password prompt.
+var interruptSignals = []os.Signal{unix.SIGINT, unix.SIGTERM}
+
+// reraiseInterrupt makes the signal which interrupted a password prompt
+// take effect, once the prompt stopped handling it. It does not return.
+func reraiseInterrupt(sig os.Signal) {
+	// Reraise the signal, so that it is handled as if the prompt had not
+	// caught it, e.g. by terminating the process. os.Signal is always
+	// syscall.Signal.
+	if err := unix.Kill(unix.Getpid(), sig.(syscall.Signal)); err != nil {
+		// Sending a valid signal to ourselves should never fail.
+		panic(err)
+	}
+
+	// Block while we wait for the signal to be delivered.
+	select {}
+}
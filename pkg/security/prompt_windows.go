@@ -0,0 +1,35 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"os"
+)
+
+// controllingTerminalPath is the path of the input of the console of the
+// process, on which passwords are prompted for when stdin is redirected.
+const controllingTerminalPath = "CONIN$"
+
+// interruptSignals are the signals after which the state of the terminal is
+// restored if they interrupt a password prompt.
+var interruptSignals = []os.Signal{os.Interrupt}
+
+// reraiseInterrupt makes the signal which interrupted a password prompt
+// take effect, once the prompt stopped handling it. It does not return.
+func reraiseInterrupt(os.Signal) {
+	// Windows doesn't indicate whether a process exited due to a signal in
+	// the exit code, so we only need to exit with a failing code.
+	os.Exit(1)
+}
@@ -0,0 +1,192 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultPwnedPasswordsURL is the base URL of the Pwned Passwords range
+	// API, to which the hash prefix is appended.
+	DefaultPwnedPasswordsURL = "https://api.pwnedpasswords.com/range/"
+	// DefaultPwnedPasswordsTimeout bounds the duration of a range request.
+	DefaultPwnedPasswordsTimeout = 5 * time.Second
+
+	// pwnedPrefixLen is the number of hex characters of the SHA-1 of the
+	// password sent to the service.
+	pwnedPrefixLen = 5
+	// maxPwnedResponseSize bounds the size of a range response read; actual
+	// responses are well under 100KB.
+	maxPwnedResponseSize = 4 << 20
+)
+
+// ErrPwnedPasswordsUnavailable is the cause of the errors returned when the
+// Pwned Passwords service cannot be queried, so that callers can choose
+// whether to accept or reject passwords when it is unavailable.
+var ErrPwnedPasswordsUnavailable = errors.New("pwned passwords service unavailable")
+
+// PwnedPasswordsUnavailableError is returned when the Pwned Passwords service
+// cannot be queried or returns an invalid response.
+type PwnedPasswordsUnavailableError struct {
+	Err error
+}
+
+func (e *PwnedPasswordsUnavailableError) Error() string {
+	return fmt.Sprintf("%s: %v", ErrPwnedPasswordsUnavailable, e.Err)
+}
+
+// Cause implements the causer interface.
+func (e *PwnedPasswordsUnavailableError) Cause() error {
+	return ErrPwnedPasswordsUnavailable
+}
+
+// ErrPwnedPassword is the cause of the errors returned by password policies
+// for passwords found in the Pwned Passwords corpus.
+var ErrPwnedPassword = errors.New("password has appeared in a data breach")
+
+// PwnedPasswordError is returned by password policies for passwords found in
+// the Pwned Passwords corpus.
+type PwnedPasswordError struct {
+	// Count is the number of times the password appears in the corpus.
+	Count int
+}
+
+func (e *PwnedPasswordError) Error() string {
+	return fmt.Sprintf("%s: it was found %d times", ErrPwnedPassword, e.Count)
+}
+
+// Cause implements the causer interface.
+func (e *PwnedPasswordError) Cause() error {
+	return ErrPwnedPassword
+}
+
+// PwnedPasswordChecker queries the Pwned Passwords range API using its
+// k-anonymity protocol: only the first 5 hex characters of the SHA-1 of the
+// password are sent, and the matching suffixes are searched locally. Its zero
+// value queries DefaultPwnedPasswordsURL with http.DefaultClient.
+type PwnedPasswordChecker struct {
+	// Client is the HTTP client used for requests, or http.DefaultClient if
+	// nil.
+	Client *http.Client
+	// BaseURL is the URL to which the hash prefix is appended, or
+	// DefaultPwnedPasswordsURL if empty.
+	BaseURL string
+	// Timeout bounds the duration of a request, or
+	// DefaultPwnedPasswordsTimeout if zero, regardless of the deadline of
+	// the context and of the timeout of the client.
+	Timeout time.Duration
+}
+
+// CheckPwnedPassword returns the number of times a password appears in the
+// Pwned Passwords corpus, or 0 if it does not appear in it. See
+// PwnedPasswordChecker for the details of the protocol. Errors querying the
+// service have ErrPwnedPasswordsUnavailable as their cause.
+func CheckPwnedPassword(
+	ctx context.Context, password string, client *http.Client,
+) (count int, err error) {
+	return (&PwnedPasswordChecker{Client: client}).Check(ctx, password)
+}
+
+// Check is like CheckPwnedPassword, using the client and URL of the checker.
+func (c *PwnedPasswordChecker) Check(ctx context.Context, password string) (int, error) {
+	return c.check(ctx, []byte(password))
+}
+
+func (c *PwnedPasswordChecker) check(ctx context.Context, password []byte) (int, error) {
+	sum := sha1.Sum(password)
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := digest[:pwnedPrefixLen], digest[pwnedPrefixLen:]
+
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultPwnedPasswordsURL
+	}
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = DefaultPwnedPasswordsTimeout
+	}
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequest("GET", baseURL+prefix, nil)
+	if err != nil {
+		return 0, &PwnedPasswordsUnavailableError{Err: err}
+	}
+	// Padding hides the number of suffixes matching the prefix from
+	// observers of the encrypted response.
+	req.Header.Set("Add-Padding", "true")
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, &PwnedPasswordsUnavailableError{Err: err}
+	}
+	defer func() {
+		// Drain the body so that the connection can be reused.
+		_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxPwnedResponseSize))
+		resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return 0, &PwnedPasswordsUnavailableError{
+			Err: errors.Errorf("unexpected status %s", resp.Status),
+		}
+	}
+	count, err := findPwnedSuffix(io.LimitReader(resp.Body, maxPwnedResponseSize), suffix)
+	if err != nil {
+		return 0, &PwnedPasswordsUnavailableError{Err: err}
+	}
+	return count, nil
+}
+
+// findPwnedSuffix searches a range response, made of "SUFFIX:COUNT" lines,
+// for the suffix of the hash of a password, and returns its count.
+func findPwnedSuffix(r io.Reader, suffix string) (int, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		i := bytes.IndexByte(line, ':')
+		if i < 0 {
+			return 0, errors.Errorf("malformed response line %q", line)
+		}
+		if !bytes.EqualFold(line[:i], []byte(suffix)) {
+			continue
+		}
+		count, err := strconv.Atoi(string(line[i+1:]))
+		if err != nil || count < 0 {
+			return 0, errors.Errorf("malformed count in response line %q", line)
+		}
+		return count, nil
+	}
+	return 0, errors.Wrap(scanner.Err(), "reading response")
+}
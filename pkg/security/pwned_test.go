@@ -0,0 +1,145 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestCheckPwnedPassword(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// The SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8. Its
+	// suffix is lowercased to check that it is matched case-insensitively.
+	const rangeResponse = "003D68EB55068C33ACE09247EE4C639306B:3\r\n" +
+		"1e4c9b93f3f0682250b6cf8331b7ee68fd8:3861493\r\n" +
+		"011053FD0102E94D6AE2F8B83D76FAF94F6:0\r\n"
+	prefixRE := regexp.MustCompile(`^/range/[0-9A-F]{5}$`)
+	var mode, requests int32
+	const (
+		modeOK = iota
+		modeError
+		modeMalformed
+		modeSlow
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		// Only the prefix of the hash may be sent.
+		if !prefixRE.MatchString(r.URL.Path) || r.URL.RawQuery != "" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		if r.Header.Get("Add-Padding") != "true" {
+			t.Error("expected a padded response to be requested")
+		}
+		switch atomic.LoadInt32(&mode) {
+		case modeOK:
+			if r.URL.Path == "/range/5BAA6" {
+				fmt.Fprint(w, rangeResponse)
+			} else {
+				fmt.Fprint(w, "0018A45C4D1DEF81644B54AB7F969B88D65:0\r\n")
+			}
+		case modeError:
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		case modeMalformed:
+			fmt.Fprint(w, "not a range response\r\n")
+		case modeSlow:
+			<-r.Context().Done()
+		}
+	}))
+	defer server.Close()
+
+	checker := &security.PwnedPasswordChecker{
+		Client:  server.Client(),
+		BaseURL: server.URL + "/range/",
+		Timeout: 50 * time.Millisecond,
+	}
+	ctx := context.Background()
+	if count, err := checker.Check(ctx, "password"); err != nil || count != 3861493 {
+		t.Errorf("expected 3861493, got %d, %v", count, err)
+	}
+
+	policy := &security.PasswordPolicy{PwnedPasswords: checker}
+	if err := policy.Validate(ctx, "password", nil); !testutils.IsError(err, "found 3861493 times") ||
+		errors.Cause(err) != security.ErrPwnedPassword {
+		t.Errorf("expected ErrPwnedPassword, got %v", err)
+	}
+
+	for _, m := range []int32{modeError, modeMalformed, modeSlow} {
+		atomic.StoreInt32(&mode, m)
+		_, err := checker.Check(ctx, "password")
+		if _, ok := err.(*security.PwnedPasswordsUnavailableError); !ok ||
+			errors.Cause(err) != security.ErrPwnedPasswordsUnavailable {
+			t.Errorf("%d: expected a PwnedPasswordsUnavailableError, got %v", m, err)
+		}
+
+		// The policy fails closed unless configured otherwise.
+		policy.PwnedPasswordsFailOpen = false
+		if err := policy.Validate(
+			ctx, "password", nil,
+		); errors.Cause(err) != security.ErrPwnedPasswordsUnavailable {
+			t.Errorf("%d: expected ErrPwnedPasswordsUnavailable, got %v", m, err)
+		}
+		policy.PwnedPasswordsFailOpen = true
+		if err := policy.Validate(ctx, "password", nil); err != nil {
+			t.Errorf("%d: %v", m, err)
+		}
+	}
+
+	// The context bounds the request too.
+	atomic.StoreInt32(&mode, modeSlow)
+	checker.Timeout = time.Minute
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := checker.Check(
+		cancelCtx, "password",
+	); errors.Cause(err) != security.ErrPwnedPasswordsUnavailable {
+		t.Errorf("expected ErrPwnedPasswordsUnavailable, got %v", err)
+	}
+
+	// Passwords whose suffix is not in the range have not been pwned.
+	atomic.StoreInt32(&mode, modeOK)
+	if count, err := checker.Check(ctx, "correct horse battery staple"); err != nil || count != 0 {
+		t.Errorf("expected 0, got %d, %v", count, err)
+	}
+	if err := policy.Validate(ctx, "correct horse battery staple", nil); err != nil {
+		t.Error(err)
+	}
+
+	// The service is only queried for passwords which follow the other
+	// rules of the policy.
+	policy.MinLength = 10
+	before := atomic.LoadInt32(&requests)
+	err := policy.Validate(ctx, "password", nil)
+	if v, ok := err.(*security.PolicyViolations); !ok || len(v.Violations) != 1 ||
+		!v.Has(security.ViolationTooShort) {
+		t.Errorf("expected a single violation, got %v", err)
+	}
+	if n := atomic.LoadInt32(&requests) - before; n != 0 {
+		t.Errorf("expected no request, got %d", n)
+	}
+}
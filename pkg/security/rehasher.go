@@ -0,0 +1,194 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/metric"
+	"github.com/cockroachdb/cockroach/pkg/util/stop"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+var (
+	metaRehashSuccess = metric.Metadata{
+		Name:        "security.password.rehash.success",
+		Help:        "Number of stored password hashes upgraded in the background",
+		Measurement: "Rehashes",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaRehashFailed = metric.Metadata{
+		Name:        "security.password.rehash.failed",
+		Help:        "Number of background password rehashes which failed to hash or persist",
+		Measurement: "Rehashes",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaRehashDropped = metric.Metadata{
+		Name:        "security.password.rehash.dropped",
+		Help:        "Number of background password rehashes dropped because the queue was full",
+		Measurement: "Rehashes",
+		Unit:        metric.Unit_COUNT,
+	}
+)
+
+// RehasherMetrics holds metrics about the rehashes of an AsyncRehasher.
+type RehasherMetrics struct {
+	Success *metric.Counter
+	Failed  *metric.Counter
+	Dropped *metric.Counter
+}
+
+// PersistHashFunc replaces the password hash of a user, e.g. in
+// system.users, with a new hash of the same password. It must only replace
+// the hash if it is still oldHash, the hash the password was verified
+// against, e.g. with
+//
+//	UPDATE system.users SET "hashedPassword" = $newHash
+//	  WHERE username = $user AND "hashedPassword" = $oldHash
+//
+// otherwise a password changed between the login and the rehash would be
+// reverted to the old one. The context is canceled when the server shuts
+// down.
+type PersistHashFunc func(ctx context.Context, user string, oldHash, newHash []byte) error
+
+// rehashRequest is a password to rehash, queued by AsyncRehasher.Enqueue.
+type rehashRequest struct {
+	user     string
+	oldHash  []byte
+	password []byte
+}
+
+// AsyncRehasher upgrades stored password hashes in the background, so that
+// rehashing a password after a successful login (see NeedsRehash) does not
+// add a second hash evaluation to the latency of the login.
+type AsyncRehasher struct {
+	persist PersistHashFunc
+	queue   chan rehashRequest
+	metrics RehasherMetrics
+
+	mu struct {
+		syncutil.Mutex
+		// stopped is set once the stopper quiesces, after which requests are
+		// dropped rather than queued.
+		stopped bool
+	}
+}
+
+// NewAsyncRehasher creates an AsyncRehasher which rehashes passwords
+// like HashPasswordBytes, but without enforcing the password policy (see
+// SetPasswordPolicy), on the given number of workers, and persists the new
+// hashes with persist. At most queueSize requests wait for a worker;
+// further ones are dropped. When the stopper quiesces, the workers finish
+// the rehash they are working on, if any, and the queued requests are
+// dropped.
+func NewAsyncRehasher(
+	stopper *stop.Stopper, workers, queueSize int, persist PersistHashFunc,
+) *AsyncRehasher {
+	if workers <= 0 {
+		workers = 1
+	}
+	r := &AsyncRehasher{
+		persist: persist,
+		queue:   make(chan rehashRequest, queueSize),
+		metrics: RehasherMetrics{
+			Success: metric.NewCounter(metaRehashSuccess),
+			Failed:  metric.NewCounter(metaRehashFailed),
+			Dropped: metric.NewCounter(metaRehashDropped),
+		},
+	}
+	for i := 0; i < workers; i++ {
+		stopper.RunWorker(context.Background(), func(ctx context.Context) {
+			ctx, cancel := stopper.WithCancelOnQuiesce(ctx)
+			defer cancel()
+			for {
+				select {
+				case <-stopper.ShouldQuiesce():
+					r.stop()
+					return
+				case req := <-r.queue:
+					r.rehash(ctx, req)
+				}
+			}
+		})
+	}
+	return r
+}
+
+// Metrics returns the metrics of the rehasher.
+func (r *AsyncRehasher) Metrics() RehasherMetrics {
+	return r.metrics
+}
+
+// Enqueue queues a rehash of the password of the given user, which must
+// have just been verified against oldHash, and reports whether it was
+// queued. oldHash is passed to the PersistHashFunc. The hash and the
+// password are copied, so the caller can zero the password right away. If
+// the queue is full or the stopper is quiescing, the rehash is dropped, and
+// will be attempted again on the next login.
+func (r *AsyncRehasher) Enqueue(user string, oldHash, password []byte) bool {
+	req := rehashRequest{
+		user:     user,
+		oldHash:  append([]byte(nil), oldHash...),
+		password: append([]byte(nil), password...),
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.mu.stopped {
+		select {
+		case r.queue <- req:
+			return true
+		default:
+		}
+	}
+	ZeroBytes(req.password)
+	r.metrics.Dropped.Inc(1)
+	return false
+}
+
+// stop drops the queued requests, and the ones enqueued afterwards.
+func (r *AsyncRehasher) stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mu.stopped = true
+	for {
+		select {
+		case req := <-r.queue:
+			ZeroBytes(req.password)
+			r.metrics.Dropped.Inc(1)
+		default:
+			return
+		}
+	}
+}
+
+func (r *AsyncRehasher) rehash(ctx context.Context, req rehashRequest) {
+	if ctx.Err() != nil {
+		ZeroBytes(req.password)
+		r.metrics.Dropped.Inc(1)
+		return
+	}
+	newHash, err := rehashPassword(req.password)
+	ZeroBytes(req.password)
+	if err == nil {
+		err = r.persist(ctx, req.user, req.oldHash, newHash)
+	}
+	if err != nil {
+		r.metrics.Failed.Inc(1)
+		log.Warningf(ctx, "could not upgrade the password hash of user %s: %v", req.user, err)
+		return
+	}
+	r.metrics.Success.Inc(1)
+}
@@ -0,0 +1,230 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/stop"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+func TestAsyncRehasher(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	defer security.TestingSetBcryptCost(4)()
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+
+	// waitFor waits until the counters of the rehasher reach the given values.
+	waitFor := func(r *security.AsyncRehasher, success, failed, dropped int64) {
+		t.Helper()
+		m := r.Metrics()
+		testutils.SucceedsSoon(t, func() error {
+			if s, f, d := m.Success.Count(), m.Failed.Count(), m.Dropped.Count(); s != success ||
+				f != failed || d != dropped {
+				return errors.Errorf("expected %d successes, %d failures and %d drops, "+
+					"got %d, %d and %d", success, failed, dropped, s, f, d)
+			}
+			return nil
+		})
+	}
+
+	t.Run("persist", func(t *testing.T) {
+		stopper := stop.NewStopper()
+		defer stopper.Stop(context.Background())
+
+		var mu syncutil.Mutex
+		hashes := map[string][]byte{}
+		r := security.NewAsyncRehasher(stopper, 2, 10,
+			func(_ context.Context, user string, _, newHash []byte) error {
+				mu.Lock()
+				defer mu.Unlock()
+				hashes[user] = newHash
+				return nil
+			})
+		password := []byte("hunter2")
+		for i := 0; i < 3; i++ {
+			if !r.Enqueue(fmt.Sprintf("user%d", i), nil, password) {
+				t.Fatal("expected the rehash to be queued")
+			}
+		}
+		if string(password) != "hunter2" {
+			t.Errorf("expected the password of the caller to be left alone, got %q", password)
+		}
+		waitFor(r, 3, 0, 0)
+		mu.Lock()
+		defer mu.Unlock()
+		for user, hash := range hashes {
+			if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+				t.Errorf("%s: %v", user, err)
+			}
+		}
+	})
+
+	t.Run("concurrent password change", func(t *testing.T) {
+		stopper := stop.NewStopper()
+		defer stopper.Stop(context.Background())
+
+		oldHash, err := security.HashPassword("hunter2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var mu syncutil.Mutex
+		hashes := map[string][]byte{"alice": oldHash}
+		started, unblock := make(chan struct{}), make(chan struct{})
+		r := security.NewAsyncRehasher(stopper, 1, 10,
+			func(_ context.Context, user string, verified, newHash []byte) error {
+				if !bytes.Equal(verified, oldHash) {
+					return errors.Errorf("expected the verified hash %q, got %q", oldHash, verified)
+				}
+				started <- struct{}{}
+				<-unblock
+				mu.Lock()
+				defer mu.Unlock()
+				if bytes.Equal(hashes[user], verified) {
+					hashes[user] = newHash
+				}
+				return nil
+			})
+		if !r.Enqueue("alice", oldHash, []byte("hunter2")) {
+			t.Fatal("expected the rehash to be queued")
+		}
+		<-started
+		// The password changes between the login and the rehash.
+		newHash, err := security.HashPassword("hunter3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		mu.Lock()
+		hashes["alice"] = newHash
+		mu.Unlock()
+		close(unblock)
+		waitFor(r, 1, 0, 0)
+		mu.Lock()
+		defer mu.Unlock()
+		if err := security.CompareHashAndPassword(hashes["alice"], "hunter3"); err != nil {
+			t.Errorf("expected the new password to be kept, got %v", err)
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		stopper := stop.NewStopper()
+		defer stopper.Stop(context.Background())
+
+		started, unblock := make(chan struct{}), make(chan struct{})
+		r := security.NewAsyncRehasher(stopper, 1, 1,
+			func(context.Context, string, []byte, []byte) error {
+				started <- struct{}{}
+				<-unblock
+				return nil
+			})
+		if !r.Enqueue("busy", nil, []byte("hunter2")) {
+			t.Fatal("expected the rehash to be queued")
+		}
+		<-started
+		if !r.Enqueue("queued", nil, []byte("hunter2")) {
+			t.Fatal("expected the rehash to be queued")
+		}
+		if r.Enqueue("dropped", nil, []byte("hunter2")) {
+			t.Fatal("expected the rehash to be dropped")
+		}
+		waitFor(r, 0, 0, 1)
+		close(unblock)
+		<-started
+		waitFor(r, 2, 0, 1)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		stopper := stop.NewStopper()
+		defer stopper.Stop(context.Background())
+
+		r := security.NewAsyncRehasher(stopper, 1, 10,
+			func(_ context.Context, user string, _, _ []byte) error {
+				if user == "fail" {
+					return errors.New("injected failure")
+				}
+				return nil
+			})
+		// Failures to persist and to hash, e.g. because of an empty
+		// password, do not prevent the following rehashes.
+		r.Enqueue("fail", nil, []byte("hunter2"))
+		r.Enqueue("empty", nil, nil)
+		r.Enqueue("ok", nil, []byte("hunter2"))
+		waitFor(r, 1, 2, 0)
+	})
+
+	t.Run("login latency", func(t *testing.T) {
+		stopper := stop.NewStopper()
+		defer stopper.Stop(context.Background())
+
+		// Hash blocks until unblock is closed, but logins queuing a rehash
+		// do not wait for it.
+		b := blockingHasher{started: make(chan struct{}), unblock: make(chan struct{})}
+		prev := security.SetDefaultHasher(b)
+		defer security.SetDefaultHasher(prev)
+
+		r := security.NewAsyncRehasher(stopper, 1, 10,
+			func(context.Context, string, []byte, []byte) error { return nil })
+		r.Enqueue("slow", nil, []byte("hunter2"))
+		<-b.started
+		for i := 0; i < 5; i++ {
+			if !r.Enqueue("fast", nil, []byte("hunter2")) {
+				t.Fatal("expected the rehash to be queued")
+			}
+		}
+		close(b.unblock)
+		waitFor(r, 1, 0, 0)
+		for i := 0; i < 5; i++ {
+			<-b.started
+		}
+		waitFor(r, 6, 0, 0)
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		stopper := stop.NewStopper()
+		started, unblock := make(chan struct{}, 10), make(chan struct{})
+		r := security.NewAsyncRehasher(stopper, 1, 10,
+			func(ctx context.Context, _ string, _, _ []byte) error {
+				started <- struct{}{}
+				<-unblock
+				return ctx.Err()
+			})
+		r.Enqueue("busy", nil, []byte("hunter2"))
+		<-started
+		r.Enqueue("queued1", nil, []byte("hunter2"))
+		r.Enqueue("queued2", nil, []byte("hunter2"))
+		close(unblock)
+		stopper.Stop(context.Background())
+
+		// The queued rehashes completed or were dropped, and later ones are
+		// dropped.
+		if r.Enqueue("late", nil, []byte("hunter2")) {
+			t.Error("expected the rehash to be dropped after shutdown")
+		}
+		m := r.Metrics()
+		if s, d := m.Success.Count()+m.Failed.Count(), m.Dropped.Count(); s+d != 4 {
+			t.Errorf("expected 4 rehashes to be accounted for, got %d completed and %d dropped", s, d)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/rand"
+	"flag"
+	"io"
+
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+// saltSource is the source of the salts of new password hashes. It is only
+// overridden in tests (see TestingSetSaltSource).
+var saltSource struct {
+	syncutil.Mutex
+	r io.Reader
+}
+
+// generateSalt returns a salt of the given length for a new password hash.
+func generateSalt(n int) ([]byte, error) {
+	salt := make([]byte, n)
+	saltSource.Lock()
+	r := saltSource.r
+	if r == nil {
+		saltSource.Unlock()
+		_, err := rand.Read(salt)
+		return salt, err
+	}
+	// The test reader may not be safe for concurrent use.
+	defer saltSource.Unlock()
+	_, err := io.ReadFull(r, salt)
+	return salt, err
+}
+
+// testingSaltSourceSet reports whether the salt source was overridden.
+func testingSaltSourceSet() bool {
+	saltSource.Lock()
+	defer saltSource.Unlock()
+	return saltSource.r != nil
+}
+
+// TestingSetSaltSource makes new password hashes read their salts from r
+// instead of crypto/rand, so that tests can produce byte-identical hashes.
+// It returns a function that restores the previous source. It panics if
+// called outside of a test binary.
+//
+// The salt is the only source of randomness of all hash methods, but hashes
+// also depend on the parameters of their method: tests comparing hashes
+// against golden files must also fix e.g. BcryptCost, which is typically
+// lowered in tests to keep them fast.
+func TestingSetSaltSource(r io.Reader) func() {
+	if flag.Lookup("test.v") == nil {
+		panic("TestingSetSaltSource can only be used in tests")
+	}
+	saltSource.Lock()
+	defer saltSource.Unlock()
+	prev := saltSource.r
+	saltSource.r = r
+	return func() {
+		saltSource.Lock()
+		defer saltSource.Unlock()
+		saltSource.r = prev
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+// zeroReader is an infinite source of zero bytes.
+type zeroReader struct{}
+
+func (zeroReader) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = 0
+	}
+	return len(b), nil
+}
+
+func TestSaltSource(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	// The hashes also depend on the cost parameters, which the golden hashes
+	// below were computed with.
+	defer security.TestingSetBcryptCost(4)()
+	defer func(m, tm uint32, n, i int) {
+		security.Argon2Memory, security.Argon2Time = m, tm
+		security.ScryptN, security.PBKDF2Iterations = n, i
+	}(security.Argon2Memory, security.Argon2Time, security.ScryptN, security.PBKDF2Iterations)
+	security.Argon2Memory, security.Argon2Time = 64, 1
+	security.ScryptN, security.PBKDF2Iterations = 16, 1000
+
+	defer security.TestingSetSaltSource(zeroReader{})()
+
+	for _, method := range []security.HashMethod{
+		security.HashBCrypt, security.HashArgon2id, security.HashScrypt, security.HashPBKDF2,
+		security.HashSCRAMSHA256,
+	} {
+		first, err := security.HashPasswordWithMethod(method, "hunter2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, err := security.HashPasswordWithMethod(method, "hunter2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(first, second) {
+			t.Errorf("%s: expected identical hashes, got %s and %s", method, first, second)
+		}
+		if err := security.CompareHashAndPassword(first, "hunter2"); err != nil {
+			t.Errorf("%s: %v", method, err)
+		}
+		if err := security.CompareHashAndPassword(
+			first, "hunter3",
+		); err != security.ErrPasswordMismatch {
+			t.Errorf("%s: expected mismatch, got %v", method, err)
+		}
+	}
+
+	const golden = "crdb-bcrypt2$2a$04$......................"
+	hash, err := security.HashPassword("hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(hash, []byte(golden)) {
+		t.Errorf("expected a hash with an all-zero salt, got %s", hash)
+	}
+}
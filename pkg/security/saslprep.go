@@ -0,0 +1,148 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/bidi"
+	"golang.org/x/text/unicode/norm"
+)
+
+// runeRange is an inclusive range of code points.
+type runeRange struct {
+	lo, hi rune
+}
+
+func inRanges(r rune, ranges []runeRange) bool {
+	for _, rr := range ranges {
+		if r < rr.lo {
+			return false
+		}
+		if r <= rr.hi {
+			return true
+		}
+	}
+	return false
+}
+
+// The tables below are taken from RFC 3454 and must be kept sorted.
+var (
+	// Table C.1.2: non-ASCII space characters, mapped to SPACE by SASLprep.
+	saslPrepNonASCIISpace = []runeRange{
+		{0x00A0, 0x00A0}, {0x1680, 0x1680}, {0x2000, 0x200B}, {0x202F, 0x202F},
+		{0x205F, 0x205F}, {0x3000, 0x3000},
+	}
+	// Table B.1: characters commonly mapped to nothing.
+	saslPrepMappedToNothing = []runeRange{
+		{0x00AD, 0x00AD}, {0x034F, 0x034F}, {0x1806, 0x1806}, {0x180B, 0x180D},
+		{0x200B, 0x200D}, {0x2060, 0x2060}, {0xFE00, 0xFE0F}, {0xFEFF, 0xFEFF},
+	}
+	// Tables C.1.2, C.2.1, C.2.2, C.3, C.4, C.5, C.6, C.7, C.8 and C.9:
+	// characters prohibited in the output of SASLprep. Non-characters of
+	// the form U+xFFFE and U+xFFFF above the BMP are checked separately.
+	saslPrepProhibited = []runeRange{
+		{0x0000, 0x001F}, {0x007F, 0x00A0}, {0x0340, 0x0341}, {0x06DD, 0x06DD},
+		{0x070F, 0x070F}, {0x1680, 0x1680}, {0x180E, 0x180E}, {0x2000, 0x200F},
+		{0x2028, 0x202F}, {0x205F, 0x2063}, {0x206A, 0x206F}, {0x2FF0, 0x2FFB},
+		{0x3000, 0x3000}, {0xD800, 0xF8FF}, {0xFDD0, 0xFDEF}, {0xFEFF, 0xFEFF},
+		{0xFFF9, 0xFFFF}, {0x1D173, 0x1D17A}, {0xE0001, 0xE0001},
+		{0xE0020, 0xE007F}, {0xF0000, 0xFFFFD}, {0x100000, 0x10FFFD},
+	}
+)
+
+// saslPrep applies the SASLprep profile of stringprep (RFC 4013) to s. It
+// returns an error if s is not valid UTF-8 or if the prepared string
+// contains prohibited characters or violates the bidirectional rules. The
+// check for unassigned code points is not performed: Go's Unicode tables
+// are much newer than the Unicode 3.2 tables stringprep refers to, and
+// PostgreSQL does not reject them either.
+//
+// The error never contains the input, nor any of its characters, as it is
+// usually a password.
+func saslPrep(s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", errors.New("password is not valid UTF-8")
+	}
+
+	// Fast path: printable ASCII is unchanged by SASLprep.
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] < 0x20 || s[i] >= 0x7F {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return s, nil
+	}
+
+	// Step 1: mapping.
+	mapped := make([]rune, 0, len(s))
+	for _, r := range s {
+		switch {
+		case inRanges(r, saslPrepNonASCIISpace):
+			mapped = append(mapped, ' ')
+		case inRanges(r, saslPrepMappedToNothing):
+		default:
+			mapped = append(mapped, r)
+		}
+	}
+
+	// Step 2: normalization with form KC.
+	prepared := norm.NFKC.String(string(mapped))
+
+	// Step 3: prohibited output, and step 4: bidirectional characters.
+	var hasRandAL, hasL bool
+	var first, last rune
+	for i, r := range prepared {
+		if inRanges(r, saslPrepProhibited) || r&0xFFFE == 0xFFFE {
+			return "", errors.New("password contains a prohibited character")
+		}
+		if i == 0 {
+			first = r
+		}
+		last = r
+		switch isRandAL(r) {
+		case 1:
+			hasRandAL = true
+		case -1:
+			hasL = true
+		}
+	}
+	if hasRandAL {
+		if hasL {
+			return "", errors.New("password mixes left-to-right and right-to-left characters")
+		}
+		if isRandAL(first) != 1 || isRandAL(last) != 1 {
+			return "", errors.New("right-to-left password must start and end with a right-to-left character")
+		}
+	}
+	return prepared, nil
+}
+
+// isRandAL returns 1 if r has bidirectional category R or AL (table D.1),
+// -1 if it has category L (table D.2) and 0 otherwise.
+func isRandAL(r rune) int {
+	p, _ := bidi.LookupRune(r)
+	switch p.Class() {
+	case bidi.R, bidi.AL:
+		return 1
+	case bidi.L:
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,214 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// SCRAM-SHA-256 verifiers (RFC 5802, RFC 7677) are stored using the same
+// textual encoding as PostgreSQL's pg_authid.rolpassword column:
+//
+//	SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>
+//
+// where salt, StoredKey and ServerKey are encoded using standard base64.
+const scramSHA256Prefix = "SCRAM-SHA-256$"
+
+const (
+	// SCRAMMinIterations is the lowest iteration count accepted when
+	// generating a verifier. It is the minimum mandated by RFC 7677.
+	SCRAMMinIterations = 4096
+	// SCRAMMaxIterations is the highest iteration count accepted in a
+	// stored verifier, so that a crafted verifier, e.g. imported with
+	// ImportPGVerifier, cannot make every verification of the password
+	// take minutes. PostgreSQL generates verifiers with 4096 iterations.
+	SCRAMMaxIterations = 1 << 20
+	// scramSaltLen is the length of generated salts. It matches
+	// PostgreSQL's SCRAM_DEFAULT_SALT_LEN.
+	scramSaltLen = 16
+)
+
+// GenerateSCRAMVerifier computes a SCRAM-SHA-256 verifier for the given
+// password using a random salt and the given iteration count. The password
+// is prepared with SASLprep first; as in PostgreSQL, passwords that SASLprep
+// rejects are used as-is.
+func GenerateSCRAMVerifier(password string, iterations int) ([]byte, error) {
+	return generateSCRAMVerifier([]byte(password), iterations)
+}
+
+func generateSCRAMVerifier(password []byte, iterations int) ([]byte, error) {
+	if iterations < SCRAMMinIterations {
+		return nil, errors.Errorf("SCRAM iteration count %d is below the minimum of %d",
+			iterations, SCRAMMinIterations)
+	}
+	if iterations > SCRAMMaxIterations {
+		return nil, errors.Errorf("SCRAM iteration count %d is above the maximum of %d",
+			iterations, SCRAMMaxIterations)
+	}
+	salt, err := generateSalt(scramSaltLen)
+	if err != nil {
+		return nil, err
+	}
+	storedKey, serverKey := scramKeys(password, salt, iterations)
+	return encodeSCRAMVerifier(iterations, salt, storedKey, serverKey), nil
+}
+
+// CompareSCRAMVerifierAndPassword tests that the provided verifier was
+// computed from the supplied password. If it was not, returns
+// ErrPasswordMismatch.
+func CompareSCRAMVerifierAndPassword(verifier []byte, password string) error {
+	if !bytes.HasPrefix(verifier, []byte(scramSHA256Prefix)) {
+		return errors.New("not a SCRAM-SHA-256 verifier")
+	}
+	h, err := ParsePasswordHash(verifier)
+	if err != nil {
+		return err
+	}
+	return verifySCRAM(h, []byte(password))
+}
+
+func verifySCRAM(h PasswordHash, password []byte) error {
+	iterations, _ := h.Param("i")
+	storedKey, serverKey := scramKeys(password, h.salt, iterations)
+	// Evaluate both comparisons to avoid leaking which key differed.
+	ok := subtle.ConstantTimeCompare(h.hash, storedKey) &
+		subtle.ConstantTimeCompare(h.serverKey, serverKey)
+	if ok != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+// scramKeys derives the StoredKey and ServerKey for the given password.
+func scramKeys(password []byte, salt []byte, iterations int) (storedKey, serverKey []byte) {
+	if prepared, err := saslPrep(string(password)); err == nil {
+		password = []byte(prepared)
+	}
+	saltedPassword := pbkdf2.Key(password, salt, iterations, sha256.Size, sha256.New)
+	defer ZeroBytes(saltedPassword)
+
+	mac := hmac.New(sha256.New, saltedPassword)
+	mac.Write([]byte("Client Key"))
+	clientKey := mac.Sum(nil)
+	sum := sha256.Sum256(clientKey)
+	storedKey = sum[:]
+
+	mac = hmac.New(sha256.New, saltedPassword)
+	mac.Write([]byte("Server Key"))
+	serverKey = mac.Sum(nil)
+	return storedKey, serverKey
+}
+
+func encodeSCRAMVerifier(iterations int, salt, storedKey, serverKey []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(scramSHA256Prefix)
+	buf.WriteString(strconv.Itoa(iterations))
+	buf.WriteByte(':')
+	buf.WriteString(base64.StdEncoding.EncodeToString(salt))
+	buf.WriteByte('$')
+	buf.WriteString(base64.StdEncoding.EncodeToString(storedKey))
+	buf.WriteByte(':')
+	buf.WriteString(base64.StdEncoding.EncodeToString(serverKey))
+	return buf.Bytes()
+}
+
+func decodeSCRAMVerifier(
+	verifier []byte,
+) (iterations int, salt, storedKey, serverKey []byte, err error) {
+	if !bytes.HasPrefix(verifier, []byte(scramSHA256Prefix)) {
+		return 0, nil, nil, nil, errors.New("not a SCRAM-SHA-256 verifier")
+	}
+	parts := bytes.Split(verifier[len(scramSHA256Prefix):], []byte("$"))
+	if len(parts) != 2 {
+		return 0, nil, nil, nil, errors.New("malformed SCRAM-SHA-256 verifier")
+	}
+	iterSalt := bytes.Split(parts[0], []byte(":"))
+	keys := bytes.Split(parts[1], []byte(":"))
+	if len(iterSalt) != 2 || len(keys) != 2 {
+		return 0, nil, nil, nil, errors.New("malformed SCRAM-SHA-256 verifier")
+	}
+	iterations, err = strconv.Atoi(string(iterSalt[0]))
+	if err != nil || iterations <= 0 {
+		return 0, nil, nil, nil, errors.New("malformed SCRAM-SHA-256 verifier: invalid iteration count")
+	}
+	if iterations > SCRAMMaxIterations {
+		return 0, nil, nil, nil, errors.Errorf(
+			"malformed SCRAM-SHA-256 verifier: iteration count %d above the maximum of %d",
+			iterations, SCRAMMaxIterations)
+	}
+	if salt, err = base64.StdEncoding.DecodeString(string(iterSalt[1])); err != nil || len(salt) == 0 {
+		return 0, nil, nil, nil, errors.New("malformed SCRAM-SHA-256 verifier: invalid salt")
+	}
+	if storedKey, err = base64.StdEncoding.DecodeString(string(keys[0])); err != nil ||
+		len(storedKey) != sha256.Size {
+		return 0, nil, nil, nil, errors.New("malformed SCRAM-SHA-256 verifier: invalid stored key")
+	}
+	if serverKey, err = base64.StdEncoding.DecodeString(string(keys[1])); err != nil ||
+		len(serverKey) != sha256.Size {
+		return 0, nil, nil, nil, errors.New("malformed SCRAM-SHA-256 verifier: invalid server key")
+	}
+	return iterations, salt, storedKey, serverKey, nil
+}
+
+// ErrNotConvertible indicates that a password hash cannot be converted to
+// a PostgreSQL verifier, since doing so would require the plaintext
+// password.
+var ErrNotConvertible = errors.New("password hash cannot be converted to a PostgreSQL verifier")
+
+// ExportPGVerifier converts a stored password hash to the textual form of
+// PostgreSQL's pg_authid.rolpassword column, as expected by pg_dump and
+// ALTER ROLE ... PASSWORD. Only SCRAM-SHA-256 verifiers and md5 hashes can be
+// converted; other hashes result in an error whose cause is
+// ErrNotConvertible.
+func ExportPGVerifier(hashedPassword []byte) (string, error) {
+	h, err := ParsePasswordHash(hashedPassword)
+	if err != nil {
+		return "", err
+	}
+	switch h.method {
+	case HashSCRAMSHA256, HashPGMD5:
+		return string(h.Encode()), nil
+	default:
+		return "", errors.Wrapf(ErrNotConvertible, "%s hash", h.method)
+	}
+}
+
+// ImportPGVerifier is the inverse of ExportPGVerifier: it validates a
+// PostgreSQL rolpassword value and returns the hash to store. The iteration
+// count of SCRAM-SHA-256 verifiers is bounded as the cost of the pre-hashed
+// passwords accepted by CheckPasswordHashValidity.
+func ImportPGVerifier(verifier string) ([]byte, error) {
+	h, err := ParsePasswordHash([]byte(verifier))
+	if err != nil {
+		return nil, err
+	}
+	switch h.method {
+	case HashSCRAMSHA256, HashPGMD5:
+		if err := checkImportedHashParams(h); err != nil {
+			return nil, err
+		}
+		return h.Encode(), nil
+	default:
+		return nil, errors.Errorf("not a PostgreSQL password verifier: %s hash", h.method)
+	}
+}
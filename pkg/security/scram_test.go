@@ -0,0 +1,155 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+// pencilVerifier is the verifier for the password "pencil" using the salt
+// and iteration count of the example exchange in RFC 7677.
+const pencilVerifier = "SCRAM-SHA-256$4096:W22ZaJ0SNY7soEsUEjb6gQ==$" +
+	"WG5d8oPm3OtcPnkdi4Uo7BkeZkBFzpcXkuLmtbsT4qY=:wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU="
+
+func TestSCRAMVerifier(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	if err := security.CompareSCRAMVerifierAndPassword([]byte(pencilVerifier), "pencil"); err != nil {
+		t.Fatalf("known verifier did not verify: %v", err)
+	}
+	if err := security.CompareSCRAMVerifierAndPassword(
+		[]byte(pencilVerifier), "pencils",
+	); err != security.ErrPasswordMismatch {
+		t.Fatalf("expected mismatch, got %v", err)
+	}
+
+	for _, password := range []string{"pencil", "café", "I­X", "Ⅸ"} {
+		v, err := security.GenerateSCRAMVerifier(password, security.SCRAMMinIterations)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(string(v), "SCRAM-SHA-256$4096:") {
+			t.Fatalf("unexpected verifier format %q", v)
+		}
+		if err := security.CompareSCRAMVerifierAndPassword(v, password); err != nil {
+			t.Errorf("%q: %v", password, err)
+		}
+	}
+
+	// SASLprep maps "I<soft hyphen>X" and "<roman numeral nine>" to "IX".
+	v, err := security.GenerateSCRAMVerifier("IX", security.SCRAMMinIterations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, password := range []string{"I­X", "Ⅸ"} {
+		if err := security.CompareSCRAMVerifierAndPassword(v, password); err != nil {
+			t.Errorf("%q: %v", password, err)
+		}
+	}
+
+	if _, err := security.GenerateSCRAMVerifier("pencil", security.SCRAMMinIterations-1); err == nil {
+		t.Error("expected error for low iteration count")
+	}
+	if _, err := security.GenerateSCRAMVerifier("pencil", security.SCRAMMaxIterations+1); err == nil {
+		t.Error("expected error for high iteration count")
+	}
+}
+
+func TestSCRAMVerifierMalformed(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, v := range []string{
+		"",
+		"SCRAM-SHA-256$",
+		"SCRAM-SHA-256$4096:W22ZaJ0SNY7soEsUEjb6gQ==",
+		"SCRAM-SHA-256$x:W22ZaJ0SNY7soEsUEjb6gQ==$WG5d8oPm3OtcPnkdi4Uo7BkeZkBFzpcXkuLmtbsT4qY=:" +
+			"wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU=",
+		"SCRAM-SHA-256$4096:!!$WG5d8oPm3OtcPnkdi4Uo7BkeZkBFzpcXkuLmtbsT4qY=:" +
+			"wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU=",
+		"SCRAM-SHA-256$4096:W22ZaJ0SNY7soEsUEjb6gQ==$WG5d8oPm3OtcPnkdi4Uo7Bk=:" +
+			"wfPLwcE6nTWhTAmQ7tl2KeoiWGPlZqQxSrmfPwDl2dU=",
+		pencilVerifier[:len(pencilVerifier)-4],
+		// Iteration counts above SCRAMMaxIterations are rejected before
+		// any work is spent on them.
+		strings.Replace(pencilVerifier, "4096:", "2147483647:", 1),
+	} {
+		if err := security.CompareSCRAMVerifierAndPassword([]byte(v), "pencil"); err == nil ||
+			err == security.ErrPasswordMismatch {
+			t.Errorf("%q: expected malformed verifier error, got %v", v, err)
+		}
+	}
+}
+
+func TestPGVerifierRoundTrip(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		verifier string
+		password string
+	}{
+		{pencilVerifier, "pencil"},
+		// A verifier as PostgreSQL stores it, with its default salt length
+		// of 16 bytes.
+		{"SCRAM-SHA-256$4096:c2VjcmV0c2FsdDEyMzQ1Ng==$" +
+			"XqtUFl9vu1/LTvBm4h2e4sGRNK54oAd1ZHOlmUVpvB4=:" +
+			"/WgQYpvt+Jm8ZbG9DoSNn3C8tTzv06VGDPFStJAh4Tw=",
+			"correct horse battery staple"},
+	} {
+		hash, err := security.ImportPGVerifier(tc.verifier)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := security.CompareHashAndPassword(hash, tc.password); err != nil {
+			t.Errorf("%s: %v", tc.verifier, err)
+		}
+		exported, err := security.ExportPGVerifier(hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exported != tc.verifier {
+			t.Errorf("expected %s to round-trip, got %s", tc.verifier, exported)
+		}
+	}
+
+	const md5Hash = "md5f1d6e2da5767fddc60c941cf0fa924cf"
+	exported, err := security.ExportPGVerifier([]byte(md5Hash))
+	if err != nil || exported != md5Hash {
+		t.Errorf("expected %s to round-trip, got %s (%v)", md5Hash, exported, err)
+	}
+
+	if _, err := security.ExportPGVerifier(
+		[]byte(legacyHash),
+	); errors.Cause(err) != security.ErrNotConvertible {
+		t.Errorf("expected ErrNotConvertible, got %v", err)
+	}
+	for _, v := range []string{legacyHash, "hunter2", "SCRAM-SHA-256$4096:c2FsdA=="} {
+		if _, err := security.ImportPGVerifier(v); err == nil {
+			t.Errorf("%s: expected error", v)
+		}
+	}
+
+	// Iteration counts more than 4 times SCRAMMinIterations are rejected.
+	if _, err := security.ImportPGVerifier(
+		strings.Replace(pencilVerifier, "4096:", "16385:", 1),
+	); errors.Cause(err) != security.ErrHashCostOutOfRange {
+		t.Errorf("expected ErrHashCostOutOfRange, got %v", err)
+	}
+}
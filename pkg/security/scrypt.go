@@ -0,0 +1,121 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/subtle"
+	"math/bits"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+// scrypt hashes are encoded in the PHC string format, recording the cost
+// parameter N as its base-2 logarithm:
+//
+//	$scrypt$ln=<log2(N)>,r=<r>,p=<p>$<salt>$<hash>
+const scryptID = "scrypt"
+
+// ScryptN, ScryptR and ScryptP are the parameters to use when hashing
+// passwords with HashScrypt. Like BcryptCost, they are exposed for testing.
+// Verification always uses the parameters recorded in the stored hash.
+var (
+	ScryptN = 32768
+	ScryptR = 8
+	ScryptP = 1
+)
+
+// ScryptMaxMemory is the maximum amount of memory, in bytes, that a single
+// scrypt evaluation is allowed to use. Parameters exceeding it are rejected
+// both when hashing and when verifying, so that a crafted stored hash cannot
+// be used to exhaust the memory of the server.
+var ScryptMaxMemory = 64 << 20
+
+const (
+	scryptSaltLen = 16
+	scryptKeyLen  = 32
+)
+
+// checkScryptParams verifies that the given parameters are acceptable to the
+// scrypt package and stay within ScryptMaxMemory.
+func checkScryptParams(n, r, p int) error {
+	if n <= 1 || n&(n-1) != 0 {
+		return errors.Errorf("scrypt N=%d must be a power of 2 greater than 1", n)
+	}
+	if r <= 0 || p <= 0 || uint64(r)*uint64(p) >= 1<<30 {
+		return errors.Errorf("invalid scrypt parameters r=%d, p=%d", r, p)
+	}
+	// scrypt needs 128*r*N bytes for its main buffer and 128*r*p bytes for
+	// the output of the initial PBKDF2 step.
+	if mem := 128 * uint64(r) * (uint64(n) + uint64(p)); mem > uint64(ScryptMaxMemory) {
+		return errors.Errorf("scrypt parameters N=%d, r=%d, p=%d require %d bytes, "+
+			"more than the maximum of %d", n, r, p, mem, ScryptMaxMemory)
+	}
+	return nil
+}
+
+func hashScrypt(password []byte) ([]byte, error) {
+	n, r, p := ScryptN, ScryptR, ScryptP
+	if err := checkScryptParams(n, r, p); err != nil {
+		return nil, err
+	}
+	salt, err := generateSalt(scryptSaltLen)
+	if err != nil {
+		return nil, err
+	}
+	key, err := scrypt.Key(password, salt, n, r, p, scryptKeyLen)
+	if err != nil {
+		return nil, err
+	}
+	h := PasswordHash{
+		method: HashScrypt,
+		id:     scryptID,
+		params: []phcParam{{"ln", bits.TrailingZeros(uint(n))}, {"r", r}, {"p", p}},
+		salt:   salt,
+		hash:   key,
+	}
+	return h.Encode(), nil
+}
+
+// scryptParams validates and returns the parameters of an scrypt hash.
+func scryptParams(h PasswordHash) (n, r, p int, err error) {
+	values, err := requireParams(h, "ln", "r", "p")
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if values[0] >= 63 {
+		return 0, 0, 0, errors.New("malformed scrypt hash: invalid parameters")
+	}
+	n, r, p = 1<<uint(values[0]), values[1], values[2]
+	if err := checkScryptParams(n, r, p); err != nil {
+		return 0, 0, 0, err
+	}
+	return n, r, p, nil
+}
+
+func verifyScrypt(h PasswordHash, password []byte) error {
+	n, r, p, err := scryptParams(h)
+	if err != nil {
+		return err
+	}
+	got, err := scrypt.Key(password, h.salt, n, r, p, len(h.hash))
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(h.hash, got) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+)
+
+// redactedSecret is written in place of the value of a SecureString.
+const redactedSecret = "‹×›"
+
+// SecureString is a secret, such as a password, held in a byte slice, which
+// unlike a string can be zeroed with Destroy once the secret is no longer
+// needed, e.g. once it has been hashed, rather than surviving in memory, or
+// in heap dumps, until it is garbage collected.
+//
+// The fmt package formats it as "‹×›" with any verb, including %s, %x, %v
+// and %#v, and so does its JSON encoding and its SafeMessage, so that
+// logging it by accident, e.g. among the arguments of a log call, or
+// wrapping it into an error, does not leak the secret. However, fmt does
+// not call the methods of unexported struct fields, which must not hold
+// SecureStrings. UnsafeBytes returns the secret itself.
+//
+// HashPasswordSecure and CompareHashAndPasswordSecure take SecureStrings.
+// Since its type is a byte slice, it can also be passed as is to the other
+// functions taking passwords as bytes, like HashPasswordBytes and
+// CompareHashAndPasswordBytes. None of them copy it beyond the buffers they
+// zero, nor quote it in their errors.
+type SecureString []byte
+
+var _ fmt.Stringer = SecureString(nil)
+var _ fmt.GoStringer = SecureString(nil)
+var _ fmt.Formatter = SecureString(nil)
+var _ json.Marshaler = SecureString(nil)
+var _ log.SafeMessager = SecureString(nil)
+
+// String implements fmt.Stringer, returning "‹×›" rather than the secret.
+func (s SecureString) String() string {
+	return redactedSecret
+}
+
+// GoString implements fmt.GoStringer, returning "‹×›" rather than the
+// secret.
+func (s SecureString) GoString() string {
+	return redactedSecret
+}
+
+// Format implements fmt.Formatter, writing "‹×›" whatever the verb.
+func (s SecureString) Format(f fmt.State, _ rune) {
+	_, _ = io.WriteString(f, redactedSecret)
+}
+
+// SafeMessage implements log.SafeMessager, so that the redacted form is
+// also used in anonymized reports.
+func (s SecureString) SafeMessage() string {
+	return redactedSecret
+}
+
+// MarshalJSON implements json.Marshaler, encoding "‹×›" rather than the
+// secret.
+func (s SecureString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redactedSecret)
+}
+
+// UnsafeBytes returns the secret. The slice shares the memory of the
+// SecureString, and is zeroed by Destroy.
+func (s SecureString) UnsafeBytes() []byte {
+	return s
+}
+
+// Destroy zeroes the secret, and unlocks its memory if it is held in a
+// LockedBuffer, e.g. when read by a Prompter given WithLockedMemory. It
+// keeps its length, and must not be used anymore.
+func (s SecureString) Destroy() {
+	ZeroBytes(s)
+	destroyLockedBuffer(s)
+}
@@ -0,0 +1,157 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestSecureString(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	const redacted = "‹×›"
+	s := security.SecureString("hunter2")
+	if string(s.UnsafeBytes()) != "hunter2" {
+		t.Errorf("expected hunter2, got %q", s.UnsafeBytes())
+	}
+
+	// The secret is never formatted nor encoded.
+	for _, format := range []string{"%s", "%v", "%+v", "%#v", "%q", "%x", "%X", "%d", "%10s"} {
+		if out := fmt.Sprintf(format, s); out != redacted {
+			t.Errorf("%s: expected %s, got %s", format, redacted, out)
+		}
+	}
+	if out := fmt.Sprint(s) + s.String(); out != redacted+redacted {
+		t.Errorf("expected %s, got %s", redacted, out)
+	}
+	login := struct {
+		User     string
+		Password security.SecureString
+	}{"alice", s}
+	if out := fmt.Sprintf("%+v", login); strings.Contains(out, "hunter2") {
+		t.Errorf("leaked the password: %s", out)
+	}
+	b, err := json.Marshal(login)
+	const expected = `{"User":"alice","Password":"‹×›"}`
+	if err != nil || string(b) != expected {
+		t.Errorf("expected %s, got %s, %v", expected, b, err)
+	}
+
+	// It can be hashed and compared as is.
+	hash, err := security.HashPasswordBytes(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := security.CompareHashAndPasswordBytes(hash, s); err != nil {
+		t.Errorf("expected the password to match, got %v", err)
+	}
+	secureHash, err := security.HashPasswordSecure(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range [][]byte{hash, secureHash} {
+		if err := security.CompareHashAndPasswordSecure(h, s); err != nil {
+			t.Errorf("expected the password to match, got %v", err)
+		}
+	}
+
+	// Destroy zeroes the backing array.
+	backing := s.UnsafeBytes()[:cap(s)]
+	s.Destroy()
+	if !bytes.Equal(backing, make([]byte, len(backing))) {
+		t.Errorf("expected the backing array to be zeroed, got %q", backing)
+	}
+	if err := security.CompareHashAndPasswordBytes(hash, s); err == nil {
+		t.Error("expected the destroyed password not to match")
+	}
+
+	// The passwords read by prompters are SecureStrings.
+	p := security.NewTestPrompter([]string{"hunter2"})
+	password, err := p.ReadPasswordBytes()
+	if err != nil || fmt.Sprint(password) != redacted || string(password) != "hunter2" {
+		t.Errorf("expected a redacted hunter2, got %q, %v", password.UnsafeBytes(), err)
+	}
+	password.Destroy()
+}
+
+func TestSecureStringRedaction(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	const secret = "hunter2"
+	s := security.SecureString(secret)
+	// leaks reports whether the output contains the secret, or its hex or
+	// quoted encodings.
+	leaks := func(out string) bool {
+		for _, enc := range []string{
+			secret, fmt.Sprintf("%x", secret), fmt.Sprintf("%X", secret), fmt.Sprintf("%q", secret),
+			fmt.Sprintf("% x", secret), fmt.Sprint([]byte(secret)),
+		} {
+			if strings.Contains(out, enc) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, format := range []string{"%v", "%s", "%q", "%#v", "%x", "%X", "% x", "%+v"} {
+		out := fmt.Sprintf(format, s)
+		if leaks(out) || !strings.Contains(out, "‹×›") {
+			t.Errorf("%s: expected a redacted secret, got %s", format, out)
+		}
+	}
+	for _, out := range []string{
+		s.String(), s.GoString(), s.SafeMessage(),
+		// E.g. the arguments of a debug log line.
+		fmt.Sprint("user", "alice", "password", s),
+		fmt.Sprintf("%v", []interface{}{"alice", s}),
+		fmt.Sprintf("%#v", []interface{}{"alice", s}),
+		fmt.Sprintf("%+v", map[string]interface{}{"password": s}),
+		fmt.Sprintf("%v", []security.SecureString{s}),
+		// Errors quoting it.
+		fmt.Errorf("invalid password %s", s).Error(),
+		errors.Errorf("invalid password %q", s).Error(),
+		fmt.Sprintf("%+v", errors.Wrapf(errors.New("login failed"), "password %v", s)),
+	} {
+		if leaks(out) || !strings.Contains(out, "‹×›") {
+			t.Errorf("expected a redacted secret, got %s", out)
+		}
+	}
+
+	// The errors of the package do not quote the password either, e.g. when
+	// SASLprep rejects one of its characters.
+	security.SetPasswordNormalization(true)
+	defer security.SetPasswordNormalization(false)
+	for _, password := range []string{
+		secret + "\ue000", secret + "\u0627", secret + "\x00", secret + "\xff",
+		strings.Repeat(secret, 1000),
+	} {
+		_, err := security.HashPasswordSecure(security.SecureString(password))
+		if err == nil {
+			t.Fatalf("%+q: expected an error", password)
+		}
+		if msg := err.Error(); leaks(msg) || strings.Contains(msg, "U+") {
+			t.Errorf("%+q: the error quotes the password: %s", password, msg)
+		}
+	}
+}
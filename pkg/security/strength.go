@@ -0,0 +1,1026 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+// The strength estimator follows zxcvbn (Wheeler, "zxcvbn: Low-Budget
+// Password Strength Estimation", USENIX Security 2016): the password is
+// matched against patterns an attacker would try first, the number of
+// guesses needed to find each match is estimated, and the password is scored
+// by the cheapest sequence of matches covering it, with the characters not
+// covered by any match brute-forced.
+
+const (
+	// maxStrengthRunes bounds the part of a password examined by the
+	// estimator, whose cost is cubic in its length. Each additional rune is
+	// counted as brute-forced.
+	maxStrengthRunes = 100
+	// maxDictionaryWordRunes bounds the length of dictionary matches.
+	maxDictionaryWordRunes = 40
+	// bruteforceCardinalityLog10 is the log10 of the number of guesses per
+	// brute-forced rune.
+	bruteforceCardinalityLog10 = 1
+	// minSequenceGuessesLog10 is the log10 of the number of guesses of an
+	// additional match in a sequence, which keeps long sequences of cheap
+	// matches, e.g. one per rune, from being estimated as weak.
+	minSequenceGuessesLog10 = 4
+	// minYearSpace is the minimum distance to the reference year used to
+	// estimate the guesses of years and dates.
+	minYearSpace = 20
+)
+
+// PatternKind is a kind of pattern matched by the strength estimator.
+type PatternKind int
+
+const (
+	// BruteforcePattern are runes that match no other pattern.
+	BruteforcePattern PatternKind = iota
+	// DictionaryPattern are common passwords, English words and user
+	// inputs.
+	DictionaryPattern
+	// SpatialPattern are walks on a QWERTY keyboard, e.g. "qwerty" or "zxcvb".
+	SpatialPattern
+	// RepeatPattern are repeated runes or strings, e.g. "aaa" or "abcabc".
+	RepeatPattern
+	// SequencePattern are sequences of consecutive letters or digits, e.g.
+	// "abcd" or "9876".
+	SequencePattern
+	// DatePattern are years and dates, e.g. "1987" or "12/25/87".
+	DatePattern
+)
+
+func (k PatternKind) String() string {
+	switch k {
+	case BruteforcePattern:
+		return "bruteforce"
+	case DictionaryPattern:
+		return "dictionary"
+	case SpatialPattern:
+		return "spatial"
+	case RepeatPattern:
+		return "repeat"
+	case SequencePattern:
+		return "sequence"
+	case DatePattern:
+		return "date"
+	default:
+		return fmt.Sprintf("PatternKind(%d)", int(k))
+	}
+}
+
+// WordTransformation is a set of transformations of the word matched by a
+// DictionaryPattern.
+type WordTransformation uint8
+
+const (
+	// LeetTransformation is the substitution of letters of the word by
+	// similar digits or symbols, e.g. "p@ssw0rd" for "password".
+	LeetTransformation WordTransformation = 1 << iota
+	// AppendedCharacterTransformation is a single digit or symbol appended
+	// to the word, e.g. "dragon1" for "dragon".
+	AppendedCharacterTransformation
+)
+
+func (t WordTransformation) String() string {
+	if t == 0 {
+		return "none"
+	}
+	var names []string
+	if t&LeetTransformation != 0 {
+		names = append(names, "leet")
+	}
+	if t&AppendedCharacterTransformation != 0 {
+		names = append(names, "appended character")
+	}
+	if rest := t &^ (LeetTransformation | AppendedCharacterTransformation); rest != 0 {
+		names = append(names, fmt.Sprintf("WordTransformation(%d)", uint8(rest)))
+	}
+	return strings.Join(names, "+")
+}
+
+// Dictionaries of DictionaryPattern matches.
+const (
+	// PasswordsDictionary is the list of common passwords (see
+	// IsCommonPassword).
+	PasswordsDictionary = "passwords"
+	// EnglishDictionary is the list of English words, ranked by frequency.
+	EnglishDictionary = "english"
+	// UserInputsDictionary are the user inputs passed to the estimator.
+	UserInputsDictionary = "user_inputs"
+)
+
+// PatternMatch is a part of a password matched by a pattern.
+type PatternMatch struct {
+	Kind PatternKind
+	// Start and End are the offsets, in runes, of the matched part of the
+	// password, which is Token.
+	Start, End int
+	Token      string
+	// Dictionary and Rank are the dictionary matched by a DictionaryPattern
+	// and the rank of the word in it, from 1 for the most common word.
+	Dictionary string
+	Rank       int
+	// Word is the word matched by a DictionaryPattern, in lowercase, once the
+	// transformations of the token are undone, e.g. "password" for
+	// "P@ssw0rd!", so that the match can be explained to the user.
+	Word            string
+	Transformations WordTransformation
+	// Substitutions maps the runes of the token substituted for letters of
+	// the word by a LeetTransformation to those letters, e.g. '@' to 'a'.
+	Substitutions map[rune]rune
+	// GuessesLog10 is the log10 of the estimated number of guesses needed to
+	// find the token.
+	GuessesLog10 float64
+}
+
+// StrengthResult is the result of EstimatePasswordStrength.
+type StrengthResult struct {
+	// GuessesLog10 is the log10 of the estimated number of guesses needed to
+	// find the password.
+	GuessesLog10 float64
+	// Score is a rating of the password from 0, too guessable, to 4, very
+	// unguessable.
+	Score int
+	// Sequence are the matches composing the password, in order, which
+	// cover the whole examined part of the password.
+	Sequence []PatternMatch
+}
+
+// strengthScoreThresholds are the GuessesLog10 from which the scores from 1
+// to 4 are given: below 10^3 guesses, a password falls to an online attack
+// even when it is throttled, while above 10^10 it resists an offline attack
+// on a slow hash.
+var strengthScoreThresholds = [...]float64{3, 6, 8, 10}
+
+// EstimatePasswordStrength estimates how many guesses an attacker needs to
+// find a password. The password is matched against common passwords, about
+// 30,000 English words ranked by frequency, the user inputs, keyboard walks,
+// repeats, sequences and dates, and the estimate is that of the cheapest
+// sequence of matches composing it, so that passphrases of common words,
+// e.g. "correcthorsebatterystaple", are estimated by their words rather than
+// by their length. Dictionary words are also matched with l33t
+// substitutions, e.g. "p@ssw0rd", and followed by a single digit or symbol;
+// the transformations of the words matched are reported with their matches.
+// The user inputs, e.g. the username and the cluster name, are matched case
+// insensitively and rank first among dictionary words, so that passwords
+// made of them are estimated as trivially guessable.
+//
+// Only the first 100 runes of a password are matched against patterns; each
+// additional rune multiplies the estimate by 10.
+func EstimatePasswordStrength(password string, userInputs []string) StrengthResult {
+	runes := []rune(password)
+	var excess int
+	if len(runes) > maxStrengthRunes {
+		excess = len(runes) - maxStrengthRunes
+		runes = runes[:maxStrengthRunes]
+	}
+	e := newStrengthEstimator(userInputs)
+	result := e.estimate(runes)
+	result.GuessesLog10 += float64(excess) * bruteforceCardinalityLog10
+	result.Score = strengthScore(result.GuessesLog10)
+	return result
+}
+
+func strengthScore(guessesLog10 float64) int {
+	score := 0
+	for _, t := range strengthScoreThresholds {
+		if guessesLog10 >= t {
+			score++
+		}
+	}
+	return score
+}
+
+// ErrPasswordTooWeak is the cause of the errors returned for passwords whose
+// estimated strength is below the minimum score of the password policy.
+var ErrPasswordTooWeak = errors.New("password is too weak")
+
+// PasswordTooWeakError is returned for passwords whose estimated strength is
+// below the minimum score of the password policy.
+type PasswordTooWeakError struct {
+	// Required is the minimum score, and Score the score of the password, as
+	// returned by EstimatePasswordStrength.
+	Required, Score int
+}
+
+func (e *PasswordTooWeakError) Error() string {
+	return fmt.Sprintf("%s: its strength score is %d, the minimum is %d",
+		ErrPasswordTooWeak, e.Score, e.Required)
+}
+
+// Cause implements the causer interface.
+func (e *PasswordTooWeakError) Cause() error {
+	return ErrPasswordTooWeak
+}
+
+// strengthEstimator holds the state of an estimation.
+type strengthEstimator struct {
+	userInputs map[string]int
+	// referenceYear is the year from which the guesses of years are
+	// estimated.
+	referenceYear int
+}
+
+func newStrengthEstimator(userInputs []string) *strengthEstimator {
+	e := &strengthEstimator{
+		userInputs:    make(map[string]int, len(userInputs)),
+		referenceYear: timeutil.Now().Year(),
+	}
+	for _, input := range userInputs {
+		input = strings.ToLower(norm.NFKC.String(input))
+		if _, ok := e.userInputs[input]; !ok && input != "" {
+			e.userInputs[input] = len(e.userInputs) + 1
+		}
+	}
+	return e
+}
+
+// estimate returns the cheapest sequence of matches composing the runes.
+func (e *strengthEstimator) estimate(runes []rune) StrengthResult {
+	n := len(runes)
+	if n == 0 {
+		return StrengthResult{}
+	}
+	matches := e.matches(runes)
+	byEnd := make([][]*PatternMatch, n+1)
+	for i := range matches {
+		m := &matches[i]
+		// Matches shorter than the password cannot be cheaper than a guess
+		// per rune, as in zxcvbn.
+		if m.End-m.Start < n {
+			min := 1.0
+			if m.End-m.Start > 1 {
+				min = math.Log10(50)
+			}
+			m.GuessesLog10 = math.Max(m.GuessesLog10, min)
+		}
+		byEnd[m.End] = append(byEnd[m.End], m)
+	}
+
+	// best[j][l][bf] is the cheapest product of guesses, in log10, of a
+	// sequence of l matches covering runes[:j], ending with a brute-forced
+	// run if bf is 1: consecutive brute-forced runs are merged rather than
+	// counted as multiple matches.
+	type state struct {
+		guessesLog10 float64
+		match        *PatternMatch
+		prevBF       int
+		ok           bool
+	}
+	best := make([][][2]state, n+1)
+	for j := range best {
+		best[j] = make([][2]state, n+1)
+	}
+	best[0][0][0] = state{ok: true}
+	improve := func(j, l, bf int, m *PatternMatch, prevBF int) {
+		prev := best[m.Start][l-1][prevBF]
+		if !prev.ok {
+			return
+		}
+		g := prev.guessesLog10 + m.GuessesLog10
+		if s := &best[j][l][bf]; !s.ok || g < s.guessesLog10 {
+			*s = state{guessesLog10: g, match: m, prevBF: prevBF, ok: true}
+		}
+	}
+	for j := 1; j <= n; j++ {
+		for _, m := range byEnd[j] {
+			for l := 1; l <= m.Start+1; l++ {
+				improve(j, l, 0, m, 0)
+				improve(j, l, 0, m, 1)
+			}
+		}
+		for i := 0; i < j; i++ {
+			m := &PatternMatch{
+				Kind:         BruteforcePattern,
+				Start:        i,
+				End:          j,
+				Token:        string(runes[i:j]),
+				GuessesLog10: float64(j-i) * bruteforceCardinalityLog10,
+			}
+			for l := 1; l <= i+1; l++ {
+				improve(j, l, 1, m, 0)
+			}
+		}
+	}
+
+	// The number of guesses of a sequence of l matches accounts for the l!
+	// orderings of its matches, and for the guesses of shorter sequences,
+	// which an attacker tries first.
+	result := StrengthResult{GuessesLog10: math.Inf(1)}
+	bestL, bestBF := 0, 0
+	for l := 1; l <= n; l++ {
+		for bf := 0; bf < 2; bf++ {
+			s := best[n][l][bf]
+			if !s.ok {
+				continue
+			}
+			lgamma, _ := math.Lgamma(float64(l + 1))
+			g := addLog10(lgamma/math.Ln10+s.guessesLog10, float64(l-1)*minSequenceGuessesLog10)
+			if g < result.GuessesLog10 {
+				result.GuessesLog10, bestL, bestBF = g, l, bf
+			}
+		}
+	}
+	for j, l, bf := n, bestL, bestBF; j > 0; l-- {
+		s := best[j][l][bf]
+		result.Sequence = append(result.Sequence, *s.match)
+		j, bf = s.match.Start, s.prevBF
+	}
+	for i, j := 0, len(result.Sequence)-1; i < j; i, j = i+1, j-1 {
+		result.Sequence[i], result.Sequence[j] = result.Sequence[j], result.Sequence[i]
+	}
+	return result
+}
+
+// addLog10 returns log10(10^a + 10^b).
+func addLog10(a, b float64) float64 {
+	if a < b {
+		a, b = b, a
+	}
+	return a + math.Log10(1+math.Pow(10, b-a))
+}
+
+// log10Binomial returns log10 of the binomial coefficient (n k).
+func log10Binomial(n, k int) float64 {
+	if k < 0 || k > n {
+		return math.Inf(-1)
+	}
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+	return (a - b - c) / math.Ln10
+}
+
+// matches returns the matches of all patterns in the runes.
+func (e *strengthEstimator) matches(runes []rune) []PatternMatch {
+	var matches []PatternMatch
+	matches = e.dictionaryMatches(runes, matches)
+	matches = spatialMatches(runes, matches)
+	matches = e.repeatMatches(runes, matches)
+	matches = sequenceMatches(runes, matches)
+	matches = e.dateMatches(runes, matches)
+	return matches
+}
+
+// dictionaryMatches appends the substrings of the runes found, case
+// insensitively, in the dictionaries, as is or with l33t substitutions, and
+// followed or not by a single digit or symbol.
+func (e *strengthEstimator) dictionaryMatches(runes []rune, matches []PatternMatch) []PatternMatch {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	start := len(matches)
+	matches = e.wordMatches(runes, lower, lower, nil, matches)
+	for _, table := range leetTables(lower) {
+		subbed := make([]rune, len(lower))
+		for i, r := range lower {
+			if l, ok := table[r]; ok {
+				r = l
+			}
+			subbed[i] = r
+		}
+		matches = e.wordMatches(runes, lower, subbed, table, matches)
+	}
+	for i, end := start, len(matches); i < end; i++ {
+		m := matches[i]
+		if m.End == len(runes) || !isAppendedRune(runes[m.End]) {
+			continue
+		}
+		m.End++
+		m.Token = string(runes[m.Start:m.End])
+		m.Transformations |= AppendedCharacterTransformation
+		m.GuessesLog10 += appendedCharacterGuessesLog10
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// wordMatches appends the substrings of the runes whose lowercase l33t
+// translation by a table, subbed, is found in the dictionaries. Without a
+// table, subbed is the lowercase runes, lower. With one, only the
+// substrings with a substitution are appended.
+func (e *strengthEstimator) wordMatches(
+	runes, lower, subbed []rune, table map[rune]rune, matches []PatternMatch,
+) []PatternMatch {
+	for i := range subbed {
+		for j := i + 1; j <= len(subbed) && j-i <= maxDictionaryWordRunes; j++ {
+			var subs map[rune]rune
+			if table != nil {
+				for k := i; k < j; k++ {
+					if subbed[k] != lower[k] {
+						if subs == nil {
+							subs = make(map[rune]rune)
+						}
+						subs[lower[k]] = subbed[k]
+					}
+				}
+				if subs == nil {
+					continue
+				}
+			}
+			word := string(subbed[i:j])
+			dictionary, rank := UserInputsDictionary, e.userInputs[word]
+			if rank == 0 {
+				dictionary, rank = PasswordsDictionary, commonPasswordRank(word)
+				// A word in both lists is counted with its lower rank.
+				if r := englishWordRank(word); r != 0 && (rank == 0 || r < rank) {
+					dictionary, rank = EnglishDictionary, r
+				}
+			}
+			if rank == 0 {
+				continue
+			}
+			m := PatternMatch{
+				Kind:       DictionaryPattern,
+				Start:      i,
+				End:        j,
+				Token:      string(runes[i:j]),
+				Dictionary: dictionary,
+				Rank:       rank,
+				Word:       word,
+				GuessesLog10: math.Log10(float64(rank)) +
+					uppercaseVariationsLog10(runes[i:j]),
+			}
+			if subs != nil {
+				m.Transformations = LeetTransformation
+				m.Substitutions = subs
+				m.GuessesLog10 += leetVariationsLog10(lower[i:j], subs)
+			}
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+// leetSubstitutions maps the digits and symbols commonly substituted for
+// letters to those letters.
+var leetSubstitutions = map[rune][]rune{
+	'4': {'a'}, '@': {'a'},
+	'8': {'b'},
+	'(': {'c'}, '{': {'c'}, '[': {'c'}, '<': {'c'},
+	'3': {'e'},
+	'6': {'g'}, '9': {'g'},
+	'1': {'i', 'l'}, '!': {'i'}, '|': {'i', 'l'},
+	'7': {'l', 't'},
+	'0': {'o'},
+	'$': {'s'}, '5': {'s'},
+	'+': {'t'},
+	'%': {'x'},
+	'2': {'z'},
+}
+
+// maxLeetTables bounds the number of substitution tables tried by
+// leetTables.
+const maxLeetTables = 16
+
+// leetTables returns the tables mapping each of the l33t runes of a password
+// to one of the letters it may stand for. Like zxcvbn, a table substitutes
+// all the occurrences of a rune by the same letter.
+func leetTables(lower []rune) []map[rune]rune {
+	var leet []rune
+	seen := make(map[rune]bool)
+	for _, r := range lower {
+		if _, ok := leetSubstitutions[r]; ok && !seen[r] {
+			seen[r] = true
+			leet = append(leet, r)
+		}
+	}
+	if len(leet) == 0 {
+		return nil
+	}
+	tables := []map[rune]rune{{}}
+	for _, r := range leet {
+		letters := leetSubstitutions[r]
+		var next []map[rune]rune
+		for _, t := range tables {
+			for _, l := range letters {
+				if len(next) == maxLeetTables {
+					break
+				}
+				c := make(map[rune]rune, len(t)+1)
+				for k, v := range t {
+					c[k] = v
+				}
+				c[r] = l
+				next = append(next, c)
+			}
+		}
+		tables = next
+	}
+	return tables
+}
+
+// leetVariationsLog10 returns the log10 of the number of ways to substitute
+// the letters of a word with as many substitutions as the token, as in
+// zxcvbn: the letters which stand for a substituted rune can be
+// substituted or not.
+func leetVariationsLog10(lower []rune, subs map[rune]rune) float64 {
+	var variations float64
+	for subbed, unsubbed := range subs {
+		var s, u int
+		for _, r := range lower {
+			switch r {
+			case subbed:
+				s++
+			case unsubbed:
+				u++
+			}
+		}
+		if s == 0 || u == 0 {
+			variations += math.Log10(2)
+			continue
+		}
+		possibilities := math.Inf(-1)
+		for k := 1; k <= s && k <= u; k++ {
+			possibilities = addLog10(possibilities, log10Binomial(s+u, k))
+		}
+		variations += possibilities
+	}
+	return variations
+}
+
+// appendedCharacterGuessesLog10 is the log10 of the number of digits and
+// ASCII symbols which can be appended to a word.
+var appendedCharacterGuessesLog10 = math.Log10(10 + 32)
+
+// isAppendedRune returns whether a rune is a digit or symbol which may be
+// appended to a word.
+func isAppendedRune(r rune) bool {
+	return unicode.IsDigit(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// uppercaseVariationsLog10 returns the log10 of the number of ways to
+// capitalize a word with as many uppercase letters as the token, with the
+// common capitalizations (first letter, last letter, all letters) counted
+// as a single additional guess.
+func uppercaseVariationsLog10(token []rune) float64 {
+	var upper, lower int
+	for _, r := range token {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+		case unicode.IsLower(r):
+			lower++
+		}
+	}
+	if upper == 0 {
+		return 0
+	}
+	if lower == 0 || upper == 1 && (unicode.IsUpper(token[0]) ||
+		unicode.IsUpper(token[len(token)-1])) {
+		return math.Log10(2)
+	}
+	variations := math.Inf(-1)
+	for k := 1; k <= upper && k <= lower; k++ {
+		variations = addLog10(variations, log10Binomial(upper+lower, k))
+	}
+	return variations
+}
+
+// qwertyRows are the rows of a QWERTY keyboard, unshifted and shifted. The
+// leading spaces are the keys to the left of the rows, so that the key at
+// a column of a row touches the keys at the same column and the next one in
+// the row above.
+var qwertyRows = [...][2]string{
+	{"`1234567890-=", "~!@#$%^&*()_+"},
+	{" qwertyuiop[]\\", " QWERTYUIOP{}|"},
+	{" asdfghjkl;'", " ASDFGHJKL:\""},
+	{" zxcvbnm,./", " ZXCVBNM<>?"},
+}
+
+// qwertyKey is the position of a rune on the QWERTY keyboard.
+type qwertyKey struct {
+	row, col int
+	shifted  bool
+}
+
+// qwertyKeys maps the runes of the QWERTY keyboard to their key.
+var qwertyKeys = func() map[rune]qwertyKey {
+	keys := make(map[rune]qwertyKey)
+	for row, r := range qwertyRows {
+		for shifted, s := range r {
+			for col, c := range s {
+				if c != ' ' {
+					keys[c] = qwertyKey{row: row, col: col, shifted: shifted == 1}
+				}
+			}
+		}
+	}
+	return keys
+}()
+
+// qwertyDirections are the offsets of the neighbors of a key.
+var qwertyDirections = [...][2]int{{0, -1}, {0, 1}, {-1, 0}, {-1, 1}, {1, -1}, {1, 0}}
+
+// qwertyDirection returns the direction from a key to another, or -1 if
+// they are not adjacent.
+func qwertyDirection(from, to qwertyKey) int {
+	for i, d := range qwertyDirections {
+		if from.row+d[0] == to.row && from.col+d[1] == to.col {
+			return i
+		}
+	}
+	return -1
+}
+
+// qwertyAverageDegreeLog10 and qwertyStartingPositionsLog10 are the log10 of
+// the average number of neighbors of a key and of the number of runes on the
+// keyboard.
+var qwertyAverageDegreeLog10, qwertyStartingPositionsLog10 = func() (float64, float64) {
+	var neighbors int
+	for _, from := range qwertyKeys {
+		for _, to := range qwertyKeys {
+			if !to.shifted && qwertyDirection(from, to) >= 0 {
+				neighbors++
+			}
+		}
+	}
+	return math.Log10(float64(neighbors) / float64(len(qwertyKeys))),
+		math.Log10(float64(len(qwertyKeys)))
+}()
+
+// spatialMatches appends the walks of at least 3 adjacent keys of a QWERTY
+// keyboard. Runes which are not on the keyboard end walks.
+func spatialMatches(runes []rune, matches []PatternMatch) []PatternMatch {
+	for i := 0; i < len(runes); {
+		prev, ok := qwertyKeys[runes[i]]
+		if !ok {
+			i++
+			continue
+		}
+		j, turns, shifted, direction := i+1, 0, 0, -1
+		if prev.shifted {
+			shifted++
+		}
+		for ; j < len(runes); j++ {
+			key, ok := qwertyKeys[runes[j]]
+			if !ok {
+				break
+			}
+			d := qwertyDirection(prev, key)
+			if d < 0 {
+				break
+			}
+			if d != direction {
+				turns++
+				direction = d
+			}
+			if key.shifted {
+				shifted++
+			}
+			prev = key
+		}
+		if j-i >= 3 {
+			matches = append(matches, PatternMatch{
+				Kind:         SpatialPattern,
+				Start:        i,
+				End:          j,
+				Token:        string(runes[i:j]),
+				GuessesLog10: spatialGuessesLog10(j-i, turns, shifted),
+			})
+			i = j
+		} else {
+			i++
+		}
+	}
+	return matches
+}
+
+// spatialGuessesLog10 estimates the guesses of a keyboard walk of a length
+// with a number of turns and of shifted keys: the number of walks with at
+// most as many turns, times the number of ways to shift as many keys.
+func spatialGuessesLog10(length, turns, shifted int) float64 {
+	guesses := math.Inf(-1)
+	for i := 2; i <= length; i++ {
+		for j := 1; j <= turns && j <= i-1; j++ {
+			guesses = addLog10(guesses, log10Binomial(i-1, j-1)+
+				qwertyStartingPositionsLog10+float64(j)*qwertyAverageDegreeLog10)
+		}
+	}
+	unshifted := length - shifted
+	switch {
+	case shifted == 0 || unshifted == 0:
+		guesses += math.Log10(2)
+	default:
+		variations := math.Inf(-1)
+		for k := 1; k <= shifted && k <= unshifted; k++ {
+			variations = addLog10(variations, log10Binomial(length, k))
+		}
+		guesses += variations
+	}
+	return guesses
+}
+
+// repeatMatches appends, for each rune, the longest run of repetitions of a
+// string starting with it, e.g. "aaa" or "abab": a string must be repeated
+// at least twice, and a single rune three times. The guesses of a repeat
+// are those of the repeated string times the number of repetitions.
+func (e *strengthEstimator) repeatMatches(runes []rune, matches []PatternMatch) []PatternMatch {
+	for i := 0; i < len(runes); {
+		bestLen, bestPeriod := 0, 0
+		for p := 1; i+2*p <= len(runes); p++ {
+			k := i + p
+			for k < len(runes) && runes[k] == runes[k-p] {
+				k++
+			}
+			if l := (k - i) / p * p; l > bestLen && l >= 2*p && (p > 1 || l >= 3) {
+				bestLen, bestPeriod = l, p
+			}
+		}
+		if bestLen == 0 {
+			i++
+			continue
+		}
+		var baseLog10 float64
+		if bestPeriod == 1 {
+			baseLog10 = math.Log10(runeCardinality(runes[i]))
+		} else {
+			baseLog10 = e.estimate(runes[i : i+bestPeriod]).GuessesLog10
+		}
+		matches = append(matches, PatternMatch{
+			Kind:         RepeatPattern,
+			Start:        i,
+			End:          i + bestLen,
+			Token:        string(runes[i : i+bestLen]),
+			GuessesLog10: baseLog10 + math.Log10(float64(bestLen/bestPeriod)),
+		})
+		i += bestLen
+	}
+	return matches
+}
+
+// DetectTrivialPatterns returns the parts of a password made of trivial
+// patterns: runs of at least 3 repetitions of a rune, e.g. "aaa", ascending
+// or descending sequences of ASCII letters or digits, e.g. "abcd" or "987",
+// and walks on a QWERTY keyboard, e.g. "qwerty" or "zxcvb". The matches do
+// not overlap and are ordered by offset; where patterns overlap, the longest
+// is reported. Runes other than ASCII letters, digits and symbols are never
+// part of sequences or keyboard walks, but can be part of runs.
+func DetectTrivialPatterns(password string) []PatternMatch {
+	runes := []rune(password)
+	var candidates []PatternMatch
+	candidates = runMatches(runes, candidates)
+	candidates = sequenceMatches(runes, candidates)
+	candidates = spatialMatches(runes, candidates)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].End-candidates[i].Start > candidates[j].End-candidates[j].Start
+	})
+	covered := make([]bool, len(runes))
+	var matches []PatternMatch
+outer:
+	for _, m := range candidates {
+		for k := m.Start; k < m.End; k++ {
+			if covered[k] {
+				continue outer
+			}
+		}
+		for k := m.Start; k < m.End; k++ {
+			covered[k] = true
+		}
+		matches = append(matches, m)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Start < matches[j].Start })
+	return matches
+}
+
+// runMatches appends the runs of at least 3 repetitions of a rune, which
+// are the repeats of a single rune.
+func runMatches(runes []rune, matches []PatternMatch) []PatternMatch {
+	for i := 0; i < len(runes); {
+		j := i + 1
+		for j < len(runes) && runes[j] == runes[i] {
+			j++
+		}
+		if j-i >= 3 {
+			matches = append(matches, PatternMatch{
+				Kind:         RepeatPattern,
+				Start:        i,
+				End:          j,
+				Token:        string(runes[i:j]),
+				GuessesLog10: math.Log10(runeCardinality(runes[i])) + math.Log10(float64(j-i)),
+			})
+		}
+		i = j
+	}
+	return matches
+}
+
+// runeCardinality returns the size of the class of a rune.
+func runeCardinality(r rune) float64 {
+	switch {
+	case unicode.IsDigit(r):
+		return 10
+	case unicode.IsLower(r) || unicode.IsUpper(r):
+		return 26
+	default:
+		return 33
+	}
+}
+
+// sequenceMatches appends the runs of at least 3 consecutive digits or
+// letters of the same case, ascending or descending, e.g. "abc" or "987".
+func sequenceMatches(runes []rune, matches []PatternMatch) []PatternMatch {
+	for i := 0; i+2 < len(runes); {
+		delta := runes[i+1] - runes[i]
+		j := i + 1
+		if delta == 1 || delta == -1 {
+			for j < len(runes) && runes[j]-runes[j-1] == delta &&
+				sequenceClass(runes[j]) == sequenceClass(runes[i]) && sequenceClass(runes[i]) != 0 {
+				j++
+			}
+		}
+		if j-i < 3 {
+			i++
+			continue
+		}
+		// Sequences starting with the first or last rune of their class are
+		// tried first.
+		var baseLog10 float64
+		switch first := runes[i]; {
+		case first == 'a' || first == 'A' || first == 'z' || first == 'Z' ||
+			first == '0' || first == '1' || first == '9':
+			baseLog10 = math.Log10(4)
+		case unicode.IsDigit(first):
+			baseLog10 = 1
+		default:
+			baseLog10 = math.Log10(26)
+		}
+		if delta < 0 {
+			baseLog10 += math.Log10(2)
+		}
+		matches = append(matches, PatternMatch{
+			Kind:         SequencePattern,
+			Start:        i,
+			End:          j,
+			Token:        string(runes[i:j]),
+			GuessesLog10: baseLog10 + math.Log10(float64(j-i)),
+		})
+		i = j
+	}
+	return matches
+}
+
+// sequenceClass returns the class of a rune in sequences: 1 for ASCII
+// digits, 2 for lowercase and 3 for uppercase ASCII letters, and 0 for runes
+// which are not part of sequences.
+func sequenceClass(r rune) int {
+	switch {
+	case r >= '0' && r <= '9':
+		return 1
+	case r >= 'a' && r <= 'z':
+		return 2
+	case r >= 'A' && r <= 'Z':
+		return 3
+	default:
+		return 0
+	}
+}
+
+// dateSeparators are the runes separating the parts of dates.
+const dateSeparators = " /\\_.-"
+
+// dateSplits are the ways to split dates without separators of 4 to 8
+// digits in three parts, as the offsets of the second and third parts.
+var dateSplits = map[int][][2]int{
+	4: {{1, 2}, {2, 3}},
+	5: {{1, 3}, {2, 3}},
+	6: {{1, 2}, {2, 4}, {4, 5}},
+	7: {{1, 3}, {2, 3}, {4, 5}, {4, 6}},
+	8: {{2, 4}, {4, 6}},
+}
+
+// dateMatches appends the years from 1900 to 2099, and the dates with or
+// without separators, e.g. "12/25/87" or "19871225".
+func (e *strengthEstimator) dateMatches(runes []rune, matches []PatternMatch) []PatternMatch {
+	for i := range runes {
+		for j := i + 4; j <= len(runes) && j-i <= 10; j++ {
+			token := runes[i:j]
+			guessesLog10, ok := e.dateGuessesLog10(token)
+			if !ok {
+				continue
+			}
+			matches = append(matches, PatternMatch{
+				Kind:         DatePattern,
+				Start:        i,
+				End:          j,
+				Token:        string(token),
+				GuessesLog10: guessesLog10,
+			})
+		}
+	}
+	return matches
+}
+
+// dateGuessesLog10 returns the guesses of a year or date: the distance of the
+// year to the reference year, times the days of a year for dates, times the
+// separators for dates with separators.
+func (e *strengthEstimator) dateGuessesLog10(token []rune) (float64, bool) {
+	yearSpace := func(year int) float64 {
+		d := year - e.referenceYear
+		if d < 0 {
+			d = -d
+		}
+		if d < minYearSpace {
+			d = minYearSpace
+		}
+		return math.Log10(float64(d))
+	}
+	sep := -1
+	for k, r := range token {
+		if r < '0' || r > '9' {
+			if sep >= 0 && r != token[sep] {
+				return 0, false
+			}
+			if sep < 0 {
+				sep = k
+			}
+		}
+	}
+	if sep < 0 {
+		digits := string(token)
+		if len(token) == 4 && (digits[:2] == "19" || digits[:2] == "20") {
+			return yearSpace(atoiDigits(token)), true
+		}
+		best, ok := 0.0, false
+		for _, split := range dateSplits[len(token)] {
+			year, valid := dateYear(atoiDigits(token[:split[0]]),
+				atoiDigits(token[split[0]:split[1]]), atoiDigits(token[split[1]:]))
+			if g := yearSpace(year); valid && (!ok || g < best) {
+				best, ok = g, true
+			}
+		}
+		return best + math.Log10(365), ok
+	}
+	if !strings.ContainsRune(dateSeparators, token[sep]) {
+		return 0, false
+	}
+	parts := strings.Split(string(token), string(token[sep]))
+	if len(parts) != 3 || len(parts[0]) < 1 || len(parts[0]) > 4 || len(parts[1]) < 1 ||
+		len(parts[1]) > 2 || len(parts[2]) < 1 || len(parts[2]) > 4 {
+		return 0, false
+	}
+	year, valid := dateYear(atoiDigits([]rune(parts[0])), atoiDigits([]rune(parts[1])),
+		atoiDigits([]rune(parts[2])))
+	if !valid {
+		return 0, false
+	}
+	return yearSpace(year) + math.Log10(365) + math.Log10(4), true
+}
+
+// dateYear returns the year of a date made of three numbers, in
+// year-month-day, year-day-month, day-month-year or month-day-year order,
+// with two-digit years in 1951-2050, or false if they are not a date.
+func dateYear(a, b, c int) (int, bool) {
+	dayMonth := func(x, y int) bool {
+		return x >= 1 && x <= 31 && y >= 1 && y <= 12 || y >= 1 && y <= 31 && x >= 1 && x <= 12
+	}
+	for _, candidate := range [...][3]int{{c, a, b}, {a, b, c}} {
+		year := candidate[0]
+		if !dayMonth(candidate[1], candidate[2]) {
+			continue
+		}
+		switch {
+		case year >= 1000 && year <= 2050:
+			return year, true
+		case year < 100 && year > 50:
+			return 1900 + year, true
+		case year < 100:
+			return 2000 + year, true
+		}
+	}
+	return 0, false
+}
+
+// atoiDigits returns the number made of ASCII digits.
+func atoiDigits(digits []rune) int {
+	n := 0
+	for _, r := range digits {
+		n = 10*n + int(r-'0')
+	}
+	return n
+}
@@ -0,0 +1,165 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"strings"
+	"sync/atomic"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// The identifiers of the suggestions returned by ScorePassword, which are
+// stable so that callers can localize them.
+const (
+	FeedbackUseFewWords        = "use_few_words"
+	FeedbackTop10Password      = "top_10_password"
+	FeedbackTop100Password     = "top_100_password"
+	FeedbackCommonPassword     = "common_password"
+	FeedbackSimilarToCommon    = "similar_to_common_password"
+	FeedbackAvoidUserInputs    = "avoid_user_inputs"
+	FeedbackCapitalization     = "capitalization"
+	FeedbackLeetSubstitutions  = "leet_substitutions"
+	FeedbackAppendedCharacter  = "appended_character"
+	FeedbackAvoidKeyboardWalks = "avoid_keyboard_walks"
+	FeedbackAvoidRepeats       = "avoid_repeats"
+	FeedbackAvoidSequences     = "avoid_sequences"
+	FeedbackAvoidDates         = "avoid_dates"
+	FeedbackAddAnotherWord     = "add_another_word"
+)
+
+// feedbackSuggestionSeparator separates the identifier of a suggestion from
+// its text.
+const feedbackSuggestionSeparator = ": "
+
+// feedbackTexts are the English texts of the suggestions, by identifier.
+var feedbackTexts = map[string]string{
+	FeedbackUseFewWords:        "use a few words, and avoid common phrases",
+	FeedbackTop10Password:      "this is a top-10 common password",
+	FeedbackTop100Password:     "this is a top-100 common password",
+	FeedbackCommonPassword:     "this is a very common password",
+	FeedbackSimilarToCommon:    "this is similar to a commonly used password",
+	FeedbackAvoidUserInputs:    "avoid your username and other personal information",
+	FeedbackCapitalization:     "capitalization does not help very much",
+	FeedbackLeetSubstitutions:  "predictable substitutions like '@' for 'a' do not help very much",
+	FeedbackAppendedCharacter:  "a digit or symbol appended to a word does not help very much",
+	FeedbackAvoidKeyboardWalks: "avoid keyboard patterns like qwerty",
+	FeedbackAvoidRepeats:       "avoid repeated characters and words like aaa or abcabc",
+	FeedbackAvoidSequences:     "avoid sequences like abc or 6543",
+	FeedbackAvoidDates:         "avoid dates and years that are associated with you",
+	FeedbackAddAnotherWord:     "add another word or two; uncommon words are better",
+}
+
+// goodStrengthScore is the score from which ScorePassword makes no
+// suggestion.
+const goodStrengthScore = 3
+
+// ScorePassword rates a password from 0 to 4, as EstimatePasswordStrength,
+// and explains the rating of passwords scoring below 3 with suggestions to
+// strengthen them, most specific first. Each suggestion is of the form
+// "<identifier>: <text>", e.g. "avoid_sequences: avoid sequences like abc or
+// 6543", where the identifier is one of the Feedback constants; see
+// SplitFeedback.
+func ScorePassword(password string, userInputs []string) (score int, feedback []string) {
+	result := EstimatePasswordStrength(password, userInputs)
+	if result.Score >= goodStrengthScore {
+		return result.Score, nil
+	}
+	var ids []string
+	seen := make(map[string]bool)
+	add := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if password == "" {
+		add(FeedbackUseFewWords)
+	}
+	sole := len(result.Sequence) == 1
+	for _, m := range result.Sequence {
+		switch m.Kind {
+		case DictionaryPattern:
+			dictionaryFeedback(m, sole, add)
+		case SpatialPattern:
+			add(FeedbackAvoidKeyboardWalks)
+		case RepeatPattern:
+			add(FeedbackAvoidRepeats)
+		case SequencePattern:
+			add(FeedbackAvoidSequences)
+		case DatePattern:
+			add(FeedbackAvoidDates)
+		}
+	}
+	add(FeedbackAddAnotherWord)
+	feedback = make([]string, len(ids))
+	for i, id := range ids {
+		feedback[i] = id + feedbackSuggestionSeparator + feedbackTexts[id]
+	}
+	return result.Score, feedback
+}
+
+// dictionaryFeedback adds the suggestions for a match of a dictionary word,
+// which is the sole match of the password if sole is set.
+func dictionaryFeedback(m PatternMatch, sole bool, add func(string)) {
+	switch {
+	case m.Dictionary == UserInputsDictionary:
+		add(FeedbackAvoidUserInputs)
+	case !sole || m.Transformations != 0:
+		add(FeedbackSimilarToCommon)
+	case m.Rank <= 10:
+		add(FeedbackTop10Password)
+	case m.Rank <= 100:
+		add(FeedbackTop100Password)
+	default:
+		add(FeedbackCommonPassword)
+	}
+	if strings.IndexFunc(m.Token, unicode.IsUpper) >= 0 {
+		add(FeedbackCapitalization)
+	}
+	if m.Transformations&LeetTransformation != 0 {
+		add(FeedbackLeetSubstitutions)
+	}
+	if m.Transformations&AppendedCharacterTransformation != 0 {
+		add(FeedbackAppendedCharacter)
+	}
+}
+
+// SplitFeedback splits a suggestion returned by ScorePassword into its
+// identifier and its English text.
+func SplitFeedback(feedback string) (id, text string) {
+	i := strings.Index(feedback, feedbackSuggestionSeparator)
+	if i < 0 {
+		return feedback, ""
+	}
+	return feedback[:i], feedback[i+len(feedbackSuggestionSeparator):]
+}
+
+// passwordWarningScore is the score set by SetPasswordStrengthWarning.
+var passwordWarningScore int32
+
+// SetPasswordStrengthWarning makes PromptForPasswordTwice warn, without
+// rejecting the password, when the score of the password, as returned by
+// ScorePassword, is below score, and print the main suggestion to
+// strengthen it, as WithStrengthCheck in StrengthWarn mode. The score must
+// be between 0 and 4; 0, the default, disables the warning.
+func SetPasswordStrengthWarning(score int) error {
+	if score < 0 || score > 4 {
+		return errors.Errorf("invalid password strength warning score %d", score)
+	}
+	atomic.StoreInt32(&passwordWarningScore, int32(score))
+	return nil
+}
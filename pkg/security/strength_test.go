@@ -0,0 +1,400 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestEstimatePasswordStrength(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	userInputs := []string{"Roach_Admin", "acme-prod"}
+	for _, tc := range []struct {
+		password string
+		// patterns are the kinds of the matches of the password; bruteforced
+		// runs are omitted.
+		patterns []security.PatternKind
+		maxScore int
+		minScore int
+	}{
+		{"password", []security.PatternKind{security.DictionaryPattern}, 0, 0},
+		{"PASSWORD", []security.PatternKind{security.DictionaryPattern}, 0, 0},
+		{"roach_admin", []security.PatternKind{security.DictionaryPattern}, 0, 0},
+		{"acme-prod!", []security.PatternKind{security.DictionaryPattern}, 1, 0},
+		{"aaaaaaaaaa", []security.PatternKind{security.RepeatPattern}, 0, 0},
+		{"xyzxyzxyz", []security.PatternKind{security.RepeatPattern}, 0, 0},
+		{"lmnopqrs", []security.PatternKind{security.SequencePattern}, 0, 0},
+		{"87654", []security.PatternKind{security.SequencePattern}, 0, 0},
+		{"zxcvbnm,.", []security.PatternKind{security.SpatialPattern}, 1, 0},
+		{"1987", []security.PatternKind{security.DatePattern}, 0, 0},
+		{"25.12.1987", []security.PatternKind{security.DatePattern}, 1, 0},
+		{"19871225", []security.PatternKind{security.DatePattern}, 1, 0},
+		{"x7#Kq!9vLp2@", nil, 4, 4},
+		{"日本語のパスワードです", nil, 4, 3},
+		{"", nil, 0, 0},
+	} {
+		r := security.EstimatePasswordStrength(tc.password, userInputs)
+		var patterns []security.PatternKind
+		var tokens []string
+		end := 0
+		for _, m := range r.Sequence {
+			if m.Start != end {
+				t.Errorf("%q: the matches do not cover the password: %+v", tc.password, r.Sequence)
+			}
+			end = m.End
+			tokens = append(tokens, m.Token)
+			if m.Kind != security.BruteforcePattern {
+				patterns = append(patterns, m.Kind)
+			}
+		}
+		if joined := strings.Join(tokens, ""); joined != tc.password {
+			t.Errorf("%q: the tokens of the matches form %q", tc.password, joined)
+		}
+		if len(patterns) != len(tc.patterns) {
+			t.Errorf("%q: expected patterns %s, got %+v", tc.password, tc.patterns, r.Sequence)
+		} else {
+			for i := range patterns {
+				if patterns[i] != tc.patterns[i] {
+					t.Errorf("%q: expected patterns %s, got %+v", tc.password, tc.patterns, r.Sequence)
+				}
+			}
+		}
+		if r.Score < tc.minScore || r.Score > tc.maxScore {
+			t.Errorf("%q: expected a score in [%d, %d], got %d (%.1f)",
+				tc.password, tc.minScore, tc.maxScore, r.Score, r.GuessesLog10)
+		}
+	}
+
+	// Dictionary matches report their dictionary and rank.
+	r := security.EstimatePasswordStrength("Password", userInputs)
+	if m := r.Sequence[0]; m.Dictionary != security.PasswordsDictionary || m.Rank != 2 {
+		t.Errorf("unexpected match %+v", m)
+	}
+	r = security.EstimatePasswordStrength("ROACH_ADMIN", userInputs)
+	if m := r.Sequence[0]; m.Dictionary != security.UserInputsDictionary || m.Rank != 1 {
+		t.Errorf("unexpected match %+v", m)
+	}
+
+	// Capitalization and appended characters make passwords stronger, but
+	// not as strong as random ones.
+	weak := security.EstimatePasswordStrength("password", nil).GuessesLog10
+	capitalized := security.EstimatePasswordStrength("pAssWord", nil).GuessesLog10
+	if capitalized <= weak {
+		t.Errorf("expected %.1f > %.1f", capitalized, weak)
+	}
+
+	// Words are also matched with l33t substitutions and a single appended
+	// digit or symbol, and the transformations are reported.
+	for _, tc := range []struct {
+		password        string
+		word            string
+		transformations security.WordTransformation
+		substitutions   map[rune]rune
+	}{
+		{"P@ssw0rd!", "password", security.LeetTransformation |
+			security.AppendedCharacterTransformation, map[rune]rune{'@': 'a', '0': 'o'}},
+		{"p@ssw0rd", "password", security.LeetTransformation, map[rune]rune{'@': 'a', '0': 'o'}},
+		{"dragon1", "dragon", security.AppendedCharacterTransformation, nil},
+		{"M0nk3y", "monkey", security.LeetTransformation, map[rune]rune{'0': 'o', '3': 'e'}},
+		{"l3tm31n$", "letmein", security.LeetTransformation |
+			security.AppendedCharacterTransformation, map[rune]rune{'3': 'e', '1': 'i'}},
+		{"r0ach_adm1n", "roach_admin", security.LeetTransformation, map[rune]rune{'0': 'o', '1': 'i'}},
+	} {
+		r := security.EstimatePasswordStrength(tc.password, userInputs)
+		if len(r.Sequence) != 1 {
+			t.Errorf("%q: expected a single match, got %+v", tc.password, r.Sequence)
+			continue
+		}
+		m := r.Sequence[0]
+		if m.Kind != security.DictionaryPattern || m.Word != tc.word ||
+			m.Transformations != tc.transformations ||
+			!reflect.DeepEqual(m.Substitutions, tc.substitutions) {
+			t.Errorf("%q: unexpected match %+v", tc.password, m)
+		}
+		if r.Score > 1 {
+			t.Errorf("%q: expected a weak password, got %d (%.1f)", tc.password, r.Score, r.GuessesLog10)
+		}
+	}
+	if s := (security.LeetTransformation | security.AppendedCharacterTransformation).String(); s !=
+		"leet+appended character" {
+		t.Errorf("unexpected %q", s)
+	}
+	// Substitutions make passwords stronger, but not strong.
+	leet := security.EstimatePasswordStrength("p@ssw0rd", nil).GuessesLog10
+	if leet <= weak {
+		t.Errorf("expected %.1f > %.1f", leet, weak)
+	}
+	p := &security.PasswordPolicy{
+		RequireUppercase: true, RequireLowercase: true, RequireDigit: true, RequireSymbol: true,
+		MinStrengthScore: 2,
+	}
+	if err := p.Validate(
+		context.Background(), "P@ssw0rd!", nil,
+	); errors.Cause(err) != security.ErrPasswordTooWeak {
+		t.Errorf("expected ErrPasswordTooWeak, got %v", err)
+	}
+
+	// Only the first runes of long passwords are matched.
+	long := strings.Repeat("ab", 200)
+	if r := security.EstimatePasswordStrength(long, nil); r.Score != 4 || len(r.Sequence) == 0 {
+		t.Errorf("unexpected result %+v", r)
+	}
+}
+
+func TestEstimatePasswordStrengthEnglishWords(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// Passphrases of common words are estimated by their words, rather than
+	// as long random strings.
+	for _, tc := range []struct {
+		password string
+		words    []string
+		maxScore int
+	}{
+		{"battery", []string{"battery"}, 1},
+		{"batterystaple", []string{"battery", "staple"}, 3},
+		{"kitchenwindowtelescopestaple", []string{"kitchen", "window", "telescope", "staple"}, 4},
+		// A string is not a repeat of itself; "correcthorse" is a common
+		// password.
+		{"correcthorsebatterystaple", []string{"battery", "staple"}, 4},
+		{"Correct-Horse-Battery-Staple", []string{"correct", "horse", "battery", "staple"}, 4},
+	} {
+		r := security.EstimatePasswordStrength(tc.password, nil)
+		var words []string
+		for _, m := range r.Sequence {
+			if m.Kind == security.DictionaryPattern && m.Dictionary == security.EnglishDictionary {
+				words = append(words, m.Word)
+			}
+		}
+		if !reflect.DeepEqual(words, tc.words) {
+			t.Errorf("%q: expected the English words %q, got %+v", tc.password, tc.words, r.Sequence)
+		}
+		if r.Score > tc.maxScore {
+			t.Errorf("%q: expected a score of at most %d, got %d", tc.password, tc.maxScore, r.Score)
+		}
+		if bruteforce := float64(len(tc.password)); r.GuessesLog10 > bruteforce-3 {
+			t.Errorf("%q: expected fewer than 10^%.0f guesses, got 10^%.1f",
+				tc.password, bruteforce-3, r.GuessesLog10)
+		}
+	}
+
+	// The embedded list is generated from english_words.txt; make sure it
+	// was regenerated after the last change.
+	f, err := os.Open("english_words.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	var last string
+	words := map[string]bool{}
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if line == "" || strings.Trim(line, "abcdefghijklmnopqrstuvwxyz") != "" || words[line] {
+			t.Errorf("invalid or duplicate word %q", line)
+		}
+		words[line] = true
+		last = line
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	// The size of the list is documented on EstimatePasswordStrength.
+	if len(words) < 30000 {
+		t.Errorf("expected about 30,000 English words, got %d", len(words))
+	}
+	r := security.EstimatePasswordStrength(last, nil)
+	if len(r.Sequence) != 1 || r.Sequence[0].Dictionary != security.EnglishDictionary ||
+		r.Sequence[0].Rank != len(words) {
+		t.Errorf("%q: expected the English word of rank %d; run go generate, got %+v",
+			last, len(words), r.Sequence)
+	}
+}
+
+func TestPasswordPolicyMinStrengthScore(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	if err := security.SetPasswordPolicy(
+		&security.PasswordPolicy{MinStrengthScore: 5},
+	); !testutils.IsError(err, "invalid minimum password strength score 5") {
+		t.Errorf("expected error, got %v", err)
+	}
+
+	p := &security.PasswordPolicy{MinStrengthScore: 3}
+	err := p.Validate(ctx, "Password123!", nil)
+	var e *security.PasswordTooWeakError
+	if v, ok := err.(*security.PolicyViolations); !ok || !v.As(&e) || e.Required != 3 ||
+		e.Score >= 3 {
+		t.Errorf("expected a PasswordTooWeakError, got %v", err)
+	}
+	if errors.Cause(err) != security.ErrPasswordTooWeak {
+		t.Errorf("expected ErrPasswordTooWeak, got %v", err)
+	}
+	if err := p.Validate(ctx, "x7#Kq!9vLp2@", nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func BenchmarkEstimatePasswordStrength(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		security.EstimatePasswordStrength("Tr0ub4dor&3-correct-horse", []string{"root"})
+	}
+}
+
+func TestDetectTrivialPatterns(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	type match struct {
+		kind  security.PatternKind
+		token string
+	}
+	for _, tc := range []struct {
+		password string
+		expected []match
+	}{
+		{"aaaaaaaa", []match{{security.RepeatPattern, "aaaaaaaa"}}},
+		{"abcdefgh", []match{{security.SequencePattern, "abcdefgh"}}},
+		{"12345678", []match{{security.SequencePattern, "12345678"}}},
+		{"zyxw", []match{{security.SequencePattern, "zyxw"}}},
+		{"qwertyui", []match{{security.SpatialPattern, "qwertyui"}}},
+		{"asdfgh", []match{{security.SpatialPattern, "asdfgh"}}},
+		{"xx!!!!abc9", []match{{security.RepeatPattern, "!!!!"}, {security.SequencePattern, "abc"}}},
+		{"ééé", []match{{security.RepeatPattern, "ééé"}}},
+		// Non-ASCII runes are not part of sequences or keyboard walks.
+		{"αβγδ", nil},
+		{"йцукен", nil},
+		{"x7#Kq!9vLp2@", nil},
+		{"ab", nil},
+		{"", nil},
+	} {
+		var got []match
+		for _, m := range security.DetectTrivialPatterns(tc.password) {
+			got = append(got, match{m.Kind, m.Token})
+		}
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("%q: expected %v, got %v", tc.password, tc.expected, got)
+		}
+	}
+
+	// The detectors do not panic on any input.
+	for _, password := range []string{
+		"\x00\x00\x00", "\xff\xfe\xfd", "\U0010FFFF\U0010FFFE\U0010FFFD", "ááá",
+		strings.Repeat("🔑", 200), strings.Repeat("q", 1000),
+	} {
+		security.DetectTrivialPatterns(password)
+		security.EstimatePasswordStrength(password, nil)
+	}
+
+	if err := security.SetPasswordPolicy(
+		&security.PasswordPolicy{MaxTrivialPatternFraction: 1.5},
+	); !testutils.IsError(err, "invalid maximum trivial pattern fraction") {
+		t.Errorf("expected error, got %v", err)
+	}
+	p := security.NewPasswordPolicy(security.WithMaxTrivialPatternFraction(0.8))
+	for _, tc := range []struct {
+		password string
+		covered  int
+	}{
+		{"abcd1234", 8},
+		{"qwertyx", 6},
+		{"aaaaaaaa", 8},
+		{"qwerty-Lp9", 0},
+		{"correct horse", 0},
+	} {
+		err := p.Validate(context.Background(), tc.password, nil)
+		if tc.covered == 0 {
+			if err != nil {
+				t.Errorf("%q: %v", tc.password, err)
+			}
+			continue
+		}
+		var e *security.TrivialPatternsError
+		if v, ok := err.(*security.PolicyViolations); !ok || !v.As(&e) || e.Covered != tc.covered ||
+			errors.Cause(err) != security.ErrPasswordTrivialPatterns {
+			t.Errorf("%q: expected a TrivialPatternsError covering %d runes, got %v",
+				tc.password, tc.covered, err)
+		}
+	}
+}
+
+func TestScorePassword(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, tc := range []struct {
+		password string
+		feedback []string
+	}{
+		{"", []string{security.FeedbackUseFewWords}},
+		{"123456", []string{security.FeedbackTop10Password}},
+		{"qwertyuiop", []string{security.FeedbackTop100Password}},
+		{"P@ssw0rd", []string{security.FeedbackSimilarToCommon, security.FeedbackCapitalization,
+			security.FeedbackLeetSubstitutions}},
+		{"Dragon1", []string{security.FeedbackSimilarToCommon, security.FeedbackCapitalization,
+			security.FeedbackAppendedCharacter}},
+		{"aaaaaaaaa", []string{security.FeedbackAvoidRepeats}},
+		{"abcdefgh", []string{security.FeedbackAvoidSequences}},
+		{"roach_admin", []string{security.FeedbackAvoidUserInputs}},
+		// Strong passwords get no suggestion.
+		{"x7#Kq!9vLp2@", nil},
+	} {
+		score, feedback := security.ScorePassword(tc.password, []string{"roach_admin"})
+		if expected := security.EstimatePasswordStrength(
+			tc.password, []string{"roach_admin"},
+		).Score; score != expected {
+			t.Errorf("%q: expected score %d, got %d", tc.password, expected, score)
+		}
+		if tc.feedback != nil {
+			tc.feedback = append(tc.feedback, security.FeedbackAddAnotherWord)
+		}
+		var ids []string
+		for _, f := range feedback {
+			id, text := security.SplitFeedback(f)
+			if text == "" || f != id+": "+text {
+				t.Errorf("%q: malformed feedback %q", tc.password, f)
+			}
+			ids = append(ids, id)
+		}
+		if !reflect.DeepEqual(ids, tc.feedback) {
+			t.Errorf("%q: expected %q, got %q", tc.password, tc.feedback, feedback)
+		}
+	}
+
+	for _, score := range []int{-1, 5} {
+		if err := security.SetPasswordStrengthWarning(score); !testutils.IsError(
+			err, "invalid password strength warning score",
+		) {
+			t.Errorf("%d: expected error, got %v", score, err)
+		}
+	}
+	if err := security.SetPasswordStrengthWarning(0); err != nil {
+		t.Error(err)
+	}
+}
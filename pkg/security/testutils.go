@@ -0,0 +1,52 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"io"
+	"io/ioutil"
+)
+
+// NewTestPrompter returns a Prompter on a fake terminal, on which the input
+// is typed in response to successive reads. Once it is exhausted, reads
+// fail with io.EOF. The prompts are discarded, unless WithPromptOutput is
+// given.
+func NewTestPrompter(input []string, opts ...PrompterOption) *Prompter {
+	return NewTestTerminalPrompter(func() ([]byte, error) {
+		if len(input) == 0 {
+			return nil, io.EOF
+		}
+		password := input[0]
+		input = input[1:]
+		return []byte(password), nil
+	}, opts...)
+}
+
+// NewTestTerminalPrompter returns a Prompter on a fake terminal from which
+// passwords, and lines read by ReadLineEchoed, are read with read, which is
+// called once the prompt has been written, e.g. to block until a test types
+// a password.
+func NewTestTerminalPrompter(read func() ([]byte, error), opts ...PrompterOption) *Prompter {
+	p := &Prompter{
+		out: ioutil.Discard,
+		readTerminal: func(*Prompter) ([]byte, error) {
+			return read()
+		},
+		readTerminalEchoed: func(*Prompter) ([]byte, error) {
+			return read()
+		},
+	}
+	return p.apply(opts)
+}
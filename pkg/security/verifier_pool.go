@@ -0,0 +1,191 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+// ErrVerifyQueueFull indicates that a VerifierPool rejected a verification,
+// because its queue was full or because the context of the verification
+// expired while it was queued.
+var ErrVerifyQueueFull = errors.New("password verification queue is full")
+
+// ErrVerifierPoolClosed indicates that a verification was submitted to a
+// VerifierPool after it was closed.
+var ErrVerifierPoolClosed = errors.New("password verifier pool is closed")
+
+// The states of a verifyRequest.
+const (
+	verifyRequestQueued int32 = iota
+	verifyRequestStarted
+	verifyRequestAbandoned
+)
+
+type verifyRequest struct {
+	ctx            context.Context
+	hashedPassword []byte
+	password       []byte
+	// state is updated atomically, so that the worker and the caller agree
+	// on whether a request timed out in the queue.
+	state  int32
+	result chan error
+}
+
+// VerifierPool runs password verifications on a fixed number of workers, so
+// that the CPU spent on them is bounded and can be reasoned about.
+// Verifications wait in a bounded queue for a worker to be available. Its
+// zero value is not usable; use NewVerifierPool.
+//
+// Using a VerifierPool is optional: CompareHashAndPassword and the related
+// functions verify passwords on the calling goroutine.
+type VerifierPool struct {
+	workers  int
+	queue    chan *verifyRequest
+	busy     int32
+	rejected int64
+	wg       sync.WaitGroup
+
+	mu struct {
+		syncutil.RWMutex
+		closed bool
+	}
+}
+
+// VerifierPoolStats are statistics about a VerifierPool.
+type VerifierPoolStats struct {
+	// Workers is the number of workers of the pool, and Busy the number of
+	// them verifying a password.
+	Workers, Busy int
+	// Queued is the number of verifications waiting for a worker.
+	Queued int
+	// Rejected is the number of verifications rejected with
+	// ErrVerifyQueueFull.
+	Rejected int64
+}
+
+// NewVerifierPool creates a VerifierPool with the given number of workers,
+// and a queue of the given depth. It must be closed with Close.
+func NewVerifierPool(workers, queueDepth int) *VerifierPool {
+	if workers <= 0 {
+		workers = 1
+	}
+	if queueDepth < 0 {
+		queueDepth = 0
+	}
+	p := &VerifierPool{
+		workers: workers,
+		queue:   make(chan *verifyRequest, queueDepth),
+	}
+	p.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+// Compare is like CompareHashAndPasswordCtx, but runs the verification on a
+// worker of the pool. If the queue of the pool is full, or the context
+// expires before a worker picks the verification up, it fails with an error
+// whose cause is ErrVerifyQueueFull.
+func (p *VerifierPool) Compare(ctx context.Context, hashedPassword []byte, password string) error {
+	// Verifications run by a worker are reported to the audit hook by the
+	// worker, and the others here.
+	hook := activeVerificationAuditHook()
+	start := hook.start()
+	req := &verifyRequest{
+		ctx:            ctx,
+		hashedPassword: hashedPassword,
+		password:       []byte(password),
+		result:         make(chan error, 1),
+	}
+	if err := p.submit(req); err != nil {
+		ZeroBytes(req.password)
+		hook.record(ctx, start, hashedPassword, err)
+		return err
+	}
+	select {
+	case err := <-req.result:
+		return err
+	case <-ctx.Done():
+		if atomic.CompareAndSwapInt32(&req.state, verifyRequestQueued, verifyRequestAbandoned) {
+			// The worker which dequeues the request zeroes the password.
+			atomic.AddInt64(&p.rejected, 1)
+			err := errors.Wrapf(ErrVerifyQueueFull, "waiting for a worker: %v", ctx.Err())
+			hook.record(ctx, start, hashedPassword, err)
+			return err
+		}
+		return errors.Wrap(ctx.Err(), "comparing password")
+	}
+}
+
+// submit queues a request unless the pool is closed or its queue is full.
+func (p *VerifierPool) submit(req *verifyRequest) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.mu.closed {
+		return ErrVerifierPoolClosed
+	}
+	select {
+	case p.queue <- req:
+		return nil
+	default:
+		atomic.AddInt64(&p.rejected, 1)
+		return ErrVerifyQueueFull
+	}
+}
+
+func (p *VerifierPool) worker() {
+	defer p.wg.Done()
+	for req := range p.queue {
+		if !atomic.CompareAndSwapInt32(&req.state, verifyRequestQueued, verifyRequestStarted) {
+			ZeroBytes(req.password)
+			continue
+		}
+		atomic.AddInt32(&p.busy, 1)
+		req.result <- compareHashAndPassword(req.ctx, req.hashedPassword, req.password)
+		atomic.AddInt32(&p.busy, -1)
+		ZeroBytes(req.password)
+	}
+}
+
+// Stats returns statistics about the pool.
+func (p *VerifierPool) Stats() VerifierPoolStats {
+	return VerifierPoolStats{
+		Workers:  p.workers,
+		Busy:     int(atomic.LoadInt32(&p.busy)),
+		Queued:   len(p.queue),
+		Rejected: atomic.LoadInt64(&p.rejected),
+	}
+}
+
+// Close stops accepting verifications, and waits for the ones already
+// submitted to complete. Queued verifications whose context expires in the
+// meantime are rejected.
+func (p *VerifierPool) Close() {
+	p.mu.Lock()
+	if !p.mu.closed {
+		p.mu.closed = true
+		close(p.queue)
+	}
+	p.mu.Unlock()
+	p.wg.Wait()
+}
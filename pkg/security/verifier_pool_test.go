@@ -0,0 +1,165 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+// pbkdf2Hash is a PBKDF2 hash of "hunter2", whose prefix lets it reach the
+// default Hasher in FIPS mode too.
+const pbkdf2Hash = "$pbkdf2-sha256$i=4096$c2FsdA$g2tfxtuFNB9nROQSUxQQGp0HAqV9Abs1Gcpe+y2spXA"
+
+func TestVerifierPool(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	ctx := context.Background()
+
+	t.Run("compare", func(t *testing.T) {
+		p := security.NewVerifierPool(2, 4)
+		defer p.Close()
+		if err := p.Compare(ctx, []byte(pbkdf2Hash), "hunter2"); err != nil {
+			t.Error(err)
+		}
+		if err := p.Compare(
+			ctx, []byte(pbkdf2Hash), "hunter3",
+		); err != security.ErrPasswordMismatch {
+			t.Errorf("expected ErrPasswordMismatch, got %v", err)
+		}
+	})
+
+	// The following subtests block the workers with a blockingHasher.
+	b := blockingHasher{started: make(chan struct{}), unblock: make(chan struct{})}
+	prev := security.SetDefaultHasher(b)
+	defer security.SetDefaultHasher(prev)
+
+	// waitForStats waits until the stats of the pool match the given values.
+	waitForStats := func(p *security.VerifierPool, busy, queued int, rejected int64) {
+		t.Helper()
+		testutils.SucceedsSoon(t, func() error {
+			s := p.Stats()
+			if s.Busy != busy || s.Queued != queued || s.Rejected != rejected {
+				return errors.Errorf("expected %d busy, %d queued and %d rejected, got %+v",
+					busy, queued, rejected, s)
+			}
+			return nil
+		})
+	}
+	compareAsync := func(p *security.VerifierPool, ctx context.Context) chan error {
+		errCh := make(chan error, 1)
+		go func() { errCh <- p.Compare(ctx, []byte(pbkdf2Hash), "hunter2") }()
+		return errCh
+	}
+
+	t.Run("backpressure", func(t *testing.T) {
+		p := security.NewVerifierPool(1, 1)
+		running := compareAsync(p, ctx)
+		<-b.started
+		queued := compareAsync(p, ctx)
+		waitForStats(p, 1, 1, 0)
+
+		if err := p.Compare(
+			ctx, []byte(pbkdf2Hash), "hunter2",
+		); errors.Cause(err) != security.ErrVerifyQueueFull {
+			t.Errorf("expected ErrVerifyQueueFull, got %v", err)
+		}
+		waitForStats(p, 1, 1, 1)
+
+		b.unblock <- struct{}{}
+		if err := <-running; err != nil {
+			t.Error(err)
+		}
+		<-b.started
+		b.unblock <- struct{}{}
+		if err := <-queued; err != nil {
+			t.Error(err)
+		}
+		p.Close()
+		if s := p.Stats(); s.Workers != 1 || s.Busy != 0 || s.Queued != 0 || s.Rejected != 1 {
+			t.Errorf("unexpected stats %+v", s)
+		}
+	})
+
+	t.Run("queue timeout", func(t *testing.T) {
+		p := security.NewVerifierPool(1, 1)
+		running := compareAsync(p, ctx)
+		<-b.started
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		if err := p.Compare(
+			timeoutCtx, []byte(pbkdf2Hash), "hunter2",
+		); errors.Cause(err) != security.ErrVerifyQueueFull {
+			t.Errorf("expected ErrVerifyQueueFull, got %v", err)
+		}
+
+		// The abandoned request is skipped by the worker.
+		b.unblock <- struct{}{}
+		if err := <-running; err != nil {
+			t.Error(err)
+		}
+		p.Close()
+		if s := p.Stats(); s.Rejected != 1 {
+			t.Errorf("expected 1 rejection, got %+v", s)
+		}
+	})
+
+	t.Run("close", func(t *testing.T) {
+		p := security.NewVerifierPool(1, 1)
+		running := compareAsync(p, ctx)
+		<-b.started
+		queued := compareAsync(p, ctx)
+		waitForStats(p, 1, 1, 0)
+
+		closed := make(chan struct{})
+		go func() {
+			p.Close()
+			close(closed)
+		}()
+		testutils.SucceedsSoon(t, func() error {
+			if err := p.Compare(
+				ctx, []byte(pbkdf2Hash), "hunter2",
+			); err != security.ErrVerifierPoolClosed {
+				return errors.Errorf("expected ErrVerifierPoolClosed, got %v", err)
+			}
+			return nil
+		})
+
+		// Close waits for the verifications in progress and in the queue.
+		for _, errCh := range []chan error{running, queued} {
+			select {
+			case <-closed:
+				t.Fatal("expected Close to wait for the submitted verifications")
+			default:
+			}
+			b.unblock <- struct{}{}
+			if err := <-errCh; err != nil {
+				t.Error(err)
+			}
+			if errCh == running {
+				<-b.started
+			}
+		}
+		<-closed
+	})
+}
@@ -0,0 +1,208 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/util/cache"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+// defaultVerifyCacheTTL is how long verification outcomes are cached, unless
+// changed with SetVerifyCacheTTL.
+const defaultVerifyCacheTTL = time.Minute
+
+// verifyCacheKey identifies a (stored hash, password, user name) triple in
+// the verification cache. It is the SHA-256 of the stored hash, followed by
+// an HMAC of the password, keyed with a secret generated once per process, so
+// that neither the cache nor a dump of the memory holding it can be used to
+// recover or test guesses of the password, and by the user name. The user
+// name is empty unless it is needed to verify the hash, as for PostgreSQL
+// md5 hashes, which are salted with it.
+type verifyCacheKey [sha256.Size]byte
+
+// verifyCacheEntry is the value stored in the verification cache. Only the
+// outcome of the comparison is kept.
+type verifyCacheEntry struct {
+	hashID  verifyCacheKey
+	match   bool
+	expires time.Time
+}
+
+// verifyCache caches the outcomes of password verifications, so that
+// clients reconnecting repeatedly with the same credentials, e.g. through a
+// connection pool, do not pay for a full hash evaluation every time. It is
+// disabled unless enabled with SetVerifyCacheSize.
+var verifyCache struct {
+	syncutil.Mutex
+	size    int
+	ttl     time.Duration
+	secret  []byte
+	entries *cache.UnorderedCache
+	// byHash indexes the keys of the entries by the SHA-256 of their stored
+	// hash, so that InvalidateVerifyCache can drop them.
+	byHash map[verifyCacheKey]map[verifyCacheKey]struct{}
+	// generation is incremented whenever entries are dropped, so that the
+	// outcomes of the verifications which started before are not cached.
+	generation uint64
+}
+
+func init() {
+	verifyCache.ttl = defaultVerifyCacheTTL
+	verifyCache.byHash = make(map[verifyCacheKey]map[verifyCacheKey]struct{})
+	verifyCache.entries = cache.NewUnorderedCache(cache.Config{
+		Policy: cache.CacheLRU,
+		ShouldEvict: func(size int, _, value interface{}) bool {
+			return size > verifyCache.size ||
+				timeutil.Now().After(value.(verifyCacheEntry).expires)
+		},
+		OnEvicted: func(key, value interface{}) {
+			hashID := value.(verifyCacheEntry).hashID
+			keys := verifyCache.byHash[hashID]
+			delete(keys, key.(verifyCacheKey))
+			if len(keys) == 0 {
+				delete(verifyCache.byHash, hashID)
+			}
+		},
+	})
+}
+
+// SetVerifyCacheSize sets the maximum number of verification outcomes to
+// cache. A size of 0 disables the cache, which is the default. Changing the
+// size drops all the cached outcomes.
+//
+// A cached outcome is only reused for the same password and stored hash,
+// until it expires (see SetVerifyCacheTTL). Changing a password replaces its
+// stored hash, so the outcomes cached for the old one are not used anymore;
+// InvalidateVerifyCache drops them right away, and keeps the verifications
+// in flight from caching theirs.
+func SetVerifyCacheSize(n int) error {
+	if n < 0 {
+		return errors.Errorf("invalid password verification cache size %d", n)
+	}
+	verifyCache.Lock()
+	defer verifyCache.Unlock()
+	if n > 0 && verifyCache.secret == nil {
+		secret := make([]byte, sha256.Size)
+		if _, err := rand.Read(secret); err != nil {
+			return errors.Wrap(err, "generating password verification cache key")
+		}
+		verifyCache.secret = secret
+	}
+	verifyCache.size = n
+	verifyCache.entries.Clear()
+	verifyCache.generation++
+	return nil
+}
+
+// SetVerifyCacheTTL sets how long verification outcomes are cached. It
+// applies to outcomes cached afterwards. The default is one minute.
+func SetVerifyCacheTTL(d time.Duration) error {
+	if d <= 0 {
+		return errors.Errorf("invalid password verification cache TTL %s", d)
+	}
+	verifyCache.Lock()
+	defer verifyCache.Unlock()
+	verifyCache.ttl = d
+	return nil
+}
+
+// InvalidateVerifyCache drops the cached verification outcomes for a stored
+// hash, e.g. because it was replaced when the password changed or the user
+// was dropped.
+func InvalidateVerifyCache(hashedPassword []byte) {
+	hashID := verifyCacheKey(sha256.Sum256(hashedPassword))
+	verifyCache.Lock()
+	defer verifyCache.Unlock()
+	for key := range verifyCache.byHash[hashID] {
+		verifyCache.entries.Del(key)
+	}
+	verifyCache.generation++
+}
+
+// clearVerifyCache drops all the cached verification outcomes. It is called
+// when a configuration change can alter the outcome of a verification.
+func clearVerifyCache() {
+	verifyCache.Lock()
+	defer verifyCache.Unlock()
+	verifyCache.entries.Clear()
+	verifyCache.generation++
+}
+
+// verifyCacheLookup returns the key under which the outcome of verifying
+// password against hashedPassword of the user is cached, the generation of
+// the cache, and the outcome if it is cached. The key and generation are only valid if
+// the cache is enabled, and are to be passed to verifyCacheAdd.
+func verifyCacheLookup(
+	hashedPassword, password []byte, username string,
+) (key verifyCacheKey, generation uint64, match, ok, enabled bool) {
+	verifyCache.Lock()
+	defer verifyCache.Unlock()
+	if verifyCache.size == 0 {
+		return key, 0, false, false, false
+	}
+	generation = verifyCache.generation
+	mac := hmac.New(sha256.New, verifyCache.secret)
+	_, _ = mac.Write(password)
+	h := sha256.New()
+	_, _ = h.Write(hashedPassword)
+	_, _ = h.Write(mac.Sum(nil))
+	_, _ = h.Write([]byte(username))
+	copy(key[:], h.Sum(nil))
+
+	v, ok := verifyCache.entries.Get(key)
+	if !ok {
+		return key, generation, false, false, true
+	}
+	e := v.(verifyCacheEntry)
+	if timeutil.Now().After(e.expires) {
+		verifyCache.entries.Del(key)
+		return key, generation, false, false, true
+	}
+	return key, generation, e.match, true, true
+}
+
+// verifyCacheAdd caches the outcome of a verification, unless entries were
+// dropped since verifyCacheLookup returned the generation, e.g. because the
+// password changed, or a configuration change altered the outcome.
+func verifyCacheAdd(
+	key verifyCacheKey, generation uint64, hashedPassword []byte, match bool,
+) {
+	hashID := verifyCacheKey(sha256.Sum256(hashedPassword))
+	verifyCache.Lock()
+	defer verifyCache.Unlock()
+	if verifyCache.size == 0 || verifyCache.generation != generation {
+		// The cache was disabled or cleared during the verification.
+		return
+	}
+	keys, ok := verifyCache.byHash[hashID]
+	if !ok {
+		keys = make(map[verifyCacheKey]struct{})
+		verifyCache.byHash[hashID] = keys
+	}
+	keys[key] = struct{}{}
+	verifyCache.entries.Add(key, verifyCacheEntry{
+		hashID:  hashID,
+		match:   match,
+		expires: timeutil.Now().Add(verifyCache.ttl),
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/testutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+// countingHasher is a Hasher which counts the comparisons it delegates to
+// another Hasher.
+type countingHasher struct {
+	security.Hasher
+	compares *int32
+}
+
+func (c countingHasher) Compare(hashedPassword, password []byte) error {
+	atomic.AddInt32(c.compares, 1)
+	return c.Hasher.Compare(hashedPassword, password)
+}
+
+// pausingHasher is a Hasher which, when pause is set, waits for resume
+// before its comparisons.
+type pausingHasher struct {
+	security.Hasher
+	pause           *int32
+	paused, resumed chan struct{}
+}
+
+func (p pausingHasher) Compare(hashedPassword, password []byte) error {
+	if atomic.CompareAndSwapInt32(p.pause, 1, 0) {
+		p.paused <- struct{}{}
+		<-p.resumed
+	}
+	return p.Hasher.Compare(hashedPassword, password)
+}
+
+func TestVerifyCache(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	defer func(i int) { security.PBKDF2Iterations = i }(security.PBKDF2Iterations)
+	security.PBKDF2Iterations = 1000
+	var compares int32
+	prev := security.SetDefaultHasher(countingHasher{security.DefaultHasher(), &compares})
+	defer security.SetDefaultHasher(prev)
+
+	if err := security.SetVerifyCacheSize(-1); !testutils.IsError(err, "invalid") {
+		t.Fatalf("expected an invalid size error, got %v", err)
+	}
+	if err := security.SetVerifyCacheTTL(0); !testutils.IsError(err, "invalid") {
+		t.Fatalf("expected an invalid TTL error, got %v", err)
+	}
+	defer func() {
+		if err := security.SetVerifyCacheTTL(time.Minute); err != nil {
+			t.Fatal(err)
+		}
+		if err := security.SetVerifyCacheSize(0); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	hash, err := security.HashPasswordWithMethod(security.HashPBKDF2, "hunter2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// compare verifies the password and checks the outcome, and whether the
+	// verification went through the hasher.
+	compare := func(hash []byte, password string, match, cached bool) {
+		t.Helper()
+		before := atomic.LoadInt32(&compares)
+		err := security.CompareHashAndPassword(hash, password)
+		if match && err != nil {
+			t.Errorf("expected %q to match, got %v", password, err)
+		} else if !match && err != security.ErrPasswordMismatch {
+			t.Errorf("expected %q not to match, got %v", password, err)
+		}
+		if hit := atomic.LoadInt32(&compares) == before; hit != cached {
+			t.Errorf("expected cached=%t for %q, got %t", cached, password, hit)
+		}
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		compare(hash, "hunter2", true, false)
+		compare(hash, "hunter2", true, false)
+	})
+
+	t.Run("outcomes", func(t *testing.T) {
+		if err := security.SetVerifyCacheSize(10); err != nil {
+			t.Fatal(err)
+		}
+		compare(hash, "hunter2", true, false)
+		compare(hash, "hunter2", true, true)
+		compare(hash, "hunter3", false, false)
+		compare(hash, "hunter3", false, true)
+
+		// Login-disabled hashes are never looked up.
+		for i := 0; i < 2; i++ {
+			if err := security.CompareHashAndPassword(
+				security.MissingPasswordHash(), "hunter2",
+			); err != security.ErrPasswordLoginDisabled {
+				t.Errorf("expected ErrPasswordLoginDisabled, got %v", err)
+			}
+		}
+	})
+
+	t.Run("md5", func(t *testing.T) {
+		skipUnderFIPS(t)
+		if err := security.SetVerifyCacheSize(10); err != nil {
+			t.Fatal(err)
+		}
+		// md5 hashes are salted with the user name, so the outcomes cached for
+		// a user are not those of another. md5("hunter2" || "alice").
+		md5Hash := []byte("md5f1d6e2da5767fddc60c941cf0fa924cf")
+		for _, tc := range []struct {
+			password, username string
+			err                error
+		}{
+			{"hunter2", "alice", nil},
+			{"hunter2", "alice", nil},
+			{"hunter2", "bob", security.ErrPasswordMismatch},
+			{"hunter2a", "lice", nil},
+			{"hunter2a", "alice", security.ErrPasswordMismatch},
+		} {
+			if err := security.CompareHashAndPasswordWithUser(
+				md5Hash, tc.password, tc.username,
+			); err != tc.err {
+				t.Errorf("%s, %s: expected %v, got %v", tc.password, tc.username, tc.err, err)
+			}
+		}
+	})
+
+	t.Run("size", func(t *testing.T) {
+		if err := security.SetVerifyCacheSize(1); err != nil {
+			t.Fatal(err)
+		}
+		compare(hash, "hunter2", true, false)
+		compare(hash, "hunter3", false, false)
+		compare(hash, "hunter2", true, false)
+	})
+
+	t.Run("rotation", func(t *testing.T) {
+		if err := security.SetVerifyCacheSize(10); err != nil {
+			t.Fatal(err)
+		}
+		compare(hash, "hunter2", true, false)
+		compare(hash, "hunter2", true, true)
+
+		// The outcomes for the old hash are not used for the new one.
+		newHash, err := security.HashPasswordWithMethod(security.HashPBKDF2, "correct horse")
+		if err != nil {
+			t.Fatal(err)
+		}
+		compare(newHash, "hunter2", false, false)
+		compare(newHash, "correct horse", true, false)
+		compare(newHash, "correct horse", true, true)
+
+		security.InvalidateVerifyCache(hash)
+		compare(hash, "hunter2", true, false)
+		compare(newHash, "correct horse", true, true)
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		if err := security.SetVerifyCacheSize(10); err != nil {
+			t.Fatal(err)
+		}
+		if err := security.SetVerifyCacheTTL(50 * time.Millisecond); err != nil {
+			t.Fatal(err)
+		}
+		compare(hash, "hunter2", true, false)
+		compare(hash, "hunter2", true, true)
+		time.Sleep(100 * time.Millisecond)
+		compare(hash, "hunter2", true, false)
+	})
+
+	t.Run("stale outcome", func(t *testing.T) {
+		var pause int32
+		h := pausingHasher{security.DefaultHasher(), &pause, make(chan struct{}), make(chan struct{})}
+		defer security.SetDefaultHasher(security.SetDefaultHasher(h))
+		if err := security.SetVerifyCacheSize(10); err != nil {
+			t.Fatal(err)
+		}
+
+		// A verification which started before the cache was cleared does not
+		// cache its outcome, which may be out of date.
+		for _, drop := range []func(){
+			func() { security.InvalidateVerifyCache(hash) },
+			func() { security.SetDefaultHasher(security.SetDefaultHasher(h)) },
+		} {
+			atomic.StoreInt32(&pause, 1)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				compare(hash, "hunter2", true, false)
+			}()
+			<-h.paused
+			drop()
+			h.resumed <- struct{}{}
+			<-done
+			compare(hash, "hunter2", true, false)
+			compare(hash, "hunter2", true, true)
+			security.InvalidateVerifyCache(hash)
+		}
+	})
+
+	t.Run("hasher change", func(t *testing.T) {
+		if err := security.SetVerifyCacheSize(10); err != nil {
+			t.Fatal(err)
+		}
+		compare(hash, "hunter2", true, false)
+		security.SetDefaultHasher(security.SetDefaultHasher(security.DefaultHasher()))
+		compare(hash, "hunter2", true, false)
+	})
+}
+
+// BenchmarkVerifyCache measures the latency of reconnecting repeatedly with
+// the same credentials, with and without the verification cache.
+func BenchmarkVerifyCache(b *testing.B) {
+	hash, err := security.HashPassword("hunter2")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() {
+		if err := security.SetVerifyCacheSize(0); err != nil {
+			b.Fatal(err)
+		}
+	}()
+	for _, size := range []int{0, 1024} {
+		name := "cache=off"
+		if size > 0 {
+			name = "cache=on"
+		}
+		b.Run(name, func(b *testing.B) {
+			if err := security.SetVerifyCacheSize(size); err != nil {
+				b.Fatal(err)
+			}
+			latencies := make([]time.Duration, b.N)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				start := timeutil.Now()
+				if err := security.CompareHashAndPassword(hash, "hunter2"); err != nil {
+					b.Fatal(err)
+				}
+				latencies[i] = timeutil.Since(start)
+			}
+			b.StopTimer()
+			sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+			b.Logf("p50 latency over %d reconnects: %s", b.N, latencies[b.N/2])
+		})
+	}
+}
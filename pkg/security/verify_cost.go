@@ -0,0 +1,163 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"crypto/sha256"
+	"flag"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+)
+
+const (
+	// verifyCostWeight is the weight of a new sample in the moving average
+	// of verification durations.
+	verifyCostWeight = 0.3
+	// verifyCostBaseIterations is the PBKDF2 iteration count timed to
+	// estimate the latency of PBKDF2 hashes.
+	verifyCostBaseIterations = 1000
+)
+
+// verifyCostIdleDecay is the time constant with which EstimatedVerifyCost
+// decays toward the expected latency of the configured parameters when no
+// verification is recorded, in nanoseconds. It is accessed atomically.
+var verifyCostIdleDecay = int64(time.Minute)
+
+// verifyCost is the state of EstimatedVerifyCost. Its fields are accessed
+// atomically.
+var verifyCost struct {
+	// ewma is the moving average of the durations of verifications, in
+	// nanoseconds, or 0 until the first one is recorded.
+	ewma int64
+	// last is when the last verification was recorded, in nanoseconds since
+	// the epoch.
+	last int64
+}
+
+// baseVerifyLatencies are the latencies of bcrypt and PBKDF2 with cheap
+// parameters on the local machine, from which the expected latency of the
+// configured parameters is extrapolated. They are timed once, the first time
+// they are needed.
+var baseVerifyLatencies struct {
+	once           sync.Once
+	bcrypt, pbkdf2 time.Duration
+}
+
+// expectedVerifyCost returns the expected latency of verifying a password
+// against a hash produced with the default hash method and its configured
+// parameters, or 0 if it cannot be estimated for the method.
+func expectedVerifyCost() time.Duration {
+	b := &baseVerifyLatencies
+	b.once.Do(func() {
+		password, salt := []byte("calibration password"), make([]byte, pbkdf2SaltLen)
+		for i := 0; i < calibrationRuns; i++ {
+			start := timeutil.Now()
+			_, _ = bcrypt.GenerateFromPassword(password, bcrypt.MinCost)
+			if d := timeutil.Since(start); i == 0 || d < b.bcrypt {
+				b.bcrypt = d
+			}
+			start = timeutil.Now()
+			_ = pbkdf2.Key(password, salt, verifyCostBaseIterations, pbkdf2KeyLen, sha256.New)
+			if d := timeutil.Since(start); i == 0 || d < b.pbkdf2 {
+				b.pbkdf2 = d
+			}
+		}
+	})
+	switch GetDefaultHashMethod() {
+	case HashBCrypt:
+		cost := GetBcryptCost()
+		if cost < bcrypt.MinCost {
+			return b.bcrypt
+		}
+		return b.bcrypt << uint(cost-bcrypt.MinCost)
+	case HashPBKDF2:
+		return time.Duration(int64(b.pbkdf2) * int64(PBKDF2Iterations) / verifyCostBaseIterations)
+	default:
+		return 0
+	}
+}
+
+// decayedVerifyCost returns the moving average of verification durations,
+// decayed toward the expected latency given how long ago the last
+// verification was recorded.
+func decayedVerifyCost(ewma, last, now int64) float64 {
+	expected := float64(expectedVerifyCost())
+	if ewma == 0 {
+		return expected
+	}
+	if expected == 0 {
+		return float64(ewma)
+	}
+	idle := float64(now - last)
+	if idle < 0 {
+		idle = 0
+	}
+	w := math.Exp(-idle / float64(atomic.LoadInt64(&verifyCostIdleDecay)))
+	return expected + (float64(ewma)-expected)*w
+}
+
+// recordVerifyCost adds the duration of a verification to the moving
+// average returned by EstimatedVerifyCost.
+func recordVerifyCost(d time.Duration) {
+	now := timeutil.Now().UnixNano()
+	for {
+		ewma, last := atomic.LoadInt64(&verifyCost.ewma), atomic.LoadInt64(&verifyCost.last)
+		next := float64(d)
+		if ewma != 0 {
+			cur := decayedVerifyCost(ewma, last, now)
+			next = cur + verifyCostWeight*(float64(d)-cur)
+		}
+		if next < 1 {
+			next = 1
+		}
+		if atomic.CompareAndSwapInt64(&verifyCost.ewma, ewma, int64(next)) {
+			atomic.StoreInt64(&verifyCost.last, now)
+			return
+		}
+	}
+}
+
+// EstimatedVerifyCost returns an estimate of how long verifying a password
+// takes, e.g. for admission control to budget authentication against other
+// work. It is a moving average of the durations of recent verifications,
+// which decays toward the expected latency of the configured hash method and
+// parameters (e.g. the bcrypt cost) when no password was verified recently.
+// Verifications answered from the verification cache, or which did not
+// evaluate a hash, are not taken into account.
+func EstimatedVerifyCost() time.Duration {
+	now := timeutil.Now().UnixNano()
+	return time.Duration(decayedVerifyCost(
+		atomic.LoadInt64(&verifyCost.ewma), atomic.LoadInt64(&verifyCost.last), now))
+}
+
+// TestingSetVerifyCostIdleDecay changes the time constant with which
+// EstimatedVerifyCost decays when no verification is recorded. The returned
+// function restores the previous one.
+func TestingSetVerifyCostIdleDecay(d time.Duration) func() {
+	if flag.Lookup("test.v") == nil {
+		panic("TestingSetVerifyCostIdleDecay can only be used in tests")
+	}
+	prev := atomic.SwapInt64(&verifyCostIdleDecay, int64(d))
+	return func() {
+		atomic.StoreInt64(&verifyCostIdleDecay, prev)
+	}
+}
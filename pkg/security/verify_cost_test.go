@@ -0,0 +1,100 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security_test
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/security"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+)
+
+func TestEstimatedVerifyCost(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	skipUnderFIPS(t)
+
+	hashAt := func(cost int) []byte {
+		defer security.TestingSetBcryptCost(cost)()
+		hash, err := security.HashPassword("hunter2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+	cheap, expensive := hashAt(4), hashAt(8)
+	defer security.TestingSetBcryptCost(8)()
+
+	// verify verifies a password n times against the hash, and returns the
+	// median duration reported by CompareHashAndPasswordTimed.
+	verify := func(hash []byte, n int) time.Duration {
+		var durations []time.Duration
+		for i := 0; i < n; i++ {
+			d, err := security.CompareHashAndPasswordTimed(hash, "hunter2")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d <= 0 {
+				t.Fatalf("expected a positive duration, got %s", d)
+			}
+			durations = append(durations, d)
+		}
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		return durations[n/2]
+	}
+
+	// Concurrent verifications all contribute to the estimate.
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				if _, err := security.CompareHashAndPasswordTimed(cheap, "hunter2"); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	cheapLatency := verify(cheap, 5)
+	if e := security.EstimatedVerifyCost(); e <= 0 || e > 4*cheapLatency {
+		t.Errorf("expected an estimate close to %s, got %s", cheapLatency, e)
+	}
+
+	// The estimate follows a cost change within a handful of samples.
+	expensiveLatency := verify(expensive, 5)
+	if e := security.EstimatedVerifyCost(); e < expensiveLatency/2 {
+		t.Errorf("expected an estimate close to %s, got %s", expensiveLatency, e)
+	}
+
+	// Without verifications, the estimate decays toward the expected latency
+	// of the configured cost.
+	defer security.TestingSetBcryptCost(4)()
+	defer security.TestingSetVerifyCostIdleDecay(time.Millisecond)()
+	time.Sleep(50 * time.Millisecond)
+	if e := security.EstimatedVerifyCost(); e >= expensiveLatency/2 {
+		t.Errorf("expected the estimate to decay below %s, got %s", expensiveLatency/2, e)
+	}
+
+	// Verifications which do not evaluate a hash report no duration.
+	if d, err := security.CompareHashAndPasswordTimed(
+		security.MissingPasswordHash(), "hunter2",
+	); err != security.ErrPasswordLoginDisabled || d != 0 {
+		t.Errorf("expected ErrPasswordLoginDisabled and no duration, got %s, %v", d, err)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2018 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package security
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/cockroachdb/cockroach/pkg/util/metric"
+)
+
+var (
+	metaVerifyInFlight = metric.Metadata{
+		Name:        "security.password.verify.inflight",
+		Help:        "Number of password verifications in progress",
+		Measurement: "Verifications",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaVerifyQueued = metric.Metadata{
+		Name:        "security.password.verify.queued",
+		Help:        "Number of password verifications waiting for the concurrency limit",
+		Measurement: "Verifications",
+		Unit:        metric.Unit_COUNT,
+	}
+)
+
+// VerifyMetrics holds metrics about password verifications. Verifications
+// are queued when the limit set by SetMaxVerifyConcurrency is reached.
+type VerifyMetrics struct {
+	InFlight *metric.Gauge
+	Queued   *metric.Gauge
+}
+
+var verifyMetrics = VerifyMetrics{
+	InFlight: metric.NewGauge(metaVerifyInFlight),
+	Queued:   metric.NewGauge(metaVerifyQueued),
+}
+
+// PasswordVerifyMetrics returns the metrics about password verifications.
+// They are shared by all the servers of a process.
+func PasswordVerifyMetrics() VerifyMetrics {
+	return verifyMetrics
+}
+
+// verifySem limits the number of concurrent verifications. It holds a
+// verifySemaphore, whose channel is nil when there is no limit.
+var verifySem atomic.Value
+
+type verifySemaphore struct {
+	c chan struct{}
+}
+
+func init() {
+	verifySem.Store(verifySemaphore{})
+}
+
+// SetMaxVerifyConcurrency limits the number of password verifications that
+// can run concurrently, so that a burst of login attempts cannot starve
+// other work of CPU. Verifications beyond the limit wait for others to
+// finish. A limit of 0 means no limit, which is the default.
+//
+// Verifications already running when the limit changes count towards the
+// limit they started under.
+func SetMaxVerifyConcurrency(n int) error {
+	if n < 0 {
+		return errors.Errorf("invalid password verification concurrency %d", n)
+	}
+	var s verifySemaphore
+	if n > 0 {
+		s.c = make(chan struct{}, n)
+	}
+	verifySem.Store(s)
+	return nil
+}
+
+// acquireVerifySlot waits until a verification can start, or the context
+// is canceled. On success, the returned function must be called once the
+// verification finished.
+func acquireVerifySlot(ctx context.Context) (release func(), _ error) {
+	s := verifySem.Load().(verifySemaphore)
+	if s.c != nil {
+		select {
+		case s.c <- struct{}{}:
+		default:
+			verifyMetrics.Queued.Inc(1)
+			select {
+			case s.c <- struct{}{}:
+				verifyMetrics.Queued.Dec(1)
+			case <-ctx.Done():
+				verifyMetrics.Queued.Dec(1)
+				return nil, errors.Wrap(ctx.Err(), "waiting to compare password")
+			}
+		}
+	}
+	verifyMetrics.InFlight.Inc(1)
+	return func() {
+		verifyMetrics.InFlight.Dec(1)
+		if s.c != nil {
+			<-s.c
+		}
+	}, nil
+}
@@ -273,7 +273,8 @@ func (s *authenticationServer) verifyPassword(
 	if !exists {
 		return false, nil
 	}
-	return (security.CompareHashAndPassword(hashedPassword, password) == nil), nil
+	err = security.CompareHashAndPasswordWithUser(hashedPassword, password, username)
+	return err == nil, nil
 }
 
 // newAuthSession attempts to create a new authentication session for the given
@@ -185,8 +185,7 @@ func TestVerifyPassword(t *testing.T) {
 	if util.RaceEnabled {
 		// The default bcrypt cost makes this test approximately 30s slower when the
 		// race detector is on.
-		defer func(prev int) { security.BcryptCost = prev }(security.BcryptCost)
-		security.BcryptCost = bcrypt.MinCost
+		defer security.TestingSetBcryptCost(bcrypt.MinCost)()
 	}
 
 	for _, user := range []struct {
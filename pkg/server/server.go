@@ -46,6 +46,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
 	"github.com/cockroachdb/cockroach/pkg/rpc"
 	"github.com/cockroachdb/cockroach/pkg/rpc/nodedialer"
+	"github.com/cockroachdb/cockroach/pkg/security"
 	"github.com/cockroachdb/cockroach/pkg/server/debug"
 	"github.com/cockroachdb/cockroach/pkg/server/heapprofiler"
 	"github.com/cockroachdb/cockroach/pkg/server/serverpb"
@@ -225,6 +226,7 @@ func NewServer(cfg Config, stopper *stop.Stopper) (*Server, error) {
 		// The certificate manager is non-nil in secure mode.
 		s.registry.AddMetricStruct(certMgr.Metrics())
 	}
+	security.SetMetricsRegistry(s.registry, cfg.HistogramWindowInterval())
 
 	// Add a dynamic log tag value for the node ID.
 	//
@@ -235,7 +235,17 @@ func (ua *userAuthInfo) resolve() (string, []byte, error) {
 			return "", nil, security.ErrEmptyPassword
 		}
 
-		hashedPassword, err = security.HashPassword(resolvedPassword)
+		isHashed, err := security.CheckPasswordHashValidity([]byte(resolvedPassword))
+		if err != nil {
+			// E.g. a malformed pre-hashed password, or one whose cost is out of
+			// the accepted range.
+			return "", nil, pgerror.NewError(pgerror.CodeInvalidParameterValueError, err.Error())
+		}
+		if isHashed {
+			hashedPassword, err = security.LoadPasswordHash([]byte(resolvedPassword))
+		} else {
+			hashedPassword, err = security.HashPassword(resolvedPassword)
+		}
 		if err != nil {
 			return "", nil, err
 		}